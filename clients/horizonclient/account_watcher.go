@@ -0,0 +1,520 @@
+package horizonclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/amount"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/support/errors"
+)
+
+// AccountChange is a change of an account observed by an AccountWatcher. It
+// is one of BalanceChanged, TrustlineAdded, TrustlineUpdated,
+// TrustlineRemoved, SignerAdded, SignerUpdated, SignerRemoved,
+// ThresholdsChanged, FlagsChanged, SequenceChanged, HomeDomainChanged and
+// DataChanged.
+type AccountChange interface {
+	accountChange()
+}
+
+// BalanceChanged is the change of the balance of an asset.
+type BalanceChanged struct {
+	Asset    base.Asset
+	Previous string
+	Current  string
+}
+
+// TrustlineAdded is the creation of a trustline.
+type TrustlineAdded struct {
+	Balance hProtocol.Balance
+}
+
+// TrustlineUpdated is the change of the limit or of the authorization of a
+// trustline.
+type TrustlineUpdated struct {
+	Previous hProtocol.Balance
+	Current  hProtocol.Balance
+}
+
+// TrustlineRemoved is the removal of a trustline.
+type TrustlineRemoved struct {
+	Balance hProtocol.Balance
+}
+
+// SignerAdded is the addition of a signer.
+type SignerAdded struct {
+	Signer hProtocol.Signer
+}
+
+// SignerUpdated is the change of the weight of a signer.
+type SignerUpdated struct {
+	Previous hProtocol.Signer
+	Current  hProtocol.Signer
+}
+
+// SignerRemoved is the removal of a signer.
+type SignerRemoved struct {
+	Signer hProtocol.Signer
+}
+
+// ThresholdsChanged is the change of the thresholds of an account.
+type ThresholdsChanged struct {
+	Previous hProtocol.AccountThresholds
+	Current  hProtocol.AccountThresholds
+}
+
+// FlagsChanged is the change of the flags of an account.
+type FlagsChanged struct {
+	Previous hProtocol.AccountFlags
+	Current  hProtocol.AccountFlags
+}
+
+// SequenceChanged is the change of the sequence number of an account.
+type SequenceChanged struct {
+	Previous string
+	Current  string
+}
+
+// HomeDomainChanged is the change of the home domain of an account.
+type HomeDomainChanged struct {
+	Previous string
+	Current  string
+}
+
+// DataChanged is the change of a data entry. Previous is empty if the entry
+// was added, and Current if it was removed.
+type DataChanged struct {
+	Key      string
+	Previous string
+	Current  string
+}
+
+func (BalanceChanged) accountChange()    {}
+func (TrustlineAdded) accountChange()    {}
+func (TrustlineUpdated) accountChange()  {}
+func (TrustlineRemoved) accountChange()  {}
+func (SignerAdded) accountChange()       {}
+func (SignerUpdated) accountChange()     {}
+func (SignerRemoved) accountChange()     {}
+func (ThresholdsChanged) accountChange() {}
+func (FlagsChanged) accountChange()      {}
+func (SequenceChanged) accountChange()   {}
+func (HomeDomainChanged) accountChange() {}
+func (DataChanged) accountChange()       {}
+
+// AccountWatcher maintains a live local view of an account. It loads the
+// account with AccountDetail, then applies the effects streamed for the
+// account to its local view. Not every change of an account has an effect,
+// the fees charged to the account and the sequence numbers consumed by its
+// transactions in particular, so the watcher periodically reconciles its view
+// with a fresh AccountDetail.
+//
+//	watcher := &horizonclient.AccountWatcher{
+//		Client:    client,
+//		AccountID: address,
+//		OnChange: func(account hProtocol.Account, change horizonclient.AccountChange) {
+//			if balance, ok := change.(horizonclient.BalanceChanged); ok {
+//				...
+//			}
+//		},
+//	}
+//	err := watcher.Run(ctx)
+type AccountWatcher struct {
+	Client    ClientInterface
+	AccountID string
+	// ReconcileInterval is the delay between two reconciliations of the local
+	// view with AccountDetail. Defaults to 1 minute.
+	ReconcileInterval time.Duration
+	// OnChange is called with the updated view of the account for every
+	// change of the account, by the goroutine running Run.
+	OnChange func(account hProtocol.Account, change AccountChange)
+	// OnError is called when a reconciliation fails or when the stream of
+	// effects stops. The watcher keeps running.
+	OnError func(err error)
+
+	mutex   sync.RWMutex
+	account hProtocol.Account
+	loaded  bool
+
+	// snapshotLedger is the latest ledger whose changes are included in the
+	// last account loaded with AccountDetail.
+	snapshotLedger int64
+	cursor         string
+}
+
+// Account returns the local view of the account, and false if it was not
+// loaded yet.
+func (w *AccountWatcher) Account() (hProtocol.Account, bool) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	return copyAccount(w.account), w.loaded
+}
+
+// Run loads the account and keeps its local view up to date until ctx is
+// done. It returns an error if the account cannot be loaded, and nil when ctx
+// is done.
+func (w *AccountWatcher) Run(ctx context.Context) error {
+	if err := w.Reconcile(); err != nil {
+		return errors.Wrap(err, "could not load account")
+	}
+
+	interval := w.ReconcileInterval
+	if interval == 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	effectsCh := make(chan effects.Effect)
+	streamErrs := make(chan error, 1)
+	w.streamEffects(ctx, effectsCh, streamErrs)
+
+	var restart <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case effect := <-effectsCh:
+			w.apply(effect)
+		case err := <-streamErrs:
+			w.report(errors.Wrap(err, "effects stream stopped"))
+			restart = time.After(time.Second)
+		case <-restart:
+			restart = nil
+			w.streamEffects(ctx, effectsCh, streamErrs)
+		case <-ticker.C:
+			if err := w.Reconcile(); err != nil {
+				w.report(errors.Wrap(err, "could not reconcile account"))
+			}
+		}
+	}
+}
+
+// Reconcile replaces the local view of the account with a fresh
+// AccountDetail, calling OnChange for every difference. It must not be called
+// concurrently with Run.
+func (w *AccountWatcher) Reconcile() error {
+	account, err := w.Client.AccountDetail(AccountRequest{AccountID: w.AccountID})
+	if err != nil {
+		return err
+	}
+
+	snapshotLedger := int64(account.LastModifiedLedger)
+	for _, balance := range account.Balances {
+		if int64(balance.LastModifiedLedger) > snapshotLedger {
+			snapshotLedger = int64(balance.LastModifiedLedger)
+		}
+	}
+	if snapshotLedger > w.snapshotLedger {
+		w.snapshotLedger = snapshotLedger
+	}
+
+	if !w.loaded {
+		w.mutex.Lock()
+		w.account = account
+		w.loaded = true
+		w.mutex.Unlock()
+		return nil
+	}
+	w.update(account)
+	return nil
+}
+
+// streamEffects streams the effects of the account to effectsCh, starting
+// after the last applied effect, or after the ledger of the account snapshot.
+func (w *AccountWatcher) streamEffects(ctx context.Context, effectsCh chan<- effects.Effect, errs chan<- error) {
+	cursor := w.cursor
+	if cursor == "" {
+		cursor = w.startCursor()
+	}
+	request := EffectRequest{ForAccount: w.AccountID, Cursor: cursor}
+
+	go func() {
+		err := w.Client.StreamEffects(ctx, request, func(effect effects.Effect) {
+			select {
+			case effectsCh <- effect:
+			case <-ctx.Done():
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("stream closed")
+		}
+		errs <- err
+	}()
+}
+
+// startCursor returns the cursor of the first effect after the ledger of the
+// account snapshot.
+func (w *AccountWatcher) startCursor() string {
+	if w.snapshotLedger == 0 {
+		return "now"
+	}
+	return fmt.Sprintf("%d-0", (w.snapshotLedger+1)<<32)
+}
+
+// apply applies an effect to the local view of the account.
+func (w *AccountWatcher) apply(effect effects.Effect) {
+	w.cursor = effect.PagingToken()
+	// the changes of the ledgers up to the snapshot ledger are already
+	// included in the local view
+	if effectLedger(effect.PagingToken()) <= w.snapshotLedger {
+		return
+	}
+
+	w.mutex.RLock()
+	account := copyAccount(w.account)
+	w.mutex.RUnlock()
+
+	switch e := effect.(type) {
+	case effects.AccountCredited:
+		addToBalance(&account, e.Asset, e.Amount, 1)
+	case effects.AccountDebited:
+		addToBalance(&account, e.Asset, e.Amount, -1)
+	case effects.Trade:
+		addToBalance(&account, base.Asset{Type: e.SoldAssetType, Code: e.SoldAssetCode, Issuer: e.SoldAssetIssuer}, e.SoldAmount, -1)
+		addToBalance(&account, base.Asset{Type: e.BoughtAssetType, Code: e.BoughtAssetCode, Issuer: e.BoughtAssetIssuer}, e.BoughtAmount, 1)
+	case effects.SignerCreated:
+		account.Signers = append(account.Signers, effectSigner(e.Key, e.PublicKey, e.Weight))
+	case effects.SignerUpdated:
+		signer := effectSigner(e.Key, e.PublicKey, e.Weight)
+		for i := range account.Signers {
+			if account.Signers[i].Key == signer.Key {
+				account.Signers[i] = signer
+			}
+		}
+	case effects.SignerRemoved:
+		signer := effectSigner(e.Key, e.PublicKey, e.Weight)
+		signers := account.Signers[:0]
+		for _, s := range account.Signers {
+			if s.Key != signer.Key {
+				signers = append(signers, s)
+			}
+		}
+		account.Signers = signers
+	case effects.TrustlineCreated:
+		account.Balances = append(account.Balances, hProtocol.Balance{
+			Balance: "0.0000000",
+			Limit:   e.Limit,
+			Asset:   e.Asset,
+		})
+	case effects.TrustlineUpdated:
+		if i := balanceIndex(account, e.Asset); i >= 0 {
+			account.Balances[i].Limit = e.Limit
+		}
+	case effects.TrustlineRemoved:
+		if i := balanceIndex(account, e.Asset); i >= 0 {
+			account.Balances = append(account.Balances[:i], account.Balances[i+1:]...)
+		}
+	case effects.AccountThresholdsUpdated:
+		account.Thresholds = hProtocol.AccountThresholds{
+			LowThreshold:  byte(e.LowThreshold),
+			MedThreshold:  byte(e.MedThreshold),
+			HighThreshold: byte(e.HighThreshold),
+		}
+	case effects.AccountFlagsUpdated:
+		if e.AuthRequired != nil {
+			account.Flags.AuthRequired = *e.AuthRequired
+		}
+		if e.AuthRevokable != nil {
+			account.Flags.AuthRevocable = *e.AuthRevokable
+		}
+	case effects.AccountHomeDomainUpdated:
+		account.HomeDomain = e.HomeDomain
+	case effects.SequenceBumped:
+		account.Sequence = strconv.FormatInt(e.NewSeq, 10)
+	default:
+		return
+	}
+	w.update(account)
+}
+
+// update replaces the local view of the account and calls OnChange for every
+// difference with the previous view.
+func (w *AccountWatcher) update(account hProtocol.Account) {
+	w.mutex.Lock()
+	previous := w.account
+	w.account = account
+	w.mutex.Unlock()
+
+	if w.OnChange == nil {
+		return
+	}
+	for _, change := range accountChanges(previous, account) {
+		w.OnChange(copyAccount(account), change)
+	}
+}
+
+func (w *AccountWatcher) report(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+	}
+}
+
+// accountChanges returns the differences between two views of an account.
+func accountChanges(previous, current hProtocol.Account) []AccountChange {
+	var changes []AccountChange
+
+	previousBalances := map[base.Asset]hProtocol.Balance{}
+	for _, balance := range previous.Balances {
+		previousBalances[balance.Asset] = balance
+	}
+	currentBalances := map[base.Asset]bool{}
+	for _, balance := range current.Balances {
+		currentBalances[balance.Asset] = true
+		old, ok := previousBalances[balance.Asset]
+		if !ok {
+			changes = append(changes, TrustlineAdded{Balance: balance})
+			continue
+		}
+		if !sameAmount(old.Balance, balance.Balance) {
+			changes = append(changes, BalanceChanged{
+				Asset:    balance.Asset,
+				Previous: old.Balance,
+				Current:  balance.Balance,
+			})
+		}
+		if !sameAmount(old.Limit, balance.Limit) ||
+			!sameFlag(old.IsAuthorized, balance.IsAuthorized) ||
+			!sameFlag(old.IsAuthorizedToMaintainLiabilities, balance.IsAuthorizedToMaintainLiabilities) {
+			changes = append(changes, TrustlineUpdated{Previous: old, Current: balance})
+		}
+	}
+	for _, balance := range previous.Balances {
+		if !currentBalances[balance.Asset] {
+			changes = append(changes, TrustlineRemoved{Balance: balance})
+		}
+	}
+
+	previousSigners := map[string]hProtocol.Signer{}
+	for _, signer := range previous.Signers {
+		previousSigners[signer.Key] = signer
+	}
+	currentSigners := map[string]bool{}
+	for _, signer := range current.Signers {
+		currentSigners[signer.Key] = true
+		old, ok := previousSigners[signer.Key]
+		switch {
+		case !ok:
+			changes = append(changes, SignerAdded{Signer: signer})
+		case old.Weight != signer.Weight:
+			changes = append(changes, SignerUpdated{Previous: old, Current: signer})
+		}
+	}
+	for _, signer := range previous.Signers {
+		if !currentSigners[signer.Key] {
+			changes = append(changes, SignerRemoved{Signer: signer})
+		}
+	}
+
+	if previous.Thresholds != current.Thresholds {
+		changes = append(changes, ThresholdsChanged{Previous: previous.Thresholds, Current: current.Thresholds})
+	}
+	if previous.Flags != current.Flags {
+		changes = append(changes, FlagsChanged{Previous: previous.Flags, Current: current.Flags})
+	}
+	if previous.Sequence != current.Sequence {
+		changes = append(changes, SequenceChanged{Previous: previous.Sequence, Current: current.Sequence})
+	}
+	if previous.HomeDomain != current.HomeDomain {
+		changes = append(changes, HomeDomainChanged{Previous: previous.HomeDomain, Current: current.HomeDomain})
+	}
+
+	for key, value := range current.Data {
+		if previous.Data[key] != value {
+			changes = append(changes, DataChanged{Key: key, Previous: previous.Data[key], Current: value})
+		}
+	}
+	for key, value := range previous.Data {
+		if _, ok := current.Data[key]; !ok {
+			changes = append(changes, DataChanged{Key: key, Previous: value})
+		}
+	}
+	return changes
+}
+
+func copyAccount(account hProtocol.Account) hProtocol.Account {
+	account.Balances = append([]hProtocol.Balance(nil), account.Balances...)
+	account.Signers = append([]hProtocol.Signer(nil), account.Signers...)
+	if account.Data != nil {
+		data := make(map[string]string, len(account.Data))
+		for key, value := range account.Data {
+			data[key] = value
+		}
+		account.Data = data
+	}
+	return account
+}
+
+func balanceIndex(account hProtocol.Account, asset base.Asset) int {
+	for i, balance := range account.Balances {
+		if balance.Asset == asset {
+			return i
+		}
+	}
+	return -1
+}
+
+// addToBalance adds sign * value to the balance of asset.
+func addToBalance(account *hProtocol.Account, asset base.Asset, value string, sign int64) {
+	i := balanceIndex(*account, asset)
+	if i < 0 {
+		return
+	}
+	balance, err := amount.ParseInt64(account.Balances[i].Balance)
+	if err != nil {
+		return
+	}
+	delta, err := amount.ParseInt64(value)
+	if err != nil {
+		return
+	}
+	account.Balances[i].Balance = amount.StringFromInt64(balance + sign*delta)
+}
+
+func effectSigner(key, publicKey string, weight int32) hProtocol.Signer {
+	if key == "" {
+		key = publicKey
+	}
+	keyType, err := hProtocol.KeyTypeFromAddress(key)
+	if err != nil {
+		keyType = ""
+	}
+	return hProtocol.Signer{Key: key, Weight: weight, Type: keyType}
+}
+
+// effectLedger returns the ledger of an effect, from its paging token.
+func effectLedger(pagingToken string) int64 {
+	operationID, err := strconv.ParseInt(strings.SplitN(pagingToken, "-", 2)[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return operationID >> 32
+}
+
+func sameAmount(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, errA := amount.ParseInt64(a)
+	y, errB := amount.ParseInt64(b)
+	return errA == nil && errB == nil && x == y
+}
+
+func sameFlag(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
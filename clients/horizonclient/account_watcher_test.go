@@ -0,0 +1,169 @@
+package horizonclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/support/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const watchedAccount = "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+
+var (
+	nativeAsset = base.Asset{Type: "native"}
+	usdAsset    = base.Asset{Type: "credit_alphanum4", Code: "USD", Issuer: "GDUKMGUGDZQK6YHYA5Z6AY2G4XDSZPSZ3SW5UN3ARVMO6QSRDWP5YLEX"}
+)
+
+func watchedAccountDetail(sequence string) hProtocol.Account {
+	return hProtocol.Account{
+		AccountID:          watchedAccount,
+		Sequence:           sequence,
+		LastModifiedLedger: 10,
+		Balances: []hProtocol.Balance{
+			{Balance: "100.0000000", LastModifiedLedger: 10, Asset: nativeAsset},
+		},
+		Signers: []hProtocol.Signer{
+			{Key: watchedAccount, Weight: 1, Type: "ed25519_public_key"},
+		},
+	}
+}
+
+// effectBase returns the base of an effect in the given ledger.
+func effectBase(ledger int64, kind string) effects.Base {
+	return effects.Base{
+		PT:      effectPagingToken(ledger),
+		Account: watchedAccount,
+		Type:    kind,
+	}
+}
+
+func effectPagingToken(ledger int64) string {
+	return (&AccountWatcher{snapshotLedger: ledger - 1}).startCursor()
+}
+
+// watch runs the watcher until it reported count changes.
+func watch(t *testing.T, watcher *AccountWatcher, count int) []AccountChange {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var changes []AccountChange
+	watcher.OnChange = func(account hProtocol.Account, change AccountChange) {
+		changes = append(changes, change)
+		if len(changes) == count {
+			cancel()
+		}
+	}
+	require.NoError(t, watcher.Run(ctx))
+	require.Len(t, changes, count)
+	return changes
+}
+
+func TestAccountWatcherAppliesEffects(t *testing.T) {
+	client := &MockClient{}
+	client.On("AccountDetail", AccountRequest{AccountID: watchedAccount}).
+		Return(watchedAccountDetail("100"), nil)
+	request := EffectRequest{ForAccount: watchedAccount, Cursor: effectPagingToken(11)}
+	client.On("StreamEffects", mock.Anything, request, mock.Anything).Run(func(args mock.Arguments) {
+		handler := args.Get(2).(EffectHandler)
+		// already included in the account snapshot
+		handler(effects.AccountDebited{Base: effectBase(10, "account_debited"), Asset: nativeAsset, Amount: "50.0000000"})
+		handler(effects.AccountCredited{Base: effectBase(11, "account_credited"), Asset: nativeAsset, Amount: "10.5000000"})
+		handler(effects.TrustlineCreated{Base: effectBase(12, "trustline_created"), Asset: usdAsset, Limit: "1000.0000000"})
+		handler(effects.SignerCreated{Base: effectBase(12, "signer_created"), Key: usdAsset.Issuer, Weight: 2})
+		handler(effects.Trade{
+			Base:              effectBase(13, "trade"),
+			SoldAmount:        "0.5000000",
+			SoldAssetType:     "native",
+			BoughtAmount:      "2.0000000",
+			BoughtAssetType:   usdAsset.Type,
+			BoughtAssetCode:   usdAsset.Code,
+			BoughtAssetIssuer: usdAsset.Issuer,
+		})
+		handler(effects.SignerRemoved{Base: effectBase(14, "signer_removed"), Key: watchedAccount})
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+
+	watcher := &AccountWatcher{Client: client, AccountID: watchedAccount, ReconcileInterval: time.Hour}
+	changes := watch(t, watcher, 6)
+
+	assert.Equal(t, BalanceChanged{Asset: nativeAsset, Previous: "100.0000000", Current: "110.5000000"}, changes[0])
+	assert.Equal(t, TrustlineAdded{Balance: hProtocol.Balance{Balance: "0.0000000", Limit: "1000.0000000", Asset: usdAsset}}, changes[1])
+	assert.Equal(t, SignerAdded{Signer: hProtocol.Signer{Key: usdAsset.Issuer, Weight: 2, Type: "ed25519_public_key"}}, changes[2])
+	assert.Equal(t, BalanceChanged{Asset: nativeAsset, Previous: "110.5000000", Current: "110.0000000"}, changes[3])
+	assert.Equal(t, BalanceChanged{Asset: usdAsset, Previous: "0.0000000", Current: "2.0000000"}, changes[4])
+	assert.Equal(t, SignerRemoved{Signer: hProtocol.Signer{Key: watchedAccount, Weight: 1, Type: "ed25519_public_key"}}, changes[5])
+
+	account, ok := watcher.Account()
+	require.True(t, ok)
+	require.Len(t, account.Balances, 2)
+	assert.Equal(t, "110.0000000", account.Balances[0].Balance)
+	assert.Equal(t, "2.0000000", account.Balances[1].Balance)
+	require.Len(t, account.Signers, 1)
+	assert.Equal(t, usdAsset.Issuer, account.Signers[0].Key)
+}
+
+func TestAccountWatcherReconciles(t *testing.T) {
+	client := &MockClient{}
+	client.On("AccountDetail", AccountRequest{AccountID: watchedAccount}).
+		Return(watchedAccountDetail("100"), nil).Once()
+	reconciled := watchedAccountDetail("101")
+	reconciled.Balances[0].Balance = "99.9999900"
+	client.On("AccountDetail", AccountRequest{AccountID: watchedAccount}).Return(reconciled, nil)
+	client.On("StreamEffects", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+
+	watcher := &AccountWatcher{Client: client, AccountID: watchedAccount, ReconcileInterval: time.Millisecond}
+	changes := watch(t, watcher, 2)
+	assert.Equal(t, BalanceChanged{Asset: nativeAsset, Previous: "100.0000000", Current: "99.9999900"}, changes[0])
+	assert.Equal(t, SequenceChanged{Previous: "100", Current: "101"}, changes[1])
+}
+
+func TestAccountWatcherRestartsStream(t *testing.T) {
+	client := &MockClient{}
+	client.On("AccountDetail", AccountRequest{AccountID: watchedAccount}).
+		Return(watchedAccountDetail("100"), nil)
+	client.On("StreamEffects", mock.Anything, EffectRequest{ForAccount: watchedAccount, Cursor: effectPagingToken(11)}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(EffectHandler)
+			handler(effects.AccountCredited{Base: effectBase(11, "account_credited"), Asset: nativeAsset, Amount: "1.0000000"})
+		}).Return(errors.New("connection reset")).Once()
+	// the stream resumes after the last applied effect
+	client.On("StreamEffects", mock.Anything, EffectRequest{ForAccount: watchedAccount, Cursor: effectPagingToken(11)}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(EffectHandler)
+			handler(effects.AccountCredited{Base: effectBase(12, "account_credited"), Asset: nativeAsset, Amount: "2.0000000"})
+			<-args.Get(0).(context.Context).Done()
+		}).Return(nil)
+
+	var errs []error
+	watcher := &AccountWatcher{
+		Client:            client,
+		AccountID:         watchedAccount,
+		ReconcileInterval: time.Hour,
+		OnError:           func(err error) { errs = append(errs, err) },
+	}
+	changes := watch(t, watcher, 2)
+	assert.Equal(t, BalanceChanged{Asset: nativeAsset, Previous: "101.0000000", Current: "103.0000000"}, changes[1])
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "effects stream stopped: connection reset")
+}
+
+func TestAccountWatcherLoadError(t *testing.T) {
+	client := &MockClient{}
+	client.On("AccountDetail", AccountRequest{AccountID: watchedAccount}).
+		Return(hProtocol.Account{}, errors.New("account not found"))
+
+	watcher := &AccountWatcher{Client: client, AccountID: watchedAccount}
+	err := watcher.Run(context.Background())
+	assert.EqualError(t, err, "could not load account: account not found")
+	_, ok := watcher.Account()
+	assert.False(t, ok)
+}
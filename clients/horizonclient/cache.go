@@ -0,0 +1,295 @@
+package horizonclient
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/errors"
+)
+
+// Cache stores the bodies of Horizon responses, keyed by request URL. It must
+// be safe for concurrent use. Implementations can keep the responses in
+// memory, like LRUCache, on disk or in a shared store like Redis.
+type Cache interface {
+	// Get returns the body cached for key, and false if there is none or if
+	// it expired.
+	Get(key string) ([]byte, bool)
+	// Set caches body for key. The entry expires after ttl, or never if ttl
+	// is zero.
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// SetCache sets the cache of the responses to GET requests. Responses are
+// cached forever if they can never change: ledgers, transactions and
+// operations, and the pages of their history, and only pages whose cursor
+// range is closed for the history of accounts and of the network. Other
+// responses are cached for the duration of the max-age directive of their
+// Cache-Control header. Responses are not cached if cache is nil.
+func (c *Client) SetCache(cache Cache) *Client {
+	c.cache = cache
+	return c
+}
+
+// cachedResponse decodes the response cached for requestURL into a, and returns
+// false if there is none.
+func (c *Client) cachedResponse(requestURL string, a interface{}) bool {
+	if c.cache == nil {
+		return false
+	}
+	body, ok := c.cache.Get(requestURL)
+	if !ok {
+		return false
+	}
+	// a response which cannot be decoded is fetched again
+	return json.Unmarshal(body, a) == nil
+}
+
+// cacheResponse caches the body of a successful response if it can be cached.
+// The body is read and replaced by a copy, so the response can still be
+// decoded.
+func (c *Client) cacheResponse(requestURL string, resp *http.Response) error {
+	if c.cache == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return errors.Wrap(err, "error reading response")
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return nil
+	}
+	if ttl, ok := responseTTL(u, resp.Header, body); ok {
+		c.cache.Set(requestURL, body, ttl)
+	}
+	return nil
+}
+
+// responseTTL returns how long a response can be cached, zero if it can be
+// cached forever, and false if it must not be cached.
+func responseTTL(u *url.URL, header http.Header, body []byte) (time.Duration, bool) {
+	maxAge, cacheable := cacheControlMaxAge(header.Get("Cache-Control"))
+	if !cacheable {
+		return 0, false
+	}
+	if isImmutableResponse(u, body) {
+		return 0, true
+	}
+	if maxAge > 0 {
+		return maxAge, true
+	}
+	return 0, false
+}
+
+// cacheControlMaxAge returns the max-age directive of a Cache-Control header,
+// and false if the header forbids caching.
+func cacheControlMaxAge(header string) (time.Duration, bool) {
+	var maxAge time.Duration
+	for _, directive := range strings.Split(header, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store" || directive == "no-cache":
+			return 0, false
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && seconds > 0 {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	return maxAge, true
+}
+
+// immutableResources are the resources which never change once they are in
+// the history of Horizon.
+var immutableResources = map[string]bool{
+	"ledgers":      true,
+	"transactions": true,
+	"operations":   true,
+}
+
+// isResourceID returns true if id is the ID of one of the immutableResources:
+// a number for ledgers and operations, or a transaction hash. It tells an
+// immutable resource apart from another endpoint of the same collection, like
+// /operations/search.
+func isResourceID(resource, id string) bool {
+	switch resource {
+	case "ledgers", "operations":
+		_, err := strconv.ParseUint(id, 10, 64)
+		return err == nil
+	case "transactions":
+		if len(id) != 64 {
+			return false
+		}
+		_, err := hex.DecodeString(id)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// historyCollections are the collections of records which are only ever
+// appended to.
+var historyCollections = map[string]bool{
+	"ledgers":      true,
+	"transactions": true,
+	"operations":   true,
+	"payments":     true,
+	"effects":      true,
+	"trades":       true,
+}
+
+// isImmutableResponse returns true if the response to a request for u can
+// never change.
+func isImmutableResponse(u *url.URL, body []byte) bool {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	n := len(segments)
+
+	// a ledger, a transaction or an operation
+	if n >= 2 && immutableResources[segments[n-2]] && isResourceID(segments[n-2], segments[n-1]) {
+		return true
+	}
+	if n == 0 || !historyCollections[segments[n-1]] {
+		return false
+	}
+	records, ok := pageRecords(body)
+	if !ok {
+		return false
+	}
+
+	// the history of a ledger, a transaction or an operation, which is empty
+	// until the ledger is closed
+	if n >= 3 && immutableResources[segments[n-3]] && isResourceID(segments[n-3], segments[n-2]) {
+		return records > 0
+	}
+
+	// a page of a history collection is immutable if no record can be added
+	// to it: either records are in descending order, older than the cursor,
+	// or the page is full
+	query := u.Query()
+	cursor := query.Get("cursor")
+	if cursor == "" || cursor == "now" {
+		return false
+	}
+	if query.Get("order") == string(OrderDesc) {
+		return true
+	}
+	limit := 10
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil {
+			return false
+		}
+	}
+	return records >= limit
+}
+
+// pageRecords returns the number of records in a page, and false if body is
+// not a page.
+func pageRecords(body []byte) (int, bool) {
+	var page struct {
+		Embedded *struct {
+			Records []json.RawMessage `json:"records"`
+		} `json:"_embedded"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil || page.Embedded == nil {
+		return 0, false
+	}
+	return len(page.Embedded.Records), true
+}
+
+// LRUCache is a Cache keeping a bounded number of responses in memory. The
+// least recently used response is evicted when the cache is full.
+type LRUCache struct {
+	mutex   sync.Mutex
+	size    int
+	entries *list.List
+	index   map[string]*list.Element
+	clock   *clock.Clock
+}
+
+type lruEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+// NewLRUCache returns an LRUCache holding at most size responses.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		entries: list.New(),
+		index:   map[string]*list.Element{},
+	}
+}
+
+// Get returns the body cached for key.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	element, ok := l.index[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && !l.clock.Now().Before(entry.expires) {
+		l.remove(element)
+		return nil, false
+	}
+	l.entries.MoveToFront(element)
+	return entry.body, true
+}
+
+// Set caches body for key, evicting the least recently used response if the
+// cache is full.
+func (l *LRUCache) Set(key string, body []byte, ttl time.Duration) {
+	if l.size <= 0 {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = l.clock.Now().Add(ttl)
+	}
+	if element, ok := l.index[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.body = body
+		entry.expires = expires
+		l.entries.MoveToFront(element)
+		return
+	}
+
+	l.index[key] = l.entries.PushFront(&lruEntry{key: key, body: body, expires: expires})
+	for l.entries.Len() > l.size {
+		l.remove(l.entries.Back())
+	}
+}
+
+// Len returns the number of cached responses, including expired ones which
+// were not evicted yet.
+func (l *LRUCache) Len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.entries.Len()
+}
+
+func (l *LRUCache) remove(element *list.Element) {
+	l.entries.Remove(element)
+	delete(l.index, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,192 @@
+package horizonclient
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/clock/clocktest"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheTestClient() (*Client, *httptest.Client, *LRUCache) {
+	hmock := httptest.NewClient()
+	cache := NewLRUCache(10)
+	client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+	client.SetCache(cache)
+	return client, hmock, cache
+}
+
+func TestCacheImmutableResponses(t *testing.T) {
+	client, hmock, cache := newCacheTestClient()
+
+	calls := 0
+	hmock.On("GET", "https://localhost/ledgers/69859").
+		Return(responses(&calls, stringResponder(200, ledgerResponse)))
+	for i := 0; i < 3; i++ {
+		ledger, err := client.LedgerDetail(69859)
+		require.NoError(t, err)
+		assert.Equal(t, int32(69859), ledger.Sequence)
+	}
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, cache.Len())
+
+	// errors are not cached
+	calls = 0
+	hmock.On("GET", "https://localhost/ledgers/1").
+		Return(responses(&calls, stringResponder(404, notFoundResponse)))
+	for i := 0; i < 2; i++ {
+		_, err := client.LedgerDetail(1)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 2, calls)
+
+	// responses are not cached without a cache
+	client.SetCache(nil)
+	calls = 0
+	hmock.On("GET", "https://localhost/ledgers/69859").
+		Return(responses(&calls, stringResponder(200, ledgerResponse)))
+	_, err := client.LedgerDetail(69859)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCacheOperationSearch(t *testing.T) {
+	client, hmock, cache := newCacheTestClient()
+
+	// a search is not an operation, new operations can match it
+	calls := 0
+	hmock.On("GET", "https://localhost/operations/search?limit=2&type=create_account").
+		Return(responses(&calls, stringResponder(200, firstOperationsPage)))
+	for i := 0; i < 2; i++ {
+		_, err := client.SearchOperations(OperationSearchRequest{
+			OperationTypes: []string{"create_account"},
+			Limit:          2,
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCacheMutableResponses(t *testing.T) {
+	client, hmock, cache := newCacheTestClient()
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	cache.clock = &clock.Clock{Source: clocktest.FixedSource(now)}
+	request := AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
+	accountURL := "https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+
+	// mutable responses are not cached without Cache-Control
+	calls := 0
+	hmock.On("GET", accountURL).Return(responses(&calls, stringResponder(200, accountResponse)))
+	for i := 0; i < 2; i++ {
+		_, err := client.AccountDetail(request)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+
+	// or if Cache-Control forbids it
+	calls = 0
+	hmock.On("GET", accountURL).Return(responses(&calls,
+		responseWithHeader(200, accountResponse, http.Header{"Cache-Control": []string{"max-age=5, no-store"}}),
+	))
+	for i := 0; i < 2; i++ {
+		_, err := client.AccountDetail(request)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+
+	// they are cached until max-age
+	calls = 0
+	hmock.On("GET", accountURL).Return(responses(&calls,
+		responseWithHeader(200, accountResponse, http.Header{"Cache-Control": []string{"public, max-age=5"}}),
+	))
+	for i := 0; i < 2; i++ {
+		account, err := client.AccountDetail(request)
+		require.NoError(t, err)
+		assert.Equal(t, request.AccountID, account.AccountID)
+	}
+	assert.Equal(t, 1, calls)
+
+	cache.clock = &clock.Clock{Source: clocktest.FixedSource(now.Add(5 * time.Second))}
+	_, err := client.AccountDetail(request)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIsImmutableResponse(t *testing.T) {
+	page := func(records int) []byte {
+		body := `{"_embedded": {"records": [`
+		for i := 0; i < records; i++ {
+			if i > 0 {
+				body += ","
+			}
+			body += `{}`
+		}
+		return []byte(body + `]}}`)
+	}
+	record := []byte(`{"id": "1"}`)
+
+	for _, testCase := range []struct {
+		url       string
+		body      []byte
+		immutable bool
+	}{
+		{"https://localhost/ledgers/5", record, true},
+		{"https://localhost/transactions/bcc7a97264dca0a51a63f7ea971b5e7458e334489673078bb2a34eb0cce910ca", record, true},
+		{"https://localhost/operations/12884905985", record, true},
+		{"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", record, false},
+		{"https://localhost/operations/search?account_id=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", page(2), false},
+		{"https://localhost/operations/search?account_id=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU&cursor=12&order=desc", page(2), false},
+		{"https://localhost/transactions/bcc7a97264dca0a51a63f7ea971b5e74", record, false},
+		{"https://localhost/ledgers/5/transactions", page(2), true},
+		{"https://localhost/ledgers/5/operations?limit=200", page(0), false},
+		{"https://localhost/transactions/bcc7a97264dca0a51a63f7ea971b5e7458e334489673078bb2a34eb0cce910ca/effects", page(1), true},
+		{"https://localhost/transactions?limit=2", page(2), false},
+		{"https://localhost/transactions?cursor=now&limit=2", page(2), false},
+		{"https://localhost/transactions?cursor=12884905985&limit=2", page(2), true},
+		{"https://localhost/transactions?cursor=12884905985&limit=2", page(1), false},
+		{"https://localhost/transactions?cursor=12884905985", page(10), true},
+		{"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/payments?cursor=12884905985&order=desc", page(1), true},
+		{"https://localhost/offers?cursor=12&order=desc", page(1), false},
+		{"https://localhost/trades?cursor=12&order=desc", []byte(`not json`), false},
+	} {
+		u, err := url.Parse(testCase.url)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.immutable, isImmutableResponse(u, testCase.body), testCase.url)
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewLRUCache(2)
+	cache.clock = &clock.Clock{Source: clocktest.FixedSource(now)}
+
+	cache.Set("a", []byte("1"), 0)
+	cache.Set("b", []byte("2"), time.Minute)
+	body, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), body)
+
+	// b is the least recently used entry
+	cache.Set("c", []byte("3"), 0)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Set("c", []byte("4"), time.Minute)
+	body, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("4"), body)
+
+	cache.clock = &clock.Clock{Source: clocktest.FixedSource(now.Add(time.Minute))}
+	_, ok = cache.Get("c")
+	assert.False(t, ok)
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, cache.Len())
+}
@@ -0,0 +1,38 @@
+package horizonclient
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// BuildURL creates the endpoint to be queried based on the data in the ClaimableBalanceRequest struct.
+func (cbr ClaimableBalanceRequest) BuildURL() (endpoint string, err error) {
+	if len(cbr.ID) > 0 {
+		endpoint = fmt.Sprintf("claimable_balances/%s", cbr.ID)
+	} else {
+		nParams := countParams(cbr.Asset, cbr.Sponsor, cbr.Claimant)
+		if nParams == 0 {
+			return endpoint, errors.New("invalid request: no filter parameters")
+		}
+
+		endpoint = "claimable_balances"
+		queryParams := addQueryParams(
+			map[string]string{
+				"asset":    cbr.Asset,
+				"sponsor":  cbr.Sponsor,
+				"claimant": cbr.Claimant,
+			},
+			cursor(cbr.Cursor), limit(cbr.Limit), cbr.Order,
+		)
+		endpoint = fmt.Sprintf("%s?%s", endpoint, queryParams)
+	}
+
+	_, err = url.Parse(endpoint)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse endpoint")
+	}
+
+	return endpoint, err
+}
@@ -0,0 +1,121 @@
+package horizonclient
+
+import (
+	"testing"
+
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimableBalanceRequestBuildUrl(t *testing.T) {
+	cbr := ClaimableBalanceRequest{}
+	_, err := cbr.BuildURL()
+
+	// error case: no filter parameters
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid request: no filter parameters")
+	}
+
+	cbr = ClaimableBalanceRequest{ID: "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be"}
+	endpoint, err := cbr.BuildURL()
+
+	// It should return valid claimable balance endpoint and no errors
+	require.NoError(t, err)
+	assert.Equal(t, "claimable_balances/00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be", endpoint)
+
+	cbr = ClaimableBalanceRequest{
+		Asset:    "USD:GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB",
+		Claimant: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+		Limit:    10,
+		Order:    OrderDesc,
+	}
+	endpoint, err = cbr.BuildURL()
+
+	// It should return valid claimable balances endpoint with query params and no errors
+	require.NoError(t, err)
+	assert.Equal(t, "claimable_balances?asset=USD%3AGAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"+
+		"&claimant=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU&limit=10&order=desc", endpoint)
+}
+
+func TestClaimableBalances(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/claimable_balances?sponsor=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	).ReturnString(200, claimableBalancesPage)
+
+	cbs, err := client.ClaimableBalances(ClaimableBalanceRequest{
+		Sponsor: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	})
+	if assert.NoError(t, err) {
+		require.Len(t, cbs.Embedded.Records, 1)
+		cb := cbs.Embedded.Records[0]
+		assert.Equal(t, "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be", cb.BalanceID)
+		assert.Equal(t, "native", cb.Asset)
+		assert.Equal(t, "10.0000000", cb.Amount)
+		assert.Equal(t, "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", cb.Sponsor)
+		require.Len(t, cb.Claimants, 1)
+		assert.Equal(t, "GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML", cb.Claimants[0].Destination)
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/claimable_balances/00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+	).ReturnString(404, notFoundResponse)
+
+	_, err = client.ClaimableBalance("00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be")
+	if assert.Error(t, err) {
+		horizonError, ok := err.(*Error)
+		assert.True(t, ok)
+		assert.Equal(t, "Resource Missing", horizonError.Problem.Title)
+	}
+
+	_, err = client.ClaimableBalances(ClaimableBalanceRequest{ID: "00000000"})
+	assert.EqualError(t, err, "use ClaimableBalance to request a single claimable balance")
+}
+
+var claimableBalancesPage = `{
+  "_links": {
+    "self": {
+      "href": "https://localhost/claimable_balances?cursor=&limit=10&order=asc&sponsor=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+    },
+    "next": {
+      "href": "https://localhost/claimable_balances?cursor=00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be&limit=10&order=asc&sponsor=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+    },
+    "prev": {
+      "href": "https://localhost/claimable_balances?cursor=00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be&limit=10&order=desc&sponsor=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+    }
+  },
+  "_embedded": {
+    "records": [
+      {
+        "_links": {
+          "self": {
+            "href": "https://localhost/claimable_balances/00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be"
+          }
+        },
+        "id": "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+        "asset": "native",
+        "amount": "10.0000000",
+        "sponsor": "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+        "last_modified_ledger": 116,
+        "last_modified_time": "2020-09-28T17:56:04Z",
+        "claimants": [
+          {
+            "destination": "GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
+            "predicate": {
+              "unconditional": true
+            }
+          }
+        ],
+        "paging_token": "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be"
+      }
+    ]
+  }
+}`
@@ -64,8 +64,11 @@ func (c *Client) checkMemoRequired(transaction *txnbuild.Transaction) error {
 			continue
 		}
 
-		// TODO: once we support M-strkeys (SEP23), also check whether the destination
-		//       is a muxed account with a memo ID.
+		// A muxed account destination (SEP-23) identifies the recipient with
+		// its id, so it does not need a memo.
+		if strings.HasPrefix(destination, "M") {
+			continue
+		}
 
 		if destinations[destination] {
 			continue
@@ -101,10 +104,38 @@ func (c *Client) checkMemoRequired(transaction *txnbuild.Transaction) error {
 
 // sendRequestURL sends a url to a horizon server.
 // It can be used for requests that do not implement the HorizonRequest interface.
+// GET requests which fail with a transient error are retried according to the
+// retry policy of the client.
 func (c *Client) sendRequestURL(requestURL string, method string, a interface{}) (err error) {
+	return c.sendRequestURLContext(context.Background(), requestURL, method, a)
+}
+
+// sendRequestURLContext is like sendRequestURL, and gives up when ctx is done.
+func (c *Client) sendRequestURLContext(ctx context.Context, requestURL string, method string, a interface{}) (err error) {
+	post := method == "post" || method == "POST"
+	if !post && c.cachedResponse(requestURL, a) {
+		return nil
+	}
+	for attempt := 1; ; attempt++ {
+		var transportErr bool
+		transportErr, err = c.sendRequestURLOnce(ctx, requestURL, post, a)
+		if err == nil || post || ctx.Err() != nil {
+			return
+		}
+		delay, retry := c.retryDelay(attempt, transportErr, err)
+		if !retry {
+			return
+		}
+		c.wait(delay)
+	}
+}
+
+// sendRequestURLOnce sends a url to a horizon server. transportErr is true if
+// the request failed without getting a response.
+func (c *Client) sendRequestURLOnce(ctx context.Context, requestURL string, post bool, a interface{}) (transportErr bool, err error) {
 	var req *http.Request
 
-	if method == "post" || method == "POST" {
+	if post {
 		req, err = http.NewRequest("POST", requestURL, nil)
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
 	} else {
@@ -112,23 +143,28 @@ func (c *Client) sendRequestURL(requestURL string, method string, a interface{})
 	}
 
 	if err != nil {
-		return errors.Wrap(err, "error creating HTTP request")
+		return false, errors.Wrap(err, "error creating HTTP request")
 	}
 	c.setClientAppHeaders(req)
-	c.setDefaultClient()
 	if c.horizonTimeout == 0 {
 		c.horizonTimeout = HorizonTimeout
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*c.horizonTimeout)
-	resp, err := c.HTTP.Do(req.WithContext(ctx))
+	ctx, cancel := context.WithTimeout(ctx, time.Second*c.horizonTimeout)
+	_, resp, err := c.doRequest(req.WithContext(ctx), false, false)
 	if err != nil {
 		cancel()
-		return
+		return true, err
 	}
 
+	if !post {
+		if err = c.cacheResponse(requestURL, resp); err != nil {
+			cancel()
+			return true, err
+		}
+	}
 	err = decodeResponse(resp, &a, c)
 	cancel()
-	return
+	return false, err
 }
 
 // stream handles connections to endpoints that support streaming on a horizon server
@@ -136,6 +172,17 @@ func (c *Client) stream(
 	ctx context.Context,
 	streamURL string,
 	handler func(data []byte) error,
+) error {
+	return c.streamConnections(ctx, streamURL, false, handler)
+}
+
+// streamConnections is like stream. reconnect is true if the first connection
+// reconnects to a stream, which is reported to the hooks of the client.
+func (c *Client) streamConnections(
+	ctx context.Context,
+	streamURL string,
+	reconnect bool,
+	handler func(data []byte) error,
 ) error {
 	su, err := url.Parse(streamURL)
 	if err != nil {
@@ -155,18 +202,22 @@ func (c *Client) stream(
 			return errors.Wrap(err, "error creating HTTP request")
 		}
 		req.Header.Set("Accept", "text/event-stream")
-		c.setDefaultClient()
 		c.setClientAppHeaders(req)
 
 		// We can use c.HTTP here because we set Timeout per request not on the client. See sendRequest()
-		resp, err := c.HTTP.Do(req)
+		req, resp, err := c.doRequest(req.WithContext(ctx), true, reconnect)
+		reconnect = true
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
 			return errors.Wrap(err, "error sending HTTP request")
 		}
 
 		// Expected statusCode are 200-299
 		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf("got bad HTTP status code %d", resp.StatusCode)
+			resp.Body.Close()
+			return &streamStatusError{StatusCode: resp.StatusCode}
 		}
 		defer resp.Body.Close()
 
@@ -211,6 +262,9 @@ func (c *Client) stream(
 							break Events
 						}
 					} else {
+						if ctx.Err() != nil {
+							return nil
+						}
 						return errors.Wrap(err, "error reading line")
 					}
 				}
@@ -239,6 +293,7 @@ func (c *Client) stream(
 				if event.Id != "" {
 					query.Set("cursor", event.Id)
 				}
+				c.streamEvent(req, event.Id)
 
 				switch data := event.Data.(type) {
 				case string:
@@ -258,6 +313,16 @@ func (c *Client) stream(
 	}
 }
 
+// streamStatusError is returned by stream when horizon responds with an
+// unexpected status code.
+type streamStatusError struct {
+	StatusCode int
+}
+
+func (e *streamStatusError) Error() string {
+	return fmt.Sprintf("got bad HTTP status code %d", e.StatusCode)
+}
+
 func (c *Client) setClientAppHeaders(req *http.Request) {
 	req.Header.Set("X-Client-Name", "go-stellar-sdk")
 	req.Header.Set("X-Client-Version", c.Version())
@@ -372,6 +437,30 @@ func (c *Client) FeeStats() (feestats hProtocol.FeeStats, err error) {
 	return
 }
 
+// ClaimableBalances returns the claimable balances matching the filters of
+// the request.
+func (c *Client) ClaimableBalances(request ClaimableBalanceRequest) (cbs hProtocol.ClaimableBalancesPage, err error) {
+	if request.ID != "" {
+		err = errors.New("use ClaimableBalance to request a single claimable balance")
+		return
+	}
+
+	err = c.sendRequest(request, &cbs)
+	return
+}
+
+// ClaimableBalance returns details about a claimable balance, id is the hex
+// encoded XDR of the balance id.
+func (c *Client) ClaimableBalance(id string) (cb hProtocol.ClaimableBalance, err error) {
+	if len(id) == 0 {
+		err = errors.New("no claimable balance ID provided")
+		return
+	}
+
+	err = c.sendRequest(ClaimableBalanceRequest{ID: id}, &cb)
+	return
+}
+
 // Offers returns information about offers made on the SDEX.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/offers-for-account.html
 func (c *Client) Offers(request OfferRequest) (offers hProtocol.OffersPage, err error) {
@@ -440,6 +529,9 @@ func (c *Client) OperationDetail(id string) (ops operations.Operation, err error
 func (c *Client) SubmitTransactionXDR(transactionXdr string) (tx hProtocol.Transaction,
 	err error) {
 	request := submitRequest{endpoint: "transactions", transactionXdr: transactionXdr}
+	if c.retryPolicy != nil && c.retryPolicy.RetrySubmissions {
+		return c.submitWithRetries(request)
+	}
 	err = c.sendRequest(request, &tx)
 	return
 }
@@ -567,6 +659,13 @@ func (c *Client) Payments(request OperationRequest) (ops operations.OperationsPa
 	return
 }
 
+// SearchOperations returns the stellar operations matching the filters of the
+// request, e.g. all payments of an asset above an amount with a given memo.
+func (c *Client) SearchOperations(request OperationSearchRequest) (ops operations.OperationsPage, err error) {
+	err = c.sendRequest(request, &ops)
+	return
+}
+
 // Trades returns stellar trades (https://www.stellar.org/developers/horizon/reference/resources/trade.html)
 // It can be used to return trades for an account, an offer and all trades on the network.
 func (c *Client) Trades(request TradeRequest) (tds hProtocol.TradesPage, err error) {
@@ -811,3 +910,6 @@ func (c *Client) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsPage)
 
 // ensure that the horizon client implements ClientInterface
 var _ ClientInterface = &Client{}
+
+// ensure that the horizon client can be used as a fee stats source by txnbuild.FeeStatsStrategy
+var _ txnbuild.FeeStatsSource = &Client{}
@@ -0,0 +1,78 @@
+package horizonclient
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// CursorStore persists the cursor of a stream, the paging token of the last
+// event it handled, so that a restarted process resumes the stream where it
+// stopped.
+type CursorStore interface {
+	// Load returns the saved cursor, or an empty string if there is none.
+	Load() (string, error)
+	// Save saves the cursor.
+	Save(cursor string) error
+}
+
+// MemoryCursorStore is a CursorStore keeping the cursor in memory. It is safe
+// for concurrent use.
+type MemoryCursorStore struct {
+	mutex  sync.Mutex
+	cursor string
+}
+
+// NewMemoryCursorStore returns a MemoryCursorStore holding cursor.
+func NewMemoryCursorStore(cursor string) *MemoryCursorStore {
+	return &MemoryCursorStore{cursor: cursor}
+}
+
+// Load returns the cursor held by the store.
+func (s *MemoryCursorStore) Load() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cursor, nil
+}
+
+// Save replaces the cursor held by the store.
+func (s *MemoryCursorStore) Save(cursor string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cursor = cursor
+	return nil
+}
+
+// FileCursorStore is a CursorStore saving the cursor in a file. The file is
+// replaced atomically, so it always holds a complete cursor.
+type FileCursorStore struct {
+	Path string
+}
+
+// Load reads the cursor from the file, returning an empty string if the file
+// does not exist.
+func (s FileCursorStore) Load() (string, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "could not read cursor file")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Save writes the cursor to the file.
+func (s FileCursorStore) Save(cursor string) error {
+	tmp := s.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(cursor+"\n"), 0644); err != nil {
+		return errors.Wrap(err, "could not write cursor file")
+	}
+	if err := os.Rename(tmp, s.Path); err != nil {
+		return errors.Wrap(err, "could not replace cursor file")
+	}
+	return nil
+}
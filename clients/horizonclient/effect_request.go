@@ -64,19 +64,28 @@ func (er EffectRequest) StreamEffects(ctx context.Context, client *Client, handl
 
 	url := fmt.Sprintf("%s%s", client.fixHorizonURL(), endpoint)
 	return client.stream(ctx, url, func(data []byte) error {
-		var baseEffect effects.Base
-		// unmarshal into the base effect type
-		if err = json.Unmarshal(data, &baseEffect); err != nil {
-			return errors.Wrap(err, "error unmarshaling data for effects request")
-		}
-
-		// unmarshal into the concrete effect type
-		effs, err := effects.UnmarshalEffect(baseEffect.GetType(), data)
+		effs, err := decodeEffect(data)
 		if err != nil {
-			return errors.Wrap(err, "unmarshaling to the correct effect type")
+			return err
 		}
 
 		handler(effs)
 		return nil
 	})
 }
+
+// decodeEffect decodes a streamed effect into its concrete type.
+func decodeEffect(data []byte) (effects.Effect, error) {
+	var baseEffect effects.Base
+	// unmarshal into the base effect type
+	if err := json.Unmarshal(data, &baseEffect); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling data for effects request")
+	}
+
+	// unmarshal into the concrete effect type
+	effs, err := effects.UnmarshalEffect(baseEffect.GetType(), data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling to the correct effect type")
+	}
+	return effs, nil
+}
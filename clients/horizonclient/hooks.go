@@ -0,0 +1,141 @@
+package horizonclient
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Hooks observes the requests a Client sends to horizon, for instance to
+// record metrics or to trace requests. Its methods are called concurrently
+// when the client is used by several goroutines.
+type Hooks interface {
+	// BeforeRequest is called before every HTTP request to horizon, stream
+	// connections included. It returns the request to send, which may carry
+	// additional headers or a different context, e.g. with a tracing span.
+	BeforeRequest(req *http.Request) *http.Request
+	// AfterRequest is called when the response headers of a request are
+	// received, or when the request fails without a response.
+	AfterRequest(info RequestInfo)
+	// StreamEvent is called for every event received on a stream.
+	StreamEvent(info StreamEventInfo)
+}
+
+// RequestInfo describes a request sent to horizon.
+type RequestInfo struct {
+	// Request is the request returned by Hooks.BeforeRequest.
+	Request *http.Request
+	// Endpoint is the path of the request with its identifiers replaced by
+	// placeholders, e.g. /accounts/{id}/payments.
+	Endpoint string
+	// Stream is true if the request connects to a stream.
+	Stream bool
+	// Reconnect is true if the request reconnects to a stream after the
+	// previous connection ended.
+	Reconnect bool
+	// Response is nil if the request failed without a response.
+	Response *http.Response
+	Err      error
+	// Duration is the time until the response headers were received.
+	Duration time.Duration
+}
+
+// StreamEventInfo describes an event received on a stream.
+type StreamEventInfo struct {
+	// Request is the request of the stream connection.
+	Request  *http.Request
+	Endpoint string
+	// ID is the id of the event, the paging token of its record.
+	ID string
+}
+
+// SetHooks sets the hooks called around the requests of the client. No hooks
+// are called if hooks is nil.
+func (c *Client) SetHooks(hooks Hooks) *Client {
+	c.hooks = hooks
+	return c
+}
+
+// doRequest sends req with the HTTP client, calling the hooks of the client
+// around it.
+func (c *Client) doRequest(req *http.Request, stream, reconnect bool) (*http.Request, *http.Response, error) {
+	c.setDefaultClient()
+	if c.hooks == nil {
+		resp, err := c.HTTP.Do(req)
+		return req, resp, err
+	}
+
+	req = c.hooks.BeforeRequest(req)
+	start := time.Now()
+	resp, err := c.HTTP.Do(req)
+	c.hooks.AfterRequest(RequestInfo{
+		Request:   req,
+		Endpoint:  c.endpoint(req.URL),
+		Stream:    stream,
+		Reconnect: reconnect,
+		Response:  resp,
+		Err:       err,
+		Duration:  time.Since(start),
+	})
+	return req, resp, err
+}
+
+// streamEvent calls the hooks of the client for an event received on the
+// stream of req.
+func (c *Client) streamEvent(req *http.Request, id string) {
+	if c.hooks == nil {
+		return
+	}
+	c.hooks.StreamEvent(StreamEventInfo{
+		Request:  req,
+		Endpoint: c.endpoint(req.URL),
+		ID:       id,
+	})
+}
+
+// endpointSegments are the path segments of the horizon endpoints which are
+// not identifiers.
+var endpointSegments = map[string]bool{
+	"accounts":           true,
+	"assets":             true,
+	"claimable_balances": true,
+	"data":               true,
+	"effects":            true,
+	"fee_stats":          true,
+	"friendbot":          true,
+	"ledgers":            true,
+	"offers":             true,
+	"operations":         true,
+	"order_book":         true,
+	"paths":              true,
+	"payments":           true,
+	"search":             true,
+	"strict-receive":     true,
+	"strict-send":        true,
+	"trade_aggregations": true,
+	"trades":             true,
+	"transactions":       true,
+}
+
+// endpoint returns the path of u relative to the horizon URL of the client,
+// with its identifiers replaced by placeholders so that it can be used as a
+// metric label.
+func (c *Client) endpoint(u *url.URL) string {
+	path := u.Path
+	if base, err := url.Parse(c.HorizonURL); err == nil && base.Host == u.Host {
+		path = strings.TrimPrefix(path, strings.TrimRight(base.Path, "/"))
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "" || endpointSegments[segment]:
+		case i > 0 && segments[i-1] == "data":
+			segments[i] = "{key}"
+		default:
+			segments[i] = "{id}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,152 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHooks records the requests and the stream events of a client, and
+// adds a tracing header to its requests.
+type recordingHooks struct {
+	mutex    sync.Mutex
+	requests []RequestInfo
+	events   []StreamEventInfo
+}
+
+func (h *recordingHooks) BeforeRequest(req *http.Request) *http.Request {
+	req.Header.Set("X-Trace-Id", "trace")
+	return req
+}
+
+func (h *recordingHooks) AfterRequest(info RequestInfo) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.requests = append(h.requests, info)
+}
+
+func (h *recordingHooks) StreamEvent(info StreamEventInfo) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.events = append(h.events, info)
+}
+
+func TestHooksAroundRequests(t *testing.T) {
+	hmock := httptest.NewClient()
+	hooks := &recordingHooks{}
+	client := (&Client{HorizonURL: "https://localhost/", HTTP: hmock}).SetHooks(hooks)
+
+	hmock.On("GET", "https://localhost/ledgers/69859").Return(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "trace", req.Header.Get("X-Trace-Id"))
+		return httpmock.NewStringResponse(200, ledgerResponse), nil
+	})
+	hmock.On("GET", "https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/data/test").
+		Return(errorResponder("connection refused"))
+
+	_, err := client.LedgerDetail(69859)
+	require.NoError(t, err)
+	_, err = client.AccountData(AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", DataKey: "test"})
+	assert.Error(t, err)
+
+	require.Len(t, hooks.requests, 2)
+	assert.Equal(t, "/ledgers/{id}", hooks.requests[0].Endpoint)
+	assert.Equal(t, "GET", hooks.requests[0].Request.Method)
+	assert.Equal(t, 200, hooks.requests[0].Response.StatusCode)
+	assert.False(t, hooks.requests[0].Stream)
+	assert.NoError(t, hooks.requests[0].Err)
+
+	assert.Equal(t, "/accounts/{id}/data/{key}", hooks.requests[1].Endpoint)
+	assert.Nil(t, hooks.requests[1].Response)
+	assert.Error(t, hooks.requests[1].Err)
+}
+
+func TestClientEndpoint(t *testing.T) {
+	client := &Client{HorizonURL: "https://localhost/horizon/"}
+	for path, endpoint := range map[string]string{
+		"https://localhost/horizon/":                               "/",
+		"https://localhost/horizon/fee_stats":                      "/fee_stats",
+		"https://localhost/horizon/accounts/GABC/payments?limit=2": "/accounts/{id}/payments",
+		"https://localhost/horizon/transactions/abcdef/operations": "/transactions/{id}/operations",
+		"https://localhost/horizon/paths/strict-send":              "/paths/strict-send",
+		"https://localhost/horizon/operations/search?memo=abc":     "/operations/search",
+		"https://localhost/horizon/claimable_balances/00000000abc": "/claimable_balances/{id}",
+		"https://friendbot.stellar.org/?addr=GABC":                 "/",
+	} {
+		u, err := url.Parse(path)
+		require.NoError(t, err)
+		assert.Equal(t, endpoint, client.endpoint(u), path)
+	}
+}
+
+func metricValue(t *testing.T, metric prometheus.Metric) *dto.Metric {
+	value := &dto.Metric{}
+	require.NoError(t, metric.Write(value))
+	return value
+}
+
+func TestMetricsCollector(t *testing.T) {
+	client, _, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		switch n {
+		case 0:
+			// the connection is closed after a few events
+			writeTransactions(w, r, false, "1", "2")
+		case 1:
+			w.Header().Set("X-Ratelimit-Remaining", "41")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			writeTransactions(w, r, true, "3")
+		}
+	})
+	defer closeServer()
+	metrics := NewMetricsCollector("test")
+	client.SetHooks(metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := client.StreamTransactionsWithOptions(ctx, TransactionRequest{ForAccount: "GABC"}, StreamOptions{
+		ReconnectDelay: time.Millisecond,
+	}, func(tx hProtocol.Transaction) {
+		if tx.PT == "3" {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+
+	endpoint := "/accounts/{id}/transactions"
+	assert.Equal(t, float64(3), metricValue(t, metrics.streamEvents.WithLabelValues(endpoint)).GetCounter().GetValue())
+	assert.Equal(t, float64(2), metricValue(t, metrics.streamReconnects.WithLabelValues(endpoint)).GetCounter().GetValue())
+	assert.Equal(t, float64(2), metricValue(t, metrics.responses.WithLabelValues(endpoint, "GET", "200")).GetCounter().GetValue())
+	assert.Equal(t, float64(1), metricValue(t, metrics.responses.WithLabelValues(endpoint, "GET", "503")).GetCounter().GetValue())
+	assert.Equal(t, float64(41), metricValue(t, metrics.rateLimit).GetGauge().GetValue())
+
+	histogram, err := metrics.requestDuration.GetMetricWithLabelValues(endpoint, "GET")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), metricValue(t, histogram.(prometheus.Metric)).GetHistogram().GetSampleCount())
+
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(metrics))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	var names []string
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+	assert.Equal(t, []string{
+		"test_horizon_ratelimit_remaining",
+		"test_horizon_request_duration_seconds",
+		"test_horizon_responses_total",
+		"test_horizon_stream_events_total",
+		"test_horizon_stream_reconnects_total",
+	}, names)
+}
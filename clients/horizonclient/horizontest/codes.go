@@ -0,0 +1,188 @@
+package horizontest
+
+import (
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/xdr"
+)
+
+// transactionCodes maps the transaction result codes to the strings used by
+// horizon.
+var transactionCodes = map[xdr.TransactionResultCode]string{
+	xdr.TransactionResultCodeTxFeeBumpInnerSuccess: "tx_fee_bump_inner_success",
+	xdr.TransactionResultCodeTxFeeBumpInnerFailed:  "tx_fee_bump_inner_failed",
+	xdr.TransactionResultCodeTxNotSupported:        "tx_not_supported",
+	xdr.TransactionResultCodeTxSuccess:             "tx_success",
+	xdr.TransactionResultCodeTxFailed:              "tx_failed",
+	xdr.TransactionResultCodeTxTooEarly:            "tx_too_early",
+	xdr.TransactionResultCodeTxTooLate:             "tx_too_late",
+	xdr.TransactionResultCodeTxMissingOperation:    "tx_missing_operation",
+	xdr.TransactionResultCodeTxBadSeq:              "tx_bad_seq",
+	xdr.TransactionResultCodeTxBadAuth:             "tx_bad_auth",
+	xdr.TransactionResultCodeTxInsufficientBalance: "tx_insufficient_balance",
+	xdr.TransactionResultCodeTxNoAccount:           "tx_no_source_account",
+	xdr.TransactionResultCodeTxInsufficientFee:     "tx_insufficient_fee",
+	xdr.TransactionResultCodeTxBadAuthExtra:        "tx_bad_auth_extra",
+	xdr.TransactionResultCodeTxInternalError:       "tx_internal_error",
+}
+
+// operationCodes maps the operation result codes to the strings used by
+// horizon.
+var operationCodes = map[xdr.OperationResultCode]string{
+	xdr.OperationResultCodeOpBadAuth:           "op_bad_auth",
+	xdr.OperationResultCodeOpNoAccount:         "op_no_source_account",
+	xdr.OperationResultCodeOpNotSupported:      "op_not_supported",
+	xdr.OperationResultCodeOpTooManySubentries: "op_too_many_subentries",
+	xdr.OperationResultCodeOpExceededWorkLimit: "op_exceeded_work_limit",
+}
+
+// resultCodes returns the result codes of a transaction, as reported by
+// horizon when a submission fails. The operation codes of the inner
+// transaction are reported for fee bump transactions.
+func resultCodes(result xdr.TransactionResult) hProtocol.TransactionResultCodes {
+	codes := hProtocol.TransactionResultCodes{
+		TransactionCode: transactionCodes[result.Result.Code],
+	}
+	opResults, ok := result.OperationResults()
+	if !ok {
+		return codes
+	}
+	for _, opResult := range opResults {
+		codes.OperationCodes = append(codes.OperationCodes, operationCode(opResult))
+	}
+	return codes
+}
+
+func operationCode(result xdr.OperationResult) string {
+	if result.Code != xdr.OperationResultCodeOpInner {
+		return operationCodes[result.Code]
+	}
+
+	tr := result.MustTr()
+	switch tr.Type {
+	case xdr.OperationTypeCreateAccount:
+		switch tr.MustCreateAccountResult().Code {
+		case xdr.CreateAccountResultCodeCreateAccountSuccess:
+			return "op_success"
+		case xdr.CreateAccountResultCodeCreateAccountMalformed:
+			return "op_malformed"
+		case xdr.CreateAccountResultCodeCreateAccountUnderfunded:
+			return "op_underfunded"
+		case xdr.CreateAccountResultCodeCreateAccountLowReserve:
+			return "op_low_reserve"
+		case xdr.CreateAccountResultCodeCreateAccountAlreadyExist:
+			return "op_already_exists"
+		}
+	case xdr.OperationTypePayment:
+		switch tr.MustPaymentResult().Code {
+		case xdr.PaymentResultCodePaymentSuccess:
+			return "op_success"
+		case xdr.PaymentResultCodePaymentMalformed:
+			return "op_malformed"
+		case xdr.PaymentResultCodePaymentUnderfunded:
+			return "op_underfunded"
+		case xdr.PaymentResultCodePaymentSrcNoTrust:
+			return "op_src_no_trust"
+		case xdr.PaymentResultCodePaymentSrcNotAuthorized:
+			return "op_src_not_authorized"
+		case xdr.PaymentResultCodePaymentNoDestination:
+			return "op_no_destination"
+		case xdr.PaymentResultCodePaymentNoTrust:
+			return "op_no_trust"
+		case xdr.PaymentResultCodePaymentNotAuthorized:
+			return "op_not_authorized"
+		case xdr.PaymentResultCodePaymentLineFull:
+			return "op_line_full"
+		case xdr.PaymentResultCodePaymentNoIssuer:
+			return "op_no_issuer"
+		}
+	case xdr.OperationTypeSetOptions:
+		switch tr.MustSetOptionsResult().Code {
+		case xdr.SetOptionsResultCodeSetOptionsSuccess:
+			return "op_success"
+		case xdr.SetOptionsResultCodeSetOptionsLowReserve:
+			return "op_low_reserve"
+		case xdr.SetOptionsResultCodeSetOptionsTooManySigners:
+			return "op_too_many_signers"
+		case xdr.SetOptionsResultCodeSetOptionsBadFlags:
+			return "op_bad_flags"
+		case xdr.SetOptionsResultCodeSetOptionsInvalidInflation:
+			return "op_invalid_inflation"
+		case xdr.SetOptionsResultCodeSetOptionsCantChange:
+			return "op_cant_change"
+		case xdr.SetOptionsResultCodeSetOptionsUnknownFlag:
+			return "op_unknown_flag"
+		case xdr.SetOptionsResultCodeSetOptionsThresholdOutOfRange:
+			return "op_threshold_out_of_range"
+		case xdr.SetOptionsResultCodeSetOptionsBadSigner:
+			return "op_bad_signer"
+		case xdr.SetOptionsResultCodeSetOptionsInvalidHomeDomain:
+			return "op_invalid_home_domain"
+		}
+	case xdr.OperationTypeChangeTrust:
+		switch tr.MustChangeTrustResult().Code {
+		case xdr.ChangeTrustResultCodeChangeTrustSuccess:
+			return "op_success"
+		case xdr.ChangeTrustResultCodeChangeTrustMalformed:
+			return "op_malformed"
+		case xdr.ChangeTrustResultCodeChangeTrustNoIssuer:
+			return "op_no_issuer"
+		case xdr.ChangeTrustResultCodeChangeTrustInvalidLimit:
+			return "op_invalid_limit"
+		case xdr.ChangeTrustResultCodeChangeTrustLowReserve:
+			return "op_low_reserve"
+		case xdr.ChangeTrustResultCodeChangeTrustSelfNotAllowed:
+			return "op_self_not_allowed"
+		}
+	case xdr.OperationTypeAllowTrust:
+		switch tr.MustAllowTrustResult().Code {
+		case xdr.AllowTrustResultCodeAllowTrustSuccess:
+			return "op_success"
+		case xdr.AllowTrustResultCodeAllowTrustMalformed:
+			return "op_malformed"
+		case xdr.AllowTrustResultCodeAllowTrustNoTrustLine:
+			return "op_no_trustline"
+		case xdr.AllowTrustResultCodeAllowTrustTrustNotRequired:
+			return "op_not_required"
+		case xdr.AllowTrustResultCodeAllowTrustCantRevoke:
+			return "op_cant_revoke"
+		}
+	case xdr.OperationTypeAccountMerge:
+		switch tr.MustAccountMergeResult().Code {
+		case xdr.AccountMergeResultCodeAccountMergeSuccess:
+			return "op_success"
+		case xdr.AccountMergeResultCodeAccountMergeMalformed:
+			return "op_malformed"
+		case xdr.AccountMergeResultCodeAccountMergeNoAccount:
+			return "op_no_account"
+		case xdr.AccountMergeResultCodeAccountMergeImmutableSet:
+			return "op_immutable_set"
+		case xdr.AccountMergeResultCodeAccountMergeHasSubEntries:
+			return "op_has_sub_entries"
+		case xdr.AccountMergeResultCodeAccountMergeSeqnumTooFar:
+			return "op_seq_num_too_far"
+		case xdr.AccountMergeResultCodeAccountMergeDestFull:
+			return "op_dest_full"
+		}
+	case xdr.OperationTypeManageData:
+		switch tr.MustManageDataResult().Code {
+		case xdr.ManageDataResultCodeManageDataSuccess:
+			return "op_success"
+		case xdr.ManageDataResultCodeManageDataNotSupportedYet:
+			return "op_not_supported_yet"
+		case xdr.ManageDataResultCodeManageDataNameNotFound:
+			return "op_data_name_not_found"
+		case xdr.ManageDataResultCodeManageDataLowReserve:
+			return "op_low_reserve"
+		case xdr.ManageDataResultCodeManageDataInvalidName:
+			return "op_data_invalid_name"
+		}
+	case xdr.OperationTypeBumpSequence:
+		switch tr.MustBumpSeqResult().Code {
+		case xdr.BumpSequenceResultCodeBumpSequenceSuccess:
+			return "op_success"
+		case xdr.BumpSequenceResultCodeBumpSequenceBadSeq:
+			return "op_bad_seq"
+		}
+	}
+	return "op_not_supported"
+}
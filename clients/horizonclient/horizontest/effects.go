@@ -0,0 +1,307 @@
+package horizontest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/xdr"
+)
+
+// dataEffect is a data_created, data_updated or data_removed effect, which
+// have no type in the effects package.
+type dataEffect struct {
+	effects.Base
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// inflationDestinationUpdated is an account_inflation_destination_updated
+// effect.
+type inflationDestinationUpdated struct {
+	effects.Base
+	InflationDestination string `json:"inflation_destination"`
+}
+
+// effectsBuilder builds the effects of an operation, numbered from 1.
+type effectsBuilder struct {
+	operationID int64
+	txHash      string
+	closeTime   time.Time
+	index       int
+	records     []record
+}
+
+// base returns the base of the next effect of the operation.
+func (b *effectsBuilder) base(account string, effectType effects.EffectType) effects.Base {
+	b.index++
+	return effects.Base{
+		ID:              fmt.Sprintf("%019d-%010d", b.operationID, b.index),
+		PT:              fmt.Sprintf("%d-%d", b.operationID, b.index),
+		Account:         account,
+		Type:            effects.EffectTypeNames[effectType],
+		TypeI:           int32(effectType),
+		LedgerCloseTime: b.closeTime,
+	}
+}
+
+// add adds an effect whose base was returned by the last call to base.
+func (b *effectsBuilder) add(effect effects.Effect) {
+	b.records = append(b.records, record{
+		id:         b.operationID,
+		index:      b.index,
+		accounts:   []string{effect.GetAccount()},
+		txHash:     b.txHash,
+		successful: true,
+		resource:   effect,
+	})
+}
+
+// addOperationEffects adds the effects of a successful operation, from the
+// operation and the ledger entry changes it caused.
+func (b *effectsBuilder) addOperationEffects(
+	op xdr.Operation,
+	source xdr.AccountId,
+	result xdr.OperationResult,
+	changes xdr.LedgerEntryChanges,
+) {
+	sourceAddress := source.Address()
+	switch op.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		body := op.Body.MustCreateAccountOp()
+		destination := body.Destination.Address()
+		startingBalance := amount.String(body.StartingBalance)
+		b.add(effects.AccountCreated{
+			Base:            b.base(destination, effects.EffectAccountCreated),
+			StartingBalance: startingBalance,
+		})
+		b.add(effects.AccountDebited{
+			Base:   b.base(sourceAddress, effects.EffectAccountDebited),
+			Asset:  base.Asset{Type: "native"},
+			Amount: startingBalance,
+		})
+		b.add(effects.SignerCreated{
+			Base:      b.base(destination, effects.EffectSignerCreated),
+			Weight:    1,
+			PublicKey: destination,
+			Key:       destination,
+		})
+
+	case xdr.OperationTypePayment:
+		body := op.Body.MustPaymentOp()
+		destination := body.Destination.ToAccountId()
+		asset := assetResource(body.Asset)
+		b.add(effects.AccountCredited{
+			Base:   b.base(destination.Address(), effects.EffectAccountCredited),
+			Asset:  asset,
+			Amount: amount.String(body.Amount),
+		})
+		b.add(effects.AccountDebited{
+			Base:   b.base(sourceAddress, effects.EffectAccountDebited),
+			Asset:  asset,
+			Amount: amount.String(body.Amount),
+		})
+
+	case xdr.OperationTypeAccountMerge:
+		destination := op.Body.MustDestination().ToAccountId()
+		merged := amount.String(*result.MustTr().MustAccountMergeResult().SourceAccountBalance)
+		b.add(effects.AccountDebited{
+			Base:   b.base(sourceAddress, effects.EffectAccountDebited),
+			Asset:  base.Asset{Type: "native"},
+			Amount: merged,
+		})
+		b.add(effects.AccountCredited{
+			Base:   b.base(destination.Address(), effects.EffectAccountCredited),
+			Asset:  base.Asset{Type: "native"},
+			Amount: merged,
+		})
+		b.add(b.base(sourceAddress, effects.EffectAccountRemoved))
+
+	case xdr.OperationTypeChangeTrust:
+		body := op.Body.MustChangeTrustOp()
+		var key xdr.LedgerKey
+		if err := key.SetTrustline(source, body.Line); err != nil {
+			return
+		}
+		before, after := entryChange(changes, key)
+		asset := assetResource(body.Line)
+		limit := amount.String(body.Limit)
+		switch {
+		case before == nil:
+			b.add(effects.TrustlineCreated{Base: b.base(sourceAddress, effects.EffectTrustlineCreated), Asset: asset, Limit: limit})
+		case after == nil:
+			b.add(effects.TrustlineRemoved{Base: b.base(sourceAddress, effects.EffectTrustlineRemoved), Asset: asset, Limit: limit})
+		default:
+			b.add(effects.TrustlineUpdated{Base: b.base(sourceAddress, effects.EffectTrustlineUpdated), Asset: asset, Limit: limit})
+		}
+
+	case xdr.OperationTypeAllowTrust:
+		body := op.Body.MustAllowTrustOp()
+		asset := assetResource(body.Asset.ToAsset(source))
+		trustor := body.Trustor.Address()
+		switch flags := xdr.TrustLineFlags(body.Authorize); {
+		case flags.IsAuthorized():
+			b.add(effects.TrustlineAuthorized{
+				Base:      b.base(sourceAddress, effects.EffectTrustlineAuthorized),
+				Trustor:   trustor,
+				AssetType: asset.Type,
+				AssetCode: asset.Code,
+			})
+		case flags.IsAuthorizedToMaintainLiabilitiesFlag():
+			b.add(effects.TrustlineAuthorizedToMaintainLiabilities{
+				Base:      b.base(sourceAddress, effects.EffectTrustlineAuthorizedToMaintainLiabilities),
+				Trustor:   trustor,
+				AssetType: asset.Type,
+				AssetCode: asset.Code,
+			})
+		default:
+			b.add(effects.TrustlineDeauthorized{
+				Base:      b.base(sourceAddress, effects.EffectTrustlineDeauthorized),
+				Trustor:   trustor,
+				AssetType: asset.Type,
+				AssetCode: asset.Code,
+			})
+		}
+
+	case xdr.OperationTypeManageData:
+		body := op.Body.MustManageDataOp()
+		var key xdr.LedgerKey
+		if err := key.SetData(source, string(body.DataName)); err != nil {
+			return
+		}
+		before, after := entryChange(changes, key)
+		effect := dataEffect{Name: string(body.DataName)}
+		if body.DataValue != nil {
+			effect.Value = base64.StdEncoding.EncodeToString(*body.DataValue)
+		}
+		switch {
+		case before == nil:
+			effect.Base = b.base(sourceAddress, effects.EffectDataCreated)
+		case after == nil:
+			effect.Base = b.base(sourceAddress, effects.EffectDataRemoved)
+		default:
+			effect.Base = b.base(sourceAddress, effects.EffectDataUpdated)
+		}
+		b.add(effect)
+
+	case xdr.OperationTypeBumpSequence:
+		before, after := entryChange(changes, source.LedgerKey())
+		if before != nil && after != nil && before.Data.MustAccount().SeqNum != after.Data.MustAccount().SeqNum {
+			b.add(effects.SequenceBumped{
+				Base:   b.base(sourceAddress, effects.EffectSequenceBumped),
+				NewSeq: int64(after.Data.MustAccount().SeqNum),
+			})
+		}
+
+	case xdr.OperationTypeSetOptions:
+		before, after := entryChange(changes, source.LedgerKey())
+		if before != nil && after != nil {
+			b.addSetOptionsEffects(sourceAddress, before.Data.MustAccount(), after.Data.MustAccount())
+		}
+	}
+}
+
+// addSetOptionsEffects adds the effects of the changes of an account by a
+// set options operation.
+func (b *effectsBuilder) addSetOptionsEffects(address string, before, after xdr.AccountEntry) {
+	if before.HomeDomain != after.HomeDomain {
+		b.add(effects.AccountHomeDomainUpdated{
+			Base:       b.base(address, effects.EffectAccountHomeDomainUpdated),
+			HomeDomain: string(after.HomeDomain),
+		})
+	}
+
+	if before.ThresholdLow() != after.ThresholdLow() ||
+		before.ThresholdMedium() != after.ThresholdMedium() ||
+		before.ThresholdHigh() != after.ThresholdHigh() {
+		b.add(effects.AccountThresholdsUpdated{
+			Base:          b.base(address, effects.EffectAccountThresholdsUpdated),
+			LowThreshold:  int32(after.ThresholdLow()),
+			MedThreshold:  int32(after.ThresholdMedium()),
+			HighThreshold: int32(after.ThresholdHigh()),
+		})
+	}
+
+	if before.Flags != after.Flags {
+		beforeFlags, afterFlags := xdr.AccountFlags(before.Flags), xdr.AccountFlags(after.Flags)
+		effect := effects.AccountFlagsUpdated{Base: b.base(address, effects.EffectAccountFlagsUpdated)}
+		if beforeFlags.IsAuthRequired() != afterFlags.IsAuthRequired() {
+			authRequired := afterFlags.IsAuthRequired()
+			effect.AuthRequired = &authRequired
+		}
+		if beforeFlags.IsAuthRevocable() != afterFlags.IsAuthRevocable() {
+			authRevocable := afterFlags.IsAuthRevocable()
+			effect.AuthRevokable = &authRevocable
+		}
+		b.add(effect)
+	}
+
+	inflationDestination := func(account xdr.AccountEntry) string {
+		if account.InflationDest == nil {
+			return ""
+		}
+		return account.InflationDest.Address()
+	}
+	if inflationDestination(before) != inflationDestination(after) {
+		b.add(inflationDestinationUpdated{
+			Base:                 b.base(address, effects.EffectAccountInflationDestinationUpdated),
+			InflationDestination: inflationDestination(after),
+		})
+	}
+
+	// the master key is reported as a signer of the account
+	beforeSigners := before.SignerSummary()
+	afterSigners := after.SignerSummary()
+	var keys []string
+	for _, signer := range before.Signers {
+		keys = append(keys, signer.Key.Address())
+	}
+	for _, signer := range after.Signers {
+		if _, ok := beforeSigners[signer.Key.Address()]; !ok {
+			keys = append(keys, signer.Key.Address())
+		}
+	}
+	keys = append(keys, address)
+	for _, key := range keys {
+		previous, existed := beforeSigners[key]
+		weight, exists := afterSigners[key]
+		switch {
+		case !existed && exists:
+			b.add(effects.SignerCreated{Base: b.base(address, effects.EffectSignerCreated), Weight: weight, PublicKey: key, Key: key})
+		case existed && !exists:
+			b.add(effects.SignerRemoved{Base: b.base(address, effects.EffectSignerRemoved), Weight: 0, PublicKey: key, Key: key})
+		case existed && previous != weight:
+			b.add(effects.SignerUpdated{Base: b.base(address, effects.EffectSignerUpdated), Weight: weight, PublicKey: key, Key: key})
+		}
+	}
+}
+
+// entryChange returns the entry identified by key before and after the
+// changes, nil if it did not exist before or was removed.
+func entryChange(changes xdr.LedgerEntryChanges, key xdr.LedgerKey) (before, after *xdr.LedgerEntry) {
+	for _, change := range changes {
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			if key.Equals(change.State.LedgerKey()) {
+				before = change.State
+				after = change.State
+			}
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			if key.Equals(change.Created.LedgerKey()) {
+				after = change.Created
+			}
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			if key.Equals(change.Updated.LedgerKey()) {
+				after = change.Updated
+			}
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			if key.Equals(*change.Removed) {
+				after = nil
+			}
+		}
+	}
+	return before, after
+}
@@ -0,0 +1,401 @@
+package horizontest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stellar/go/amount"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/httpjson"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+var notFound = problem.P{
+	Type:   "https://stellar.org/horizon-errors/not_found",
+	Title:  "Resource Missing",
+	Status: http.StatusNotFound,
+	Detail: "The resource at the url requested was not found.",
+}
+
+func badRequest(detail string) problem.P {
+	return problem.P{
+		Type:   "https://stellar.org/horizon-errors/bad_request",
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: detail,
+	}
+}
+
+func renderProblem(w http.ResponseWriter, p problem.P) {
+	httpjson.RenderStatus(w, p.Status, p, httpjson.HALJSON)
+}
+
+func (s *Server) getRoot(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	latest := s.latestLedger()
+	s.mutex.Unlock()
+
+	hal.Render(w, hProtocol.Root{
+		HorizonVersion:               "horizontest",
+		StellarCoreVersion:           "horizontest",
+		IngestSequence:               uint32(latest),
+		HorizonSequence:              latest,
+		HistoryElderSequence:         1,
+		CoreSequence:                 latest,
+		NetworkPassphrase:            s.networkPassphrase,
+		CurrentProtocolVersion:       13,
+		CoreSupportedProtocolVersion: 13,
+	})
+}
+
+func (s *Server) getAccount(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	account, ok := s.accountResource(chi.URLParam(r, "account_id"))
+	s.mutex.Unlock()
+	if !ok {
+		renderProblem(w, notFound)
+		return
+	}
+	hal.Render(w, account)
+}
+
+func (s *Server) getAccountData(w http.ResponseWriter, r *http.Request) {
+	aid, err := xdr.AddressToAccountId(chi.URLParam(r, "account_id"))
+	if err != nil {
+		renderProblem(w, notFound)
+		return
+	}
+	var key xdr.LedgerKey
+	if err = key.SetData(aid, chi.URLParam(r, "key")); err != nil {
+		renderProblem(w, notFound)
+		return
+	}
+
+	s.mutex.Lock()
+	entry, ok := s.state.Get(key)
+	s.mutex.Unlock()
+	if !ok {
+		renderProblem(w, notFound)
+		return
+	}
+	hal.Render(w, hProtocol.AccountData{
+		Value: base64.StdEncoding.EncodeToString(entry.Data.MustData().DataValue),
+	})
+}
+
+// getAccounts serves the accounts which have a signer, or a trustline to an
+// asset, ordered by account id.
+func (s *Server) getAccounts(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+	signer := values.Get("signer")
+	asset := values.Get("asset")
+	if signer == "" && asset == "" {
+		renderProblem(w, badRequest("either signer or asset must be set"))
+		return
+	}
+
+	// the paging tokens of accounts are account IDs, not positions
+	cursor := values.Get("cursor")
+	order, limit, err := parseOrderAndLimit(values)
+	if err != nil {
+		renderProblem(w, badRequest(err.Error()))
+		return
+	}
+
+	s.mutex.Lock()
+	var accounts []hProtocol.Account
+	for _, entry := range s.state.Entries() {
+		if entry.Data.Type != xdr.LedgerEntryTypeAccount {
+			continue
+		}
+		aid := entry.Data.MustAccount().AccountId
+		account, _ := s.accountResource(aid.Address())
+		if (signer == "" || hasSigner(account, signer)) && (asset == "" || hasTrustline(account, asset)) {
+			accounts = append(accounts, account)
+		}
+	}
+	s.mutex.Unlock()
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if order == "desc" {
+			return accounts[i].AccountID > accounts[j].AccountID
+		}
+		return accounts[i].AccountID < accounts[j].AccountID
+	})
+	page := hal.Page{Order: order, Limit: uint64(limit), Cursor: cursor}
+	page.FullURL = requestURL(r)
+	for _, account := range accounts {
+		if len(page.Embedded.Records) == limit {
+			break
+		}
+		if cursor == "" ||
+			(order == "asc" && account.AccountID > cursor) ||
+			(order == "desc" && account.AccountID < cursor) {
+			page.Add(account)
+		}
+	}
+	page.PopulateLinks()
+	hal.Render(w, page)
+}
+
+func hasSigner(account hProtocol.Account, signer string) bool {
+	for _, s := range account.Signers {
+		if s.Key == signer && s.Weight > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTrustline returns true if the account trusts the asset, given as
+// CODE:ISSUER.
+func hasTrustline(account hProtocol.Account, asset string) bool {
+	for _, balance := range account.Balances {
+		if balance.Code+":"+balance.Issuer == asset {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) getLedgers(w http.ResponseWriter, r *http.Request) {
+	s.serveCollection(w, r, func() []record { return s.ledgers }, nil)
+}
+
+func (s *Server) getLedger(w http.ResponseWriter, r *http.Request) {
+	sequence, err := strconv.ParseInt(chi.URLParam(r, "sequence"), 10, 32)
+	if err != nil {
+		renderProblem(w, badRequest("invalid ledger sequence"))
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if sequence < 1 || sequence > int64(s.latestLedger()) {
+		renderProblem(w, notFound)
+		return
+	}
+	hal.Render(w, s.ledgers[sequence-1].resource)
+}
+
+func (s *Server) getTransactions(w http.ResponseWriter, r *http.Request) {
+	s.serveCollection(w, r, func() []record { return s.transactions }, historyFilter(r, false))
+}
+
+func (s *Server) getTransaction(w http.ResponseWriter, r *http.Request) {
+	hash := chi.URLParam(r, "hash")
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, transaction := range s.transactions {
+		if transaction.txHash == hash {
+			hal.Render(w, transaction.resource)
+			return
+		}
+	}
+	renderProblem(w, notFound)
+}
+
+func (s *Server) getOperations(w http.ResponseWriter, r *http.Request) {
+	s.serveCollection(w, r, func() []record { return s.operations }, historyFilter(r, false))
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, operation := range s.operations {
+		if operation.resource.PagingToken() == id {
+			hal.Render(w, operation.resource)
+			return
+		}
+	}
+	renderProblem(w, notFound)
+}
+
+func (s *Server) getPayments(w http.ResponseWriter, r *http.Request) {
+	s.serveCollection(w, r, func() []record { return s.operations }, historyFilter(r, true))
+}
+
+func (s *Server) getEffects(w http.ResponseWriter, r *http.Request) {
+	s.serveCollection(w, r, func() []record { return s.effects }, historyFilter(r, false))
+}
+
+// historyFilter returns the filter of the records of an account or of a
+// transaction, as given by the path of the request.
+func historyFilter(r *http.Request, payments bool) func(record) bool {
+	account := chi.URLParam(r, "account_id")
+	hash := chi.URLParam(r, "hash")
+	return func(rec record) bool {
+		return (!payments || rec.payment) &&
+			(account == "" || rec.involves(account)) &&
+			(hash == "" || rec.txHash == hash)
+	}
+}
+
+// getOffers serves the offers of a seller, given in the path or with the
+// seller parameter.
+func (s *Server) getOffers(w http.ResponseWriter, r *http.Request) {
+	seller := chi.URLParam(r, "account_id")
+	if seller == "" {
+		seller = r.URL.Query().Get("seller")
+	}
+	s.serveCollection(w, r, s.offerRecords, func(rec record) bool {
+		return seller == "" || rec.involves(seller)
+	})
+}
+
+// offerRecords returns the offers of the ledger as records ordered by offer
+// id. The caller must hold the mutex.
+func (s *Server) offerRecords() []record {
+	var records []record
+	for _, entry := range s.state.Entries() {
+		if entry.Data.Type != xdr.LedgerEntryTypeOffer {
+			continue
+		}
+		offer := s.offerResource(entry)
+		records = append(records, record{
+			id:         offer.ID,
+			accounts:   []string{offer.Seller},
+			successful: true,
+			resource:   offer,
+		})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].id < records[j].id
+	})
+	return records
+}
+
+// accountResource returns the account with the given address, and false if
+// it does not exist. The caller must hold the mutex.
+func (s *Server) accountResource(address string) (hProtocol.Account, bool) {
+	aid, err := xdr.AddressToAccountId(address)
+	if err != nil {
+		return hProtocol.Account{}, false
+	}
+	entry, ok := s.state.Get(aid.LedgerKey())
+	if !ok {
+		return hProtocol.Account{}, false
+	}
+	account := entry.Data.MustAccount()
+	flags := xdr.AccountFlags(account.Flags)
+
+	resource := hProtocol.Account{
+		ID:                 address,
+		AccountID:          address,
+		Sequence:           strconv.FormatInt(int64(account.SeqNum), 10),
+		SubentryCount:      int32(account.NumSubEntries),
+		HomeDomain:         string(account.HomeDomain),
+		LastModifiedLedger: uint32(entry.LastModifiedLedgerSeq),
+		LastModifiedTime:   s.ledgerCloseTime(entry.LastModifiedLedgerSeq),
+		Thresholds: hProtocol.AccountThresholds{
+			LowThreshold:  account.ThresholdLow(),
+			MedThreshold:  account.ThresholdMedium(),
+			HighThreshold: account.ThresholdHigh(),
+		},
+		Flags: hProtocol.AccountFlags{
+			AuthRequired:  flags.IsAuthRequired(),
+			AuthRevocable: flags.IsAuthRevocable(),
+			AuthImmutable: flags.IsAuthImmutable(),
+		},
+		Data: map[string]string{},
+		PT:   address,
+	}
+	if account.InflationDest != nil {
+		resource.InflationDestination = account.InflationDest.Address()
+	}
+
+	var trustlines []hProtocol.Balance
+	for _, e := range s.state.Entries() {
+		switch e.Data.Type {
+		case xdr.LedgerEntryTypeTrustline:
+			line := e.Data.MustTrustLine()
+			if line.AccountId.Equals(aid) {
+				trustlines = append(trustlines, s.trustlineBalance(e))
+			}
+		case xdr.LedgerEntryTypeData:
+			data := e.Data.MustData()
+			if data.AccountId.Equals(aid) {
+				resource.Data[string(data.DataName)] = base64.StdEncoding.EncodeToString(data.DataValue)
+			}
+		}
+	}
+	sort.Slice(trustlines, func(i, j int) bool {
+		return trustlines[i].Code+trustlines[i].Issuer < trustlines[j].Code+trustlines[j].Issuer
+	})
+	native := hProtocol.Balance{
+		Balance:            amount.String(account.Balance),
+		BuyingLiabilities:  "0.0000000",
+		SellingLiabilities: "0.0000000",
+	}
+	native.Type = "native"
+	if v1, ok := account.Ext.GetV1(); ok {
+		native.BuyingLiabilities = amount.String(v1.Liabilities.Buying)
+		native.SellingLiabilities = amount.String(v1.Liabilities.Selling)
+	}
+	resource.Balances = append(trustlines, native)
+
+	for _, signer := range account.Signers {
+		resource.Signers = append(resource.Signers, signerResource(signer.Key.Address(), int32(signer.Weight)))
+	}
+	resource.Signers = append(resource.Signers, signerResource(address, int32(account.MasterKeyWeight())))
+	return resource, true
+}
+
+func (s *Server) trustlineBalance(entry xdr.LedgerEntry) hProtocol.Balance {
+	line := entry.Data.MustTrustLine()
+	authorized := xdr.TrustLineFlags(line.Flags).IsAuthorized()
+	maintainLiabilities := xdr.TrustLineFlags(line.Flags).IsAuthorizedToMaintainLiabilitiesFlag()
+	balance := hProtocol.Balance{
+		Balance:                           amount.String(line.Balance),
+		Limit:                             amount.String(line.Limit),
+		BuyingLiabilities:                 "0.0000000",
+		SellingLiabilities:                "0.0000000",
+		LastModifiedLedger:                uint32(entry.LastModifiedLedgerSeq),
+		IsAuthorized:                      &authorized,
+		IsAuthorizedToMaintainLiabilities: &maintainLiabilities,
+		Asset:                             assetResource(line.Asset),
+	}
+	if v1, ok := line.Ext.GetV1(); ok {
+		balance.BuyingLiabilities = amount.String(v1.Liabilities.Buying)
+		balance.SellingLiabilities = amount.String(v1.Liabilities.Selling)
+	}
+	return balance
+}
+
+func (s *Server) offerResource(entry xdr.LedgerEntry) hProtocol.Offer {
+	offer := entry.Data.MustOffer()
+	return hProtocol.Offer{
+		ID:                 int64(offer.OfferId),
+		PT:                 strconv.FormatInt(int64(offer.OfferId), 10),
+		Seller:             offer.SellerId.Address(),
+		Selling:            hProtocol.Asset(assetResource(offer.Selling)),
+		Buying:             hProtocol.Asset(assetResource(offer.Buying)),
+		Amount:             amount.String(offer.Amount),
+		PriceR:             hProtocol.Price{N: int32(offer.Price.N), D: int32(offer.Price.D)},
+		Price:              offer.Price.String(),
+		LastModifiedLedger: int32(entry.LastModifiedLedgerSeq),
+		LastModifiedTime:   s.ledgerCloseTime(entry.LastModifiedLedgerSeq),
+	}
+}
+
+// ledgerCloseTime returns the close time of a ledger, nil if the ledger is
+// not closed yet. The caller must hold the mutex.
+func (s *Server) ledgerCloseTime(sequence xdr.Uint32) *time.Time {
+	if sequence < 1 || int(sequence) > len(s.ledgers) {
+		return nil
+	}
+	closedAt := s.ledgers[sequence-1].resource.(hProtocol.Ledger).ClosedAt
+	return &closedAt
+}
+
+func signerResource(key string, weight int32) hProtocol.Signer {
+	keyType, _ := hProtocol.KeyTypeFromAddress(key)
+	return hProtocol.Signer{Key: key, Weight: weight, Type: keyType}
+}
@@ -0,0 +1,416 @@
+package horizontest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/stellar/go/amount"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/txnbuild/simulator"
+	"github.com/stellar/go/xdr"
+)
+
+// record is a record of a collection served by the server.
+type record struct {
+	// id and index order the records of a collection. index orders the
+	// effects of an operation, and is 0 for other records.
+	id    int64
+	index int
+	// accounts are the accounts participating in the record.
+	accounts []string
+	// txHash is the hash of the transaction of the record, if any.
+	txHash     string
+	successful bool
+	payment    bool
+	resource   hal.Pageable
+}
+
+func (r record) position() position {
+	return position{id: r.id, index: r.index}
+}
+
+func (r record) involves(account string) bool {
+	for _, a := range r.accounts {
+		if a == account {
+			return true
+		}
+	}
+	return false
+}
+
+// appliedTransaction is a transaction applied to the ledger state.
+type appliedTransaction struct {
+	envelope    xdr.TransactionEnvelope
+	envelopeXDR string
+	hash        string
+	// innerHash is the hash of the inner transaction of a fee bump
+	// transaction.
+	innerHash string
+	result    simulator.Result
+}
+
+// toid returns the id of a ledger, transaction or operation, as assigned by
+// horizon. Transactions and operations are numbered from 1 in their ledger
+// and transaction, and a ledger has the id of its transaction 0.
+func toid(ledger int32, transaction, operation int32) int64 {
+	return int64(ledger)<<32 | int64(transaction)<<12 | int64(operation)
+}
+
+// closeLedger closes a ledger including tx, which can be nil for an empty
+// ledger, and records its history. The caller must hold the mutex, except
+// when the server is created.
+func (s *Server) closeLedger(closeTime time.Time, tx *appliedTransaction) (hProtocol.Transaction, error) {
+	sequence := s.latestLedger() + 1
+	var failed, txSetOperations int32
+	ledger := hProtocol.Ledger{
+		PT:                     strconv.FormatInt(toid(sequence, 0, 0), 10),
+		Sequence:               sequence,
+		FailedTransactionCount: &failed,
+		TxSetOperationCount:    &txSetOperations,
+		ClosedAt:               closeTime,
+		TotalCoins:             amount.String(amount.MustParse(TotalCoins)),
+		BaseFee:                int32(s.baseFee),
+		BaseReserve:            int32(s.baseReserve),
+		MaxTxSetSize:           100,
+		ProtocolVersion:        13,
+	}
+
+	var transaction hProtocol.Transaction
+	var txRecord record
+	var opRecords, effectRecords []record
+	if tx != nil {
+		var err error
+		txRecord, opRecords, effectRecords, err = s.transactionRecords(sequence, closeTime, tx)
+		if err != nil {
+			return transaction, err
+		}
+		transaction = txRecord.resource.(hProtocol.Transaction)
+		txSetOperations = transaction.OperationCount
+		if transaction.Successful {
+			ledger.SuccessfulTransactionCount = 1
+			ledger.OperationCount = transaction.OperationCount
+		} else {
+			failed = 1
+		}
+		s.feePool += transaction.FeeCharged
+	}
+	ledger.FeePool = amount.StringFromInt64(s.feePool)
+
+	hash := sha256.New()
+	if len(s.ledgers) > 0 {
+		ledger.PrevHash = s.ledgers[len(s.ledgers)-1].resource.(hProtocol.Ledger).Hash
+		hash.Write([]byte(ledger.PrevHash))
+	}
+	binary.Write(hash, binary.BigEndian, sequence)
+	hash.Write([]byte(transaction.Hash))
+	ledger.Hash = hex.EncodeToString(hash.Sum(nil))
+	ledger.ID = ledger.Hash
+
+	s.ledgers = append(s.ledgers, record{id: toid(sequence, 0, 0), successful: true, resource: ledger})
+	if tx != nil {
+		s.transactions = append(s.transactions, txRecord)
+		s.operations = append(s.operations, opRecords...)
+		s.effects = append(s.effects, effectRecords...)
+	}
+	close(s.ledgerClosed)
+	s.ledgerClosed = make(chan struct{})
+	return transaction, nil
+}
+
+// transactionRecords returns the records of the transaction, operations and
+// effects of a transaction included in a ledger.
+func (s *Server) transactionRecords(
+	sequence int32,
+	closeTime time.Time,
+	tx *appliedTransaction,
+) (record, []record, []record, error) {
+	env := tx.envelope
+	result := tx.result
+	successful := result.Successful()
+	source := env.SourceAccount().ToAccountId()
+
+	resultXDR, err := xdr.MarshalBase64(result.TransactionResult)
+	if err != nil {
+		return record{}, nil, nil, errors.Wrap(err, "could not encode transaction result")
+	}
+	opMetas := []xdr.OperationMeta{}
+	for _, changes := range result.OperationChanges {
+		opMetas = append(opMetas, xdr.OperationMeta{Changes: changes})
+	}
+	metaXDR, err := xdr.MarshalBase64(xdr.TransactionMeta{
+		V: 2,
+		V2: &xdr.TransactionMetaV2{
+			TxChangesBefore: xdr.LedgerEntryChanges{},
+			Operations:      opMetas,
+			TxChangesAfter:  xdr.LedgerEntryChanges{},
+		},
+	})
+	if err != nil {
+		return record{}, nil, nil, errors.Wrap(err, "could not encode transaction meta")
+	}
+	feeMetaXDR, err := xdr.MarshalBase64(result.FeeChanges)
+	if err != nil {
+		return record{}, nil, nil, errors.Wrap(err, "could not encode fee meta")
+	}
+
+	id := toid(sequence, 1, 0)
+	transaction := hProtocol.Transaction{
+		ID:              tx.hash,
+		PT:              strconv.FormatInt(id, 10),
+		Successful:      successful,
+		Hash:            tx.hash,
+		Ledger:          sequence,
+		LedgerCloseTime: closeTime,
+		Account:         source.Address(),
+		AccountSequence: strconv.FormatInt(env.SeqNum(), 10),
+		FeeAccount:      source.Address(),
+		FeeCharged:      int64(result.TransactionResult.FeeCharged),
+		MaxFee:          int64(env.Fee()),
+		OperationCount:  int32(len(env.Operations())),
+		EnvelopeXdr:     tx.envelopeXDR,
+		ResultXdr:       resultXDR,
+		ResultMetaXdr:   metaXDR,
+		FeeMetaXdr:      feeMetaXDR,
+		Signatures:      signatures(env.Signatures()),
+	}
+	setMemo(&transaction, env.Memo())
+	if tb := env.TimeBounds(); tb != nil {
+		transaction.ValidAfter = time.Unix(int64(tb.MinTime), 0).UTC().Format(time.RFC3339)
+		if tb.MaxTime != 0 {
+			transaction.ValidBefore = time.Unix(int64(tb.MaxTime), 0).UTC().Format(time.RFC3339)
+		}
+	}
+	if env.IsFeeBump() {
+		feeAccount := env.FeeBumpAccount().ToAccountId()
+		transaction.FeeAccount = feeAccount.Address()
+		transaction.MaxFee = env.FeeBumpFee()
+		transaction.Signatures = signatures(env.FeeBumpSignatures())
+		transaction.FeeBumpTransaction = &hProtocol.FeeBumpTransaction{
+			Hash:       tx.hash,
+			Signatures: transaction.Signatures,
+		}
+		transaction.InnerTransaction = &hProtocol.InnerTransaction{
+			Hash:       tx.innerHash,
+			Signatures: signatures(env.Signatures()),
+			MaxFee:     int64(env.Fee()),
+		}
+	}
+
+	txRecord := record{
+		id:         id,
+		accounts:   []string{transaction.Account, transaction.FeeAccount},
+		txHash:     tx.hash,
+		successful: successful,
+		resource:   transaction,
+	}
+
+	opResults, _ := result.TransactionResult.OperationResults()
+	var opRecords, effectRecords []record
+	for i, op := range env.Operations() {
+		opSource := source
+		if op.SourceAccount != nil {
+			opSource = op.SourceAccount.ToAccountId()
+		}
+		opID := toid(sequence, 1, int32(i+1))
+		opRecord := operationRecord(operations.Base{
+			ID:                    strconv.FormatInt(opID, 10),
+			PT:                    strconv.FormatInt(opID, 10),
+			TransactionSuccessful: successful,
+			SourceAccount:         opSource.Address(),
+			Type:                  operations.TypeNames[op.Body.Type],
+			TypeI:                 int32(op.Body.Type),
+			LedgerCloseTime:       closeTime,
+			TransactionHash:       tx.hash,
+		}, op)
+		opRecord.id = opID
+		opRecord.txHash = tx.hash
+		opRecord.successful = successful
+		opRecord.accounts = appendAccount(opRecord.accounts, transaction.Account)
+		opRecords = append(opRecords, opRecord)
+		for _, account := range opRecord.accounts {
+			txRecord.accounts = appendAccount(txRecord.accounts, account)
+		}
+
+		if successful && i < len(opResults) && i < len(result.OperationChanges) {
+			b := &effectsBuilder{operationID: opID, txHash: tx.hash, closeTime: closeTime}
+			b.addOperationEffects(op, opSource, opResults[i], result.OperationChanges[i])
+			effectRecords = append(effectRecords, b.records...)
+		}
+	}
+	return txRecord, opRecords, effectRecords, nil
+}
+
+// operationRecord returns the record of an operation, with its participants
+// other than the source account of its transaction.
+func operationRecord(b operations.Base, op xdr.Operation) record {
+	r := record{accounts: []string{b.SourceAccount}}
+	switch op.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		body := op.Body.MustCreateAccountOp()
+		r.payment = true
+		r.accounts = appendAccount(r.accounts, body.Destination.Address())
+		r.resource = operations.CreateAccount{
+			Base:            b,
+			StartingBalance: amount.String(body.StartingBalance),
+			Funder:          b.SourceAccount,
+			Account:         body.Destination.Address(),
+		}
+	case xdr.OperationTypePayment:
+		body := op.Body.MustPaymentOp()
+		destination := body.Destination.ToAccountId()
+		r.payment = true
+		r.accounts = appendAccount(r.accounts, destination.Address())
+		r.resource = operations.Payment{
+			Base:   b,
+			Asset:  assetResource(body.Asset),
+			From:   b.SourceAccount,
+			To:     destination.Address(),
+			Amount: amount.String(body.Amount),
+		}
+	case xdr.OperationTypeAccountMerge:
+		destination := op.Body.MustDestination().ToAccountId()
+		r.payment = true
+		r.accounts = appendAccount(r.accounts, destination.Address())
+		r.resource = operations.AccountMerge{
+			Base:    b,
+			Account: b.SourceAccount,
+			Into:    destination.Address(),
+		}
+	case xdr.OperationTypeChangeTrust:
+		body := op.Body.MustChangeTrustOp()
+		asset := assetResource(body.Line)
+		r.resource = operations.ChangeTrust{
+			Base:    b,
+			Asset:   asset,
+			Limit:   amount.String(body.Limit),
+			Trustee: asset.Issuer,
+			Trustor: b.SourceAccount,
+		}
+	case xdr.OperationTypeAllowTrust:
+		body := op.Body.MustAllowTrustOp()
+		r.accounts = appendAccount(r.accounts, body.Trustor.Address())
+		r.resource = operations.AllowTrust{
+			Base:                           b,
+			Asset:                          assetResource(body.Asset.ToAsset(xdr.MustAddress(b.SourceAccount))),
+			Trustee:                        b.SourceAccount,
+			Trustor:                        body.Trustor.Address(),
+			Authorize:                      xdr.TrustLineFlags(body.Authorize).IsAuthorized(),
+			AuthorizeToMaintainLiabilities: xdr.TrustLineFlags(body.Authorize).IsAuthorizedToMaintainLiabilitiesFlag(),
+		}
+	case xdr.OperationTypeManageData:
+		body := op.Body.MustManageDataOp()
+		resource := operations.ManageData{Base: b, Name: string(body.DataName)}
+		if body.DataValue != nil {
+			resource.Value = base64.StdEncoding.EncodeToString(*body.DataValue)
+		}
+		r.resource = resource
+	case xdr.OperationTypeBumpSequence:
+		r.resource = operations.BumpSequence{
+			Base:   b,
+			BumpTo: strconv.FormatInt(int64(op.Body.MustBumpSequenceOp().BumpTo), 10),
+		}
+	case xdr.OperationTypeSetOptions:
+		r.resource = setOptionsResource(b, op.Body.MustSetOptionsOp())
+	default:
+		r.resource = b
+	}
+	return r
+}
+
+var accountFlagNames = []struct {
+	flag xdr.AccountFlags
+	name string
+}{
+	{xdr.AccountFlagsAuthRequiredFlag, "auth_required"},
+	{xdr.AccountFlagsAuthRevocableFlag, "auth_revocable"},
+	{xdr.AccountFlagsAuthImmutableFlag, "auth_immutable"},
+}
+
+func setOptionsResource(b operations.Base, body xdr.SetOptionsOp) operations.SetOptions {
+	resource := operations.SetOptions{Base: b}
+	if body.HomeDomain != nil {
+		resource.HomeDomain = string(*body.HomeDomain)
+	}
+	if body.InflationDest != nil {
+		resource.InflationDest = body.InflationDest.Address()
+	}
+	optionalInt := func(value *xdr.Uint32) *int {
+		if value == nil {
+			return nil
+		}
+		v := int(*value)
+		return &v
+	}
+	resource.MasterKeyWeight = optionalInt(body.MasterWeight)
+	resource.LowThreshold = optionalInt(body.LowThreshold)
+	resource.MedThreshold = optionalInt(body.MedThreshold)
+	resource.HighThreshold = optionalInt(body.HighThreshold)
+	if body.Signer != nil {
+		resource.SignerKey = body.Signer.Key.Address()
+		resource.SignerWeight = optionalInt(&body.Signer.Weight)
+	}
+	for _, f := range accountFlagNames {
+		if body.SetFlags != nil && xdr.AccountFlags(*body.SetFlags)&f.flag != 0 {
+			resource.SetFlags = append(resource.SetFlags, int(f.flag))
+			resource.SetFlagsS = append(resource.SetFlagsS, f.name)
+		}
+		if body.ClearFlags != nil && xdr.AccountFlags(*body.ClearFlags)&f.flag != 0 {
+			resource.ClearFlags = append(resource.ClearFlags, int(f.flag))
+			resource.ClearFlagsS = append(resource.ClearFlagsS, f.name)
+		}
+	}
+	return resource
+}
+
+func appendAccount(accounts []string, account string) []string {
+	for _, a := range accounts {
+		if a == account {
+			return accounts
+		}
+	}
+	return append(accounts, account)
+}
+
+func assetResource(asset xdr.Asset) base.Asset {
+	var resource base.Asset
+	asset.MustExtract(&resource.Type, &resource.Code, &resource.Issuer)
+	return resource
+}
+
+func signatures(decorated []xdr.DecoratedSignature) []string {
+	encoded := make([]string, 0, len(decorated))
+	for _, signature := range decorated {
+		encoded = append(encoded, base64.StdEncoding.EncodeToString(signature.Signature))
+	}
+	return encoded
+}
+
+func setMemo(transaction *hProtocol.Transaction, memo xdr.Memo) {
+	switch memo.Type {
+	case xdr.MemoTypeMemoText:
+		transaction.MemoType = "text"
+		transaction.Memo = memo.MustText()
+		transaction.MemoBytes = base64.StdEncoding.EncodeToString([]byte(memo.MustText()))
+	case xdr.MemoTypeMemoId:
+		transaction.MemoType = "id"
+		transaction.Memo = strconv.FormatUint(uint64(memo.MustId()), 10)
+	case xdr.MemoTypeMemoHash:
+		hash := memo.MustHash()
+		transaction.MemoType = "hash"
+		transaction.Memo = base64.StdEncoding.EncodeToString(hash[:])
+	case xdr.MemoTypeMemoReturn:
+		hash := memo.MustRetHash()
+		transaction.MemoType = "return"
+		transaction.Memo = base64.StdEncoding.EncodeToString(hash[:])
+	default:
+		transaction.MemoType = "none"
+	}
+}
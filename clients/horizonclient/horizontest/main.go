@@ -0,0 +1,208 @@
+/*
+Package horizontest provides a fake Horizon server for end-to-end tests of code using horizonclient.
+
+A Server is an http.Handler holding an in-memory ledger of accounts, trustlines, offers and data entries. It applies
+the transactions submitted with POST /transactions using txnbuild/simulator, closes a ledger for every transaction
+included in the ledger, and records the transactions, operations and effects of the ledgers. It serves the
+endpoints which horizonclient uses to load accounts and to browse and stream their history:
+
+	GET  /
+	GET  /accounts?signer=...|asset=...
+	GET  /accounts/{account_id}
+	GET  /accounts/{account_id}/data/{key}
+	GET  /accounts/{account_id}/{transactions,operations,payments,effects,offers}
+	GET  /ledgers, /ledgers/{sequence}
+	GET  /transactions, /transactions/{hash}, /transactions/{hash}/{operations,payments,effects}
+	GET  /operations, /operations/{id}
+	GET  /payments
+	GET  /effects
+	GET  /offers?seller=...
+	POST /transactions
+
+The history endpoints support the cursor, order and limit parameters, and stream their records with Server-Sent
+Events when requested with the "Accept: text/event-stream" header.
+
+The fake server has the limitations of the simulator: signatures are not checked and only the operations which the
+simulator supports can be submitted. Transactions containing other operations are rejected with a 400 response.
+*/
+package horizontest
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/txnbuild/simulator"
+	"github.com/stellar/go/xdr"
+)
+
+// TotalCoins is the amount of lumens held by the root account of a new
+// Server.
+const TotalCoins = "100000000000"
+
+// Server is a fake Horizon server. It is an http.Handler, to be served with
+// net/http/httptest:
+//
+//	fake := horizontest.NewServer(network.TestNetworkPassphrase)
+//	server := httptest.NewServer(fake)
+//	defer server.Close()
+//	defer fake.Close()
+//	client := &horizonclient.Client{HorizonURL: server.URL}
+type Server struct {
+	networkPassphrase string
+	baseFee           int64
+	baseReserve       int64
+	router            chi.Router
+	// clock is a Clock returning the close time of the ledgers.
+	clock *clock.Clock
+
+	mutex        sync.Mutex
+	state        *simulator.LedgerState
+	feePool      int64
+	ledgers      []record
+	transactions []record
+	operations   []record
+	effects      []record
+	// ledgerClosed is closed and replaced when a ledger closes, to wake up
+	// the open streams.
+	ledgerClosed chan struct{}
+	done         chan struct{}
+	closeOnce    sync.Once
+}
+
+// NewServer returns a fake Horizon server for the network with the given
+// passphrase. Its ledger contains the root account of the network, which
+// holds TotalCoins lumens and can be used to create other accounts. Its
+// secret key is keypair.Master(networkPassphrase).
+func NewServer(networkPassphrase string) *Server {
+	s := &Server{
+		networkPassphrase: networkPassphrase,
+		baseFee:           txnbuild.MinBaseFee,
+		baseReserve:       simulator.DefaultBaseReserve,
+		clock:             &clock.Clock{},
+		ledgerClosed:      make(chan struct{}),
+		done:              make(chan struct{}),
+	}
+	s.router = s.newRouter()
+
+	root := keypair.Master(networkPassphrase).Address()
+	state, err := simulator.NewLedgerState(newAccountEntry(root, amount.MustParse(TotalCoins), 1))
+	if err != nil {
+		panic(err)
+	}
+	s.state = state
+	s.closeLedger(s.clock.Now(), nil)
+	return s
+}
+
+// AddAccount adds an account holding balance lumens to the ledger, without
+// recording its creation in the history. The lumens do not come from the
+// root account.
+func (s *Server) AddAccount(address, balance string) error {
+	if _, err := keypair.ParseAddress(address); err != nil {
+		return errors.Wrap(err, "invalid address")
+	}
+	stroops, err := amount.ParseInt64(balance)
+	if err != nil {
+		return errors.Wrap(err, "invalid balance")
+	}
+
+	s.mutex.Lock()
+	ledger := s.latestLedger()
+	s.mutex.Unlock()
+	return s.AddEntries(newAccountEntry(address, xdr.Int64(stroops), ledger))
+}
+
+// AddEntries adds ledger entries, like trustlines and offers, to the ledger
+// without recording their creation in the history. An error is returned if
+// one of the entries already exists.
+func (s *Server) AddEntries(entries ...xdr.LedgerEntry) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	state, err := simulator.NewLedgerState(append(s.state.Entries(), entries...)...)
+	if err != nil {
+		return err
+	}
+	s.state = state
+	return nil
+}
+
+// Close ends the open streams of the server.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+func (s *Server) newRouter() chi.Router {
+	r := chi.NewRouter()
+	r.Get("/", s.getRoot)
+
+	r.Get("/accounts", s.getAccounts)
+	r.Get("/accounts/{account_id}", s.getAccount)
+	r.Get("/accounts/{account_id}/data/{key}", s.getAccountData)
+	r.Get("/accounts/{account_id}/transactions", s.getTransactions)
+	r.Get("/accounts/{account_id}/operations", s.getOperations)
+	r.Get("/accounts/{account_id}/payments", s.getPayments)
+	r.Get("/accounts/{account_id}/effects", s.getEffects)
+	r.Get("/accounts/{account_id}/offers", s.getOffers)
+
+	r.Get("/ledgers", s.getLedgers)
+	r.Get("/ledgers/{sequence}", s.getLedger)
+
+	r.Get("/transactions", s.getTransactions)
+	r.Post("/transactions", s.submitTransaction)
+	r.Get("/transactions/{hash}", s.getTransaction)
+	r.Get("/transactions/{hash}/operations", s.getOperations)
+	r.Get("/transactions/{hash}/payments", s.getPayments)
+	r.Get("/transactions/{hash}/effects", s.getEffects)
+
+	r.Get("/operations", s.getOperations)
+	r.Get("/operations/{id}", s.getOperation)
+	r.Get("/payments", s.getPayments)
+	r.Get("/effects", s.getEffects)
+	r.Get("/offers", s.getOffers)
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		renderProblem(w, notFound)
+	})
+	return r
+}
+
+// latestLedger returns the sequence of the last closed ledger. The caller
+// must hold the mutex.
+func (s *Server) latestLedger() int32 {
+	return int32(len(s.ledgers))
+}
+
+func newAccountEntry(address string, balance xdr.Int64, ledger int32) xdr.LedgerEntry {
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: xdr.Uint32(ledger),
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeAccount,
+			Account: &xdr.AccountEntry{
+				AccountId:  xdr.MustAddress(address),
+				Balance:    balance,
+				SeqNum:     xdr.SequenceNumber(int64(ledger) << 32),
+				Thresholds: xdr.Thresholds{1, 0, 0, 0},
+			},
+		},
+	}
+}
+
+// closeTime returns the close time of a new ledger.
+func (s *Server) closeTime() time.Time {
+	return s.clock.Now().UTC().Truncate(time.Second)
+}
@@ -0,0 +1,298 @@
+package horizontest
+
+import (
+	"context"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) (*horizonclient.Client, *Server, func()) {
+	fake := NewServer(network.TestNetworkPassphrase)
+	server := httptest.NewServer(fake)
+	client := &horizonclient.Client{HorizonURL: server.URL + "/"}
+	return client, fake, func() {
+		fake.Close()
+		server.Close()
+	}
+}
+
+func submit(t *testing.T, client *horizonclient.Client, signer *keypair.Full, ops ...txnbuild.Operation) error {
+	account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: signer.Address()})
+	require.NoError(t, err)
+	tx, err := txnbuild.NewTransaction(txnbuild.TransactionParams{
+		SourceAccount:        &account,
+		IncrementSequenceNum: true,
+		Operations:           ops,
+		BaseFee:              txnbuild.MinBaseFee,
+		Timebounds:           txnbuild.NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+	tx, err = tx.Sign(network.TestNetworkPassphrase, signer)
+	require.NoError(t, err)
+	_, err = client.SubmitTransaction(tx)
+	return err
+}
+
+func TestSubmitTransactions(t *testing.T) {
+	client, _, done := newTestClient(t)
+	defer done()
+
+	root := keypair.Master(network.TestNetworkPassphrase).(*keypair.Full)
+	alice := keypair.MustRandom()
+	bob := keypair.MustRandom()
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.CreateAccount{Destination: alice.Address(), Amount: "100"},
+		&txnbuild.CreateAccount{Destination: bob.Address(), Amount: "100"},
+	))
+	require.NoError(t, submit(t, client, alice,
+		&txnbuild.Payment{Destination: bob.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+	))
+
+	err := submit(t, client, alice,
+		&txnbuild.Payment{Destination: bob.Address(), Amount: "1000", Asset: txnbuild.NativeAsset{}},
+	)
+	herr := horizonclient.GetError(err)
+	require.NotNil(t, herr)
+	codes, err := herr.ResultCodes()
+	require.NoError(t, err)
+	assert.Equal(t, "tx_failed", codes.TransactionCode)
+	assert.Equal(t, []string{"op_underfunded"}, codes.OperationCodes)
+
+	account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: alice.Address()})
+	require.NoError(t, err)
+	balance, err := account.GetNativeBalance()
+	require.NoError(t, err)
+	assert.Equal(t, "89.9999800", balance)
+	account, err = client.AccountDetail(horizonclient.AccountRequest{AccountID: bob.Address()})
+	require.NoError(t, err)
+	balance, err = account.GetNativeBalance()
+	require.NoError(t, err)
+	assert.Equal(t, "110.0000000", balance)
+
+	root2, err := client.Root()
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), root2.HorizonSequence)
+
+	txs, err := client.Transactions(horizonclient.TransactionRequest{ForAccount: alice.Address()})
+	require.NoError(t, err)
+	require.Len(t, txs.Embedded.Records, 2)
+	assert.Equal(t, int32(2), txs.Embedded.Records[0].Ledger)
+	assert.Equal(t, int32(3), txs.Embedded.Records[1].Ledger)
+
+	txs, err = client.Transactions(horizonclient.TransactionRequest{ForAccount: alice.Address(), IncludeFailed: true})
+	require.NoError(t, err)
+	require.Len(t, txs.Embedded.Records, 3)
+	assert.False(t, txs.Embedded.Records[2].Successful)
+	assert.Equal(t, "op_underfunded", codesOf(t, txs.Embedded.Records[2].ResultXdr))
+
+	_, err = client.AccountDetail(horizonclient.AccountRequest{AccountID: keypair.MustRandom().Address()})
+	assert.True(t, horizonclient.IsNotFoundError(err))
+}
+
+func codesOf(t *testing.T, resultXDR string) string {
+	var result xdr.TransactionResult
+	require.NoError(t, xdr.SafeUnmarshalBase64(resultXDR, &result))
+	codes := resultCodes(result)
+	require.Len(t, codes.OperationCodes, 1)
+	return codes.OperationCodes[0]
+}
+
+func TestPaging(t *testing.T) {
+	client, _, done := newTestClient(t)
+	defer done()
+
+	root := keypair.Master(network.TestNetworkPassphrase).(*keypair.Full)
+	alice := keypair.MustRandom()
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.CreateAccount{Destination: alice.Address(), Amount: "100"},
+	))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, submit(t, client, root,
+			&txnbuild.Payment{Destination: alice.Address(), Amount: "1", Asset: txnbuild.NativeAsset{}},
+		))
+	}
+
+	page, err := client.Payments(horizonclient.OperationRequest{ForAccount: alice.Address(), Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, page.Embedded.Records, 3)
+	assert.IsType(t, operations.CreateAccount{}, page.Embedded.Records[0])
+	assert.IsType(t, operations.Payment{}, page.Embedded.Records[1])
+
+	page, err = client.NextPaymentsPage(page)
+	require.NoError(t, err)
+	require.Len(t, page.Embedded.Records, 1)
+	last := page.Embedded.Records[0].PagingToken()
+	assert.Equal(t, "21474840577", last)
+
+	page, err = client.NextPaymentsPage(page)
+	require.NoError(t, err)
+	assert.Len(t, page.Embedded.Records, 0)
+
+	page, err = client.Payments(horizonclient.OperationRequest{
+		ForAccount: alice.Address(),
+		Order:      horizonclient.OrderDesc,
+		Cursor:     last,
+	})
+	require.NoError(t, err)
+	assert.Len(t, page.Embedded.Records, 3)
+
+	effects, err := client.Effects(horizonclient.EffectRequest{ForAccount: alice.Address(), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, effects.Embedded.Records, 2)
+	assert.Equal(t, "account_created", effects.Embedded.Records[0].GetType())
+	assert.Equal(t, "signer_created", effects.Embedded.Records[1].GetType())
+
+	effects, err = client.Effects(horizonclient.EffectRequest{
+		ForAccount: alice.Address(),
+		Cursor:     effects.Embedded.Records[1].PagingToken(),
+	})
+	require.NoError(t, err)
+	require.Len(t, effects.Embedded.Records, 3)
+	for _, effect := range effects.Embedded.Records {
+		assert.Equal(t, "account_credited", effect.GetType())
+	}
+}
+
+func TestTrustlines(t *testing.T) {
+	client, _, done := newTestClient(t)
+	defer done()
+
+	root := keypair.Master(network.TestNetworkPassphrase).(*keypair.Full)
+	issuer := keypair.MustRandom()
+	holder := keypair.MustRandom()
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.CreateAccount{Destination: issuer.Address(), Amount: "100"},
+		&txnbuild.CreateAccount{Destination: holder.Address(), Amount: "100"},
+	))
+	usd := txnbuild.CreditAsset{Code: "USD", Issuer: issuer.Address()}
+	require.NoError(t, submit(t, client, holder, &txnbuild.ChangeTrust{Line: usd, Limit: "1000"}))
+	require.NoError(t, submit(t, client, issuer,
+		&txnbuild.Payment{Destination: holder.Address(), Amount: "25", Asset: usd},
+	))
+
+	account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: holder.Address()})
+	require.NoError(t, err)
+	require.Len(t, account.Balances, 2)
+	assert.Equal(t, "USD", account.Balances[0].Code)
+	assert.Equal(t, "25.0000000", account.Balances[0].Balance)
+	assert.Equal(t, "1000.0000000", account.Balances[0].Limit)
+	assert.Equal(t, "native", account.Balances[1].Type)
+	assert.Equal(t, int32(1), account.SubentryCount)
+
+	accounts, err := client.Accounts(horizonclient.AccountsRequest{Asset: "USD:" + issuer.Address()})
+	require.NoError(t, err)
+	require.Len(t, accounts.Embedded.Records, 1)
+	assert.Equal(t, holder.Address(), accounts.Embedded.Records[0].AccountID)
+
+	err = submit(t, client, holder,
+		&txnbuild.ManageSellOffer{Selling: usd, Buying: txnbuild.NativeAsset{}, Amount: "1", Price: "1"},
+	)
+	herr := horizonclient.GetError(err)
+	require.NotNil(t, herr)
+	assert.Equal(t, 400, herr.Problem.Status)
+}
+
+func TestAccountsPaging(t *testing.T) {
+	client, _, done := newTestClient(t)
+	defer done()
+
+	root := keypair.Master(network.TestNetworkPassphrase).(*keypair.Full)
+	issuer := keypair.MustRandom()
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.CreateAccount{Destination: issuer.Address(), Amount: "100"},
+	))
+	usd := txnbuild.CreditAsset{Code: "USD", Issuer: issuer.Address()}
+	var holders []string
+	for i := 0; i < 3; i++ {
+		holder := keypair.MustRandom()
+		require.NoError(t, submit(t, client, root,
+			&txnbuild.CreateAccount{Destination: holder.Address(), Amount: "100"},
+		))
+		require.NoError(t, submit(t, client, holder, &txnbuild.ChangeTrust{Line: usd, Limit: "1000"}))
+		holders = append(holders, holder.Address())
+	}
+	sort.Strings(holders)
+
+	page, err := client.Accounts(horizonclient.AccountsRequest{Asset: "USD:" + issuer.Address(), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Embedded.Records, 2)
+	assert.Equal(t, holders[0], page.Embedded.Records[0].AccountID)
+	assert.Equal(t, holders[1], page.Embedded.Records[1].AccountID)
+
+	page, err = client.NextAccountsPage(page)
+	require.NoError(t, err)
+	require.Len(t, page.Embedded.Records, 1)
+	assert.Equal(t, holders[2], page.Embedded.Records[0].AccountID)
+
+	page, err = client.NextAccountsPage(page)
+	require.NoError(t, err)
+	assert.Len(t, page.Embedded.Records, 0)
+
+	page, err = client.Accounts(horizonclient.AccountsRequest{
+		Asset:  "USD:" + issuer.Address(),
+		Order:  horizonclient.OrderDesc,
+		Cursor: holders[2],
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Embedded.Records, 2)
+	assert.Equal(t, holders[1], page.Embedded.Records[0].AccountID)
+	assert.Equal(t, holders[0], page.Embedded.Records[1].AccountID)
+}
+
+func TestStreamPayments(t *testing.T) {
+	client, _, done := newTestClient(t)
+	defer done()
+
+	root := keypair.Master(network.TestNetworkPassphrase).(*keypair.Full)
+	alice := keypair.MustRandom()
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.CreateAccount{Destination: alice.Address(), Amount: "100"},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	received := make(chan operations.Operation)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- client.StreamPayments(ctx, horizonclient.OperationRequest{
+			ForAccount: alice.Address(),
+			Cursor:     "0",
+		}, func(op operations.Operation) {
+			received <- op
+		})
+	}()
+
+	// the stream starts with the past payments
+	select {
+	case op := <-received:
+		assert.IsType(t, operations.CreateAccount{}, op)
+	case <-ctx.Done():
+		t.Fatal("account creation was not streamed")
+	}
+
+	require.NoError(t, submit(t, client, root,
+		&txnbuild.Payment{Destination: alice.Address(), Amount: "5", Asset: txnbuild.NativeAsset{}},
+	))
+	select {
+	case op := <-received:
+		payment, ok := op.(operations.Payment)
+		require.True(t, ok)
+		assert.Equal(t, "5.0000000", payment.Amount)
+		assert.Equal(t, alice.Address(), payment.To)
+	case <-ctx.Done():
+		t.Fatal("payment was not streamed")
+	}
+	cancel()
+	assert.NoError(t, <-streamErr)
+}
@@ -0,0 +1,233 @@
+package horizontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/stellar/go/support/render/hal"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 200
+)
+
+// position is the position of a record in its collection.
+type position struct {
+	id    int64
+	index int
+}
+
+func (p position) before(other position) bool {
+	return p.id < other.id || (p.id == other.id && p.index < other.index)
+}
+
+// pageQuery is the query of a page of a collection.
+type pageQuery struct {
+	cursor        string
+	after         position
+	order         string
+	limit         int
+	includeFailed bool
+}
+
+// parsePageQuery parses the paging parameters of a request. The caller must
+// hold the mutex, to resolve the "now" cursor.
+func (s *Server) parsePageQuery(r *http.Request) (pageQuery, error) {
+	values := r.URL.Query()
+	query := pageQuery{
+		cursor:        values.Get("cursor"),
+		includeFailed: values.Get("include_failed") == "true",
+	}
+	var err error
+	query.order, query.limit, err = parseOrderAndLimit(values)
+	if err != nil {
+		return query, err
+	}
+
+	switch {
+	case query.cursor == "now":
+		query.after = position{id: toid(s.latestLedger()+1, 0, 0) - 1}
+	case query.cursor != "":
+		query.after, err = parseCursor(query.cursor)
+		if err != nil {
+			return query, err
+		}
+	case query.order == "asc":
+		query.after = position{id: math.MinInt64}
+	default:
+		query.after = position{id: math.MaxInt64}
+	}
+	return query, nil
+}
+
+// parseOrderAndLimit parses the order and limit parameters of a request,
+// for the collections whose cursors are not positions.
+func parseOrderAndLimit(values url.Values) (string, int, error) {
+	order := values.Get("order")
+	switch order {
+	case "":
+		order = "asc"
+	case "asc", "desc":
+	default:
+		return "", 0, fmt.Errorf("order must be asc or desc")
+	}
+
+	limit := defaultLimit
+	if value := values.Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return "", 0, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+		}
+	}
+	return order, limit, nil
+}
+
+// parseCursor parses the paging token of a record: a number, or a number and
+// an index separated by a dash for effects.
+func parseCursor(cursor string) (position, error) {
+	parts := strings.SplitN(cursor, "-", 2)
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return position{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	p := position{id: id}
+	if len(parts) == 2 {
+		if p.index, err = strconv.Atoi(parts[1]); err != nil {
+			return position{}, fmt.Errorf("invalid cursor %q", cursor)
+		}
+	}
+	return p, nil
+}
+
+// selectRecords returns the records of the page of query, among the records
+// accepted by filter.
+func selectRecords(records []record, query pageQuery, filter func(record) bool) []record {
+	var selected []record
+	accept := func(r record) bool {
+		return (r.successful || query.includeFailed) && (filter == nil || filter(r))
+	}
+	if query.order == "asc" {
+		for _, r := range records {
+			if len(selected) == query.limit {
+				break
+			}
+			if query.after.before(r.position()) && accept(r) {
+				selected = append(selected, r)
+			}
+		}
+		return selected
+	}
+	for i := len(records) - 1; i >= 0 && len(selected) < query.limit; i-- {
+		if records[i].position().before(query.after) && accept(records[i]) {
+			selected = append(selected, records[i])
+		}
+	}
+	return selected
+}
+
+// serveCollection serves a page of a collection, or streams the collection
+// if the request accepts Server-Sent Events. collection returns the records
+// of the collection, it is called with the mutex held.
+func (s *Server) serveCollection(
+	w http.ResponseWriter,
+	r *http.Request,
+	collection func() []record,
+	filter func(record) bool,
+) {
+	s.mutex.Lock()
+	query, err := s.parsePageQuery(r)
+	if err != nil {
+		s.mutex.Unlock()
+		renderProblem(w, badRequest(err.Error()))
+		return
+	}
+	if r.Header.Get("Accept") == "text/event-stream" {
+		s.mutex.Unlock()
+		if query.order != "asc" {
+			renderProblem(w, badRequest("streams must be in ascending order"))
+			return
+		}
+		s.stream(w, r, query, collection, filter)
+		return
+	}
+	records := selectRecords(collection(), query, filter)
+	s.mutex.Unlock()
+
+	page := hal.Page{
+		Order:  query.order,
+		Limit:  uint64(query.limit),
+		Cursor: query.cursor,
+	}
+	page.FullURL = requestURL(r)
+	for _, record := range records {
+		page.Add(record.resource)
+	}
+	page.PopulateLinks()
+	hal.Render(w, page)
+}
+
+// stream writes the records of a collection after the cursor of query as
+// Server-Sent Events, then the new records as ledgers close, until the client
+// goes away or the server is closed.
+func (s *Server) stream(
+	w http.ResponseWriter,
+	r *http.Request,
+	query pageQuery,
+	collection func() []record,
+	filter func(record) bool,
+) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		renderProblem(w, badRequest("streaming is not supported"))
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 1000\nevent: open\ndata: \"hello\"\n\n")
+	flusher.Flush()
+
+	query.limit = math.MaxInt32
+	for {
+		s.mutex.Lock()
+		records := selectRecords(collection(), query, filter)
+		ledgerClosed := s.ledgerClosed
+		s.mutex.Unlock()
+
+		for _, record := range records {
+			data, err := json.Marshal(record.resource)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(w, "id: %s\ndata: %s\n\n", record.resource.PagingToken(), data)
+			query.after = record.position()
+		}
+		flusher.Flush()
+
+		select {
+		case <-ledgerClosed:
+		case <-r.Context().Done():
+			return
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// requestURL returns the absolute URL of a request.
+func requestURL(r *http.Request) *url.URL {
+	u := *r.URL
+	u.Host = r.Host
+	u.Scheme = "http"
+	if r.TLS != nil {
+		u.Scheme = "https"
+	}
+	return &u
+}
@@ -0,0 +1,155 @@
+package horizontest
+
+import (
+	"net/http"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/txnbuild/simulator"
+	"github.com/stellar/go/xdr"
+)
+
+// submitTransaction applies the transaction in the tx form value to the
+// ledger. The transaction is included in a new ledger unless it is rejected
+// before being charged a fee, like horizon it is rendered when it succeeds
+// and a transaction_failed problem is rendered otherwise.
+func (s *Server) submitTransaction(w http.ResponseWriter, r *http.Request) {
+	raw := r.FormValue("tx")
+	tx, err := s.parseTransaction(raw)
+	if err != nil {
+		renderProblem(w, problem.P{
+			Type:   "https://stellar.org/horizon-errors/transaction_malformed",
+			Title:  "Transaction Malformed",
+			Status: http.StatusBadRequest,
+			Detail: "Horizon could not decode the transaction envelope in this request.",
+			Extras: map[string]interface{}{
+				"envelope_xdr": raw,
+			},
+		})
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	state, err := s.state.Clone()
+	if err != nil {
+		renderProblem(w, serverError(err))
+		return
+	}
+	closeTime := s.closeTime()
+	sim := simulator.Simulator{
+		NetworkPassphrase: s.networkPassphrase,
+		BaseFee:           s.baseFee,
+		BaseReserve:       s.baseReserve,
+		LedgerSequence:    uint32(s.latestLedger() + 1),
+		CloseTime:         closeTime,
+	}
+	if tx.feeBump != nil {
+		tx.result, err = sim.SimulateFeeBump(state, tx.feeBump)
+	} else {
+		tx.result, err = sim.Simulate(state, tx.tx)
+	}
+	if errors.Cause(err) == simulator.ErrUnsupportedOperation {
+		renderProblem(w, badRequest(err.Error()))
+		return
+	} else if err != nil {
+		renderProblem(w, serverError(err))
+		return
+	}
+
+	if len(tx.result.FeeChanges) == 0 {
+		// the transaction is rejected without being included in a ledger
+		s.renderFailedTransaction(w, tx.appliedTransaction)
+		return
+	}
+
+	s.state = state
+	transaction, err := s.closeLedger(closeTime, &tx.appliedTransaction)
+	if err != nil {
+		renderProblem(w, serverError(err))
+		return
+	}
+	if !transaction.Successful {
+		s.renderFailedTransaction(w, tx.appliedTransaction)
+		return
+	}
+	hal.Render(w, transaction)
+}
+
+// submittedTransaction is a transaction being submitted.
+type submittedTransaction struct {
+	appliedTransaction
+	// tx or feeBump is the parsed transaction.
+	tx      *txnbuild.Transaction
+	feeBump *txnbuild.FeeBumpTransaction
+}
+
+// parseTransaction decodes a transaction envelope and computes its hash.
+func (s *Server) parseTransaction(raw string) (submittedTransaction, error) {
+	generic, err := txnbuild.TransactionFromXDR(raw)
+	if err != nil {
+		return submittedTransaction{}, err
+	}
+	tx := submittedTransaction{
+		appliedTransaction: appliedTransaction{envelopeXDR: raw},
+	}
+
+	if feeBump, ok := generic.FeeBump(); ok {
+		tx.feeBump = feeBump
+		if tx.envelope, err = feeBump.TxEnvelope(); err != nil {
+			return tx, err
+		}
+		if tx.hash, err = feeBump.HashHex(s.networkPassphrase); err != nil {
+			return tx, err
+		}
+		tx.innerHash, err = feeBump.InnerTransaction().HashHex(s.networkPassphrase)
+		return tx, err
+	}
+
+	inner, ok := generic.Transaction()
+	if !ok {
+		return tx, errors.New("invalid transaction envelope")
+	}
+	tx.tx = inner
+	if tx.envelope, err = inner.TxEnvelope(); err != nil {
+		return tx, err
+	}
+	tx.hash, err = inner.HashHex(s.networkPassphrase)
+	return tx, err
+}
+
+// renderFailedTransaction renders the transaction_failed problem of a
+// transaction which did not succeed.
+func (s *Server) renderFailedTransaction(w http.ResponseWriter, tx appliedTransaction) {
+	resultXDR, err := xdr.MarshalBase64(tx.result.TransactionResult)
+	if err != nil {
+		renderProblem(w, serverError(err))
+		return
+	}
+	renderProblem(w, problem.P{
+		Type:   "https://stellar.org/horizon-errors/transaction_failed",
+		Title:  "Transaction Failed",
+		Status: http.StatusBadRequest,
+		Detail: "The transaction failed when submitted to the stellar network. " +
+			"The `extras.result_codes` field on this response contains further " +
+			"details.",
+		Extras: map[string]interface{}{
+			"envelope_xdr": tx.envelopeXDR,
+			"result_xdr":   resultXDR,
+			"result_codes": resultCodes(tx.result.TransactionResult),
+			"hash":         tx.hash,
+		},
+	})
+}
+
+func serverError(err error) problem.P {
+	return problem.P{
+		Type:   "https://stellar.org/horizon-errors/server_error",
+		Title:  "Internal Server Error",
+		Status: http.StatusInternalServerError,
+		Detail: err.Error(),
+	}
+}
@@ -0,0 +1,440 @@
+package horizonclient
+
+import (
+	"context"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+)
+
+// IteratorOptions configures the iterators returned by the Iterate* methods of
+// Client.
+type IteratorOptions struct {
+	// MaxItems is the maximum number of records returned by the iterator. There
+	// is no maximum if it is zero.
+	MaxItems int
+	// Prefetch makes the iterator fetch the next page in the background while
+	// the records of the current page are consumed.
+	Prefetch bool
+}
+
+// recordsPage is a page of records fetched by an Iterator.
+type recordsPage struct {
+	records []interface{}
+	next    string
+	err     error
+}
+
+// Iterator walks over all the records of a collection endpoint, following the
+// next links of the pages returned by Horizon. Pages are fetched lazily, when
+// the records of the previous page were consumed. Iterators are not safe for
+// concurrent use.
+//
+// Iterator is embedded in the typed iterators of every collection, which
+// return the current record:
+//
+//	it := client.IterateTransactions(ctx, horizonclient.TransactionRequest{ForAccount: address}, horizonclient.IteratorOptions{})
+//	defer it.Close()
+//	for it.Next() {
+//		tx := it.Transaction()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	options IteratorOptions
+	fetch   func(ctx context.Context, url string) recordsPage
+
+	nextURL    string
+	records    []interface{}
+	current    interface{}
+	count      int
+	err        error
+	done       bool
+	prefetched chan recordsPage
+}
+
+func newIterator(
+	ctx context.Context,
+	options IteratorOptions,
+	firstURL string,
+	fetch func(ctx context.Context, url string) recordsPage,
+) Iterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return Iterator{
+		ctx:     ctx,
+		cancel:  cancel,
+		options: options,
+		fetch:   fetch,
+		nextURL: firstURL,
+	}
+}
+
+// Next advances the iterator to the next record, fetching the next page if
+// needed. It returns false when there are no more records, when MaxItems
+// records were returned, or when an error occurred, which is then returned by
+// Err.
+func (it *Iterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.options.MaxItems > 0 && it.count >= it.options.MaxItems {
+		it.stop(nil)
+		return false
+	}
+
+	for len(it.records) == 0 {
+		if it.nextURL == "" {
+			it.stop(nil)
+			return false
+		}
+		url := it.nextURL
+		page := it.load()
+		if page.err != nil {
+			if ctxErr := it.ctx.Err(); ctxErr != nil {
+				page.err = ctxErr
+			}
+			it.stop(page.err)
+			return false
+		}
+		// Horizon returns an empty page past the last record
+		if len(page.records) == 0 {
+			it.stop(nil)
+			return false
+		}
+		it.records = page.records
+		it.nextURL = page.next
+		if it.nextURL == url {
+			it.nextURL = ""
+		}
+		if it.options.Prefetch && it.nextURL != "" &&
+			(it.options.MaxItems == 0 || it.count+len(it.records) < it.options.MaxItems) {
+			it.prefetch()
+		}
+	}
+
+	it.current = it.records[0]
+	it.records = it.records[1:]
+	it.count++
+	return true
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator and cancels the fetching of the next page. Next
+// returns false after Close was called.
+func (it *Iterator) Close() {
+	it.stop(nil)
+}
+
+func (it *Iterator) stop(err error) {
+	if it.done {
+		return
+	}
+	it.done = true
+	it.err = err
+	it.records = nil
+	it.current = nil
+	if it.cancel != nil {
+		it.cancel()
+	}
+}
+
+// load returns the next page, waiting for it if it is being prefetched.
+func (it *Iterator) load() recordsPage {
+	if it.prefetched != nil {
+		page := <-it.prefetched
+		it.prefetched = nil
+		return page
+	}
+	if err := it.ctx.Err(); err != nil {
+		return recordsPage{err: err}
+	}
+	return it.fetch(it.ctx, it.nextURL)
+}
+
+func (it *Iterator) prefetch() {
+	// the channel is buffered so the goroutine does not leak if the iterator
+	// is closed before the page is consumed
+	prefetched := make(chan recordsPage, 1)
+	url := it.nextURL
+	go func() {
+		prefetched <- it.fetch(it.ctx, url)
+	}()
+	it.prefetched = prefetched
+}
+
+// iterate returns an Iterator over the records of request. records returns
+// the records of a page decoded into page, which is reset before every
+// request.
+func (c *Client) iterate(
+	ctx context.Context,
+	request HorizonRequest,
+	options IteratorOptions,
+	page interface{},
+	records func() ([]interface{}, string),
+) Iterator {
+	endpoint, err := request.BuildURL()
+	if err != nil {
+		it := newIterator(ctx, options, "", nil)
+		it.stop(err)
+		return it
+	}
+	c.HorizonURL = c.fixHorizonURL()
+
+	return newIterator(ctx, options, c.HorizonURL+endpoint, func(ctx context.Context, url string) recordsPage {
+		err := c.sendRequestURLContext(ctx, url, "get", page)
+		if err != nil {
+			return recordsPage{err: err}
+		}
+		list, next := records()
+		return recordsPage{records: list, next: next}
+	})
+}
+
+// AccountsIterator iterates over the accounts matching an AccountsRequest.
+type AccountsIterator struct {
+	Iterator
+}
+
+// Account returns the current account.
+func (it *AccountsIterator) Account() hProtocol.Account {
+	record, _ := it.current.(hProtocol.Account)
+	return record
+}
+
+// IterateAccounts returns an iterator over all the accounts matching request.
+func (c *Client) IterateAccounts(ctx context.Context, request AccountsRequest, options IteratorOptions) *AccountsIterator {
+	var page hProtocol.AccountsPage
+	return &AccountsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.AccountsPage{}
+		return records, next
+	})}
+}
+
+// AssetsIterator iterates over the assets matching an AssetRequest.
+type AssetsIterator struct {
+	Iterator
+}
+
+// Asset returns the current asset.
+func (it *AssetsIterator) Asset() hProtocol.AssetStat {
+	record, _ := it.current.(hProtocol.AssetStat)
+	return record
+}
+
+// IterateAssets returns an iterator over all the assets matching request.
+func (c *Client) IterateAssets(ctx context.Context, request AssetRequest, options IteratorOptions) *AssetsIterator {
+	var page hProtocol.AssetsPage
+	return &AssetsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.AssetsPage{}
+		return records, next
+	})}
+}
+
+// LedgersIterator iterates over the ledgers matching a LedgerRequest.
+type LedgersIterator struct {
+	Iterator
+}
+
+// Ledger returns the current ledger.
+func (it *LedgersIterator) Ledger() hProtocol.Ledger {
+	record, _ := it.current.(hProtocol.Ledger)
+	return record
+}
+
+// IterateLedgers returns an iterator over all the ledgers matching request.
+func (c *Client) IterateLedgers(ctx context.Context, request LedgerRequest, options IteratorOptions) *LedgersIterator {
+	var page hProtocol.LedgersPage
+	return &LedgersIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.LedgersPage{}
+		return records, next
+	})}
+}
+
+// EffectsIterator iterates over the effects matching an EffectRequest.
+type EffectsIterator struct {
+	Iterator
+}
+
+// Effect returns the current effect.
+func (it *EffectsIterator) Effect() effects.Effect {
+	record, _ := it.current.(effects.Effect)
+	return record
+}
+
+// IterateEffects returns an iterator over all the effects matching request.
+func (c *Client) IterateEffects(ctx context.Context, request EffectRequest, options IteratorOptions) *EffectsIterator {
+	var page effects.EffectsPage
+	return &EffectsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = effects.EffectsPage{}
+		return records, next
+	})}
+}
+
+// TransactionsIterator iterates over the transactions matching a
+// TransactionRequest.
+type TransactionsIterator struct {
+	Iterator
+}
+
+// Transaction returns the current transaction.
+func (it *TransactionsIterator) Transaction() hProtocol.Transaction {
+	record, _ := it.current.(hProtocol.Transaction)
+	return record
+}
+
+// IterateTransactions returns an iterator over all the transactions matching
+// request.
+func (c *Client) IterateTransactions(ctx context.Context, request TransactionRequest, options IteratorOptions) *TransactionsIterator {
+	var page hProtocol.TransactionsPage
+	return &TransactionsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.TransactionsPage{}
+		return records, next
+	})}
+}
+
+// OperationsIterator iterates over the operations, or the payments, matching
+// an OperationRequest.
+type OperationsIterator struct {
+	Iterator
+}
+
+// Operation returns the current operation.
+func (it *OperationsIterator) Operation() operations.Operation {
+	record, _ := it.current.(operations.Operation)
+	return record
+}
+
+// IterateOperations returns an iterator over all the operations matching
+// request.
+func (c *Client) IterateOperations(ctx context.Context, request OperationRequest, options IteratorOptions) *OperationsIterator {
+	return c.iterateOperations(ctx, request.SetOperationsEndpoint(), options)
+}
+
+// IteratePayments returns an iterator over all the payments matching request.
+func (c *Client) IteratePayments(ctx context.Context, request OperationRequest, options IteratorOptions) *OperationsIterator {
+	return c.iterateOperations(ctx, request.SetPaymentsEndpoint(), options)
+}
+
+func (c *Client) iterateOperations(ctx context.Context, request HorizonRequest, options IteratorOptions) *OperationsIterator {
+	var page operations.OperationsPage
+	return &OperationsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = operations.OperationsPage{}
+		return records, next
+	})}
+}
+
+// OffersIterator iterates over the offers matching an OfferRequest.
+type OffersIterator struct {
+	Iterator
+}
+
+// Offer returns the current offer.
+func (it *OffersIterator) Offer() hProtocol.Offer {
+	record, _ := it.current.(hProtocol.Offer)
+	return record
+}
+
+// IterateOffers returns an iterator over all the offers matching request.
+func (c *Client) IterateOffers(ctx context.Context, request OfferRequest, options IteratorOptions) *OffersIterator {
+	var page hProtocol.OffersPage
+	return &OffersIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.OffersPage{}
+		return records, next
+	})}
+}
+
+// TradesIterator iterates over the trades matching a TradeRequest.
+type TradesIterator struct {
+	Iterator
+}
+
+// Trade returns the current trade.
+func (it *TradesIterator) Trade() hProtocol.Trade {
+	record, _ := it.current.(hProtocol.Trade)
+	return record
+}
+
+// IterateTrades returns an iterator over all the trades matching request.
+func (c *Client) IterateTrades(ctx context.Context, request TradeRequest, options IteratorOptions) *TradesIterator {
+	var page hProtocol.TradesPage
+	return &TradesIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.TradesPage{}
+		return records, next
+	})}
+}
+
+// TradeAggregationsIterator iterates over the trade aggregations matching a
+// TradeAggregationRequest.
+type TradeAggregationsIterator struct {
+	Iterator
+}
+
+// TradeAggregation returns the current trade aggregation.
+func (it *TradeAggregationsIterator) TradeAggregation() hProtocol.TradeAggregation {
+	record, _ := it.current.(hProtocol.TradeAggregation)
+	return record
+}
+
+// IterateTradeAggregations returns an iterator over all the trade aggregations
+// matching request.
+func (c *Client) IterateTradeAggregations(ctx context.Context, request TradeAggregationRequest, options IteratorOptions) *TradeAggregationsIterator {
+	var page hProtocol.TradeAggregationsPage
+	return &TradeAggregationsIterator{c.iterate(ctx, request, options, &page, func() ([]interface{}, string) {
+		records := make([]interface{}, len(page.Embedded.Records))
+		for i, record := range page.Embedded.Records {
+			records[i] = record
+		}
+		next := page.Links.Next.Href
+		page = hProtocol.TradeAggregationsPage{}
+		return records, next
+	})}
+}
@@ -0,0 +1,158 @@
+package horizonclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transactionsPage returns a page of transactions with the given paging
+// tokens, linking to next.
+func transactionsPage(next string, tokens ...string) string {
+	records := make([]string, len(tokens))
+	for i, token := range tokens {
+		records[i] = fmt.Sprintf(`{"id": "tx%s", "paging_token": "%s", "hash": "hash%s"}`, token, token, token)
+	}
+	return fmt.Sprintf(`{
+  "_links": {"next": {"href": "%s"}},
+  "_embedded": {"records": [%s]}
+}`, next, strings.Join(records, ","))
+}
+
+func mockTransactionPages(hmock *httptest.Client) *int {
+	calls := 0
+	count := func(body string) func() string {
+		return func() string {
+			calls++
+			return body
+		}
+	}
+	pages := []struct {
+		url  string
+		body func() string
+	}{
+		{"https://localhost/transactions?limit=2", count(transactionsPage("https://localhost/transactions?cursor=2&limit=2", "1", "2"))},
+		{"https://localhost/transactions?cursor=2&limit=2", count(transactionsPage("https://localhost/transactions?cursor=4&limit=2", "3", "4"))},
+		{"https://localhost/transactions?cursor=4&limit=2", count(transactionsPage("https://localhost/transactions?cursor=4&limit=2"))},
+	}
+	for _, page := range pages {
+		body := page.body
+		hmock.On("GET", page.url).Return(func(req *http.Request) (*http.Response, error) {
+			return stringResponder(200, body())(req)
+		})
+	}
+	return &calls
+}
+
+func collectTransactions(it *TransactionsIterator) []string {
+	var hashes []string
+	for it.Next() {
+		hashes = append(hashes, it.Transaction().Hash)
+	}
+	return hashes
+}
+
+func TestIterateTransactions(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		hmock := httptest.NewClient()
+		client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+		calls := mockTransactionPages(hmock)
+
+		it := client.IterateTransactions(context.Background(), TransactionRequest{Limit: 2}, IteratorOptions{Prefetch: prefetch})
+		assert.Equal(t, []string{"hash1", "hash2", "hash3", "hash4"}, collectTransactions(it))
+		assert.NoError(t, it.Err())
+		assert.Equal(t, 3, *calls)
+		assert.False(t, it.Next())
+	}
+}
+
+func TestIterateTransactionsMaxItems(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		hmock := httptest.NewClient()
+		client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+		calls := mockTransactionPages(hmock)
+
+		it := client.IterateTransactions(context.Background(), TransactionRequest{Limit: 2}, IteratorOptions{
+			MaxItems: 3,
+			Prefetch: prefetch,
+		})
+		assert.Equal(t, []string{"hash1", "hash2", "hash3"}, collectTransactions(it))
+		assert.NoError(t, it.Err())
+		// the page after the last item is not fetched
+		assert.Equal(t, 2, *calls)
+	}
+}
+
+func TestIterateTransactionsErrors(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+	hmock.On("GET", "https://localhost/transactions?limit=2").
+		Return(stringResponder(200, transactionsPage("https://localhost/transactions?cursor=2&limit=2", "1", "2")))
+	hmock.On("GET", "https://localhost/transactions?cursor=2&limit=2").
+		Return(stringResponder(503, serviceUnavailableResponse))
+
+	it := client.IterateTransactions(context.Background(), TransactionRequest{Limit: 2}, IteratorOptions{})
+	assert.Equal(t, []string{"hash1", "hash2"}, collectTransactions(it))
+	if assert.Error(t, it.Err()) {
+		hErr := GetError(it.Err())
+		require.NotNil(t, hErr)
+		assert.Equal(t, 503, hErr.Response.StatusCode)
+	}
+
+	// the context is checked before fetching a page
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = client.IterateTransactions(ctx, TransactionRequest{Limit: 2}, IteratorOptions{})
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+
+	// invalid requests fail on the first call to Next
+	accounts := client.IterateAccounts(context.Background(), AccountsRequest{}, IteratorOptions{})
+	assert.False(t, accounts.Next())
+	assert.EqualError(t, accounts.Err(), "invalid request: no parameters - Signer or Asset must be provided")
+}
+
+func TestIteratorClose(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+	calls := mockTransactionPages(hmock)
+
+	it := client.IterateTransactions(context.Background(), TransactionRequest{Limit: 2}, IteratorOptions{})
+	require.True(t, it.Next())
+	assert.Equal(t, "hash1", it.Transaction().Hash)
+	it.Close()
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 1, *calls)
+}
+
+func TestIterateEffects(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{HorizonURL: "https://localhost/", HTTP: hmock}
+	hmock.On("GET", "https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/effects").
+		Return(stringResponder(200, effectsResponse))
+	hmock.On("GET", "https://horizon-testnet.stellar.org/operations/43989725060534273/effects?cursor=43989725060534273-3&limit=10&order=asc").
+		Return(stringResponder(200, `{"_links": {}, "_embedded": {"records": []}}`))
+
+	it := client.IterateEffects(context.Background(), EffectRequest{
+		ForAccount: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	}, IteratorOptions{})
+	require.True(t, it.Next())
+	debited, ok := it.Effect().(effects.AccountDebited)
+	require.True(t, ok, "effects are decoded to their concrete type")
+	assert.Equal(t, "9999.9999900", debited.Amount)
+
+	types := []string{it.Effect().GetType()}
+	for it.Next() {
+		types = append(types, it.Effect().GetType())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"account_debited", "account_credited", "account_removed"}, types)
+}
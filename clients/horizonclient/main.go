@@ -144,6 +144,13 @@ type Client struct {
 
 	// clock is a Clock returning the current time.
 	clock *clock.Clock
+
+	retryPolicy *RetryPolicy
+	// sleep pauses between retries, time.Sleep if nil.
+	sleep func(time.Duration)
+
+	cache Cache
+	hooks Hooks
 }
 
 // SubmitTxOpts represents the submit transaction options
@@ -156,6 +163,8 @@ type ClientInterface interface {
 	Accounts(request AccountsRequest) (hProtocol.AccountsPage, error)
 	AccountDetail(request AccountRequest) (hProtocol.Account, error)
 	AccountData(request AccountRequest) (hProtocol.AccountData, error)
+	ClaimableBalances(request ClaimableBalanceRequest) (hProtocol.ClaimableBalancesPage, error)
+	ClaimableBalance(id string) (hProtocol.ClaimableBalance, error)
 	Effects(request EffectRequest) (effects.EffectsPage, error)
 	Assets(request AssetRequest) (hProtocol.AssetsPage, error)
 	Ledgers(request LedgerRequest) (hProtocol.LedgersPage, error)
@@ -175,6 +184,7 @@ type ClientInterface interface {
 	OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error)
 	Paths(request PathsRequest) (hProtocol.PathsPage, error)
 	Payments(request OperationRequest) (operations.OperationsPage, error)
+	SearchOperations(request OperationSearchRequest) (operations.OperationsPage, error)
 	TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error)
 	Trades(request TradeRequest) (hProtocol.TradesPage, error)
 	Fund(addr string) (hProtocol.Transaction, error)
@@ -282,6 +292,20 @@ type LedgerRequest struct {
 	forSequence uint32
 }
 
+// ClaimableBalanceRequest struct contains data for getting claimable balances from a horizon server.
+// If ID is set, a single claimable balance is requested. Otherwise at least one of the filters
+// (Asset, Sponsor or Claimant) must be set. Asset uses the "native" or "code:issuer" format.
+// The query parameters (Order, Cursor and Limit) are optional. All or none can be set.
+type ClaimableBalanceRequest struct {
+	ID       string
+	Asset    string
+	Sponsor  string
+	Claimant string
+	Order    Order
+	Cursor   string
+	Limit    uint
+}
+
 type feeStatsRequest struct {
 	endpoint string
 }
@@ -316,6 +340,29 @@ type OperationRequest struct {
 	endpoint       string
 }
 
+// OperationSearchRequest struct contains data for searching operations on a horizon server.
+// At least one of the filters (ForAccount, MemoType, Asset, MinAmount, MaxAmount, OperationTypes,
+// StartTime or EndTime) must be set. Memo must be set together with MemoType, which is one of
+// "text", "id", "hash" or "return". Asset uses the "native" or "code:issuer" format and
+// OperationTypes uses the operation type names, e.g. "path_payment_strict_send".
+// The query parameters (Order, Cursor, Limit and IncludeFailed) are optional. All or none can be set.
+type OperationSearchRequest struct {
+	ForAccount     string
+	MemoType       string
+	Memo           string
+	Asset          string
+	MinAmount      string
+	MaxAmount      string
+	OperationTypes []string
+	StartTime      time.Time
+	EndTime        time.Time
+	Order          Order
+	Cursor         string
+	Limit          uint
+	IncludeFailed  bool
+	Join           string
+}
+
 type submitRequest struct {
 	endpoint       string
 	transactionXdr string
@@ -12,6 +12,7 @@ import (
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/protocols/horizon/effects"
 	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/clock"
 	"github.com/stellar/go/support/clock/clocktest"
 	"github.com/stellar/go/support/errors"
@@ -175,6 +176,25 @@ func TestCheckMemoRequired(t *testing.T) {
 			}
 		})
 	}
+
+	// muxed account destinations are not checked, no request is mocked for them
+	muxed, err := strkey.NewMuxedAccount(paymentMemoRequired.Destination, 42)
+	tt.NoError(err)
+	muxedDestination, err := muxed.Address()
+	tt.NoError(err)
+	tx, err := txnbuild.NewTransaction(
+		txnbuild.TransactionParams{
+			SourceAccount:        &sourceAccount,
+			IncrementSequenceNum: true,
+			Operations: []txnbuild.Operation{
+				&txnbuild.Payment{Destination: muxedDestination, Amount: "10", Asset: txnbuild.NativeAsset{}},
+			},
+			BaseFee:    txnbuild.MinBaseFee,
+			Timebounds: txnbuild.NewTimebounds(0, 10),
+		},
+	)
+	tt.NoError(err)
+	tt.NoError(client.checkMemoRequired(tx))
 }
 
 func TestAccounts(t *testing.T) {
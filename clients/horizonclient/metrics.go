@@ -0,0 +1,112 @@
+package horizonclient
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsCollector is a Hooks implementation recording prometheus metrics
+// about the requests of a client:
+//
+//   - <namespace>_horizon_request_duration_seconds, the latency of requests
+//     until their response headers, by endpoint and method
+//   - <namespace>_horizon_responses_total, the count of responses by endpoint,
+//     method and status code, "error" for requests which failed without a
+//     response
+//   - <namespace>_horizon_stream_events_total, the count of events received on
+//     streams, by endpoint
+//   - <namespace>_horizon_stream_reconnects_total, the count of reconnections
+//     to streams, by endpoint
+//   - <namespace>_horizon_ratelimit_remaining, the last value of the
+//     X-Ratelimit-Remaining header of horizon responses
+//
+// It is a prometheus.Collector, to be registered in a prometheus registry:
+//
+//	metrics := horizonclient.NewMetricsCollector("myapp")
+//	prometheus.MustRegister(metrics)
+//	client.SetHooks(metrics)
+type MetricsCollector struct {
+	requestDuration  *prometheus.HistogramVec
+	responses        *prometheus.CounterVec
+	streamEvents     *prometheus.CounterVec
+	streamReconnects *prometheus.CounterVec
+	rateLimit        prometheus.Gauge
+}
+
+// NewMetricsCollector returns a MetricsCollector whose metrics are in the
+// given namespace.
+func NewMetricsCollector(namespace string) *MetricsCollector {
+	return &MetricsCollector{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace, Subsystem: "horizon", Name: "request_duration_seconds",
+			Help: "Latency of the requests to horizon until their response headers.",
+		}, []string{"endpoint", "method"}),
+		responses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "horizon", Name: "responses_total",
+			Help: "Responses received from horizon, by status code.",
+		}, []string{"endpoint", "method", "status"}),
+		streamEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "horizon", Name: "stream_events_total",
+			Help: "Events received on horizon streams.",
+		}, []string{"endpoint"}),
+		streamReconnects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "horizon", Name: "stream_reconnects_total",
+			Help: "Reconnections to horizon streams.",
+		}, []string{"endpoint"}),
+		rateLimit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace, Subsystem: "horizon", Name: "ratelimit_remaining",
+			Help: "Requests remaining in the current rate limit window of horizon.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	m.requestDuration.Describe(ch)
+	m.responses.Describe(ch)
+	m.streamEvents.Describe(ch)
+	m.streamReconnects.Describe(ch)
+	m.rateLimit.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	m.requestDuration.Collect(ch)
+	m.responses.Collect(ch)
+	m.streamEvents.Collect(ch)
+	m.streamReconnects.Collect(ch)
+	m.rateLimit.Collect(ch)
+}
+
+// BeforeRequest implements Hooks.
+func (m *MetricsCollector) BeforeRequest(req *http.Request) *http.Request {
+	return req
+}
+
+// AfterRequest implements Hooks.
+func (m *MetricsCollector) AfterRequest(info RequestInfo) {
+	method := info.Request.Method
+	m.requestDuration.WithLabelValues(info.Endpoint, method).Observe(info.Duration.Seconds())
+	if info.Reconnect {
+		m.streamReconnects.WithLabelValues(info.Endpoint).Inc()
+	}
+
+	if info.Response == nil {
+		m.responses.WithLabelValues(info.Endpoint, method, "error").Inc()
+		return
+	}
+	m.responses.WithLabelValues(info.Endpoint, method, strconv.Itoa(info.Response.StatusCode)).Inc()
+	if remaining, err := strconv.ParseFloat(info.Response.Header.Get("X-Ratelimit-Remaining"), 64); err == nil {
+		m.rateLimit.Set(remaining)
+	}
+}
+
+// StreamEvent implements Hooks.
+func (m *MetricsCollector) StreamEvent(info StreamEventInfo) {
+	m.streamEvents.WithLabelValues(info.Endpoint).Inc()
+}
+
+var _ Hooks = &MetricsCollector{}
+var _ prometheus.Collector = &MetricsCollector{}
@@ -63,6 +63,18 @@ func (m *MockClient) FeeStats() (hProtocol.FeeStats, error) {
 	return a.Get(0).(hProtocol.FeeStats), a.Error(1)
 }
 
+// ClaimableBalances is a mocking method
+func (m *MockClient) ClaimableBalances(request ClaimableBalanceRequest) (hProtocol.ClaimableBalancesPage, error) {
+	a := m.Called(request)
+	return a.Get(0).(hProtocol.ClaimableBalancesPage), a.Error(1)
+}
+
+// ClaimableBalance is a mocking method
+func (m *MockClient) ClaimableBalance(id string) (hProtocol.ClaimableBalance, error) {
+	a := m.Called(id)
+	return a.Get(0).(hProtocol.ClaimableBalance), a.Error(1)
+}
+
 // Offers is a mocking method
 func (m *MockClient) Offers(request OfferRequest) (hProtocol.OffersPage, error) {
 	a := m.Called(request)
@@ -147,6 +159,12 @@ func (m *MockClient) Payments(request OperationRequest) (operations.OperationsPa
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// SearchOperations is a mocking method
+func (m *MockClient) SearchOperations(request OperationSearchRequest) (operations.OperationsPage, error) {
+	a := m.Called(request)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
 // TradeAggregations is a mocking method
 func (m *MockClient) TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
 	a := m.Called(request)
@@ -331,3 +349,6 @@ func (m *MockClient) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsP
 
 // ensure that the MockClient implements ClientInterface
 var _ ClientInterface = &MockClient{}
+
+// ensure that the MockClient can be used as a fee stats source by txnbuild.FeeStatsStrategy
+var _ txnbuild.FeeStatsSource = &MockClient{}
@@ -0,0 +1,837 @@
+package horizonclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+// MultiClient is a ClientInterface spreading requests over several Horizon
+// servers. It probes the Root endpoint of the servers to track their health
+// and their latest ledger, and sends reads to the most up-to-date healthy
+// servers. A read which fails with a network error, a 5xx or a 429 response is
+// sent again to the next server.
+//
+// Transaction submissions are sent to a single server and are never sent again
+// to another server, since their outcome is unknown when a server fails. Set a
+// RetryPolicy with RetrySubmissions on the clients to retry them safely.
+//
+// Reads are not pinned to the server a transaction was submitted to, since a
+// MultiClient is shared between goroutines and one submission would redirect
+// the reads of every goroutine. Use Session to get a MultiClient whose reads
+// follow its own submissions.
+//
+// Streams are opened on the best server and are not moved to another server if
+// it fails.
+type MultiClient struct {
+	// HealthCheckInterval is how often the servers are probed. The servers are
+	// probed before a request if they were last probed longer than
+	// HealthCheckInterval ago. Defaults to 10 seconds.
+	HealthCheckInterval time.Duration
+	// MaxLedgerLag is the number of ledgers a server can lag behind the most
+	// up-to-date server while still sharing the reads with it.
+	MaxLedgerLag int32
+	// PinDuration is how long the reads of a session are sent to the server a
+	// transaction was submitted to. Defaults to 30 seconds.
+	PinDuration time.Duration
+
+	// root is the MultiClient a session was created from, which holds the
+	// servers and the settings. It is nil for a MultiClient which is not a
+	// session.
+	root    *MultiClient
+	servers []*multiClientServer
+
+	mutex     sync.Mutex
+	lastCheck time.Time
+	next      uint64
+
+	// pinMutex guards the pin of a session.
+	pinMutex    sync.Mutex
+	pinned      *multiClientServer
+	pinnedUntil time.Time
+
+	// clock is a Clock returning the current time.
+	clock *clock.Clock
+}
+
+type multiClientServer struct {
+	client       *Client
+	healthy      bool
+	latestLedger int32
+	lastError    error
+	checkedAt    time.Time
+}
+
+// ServerStatus is the status of a server of a MultiClient.
+type ServerStatus struct {
+	HorizonURL string
+	// Healthy is false if the last probe or request to the server failed.
+	Healthy bool
+	// LatestLedger is the history_latest_ledger of the server when it was
+	// last probed.
+	LatestLedger int32
+	LastError    error
+	CheckedAt    time.Time
+}
+
+// NewMultiClient returns a MultiClient sending requests to clients, which
+// must be connected to the same network. The servers are healthy until they
+// are probed.
+func NewMultiClient(clients ...*Client) *MultiClient {
+	m := &MultiClient{}
+	for _, client := range clients {
+		m.servers = append(m.servers, &multiClientServer{client: client, healthy: true})
+	}
+	return m
+}
+
+// Session returns a MultiClient sharing the servers, their health and the
+// settings of m, whose reads are pinned to the server its last transaction was
+// submitted to for PinDuration, so that they observe the effects of the
+// transaction. The submissions of a session do not affect the reads of m or
+// of other sessions. A session is meant to be used by a single caller, for
+// instance for the duration of a request.
+func (m *MultiClient) Session() *MultiClient {
+	return &MultiClient{root: m.base()}
+}
+
+// base returns the MultiClient holding the servers and the settings.
+func (m *MultiClient) base() *MultiClient {
+	if m.root != nil {
+		return m.root
+	}
+	return m
+}
+
+// CheckHealth probes the Root endpoint of all the servers concurrently.
+func (m *MultiClient) CheckHealth() {
+	m = m.base()
+	m.mutex.Lock()
+	m.lastCheck = m.clock.Now()
+	m.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	for _, server := range m.servers {
+		wg.Add(1)
+		go func(server *multiClientServer) {
+			defer wg.Done()
+			root, err := server.client.Root()
+
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			server.checkedAt = m.clock.Now()
+			server.healthy = err == nil
+			server.lastError = err
+			if err == nil {
+				server.latestLedger = root.HorizonSequence
+			}
+		}(server)
+	}
+	wg.Wait()
+}
+
+// Status returns the status of the servers.
+func (m *MultiClient) Status() []ServerStatus {
+	m = m.base()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	status := make([]ServerStatus, len(m.servers))
+	for i, server := range m.servers {
+		status[i] = ServerStatus{
+			HorizonURL:   server.client.HorizonURL,
+			Healthy:      server.healthy,
+			LatestLedger: server.latestLedger,
+			LastError:    server.lastError,
+			CheckedAt:    server.checkedAt,
+		}
+	}
+	return status
+}
+
+// refreshHealth probes the servers if they were not probed for
+// HealthCheckInterval.
+func (m *MultiClient) refreshHealth() {
+	m = m.base()
+	interval := m.HealthCheckInterval
+	if interval == 0 {
+		interval = 10 * time.Second
+	}
+	m.mutex.Lock()
+	due := m.lastCheck.IsZero() || m.clock.Now().Sub(m.lastCheck) >= interval
+	m.mutex.Unlock()
+	if due {
+		m.CheckHealth()
+	}
+}
+
+// route returns the servers in the order in which they should receive a
+// request: the server pinned by a session, the healthy servers from the most
+// up-to-date, taking turns between the servers within MaxLedgerLag of the most
+// up-to-date server, and the unhealthy servers as a last resort.
+func (m *MultiClient) route() []*multiClientServer {
+	route := m.base().order()
+
+	m.pinMutex.Lock()
+	defer m.pinMutex.Unlock()
+	if m.pinned != nil && m.base().clock.Now().Before(m.pinnedUntil) {
+		for i, server := range route {
+			if server == m.pinned {
+				copy(route[1:i+1], route[:i])
+				route[0] = server
+				break
+			}
+		}
+	}
+	return route
+}
+
+// order returns the healthy servers from the most up-to-date, taking turns
+// between the servers within MaxLedgerLag of the most up-to-date server,
+// followed by the unhealthy servers.
+func (m *MultiClient) order() []*multiClientServer {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var healthy, unhealthy []*multiClientServer
+	for _, server := range m.servers {
+		if server.healthy {
+			healthy = append(healthy, server)
+		} else {
+			unhealthy = append(unhealthy, server)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return healthy[i].latestLedger > healthy[j].latestLedger
+	})
+
+	top := 0
+	for top < len(healthy) && healthy[top].latestLedger >= healthy[0].latestLedger-m.MaxLedgerLag {
+		top++
+	}
+	if top > 1 {
+		shift := int(m.next % uint64(top))
+		m.next++
+		rotated := append(append([]*multiClientServer{}, healthy[shift:top]...), healthy[:shift]...)
+		copy(healthy, rotated)
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// pin sends the reads of a session to server for PinDuration. It does nothing
+// if m is not a session.
+func (m *MultiClient) pin(server *multiClientServer) {
+	if m.root == nil {
+		return
+	}
+	duration := m.root.PinDuration
+	if duration == 0 {
+		duration = 30 * time.Second
+	}
+	m.pinMutex.Lock()
+	defer m.pinMutex.Unlock()
+	m.pinned = server
+	m.pinnedUntil = m.root.clock.Now().Add(duration)
+}
+
+// record updates the health of a server after a request.
+func (m *MultiClient) record(server *multiClientServer, err error) {
+	m = m.base()
+	failed := isServerFailure(err)
+	if err != nil && !failed {
+		// the server answered
+		err = nil
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	server.healthy = !failed
+	server.lastError = err
+}
+
+// isServerFailure returns true if err shows that a server failed to handle a
+// request, which can be sent to another server.
+func isServerFailure(err error) bool {
+	switch e := errors.Cause(err).(type) {
+	case *Error:
+		return e.Response != nil &&
+			(e.Response.StatusCode >= 500 || e.Response.StatusCode == http.StatusTooManyRequests)
+	case net.Error:
+		return true
+	default:
+		return false
+	}
+}
+
+// do sends a read to the servers in turn until one of them handles it.
+func (m *MultiClient) do(request func(c *Client) error) error {
+	if len(m.base().servers) == 0 {
+		return errors.New("no horizon servers")
+	}
+	m.refreshHealth()
+
+	var err error
+	for _, server := range m.route() {
+		err = request(server.client)
+		m.record(server, err)
+		if !isServerFailure(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// submit sends a transaction submission to the best server and pins the reads
+// of a session to it.
+func (m *MultiClient) submit(submission func(c *Client) (hProtocol.Transaction, error)) (hProtocol.Transaction, error) {
+	if len(m.base().servers) == 0 {
+		return hProtocol.Transaction{}, errors.New("no horizon servers")
+	}
+	m.refreshHealth()
+
+	server := m.route()[0]
+	m.pin(server)
+	tx, err := submission(server.client)
+	m.record(server, err)
+	return tx, err
+}
+
+// stream opens a stream on the best server.
+func (m *MultiClient) stream(stream func(c *Client) error) error {
+	if len(m.base().servers) == 0 {
+		return errors.New("no horizon servers")
+	}
+	m.refreshHealth()
+	return stream(m.route()[0].client)
+}
+
+// rebase makes a link returned by one of the servers point to the server of
+// c, so that the next pages can be read from another server.
+func (m *MultiClient) rebase(link string, c *Client) string {
+	for _, server := range m.base().servers {
+		base := server.client.fixHorizonURL()
+		if strings.HasPrefix(link, base) {
+			return c.fixHorizonURL() + strings.TrimPrefix(link, base)
+		}
+	}
+	return link
+}
+
+// Accounts returns accounts who have a given signer or have a trustline to an
+// asset.
+func (m *MultiClient) Accounts(request AccountsRequest) (page hProtocol.AccountsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Accounts(request)
+		return
+	})
+	return
+}
+
+// AccountDetail returns information for a single account.
+func (m *MultiClient) AccountDetail(request AccountRequest) (account hProtocol.Account, err error) {
+	err = m.do(func(c *Client) (err error) {
+		account, err = c.AccountDetail(request)
+		return
+	})
+	return
+}
+
+// AccountData returns a single data associated with a given account.
+func (m *MultiClient) AccountData(request AccountRequest) (data hProtocol.AccountData, err error) {
+	err = m.do(func(c *Client) (err error) {
+		data, err = c.AccountData(request)
+		return
+	})
+	return
+}
+
+// Effects returns effects.
+func (m *MultiClient) Effects(request EffectRequest) (page effects.EffectsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Effects(request)
+		return
+	})
+	return
+}
+
+// Assets returns asset information.
+func (m *MultiClient) Assets(request AssetRequest) (page hProtocol.AssetsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Assets(request)
+		return
+	})
+	return
+}
+
+// Ledgers returns information about all ledgers.
+func (m *MultiClient) Ledgers(request LedgerRequest) (page hProtocol.LedgersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Ledgers(request)
+		return
+	})
+	return
+}
+
+// LedgerDetail returns information about a particular ledger for a given
+// sequence number.
+func (m *MultiClient) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err error) {
+	err = m.do(func(c *Client) (err error) {
+		ledger, err = c.LedgerDetail(sequence)
+		return
+	})
+	return
+}
+
+// FeeStats returns information about fees in the last 5 ledgers.
+func (m *MultiClient) FeeStats() (feeStats hProtocol.FeeStats, err error) {
+	err = m.do(func(c *Client) (err error) {
+		feeStats, err = c.FeeStats()
+		return
+	})
+	return
+}
+
+// ClaimableBalances returns the claimable balances matching the filters of
+// the request.
+func (m *MultiClient) ClaimableBalances(request ClaimableBalanceRequest) (page hProtocol.ClaimableBalancesPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.ClaimableBalances(request)
+		return
+	})
+	return
+}
+
+// ClaimableBalance returns details about a claimable balance.
+func (m *MultiClient) ClaimableBalance(id string) (cb hProtocol.ClaimableBalance, err error) {
+	err = m.do(func(c *Client) (err error) {
+		cb, err = c.ClaimableBalance(id)
+		return
+	})
+	return
+}
+
+// Offers returns information about offers made on the SDEX.
+func (m *MultiClient) Offers(request OfferRequest) (page hProtocol.OffersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Offers(request)
+		return
+	})
+	return
+}
+
+// OfferDetails returns information for a single offer.
+func (m *MultiClient) OfferDetails(offerID string) (offer hProtocol.Offer, err error) {
+	err = m.do(func(c *Client) (err error) {
+		offer, err = c.OfferDetails(offerID)
+		return
+	})
+	return
+}
+
+// Operations returns stellar operations.
+func (m *MultiClient) Operations(request OperationRequest) (page operations.OperationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Operations(request)
+		return
+	})
+	return
+}
+
+// OperationDetail returns a single stellar operation for a given operation id.
+func (m *MultiClient) OperationDetail(id string) (op operations.Operation, err error) {
+	err = m.do(func(c *Client) (err error) {
+		op, err = c.OperationDetail(id)
+		return
+	})
+	return
+}
+
+// SubmitTransactionXDR submits a transaction represented as a base64 XDR
+// string to the best server, and pins the reads of a session to it.
+func (m *MultiClient) SubmitTransactionXDR(transactionXdr string) (hProtocol.Transaction, error) {
+	return m.submit(func(c *Client) (hProtocol.Transaction, error) {
+		return c.SubmitTransactionXDR(transactionXdr)
+	})
+}
+
+// SubmitFeeBumpTransactionWithOptions submits a fee bump transaction to the
+// best server, and pins the reads of a session to it.
+func (m *MultiClient) SubmitFeeBumpTransactionWithOptions(transaction *txnbuild.FeeBumpTransaction, opts SubmitTxOpts) (hProtocol.Transaction, error) {
+	return m.submit(func(c *Client) (hProtocol.Transaction, error) {
+		return c.SubmitFeeBumpTransactionWithOptions(transaction, opts)
+	})
+}
+
+// SubmitTransactionWithOptions submits a transaction to the best server, and
+// pins the reads of a session to it.
+func (m *MultiClient) SubmitTransactionWithOptions(transaction *txnbuild.Transaction, opts SubmitTxOpts) (hProtocol.Transaction, error) {
+	return m.submit(func(c *Client) (hProtocol.Transaction, error) {
+		return c.SubmitTransactionWithOptions(transaction, opts)
+	})
+}
+
+// SubmitFeeBumpTransaction submits a fee bump transaction to the best server,
+// and pins the reads of a session to it.
+func (m *MultiClient) SubmitFeeBumpTransaction(transaction *txnbuild.FeeBumpTransaction) (hProtocol.Transaction, error) {
+	return m.SubmitFeeBumpTransactionWithOptions(transaction, SubmitTxOpts{})
+}
+
+// SubmitTransaction submits a transaction to the best server, and pins the
+// reads of a session to it.
+func (m *MultiClient) SubmitTransaction(transaction *txnbuild.Transaction) (hProtocol.Transaction, error) {
+	return m.SubmitTransactionWithOptions(transaction, SubmitTxOpts{})
+}
+
+// Transactions returns stellar transactions.
+func (m *MultiClient) Transactions(request TransactionRequest) (page hProtocol.TransactionsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Transactions(request)
+		return
+	})
+	return
+}
+
+// TransactionDetail returns information about a particular transaction for a
+// given transaction hash.
+func (m *MultiClient) TransactionDetail(txHash string) (tx hProtocol.Transaction, err error) {
+	err = m.do(func(c *Client) (err error) {
+		tx, err = c.TransactionDetail(txHash)
+		return
+	})
+	return
+}
+
+// OrderBook returns the orderbook for an asset pair.
+func (m *MultiClient) OrderBook(request OrderBookRequest) (orderBook hProtocol.OrderBookSummary, err error) {
+	err = m.do(func(c *Client) (err error) {
+		orderBook, err = c.OrderBook(request)
+		return
+	})
+	return
+}
+
+// Paths returns the available paths to make a payment.
+func (m *MultiClient) Paths(request PathsRequest) (page hProtocol.PathsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Paths(request)
+		return
+	})
+	return
+}
+
+// Payments returns stellar account_merge, create_account, path payment and
+// payment operations.
+func (m *MultiClient) Payments(request OperationRequest) (page operations.OperationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Payments(request)
+		return
+	})
+	return
+}
+
+// SearchOperations returns the stellar operations matching the filters of the
+// request.
+func (m *MultiClient) SearchOperations(request OperationSearchRequest) (page operations.OperationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.SearchOperations(request)
+		return
+	})
+	return
+}
+
+// TradeAggregations returns stellar trade aggregations.
+func (m *MultiClient) TradeAggregations(request TradeAggregationRequest) (page hProtocol.TradeAggregationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.TradeAggregations(request)
+		return
+	})
+	return
+}
+
+// Trades returns stellar trades.
+func (m *MultiClient) Trades(request TradeRequest) (page hProtocol.TradesPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page, err = c.Trades(request)
+		return
+	})
+	return
+}
+
+// Fund creates a new account funded from friendbot. It only works on test
+// networks.
+func (m *MultiClient) Fund(addr string) (tx hProtocol.Transaction, err error) {
+	err = m.do(func(c *Client) (err error) {
+		tx, err = c.Fund(addr)
+		return
+	})
+	return
+}
+
+// StreamTransactions streams executed transactions from the best server.
+func (m *MultiClient) StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamTransactions(ctx, request, handler)
+	})
+}
+
+// StreamTrades streams executed trades from the best server.
+func (m *MultiClient) StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamTrades(ctx, request, handler)
+	})
+}
+
+// StreamEffects streams horizon effects from the best server.
+func (m *MultiClient) StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamEffects(ctx, request, handler)
+	})
+}
+
+// StreamOperations streams stellar operations from the best server.
+func (m *MultiClient) StreamOperations(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamOperations(ctx, request, handler)
+	})
+}
+
+// StreamPayments streams stellar payments from the best server.
+func (m *MultiClient) StreamPayments(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamPayments(ctx, request, handler)
+	})
+}
+
+// StreamOffers streams offers processed by the DEX from the best server.
+func (m *MultiClient) StreamOffers(ctx context.Context, request OfferRequest, handler OfferHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamOffers(ctx, request, handler)
+	})
+}
+
+// StreamLedgers streams stellar ledgers from the best server.
+func (m *MultiClient) StreamLedgers(ctx context.Context, request LedgerRequest, handler LedgerHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamLedgers(ctx, request, handler)
+	})
+}
+
+// StreamOrderBooks streams the orderbook for a given asset pair from the best
+// server.
+func (m *MultiClient) StreamOrderBooks(ctx context.Context, request OrderBookRequest, handler OrderBookHandler) error {
+	return m.stream(func(c *Client) error {
+		return c.StreamOrderBooks(ctx, request, handler)
+	})
+}
+
+// Root loads the root endpoint of the best server.
+func (m *MultiClient) Root() (root hProtocol.Root, err error) {
+	err = m.do(func(c *Client) (err error) {
+		root, err = c.Root()
+		return
+	})
+	return
+}
+
+// NextAccountsPage returns the next page of accounts.
+func (m *MultiClient) NextAccountsPage(page hProtocol.AccountsPage) (next hProtocol.AccountsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextAccountsPage(page)
+		return
+	})
+	return
+}
+
+// NextAssetsPage returns the next page of assets.
+func (m *MultiClient) NextAssetsPage(page hProtocol.AssetsPage) (next hProtocol.AssetsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextAssetsPage(page)
+		return
+	})
+	return
+}
+
+// PrevAssetsPage returns the previous page of assets.
+func (m *MultiClient) PrevAssetsPage(page hProtocol.AssetsPage) (prev hProtocol.AssetsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevAssetsPage(page)
+		return
+	})
+	return
+}
+
+// NextLedgersPage returns the next page of ledgers.
+func (m *MultiClient) NextLedgersPage(page hProtocol.LedgersPage) (next hProtocol.LedgersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextLedgersPage(page)
+		return
+	})
+	return
+}
+
+// PrevLedgersPage returns the previous page of ledgers.
+func (m *MultiClient) PrevLedgersPage(page hProtocol.LedgersPage) (prev hProtocol.LedgersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevLedgersPage(page)
+		return
+	})
+	return
+}
+
+// NextEffectsPage returns the next page of effects.
+func (m *MultiClient) NextEffectsPage(page effects.EffectsPage) (next effects.EffectsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextEffectsPage(page)
+		return
+	})
+	return
+}
+
+// PrevEffectsPage returns the previous page of effects.
+func (m *MultiClient) PrevEffectsPage(page effects.EffectsPage) (prev effects.EffectsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevEffectsPage(page)
+		return
+	})
+	return
+}
+
+// NextTransactionsPage returns the next page of transactions.
+func (m *MultiClient) NextTransactionsPage(page hProtocol.TransactionsPage) (next hProtocol.TransactionsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextTransactionsPage(page)
+		return
+	})
+	return
+}
+
+// PrevTransactionsPage returns the previous page of transactions.
+func (m *MultiClient) PrevTransactionsPage(page hProtocol.TransactionsPage) (prev hProtocol.TransactionsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevTransactionsPage(page)
+		return
+	})
+	return
+}
+
+// NextOperationsPage returns the next page of operations.
+func (m *MultiClient) NextOperationsPage(page operations.OperationsPage) (next operations.OperationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextOperationsPage(page)
+		return
+	})
+	return
+}
+
+// PrevOperationsPage returns the previous page of operations.
+func (m *MultiClient) PrevOperationsPage(page operations.OperationsPage) (prev operations.OperationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevOperationsPage(page)
+		return
+	})
+	return
+}
+
+// NextPaymentsPage returns the next page of payments.
+func (m *MultiClient) NextPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.NextOperationsPage(page)
+}
+
+// PrevPaymentsPage returns the previous page of payments.
+func (m *MultiClient) PrevPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.PrevOperationsPage(page)
+}
+
+// NextOffersPage returns the next page of offers.
+func (m *MultiClient) NextOffersPage(page hProtocol.OffersPage) (next hProtocol.OffersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextOffersPage(page)
+		return
+	})
+	return
+}
+
+// PrevOffersPage returns the previous page of offers.
+func (m *MultiClient) PrevOffersPage(page hProtocol.OffersPage) (prev hProtocol.OffersPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevOffersPage(page)
+		return
+	})
+	return
+}
+
+// NextTradesPage returns the next page of trades.
+func (m *MultiClient) NextTradesPage(page hProtocol.TradesPage) (next hProtocol.TradesPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextTradesPage(page)
+		return
+	})
+	return
+}
+
+// PrevTradesPage returns the previous page of trades.
+func (m *MultiClient) PrevTradesPage(page hProtocol.TradesPage) (prev hProtocol.TradesPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevTradesPage(page)
+		return
+	})
+	return
+}
+
+// HomeDomainForAccount returns the home domain for a single account.
+func (m *MultiClient) HomeDomainForAccount(aid string) (domain string, err error) {
+	err = m.do(func(c *Client) (err error) {
+		domain, err = c.HomeDomainForAccount(aid)
+		return
+	})
+	return
+}
+
+// NextTradeAggregationsPage returns the next page of trade aggregations.
+func (m *MultiClient) NextTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (next hProtocol.TradeAggregationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Next.Href = m.rebase(page.Links.Next.Href, c)
+		next, err = c.NextTradeAggregationsPage(page)
+		return
+	})
+	return
+}
+
+// PrevTradeAggregationsPage returns the previous page of trade aggregations.
+func (m *MultiClient) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (prev hProtocol.TradeAggregationsPage, err error) {
+	err = m.do(func(c *Client) (err error) {
+		page.Links.Prev.Href = m.rebase(page.Links.Prev.Href, c)
+		prev, err = c.PrevTradeAggregationsPage(page)
+		return
+	})
+	return
+}
+
+// ensure that the multi client implements ClientInterface
+var _ ClientInterface = &MultiClient{}
@@ -0,0 +1,281 @@
+package horizonclient
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/clock/clocktest"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func multiClientRoot(latestLedger int32) string {
+	return fmt.Sprintf(`{
+  "history_latest_ledger": %d,
+  "network_passphrase": "Test SDF Network ; September 2015"
+}`, latestLedger)
+}
+
+// newTestMultiClient returns a MultiClient with a server for every latest
+// ledger, at https://0/, https://1/...
+func newTestMultiClient(latestLedgers ...int32) (*MultiClient, *httptest.Client, *time.Time) {
+	hmock := httptest.NewClient()
+	var clients []*Client
+	for i, latestLedger := range latestLedgers {
+		url := fmt.Sprintf("https://%d/", i)
+		clients = append(clients, &Client{HorizonURL: url, HTTP: hmock})
+		hmock.On("GET", url).Return(stringResponder(200, multiClientRoot(latestLedger)))
+	}
+	m := NewMultiClient(clients...)
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	m.clock = &clock.Clock{Source: clocktest.FixedSource(now)}
+	return m, hmock, &now
+}
+
+func setMultiClientTime(m *MultiClient, now *time.Time, t time.Time) {
+	*now = t
+	m.clock = &clock.Clock{Source: clocktest.FixedSource(t)}
+}
+
+func TestMultiClientRoutesToUpToDateServer(t *testing.T) {
+	m, hmock, _ := newTestMultiClient(10, 12, 11)
+	calls := make([]int, 3)
+	for i := range calls {
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/5", i)).
+			Return(responses(&calls[i], stringResponder(200, ledgerResponse)))
+	}
+
+	_, err := m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 0}, calls)
+
+	status := m.Status()
+	require.Len(t, status, 3)
+	assert.Equal(t, ServerStatus{
+		HorizonURL:   "https://1/",
+		Healthy:      true,
+		LatestLedger: 12,
+		CheckedAt:    time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC),
+	}, status[1])
+}
+
+func TestMultiClientFailover(t *testing.T) {
+	m, hmock, _ := newTestMultiClient(10, 12, 11)
+	calls := make([]int, 3)
+	hmock.On("GET", "https://0/ledgers/5").
+		Return(responses(&calls[0], stringResponder(200, ledgerResponse)))
+	hmock.On("GET", "https://1/ledgers/5").
+		Return(responses(&calls[1], stringResponder(503, serviceUnavailableResponse)))
+	hmock.On("GET", "https://2/ledgers/5").
+		Return(responses(&calls[2], errorResponder("connection refused")))
+
+	ledger, err := m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, int32(69859), ledger.Sequence)
+	assert.Equal(t, []int{1, 1, 1}, calls)
+
+	status := m.Status()
+	assert.True(t, status[0].Healthy)
+	assert.False(t, status[1].Healthy)
+	assert.Equal(t, 503, GetError(status[1].LastError).Response.StatusCode)
+	assert.False(t, status[2].Healthy)
+
+	// the unhealthy servers are tried last
+	_, err = m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 1, 1}, calls)
+
+	// client errors are not sent to another server
+	notFound := make([]int, 3)
+	for i := range notFound {
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/6", i)).
+			Return(responses(&notFound[i], stringResponder(404, notFoundResponse)))
+	}
+	_, err = m.LedgerDetail(6)
+	assert.Equal(t, 404, GetError(err).Response.StatusCode)
+	assert.Equal(t, []int{1, 0, 0}, notFound)
+	assert.True(t, m.Status()[0].Healthy)
+
+	// the last error is returned if all servers fail
+	hmock.On("GET", "https://0/ledgers/5").Return(stringResponder(503, serviceUnavailableResponse))
+	_, err = m.LedgerDetail(5)
+	assert.Error(t, err)
+}
+
+func TestMultiClientHealthChecks(t *testing.T) {
+	m, hmock, now := newTestMultiClient(10, 12)
+	m.HealthCheckInterval = time.Minute
+	hmock.On("GET", "https://1/").Return(errorResponder("connection refused"))
+	calls := make([]int, 2)
+	for i := range calls {
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/5", i)).
+			Return(responses(&calls[i], stringResponder(200, ledgerResponse)))
+	}
+
+	_, err := m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, calls)
+	assert.False(t, m.Status()[1].Healthy)
+
+	// the servers are probed again after HealthCheckInterval
+	hmock.On("GET", "https://1/").Return(stringResponder(200, multiClientRoot(13)))
+	setMultiClientTime(m, now, now.Add(59*time.Second))
+	_, err = m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 0}, calls)
+
+	setMultiClientTime(m, now, now.Add(time.Second))
+	_, err = m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, calls)
+	assert.Equal(t, int32(13), m.Status()[1].LatestLedger)
+}
+
+func TestMultiClientLoadBalancing(t *testing.T) {
+	m, hmock, _ := newTestMultiClient(10, 12, 11)
+	m.MaxLedgerLag = 1
+	calls := make([]int, 3)
+	for i := range calls {
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/5", i)).
+			Return(responses(&calls[i], stringResponder(200, ledgerResponse)))
+	}
+
+	for i := 0; i < 4; i++ {
+		_, err := m.LedgerDetail(5)
+		require.NoError(t, err)
+	}
+	// the server lagging 2 ledgers behind does not get reads
+	assert.Equal(t, []int{0, 2, 2}, calls)
+}
+
+func TestMultiClientPinsReadsAfterSubmission(t *testing.T) {
+	txXdr := `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+	m, hmock, now := newTestMultiClient(10, 10)
+	m.HealthCheckInterval = time.Hour
+	m.PinDuration = 10 * time.Second
+	submissions := make([]int, 2)
+	calls := make([]int, 2)
+	for i := range calls {
+		hmock.On("POST", fmt.Sprintf("https://%d/transactions", i)).
+			Return(responses(&submissions[i], stringResponder(200, txSuccess)))
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/5", i)).
+			Return(responses(&calls[i], stringResponder(200, ledgerResponse)))
+	}
+
+	// the servers take turns
+	_, err := m.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, calls)
+
+	session := m.Session()
+	_, err = session.SubmitTransactionXDR(txXdr)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, submissions)
+
+	for i := 0; i < 3; i++ {
+		_, err = session.LedgerDetail(5)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{1, 3}, calls)
+
+	// the reads of the MultiClient are not pinned
+	for i := 0; i < 2; i++ {
+		_, err = m.LedgerDetail(5)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{2, 4}, calls)
+
+	setMultiClientTime(m, now, now.Add(10*time.Second))
+	for i := 0; i < 2; i++ {
+		_, err = session.LedgerDetail(5)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{3, 5}, calls)
+
+	// submissions are not sent to another server
+	hmock.On("POST", "https://0/transactions").
+		Return(responses(&submissions[0], stringResponder(504, timeoutResponse)))
+	hmock.On("POST", "https://1/transactions").
+		Return(responses(&submissions[1], stringResponder(504, timeoutResponse)))
+	_, err = m.SubmitTransactionXDR(txXdr)
+	assert.Equal(t, 504, GetError(err).Response.StatusCode)
+	assert.Equal(t, 2, submissions[0]+submissions[1])
+}
+
+func TestMultiClientSessionsPinTheirOwnSubmissions(t *testing.T) {
+	txXdr := `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+	m, hmock, _ := newTestMultiClient(10, 10)
+	m.HealthCheckInterval = time.Hour
+	for i := 0; i < 2; i++ {
+		hmock.On("POST", fmt.Sprintf("https://%d/transactions", i)).
+			Return(stringResponder(200, txSuccess))
+		// the ledger tells which server handled the read
+		hmock.On("GET", fmt.Sprintf("https://%d/ledgers/5", i)).
+			Return(stringResponder(200, fmt.Sprintf(`{"sequence": %d}`, i)))
+	}
+	m.CheckHealth()
+
+	sessions := []*MultiClient{m.Session(), m.Session()}
+	var wg sync.WaitGroup
+	for _, session := range sessions {
+		wg.Add(1)
+		go func(session *MultiClient) {
+			defer wg.Done()
+			_, err := session.SubmitTransactionXDR(txXdr)
+			assert.NoError(t, err)
+		}(session)
+	}
+	wg.Wait()
+
+	// the submissions took turns between the servers
+	servers := make([]int32, len(sessions))
+	for i, session := range sessions {
+		require.NotNil(t, session.pinned)
+		for j, server := range m.servers {
+			if server == session.pinned {
+				servers[i] = int32(j)
+			}
+		}
+	}
+	require.NotEqual(t, servers[0], servers[1])
+
+	for i, session := range sessions {
+		wg.Add(1)
+		go func(session *MultiClient, server int32) {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				ledger, err := session.LedgerDetail(5)
+				assert.NoError(t, err)
+				assert.Equal(t, server, ledger.Sequence)
+			}
+		}(session, servers[i])
+	}
+	wg.Wait()
+}
+
+func TestMultiClientNextPages(t *testing.T) {
+	m, hmock, _ := newTestMultiClient(10, 9)
+	hmock.On("GET", "https://0/ledgers?cursor=5").Return(stringResponder(503, serviceUnavailableResponse))
+	hmock.On("GET", "https://1/ledgers?cursor=5").
+		Return(stringResponder(200, `{"_embedded": {"records": [{"sequence": 6}]}}`))
+
+	page := hProtocol.LedgersPage{}
+	page.Links.Next.Href = "https://0/ledgers?cursor=5"
+	next, err := m.NextLedgersPage(page)
+	require.NoError(t, err)
+	require.Len(t, next.Embedded.Records, 1)
+	assert.Equal(t, int32(6), next.Embedded.Records[0].Sequence)
+}
+
+func TestMultiClientWithoutServers(t *testing.T) {
+	m := NewMultiClient()
+	_, err := m.LedgerDetail(5)
+	assert.EqualError(t, err, "no horizon servers")
+	_, err = m.SubmitTransactionXDR("")
+	assert.EqualError(t, err, "no horizon servers")
+}
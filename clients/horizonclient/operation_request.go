@@ -87,18 +87,27 @@ func (op OperationRequest) StreamOperations(ctx context.Context, client *Client,
 
 	url := fmt.Sprintf("%s%s", client.fixHorizonURL(), endpoint)
 	return client.stream(ctx, url, func(data []byte) error {
-		var baseRecord operations.Base
-
-		if err = json.Unmarshal(data, &baseRecord); err != nil {
-			return errors.Wrap(err, "error unmarshaling data for operation request")
-		}
-
-		ops, err := operations.UnmarshalOperation(baseRecord.GetTypeI(), data)
+		ops, err := decodeOperation(data)
 		if err != nil {
-			return errors.Wrap(err, "unmarshaling to the correct operation type")
+			return err
 		}
 
 		handler(ops)
 		return nil
 	})
 }
+
+// decodeOperation decodes a streamed operation into its concrete type.
+func decodeOperation(data []byte) (operations.Operation, error) {
+	var baseRecord operations.Base
+
+	if err := json.Unmarshal(data, &baseRecord); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling data for operation request")
+	}
+
+	ops, err := operations.UnmarshalOperation(baseRecord.GetTypeI(), data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling to the correct operation type")
+	}
+	return ops, nil
+}
@@ -0,0 +1,60 @@
+package horizonclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// BuildURL creates the endpoint to be queried based on the data in the OperationSearchRequest struct.
+func (sr OperationSearchRequest) BuildURL() (endpoint string, err error) {
+	types := strings.Join(sr.OperationTypes, ",")
+	startTime, endTime := millis(sr.StartTime), millis(sr.EndTime)
+	nParams := countParams(sr.ForAccount, sr.MemoType, sr.Asset, sr.MinAmount, sr.MaxAmount,
+		types, startTime, endTime)
+	if nParams == 0 {
+		return endpoint, errors.New("invalid request: no filter parameters")
+	}
+
+	if sr.Memo != "" && sr.MemoType == "" {
+		return endpoint, errors.New("invalid request: memo requires memo type")
+	}
+
+	endpoint = "operations/search"
+	queryParams := addQueryParams(
+		map[string]string{
+			"account_id": sr.ForAccount,
+			"memo_type":  sr.MemoType,
+			"memo":       sr.Memo,
+			"asset":      sr.Asset,
+			"min_amount": sr.MinAmount,
+			"max_amount": sr.MaxAmount,
+			"type":       types,
+			"start_time": startTime,
+			"end_time":   endTime,
+		},
+		cursor(sr.Cursor), limit(sr.Limit), sr.Order,
+		includeFailed(sr.IncludeFailed), join(sr.Join),
+	)
+	endpoint = fmt.Sprintf("%s?%s", endpoint, queryParams)
+
+	_, err = url.Parse(endpoint)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse endpoint")
+	}
+
+	return endpoint, err
+}
+
+// millis returns t as milliseconds since the unix epoch, or an empty string
+// for the zero time.
+func millis(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
@@ -0,0 +1,91 @@
+package horizonclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationSearchRequestBuildUrl(t *testing.T) {
+	sr := OperationSearchRequest{}
+	_, err := sr.BuildURL()
+
+	// error case: no filter parameters
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid request: no filter parameters")
+	}
+
+	sr = OperationSearchRequest{Memo: "123", Asset: "native"}
+	_, err = sr.BuildURL()
+
+	// error case: memo without memo type
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid request: memo requires memo type")
+	}
+
+	sr = OperationSearchRequest{MemoType: "id", Memo: "123"}
+	endpoint, err := sr.BuildURL()
+
+	// It should return valid search endpoint and no errors
+	require.NoError(t, err)
+	assert.Equal(t, "operations/search?memo=123&memo_type=id", endpoint)
+
+	sr = OperationSearchRequest{
+		ForAccount:     "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+		Asset:          "USD:GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB",
+		MinAmount:      "10",
+		MaxAmount:      "100.5",
+		OperationTypes: []string{"payment", "path_payment_strict_send"},
+		StartTime:      time.Unix(1590000000, 0),
+		EndTime:        time.Unix(1600000000, 500000000),
+		Cursor:         "123456",
+		Limit:          30,
+		Order:          OrderDesc,
+		IncludeFailed:  true,
+		Join:           "transactions",
+	}
+	endpoint, err = sr.BuildURL()
+
+	// It should return valid search endpoint with query params and no errors
+	require.NoError(t, err)
+	assert.Equal(t, "operations/search?account_id=GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"+
+		"&asset=USD%3AGAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB&cursor=123456"+
+		"&end_time=1600000000500&include_failed=true&join=transactions&limit=30&max_amount=100.5"+
+		"&min_amount=10&order=desc&start_time=1590000000000&type=payment%2Cpath_payment_strict_send", endpoint)
+}
+
+func TestSearchOperations(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/operations/search?limit=2&type=create_account",
+	).ReturnString(200, firstOperationsPage)
+
+	ops, err := client.SearchOperations(OperationSearchRequest{
+		OperationTypes: []string{"create_account"},
+		Limit:          2,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(ops.Embedded.Records), 2)
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/operations/search?type=create_account",
+	).ReturnString(400, badRequestResponse)
+
+	_, err = client.SearchOperations(OperationSearchRequest{OperationTypes: []string{"create_account"}})
+	if assert.Error(t, err) {
+		horizonError, ok := err.(*Error)
+		assert.True(t, ok)
+		assert.Equal(t, "Bad Request", horizonError.Problem.Title)
+	}
+}
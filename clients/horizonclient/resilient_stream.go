@@ -0,0 +1,381 @@
+package horizonclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+)
+
+// StreamOptions configures a resilient stream. Unlike the plain Stream
+// functions, which return as soon as the connection fails, a resilient stream
+// reconnects from the paging token of the last event it handled until its
+// context is cancelled.
+type StreamOptions struct {
+	// CursorStore persists the paging token of the last event handled. When the
+	// store holds a cursor the stream resumes from it, otherwise the stream
+	// starts from the cursor of the request, or "now" if it has none.
+	CursorStore CursorStore
+	// ReconnectDelay is the delay before reconnecting after a failure. It
+	// doubles after every consecutive failure up to MaxReconnectDelay. It
+	// defaults to one second.
+	ReconnectDelay time.Duration
+	// MaxReconnectDelay caps the delay between reconnections. It defaults to one
+	// minute.
+	MaxReconnectDelay time.Duration
+	// MaxConsecutiveFailures is the number of consecutive failed connections
+	// after which the stream stops and returns the last error. A connection
+	// fails if it ends with an error before any event was handled. The stream
+	// never gives up if it is zero.
+	MaxConsecutiveFailures int
+	// OnReconnect is called with the error which ended a connection before the
+	// stream reconnects.
+	OnReconnect func(err error)
+	// OnDuplicate is called with the paging token of every event skipped
+	// because it is not newer than the last event handled.
+	OnDuplicate func(cursor string)
+	// OnGap is called when events may have been missed. The stream goes on if it
+	// returns nil and stops with the returned error otherwise. If OnGap is nil
+	// the stream stops with a *StreamGapError.
+	OnGap func(gap StreamGap) error
+}
+
+// StreamGap describes events which a resilient stream may have missed.
+type StreamGap struct {
+	// LastCursor is the paging token of the last event handled before the gap.
+	LastCursor string
+	// NextCursor is the paging token of the first event after the gap. It is
+	// empty if the stream restarts from "now".
+	NextCursor string
+	// Reason explains why events may have been missed.
+	Reason string
+}
+
+// StreamGapError is returned by a resilient stream which stopped because of a
+// gap.
+type StreamGapError struct {
+	Gap StreamGap
+}
+
+func (e *StreamGapError) Error() string {
+	return fmt.Sprintf("stream gap after cursor %q: %s", e.Gap.LastCursor, e.Gap.Reason)
+}
+
+const (
+	defaultReconnectDelay    = time.Second
+	defaultMaxReconnectDelay = time.Minute
+)
+
+// streamRecord is an event decoded by a resilient stream.
+type streamRecord struct {
+	pagingToken string
+	// ledger is the sequence number of a ledger event, used to detect gaps in
+	// ledger streams. It is zero for other events.
+	ledger int32
+	// handle calls the user handler with the event.
+	handle func()
+}
+
+// resilientStream holds the state of a resilient stream across connections.
+type resilientStream struct {
+	opts       StreamOptions
+	cursor     string
+	lastLedger int32
+	// failures is the number of consecutive failed connections.
+	failures int
+}
+
+func (s *resilientStream) gap(gap StreamGap) error {
+	if s.opts.OnGap == nil {
+		return &StreamGapError{Gap: gap}
+	}
+	return s.opts.OnGap(gap)
+}
+
+// handle checks a record for duplicates and gaps before handling it and
+// saving its paging token.
+func (s *resilientStream) handle(record streamRecord) error {
+	if s.cursor != "" && s.cursor != "now" && !cursorAfter(record.pagingToken, s.cursor) {
+		if s.opts.OnDuplicate != nil {
+			s.opts.OnDuplicate(record.pagingToken)
+		}
+		return nil
+	}
+	if record.ledger != 0 && s.lastLedger != 0 && record.ledger > s.lastLedger+1 {
+		err := s.gap(StreamGap{
+			LastCursor: s.cursor,
+			NextCursor: record.pagingToken,
+			Reason:     fmt.Sprintf("ledgers %d to %d are missing", s.lastLedger+1, record.ledger-1),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	record.handle()
+	s.cursor = record.pagingToken
+	s.lastLedger = record.ledger
+	s.failures = 0
+	if s.opts.CursorStore != nil {
+		if err := s.opts.CursorStore.Save(record.pagingToken); err != nil {
+			return errors.Wrap(err, "could not save stream cursor")
+		}
+	}
+	return nil
+}
+
+// streamWithOptions streams the events of streamURL, decoded with decode,
+// reconnecting after failures as configured by opts.
+func (c *Client) streamWithOptions(
+	ctx context.Context,
+	streamURL string,
+	opts StreamOptions,
+	decode func(data []byte) (streamRecord, error),
+) error {
+	su, err := url.Parse(streamURL)
+	if err != nil {
+		return errors.Wrap(err, "error parsing stream url")
+	}
+	query := su.Query()
+
+	s := &resilientStream{opts: opts, cursor: query.Get("cursor")}
+	if opts.CursorStore != nil {
+		stored, err := opts.CursorStore.Load()
+		if err != nil {
+			return errors.Wrap(err, "could not load stream cursor")
+		}
+		if stored != "" {
+			s.cursor = stored
+		}
+	}
+
+	delay := opts.ReconnectDelay
+	if delay <= 0 {
+		delay = defaultReconnectDelay
+	}
+	maxDelay := opts.MaxReconnectDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxReconnectDelay
+	}
+
+	reconnect := false
+	for {
+		if s.cursor == "" {
+			query.Set("cursor", "now")
+		} else {
+			query.Set("cursor", s.cursor)
+		}
+		su.RawQuery = query.Encode()
+
+		var handlerErr error
+		err = c.streamConnections(ctx, su.String(), reconnect, func(data []byte) error {
+			record, err := decode(data)
+			if err == nil {
+				err = s.handle(record)
+			}
+			handlerErr = err
+			return err
+		})
+		reconnect = true
+		if ctx.Err() != nil {
+			return nil
+		}
+		if handlerErr != nil {
+			return handlerErr
+		}
+
+		if statusErr, ok := errors.Cause(err).(*streamStatusError); ok && statusErr.StatusCode == http.StatusGone {
+			// the cursor is older than the history kept by horizon
+			gapErr := s.gap(StreamGap{LastCursor: s.cursor, Reason: "the cursor is before the recorded history of horizon"})
+			if gapErr != nil {
+				return gapErr
+			}
+			s.cursor = "now"
+			s.lastLedger = 0
+			continue
+		}
+
+		s.failures++
+		if opts.MaxConsecutiveFailures > 0 && s.failures >= opts.MaxConsecutiveFailures {
+			return errors.Wrapf(err, "stream failed %d consecutive times", s.failures)
+		}
+		if opts.OnReconnect != nil {
+			opts.OnReconnect(err)
+		}
+
+		wait := delay
+		for i := 1; i < s.failures && wait < maxDelay; i++ {
+			wait *= 2
+		}
+		if wait > maxDelay {
+			wait = maxDelay
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// cursorAfter returns true if the paging token a comes after b. Paging tokens
+// are numbers, or dash separated numbers for effects. Tokens which cannot be
+// compared are assumed to be in order.
+func cursorAfter(a, b string) bool {
+	if a == b {
+		return false
+	}
+	partsA, partsB := strings.Split(a, "-"), strings.Split(b, "-")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		x, errA := strconv.ParseInt(partsA[i], 10, 64)
+		y, errB := strconv.ParseInt(partsB[i], 10, 64)
+		if errA != nil || errB != nil {
+			return true
+		}
+		if x != y {
+			return x > y
+		}
+	}
+	return len(partsA) > len(partsB)
+}
+
+func (c *Client) streamURL(request HorizonRequest) (string, error) {
+	endpoint, err := request.BuildURL()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to build endpoint")
+	}
+	return c.fixHorizonURL() + endpoint, nil
+}
+
+// StreamTransactionsWithOptions is like StreamTransactions, but reconnects
+// after failures and persists its cursor as configured by opts.
+func (c *Client) StreamTransactionsWithOptions(ctx context.Context, request TransactionRequest, opts StreamOptions, handler TransactionHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		var transaction hProtocol.Transaction
+		if err := json.Unmarshal(data, &transaction); err != nil {
+			return streamRecord{}, errors.Wrap(err, "error unmarshaling data")
+		}
+		return streamRecord{
+			pagingToken: transaction.PagingToken(),
+			handle:      func() { handler(transaction) },
+		}, nil
+	})
+}
+
+// StreamOperationsWithOptions is like StreamOperations, but reconnects after
+// failures and persists its cursor as configured by opts.
+func (c *Client) StreamOperationsWithOptions(ctx context.Context, request OperationRequest, opts StreamOptions, handler OperationHandler) error {
+	return c.streamOperationsWithOptions(ctx, request.SetOperationsEndpoint(), opts, handler)
+}
+
+// StreamPaymentsWithOptions is like StreamPayments, but reconnects after
+// failures and persists its cursor as configured by opts.
+func (c *Client) StreamPaymentsWithOptions(ctx context.Context, request OperationRequest, opts StreamOptions, handler OperationHandler) error {
+	return c.streamOperationsWithOptions(ctx, request.SetPaymentsEndpoint(), opts, handler)
+}
+
+func (c *Client) streamOperationsWithOptions(ctx context.Context, request *OperationRequest, opts StreamOptions, handler OperationHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		op, err := decodeOperation(data)
+		if err != nil {
+			return streamRecord{}, err
+		}
+		return streamRecord{
+			pagingToken: op.PagingToken(),
+			handle:      func() { handler(op) },
+		}, nil
+	})
+}
+
+// StreamEffectsWithOptions is like StreamEffects, but reconnects after
+// failures and persists its cursor as configured by opts.
+func (c *Client) StreamEffectsWithOptions(ctx context.Context, request EffectRequest, opts StreamOptions, handler EffectHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		effect, err := decodeEffect(data)
+		if err != nil {
+			return streamRecord{}, err
+		}
+		return streamRecord{
+			pagingToken: effect.PagingToken(),
+			handle:      func() { handler(effect) },
+		}, nil
+	})
+}
+
+// StreamLedgersWithOptions is like StreamLedgers, but reconnects after
+// failures and persists its cursor as configured by opts. Missing ledgers are
+// reported as gaps.
+func (c *Client) StreamLedgersWithOptions(ctx context.Context, request LedgerRequest, opts StreamOptions, handler LedgerHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		var ledger hProtocol.Ledger
+		if err := json.Unmarshal(data, &ledger); err != nil {
+			return streamRecord{}, errors.Wrap(err, "error unmarshaling data")
+		}
+		return streamRecord{
+			pagingToken: ledger.PagingToken(),
+			ledger:      ledger.Sequence,
+			handle:      func() { handler(ledger) },
+		}, nil
+	})
+}
+
+// StreamTradesWithOptions is like StreamTrades, but reconnects after failures
+// and persists its cursor as configured by opts.
+func (c *Client) StreamTradesWithOptions(ctx context.Context, request TradeRequest, opts StreamOptions, handler TradeHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		var trade hProtocol.Trade
+		if err := json.Unmarshal(data, &trade); err != nil {
+			return streamRecord{}, errors.Wrap(err, "error unmarshaling data")
+		}
+		return streamRecord{
+			pagingToken: trade.PagingToken(),
+			handle:      func() { handler(trade) },
+		}, nil
+	})
+}
+
+// StreamOffersWithOptions is like StreamOffers, but reconnects after failures
+// and persists its cursor as configured by opts.
+func (c *Client) StreamOffersWithOptions(ctx context.Context, request OfferRequest, opts StreamOptions, handler OfferHandler) error {
+	streamURL, err := c.streamURL(request)
+	if err != nil {
+		return err
+	}
+	return c.streamWithOptions(ctx, streamURL, opts, func(data []byte) (streamRecord, error) {
+		var offer hProtocol.Offer
+		if err := json.Unmarshal(data, &offer); err != nil {
+			return streamRecord{}, errors.Wrap(err, "error unmarshaling data")
+		}
+		return streamRecord{
+			pagingToken: offer.PagingToken(),
+			handle:      func() { handler(offer) },
+		}, nil
+	})
+}
@@ -0,0 +1,265 @@
+package horizonclient
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	stdtest "net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseServer is a Horizon streaming endpoint. respond is called for every
+// request with the number of the request, starting at 0, and its cursor.
+type sseServer struct {
+	mutex   sync.Mutex
+	cursors []string
+	respond func(n int, cursor string, w http.ResponseWriter, r *http.Request)
+}
+
+func (s *sseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cursor := r.URL.Query().Get("cursor")
+	s.mutex.Lock()
+	n := len(s.cursors)
+	s.cursors = append(s.cursors, cursor)
+	s.mutex.Unlock()
+	s.respond(n, cursor, w, r)
+}
+
+func (s *sseServer) requestedCursors() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string(nil), s.cursors...)
+}
+
+func newSSEServer(t *testing.T, respond func(n int, cursor string, w http.ResponseWriter, r *http.Request)) (*Client, *sseServer, func()) {
+	s := &sseServer{respond: respond}
+	server := stdtest.NewServer(s)
+	client := &Client{HorizonURL: server.URL, HTTP: http.DefaultClient}
+	return client, s, server.Close
+}
+
+// writeTransactions writes transaction events with the given paging tokens.
+// The connection is held open until the client goes away if hold is true.
+func writeTransactions(w http.ResponseWriter, r *http.Request, hold bool, tokens ...string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, token := range tokens {
+		fmt.Fprintf(w, "id: %s\ndata: {\"id\":\"tx%s\",\"paging_token\":\"%s\",\"hash\":\"hash%s\"}\n\n", token, token, token, token)
+	}
+	w.(http.Flusher).Flush()
+	if hold {
+		<-r.Context().Done()
+	}
+}
+
+func writeLedgers(w http.ResponseWriter, r *http.Request, sequences ...int32) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, sequence := range sequences {
+		token := fmt.Sprintf("%d", int64(sequence)<<32)
+		fmt.Fprintf(w, "id: %s\ndata: {\"id\":\"ledger%d\",\"paging_token\":\"%s\",\"sequence\":%d}\n\n", token, sequence, token, sequence)
+	}
+	w.(http.Flusher).Flush()
+	<-r.Context().Done()
+}
+
+func TestStreamWithOptionsReconnects(t *testing.T) {
+	client, server, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		switch n {
+		case 0:
+			// the connection is closed after a few events
+			writeTransactions(w, r, false, "1", "2", "3")
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			writeTransactions(w, r, true, "4", "5", "6")
+		}
+	})
+	defer closeServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	store := NewMemoryCursorStore("")
+	var reconnects []error
+	var hashes []string
+	err := client.StreamTransactionsWithOptions(ctx, TransactionRequest{}, StreamOptions{
+		CursorStore:    store,
+		ReconnectDelay: time.Millisecond,
+		OnReconnect:    func(err error) { reconnects = append(reconnects, err) },
+	}, func(tx hProtocol.Transaction) {
+		hashes = append(hashes, tx.Hash)
+		if tx.PT == "6" {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"hash1", "hash2", "hash3", "hash4", "hash5", "hash6"}, hashes)
+	assert.Equal(t, []string{"now", "3", "3"}, server.requestedCursors())
+	require.Len(t, reconnects, 1)
+	assert.EqualError(t, reconnects[0], "got bad HTTP status code 503")
+	cursor, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "6", cursor)
+}
+
+func TestStreamWithOptionsSkipsDuplicates(t *testing.T) {
+	// the server ignores the cursor and replays events
+	client, _, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		if n == 0 {
+			writeTransactions(w, r, false, "1", "2", "3")
+			return
+		}
+		writeTransactions(w, r, true, "2", "3", "4")
+	})
+	defer closeServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var duplicates, tokens []string
+	err := client.StreamTransactionsWithOptions(ctx, TransactionRequest{}, StreamOptions{
+		OnDuplicate: func(cursor string) { duplicates = append(duplicates, cursor) },
+	}, func(tx hProtocol.Transaction) {
+		tokens = append(tokens, tx.PT)
+		if tx.PT == "4" {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, tokens)
+	assert.Equal(t, []string{"2", "3"}, duplicates)
+}
+
+func TestStreamWithOptionsResumesFromFileCursor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "horizonclient")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := FileCursorStore{Path: filepath.Join(dir, "cursor")}
+
+	cursor, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "", cursor)
+	require.NoError(t, store.Save("5"))
+
+	client, server, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		writeTransactions(w, r, true, "6", "7")
+	})
+	defer closeServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var tokens []string
+	// the stored cursor takes precedence over the cursor of the request
+	err = client.StreamTransactionsWithOptions(ctx, TransactionRequest{Cursor: "1"}, StreamOptions{CursorStore: store},
+		func(tx hProtocol.Transaction) {
+			tokens = append(tokens, tx.PT)
+			if tx.PT == "7" {
+				cancel()
+			}
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"6", "7"}, tokens)
+	assert.Equal(t, []string{"5"}, server.requestedCursors())
+
+	cursor, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "7", cursor)
+}
+
+func TestStreamLedgersWithOptionsDetectsGaps(t *testing.T) {
+	client, _, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		writeLedgers(w, r, 1, 2, 4, 5)
+	})
+	defer closeServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var sequences []int32
+	handler := func(ledger hProtocol.Ledger) {
+		sequences = append(sequences, ledger.Sequence)
+		if ledger.Sequence == 5 {
+			cancel()
+		}
+	}
+
+	// without OnGap the stream stops
+	err := client.StreamLedgersWithOptions(ctx, LedgerRequest{}, StreamOptions{}, handler)
+	gapErr, ok := errors.Cause(err).(*StreamGapError)
+	require.True(t, ok, err)
+	assert.Equal(t, StreamGap{
+		LastCursor: fmt.Sprintf("%d", int64(2)<<32),
+		NextCursor: fmt.Sprintf("%d", int64(4)<<32),
+		Reason:     "ledgers 3 to 3 are missing",
+	}, gapErr.Gap)
+	assert.Equal(t, []int32{1, 2}, sequences)
+
+	// the stream goes on if OnGap returns nil
+	sequences = nil
+	var gaps []StreamGap
+	err = client.StreamLedgersWithOptions(ctx, LedgerRequest{}, StreamOptions{
+		OnGap: func(gap StreamGap) error {
+			gaps = append(gaps, gap)
+			return nil
+		},
+	}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{1, 2, 4, 5}, sequences)
+	assert.Len(t, gaps, 1)
+}
+
+func TestStreamWithOptionsBeforeHistory(t *testing.T) {
+	client, server, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		if cursor == "1" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		writeTransactions(w, r, true, "100")
+	})
+	defer closeServer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var gaps []StreamGap
+	err := client.StreamTransactionsWithOptions(ctx, TransactionRequest{Cursor: "1"}, StreamOptions{
+		OnGap: func(gap StreamGap) error {
+			gaps = append(gaps, gap)
+			return nil
+		},
+	}, func(tx hProtocol.Transaction) {
+		cancel()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []StreamGap{{LastCursor: "1", Reason: "the cursor is before the recorded history of horizon"}}, gaps)
+	assert.Equal(t, []string{"1", "now"}, server.requestedCursors())
+}
+
+func TestStreamWithOptionsGivesUp(t *testing.T) {
+	client, server, closeServer := newSSEServer(t, func(n int, cursor string, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer closeServer()
+
+	err := client.StreamTransactionsWithOptions(context.Background(), TransactionRequest{}, StreamOptions{
+		ReconnectDelay:         time.Millisecond,
+		MaxConsecutiveFailures: 3,
+	}, func(tx hProtocol.Transaction) {})
+	assert.EqualError(t, err, "stream failed 3 consecutive times: got bad HTTP status code 500")
+	assert.Len(t, server.requestedCursors(), 3)
+}
+
+func TestCursorAfter(t *testing.T) {
+	assert.True(t, cursorAfter("10", "9"))
+	assert.False(t, cursorAfter("9", "10"))
+	assert.False(t, cursorAfter("10", "10"))
+	assert.True(t, cursorAfter("2531135896703017-2", "2531135896703017-1"))
+	assert.False(t, cursorAfter("2531135896703016-3", "2531135896703017-1"))
+	assert.True(t, cursorAfter("abc", "10"))
+}
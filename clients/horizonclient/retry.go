@@ -0,0 +1,206 @@
+package horizonclient
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// RetryPolicy configures how a Client retries requests which failed with a
+// transient error: a network error, or a 429 (rate limit exceeded), 503 or 504
+// response from Horizon.
+//
+// GET requests are always safe to retry. Transaction submissions are only
+// retried if RetrySubmissions is set.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt. Requests are not retried if it is lower than 2.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. The delay doubles
+	// after every attempt, and a random jitter of up to half the delay is
+	// subtracted from it.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. If Horizon asks the
+	// client to wait longer than MaxBackoff, with the Retry-After or
+	// X-Ratelimit-Reset headers, the request is not retried. There is no cap if
+	// it is zero.
+	MaxBackoff time.Duration
+	// RetrySubmissions enables retries of transaction submissions. A submission
+	// whose outcome is unknown may have been included in a ledger, so before
+	// resubmitting the transaction the client looks it up by hash with
+	// TransactionDetail and returns it if it was found.
+	RetrySubmissions bool
+}
+
+// DefaultRetryPolicy is a RetryPolicy suitable for most applications. It does
+// not retry transaction submissions.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+}
+
+// SetRetryPolicy sets the policy used to retry requests which failed with a
+// transient error. Requests are not retried if policy is nil.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) *Client {
+	c.retryPolicy = policy
+	return c
+}
+
+// RetryPolicy returns the retry policy of the client, nil if requests are not
+// retried.
+func (c *Client) RetryPolicy() *RetryPolicy {
+	return c.retryPolicy
+}
+
+// isRetryableStatus returns true for the response status codes which indicate
+// that a request was not processed and can be sent again.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay returns how long to wait before sending a request again after the
+// attempt-th attempt failed with err, and false if the request must not be
+// retried. transportErr is true if the request did not get a response.
+func (c *Client) retryDelay(attempt int, transportErr bool, err error) (time.Duration, bool) {
+	policy := c.retryPolicy
+	if policy == nil || attempt >= policy.MaxAttempts {
+		return 0, false
+	}
+
+	delay := policy.backoff(attempt)
+	if !transportErr {
+		hErr := GetError(err)
+		if hErr == nil || hErr.Response == nil || !isRetryableStatus(hErr.Response.StatusCode) {
+			return 0, false
+		}
+		if wait, ok := serverRetryDelay(hErr.Response, c.clock.Now()); ok {
+			if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+				return 0, false
+			}
+			delay = wait
+		}
+	}
+	return delay, true
+}
+
+// backoff returns the exponential backoff with jitter after the attempt-th
+// attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay > 0; i++ {
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay/2) + 1))
+	return delay - jitter
+}
+
+// serverRetryDelay returns the delay requested by Horizon in the Retry-After
+// header, or in the X-Ratelimit-Reset header of rate limited responses.
+func serverRetryDelay(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			if delay := date.Sub(now); delay > 0 {
+				return delay, true
+			}
+			return 0, true
+		}
+	}
+	if value := resp.Header.Get("X-Ratelimit-Reset"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	return 0, false
+}
+
+// wait pauses before a retry.
+func (c *Client) wait(delay time.Duration) {
+	if c.sleep != nil {
+		c.sleep(delay)
+		return
+	}
+	time.Sleep(delay)
+}
+
+// submitWithRetries submits a transaction, retrying according to the retry
+// policy of the client.
+func (c *Client) submitWithRetries(request submitRequest) (tx hProtocol.Transaction, err error) {
+	var txHash string
+	for attempt := 1; ; attempt++ {
+		tx = hProtocol.Transaction{}
+		err = c.sendRequest(request, &tx)
+		if err == nil {
+			return tx, nil
+		}
+
+		// an error which is not a Horizon error means the outcome of the
+		// submission is unknown
+		hErr := GetError(err)
+		delay, retry := c.retryDelay(attempt, hErr == nil, err)
+		if !retry {
+			return tx, err
+		}
+		c.wait(delay)
+
+		// a rate limited submission was not processed by Horizon
+		if hErr != nil && hErr.Response.StatusCode == http.StatusTooManyRequests {
+			continue
+		}
+		if txHash == "" {
+			txHash, err = c.submittedTransactionHash(request.transactionXdr)
+			if err != nil {
+				return tx, errors.Wrap(err, "could not check whether the transaction was included in a ledger")
+			}
+		}
+		included, checkErr := c.TransactionDetail(txHash)
+		if checkErr == nil {
+			return included, nil
+		}
+		if checkHErr := GetError(checkErr); checkHErr == nil || checkHErr.Response.StatusCode != http.StatusNotFound {
+			return tx, errors.Wrap(checkErr, "could not check whether the transaction was included in a ledger")
+		}
+	}
+}
+
+// submittedTransactionHash returns the hex encoded hash of a transaction
+// envelope, using the network passphrase of the Horizon server.
+func (c *Client) submittedTransactionHash(transactionXdr string) (string, error) {
+	var envelope xdr.TransactionEnvelope
+	if err := xdr.SafeUnmarshalBase64(transactionXdr, &envelope); err != nil {
+		return "", errors.Wrap(err, "could not decode transaction envelope")
+	}
+	root, err := c.Root()
+	if err != nil {
+		return "", errors.Wrap(err, "could not load network passphrase")
+	}
+	hash, err := network.HashTransactionInEnvelope(envelope, root.NetworkPassphrase)
+	if err != nil {
+		return "", errors.Wrap(err, "could not hash transaction")
+	}
+	return hex.EncodeToString(hash[:]), nil
+}
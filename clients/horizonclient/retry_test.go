@@ -0,0 +1,263 @@
+package horizonclient
+
+import (
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var serviceUnavailableResponse = `{
+  "type": "https://stellar.org/horizon-errors/service_unavailable",
+  "title": "Service Unavailable",
+  "status": 503
+}`
+
+var rateLimitResponse = `{
+  "type": "https://stellar.org/horizon-errors/rate_limit_exceeded",
+  "title": "Rate Limit Exceeded",
+  "status": 429
+}`
+
+var timeoutResponse = `{
+  "type": "https://stellar.org/horizon-errors/timeout",
+  "title": "Timeout",
+  "status": 504
+}`
+
+// responses returns a responder which replies with the given responders in
+// order, repeating the last one, and counts the requests it receives.
+func responses(calls *int, responders ...httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		i := *calls
+		*calls++
+		if i >= len(responders) {
+			i = len(responders) - 1
+		}
+		return responders[i](req)
+	}
+}
+
+func errorResponder(msg string) httpmock.Responder {
+	return func(*http.Request) (*http.Response, error) {
+		return nil, errors.New(msg)
+	}
+}
+
+// stringResponder is like httpmock.NewStringResponder, with a new body for
+// every request.
+func stringResponder(status int, body string) httpmock.Responder {
+	return responseWithHeader(status, body, http.Header{})
+}
+
+func responseWithHeader(status int, body string, header http.Header) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(status, body)
+		resp.Header = header
+		return resp, nil
+	}
+}
+
+func newRetryTestClient(policy *RetryPolicy) (*Client, *httptest.Client, *[]time.Duration) {
+	hmock := httptest.NewClient()
+	var sleeps []time.Duration
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+		sleep:      func(d time.Duration) { sleeps = append(sleeps, d) },
+	}
+	client.SetRetryPolicy(policy)
+	return client, hmock, &sleeps
+}
+
+func TestRetryGetRequests(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	client, hmock, sleeps := newRetryTestClient(policy)
+
+	calls := 0
+	hmock.On("GET", "https://localhost/ledgers/1").Return(responses(&calls,
+		stringResponder(503, serviceUnavailableResponse),
+		errorResponder("connection reset"),
+		stringResponder(200, ledgerResponse),
+	))
+
+	ledger, err := client.LedgerDetail(1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(69859), ledger.Sequence)
+	assert.Equal(t, 3, calls)
+	require.Len(t, *sleeps, 2)
+	assert.True(t, (*sleeps)[0] >= 500*time.Millisecond && (*sleeps)[0] <= time.Second, (*sleeps)[0])
+	assert.True(t, (*sleeps)[1] >= time.Second && (*sleeps)[1] <= 2*time.Second, (*sleeps)[1])
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	client, hmock, sleeps := newRetryTestClient(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	calls := 0
+	hmock.On("GET", "https://localhost/ledgers/1").
+		Return(responses(&calls, stringResponder(503, serviceUnavailableResponse)))
+	_, err := client.LedgerDetail(1)
+	if assert.Error(t, err) {
+		assert.Equal(t, 503, GetError(err).Response.StatusCode)
+	}
+	assert.Equal(t, 3, calls)
+	assert.Len(t, *sleeps, 2)
+
+	// client errors are not retried
+	calls = 0
+	hmock.On("GET", "https://localhost/ledgers/1").
+		Return(responses(&calls, stringResponder(404, notFoundResponse)))
+	_, err = client.LedgerDetail(1)
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	// requests are not retried without a retry policy
+	client.SetRetryPolicy(nil)
+	calls = 0
+	hmock.On("GET", "https://localhost/ledgers/1").
+		Return(responses(&calls, stringResponder(503, serviceUnavailableResponse)))
+	_, err = client.LedgerDetail(1)
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryHonorsServerDelay(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Second}
+	client, hmock, sleeps := newRetryTestClient(policy)
+
+	calls := 0
+	hmock.On("GET", "https://localhost/ledgers/1").Return(responses(&calls,
+		responseWithHeader(429, rateLimitResponse, http.Header{"Retry-After": []string{"2"}}),
+		responseWithHeader(429, rateLimitResponse, http.Header{"X-Ratelimit-Reset": []string{"3"}}),
+		stringResponder(200, ledgerResponse),
+	))
+	_, err := client.LedgerDetail(1)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{2 * time.Second, 3 * time.Second}, *sleeps)
+
+	// the request is not retried if the server asks to wait too long
+	*sleeps = nil
+	calls = 0
+	hmock.On("GET", "https://localhost/ledgers/1").Return(responses(&calls,
+		responseWithHeader(429, rateLimitResponse, http.Header{"X-Ratelimit-Reset": []string{"60"}}),
+	))
+	_, err = client.LedgerDetail(1)
+	if assert.Error(t, err) {
+		assert.Equal(t, 429, GetError(err).Response.StatusCode)
+	}
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, *sleeps)
+}
+
+func TestServerRetryDelay(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	resp := &http.Response{Header: http.Header{}}
+	_, ok := serverRetryDelay(resp, now)
+	assert.False(t, ok)
+
+	resp.Header.Set("Retry-After", now.Add(7*time.Second).Format(http.TimeFormat))
+	delay, ok := serverRetryDelay(resp, now)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, delay)
+
+	resp.Header.Set("Retry-After", now.Add(-time.Minute).Format(http.TimeFormat))
+	delay, ok = serverRetryDelay(resp, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	resp.Header.Set("Retry-After", "soon")
+	resp.Header.Set("X-Ratelimit-Reset", "4")
+	delay, ok = serverRetryDelay(resp, now)
+	assert.True(t, ok)
+	assert.Equal(t, 4*time.Second, delay)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1)
+		assert.True(t, delay >= 500*time.Millisecond && delay <= time.Second, delay)
+		delay = policy.backoff(3)
+		assert.True(t, delay >= 2*time.Second && delay <= 4*time.Second, delay)
+		delay = policy.backoff(10)
+		assert.True(t, delay >= 2500*time.Millisecond && delay <= 5*time.Second, delay)
+	}
+}
+
+func TestRetrySubmission(t *testing.T) {
+	txXdr := `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+	var envelope xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(txXdr, &envelope))
+	hash, err := network.HashTransactionInEnvelope(envelope, network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	txURL := "https://localhost/transactions/" + hex.EncodeToString(hash[:])
+
+	// submissions are not retried unless enabled
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	client, hmock, _ := newRetryTestClient(policy)
+	submissions := 0
+	hmock.On("POST", "https://localhost/transactions").Return(responses(&submissions,
+		stringResponder(504, timeoutResponse),
+	))
+	_, err = client.SubmitTransactionXDR(txXdr)
+	assert.Error(t, err)
+	assert.Equal(t, 1, submissions)
+
+	// the transaction is resubmitted if it was not included in a ledger
+	policy.RetrySubmissions = true
+	submissions = 0
+	checks := 0
+	hmock.On("GET", "https://localhost/").Return(stringResponder(200, rootResponse))
+	hmock.On("POST", "https://localhost/transactions").Return(responses(&submissions,
+		stringResponder(504, timeoutResponse),
+		stringResponder(200, txSuccess),
+	))
+	hmock.On("GET", txURL).Return(responses(&checks, stringResponder(404, notFoundResponse)))
+	tx, err := client.SubmitTransactionXDR(txXdr)
+	require.NoError(t, err)
+	assert.Equal(t, "bcc7a97264dca0a51a63f7ea971b5e7458e334489673078bb2a34eb0cce910ca", tx.Hash)
+	assert.Equal(t, 2, submissions)
+	assert.Equal(t, 1, checks)
+
+	// the transaction is not resubmitted if it was included in a ledger
+	submissions = 0
+	checks = 0
+	hmock.On("POST", "https://localhost/transactions").Return(responses(&submissions,
+		errorResponder("connection reset"),
+	))
+	hmock.On("GET", txURL).Return(responses(&checks, stringResponder(200, txSuccess)))
+	tx, err = client.SubmitTransactionXDR(txXdr)
+	require.NoError(t, err)
+	assert.Equal(t, "bcc7a97264dca0a51a63f7ea971b5e7458e334489673078bb2a34eb0cce910ca", tx.Hash)
+	assert.Equal(t, 1, submissions)
+	assert.Equal(t, 1, checks)
+
+	// rate limited submissions are resubmitted without checking
+	submissions = 0
+	checks = 0
+	hmock.On("POST", "https://localhost/transactions").Return(responses(&submissions,
+		stringResponder(429, rateLimitResponse),
+		stringResponder(200, txSuccess),
+	))
+	_, err = client.SubmitTransactionXDR(txXdr)
+	require.NoError(t, err)
+	assert.Equal(t, 2, submissions)
+	assert.Equal(t, 0, checks)
+
+	// failed transactions are not resubmitted
+	submissions = 0
+	hmock.On("POST", "https://localhost/transactions").Return(responses(&submissions,
+		stringResponder(400, transactionFailure),
+	))
+	_, err = client.SubmitTransactionXDR(txXdr)
+	assert.Error(t, err)
+	assert.Equal(t, 1, submissions)
+}
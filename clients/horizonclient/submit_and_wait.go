@@ -0,0 +1,354 @@
+package horizonclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/stellar/go/amount"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// SubmitAndWaitOpts configures SubmitTransactionAndWait and
+// SubmitFeeBumpTransactionAndWait.
+type SubmitAndWaitOpts struct {
+	SubmitTxOpts
+	// PollInterval is the delay between two lookups of a transaction whose
+	// submission timed out. Defaults to 1 second.
+	PollInterval time.Duration
+}
+
+// SubmissionResult is the outcome of a transaction submitted with
+// SubmitTransactionAndWait.
+type SubmissionResult struct {
+	// Hash is the hex encoded hash of the transaction.
+	Hash string
+	// Successful is true if the transaction and all its operations succeeded.
+	Successful bool
+	// Transaction is the transaction as returned by Horizon. It is empty if the
+	// transaction was rejected without being included in a ledger.
+	Transaction hProtocol.Transaction
+	// ResultXdr is the base64 encoded result of the transaction.
+	ResultXdr string
+	// Result is the decoded result of the transaction.
+	Result xdr.TransactionResult
+	// Code is the result code of the transaction, like "tx_success" or
+	// "tx_bad_seq". It is empty if Horizon did not return the result codes,
+	// when a failed transaction was found in a ledger after its submission
+	// timed out.
+	Code string
+	// Operations are the results of the operations of the transaction, in
+	// order. They are empty if the transaction failed before its operations
+	// were applied.
+	Operations []OperationResult
+}
+
+// OperationResult is the outcome of an operation of a submitted transaction.
+type OperationResult struct {
+	// Code is the result code of the operation, like "op_success" or
+	// "op_underfunded". It is empty if Horizon did not return the result
+	// codes.
+	Code string
+	// Result is the decoded result of the operation.
+	Result xdr.OperationResult
+}
+
+// Type returns the type of the operation, and false if the operation was not
+// applied.
+func (r OperationResult) Type() (xdr.OperationType, bool) {
+	if r.Result.Tr == nil {
+		return 0, false
+	}
+	return r.Result.Tr.Type, true
+}
+
+// AmountSent returns the amount sent by a successful path payment strict
+// receive operation, denominated in the source asset. The amount sent by a
+// path payment strict send operation is the SendAmount of the operation.
+func (r OperationResult) AmountSent() (string, bool) {
+	if r.Result.Tr == nil {
+		return "", false
+	}
+	result, ok := r.Result.Tr.GetPathPaymentStrictReceiveResult()
+	if !ok || result.Code != xdr.PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess {
+		return "", false
+	}
+	return amount.String(result.SendAmount()), true
+}
+
+// AmountReceived returns the amount received by the destination of a
+// successful path payment operation, denominated in the destination asset.
+func (r OperationResult) AmountReceived() (string, bool) {
+	if r.Result.Tr == nil {
+		return "", false
+	}
+	if result, ok := r.Result.Tr.GetPathPaymentStrictSendResult(); ok {
+		if result.Code != xdr.PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess {
+			return "", false
+		}
+		return amount.String(result.DestAmount()), true
+	}
+	if result, ok := r.Result.Tr.GetPathPaymentStrictReceiveResult(); ok {
+		success, ok := result.GetSuccess()
+		if !ok {
+			return "", false
+		}
+		return amount.String(success.Last.Amount), true
+	}
+	return "", false
+}
+
+// OffersClaimed returns the offers crossed by a successful path payment or
+// offer operation.
+func (r OperationResult) OffersClaimed() []xdr.ClaimOfferAtom {
+	if success, ok := r.manageOfferSuccess(); ok {
+		return success.OffersClaimed
+	}
+	if r.Result.Tr == nil {
+		return nil
+	}
+	if result, ok := r.Result.Tr.GetPathPaymentStrictReceiveResult(); ok {
+		if success, ok := result.GetSuccess(); ok {
+			return success.Offers
+		}
+	}
+	if result, ok := r.Result.Tr.GetPathPaymentStrictSendResult(); ok {
+		if success, ok := result.GetSuccess(); ok {
+			return success.Offers
+		}
+	}
+	return nil
+}
+
+// OfferID returns the id of the offer created or updated by a successful
+// offer operation, and false if the offer was deleted or fully crossed.
+func (r OperationResult) OfferID() (int64, bool) {
+	success, ok := r.manageOfferSuccess()
+	if !ok || success.Offer.Offer == nil {
+		return 0, false
+	}
+	return int64(success.Offer.Offer.OfferId), true
+}
+
+func (r OperationResult) manageOfferSuccess() (xdr.ManageOfferSuccessResult, bool) {
+	if r.Result.Tr == nil {
+		return xdr.ManageOfferSuccessResult{}, false
+	}
+	switch r.Result.Tr.Type {
+	case xdr.OperationTypeManageSellOffer:
+		return r.Result.Tr.MustManageSellOfferResult().GetSuccess()
+	case xdr.OperationTypeCreatePassiveSellOffer:
+		return r.Result.Tr.MustCreatePassiveSellOfferResult().GetSuccess()
+	case xdr.OperationTypeManageBuyOffer:
+		return r.Result.Tr.MustManageBuyOfferResult().GetSuccess()
+	default:
+		return xdr.ManageOfferSuccessResult{}, false
+	}
+}
+
+// SubmissionError is the error returned by SubmitTransactionAndWait when a
+// transaction failed, was rejected, or expired before being included in a
+// ledger.
+type SubmissionError struct {
+	// Hash is the hex encoded hash of the transaction. It is empty if Horizon
+	// rejected the transaction without returning its hash.
+	Hash string
+	// Expired is true if the submission of the transaction timed out and the
+	// transaction can no longer be included in a ledger because its timebounds
+	// expired.
+	Expired bool
+	// Result is the outcome of the transaction. It is nil if the transaction
+	// expired.
+	Result *SubmissionResult
+	// Err is the *Error returned by Horizon when it rejected the
+	// transaction.
+	Err error
+}
+
+func (e *SubmissionError) Error() string {
+	switch {
+	case e.Expired:
+		return "transaction " + e.Hash + " expired before being included in a ledger"
+	case e.Result != nil && e.Result.Code != "":
+		return "transaction failed: " + e.Result.Code
+	case e.Err != nil:
+		return "transaction failed: " + e.Err.Error()
+	default:
+		return "transaction failed"
+	}
+}
+
+// SubmitTransactionAndWait submits a transaction and returns its decoded
+// result. If the submission times out, the transaction is looked up with
+// TransactionDetail until it is included in a ledger, or until a ledger closed
+// after the max time of its timebounds, or until ctx is done.
+//
+// The error is a *SubmissionError if the transaction failed, was rejected by
+// Horizon or expired.
+func (c *Client) SubmitTransactionAndWait(ctx context.Context, transaction *txnbuild.Transaction, opts SubmitAndWaitOpts) (*SubmissionResult, error) {
+	txeBase64, err := transaction.Base64()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert transaction object to base64 string")
+	}
+	return c.submitAndWait(ctx, txeBase64, transaction.Timebounds().MaxTime, opts, func() (hProtocol.Transaction, error) {
+		return c.SubmitTransactionWithOptions(transaction, opts.SubmitTxOpts)
+	})
+}
+
+// SubmitFeeBumpTransactionAndWait is like SubmitTransactionAndWait for fee
+// bump transactions.
+func (c *Client) SubmitFeeBumpTransactionAndWait(ctx context.Context, transaction *txnbuild.FeeBumpTransaction, opts SubmitAndWaitOpts) (*SubmissionResult, error) {
+	txeBase64, err := transaction.Base64()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert transaction object to base64 string")
+	}
+	maxTime := transaction.InnerTransaction().Timebounds().MaxTime
+	return c.submitAndWait(ctx, txeBase64, maxTime, opts, func() (hProtocol.Transaction, error) {
+		return c.SubmitFeeBumpTransactionWithOptions(transaction, opts.SubmitTxOpts)
+	})
+}
+
+func (c *Client) submitAndWait(
+	ctx context.Context,
+	transactionXdr string,
+	maxTime int64,
+	opts SubmitAndWaitOpts,
+	submit func() (hProtocol.Transaction, error),
+) (*SubmissionResult, error) {
+	tx, err := submit()
+	if err == nil {
+		return includedTransactionResult(tx)
+	}
+
+	hErr := GetError(err)
+	_, netErr := errors.Cause(err).(net.Error)
+	timedOut := (hErr != nil && hErr.Response.StatusCode == http.StatusGatewayTimeout) || (hErr == nil && netErr)
+	if !timedOut {
+		if hErr != nil && hErr.Response.StatusCode == http.StatusBadRequest {
+			return nil, rejectedTransactionError(hErr)
+		}
+		return nil, err
+	}
+
+	hash, err := c.submittedTransactionHash(transactionXdr)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not look up the transaction after its submission timed out")
+	}
+	return c.waitForTransaction(ctx, hash, maxTime, opts.PollInterval)
+}
+
+// waitForTransaction looks up a transaction until it is found, it expired or
+// ctx is done.
+func (c *Client) waitForTransaction(ctx context.Context, hash string, maxTime int64, pollInterval time.Duration) (*SubmissionResult, error) {
+	if pollInterval == 0 {
+		pollInterval = time.Second
+	}
+	for {
+		// the latest ledger is loaded before looking up the transaction, so
+		// that the transaction cannot be included after the lookup in a
+		// ledger which is considered
+		expired := false
+		if maxTime > 0 && c.clock.Now().Unix() > maxTime {
+			ledgers, err := c.Ledgers(LedgerRequest{Order: OrderDesc, Limit: 1})
+			if err == nil && len(ledgers.Embedded.Records) > 0 {
+				expired = ledgers.Embedded.Records[0].ClosedAt.Unix() > maxTime
+			}
+		}
+
+		tx, err := c.TransactionDetail(hash)
+		if err == nil {
+			return includedTransactionResult(tx)
+		}
+		hErr := GetError(err)
+		switch {
+		case hErr != nil && hErr.Response.StatusCode == http.StatusNotFound:
+			if expired {
+				return nil, &SubmissionError{Hash: hash, Expired: true}
+			}
+		case !isServerFailure(err):
+			return nil, errors.Wrap(err, "could not look up the transaction after its submission timed out")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// includedTransactionResult returns the result of a transaction which was
+// included in a ledger, and a *SubmissionError if it failed.
+func includedTransactionResult(tx hProtocol.Transaction) (*SubmissionResult, error) {
+	result := &SubmissionResult{
+		Hash:        tx.Hash,
+		Successful:  tx.Successful,
+		Transaction: tx,
+		ResultXdr:   tx.ResultXdr,
+	}
+	if err := result.decode(nil); err != nil {
+		return nil, err
+	}
+	if !result.Successful {
+		return nil, &SubmissionError{Hash: tx.Hash, Result: result}
+	}
+	return result, nil
+}
+
+// rejectedTransactionError returns the error of a transaction rejected by
+// Horizon.
+func rejectedTransactionError(hErr *Error) error {
+	submissionErr := &SubmissionError{Err: hErr}
+	if hash, ok := hErr.Problem.Extras["hash"].(string); ok {
+		submissionErr.Hash = hash
+	}
+	resultXdr, err := hErr.ResultString()
+	if err != nil {
+		return submissionErr
+	}
+
+	result := &SubmissionResult{Hash: submissionErr.Hash, ResultXdr: resultXdr}
+	codes, err := hErr.ResultCodes()
+	if err != nil {
+		codes = nil
+	}
+	if err := result.decode(codes); err != nil {
+		return err
+	}
+	submissionErr.Result = result
+	return submissionErr
+}
+
+// decode decodes ResultXdr, and sets the result codes returned by Horizon if
+// there are some.
+func (r *SubmissionResult) decode(codes *hProtocol.TransactionResultCodes) error {
+	if err := xdr.SafeUnmarshalBase64(r.ResultXdr, &r.Result); err != nil {
+		return errors.Wrap(err, "could not decode transaction result")
+	}
+
+	if codes != nil {
+		r.Code = codes.TransactionCode
+	} else if r.Successful {
+		r.Code = "tx_success"
+		if r.Result.Result.Code == xdr.TransactionResultCodeTxFeeBumpInnerSuccess {
+			r.Code = "tx_fee_bump_inner_success"
+		}
+	}
+
+	opResults, _ := r.Result.OperationResults()
+	r.Operations = make([]OperationResult, len(opResults))
+	for i, opResult := range opResults {
+		r.Operations[i].Result = opResult
+		switch {
+		case codes != nil && i < len(codes.OperationCodes):
+			r.Operations[i].Code = codes.OperationCodes[i]
+		case r.Successful:
+			r.Operations[i].Code = "op_success"
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package horizonclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/clock/clocktest"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWaitTestTransaction returns a signed transaction valid until maxTime, and
+// its hash.
+func newWaitTestTransaction(t *testing.T, maxTime int64) (*txnbuild.Transaction, string) {
+	kp := keypair.MustRandom()
+	tx, err := txnbuild.NewTransaction(txnbuild.TransactionParams{
+		SourceAccount: &txnbuild.SimpleAccount{AccountID: kp.Address(), Sequence: 1},
+		Operations:    []txnbuild.Operation{&txnbuild.BumpSequence{BumpTo: 10}},
+		BaseFee:       txnbuild.MinBaseFee,
+		Timebounds:    txnbuild.NewTimebounds(0, maxTime),
+	})
+	require.NoError(t, err)
+	tx, err = tx.Sign(network.TestNetworkPassphrase, kp)
+	require.NoError(t, err)
+	hash, err := tx.HashHex(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	return tx, hash
+}
+
+func newWaitTestClient(now time.Time) (*Client, *httptest.Client) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+		clock:      &clock.Clock{Source: clocktest.FixedSource(now)},
+	}
+	hmock.On("GET", "https://localhost/").Return(stringResponder(200, rootResponse))
+	return client, hmock
+}
+
+var waitOpts = SubmitAndWaitOpts{
+	SubmitTxOpts: SubmitTxOpts{SkipMemoRequiredCheck: true},
+	PollInterval: time.Millisecond,
+}
+
+func TestSubmitTransactionAndWaitSuccess(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	client, hmock := newWaitTestClient(now)
+	tx, _ := newWaitTestTransaction(t, now.Add(time.Minute).Unix())
+	hmock.On("POST", "https://localhost/transactions").Return(stringResponder(200, txSuccess))
+
+	result, err := client.SubmitTransactionAndWait(context.Background(), tx, waitOpts)
+	require.NoError(t, err)
+	assert.True(t, result.Successful)
+	assert.Equal(t, "bcc7a97264dca0a51a63f7ea971b5e7458e334489673078bb2a34eb0cce910ca", result.Hash)
+	assert.Equal(t, int32(354811), result.Transaction.Ledger)
+	assert.Equal(t, "tx_success", result.Code)
+	assert.Equal(t, xdr.TransactionResultCodeTxSuccess, result.Result.Result.Code)
+	require.Len(t, result.Operations, 1)
+	assert.Equal(t, "op_success", result.Operations[0].Code)
+	opType, ok := result.Operations[0].Type()
+	assert.True(t, ok)
+	assert.Equal(t, xdr.OperationTypeCreateAccount, opType)
+}
+
+func TestSubmitTransactionAndWaitRejected(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	client, hmock := newWaitTestClient(now)
+	tx, _ := newWaitTestTransaction(t, now.Add(time.Minute).Unix())
+	hmock.On("POST", "https://localhost/transactions").Return(stringResponder(400, transactionFailure))
+
+	result, err := client.SubmitTransactionAndWait(context.Background(), tx, waitOpts)
+	assert.Nil(t, result)
+	require.IsType(t, &SubmissionError{}, err)
+	submissionErr := err.(*SubmissionError)
+	assert.EqualError(t, err, "transaction failed: tx_no_source_account")
+	assert.False(t, submissionErr.Expired)
+	require.NotNil(t, submissionErr.Result)
+	assert.False(t, submissionErr.Result.Successful)
+	assert.Equal(t, xdr.TransactionResultCodeTxNoAccount, submissionErr.Result.Result.Result.Code)
+	assert.Empty(t, submissionErr.Result.Operations)
+	assert.Equal(t, 400, GetError(submissionErr.Err).Response.StatusCode)
+}
+
+func TestSubmitTransactionAndWaitTimeout(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	client, hmock := newWaitTestClient(now)
+	tx, hash := newWaitTestTransaction(t, now.Add(time.Minute).Unix())
+	hmock.On("POST", "https://localhost/transactions").Return(stringResponder(504, timeoutResponse))
+
+	lookups := 0
+	hmock.On("GET", "https://localhost/transactions/"+hash).Return(responses(&lookups,
+		stringResponder(404, notFoundResponse),
+		stringResponder(503, serviceUnavailableResponse),
+		stringResponder(200, txSuccess),
+	))
+	result, err := client.SubmitTransactionAndWait(context.Background(), tx, waitOpts)
+	require.NoError(t, err)
+	assert.True(t, result.Successful)
+	assert.Equal(t, 3, lookups)
+
+	// the lookups stop when the context is done
+	hmock.On("GET", "https://localhost/transactions/"+hash).Return(stringResponder(404, notFoundResponse))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.SubmitTransactionAndWait(ctx, tx, waitOpts)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestSubmitTransactionAndWaitExpired(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	client, hmock := newWaitTestClient(now)
+	tx, hash := newWaitTestTransaction(t, now.Add(-time.Second).Unix())
+	hmock.On("POST", "https://localhost/transactions").Return(errorResponder("connection reset"))
+	hmock.On("GET", "https://localhost/transactions/"+hash).Return(stringResponder(404, notFoundResponse))
+
+	ledgers := 0
+	latestLedger := func(closedAt time.Time) string {
+		return fmt.Sprintf(`{"_embedded": {"records": [{"sequence": 10, "closed_at": "%s"}]}}`, closedAt.Format(time.RFC3339))
+	}
+	hmock.On("GET", "https://localhost/ledgers?limit=1&order=desc").Return(responses(&ledgers,
+		stringResponder(200, latestLedger(now.Add(-time.Second))),
+		stringResponder(200, latestLedger(now)),
+	))
+
+	result, err := client.SubmitTransactionAndWait(context.Background(), tx, waitOpts)
+	assert.Nil(t, result)
+	assert.Equal(t, &SubmissionError{Hash: hash, Expired: true}, err)
+	assert.EqualError(t, err, "transaction "+hash+" expired before being included in a ledger")
+	assert.Equal(t, 2, ledgers)
+}
+
+func TestOperationResultHelpers(t *testing.T) {
+	seller := xdr.MustAddress("GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU")
+	usd := xdr.MustNewCreditAsset("USD", "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU")
+	eur := xdr.MustNewCreditAsset("EUR", "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU")
+	claims := []xdr.ClaimOfferAtom{
+		{SellerId: seller, OfferId: 1, AssetSold: usd, AmountSold: 50000000, AssetBought: xdr.MustNewNativeAsset(), AmountBought: 20000000},
+		{SellerId: seller, OfferId: 2, AssetSold: eur, AmountSold: 40000000, AssetBought: usd, AmountBought: 50000000},
+	}
+
+	pathPayment := OperationResult{Result: xdr.OperationResult{
+		Code: xdr.OperationResultCodeOpInner,
+		Tr: &xdr.OperationResultTr{
+			Type: xdr.OperationTypePathPaymentStrictReceive,
+			PathPaymentStrictReceiveResult: &xdr.PathPaymentStrictReceiveResult{
+				Code: xdr.PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess,
+				Success: &xdr.PathPaymentStrictReceiveResultSuccess{
+					Offers: claims,
+					Last:   xdr.SimplePaymentResult{Destination: seller, Asset: eur, Amount: 40000000},
+				},
+			},
+		},
+	}}
+	sent, ok := pathPayment.AmountSent()
+	assert.True(t, ok)
+	assert.Equal(t, "2.0000000", sent)
+	received, ok := pathPayment.AmountReceived()
+	assert.True(t, ok)
+	assert.Equal(t, "4.0000000", received)
+	assert.Equal(t, claims, pathPayment.OffersClaimed())
+	_, ok = pathPayment.OfferID()
+	assert.False(t, ok)
+
+	offer := OperationResult{Result: xdr.OperationResult{
+		Code: xdr.OperationResultCodeOpInner,
+		Tr: &xdr.OperationResultTr{
+			Type: xdr.OperationTypeManageBuyOffer,
+			ManageBuyOfferResult: &xdr.ManageBuyOfferResult{
+				Code: xdr.ManageBuyOfferResultCodeManageBuyOfferSuccess,
+				Success: &xdr.ManageOfferSuccessResult{
+					OffersClaimed: claims[:1],
+					Offer: xdr.ManageOfferSuccessResultOffer{
+						Effect: xdr.ManageOfferEffectManageOfferCreated,
+						Offer:  &xdr.OfferEntry{SellerId: seller, OfferId: 42},
+					},
+				},
+			},
+		},
+	}}
+	offerID, ok := offer.OfferID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), offerID)
+	assert.Equal(t, claims[:1], offer.OffersClaimed())
+	_, ok = offer.AmountSent()
+	assert.False(t, ok)
+
+	failed := OperationResult{Result: xdr.OperationResult{Code: xdr.OperationResultCodeOpNoAccount}}
+	_, ok = failed.Type()
+	assert.False(t, ok)
+	_, ok = failed.AmountReceived()
+	assert.False(t, ok)
+	assert.Nil(t, failed.OffersClaimed())
+}
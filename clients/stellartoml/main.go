@@ -40,6 +40,9 @@ type Response struct {
 	FederationServer string `toml:"FEDERATION_SERVER"`
 	EncryptionKey    string `toml:"ENCRYPTION_KEY"`
 	SigningKey       string `toml:"SIGNING_KEY"`
+	// URIRequestSigningKey is the key used to sign SEP-7 URI requests
+	// originating from the domain.
+	URIRequestSigningKey string `toml:"URI_REQUEST_SIGNING_KEY"`
 }
 
 // GetStellarToml returns stellar.toml file for a given domain
@@ -116,7 +116,7 @@ func (c *Change) AccountChangedExceptSigners() (bool, error) {
 	// modify them here, EXCEPT pointers inside them!
 	if preAccountEntry.Ext.V == 0 {
 		preAccountEntry.Ext.V = 1
-		preAccountEntry.Ext.V1 = &xdr.AccountEntryV1{
+		preAccountEntry.Ext.V1 = &xdr.AccountEntryExtensionV1{
 			Liabilities: xdr.Liabilities{
 				Buying:  0,
 				Selling: 0,
@@ -128,7 +128,7 @@ func (c *Change) AccountChangedExceptSigners() (bool, error) {
 
 	if postAccountEntry.Ext.V == 0 {
 		postAccountEntry.Ext.V = 1
-		postAccountEntry.Ext.V1 = &xdr.AccountEntryV1{
+		postAccountEntry.Ext.V1 = &xdr.AccountEntryExtensionV1{
 			Liabilities: xdr.Liabilities{
 				Buying:  0,
 				Selling: 0,
@@ -151,7 +151,8 @@ func (c *Change) AccountChangedExceptSigners() (bool, error) {
 	return !bytes.Equal(preBinary, postBinary), nil
 }
 
-// AccountSignersChanged returns true if account signers have changed.
+// AccountSignersChanged returns true if account signers or their sponsors have
+// changed.
 // Notice: this will return true on master key changes too!
 func (c *Change) AccountSignersChanged() bool {
 	if c.Type != xdr.LedgerEntryTypeAccount {
@@ -191,5 +192,23 @@ func (c *Change) AccountSignersChanged() bool {
 		}
 	}
 
+	preSignerSponsors := preAccountEntry.SponsorPerSigner()
+	postSignerSponsors := postAccountEntry.SponsorPerSigner()
+
+	if len(preSignerSponsors) != len(postSignerSponsors) {
+		return true
+	}
+
+	for signer, postSponsor := range postSignerSponsors {
+		preSponsor, exist := preSignerSponsors[signer]
+		if !exist {
+			return true
+		}
+
+		if !preSponsor.Equals(postSponsor) {
+			return true
+		}
+	}
+
 	return false
 }
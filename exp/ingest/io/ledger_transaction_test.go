@@ -504,7 +504,7 @@ func TestChangeAccountChangedExceptSignersNoChanges(t *testing.T) {
 					},
 					Ext: xdr.AccountEntryExt{
 						V: 1,
-						V1: &xdr.AccountEntryV1{
+						V1: &xdr.AccountEntryExtensionV1{
 							Liabilities: xdr.Liabilities{
 								Buying:  10,
 								Selling: 20,
@@ -535,7 +535,7 @@ func TestChangeAccountChangedExceptSignersNoChanges(t *testing.T) {
 					},
 					Ext: xdr.AccountEntryExt{
 						V: 1,
-						V1: &xdr.AccountEntryV1{
+						V1: &xdr.AccountEntryExtensionV1{
 							Liabilities: xdr.Liabilities{
 								Buying:  10,
 								Selling: 20,
@@ -791,3 +791,49 @@ func TestChangeAccountSignersChangedSignerWeightChanged(t *testing.T) {
 
 	assert.True(t, change.AccountSignersChanged())
 }
+
+func TestChangeAccountSignersChangedSignerSponsorChanged(t *testing.T) {
+	signer := xdr.Signer{
+		Key:    xdr.MustSigner("GCCCU34WDY2RATQTOOQKY6SZWU6J5DONY42SWGW2CIXGW4LICAGNRZKX"),
+		Weight: 1,
+	}
+	sponsor := xdr.MustAddress("GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A")
+
+	change := Change{
+		Type: xdr.LedgerEntryTypeAccount,
+		Pre: &xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 10,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+					Signers:   []xdr.Signer{signer},
+				},
+			},
+		},
+		Post: &xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 10,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+					Signers:   []xdr.Signer{signer},
+					Ext: xdr.AccountEntryExt{
+						V: 1,
+						V1: &xdr.AccountEntryExtensionV1{
+							Ext: xdr.AccountEntryExtensionV1Ext{
+								V: 2,
+								V2: &xdr.AccountEntryExtensionV2{
+									NumSponsored:        1,
+									SignerSponsoringIDs: []xdr.SponsorshipDescriptor{&sponsor},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(t, change.AccountSignersChanged())
+}
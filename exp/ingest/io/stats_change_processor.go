@@ -27,6 +27,10 @@ type StatsChangeProcessorResults struct {
 	TrustLinesCreated int64
 	TrustLinesUpdated int64
 	TrustLinesRemoved int64
+
+	ClaimableBalancesCreated int64
+	ClaimableBalancesUpdated int64
+	ClaimableBalancesRemoved int64
 }
 
 func (p *StatsChangeProcessor) ProcessChange(change Change) error {
@@ -67,6 +71,15 @@ func (p *StatsChangeProcessor) ProcessChange(change Change) error {
 		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
 			p.results.TrustLinesRemoved++
 		}
+	case xdr.LedgerEntryTypeClaimableBalance:
+		switch change.LedgerEntryChangeType() {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			p.results.ClaimableBalancesCreated++
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			p.results.ClaimableBalancesUpdated++
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			p.results.ClaimableBalancesRemoved++
+		}
 	}
 
 	return nil
@@ -93,5 +106,9 @@ func (stats *StatsChangeProcessorResults) Map() map[string]interface{} {
 		"stats_trust_lines_created": stats.TrustLinesCreated,
 		"stats_trust_lines_updated": stats.TrustLinesUpdated,
 		"stats_trust_lines_removed": stats.TrustLinesRemoved,
+
+		"stats_claimable_balances_created": stats.ClaimableBalancesCreated,
+		"stats_claimable_balances_updated": stats.ClaimableBalancesUpdated,
+		"stats_claimable_balances_removed": stats.ClaimableBalancesRemoved,
 	}
 }
@@ -98,6 +98,22 @@ func ParseAddress(address string) (*FromAddress, error) {
 	return &FromAddress{address: address}, nil
 }
 
+// ParseMuxedAddress parses a multiplexed account address (M...), returning the
+// address-only keypair of the underlying account, which signs for the muxed
+// account, and the id of the muxed account.
+func ParseMuxedAddress(address string) (*FromAddress, uint64, error) {
+	muxed, err := strkey.DecodeMuxedAccount(address)
+	if err != nil {
+		return nil, 0, err
+	}
+	accountID, err := muxed.AccountID()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &FromAddress{address: accountID}, muxed.ID(), nil
+}
+
 // ParseFull constructs a new Full keypair from the provided string, which should
 // be a seed.
 func ParseFull(seed string) (*Full, error) {
@@ -139,6 +155,16 @@ func MustParseAddress(address string) *FromAddress {
 	return kp
 }
 
+// MustParseMuxedAddress is the panic-on-fail version of ParseMuxedAddress
+func MustParseMuxedAddress(address string) (*FromAddress, uint64) {
+	kp, id, err := ParseMuxedAddress(address)
+	if err != nil {
+		panic(err)
+	}
+
+	return kp, id
+}
+
 // MustParseFull is the panic-on-fail version of ParseFull
 func MustParseFull(seed string) *Full {
 	kp, err := ParseFull(seed)
@@ -277,6 +277,24 @@ var _ = DescribeTable("keypair.MustParseAddress()",
 	}),
 )
 
+var _ = Describe("keypair.ParseMuxedAddress()", func() {
+	It("returns the underlying account and the id", func() {
+		kp, id, err := ParseMuxedAddress("MBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OAAAAAAAAAAAFJMRM")
+		Expect(err).To(BeNil())
+		Expect(kp).To(Equal(&FromAddress{address: address}))
+		Expect(id).To(Equal(uint64(42)))
+		Expect(kp.Verify(message, signature)).To(BeNil())
+	})
+
+	It("fails on account ids and corrupted addresses", func() {
+		_, _, err := ParseMuxedAddress(address)
+		Expect(err).To(HaveOccurred())
+		_, _, err = ParseMuxedAddress("MBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OAAAAAAAAAAAFJMRN")
+		Expect(err).To(HaveOccurred())
+		Expect(func() { MustParseMuxedAddress(seed) }).To(Panic())
+	})
+})
+
 var _ = Describe("keypair.Random()", func() {
 	It("does not return the same value twice", func() {
 		seen := map[string]bool{}
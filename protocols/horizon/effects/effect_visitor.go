@@ -0,0 +1,132 @@
+// Code generated by visitorgen. DO NOT EDIT.
+
+package effects
+
+import "fmt"
+
+// EffectVisitor is implemented by the consumers of the Effect values
+// returned by UnmarshalEffect, with one method per concrete type. Use
+// VisitEffect to call the method matching a value.
+type EffectVisitor interface {
+	VisitAccountCreated(AccountCreated) error
+	VisitAccountCredited(AccountCredited) error
+	VisitAccountDebited(AccountDebited) error
+	VisitAccountThresholdsUpdated(AccountThresholdsUpdated) error
+	VisitAccountHomeDomainUpdated(AccountHomeDomainUpdated) error
+	VisitAccountFlagsUpdated(AccountFlagsUpdated) error
+	VisitSequenceBumped(SequenceBumped) error
+	VisitSignerCreated(SignerCreated) error
+	VisitSignerRemoved(SignerRemoved) error
+	VisitSignerUpdated(SignerUpdated) error
+	VisitTrustlineAuthorized(TrustlineAuthorized) error
+	VisitTrustlineAuthorizedToMaintainLiabilities(TrustlineAuthorizedToMaintainLiabilities) error
+	VisitTrustlineCreated(TrustlineCreated) error
+	VisitTrustlineDeauthorized(TrustlineDeauthorized) error
+	VisitTrustlineRemoved(TrustlineRemoved) error
+	VisitTrustlineUpdated(TrustlineUpdated) error
+	VisitTrade(Trade) error
+	VisitClaimableBalanceCreated(ClaimableBalanceCreated) error
+	VisitClaimableBalanceClaimantCreated(ClaimableBalanceClaimantCreated) error
+	VisitClaimableBalanceClaimed(ClaimableBalanceClaimed) error
+	VisitAccountSponsorshipCreated(AccountSponsorshipCreated) error
+	VisitAccountSponsorshipUpdated(AccountSponsorshipUpdated) error
+	VisitAccountSponsorshipRemoved(AccountSponsorshipRemoved) error
+	VisitTrustlineSponsorshipCreated(TrustlineSponsorshipCreated) error
+	VisitTrustlineSponsorshipUpdated(TrustlineSponsorshipUpdated) error
+	VisitTrustlineSponsorshipRemoved(TrustlineSponsorshipRemoved) error
+	VisitDataSponsorshipCreated(DataSponsorshipCreated) error
+	VisitDataSponsorshipUpdated(DataSponsorshipUpdated) error
+	VisitDataSponsorshipRemoved(DataSponsorshipRemoved) error
+	VisitClaimableBalanceSponsorshipCreated(ClaimableBalanceSponsorshipCreated) error
+	VisitClaimableBalanceSponsorshipUpdated(ClaimableBalanceSponsorshipUpdated) error
+	VisitClaimableBalanceSponsorshipRemoved(ClaimableBalanceSponsorshipRemoved) error
+	VisitSignerSponsorshipCreated(SignerSponsorshipCreated) error
+	VisitSignerSponsorshipUpdated(SignerSponsorshipUpdated) error
+	VisitSignerSponsorshipRemoved(SignerSponsorshipRemoved) error
+	VisitUnknown(Unknown) error
+	VisitBase(Base) error
+}
+
+// VisitEffect calls the method of visitor matching the concrete type of
+// effect. An error is returned if effect was not returned by
+// UnmarshalEffect.
+func VisitEffect(effect Effect, visitor EffectVisitor) error {
+	switch v := effect.(type) {
+	case AccountCreated:
+		return visitor.VisitAccountCreated(v)
+	case AccountCredited:
+		return visitor.VisitAccountCredited(v)
+	case AccountDebited:
+		return visitor.VisitAccountDebited(v)
+	case AccountThresholdsUpdated:
+		return visitor.VisitAccountThresholdsUpdated(v)
+	case AccountHomeDomainUpdated:
+		return visitor.VisitAccountHomeDomainUpdated(v)
+	case AccountFlagsUpdated:
+		return visitor.VisitAccountFlagsUpdated(v)
+	case SequenceBumped:
+		return visitor.VisitSequenceBumped(v)
+	case SignerCreated:
+		return visitor.VisitSignerCreated(v)
+	case SignerRemoved:
+		return visitor.VisitSignerRemoved(v)
+	case SignerUpdated:
+		return visitor.VisitSignerUpdated(v)
+	case TrustlineAuthorized:
+		return visitor.VisitTrustlineAuthorized(v)
+	case TrustlineAuthorizedToMaintainLiabilities:
+		return visitor.VisitTrustlineAuthorizedToMaintainLiabilities(v)
+	case TrustlineCreated:
+		return visitor.VisitTrustlineCreated(v)
+	case TrustlineDeauthorized:
+		return visitor.VisitTrustlineDeauthorized(v)
+	case TrustlineRemoved:
+		return visitor.VisitTrustlineRemoved(v)
+	case TrustlineUpdated:
+		return visitor.VisitTrustlineUpdated(v)
+	case Trade:
+		return visitor.VisitTrade(v)
+	case ClaimableBalanceCreated:
+		return visitor.VisitClaimableBalanceCreated(v)
+	case ClaimableBalanceClaimantCreated:
+		return visitor.VisitClaimableBalanceClaimantCreated(v)
+	case ClaimableBalanceClaimed:
+		return visitor.VisitClaimableBalanceClaimed(v)
+	case AccountSponsorshipCreated:
+		return visitor.VisitAccountSponsorshipCreated(v)
+	case AccountSponsorshipUpdated:
+		return visitor.VisitAccountSponsorshipUpdated(v)
+	case AccountSponsorshipRemoved:
+		return visitor.VisitAccountSponsorshipRemoved(v)
+	case TrustlineSponsorshipCreated:
+		return visitor.VisitTrustlineSponsorshipCreated(v)
+	case TrustlineSponsorshipUpdated:
+		return visitor.VisitTrustlineSponsorshipUpdated(v)
+	case TrustlineSponsorshipRemoved:
+		return visitor.VisitTrustlineSponsorshipRemoved(v)
+	case DataSponsorshipCreated:
+		return visitor.VisitDataSponsorshipCreated(v)
+	case DataSponsorshipUpdated:
+		return visitor.VisitDataSponsorshipUpdated(v)
+	case DataSponsorshipRemoved:
+		return visitor.VisitDataSponsorshipRemoved(v)
+	case ClaimableBalanceSponsorshipCreated:
+		return visitor.VisitClaimableBalanceSponsorshipCreated(v)
+	case ClaimableBalanceSponsorshipUpdated:
+		return visitor.VisitClaimableBalanceSponsorshipUpdated(v)
+	case ClaimableBalanceSponsorshipRemoved:
+		return visitor.VisitClaimableBalanceSponsorshipRemoved(v)
+	case SignerSponsorshipCreated:
+		return visitor.VisitSignerSponsorshipCreated(v)
+	case SignerSponsorshipUpdated:
+		return visitor.VisitSignerSponsorshipUpdated(v)
+	case SignerSponsorshipRemoved:
+		return visitor.VisitSignerSponsorshipRemoved(v)
+	case Unknown:
+		return visitor.VisitUnknown(v)
+	case Base:
+		return visitor.VisitBase(v)
+	default:
+		return fmt.Errorf("unexpected effect type %T", effect)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stellar/go/protocols/horizon/base"
 	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
 )
 
 // Peter 30-04-2019: this is copied from the history package "github.com/stellar/go/services/horizon/internal/db2/history"
@@ -110,6 +111,78 @@ const (
 
 	// EffectSequenceBumped occurs when an account bumps their sequence number
 	EffectSequenceBumped EffectType = 43 // from bump_sequence
+
+	// claimable balance effects
+
+	// EffectClaimableBalanceCreated occurs when a claimable balance is created
+	EffectClaimableBalanceCreated EffectType = 50 // from create_claimable_balance
+
+	// EffectClaimableBalanceClaimantCreated occurs when a claimable balance
+	// claimant is created
+	EffectClaimableBalanceClaimantCreated EffectType = 51 // from create_claimable_balance
+
+	// EffectClaimableBalanceClaimed occurs when a claimable balance is claimed
+	EffectClaimableBalanceClaimed EffectType = 52 // from claim_claimable_balance
+
+	// sponsorship effects
+
+	// EffectAccountSponsorshipCreated occurs when an account ledger entry is
+	// sponsored
+	EffectAccountSponsorshipCreated EffectType = 60 // from create_account
+
+	// EffectAccountSponsorshipUpdated occurs when an account ledger entry changes
+	// its sponsor
+	EffectAccountSponsorshipUpdated EffectType = 61 // from revoke_sponsorship
+
+	// EffectAccountSponsorshipRemoved occurs when an account ledger entry stops
+	// being sponsored
+	EffectAccountSponsorshipRemoved EffectType = 62 // from revoke_sponsorship
+
+	// EffectTrustlineSponsorshipCreated occurs when a trustline ledger entry is
+	// sponsored
+	EffectTrustlineSponsorshipCreated EffectType = 63 // from change_trust
+
+	// EffectTrustlineSponsorshipUpdated occurs when a trustline ledger entry
+	// changes its sponsor
+	EffectTrustlineSponsorshipUpdated EffectType = 64 // from revoke_sponsorship
+
+	// EffectTrustlineSponsorshipRemoved occurs when a trustline ledger entry stops
+	// being sponsored
+	EffectTrustlineSponsorshipRemoved EffectType = 65 // from revoke_sponsorship
+
+	// EffectDataSponsorshipCreated occurs when a data ledger entry is sponsored
+	EffectDataSponsorshipCreated EffectType = 66 // from manage_data
+
+	// EffectDataSponsorshipUpdated occurs when a data ledger entry changes its
+	// sponsor
+	EffectDataSponsorshipUpdated EffectType = 67 // from revoke_sponsorship
+
+	// EffectDataSponsorshipRemoved occurs when a data ledger entry stops being
+	// sponsored
+	EffectDataSponsorshipRemoved EffectType = 68 // from revoke_sponsorship
+
+	// EffectClaimableBalanceSponsorshipCreated occurs when a claimable balance
+	// ledger entry is sponsored
+	EffectClaimableBalanceSponsorshipCreated EffectType = 69 // from create_claimable_balance
+
+	// EffectClaimableBalanceSponsorshipUpdated occurs when a claimable balance
+	// ledger entry changes its sponsor
+	EffectClaimableBalanceSponsorshipUpdated EffectType = 70 // from revoke_sponsorship
+
+	// EffectClaimableBalanceSponsorshipRemoved occurs when a claimable balance
+	// ledger entry stops being sponsored
+	EffectClaimableBalanceSponsorshipRemoved EffectType = 71 // from revoke_sponsorship
+
+	// EffectSignerSponsorshipCreated occurs when an account signer is sponsored
+	EffectSignerSponsorshipCreated EffectType = 72 // from set_options
+
+	// EffectSignerSponsorshipUpdated occurs when an account signer changes its
+	// sponsor
+	EffectSignerSponsorshipUpdated EffectType = 73 // from revoke_sponsorship
+
+	// EffectSignerSponsorshipRemoved occurs when an account signer stops being
+	// sponsored
+	EffectSignerSponsorshipRemoved EffectType = 74 // from revoke_sponsorship
 )
 
 // Peter 30-04-2019: this is copied from the resourcadapter package
@@ -143,6 +216,24 @@ var EffectTypeNames = map[EffectType]string{
 	EffectDataRemoved:                              "data_removed",
 	EffectDataUpdated:                              "data_updated",
 	EffectSequenceBumped:                           "sequence_bumped",
+	EffectClaimableBalanceCreated:                  "claimable_balance_created",
+	EffectClaimableBalanceClaimantCreated:          "claimable_balance_claimant_created",
+	EffectClaimableBalanceClaimed:                  "claimable_balance_claimed",
+	EffectAccountSponsorshipCreated:                "account_sponsorship_created",
+	EffectAccountSponsorshipUpdated:                "account_sponsorship_updated",
+	EffectAccountSponsorshipRemoved:                "account_sponsorship_removed",
+	EffectTrustlineSponsorshipCreated:              "trustline_sponsorship_created",
+	EffectTrustlineSponsorshipUpdated:              "trustline_sponsorship_updated",
+	EffectTrustlineSponsorshipRemoved:              "trustline_sponsorship_removed",
+	EffectDataSponsorshipCreated:                   "data_sponsorship_created",
+	EffectDataSponsorshipUpdated:                   "data_sponsorship_updated",
+	EffectDataSponsorshipRemoved:                   "data_sponsorship_removed",
+	EffectClaimableBalanceSponsorshipCreated:       "claimable_balance_sponsorship_created",
+	EffectClaimableBalanceSponsorshipUpdated:       "claimable_balance_sponsorship_updated",
+	EffectClaimableBalanceSponsorshipRemoved:       "claimable_balance_sponsorship_removed",
+	EffectSignerSponsorshipCreated:                 "signer_sponsorship_created",
+	EffectSignerSponsorshipUpdated:                 "signer_sponsorship_updated",
+	EffectSignerSponsorshipRemoved:                 "signer_sponsorship_removed",
 }
 
 // Base provides the common structure for any effect resource effect.
@@ -280,6 +371,120 @@ type Trade struct {
 	BoughtAssetIssuer string `json:"bought_asset_issuer,omitempty"`
 }
 
+type ClaimableBalanceCreated struct {
+	Base
+	Asset     string `json:"asset"`
+	BalanceID string `json:"balance_id"`
+	Amount    string `json:"amount"`
+}
+
+type ClaimableBalanceClaimantCreated struct {
+	Base
+	Asset     string             `json:"asset"`
+	BalanceID string             `json:"balance_id"`
+	Amount    string             `json:"amount"`
+	Predicate xdr.ClaimPredicate `json:"predicate"`
+}
+
+type ClaimableBalanceClaimed struct {
+	Base
+	Asset     string `json:"asset"`
+	BalanceID string `json:"balance_id"`
+	Amount    string `json:"amount"`
+}
+
+type AccountSponsorshipCreated struct {
+	Base
+	Sponsor string `json:"sponsor"`
+}
+
+type AccountSponsorshipUpdated struct {
+	Base
+	FormerSponsor string `json:"former_sponsor"`
+	NewSponsor    string `json:"new_sponsor"`
+}
+
+type AccountSponsorshipRemoved struct {
+	Base
+	FormerSponsor string `json:"former_sponsor"`
+}
+
+type TrustlineSponsorshipCreated struct {
+	Base
+	Asset   string `json:"asset"`
+	Sponsor string `json:"sponsor"`
+}
+
+type TrustlineSponsorshipUpdated struct {
+	Base
+	Asset         string `json:"asset"`
+	FormerSponsor string `json:"former_sponsor"`
+	NewSponsor    string `json:"new_sponsor"`
+}
+
+type TrustlineSponsorshipRemoved struct {
+	Base
+	Asset         string `json:"asset"`
+	FormerSponsor string `json:"former_sponsor"`
+}
+
+type DataSponsorshipCreated struct {
+	Base
+	DataName string `json:"data_name"`
+	Sponsor  string `json:"sponsor"`
+}
+
+type DataSponsorshipUpdated struct {
+	Base
+	DataName      string `json:"data_name"`
+	FormerSponsor string `json:"former_sponsor"`
+	NewSponsor    string `json:"new_sponsor"`
+}
+
+type DataSponsorshipRemoved struct {
+	Base
+	DataName      string `json:"data_name"`
+	FormerSponsor string `json:"former_sponsor"`
+}
+
+type ClaimableBalanceSponsorshipCreated struct {
+	Base
+	BalanceID string `json:"balance_id"`
+	Sponsor   string `json:"sponsor"`
+}
+
+type ClaimableBalanceSponsorshipUpdated struct {
+	Base
+	BalanceID     string `json:"balance_id"`
+	FormerSponsor string `json:"former_sponsor"`
+	NewSponsor    string `json:"new_sponsor"`
+}
+
+type ClaimableBalanceSponsorshipRemoved struct {
+	Base
+	BalanceID     string `json:"balance_id"`
+	FormerSponsor string `json:"former_sponsor"`
+}
+
+type SignerSponsorshipCreated struct {
+	Base
+	Signer  string `json:"signer"`
+	Sponsor string `json:"sponsor"`
+}
+
+type SignerSponsorshipUpdated struct {
+	Base
+	Signer        string `json:"signer"`
+	FormerSponsor string `json:"former_sponsor"`
+	NewSponsor    string `json:"new_sponsor"`
+}
+
+type SignerSponsorshipRemoved struct {
+	Base
+	Signer        string `json:"signer"`
+	FormerSponsor string `json:"former_sponsor"`
+}
+
 // Effect contains methods that are implemented by all effect types.
 type Effect interface {
 	PagingToken() string
@@ -346,7 +551,27 @@ func (effects *EffectsPage) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// UnmarshalEffect decodes responses to the correct effect struct
+// Unknown is an effect whose type is not known to this package, like an
+// effect added to horizon after this package was released. Raw holds the
+// JSON of the effect.
+type Unknown struct {
+	Base
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns the JSON the effect was decoded from.
+func (u Unknown) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) == 0 {
+		return json.Marshal(u.Base)
+	}
+	return u.Raw, nil
+}
+
+//go:generate go run github.com/stellar/go/protocols/horizon/internal/visitorgen -func UnmarshalEffect -interface Effect -visitor EffectVisitor -visit VisitEffect -o effect_visitor.go
+
+// UnmarshalEffect decodes responses to the correct effect struct. Effects
+// which have no struct of their own, like account_removed, are decoded to
+// Base and effects of unknown types are decoded to Unknown.
 func UnmarshalEffect(effectType string, dataString []byte) (effects Effect, err error) {
 	switch effectType {
 	case EffectTypeNames[EffectAccountCreated]:
@@ -451,7 +676,126 @@ func UnmarshalEffect(effectType string, dataString []byte) (effects Effect, err
 			return
 		}
 		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceCreated]:
+		var effect ClaimableBalanceCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceClaimantCreated]:
+		var effect ClaimableBalanceClaimantCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceClaimed]:
+		var effect ClaimableBalanceClaimed
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountSponsorshipCreated]:
+		var effect AccountSponsorshipCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountSponsorshipUpdated]:
+		var effect AccountSponsorshipUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountSponsorshipRemoved]:
+		var effect AccountSponsorshipRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineSponsorshipCreated]:
+		var effect TrustlineSponsorshipCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineSponsorshipUpdated]:
+		var effect TrustlineSponsorshipUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineSponsorshipRemoved]:
+		var effect TrustlineSponsorshipRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataSponsorshipCreated]:
+		var effect DataSponsorshipCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataSponsorshipUpdated]:
+		var effect DataSponsorshipUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataSponsorshipRemoved]:
+		var effect DataSponsorshipRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceSponsorshipCreated]:
+		var effect ClaimableBalanceSponsorshipCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceSponsorshipUpdated]:
+		var effect ClaimableBalanceSponsorshipUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectClaimableBalanceSponsorshipRemoved]:
+		var effect ClaimableBalanceSponsorshipRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerSponsorshipCreated]:
+		var effect SignerSponsorshipCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerSponsorshipUpdated]:
+		var effect SignerSponsorshipUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerSponsorshipRemoved]:
+		var effect SignerSponsorshipRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
 	default:
+		if !knownEffectType(effectType) {
+			var effect Unknown
+			if err = json.Unmarshal(dataString, &effect); err != nil {
+				return
+			}
+			effect.Raw = append(json.RawMessage(nil), dataString...)
+			effects = effect
+			return
+		}
+		// the effects which have no type of their own only have the base
+		// fields
 		var effect Base
 		if err = json.Unmarshal(dataString, &effect); err != nil {
 			return
@@ -461,6 +805,15 @@ func UnmarshalEffect(effectType string, dataString []byte) (effects Effect, err
 	return
 }
 
+func knownEffectType(effectType string) bool {
+	for _, name := range EffectTypeNames {
+		if name == effectType {
+			return true
+		}
+	}
+	return false
+}
+
 // interface implementations
 var _ base.Rehydratable = &SignerCreated{}
 var _ base.Rehydratable = &SignerRemoved{}
@@ -0,0 +1,91 @@
+package effects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the visited effects, its other methods panic.
+type recorder struct {
+	EffectVisitor
+	visited []string
+}
+
+func (r *recorder) VisitAccountCreated(effect AccountCreated) error {
+	r.visited = append(r.visited, "AccountCreated "+effect.StartingBalance)
+	return nil
+}
+
+func (r *recorder) VisitBase(effect Base) error {
+	r.visited = append(r.visited, "Base "+effect.Type)
+	return nil
+}
+
+func (r *recorder) VisitUnknown(effect Unknown) error {
+	r.visited = append(r.visited, "Unknown "+effect.Type)
+	return nil
+}
+
+func TestUnmarshalUnknownEffect(t *testing.T) {
+	data := []byte(`{"id":"0000000012884905985-0000000001","paging_token":"12884905985-1","account":"GAB","type":"liquidity_pool_deposited","type_i":90,"shares_received":"10.0000000"}`)
+	effect, err := UnmarshalEffect("liquidity_pool_deposited", data)
+	require.NoError(t, err)
+	unknown, ok := effect.(Unknown)
+	require.True(t, ok)
+	assert.Equal(t, "12884905985-1", unknown.PagingToken())
+	assert.Equal(t, int32(90), unknown.TypeI)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(unknown.Raw, &fields))
+	assert.Equal(t, "10.0000000", fields["shares_received"])
+	encoded, err := json.Marshal(unknown)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+
+	effect, err = UnmarshalEffect("account_removed", []byte(`{"type":"account_removed","type_i":1}`))
+	require.NoError(t, err)
+	assert.IsType(t, Base{}, effect)
+}
+
+func TestUnmarshalClaimableBalanceClaimantCreated(t *testing.T) {
+	data := []byte(`{
+		"type":"claimable_balance_claimant_created",
+		"type_i":51,
+		"asset":"native",
+		"balance_id":"00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+		"amount":"10.0000000",
+		"predicate":{"not":{"unconditional":true}}
+	}`)
+	effect, err := UnmarshalEffect("claimable_balance_claimant_created", data)
+	require.NoError(t, err)
+	created, ok := effect.(ClaimableBalanceClaimantCreated)
+	require.True(t, ok)
+	assert.Equal(t, "native", created.Asset)
+	assert.Equal(t, "10.0000000", created.Amount)
+	assert.Equal(t, xdr.ClaimPredicateTypeClaimPredicateNot, created.Predicate.Type)
+}
+
+func TestVisitEffect(t *testing.T) {
+	var page EffectsPage
+	require.NoError(t, json.Unmarshal([]byte(`{"_embedded":{"records":[
+		{"type":"account_created","type_i":0,"starting_balance":"10.0000000"},
+		{"type":"account_removed","type_i":1},
+		{"type":"liquidity_pool_deposited","type_i":90}
+	]}}`), &page))
+
+	r := &recorder{}
+	for _, effect := range page.Embedded.Records {
+		require.NoError(t, VisitEffect(effect, r))
+	}
+	assert.Equal(t, []string{
+		"AccountCreated 10.0000000",
+		"Base account_removed",
+		"Unknown liquidity_pool_deposited",
+	}, r.visited)
+
+	assert.EqualError(t, VisitEffect(&Base{}, r), "unexpected effect type *effects.Base")
+}
@@ -0,0 +1,142 @@
+// visitorgen generates a visitor interface for the resources decoded by an
+// unmarshal function of the effects and operations packages. It is run with
+// go generate from the directory of the package:
+//
+//	//go:generate go run github.com/stellar/go/protocols/horizon/internal/visitorgen -func UnmarshalEffect -interface Effect -visitor EffectVisitor -visit VisitEffect -o effect_visitor.go
+//
+// The visitor has one method per type declared with `var <name> <Type>` in
+// the unmarshal function, so adding a resource type to the function and
+// regenerating the visitor breaks the visitors which do not handle it.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	funcName := flag.String("func", "", "name of the unmarshal function")
+	iface := flag.String("interface", "", "name of the interface implemented by the resources")
+	visitor := flag.String("visitor", "", "name of the generated visitor interface")
+	visit := flag.String("visit", "", "name of the generated function dispatching a resource to a visitor")
+	output := flag.String("o", "", "output file")
+	flag.Parse()
+	if *funcName == "" || *iface == "" || *visitor == "" || *visit == "" || *output == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	pkg, types, err := decodedTypes(".", *funcName, *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(types) == 0 {
+		log.Fatalf("no types decoded by %s", *funcName)
+	}
+
+	var buf bytes.Buffer
+	err = visitorTemplate.Execute(&buf, struct {
+		Package   string
+		Func      string
+		Interface string
+		Visitor   string
+		Visit     string
+		Param     string
+		Types     []string
+	}{
+		Package:   pkg,
+		Func:      *funcName,
+		Interface: *iface,
+		Visitor:   *visitor,
+		Visit:     *visit,
+		Param:     strings.ToLower((*iface)[:1]) + (*iface)[1:],
+		Types:     types,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// decodedTypes returns the package name of dir and the types of the
+// variables declared in the function funcName, in order of declaration.
+func decodedTypes(dir, funcName, output string) (string, []string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != output
+	}, 0)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for name, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil || fn.Name.Name != funcName {
+					continue
+				}
+				var types []string
+				seen := map[string]bool{}
+				ast.Inspect(fn.Body, func(node ast.Node) bool {
+					spec, ok := node.(*ast.ValueSpec)
+					if !ok {
+						return true
+					}
+					if ident, ok := spec.Type.(*ast.Ident); ok && !seen[ident.Name] {
+						seen[ident.Name] = true
+						types = append(types, ident.Name)
+					}
+					return true
+				})
+				return name, types, nil
+			}
+		}
+	}
+	return "", nil, nil
+}
+
+var visitorTemplate = template.Must(template.New("visitor").Parse(`// Code generated by visitorgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import "fmt"
+
+// {{.Visitor}} is implemented by the consumers of the {{.Interface}} values
+// returned by {{.Func}}, with one method per concrete type. Use
+// {{.Visit}} to call the method matching a value.
+type {{.Visitor}} interface {
+{{- range .Types}}
+	Visit{{.}}({{.}}) error
+{{- end}}
+}
+
+// {{.Visit}} calls the method of visitor matching the concrete type of
+// {{.Param}}. An error is returned if {{.Param}} was not returned by
+// {{.Func}}.
+func {{.Visit}}({{.Param}} {{.Interface}}, visitor {{.Visitor}}) error {
+	switch v := {{.Param}}.(type) {
+{{- range .Types}}
+	case {{.}}:
+		return visitor.Visit{{.}}(v)
+{{- end}}
+	default:
+		return fmt.Errorf("unexpected {{.Param}} type %T", {{.Param}})
+	}
+}
+`))
@@ -50,6 +50,9 @@ type Account struct {
 	Balances             []Balance         `json:"balances"`
 	Signers              []Signer          `json:"signers"`
 	Data                 map[string]string `json:"data"`
+	NumSponsoring        uint32            `json:"num_sponsoring"`
+	NumSponsored         uint32            `json:"num_sponsored"`
+	Sponsor              string            `json:"sponsor,omitempty"`
 	PT                   string            `json:"paging_token"`
 }
 
@@ -199,6 +202,7 @@ type Balance struct {
 	LastModifiedLedger                uint32 `json:"last_modified_ledger,omitempty"`
 	IsAuthorized                      *bool  `json:"is_authorized,omitempty"`
 	IsAuthorizedToMaintainLiabilities *bool  `json:"is_authorized_to_maintain_liabilities,omitempty"`
+	Sponsor                           string `json:"sponsor,omitempty"`
 	base.Asset
 }
 
@@ -251,12 +255,41 @@ type Offer struct {
 	Price              string     `json:"price"`
 	LastModifiedLedger int32      `json:"last_modified_ledger"`
 	LastModifiedTime   *time.Time `json:"last_modified_time"`
+	Sponsor            string     `json:"sponsor,omitempty"`
 }
 
 func (o Offer) PagingToken() string {
 	return o.PT
 }
 
+// ClaimableBalance represents a balance which can be claimed by one of its
+// claimants once their predicate is satisfied.
+type ClaimableBalance struct {
+	Links struct {
+		Self hal.Link `json:"self"`
+	} `json:"_links"`
+
+	BalanceID          string     `json:"id"`
+	Asset              string     `json:"asset"`
+	Amount             string     `json:"amount"`
+	Sponsor            string     `json:"sponsor,omitempty"`
+	LastModifiedLedger uint32     `json:"last_modified_ledger"`
+	LastModifiedTime   *time.Time `json:"last_modified_time"`
+	Claimants          []Claimant `json:"claimants"`
+	PT                 string     `json:"paging_token"`
+}
+
+func (res ClaimableBalance) PagingToken() string {
+	return res.PT
+}
+
+// Claimant is an account which can claim a claimable balance once Predicate
+// is satisfied.
+type Claimant struct {
+	Destination string             `json:"destination"`
+	Predicate   xdr.ClaimPredicate `json:"predicate"`
+}
+
 // OrderBookSummary represents a snapshot summary of a given order book
 type OrderBookSummary struct {
 	Bids    []PriceLevel `json:"bids"`
@@ -300,6 +333,7 @@ type Root struct {
 		Accounts            *hal.Link `json:"accounts,omitempty"`
 		AccountTransactions hal.Link  `json:"account_transactions"`
 		Assets              hal.Link  `json:"assets"`
+		ClaimableBalances   *hal.Link `json:"claimable_balances,omitempty"`
 		Effects             hal.Link  `json:"effects"`
 		FeeStats            hal.Link  `json:"fee_stats"`
 		Friendbot           *hal.Link `json:"friendbot,omitempty"`
@@ -333,9 +367,10 @@ type Root struct {
 
 // Signer represents one of an account's signers.
 type Signer struct {
-	Weight int32  `json:"weight"`
-	Key    string `json:"key"`
-	Type   string `json:"type"`
+	Weight  int32  `json:"weight"`
+	Key     string `json:"key"`
+	Type    string `json:"type"`
+	Sponsor string `json:"sponsor,omitempty"`
 }
 
 // Trade represents a horizon digested trade
@@ -569,7 +604,8 @@ func MustKeyTypeFromAddress(address string) string {
 
 // AccountData represents a single data object stored on by an account
 type AccountData struct {
-	Value string `json:"value"`
+	Value   string `json:"value"`
+	Sponsor string `json:"sponsor,omitempty"`
 }
 
 // AccountsPage returns a list of account records
@@ -604,6 +640,14 @@ type OffersPage struct {
 	} `json:"_embedded"`
 }
 
+// ClaimableBalancesPage returns a list of claimable balances
+type ClaimableBalancesPage struct {
+	Links    hal.Links `json:"_links"`
+	Embedded struct {
+		Records []ClaimableBalance `json:"records"`
+	} `json:"_embedded"`
+}
+
 // AssetsPage contains page of assets returned by Horizon.
 type AssetsPage struct {
 	Links    hal.Links `json:"_links"`
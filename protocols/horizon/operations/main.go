@@ -2,7 +2,6 @@ package operations
 
 import (
 	"encoding/json"
-	"errors"
 	"time"
 
 	"github.com/stellar/go/protocols/horizon"
@@ -14,20 +13,25 @@ import (
 // TypeNames maps from operation type to the string used to represent that type
 // in horizon's JSON responses
 var TypeNames = map[xdr.OperationType]string{
-	xdr.OperationTypeCreateAccount:            "create_account",
-	xdr.OperationTypePayment:                  "payment",
-	xdr.OperationTypePathPaymentStrictReceive: "path_payment_strict_receive",
-	xdr.OperationTypeManageSellOffer:          "manage_sell_offer",
-	xdr.OperationTypeCreatePassiveSellOffer:   "create_passive_sell_offer",
-	xdr.OperationTypeSetOptions:               "set_options",
-	xdr.OperationTypeChangeTrust:              "change_trust",
-	xdr.OperationTypeAllowTrust:               "allow_trust",
-	xdr.OperationTypeAccountMerge:             "account_merge",
-	xdr.OperationTypeInflation:                "inflation",
-	xdr.OperationTypeManageData:               "manage_data",
-	xdr.OperationTypeBumpSequence:             "bump_sequence",
-	xdr.OperationTypeManageBuyOffer:           "manage_buy_offer",
-	xdr.OperationTypePathPaymentStrictSend:    "path_payment_strict_send",
+	xdr.OperationTypeCreateAccount:                 "create_account",
+	xdr.OperationTypePayment:                       "payment",
+	xdr.OperationTypePathPaymentStrictReceive:      "path_payment_strict_receive",
+	xdr.OperationTypeManageSellOffer:               "manage_sell_offer",
+	xdr.OperationTypeCreatePassiveSellOffer:        "create_passive_sell_offer",
+	xdr.OperationTypeSetOptions:                    "set_options",
+	xdr.OperationTypeChangeTrust:                   "change_trust",
+	xdr.OperationTypeAllowTrust:                    "allow_trust",
+	xdr.OperationTypeAccountMerge:                  "account_merge",
+	xdr.OperationTypeInflation:                     "inflation",
+	xdr.OperationTypeManageData:                    "manage_data",
+	xdr.OperationTypeBumpSequence:                  "bump_sequence",
+	xdr.OperationTypeManageBuyOffer:                "manage_buy_offer",
+	xdr.OperationTypePathPaymentStrictSend:         "path_payment_strict_send",
+	xdr.OperationTypeCreateClaimableBalance:        "create_claimable_balance",
+	xdr.OperationTypeClaimClaimableBalance:         "claim_claimable_balance",
+	xdr.OperationTypeBeginSponsoringFutureReserves: "begin_sponsoring_future_reserves",
+	xdr.OperationTypeEndSponsoringFutureReserves:   "end_sponsoring_future_reserves",
+	xdr.OperationTypeRevokeSponsorship:             "revoke_sponsorship",
 }
 
 // Base represents the common attributes of an operation resource
@@ -211,6 +215,53 @@ type Inflation struct {
 	Base
 }
 
+// CreateClaimableBalance is the json resource representing a single operation
+// whose type is CreateClaimableBalance.
+type CreateClaimableBalance struct {
+	Base
+	Asset     string             `json:"asset"`
+	Amount    string             `json:"amount"`
+	Claimants []horizon.Claimant `json:"claimants"`
+}
+
+// ClaimClaimableBalance is the json resource representing a single operation
+// whose type is ClaimClaimableBalance.
+type ClaimClaimableBalance struct {
+	Base
+	BalanceID string `json:"balance_id"`
+	Claimant  string `json:"claimant"`
+}
+
+// BeginSponsoringFutureReserves is the json resource representing a single
+// operation whose type is BeginSponsoringFutureReserves.
+type BeginSponsoringFutureReserves struct {
+	Base
+	SponsoredID string `json:"sponsored_id"`
+}
+
+// EndSponsoringFutureReserves is the json resource representing a single
+// operation whose type is EndSponsoringFutureReserves.
+type EndSponsoringFutureReserves struct {
+	Base
+	BeginSponsor string `json:"begin_sponsor,omitempty"`
+}
+
+// RevokeSponsorship is the json resource representing a single operation whose
+// type is RevokeSponsorship. Only the fields identifying the revoked ledger
+// entry or signer are set.
+type RevokeSponsorship struct {
+	Base
+	AccountID          *string `json:"account_id,omitempty"`
+	ClaimableBalanceID *string `json:"claimable_balance_id,omitempty"`
+	DataAccountID      *string `json:"data_account_id,omitempty"`
+	DataName           *string `json:"data_name,omitempty"`
+	OfferID            *int64  `json:"offer_id,string,omitempty"`
+	TrustlineAccountID *string `json:"trustline_account_id,omitempty"`
+	TrustlineAsset     *string `json:"trustline_asset,omitempty"`
+	SignerAccountID    *string `json:"signer_account_id,omitempty"`
+	SignerKey          *string `json:"signer_key,omitempty"`
+}
+
 // Operation interface contains methods implemented by the operation types
 type Operation interface {
 	PagingToken() string
@@ -285,7 +336,26 @@ func (ops *OperationsPage) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// UnmarshalOperation decodes responses to the correct operation struct
+// Unknown is an operation whose type is not known to this package, like an
+// operation added to the protocol after this package was released. Raw holds
+// the JSON of the operation.
+type Unknown struct {
+	Base
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON returns the JSON the operation was decoded from.
+func (u Unknown) MarshalJSON() ([]byte, error) {
+	if len(u.Raw) == 0 {
+		return json.Marshal(u.Base)
+	}
+	return u.Raw, nil
+}
+
+//go:generate go run github.com/stellar/go/protocols/horizon/internal/visitorgen -func UnmarshalOperation -interface Operation -visitor OperationVisitor -visit VisitOperation -o operation_visitor.go
+
+// UnmarshalOperation decodes responses to the correct operation struct.
+// Operations of unknown types are decoded to Unknown.
 func UnmarshalOperation(operationTypeID int32, dataString []byte) (ops Operation, err error) {
 	switch xdr.OperationType(operationTypeID) {
 	case xdr.OperationTypeCreateAccount:
@@ -372,8 +442,43 @@ func UnmarshalOperation(operationTypeID int32, dataString []byte) (ops Operation
 			return
 		}
 		ops = op
+	case xdr.OperationTypeCreateClaimableBalance:
+		var op CreateClaimableBalance
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
+	case xdr.OperationTypeClaimClaimableBalance:
+		var op ClaimClaimableBalance
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		var op BeginSponsoringFutureReserves
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		var op EndSponsoringFutureReserves
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
+	case xdr.OperationTypeRevokeSponsorship:
+		var op RevokeSponsorship
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
 	default:
-		err = errors.New("Invalid operation format, unable to unmarshal json response")
+		var op Unknown
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		op.Raw = append(json.RawMessage(nil), dataString...)
+		ops = op
 	}
 
 	return
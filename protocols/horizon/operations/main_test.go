@@ -0,0 +1,79 @@
+package operations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the visited operations, its other methods panic.
+type recorder struct {
+	OperationVisitor
+	visited []string
+}
+
+func (r *recorder) VisitPayment(op Payment) error {
+	r.visited = append(r.visited, "Payment "+op.Amount)
+	return nil
+}
+
+func (r *recorder) VisitUnknown(op Unknown) error {
+	r.visited = append(r.visited, "Unknown "+op.Type)
+	return nil
+}
+
+func TestUnmarshalUnknownOperation(t *testing.T) {
+	data := []byte(`{"id":"12884905985","paging_token":"12884905985","type":"liquidity_pool_deposit","type_i":22,"shares_received":"10.0000000"}`)
+	op, err := UnmarshalOperation(22, data)
+	require.NoError(t, err)
+	unknown, ok := op.(Unknown)
+	require.True(t, ok)
+	assert.Equal(t, "12884905985", unknown.PagingToken())
+	assert.Equal(t, "liquidity_pool_deposit", unknown.GetType())
+
+	encoded, err := json.Marshal(unknown)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+}
+
+func TestUnmarshalCreateClaimableBalance(t *testing.T) {
+	data := []byte(`{
+		"id":"12884905985",
+		"type":"create_claimable_balance",
+		"type_i":14,
+		"asset":"native",
+		"amount":"10.0000000",
+		"claimants":[{
+			"destination":"GCEZWKCA5VLDNRLN3RPRJMRZOX3Z6G5CHCGSNFHEYVXM3XOJMDS674JZ",
+			"predicate":{"rel_before":"60"}
+		}]
+	}`)
+	op, err := UnmarshalOperation(14, data)
+	require.NoError(t, err)
+	create, ok := op.(CreateClaimableBalance)
+	require.True(t, ok)
+	assert.Equal(t, "native", create.Asset)
+	assert.Equal(t, "10.0000000", create.Amount)
+	require.Len(t, create.Claimants, 1)
+	assert.Equal(t, "GCEZWKCA5VLDNRLN3RPRJMRZOX3Z6G5CHCGSNFHEYVXM3XOJMDS674JZ", create.Claimants[0].Destination)
+	assert.Equal(t, xdr.Int64(60), create.Claimants[0].Predicate.MustRelBefore())
+}
+
+func TestVisitOperation(t *testing.T) {
+	var page OperationsPage
+	require.NoError(t, json.Unmarshal([]byte(`{"_embedded":{"records":[
+		{"type":"payment","type_i":1,"amount":"5.0000000"},
+		{"type":"liquidity_pool_deposit","type_i":22}
+	]}}`), &page))
+
+	r := &recorder{}
+	for _, op := range page.Embedded.Records {
+		require.NoError(t, VisitOperation(op, r))
+	}
+	assert.Equal(t, []string{"Payment 5.0000000", "Unknown liquidity_pool_deposit"}, r.visited)
+
+	assert.EqualError(t, VisitOperation(&Payment{}, r), "unexpected operation type *operations.Payment")
+}
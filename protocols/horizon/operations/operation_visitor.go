@@ -0,0 +1,81 @@
+// Code generated by visitorgen. DO NOT EDIT.
+
+package operations
+
+import "fmt"
+
+// OperationVisitor is implemented by the consumers of the Operation values
+// returned by UnmarshalOperation, with one method per concrete type. Use
+// VisitOperation to call the method matching a value.
+type OperationVisitor interface {
+	VisitCreateAccount(CreateAccount) error
+	VisitPathPayment(PathPayment) error
+	VisitPayment(Payment) error
+	VisitManageSellOffer(ManageSellOffer) error
+	VisitCreatePassiveSellOffer(CreatePassiveSellOffer) error
+	VisitSetOptions(SetOptions) error
+	VisitChangeTrust(ChangeTrust) error
+	VisitAllowTrust(AllowTrust) error
+	VisitAccountMerge(AccountMerge) error
+	VisitInflation(Inflation) error
+	VisitManageData(ManageData) error
+	VisitBumpSequence(BumpSequence) error
+	VisitManageBuyOffer(ManageBuyOffer) error
+	VisitPathPaymentStrictSend(PathPaymentStrictSend) error
+	VisitCreateClaimableBalance(CreateClaimableBalance) error
+	VisitClaimClaimableBalance(ClaimClaimableBalance) error
+	VisitBeginSponsoringFutureReserves(BeginSponsoringFutureReserves) error
+	VisitEndSponsoringFutureReserves(EndSponsoringFutureReserves) error
+	VisitRevokeSponsorship(RevokeSponsorship) error
+	VisitUnknown(Unknown) error
+}
+
+// VisitOperation calls the method of visitor matching the concrete type of
+// operation. An error is returned if operation was not returned by
+// UnmarshalOperation.
+func VisitOperation(operation Operation, visitor OperationVisitor) error {
+	switch v := operation.(type) {
+	case CreateAccount:
+		return visitor.VisitCreateAccount(v)
+	case PathPayment:
+		return visitor.VisitPathPayment(v)
+	case Payment:
+		return visitor.VisitPayment(v)
+	case ManageSellOffer:
+		return visitor.VisitManageSellOffer(v)
+	case CreatePassiveSellOffer:
+		return visitor.VisitCreatePassiveSellOffer(v)
+	case SetOptions:
+		return visitor.VisitSetOptions(v)
+	case ChangeTrust:
+		return visitor.VisitChangeTrust(v)
+	case AllowTrust:
+		return visitor.VisitAllowTrust(v)
+	case AccountMerge:
+		return visitor.VisitAccountMerge(v)
+	case Inflation:
+		return visitor.VisitInflation(v)
+	case ManageData:
+		return visitor.VisitManageData(v)
+	case BumpSequence:
+		return visitor.VisitBumpSequence(v)
+	case ManageBuyOffer:
+		return visitor.VisitManageBuyOffer(v)
+	case PathPaymentStrictSend:
+		return visitor.VisitPathPaymentStrictSend(v)
+	case CreateClaimableBalance:
+		return visitor.VisitCreateClaimableBalance(v)
+	case ClaimClaimableBalance:
+		return visitor.VisitClaimClaimableBalance(v)
+	case BeginSponsoringFutureReserves:
+		return visitor.VisitBeginSponsoringFutureReserves(v)
+	case EndSponsoringFutureReserves:
+		return visitor.VisitEndSponsoringFutureReserves(v)
+	case RevokeSponsorship:
+		return visitor.VisitRevokeSponsorship(v)
+	case Unknown:
+		return visitor.VisitUnknown(v)
+	default:
+		return fmt.Errorf("unexpected operation type %T", operation)
+	}
+}
@@ -238,7 +238,7 @@ var configOpts = support.ConfigOptions{
 				*(co.ConfigKey.(**throttled.RateQuota)) = rateLimit
 			}
 		},
-		Usage: "max count of requests allowed in a one hour period, by remote ip address",
+		Usage: "max count of requests allowed in a one hour period, by remote ip address, requests with an API key are limited by the quota of the key instead",
 	},
 	&support.ConfigOption{ // Action needed in release: horizon-v2.0.0
 		// remove deprecated flag
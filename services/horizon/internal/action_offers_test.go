@@ -63,9 +63,21 @@ func TestOfferActions_Show(t *testing.T) {
 	}
 
 	batch := q.NewOffersBatchInsertBuilder(3)
-	err = batch.Add(eurOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &eurOffer,
+		},
+	})
 	ht.Assert.NoError(err)
-	err = batch.Add(usdOffer, 4)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 4,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &usdOffer,
+		},
+	})
 	ht.Assert.NoError(err)
 	ht.Assert.NoError(batch.Exec())
 
@@ -33,7 +33,7 @@ var (
 		Thresholds:    xdr.Thresholds{1, 2, 3, 4},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  3,
 					Selling: 4,
@@ -52,7 +52,7 @@ var (
 		Thresholds:    xdr.Thresholds{5, 6, 7, 8},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  30,
 					Selling: 40,
@@ -70,7 +70,7 @@ var (
 		Thresholds:    xdr.Thresholds{5, 6, 7, 8},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  30,
 					Selling: 40,
@@ -179,34 +179,52 @@ func TestAccountInfo(t *testing.T) {
 		Flags:         0,
 	}
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(accountEntry, 4)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 4,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &accountEntry,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
 	tt.Assert.NoError(err)
 
-	_, err = q.InsertTrustLine(xdr.TrustLineEntry{
-		AccountId: accountID,
-		Asset: xdr.MustNewCreditAsset(
-			"USD",
-			"GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4",
-		),
-		Balance: 0,
-		Limit:   9223372036854775807,
-		Flags:   1,
-	}, 6)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 6,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeTrustline,
+			TrustLine: &xdr.TrustLineEntry{
+				AccountId: accountID,
+				Asset: xdr.MustNewCreditAsset(
+					"USD",
+					"GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4",
+				),
+				Balance: 0,
+				Limit:   9223372036854775807,
+				Flags:   1,
+			},
+		},
+	})
 	assert.NoError(t, err)
 
-	_, err = q.InsertTrustLine(xdr.TrustLineEntry{
-		AccountId: accountID,
-		Asset: xdr.MustNewCreditAsset(
-			"EUR",
-			"GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4",
-		),
-		Balance: 0,
-		Limit:   9223372036854775807,
-		Flags:   1,
-	}, 6)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 6,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeTrustline,
+			TrustLine: &xdr.TrustLineEntry{
+				AccountId: accountID,
+				Asset: xdr.MustNewCreditAsset(
+					"EUR",
+					"GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4",
+				),
+				Balance: 0,
+				Limit:   9223372036854775807,
+				Flags:   1,
+			},
+		},
+	})
 	assert.NoError(t, err)
 
 	ledgerFourCloseTime := time.Now().Unix()
@@ -282,16 +300,34 @@ func TestGetAccountsHandlerPageResultsBySigner(t *testing.T) {
 	handler := &GetAccountsHandler{}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1234)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account3, 1234)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account3,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
 	for _, row := range accountSigners {
-		q.CreateAccountSigner(row.Account, row.Signer, row.Weight)
+		q.CreateAccountSigner(row.Account, row.Signer, row.Weight, nil)
 	}
 
 	records, err := handler.GetResourcePage(
@@ -362,9 +398,21 @@ func TestGetAccountsHandlerPageResultsByAsset(t *testing.T) {
 	handler := &GetAccountsHandler{}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1234)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 	ledgerCloseTime := time.Now().Unix()
@@ -379,13 +427,25 @@ func TestGetAccountsHandlerPageResultsByAsset(t *testing.T) {
 	assert.NoError(t, err)
 
 	for _, row := range accountSigners {
-		_, err = q.CreateAccountSigner(row.Account, row.Signer, row.Weight)
+		_, err = q.CreateAccountSigner(row.Account, row.Signer, row.Weight, nil)
 		tt.Assert.NoError(err)
 	}
 
-	_, err = q.InsertAccountData(data1, 1234)
+	_, err = q.InsertAccountData(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeData,
+			Data: &data1,
+		},
+	})
 	assert.NoError(t, err)
-	_, err = q.InsertAccountData(data2, 1234)
+	_, err = q.InsertAccountData(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeData,
+			Data: &data2,
+		},
+	})
 	assert.NoError(t, err)
 
 	var assetType, code, issuer string
@@ -407,9 +467,21 @@ func TestGetAccountsHandlerPageResultsByAsset(t *testing.T) {
 	tt.Assert.NoError(err)
 	tt.Assert.Equal(0, len(records))
 
-	_, err = q.InsertTrustLine(eurTrustLine, 1234)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	assert.NoError(t, err)
-	_, err = q.InsertTrustLine(usdTrustLine, 1235)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 
 	records, err = handler.GetResourcePage(
@@ -220,7 +220,13 @@ func TestAssetStats(t *testing.T) {
 			t.Fatalf("unexpected error %v", err)
 		}
 		batch := q.NewAccountsBatchInsertBuilder(0)
-		err := batch.Add(accountEntry, 3)
+		err := batch.Add(xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 3,
+			Data: xdr.LedgerEntryData{
+				Type:    xdr.LedgerEntryTypeAccount,
+				Account: &accountEntry,
+			},
+		})
 		tt.Assert.NoError(err)
 		tt.Assert.NoError(batch.Exec())
 	}
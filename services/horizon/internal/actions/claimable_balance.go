@@ -0,0 +1,225 @@
+package actions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/stellar/go/protocols/horizon"
+	horizonContext "github.com/stellar/go/services/horizon/internal/context"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+// ClaimableBalanceQuery query struct for claimable_balances/id end-point
+type ClaimableBalanceQuery struct {
+	ID string `schema:"id" valid:"required"`
+}
+
+// Validate runs extra validations on query parameters
+func (q ClaimableBalanceQuery) Validate() error {
+	_, err := q.BalanceID()
+	return err
+}
+
+// BalanceID returns the xdr.ClaimableBalanceId from the request query
+func (q ClaimableBalanceQuery) BalanceID() (xdr.ClaimableBalanceId, error) {
+	return parseBalanceID("id", q.ID)
+}
+
+// GetClaimableBalanceByIDHandler is the action handler for the
+// /claimable_balances/{id} endpoint
+type GetClaimableBalanceByIDHandler struct{}
+
+// GetResource returns a claimable balance by id.
+func (handler GetClaimableBalanceByIDHandler) GetResource(w HeaderWriter, r *http.Request) (interface{}, error) {
+	ctx := r.Context()
+
+	qp := ClaimableBalanceQuery{}
+	if err := getParams(&qp, r); err != nil {
+		return nil, err
+	}
+
+	balanceID, err := qp.BalanceID()
+	if err != nil {
+		return nil, err
+	}
+
+	historyQ, err := horizonContext.HistoryQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := historyQ.FindClaimableBalanceByID(balanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := &history.Ledger{}
+	err = historyQ.LedgerBySequence(
+		ledger,
+		int32(record.LastModifiedLedger),
+	)
+	if historyQ.NoRows(err) {
+		ledger = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var resource horizon.ClaimableBalance
+	resourceadapter.PopulateClaimableBalance(ctx, &resource, record, ledger)
+	return resource, nil
+}
+
+// ClaimableBalancesQuery query struct for claimable_balances end-point
+type ClaimableBalancesQuery struct {
+	AssetFilter    string `schema:"asset" valid:"asset,optional"`
+	SponsorFilter  string `schema:"sponsor" valid:"accountID,optional"`
+	ClaimantFilter string `schema:"claimant" valid:"accountID,optional"`
+}
+
+// URITemplate returns a rfc6570 URI template the query struct
+func (q ClaimableBalancesQuery) URITemplate() string {
+	return "/claimable_balances{?" + strings.Join(getURIParams(&q, true), ",") + "}"
+}
+
+var invalidClaimableBalancesParams = problem.P{
+	Type:   "invalid_claimable_balances_params",
+	Title:  "Invalid Claimable Balances Parameters",
+	Status: http.StatusBadRequest,
+	Detail: "A filter is required. Please ensure that you are including an asset, a sponsor or a claimant.",
+}
+
+// Validate runs custom validations.
+func (q ClaimableBalancesQuery) Validate() error {
+	if q.AssetFilter == "" && q.SponsorFilter == "" && q.ClaimantFilter == "" {
+		return invalidClaimableBalancesParams
+	}
+	return nil
+}
+
+// Asset returns an xdr.Asset representing the Asset we want to find the
+// claimable balances by.
+func (q ClaimableBalancesQuery) Asset() *xdr.Asset {
+	if len(q.AssetFilter) == 0 {
+		return nil
+	}
+
+	assets, err := xdr.BuildAssets(q.AssetFilter)
+	if err != nil {
+		return nil
+	}
+	return &assets[0]
+}
+
+// Sponsor returns an xdr.AccountId representing the account sponsoring the
+// claimable balances we want to find.
+func (q ClaimableBalancesQuery) Sponsor() *xdr.AccountId {
+	if len(q.SponsorFilter) == 0 {
+		return nil
+	}
+
+	sponsor := xdr.MustAddress(q.SponsorFilter)
+	return &sponsor
+}
+
+// Claimant returns an xdr.AccountId representing a claimant of the claimable
+// balances we want to find.
+func (q ClaimableBalancesQuery) Claimant() *xdr.AccountId {
+	if len(q.ClaimantFilter) == 0 {
+		return nil
+	}
+
+	claimant := xdr.MustAddress(q.ClaimantFilter)
+	return &claimant
+}
+
+// GetClaimableBalancesHandler is the action handler for the
+// /claimable_balances endpoint
+type GetClaimableBalancesHandler struct{}
+
+// GetResourcePage returns a page of claimable balances.
+func (handler GetClaimableBalancesHandler) GetResourcePage(
+	w HeaderWriter,
+	r *http.Request,
+) ([]hal.Pageable, error) {
+	ctx := r.Context()
+	qp := ClaimableBalancesQuery{}
+	if err := getParams(&qp, r); err != nil {
+		return nil, err
+	}
+
+	// claimable balances are paged by their hex encoded id, which is not a
+	// valid numeric cursor
+	pq, err := GetPageQuery(r, DisableCursorValidation)
+	if err != nil {
+		return nil, err
+	}
+	if pq.Cursor != "" {
+		if _, err = parseBalanceID(ParamCursor, pq.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	query := history.ClaimableBalancesQuery{
+		PageQuery: pq,
+		Asset:     qp.Asset(),
+		Sponsor:   qp.Sponsor(),
+		Claimant:  qp.Claimant(),
+	}
+
+	historyQ, err := horizonContext.HistoryQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return getClaimableBalancesPage(ctx, historyQ, query)
+}
+
+func getClaimableBalancesPage(ctx context.Context, historyQ *history.Q, query history.ClaimableBalancesQuery) ([]hal.Pageable, error) {
+	records, err := historyQ.GetClaimableBalances(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerCache := history.LedgerCache{}
+	for _, record := range records {
+		ledgerCache.Queue(int32(record.LastModifiedLedger))
+	}
+
+	if err := ledgerCache.Load(historyQ); err != nil {
+		return nil, errors.Wrap(err, "failed to load ledger batch")
+	}
+
+	var claimableBalances []hal.Pageable
+	for _, record := range records {
+		var resource horizon.ClaimableBalance
+
+		var ledger *history.Ledger
+		if l, ok := ledgerCache.Records[int32(record.LastModifiedLedger)]; ok {
+			ledger = &l
+		}
+
+		resourceadapter.PopulateClaimableBalance(ctx, &resource, record, ledger)
+		claimableBalances = append(claimableBalances, resource)
+	}
+
+	return claimableBalances, nil
+}
+
+// parseBalanceID decodes the hex encoded claimable balance id in the field
+// `name`.
+func parseBalanceID(name, value string) (xdr.ClaimableBalanceId, error) {
+	var balanceID xdr.ClaimableBalanceId
+	if err := xdr.SafeUnmarshalHex(value, &balanceID); err != nil {
+		return balanceID, problem.MakeInvalidFieldProblem(
+			name,
+			errors.New("Invalid claimable balance id"),
+		)
+	}
+	return balanceID, nil
+}
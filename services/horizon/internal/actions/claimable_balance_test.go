@@ -0,0 +1,133 @@
+package actions
+
+import (
+	"database/sql"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func claimableBalanceEntry(hash byte, asset xdr.Asset, amount xdr.Int64, claimants ...xdr.AccountId) xdr.LedgerEntry {
+	balanceID := xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &xdr.Hash{hash},
+	}
+	entry := xdr.ClaimableBalanceEntry{
+		BalanceId: balanceID,
+		Asset:     asset,
+		Amount:    amount,
+	}
+	for _, claimant := range claimants {
+		entry.Claimants = append(entry.Claimants, xdr.Claimant{
+			Type: xdr.ClaimantTypeClaimantTypeV0,
+			V0: &xdr.ClaimantV0{
+				Destination: claimant,
+				Predicate: xdr.ClaimPredicate{
+					Type: xdr.ClaimPredicateTypeClaimPredicateUnconditional,
+				},
+			},
+		})
+	}
+	return xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:             xdr.LedgerEntryTypeClaimableBalance,
+			ClaimableBalance: &entry,
+		},
+	}
+}
+
+func TestClaimableBalancesQueryValidation(t *testing.T) {
+	tt := assert.New(t)
+	handler := GetClaimableBalancesHandler{}
+
+	_, err := handler.GetResourcePage(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{}, map[string]string{}, nil),
+	)
+	tt.Equal(invalidClaimableBalancesParams, err)
+
+	_, err = handler.GetResourcePage(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{"sponsor": "GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD", "cursor": "1234"}, map[string]string{}, nil),
+	)
+	if tt.Error(err) {
+		p := err.(*problem.P)
+		tt.Equal("bad_request", p.Type)
+		tt.Equal("cursor", p.Extras["invalid_field"])
+	}
+
+	_, err = GetClaimableBalanceByIDHandler{}.GetResource(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{}, map[string]string{"id": "not-a-balance"}, nil),
+	)
+	if tt.Error(err) {
+		p := err.(*problem.P)
+		tt.Equal("bad_request", p.Type)
+		tt.Equal("id", p.Extras["invalid_field"])
+	}
+}
+
+func TestGetClaimableBalances(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+
+	q := &history.Q{tt.HorizonSession()}
+	handler := GetClaimableBalancesHandler{}
+
+	nativeBalance := claimableBalanceEntry(1, nativeAsset, 100, seller)
+	usdBalance := claimableBalanceEntry(2, usdAsset, 200, issuer, seller)
+	eurBalance := claimableBalanceEntry(3, eurAsset, 300, issuer)
+	tt.Assert.NoError(q.UpsertClaimableBalances([]xdr.LedgerEntry{nativeBalance, usdBalance, eurBalance}))
+
+	records, err := handler.GetResourcePage(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{"claimant": seller.Address()}, map[string]string{}, q.Session),
+	)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(records, 2)
+	first := records[0].(horizon.ClaimableBalance)
+	tt.Assert.Equal("native", first.Asset)
+	tt.Assert.Equal("0.0000100", first.Amount)
+	tt.Assert.Equal(seller.Address(), first.Claimants[0].Destination)
+
+	records, err = handler.GetResourcePage(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{"claimant": seller.Address(), "cursor": first.PT}, map[string]string{}, q.Session),
+	)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(records, 1)
+	tt.Assert.Equal(usdAsset.StringCanonical(), records[0].(horizon.ClaimableBalance).Asset)
+
+	records, err = handler.GetResourcePage(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{"asset": eurAsset.StringCanonical()}, map[string]string{}, q.Session),
+	)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(records, 1)
+	tt.Assert.Equal(issuer.Address(), records[0].(horizon.ClaimableBalance).Claimants[0].Destination)
+
+	balanceID, err := xdr.MarshalHex(eurBalance.Data.MustClaimableBalance().BalanceId)
+	tt.Assert.NoError(err)
+	resource, err := GetClaimableBalanceByIDHandler{}.GetResource(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{}, map[string]string{"id": balanceID}, q.Session),
+	)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(balanceID, resource.(horizon.ClaimableBalance).BalanceID)
+
+	missingID, err := xdr.MarshalHex(claimableBalanceEntry(4, nativeAsset, 1, seller).Data.MustClaimableBalance().BalanceId)
+	tt.Assert.NoError(err)
+	_, err = GetClaimableBalanceByIDHandler{}.GetResource(
+		httptest.NewRecorder(),
+		makeRequest(t, map[string]string{}, map[string]string{"id": missingID}, q.Session),
+	)
+	tt.Assert.Equal(sql.ErrNoRows, err)
+}
@@ -82,9 +82,21 @@ func TestGetOfferByIDHandler(t *testing.T) {
 	tt.Assert.NoError(err)
 
 	batch := q.NewOffersBatchInsertBuilder(0)
-	err = batch.Add(eurOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &eurOffer,
+		},
+	})
 	tt.Assert.NoError(err)
-	err = batch.Add(usdOffer, 4)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 4,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &usdOffer,
+		},
+	})
 	tt.Assert.NoError(err)
 	tt.Assert.NoError(batch.Exec())
 
@@ -193,11 +205,29 @@ func TestGetOffersHandler(t *testing.T) {
 	tt.Assert.NoError(err)
 
 	batch := q.NewOffersBatchInsertBuilder(0)
-	err = batch.Add(eurOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &eurOffer,
+		},
+	})
 	tt.Assert.NoError(err)
-	err = batch.Add(twoEurOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &twoEurOffer,
+		},
+	})
 	tt.Assert.NoError(err)
-	err = batch.Add(usdOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &usdOffer,
+		},
+	})
 	tt.Assert.NoError(err)
 	tt.Assert.NoError(batch.Exec())
 
@@ -430,10 +460,28 @@ func TestGetAccountOffersHandler(t *testing.T) {
 	handler := GetAccountOffersHandler{}
 
 	batch := q.NewOffersBatchInsertBuilder(0)
-	err := batch.Add(eurOffer, 3)
-	err = batch.Add(twoEurOffer, 3)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &eurOffer,
+		},
+	})
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &twoEurOffer,
+		},
+	})
 	tt.Assert.NoError(err)
-	err = batch.Add(usdOffer, 3)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 3,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &usdOffer,
+		},
+	})
 	tt.Assert.NoError(err)
 	tt.Assert.NoError(batch.Exec())
 
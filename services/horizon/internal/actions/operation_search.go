@@ -0,0 +1,247 @@
+package actions
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	gTime "time"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/protocols/horizon/operations"
+	horizonContext "github.com/stellar/go/services/horizon/internal/context"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/support/time"
+	"github.com/stellar/go/xdr"
+)
+
+// maxMemoTextLength is the maximum length in bytes of a text memo.
+const maxMemoTextLength = 28
+
+// maxSearchTimeRange is the maximum time range of an operations search which
+// is not filtered by account, memo or asset.
+const maxSearchTimeRange = 24 * gTime.Hour
+
+// OperationSearchQuery query struct for the operations search end-point
+type OperationSearchQuery struct {
+	Joinable                  `valid:"optional"`
+	AccountID                 string      `schema:"account_id" valid:"accountID,optional"`
+	MemoType                  string      `schema:"memo_type" valid:"in(text|id|hash|return)~Accepted values: text; id; hash; return,optional"`
+	Memo                      string      `schema:"memo" valid:"-"`
+	Asset                     string      `schema:"asset" valid:"asset,optional"`
+	MinAmount                 string      `schema:"min_amount" valid:"amount,optional"`
+	MaxAmount                 string      `schema:"max_amount" valid:"amount,optional"`
+	Type                      string      `schema:"type" valid:"-"`
+	StartTime                 time.Millis `schema:"start_time" valid:"-"`
+	EndTime                   time.Millis `schema:"end_time" valid:"-"`
+	IncludeFailedTransactions bool        `schema:"include_failed" valid:"-"`
+}
+
+// Validate runs extra validations on query parameters
+func (qp OperationSearchQuery) Validate() error {
+	filters, err := countNonEmpty(
+		qp.AccountID,
+		qp.MemoType,
+		qp.Memo,
+		qp.Asset,
+		qp.MinAmount,
+		qp.MaxAmount,
+		qp.Type,
+		int64(qp.StartTime),
+		int64(qp.EndTime),
+	)
+	if err != nil {
+		return problem.BadRequest
+	}
+	if filters == 0 {
+		return problem.MakeInvalidFieldProblem(
+			"filters",
+			errors.New("Use at least one of account_id, memo_type, asset, min_amount, max_amount, type, start_time or end_time"),
+		)
+	}
+
+	if err = qp.validateMemo(); err != nil {
+		return err
+	}
+
+	if qp.MinAmount != "" && qp.MaxAmount != "" &&
+		amount.MustParse(qp.MinAmount) > amount.MustParse(qp.MaxAmount) {
+		return problem.MakeInvalidFieldProblem(
+			"max_amount",
+			errors.New("max_amount must not be lower than min_amount"),
+		)
+	}
+
+	if _, err = qp.OperationTypes(); err != nil {
+		return err
+	}
+
+	if !qp.StartTime.IsNil() && !qp.EndTime.IsNil() && qp.StartTime >= qp.EndTime {
+		return problem.MakeInvalidFieldProblem(
+			"end_time",
+			errors.New("end_time must be after start_time"),
+		)
+	}
+
+	// The amount and type filters can't use an index, they only narrow down
+	// the operations selected by one of the other filters.
+	if qp.AccountID == "" && qp.Memo == "" && qp.Asset == "" && !qp.boundedTimeRange() {
+		return problem.MakeInvalidFieldProblem(
+			"filters",
+			errors.New("Use at least one of account_id, memo, asset or a start_time and end_time at most 24 hours apart"),
+		)
+	}
+
+	return nil
+}
+
+// boundedTimeRange returns true if the query selects the operations of at
+// most maxSearchTimeRange.
+func (qp OperationSearchQuery) boundedTimeRange() bool {
+	if qp.StartTime.IsNil() || qp.EndTime.IsNil() {
+		return false
+	}
+	return qp.EndTime.ToTime().Sub(qp.StartTime.ToTime()) <= maxSearchTimeRange
+}
+
+func (qp OperationSearchQuery) validateMemo() error {
+	if qp.MemoType == "" {
+		if qp.Memo != "" {
+			return problem.MakeInvalidFieldProblem(
+				"memo_type",
+				errors.New("memo_type is required when filtering by memo"),
+			)
+		}
+		return nil
+	}
+
+	var valid bool
+	switch qp.MemoType {
+	case "text":
+		valid = qp.Memo != "" && len(qp.Memo) <= maxMemoTextLength
+	case "id":
+		_, err := strconv.ParseUint(qp.Memo, 10, 64)
+		valid = err == nil
+	case "hash", "return":
+		decoded, err := base64.StdEncoding.DecodeString(qp.Memo)
+		valid = err == nil && len(decoded) == 32
+	}
+	if !valid {
+		return problem.MakeInvalidFieldProblem(
+			"memo",
+			errors.New("memo must be a text of at most 28 bytes, a 64 bit unsigned integer or a base64 encoded 32 byte hash, depending on memo_type"),
+		)
+	}
+	return nil
+}
+
+// OperationTypes returns the operation types of the comma separated type
+// parameter.
+func (qp OperationSearchQuery) OperationTypes() ([]xdr.OperationType, error) {
+	if qp.Type == "" {
+		return nil, nil
+	}
+
+	var types []xdr.OperationType
+	for _, name := range strings.Split(qp.Type, ",") {
+		found := false
+		for typ, typeName := range operations.TypeNames {
+			if typeName == name {
+				types = append(types, typ)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, problem.MakeInvalidFieldProblem(
+				"type",
+				errors.Errorf("unknown operation type %s", name),
+			)
+		}
+	}
+	return types, nil
+}
+
+// SearchOperationsHandler is the action handler for the operations search
+// end-point.
+type SearchOperationsHandler struct{}
+
+// GetResourcePage returns a page of the operations matching the search
+// filters.
+func (handler SearchOperationsHandler) GetResourcePage(w HeaderWriter, r *http.Request) ([]hal.Pageable, error) {
+	ctx := r.Context()
+
+	pq, err := GetPageQuery(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateCursorWithinHistory(pq)
+	if err != nil {
+		return nil, err
+	}
+
+	qp := OperationSearchQuery{}
+	err = getParams(&qp, r)
+	if err != nil {
+		return nil, err
+	}
+
+	historyQ, err := horizonContext.HistoryQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	query := historyQ.Operations()
+
+	// ForAccount must come first so that the other filters use the id column
+	// of history_operation_participants.
+	if qp.AccountID != "" {
+		query.ForAccount(qp.AccountID)
+	}
+	if qp.MemoType != "" {
+		query.ForMemo(qp.MemoType, qp.Memo)
+	}
+	if qp.Asset != "" {
+		assets, err := xdr.BuildAssets(qp.Asset)
+		if err != nil {
+			return nil, err
+		}
+		query.ForPaymentAsset(assets[0])
+	}
+	query.ForPaymentAmountRange(qp.MinAmount, qp.MaxAmount)
+	types, err := qp.OperationTypes()
+	if err != nil {
+		return nil, err
+	}
+	if len(types) > 0 {
+		query.ForTypes(types...)
+	}
+	if !qp.StartTime.IsNil() || !qp.EndTime.IsNil() {
+		var start, end gTime.Time
+		if !qp.StartTime.IsNil() {
+			start = qp.StartTime.ToTime()
+		}
+		if !qp.EndTime.IsNil() {
+			end = qp.EndTime.ToTime()
+		}
+		query.ForTimeRange(start, end)
+	}
+
+	if qp.IncludeFailedTransactions {
+		query.IncludeFailed()
+	}
+
+	if qp.IncludeTransactions() {
+		query.IncludeTransactions()
+	}
+
+	ops, txs, err := query.Page(pq).Fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	return buildOperationsPage(ctx, historyQ, ops, txs, qp.IncludeTransactions())
+}
@@ -0,0 +1,139 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationSearchQueryValidation(t *testing.T) {
+	for _, testCase := range []struct {
+		name          string
+		params        map[string]string
+		expectedField string
+	}{
+		{
+			"no filters",
+			map[string]string{},
+			"filters",
+		},
+		{
+			"memo without memo_type",
+			map[string]string{"memo": "123"},
+			"memo_type",
+		},
+		{
+			"invalid memo_type",
+			map[string]string{"memo_type": "none", "memo": "123"},
+			"memo_type",
+		},
+		{
+			"invalid id memo",
+			map[string]string{"memo_type": "id", "memo": "abc"},
+			"memo",
+		},
+		{
+			"too long text memo",
+			map[string]string{"memo_type": "text", "memo": "12345678901234567890123456789"},
+			"memo",
+		},
+		{
+			"invalid hash memo",
+			map[string]string{"memo_type": "hash", "memo": "aGVsbG8="},
+			"memo",
+		},
+		{
+			"invalid asset",
+			map[string]string{"asset": "USD"},
+			"asset",
+		},
+		{
+			"invalid amount",
+			map[string]string{"min_amount": "-1"},
+			"min_amount",
+		},
+		{
+			"inverted amount range",
+			map[string]string{"min_amount": "10", "max_amount": "1"},
+			"max_amount",
+		},
+		{
+			"unknown type",
+			map[string]string{"type": "payment,transfer"},
+			"type",
+		},
+		{
+			"inverted time range",
+			map[string]string{"start_time": "1000", "end_time": "1000"},
+			"end_time",
+		},
+		{
+			"only amount",
+			map[string]string{"min_amount": "1000", "max_amount": "2000"},
+			"filters",
+		},
+		{
+			"only type",
+			map[string]string{"type": "payment"},
+			"filters",
+		},
+		{
+			"only start_time",
+			map[string]string{"start_time": "1590000000000", "type": "payment"},
+			"filters",
+		},
+		{
+			"too long time range",
+			map[string]string{"start_time": "1590000000000", "end_time": "1590086400001", "min_amount": "10"},
+			"filters",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			qp := OperationSearchQuery{}
+			err := getParams(&qp, makeRequest(t, testCase.params, map[string]string{}, nil))
+			require.Error(t, err)
+			p, ok := err.(*problem.P)
+			require.True(t, ok, "unexpected error %v", err)
+			assert.Equal(t, testCase.expectedField, p.Extras["invalid_field"])
+		})
+	}
+}
+
+func TestOperationSearchQuery(t *testing.T) {
+	qp := OperationSearchQuery{}
+	err := getParams(&qp, makeRequest(t, map[string]string{
+		"memo_type":  "hash",
+		"memo":       "t7gxl5fXQyV4+JwKO8R5QJ5ZbKI5ZHYXkzvH1lMhO3w=",
+		"asset":      "USD:GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB",
+		"min_amount": "1000",
+		"type":       "payment,path_payment_strict_send",
+		"start_time": "1590000000000",
+		"end_time":   "1600000000000",
+	}, map[string]string{}, nil))
+	require.NoError(t, err)
+
+	types, err := qp.OperationTypes()
+	require.NoError(t, err)
+	assert.Equal(t, []xdr.OperationType{
+		xdr.OperationTypePayment,
+		xdr.OperationTypePathPaymentStrictSend,
+	}, types)
+	assert.Equal(t, int64(1590000000000), qp.StartTime.ToInt64())
+	assert.Equal(t, int64(1600000000000), qp.EndTime.ToInt64())
+}
+
+func TestOperationSearchQuerySelectiveFilters(t *testing.T) {
+	for _, params := range []map[string]string{
+		{"account_id": "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB", "type": "payment"},
+		{"memo_type": "id", "memo": "123"},
+		{"asset": "native", "min_amount": "1000"},
+		{"start_time": "1590000000000", "end_time": "1590086400000", "type": "payment"},
+	} {
+		qp := OperationSearchQuery{}
+		err := getParams(&qp, makeRequest(t, params, map[string]string{}, nil))
+		assert.NoError(t, err, "%v", params)
+	}
+}
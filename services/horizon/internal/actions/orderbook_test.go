@@ -532,7 +532,13 @@ func TestOrderbookGetResource(t *testing.T) {
 
 	batch := q.NewOffersBatchInsertBuilder(0)
 	for i, offer := range offers {
-		assert.NoError(t, batch.Add(offer, xdr.Uint32(i+1)))
+		assert.NoError(t, batch.Add(xdr.LedgerEntry{
+			LastModifiedLedgerSeq: xdr.Uint32(i + 1),
+			Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &offer,
+			},
+		}))
 	}
 	assert.NoError(t, batch.Exec())
 
@@ -46,11 +46,23 @@ func TestDataActions_Show(t *testing.T) {
 	}, 0, 0, 0, 0, 0)
 	ht.Assert.NoError(err)
 
-	rows, err := q.InsertAccountData(data1, 1234)
+	rows, err := q.InsertAccountData(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeData,
+			Data: &data1,
+		},
+	})
 	assert.NoError(t, err)
 	ht.Assert.Equal(int64(1), rows)
 
-	rows, err = q.InsertAccountData(data2, 1235)
+	rows, err = q.InsertAccountData(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeData,
+			Data: &data2,
+		},
+	})
 	assert.NoError(t, err)
 	ht.Assert.Equal(int64(1), rows)
 
@@ -152,7 +152,7 @@ func TestPathActionsStrictReceive(t *testing.T) {
 		Thresholds:    xdr.Thresholds{1, 2, 3, 4},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  3,
 					Selling: 4,
@@ -162,7 +162,13 @@ func TestPathActionsStrictReceive(t *testing.T) {
 	}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account,
+		},
+	})
 	assert.NoError(t, err)
 	err = batch.Exec()
 	assert.NoError(t, err)
@@ -192,7 +198,13 @@ func TestPathActionsStrictReceive(t *testing.T) {
 			},
 		}
 
-		rows, err1 := q.InsertTrustLine(trustline, 1234)
+		rows, err1 := q.InsertTrustLine(xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 1234,
+			Data: xdr.LedgerEntryData{
+				Type:      xdr.LedgerEntryTypeTrustline,
+				TrustLine: &trustline,
+			},
+		})
 		assert.NoError(t, err1)
 		assert.Equal(t, int64(1), rows)
 	}
@@ -498,7 +510,7 @@ func TestPathActionsStrictSend(t *testing.T) {
 		Thresholds:    xdr.Thresholds{1, 2, 3, 4},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  3,
 					Selling: 4,
@@ -508,7 +520,13 @@ func TestPathActionsStrictSend(t *testing.T) {
 	}
 
 	batch := historyQ.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account,
+		},
+	})
 	assert.NoError(t, err)
 	err = batch.Exec()
 	assert.NoError(t, err)
@@ -538,7 +556,13 @@ func TestPathActionsStrictSend(t *testing.T) {
 			},
 		}
 
-		rows, err := historyQ.InsertTrustLine(trustline, 1234)
+		rows, err := historyQ.InsertTrustLine(xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 1234,
+			Data: xdr.LedgerEntryData{
+				Type:      xdr.LedgerEntryTypeTrustline,
+				TrustLine: &trustline,
+			},
+		})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), rows)
 	}
@@ -53,6 +53,10 @@ func TestRootAction(t *testing.T) {
 			"http://localhost/offers{?selling,buying,seller,cursor,limit,order}",
 			actual.Links.Offers.Href,
 		)
+		ht.Assert.Equal(
+			"http://localhost/claimable_balances{?asset,sponsor,claimant,cursor,limit,order}",
+			actual.Links.ClaimableBalances.Href,
+		)
 
 		params := []string{
 			"destination_account",
@@ -0,0 +1,227 @@
+package apikeys
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/httpjson"
+	"github.com/stellar/go/support/render/problem"
+)
+
+// APIKey is the resource representing an API key. Key is only rendered when
+// the API key is created.
+type APIKey struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Key              string    `json:"key,omitempty"`
+	PerHourRateLimit int32     `json:"per_hour_rate_limit"`
+	MaxBurst         int32     `json:"max_burst"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// apiKeyRequest is the body of a request creating or updating an API key.
+type apiKeyRequest struct {
+	Name             string `json:"name"`
+	PerHourRateLimit int32  `json:"per_hour_rate_limit"`
+	MaxBurst         int32  `json:"max_burst"`
+}
+
+// Handler serves the API keys admin endpoints:
+//
+//	POST   /       creates an API key, the response is the only one
+//	               containing the key
+//	GET    /       lists the API keys
+//	GET    /{id}   shows an API key
+//	PUT    /{id}   updates the name and the quota of an API key
+//	DELETE /{id}   deletes an API key
+//
+// Updated quotas and deleted keys take effect within CacheTTL.
+func Handler(session *db.Session) http.Handler {
+	h := handler{session: session}
+	r := chi.NewRouter()
+	r.Post("/", h.create)
+	r.Get("/", h.list)
+	r.Route("/{id}", func(r chi.Router) {
+		r.Get("/", h.show)
+		r.Put("/", h.update)
+		r.Delete("/", h.delete)
+	})
+	return r
+}
+
+type handler struct {
+	session *db.Session
+}
+
+func (h handler) historyQ(r *http.Request) *history.Q {
+	session := h.session.Clone()
+	session.Ctx = r.Context()
+	return &history.Q{Session: session}
+}
+
+func (h handler) create(w http.ResponseWriter, r *http.Request) {
+	req, err := decodeRequest(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	key, err := generate()
+	if err != nil {
+		problem.Render(r.Context(), w, errors.Wrap(err, "could not generate API key"))
+		return
+	}
+
+	apiKey := req.apiKey()
+	apiKey.KeyHash = Hash(key)
+	apiKey.CreatedAt = time.Now().UTC()
+	apiKey.ID, err = h.historyQ(r).InsertAPIKey(apiKey)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+
+	resource := newAPIKey(apiKey)
+	resource.Key = key
+	httpjson.RenderStatus(w, http.StatusCreated, resource, httpjson.JSON)
+}
+
+func (h handler) list(w http.ResponseWriter, r *http.Request) {
+	apiKeys, err := h.historyQ(r).GetAPIKeys()
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	records := []APIKey{}
+	for _, apiKey := range apiKeys {
+		records = append(records, newAPIKey(apiKey))
+	}
+	httpjson.Render(w, map[string]interface{}{"records": records}, httpjson.JSON)
+}
+
+func (h handler) show(w http.ResponseWriter, r *http.Request) {
+	apiKey, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	httpjson.Render(w, newAPIKey(apiKey), httpjson.JSON)
+}
+
+func (h handler) update(w http.ResponseWriter, r *http.Request) {
+	apiKey, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	req, err := decodeRequest(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+
+	apiKey.Name = req.Name
+	apiKey.PerHourRateLimit = req.PerHourRateLimit
+	apiKey.MaxBurst = req.MaxBurst
+	updated, err := h.historyQ(r).UpdateAPIKey(apiKey)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	if updated == 0 {
+		problem.Render(r.Context(), w, problem.NotFound)
+		return
+	}
+	httpjson.Render(w, newAPIKey(apiKey), httpjson.JSON)
+}
+
+func (h handler) delete(w http.ResponseWriter, r *http.Request) {
+	id, err := apiKeyID(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	deleted, err := h.historyQ(r).DeleteAPIKey(id)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	if deleted == 0 {
+		problem.Render(r.Context(), w, problem.NotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeRequest decodes and validates the body of r.
+func decodeRequest(r *http.Request) (apiKeyRequest, error) {
+	var req apiKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, problem.MakeInvalidFieldProblem("body", err)
+	}
+	return req, req.validate()
+}
+
+func (req apiKeyRequest) validate() error {
+	if req.Name == "" {
+		return problem.MakeInvalidFieldProblem(
+			"name", errors.New("name must not be empty"),
+		)
+	}
+	if req.PerHourRateLimit <= 0 {
+		return problem.MakeInvalidFieldProblem(
+			"per_hour_rate_limit", errors.New("per_hour_rate_limit must be positive"),
+		)
+	}
+	if req.MaxBurst < 0 {
+		return problem.MakeInvalidFieldProblem(
+			"max_burst", errors.New("max_burst must not be negative"),
+		)
+	}
+	return nil
+}
+
+func (req apiKeyRequest) apiKey() history.APIKey {
+	return history.APIKey{
+		Name:             req.Name,
+		PerHourRateLimit: req.PerHourRateLimit,
+		MaxBurst:         req.MaxBurst,
+	}
+}
+
+// load loads the API key identified by the id url parameter.
+func (h handler) load(r *http.Request) (history.APIKey, error) {
+	id, err := apiKeyID(r)
+	if err != nil {
+		return history.APIKey{}, err
+	}
+	q := h.historyQ(r)
+	apiKey, err := q.GetAPIKeyByID(id)
+	if q.NoRows(err) {
+		return apiKey, problem.NotFound
+	}
+	return apiKey, err
+}
+
+func apiKeyID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, problem.MakeInvalidFieldProblem("id", errors.New("id must be an API key id"))
+	}
+	return id, nil
+}
+
+func newAPIKey(row history.APIKey) APIKey {
+	return APIKey{
+		ID:               row.ID,
+		Name:             row.Name,
+		PerHourRateLimit: row.PerHourRateLimit,
+		MaxBurst:         row.MaxBurst,
+		CreatedAt:        row.CreatedAt,
+	}
+}
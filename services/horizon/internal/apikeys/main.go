@@ -0,0 +1,60 @@
+// Package apikeys implements the API keys which clients send to be rate
+// limited with a quota of their own instead of the one of their IP address,
+// so that clients behind a NAT do not share a quota.
+//
+// API keys are created through the admin router, which renders a key only
+// once: the Horizon DB stores the SHA-256 hash of the key along with its
+// quota.
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/throttled"
+)
+
+const (
+	// Header is the header containing the API key of a request.
+	Header = "X-API-Key"
+	// QueryParam is the query parameter containing the API key of a request
+	// which does not send the Header.
+	QueryParam = "api_key"
+
+	keyLength = 32
+)
+
+// FromRequest returns the API key sent with r or an empty string if r has
+// no API key.
+func FromRequest(r *http.Request) string {
+	if key := r.Header.Get(Header); key != "" {
+		return key
+	}
+	return r.URL.Query().Get(QueryParam)
+}
+
+// Hash returns the hex encoded SHA-256 hash of key, under which it is stored.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Quota returns the rate quota of an API key.
+func Quota(apiKey history.APIKey) throttled.RateQuota {
+	return throttled.RateQuota{
+		MaxRate:  throttled.PerHour(int(apiKey.PerHourRateLimit)),
+		MaxBurst: int(apiKey.MaxBurst),
+	}
+}
+
+// generate returns a new random API key.
+func generate() (string, error) {
+	b := make([]byte, keyLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
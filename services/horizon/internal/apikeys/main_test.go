@@ -0,0 +1,145 @@
+package apikeys
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/throttled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/ledgers", nil)
+	assert.Equal(t, "", FromRequest(r))
+
+	r = httptest.NewRequest("GET", "/ledgers?api_key=query", nil)
+	assert.Equal(t, "query", FromRequest(r))
+
+	r.Header.Set(Header, "header")
+	assert.Equal(t, "header", FromRequest(r))
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", Hash("foo"))
+}
+
+func TestGenerate(t *testing.T) {
+	key, err := generate()
+	require.NoError(t, err)
+	assert.Len(t, key, 2*keyLength)
+
+	other, err := generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestQuota(t *testing.T) {
+	assert.Equal(t, throttled.RateQuota{
+		MaxRate:  throttled.PerHour(3600),
+		MaxBurst: 10,
+	}, Quota(history.APIKey{PerHourRateLimit: 3600, MaxBurst: 10}))
+}
+
+func TestQuotas(t *testing.T) {
+	quota := throttled.RateQuota{MaxRate: throttled.PerHour(3600), MaxBurst: 10}
+	loads := map[string]int{}
+	var loadErr error
+	q := newQuotas(func(keyHash string) (throttled.RateQuota, error) {
+		loads[keyHash]++
+		if loadErr != nil {
+			return throttled.RateQuota{}, loadErr
+		}
+		if keyHash == "known" {
+			return quota, nil
+		}
+		return throttled.RateQuota{}, ErrUnknownKey
+	})
+	now := time.Now()
+	q.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		got, err := q.Get("known")
+		assert.NoError(t, err)
+		assert.Equal(t, quota, got)
+
+		_, err = q.Get("unknown")
+		assert.Equal(t, ErrUnknownKey, err)
+	}
+	assert.Equal(t, map[string]int{"known": 1, "unknown": 1}, loads)
+
+	// DB errors are not cached
+	loadErr = errors.New("db error")
+	_, err := q.Get("other")
+	assert.EqualError(t, err, "db error")
+	_, err = q.Get("other")
+	assert.EqualError(t, err, "db error")
+	assert.Equal(t, 2, loads["other"])
+
+	_, found, _ := q.Cached("other")
+	assert.False(t, found)
+	got, found, err := q.Cached("known")
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, quota, got)
+	_, found, err = q.Cached("unknown")
+	assert.True(t, found)
+	assert.Equal(t, ErrUnknownKey, err)
+
+	// expired quotas are loaded again
+	loadErr = nil
+	now = now.Add(CacheTTL)
+	_, found, _ = q.Cached("known")
+	assert.False(t, found)
+	_, err = q.Get("known")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, loads["known"])
+}
+
+func TestQuotasUnknownKeysDoNotEvictQuotas(t *testing.T) {
+	quota := throttled.RateQuota{MaxRate: throttled.PerHour(3600), MaxBurst: 10}
+	q := newQuotas(func(keyHash string) (throttled.RateQuota, error) {
+		if keyHash == "known" {
+			return quota, nil
+		}
+		return throttled.RateQuota{}, ErrUnknownKey
+	})
+	_, err := q.Get("known")
+	require.NoError(t, err)
+
+	for i := 0; i < maxCachedUnknownKeys+10; i++ {
+		_, err = q.Get(fmt.Sprintf("unknown-%d", i))
+		assert.Equal(t, ErrUnknownKey, err)
+	}
+	assert.Len(t, q.unknown.entries, maxCachedUnknownKeys)
+
+	got, found, err := q.Cached("known")
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, quota, got)
+}
+
+func TestAPIKeyRequest(t *testing.T) {
+	require.NoError(t, apiKeyRequest{Name: "partner", PerHourRateLimit: 36000}.validate())
+
+	for _, testCase := range []struct {
+		field   string
+		request apiKeyRequest
+	}{
+		{"name", apiKeyRequest{PerHourRateLimit: 36000}},
+		{"per_hour_rate_limit", apiKeyRequest{Name: "partner"}},
+		{"per_hour_rate_limit", apiKeyRequest{Name: "partner", PerHourRateLimit: -1}},
+		{"max_burst", apiKeyRequest{Name: "partner", PerHourRateLimit: 36000, MaxBurst: -1}},
+	} {
+		err := testCase.request.validate()
+		require.Error(t, err)
+		p, ok := err.(*problem.P)
+		require.True(t, ok)
+		assert.Equal(t, testCase.field, p.Extras["invalid_field"])
+	}
+}
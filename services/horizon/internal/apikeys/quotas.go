@@ -0,0 +1,136 @@
+package apikeys
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/throttled"
+)
+
+const (
+	// CacheTTL is the duration for which the quota of an API key is cached.
+	// Updated quotas and deleted API keys take effect on every instance
+	// within CacheTTL.
+	CacheTTL = time.Minute
+
+	maxCachedQuotas      = 50000
+	maxCachedUnknownKeys = 10000
+)
+
+// ErrUnknownKey is returned by Quotas for API keys which do not exist.
+var ErrUnknownKey = errors.New("unknown API key")
+
+// Quotas loads the quotas of API keys from the Horizon DB. Quotas, and the
+// absence of one for unknown keys, are cached for CacheTTL so that requests
+// do not hit the DB. Unknown keys are cached separately so that requests with
+// random keys can't evict the quotas of existing keys.
+type Quotas struct {
+	load    func(keyHash string) (throttled.RateQuota, error)
+	now     func() time.Time
+	mutex   sync.Mutex
+	known   quotaCache
+	unknown quotaCache
+}
+
+type cachedQuota struct {
+	quota     throttled.RateQuota
+	expiresAt time.Time
+}
+
+// quotaCache is a map of cached quotas holding at most size entries.
+type quotaCache struct {
+	size    int
+	entries map[string]cachedQuota
+}
+
+func newQuotaCache(size int) quotaCache {
+	return quotaCache{size: size, entries: map[string]cachedQuota{}}
+}
+
+func (c quotaCache) get(keyHash string, now time.Time) (throttled.RateQuota, bool) {
+	cached, ok := c.entries[keyHash]
+	if !ok || !now.Before(cached.expiresAt) {
+		return throttled.RateQuota{}, false
+	}
+	return cached.quota, true
+}
+
+func (c quotaCache) add(keyHash string, quota throttled.RateQuota, now time.Time) {
+	if _, ok := c.entries[keyHash]; !ok && len(c.entries) >= c.size {
+		// evict a single entry, the iteration order of maps is random
+		for evicted := range c.entries {
+			delete(c.entries, evicted)
+			break
+		}
+	}
+	c.entries[keyHash] = cachedQuota{quota: quota, expiresAt: now.Add(CacheTTL)}
+}
+
+// NewQuotas returns the Quotas of the API keys stored in the Horizon DB.
+func NewQuotas(session *db.Session) *Quotas {
+	q := &history.Q{Session: session}
+	return newQuotas(func(keyHash string) (throttled.RateQuota, error) {
+		apiKey, err := q.GetAPIKeyByHash(keyHash)
+		if q.NoRows(err) {
+			return throttled.RateQuota{}, ErrUnknownKey
+		}
+		if err != nil {
+			return throttled.RateQuota{}, errors.Wrap(err, "could not load API key")
+		}
+		return Quota(apiKey), nil
+	})
+}
+
+func newQuotas(load func(keyHash string) (throttled.RateQuota, error)) *Quotas {
+	return &Quotas{
+		load:    load,
+		now:     time.Now,
+		known:   newQuotaCache(maxCachedQuotas),
+		unknown: newQuotaCache(maxCachedUnknownKeys),
+	}
+}
+
+// Cached returns the cached quota of the API key with the given hash, or
+// ErrUnknownKey if the key is cached as unknown. found is false if the key
+// is not cached, in which case Get has to query the DB.
+func (q *Quotas) Cached(keyHash string) (quota throttled.RateQuota, found bool, err error) {
+	now := q.now()
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if quota, ok := q.known.get(keyHash, now); ok {
+		return quota, true, nil
+	}
+	if _, ok := q.unknown.get(keyHash, now); ok {
+		return throttled.RateQuota{}, true, ErrUnknownKey
+	}
+	return throttled.RateQuota{}, false, nil
+}
+
+// Get returns the quota of the API key with the given hash or ErrUnknownKey
+// if there is no such key.
+func (q *Quotas) Get(keyHash string) (throttled.RateQuota, error) {
+	if quota, found, err := q.Cached(keyHash); found {
+		return quota, err
+	}
+
+	// the DB is queried without holding the lock so that a slow query does
+	// not hold back requests with cached quotas
+	quota, err := q.load(keyHash)
+	if err != nil && err != ErrUnknownKey {
+		return quota, err
+	}
+
+	now := q.now()
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err == ErrUnknownKey {
+		q.unknown.add(keyHash, quota, now)
+	} else {
+		q.known.add(keyHash, quota, now)
+	}
+	return quota, err
+}
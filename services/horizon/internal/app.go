@@ -24,6 +24,7 @@ import (
 	"github.com/stellar/go/services/horizon/internal/paths"
 	"github.com/stellar/go/services/horizon/internal/reap"
 	"github.com/stellar/go/services/horizon/internal/txsub"
+	"github.com/stellar/go/services/horizon/internal/webhooks"
 	"github.com/stellar/go/support/app"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
@@ -63,6 +64,7 @@ type App struct {
 	submitter       *txsub.System
 	paths           paths.Finder
 	expingester     expingest.System
+	webhookWorker   *webhooks.Worker
 	reaper          *reap.System
 	ticks           *time.Ticker
 
@@ -124,6 +126,14 @@ func (a *App) Serve() {
 		}()
 	}
 
+	if a.webhookWorker != nil {
+		wg.Add(1)
+		go func() {
+			a.webhookWorker.Run(a.ctx)
+			wg.Done()
+		}()
+	}
+
 	// configure shutdown signal handler
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
@@ -425,6 +435,8 @@ func (a *App) init() error {
 	if a.config.Ingest {
 		// expingester
 		initExpIngester(a)
+		// webhooks are delivered by the ingesting instances
+		a.webhookWorker = webhooks.NewWorker(a.HorizonSession(a.ctx))
 	}
 	initPathFinder(a)
 
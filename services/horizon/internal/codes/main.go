@@ -69,6 +69,8 @@ func String(code interface{}) (string, error) {
 			return "tx_bad_auth_extra", nil
 		case xdr.TransactionResultCodeTxInternalError:
 			return "tx_internal_error", nil
+		case xdr.TransactionResultCodeTxBadSponsorship:
+			return "tx_bad_sponsorship", nil
 		}
 	case xdr.OperationResultCode:
 		switch code {
@@ -84,6 +86,8 @@ func String(code interface{}) (string, error) {
 			return "op_too_many_subentries", nil
 		case xdr.OperationResultCodeOpExceededWorkLimit:
 			return "op_exceeded_work_limit", nil
+		case xdr.OperationResultCodeOpTooManySponsoring:
+			return "op_too_many_sponsoring", nil
 		}
 	case xdr.CreateAccountResultCode:
 		switch code {
@@ -333,6 +337,67 @@ func String(code interface{}) (string, error) {
 		case xdr.PathPaymentStrictSendResultCodePathPaymentStrictSendUnderDestmin:
 			return "op_under_dest_min", nil
 		}
+	case xdr.CreateClaimableBalanceResultCode:
+		switch code {
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess:
+			return OpSuccess, nil
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceMalformed:
+			return OpMalformed, nil
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceLowReserve:
+			return OpLowReserve, nil
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceNoTrust:
+			return "op_no_trust", nil
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceNotAuthorized:
+			return "op_not_authorized", nil
+		case xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceUnderfunded:
+			return OpUnderfunded, nil
+		}
+	case xdr.ClaimClaimableBalanceResultCode:
+		switch code {
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceSuccess:
+			return OpSuccess, nil
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceDoesNotExist:
+			return "op_does_not_exist", nil
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceCannotClaim:
+			return "op_cannot_claim", nil
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceLineFull:
+			return OpLineFull, nil
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceNoTrust:
+			return "op_no_trust", nil
+		case xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceNotAuthorized:
+			return "op_not_authorized", nil
+		}
+	case xdr.BeginSponsoringFutureReservesResultCode:
+		switch code {
+		case xdr.BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesSuccess:
+			return OpSuccess, nil
+		case xdr.BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesMalformed:
+			return OpMalformed, nil
+		case xdr.BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesAlreadySponsored:
+			return "op_already_sponsored", nil
+		case xdr.BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesRecursive:
+			return "op_recursive", nil
+		}
+	case xdr.EndSponsoringFutureReservesResultCode:
+		switch code {
+		case xdr.EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesSuccess:
+			return OpSuccess, nil
+		case xdr.EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesNotSponsored:
+			return "op_not_sponsored", nil
+		}
+	case xdr.RevokeSponsorshipResultCode:
+		switch code {
+		case xdr.RevokeSponsorshipResultCodeRevokeSponsorshipSuccess:
+			return OpSuccess, nil
+		case xdr.RevokeSponsorshipResultCodeRevokeSponsorshipDoesNotExist:
+			return "op_does_not_exist", nil
+		case xdr.RevokeSponsorshipResultCodeRevokeSponsorshipNotSponsor:
+			return "op_not_sponsor", nil
+		case xdr.RevokeSponsorshipResultCodeRevokeSponsorshipLowReserve:
+			return OpLowReserve, nil
+		case xdr.RevokeSponsorshipResultCodeRevokeSponsorshipOnlyTransferable:
+			return "op_only_transferable", nil
+		}
 	}
 
 	return "", errors.New(ErrUnknownCode)
@@ -377,6 +442,16 @@ func ForOperationResult(opr xdr.OperationResult) (string, error) {
 		ic = ir.MustBumpSeqResult().Code
 	case xdr.OperationTypePathPaymentStrictSend:
 		ic = ir.MustPathPaymentStrictSendResult().Code
+	case xdr.OperationTypeCreateClaimableBalance:
+		ic = ir.MustCreateClaimableBalanceResult().Code
+	case xdr.OperationTypeClaimClaimableBalance:
+		ic = ir.MustClaimClaimableBalanceResult().Code
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		ic = ir.MustBeginSponsoringFutureReservesResult().Code
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		ic = ir.MustEndSponsoringFutureReservesResult().Code
+	case xdr.OperationTypeRevokeSponsorship:
+		ic = ir.MustRevokeSponsorshipResult().Code
 	}
 
 	return String(ic)
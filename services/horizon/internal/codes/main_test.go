@@ -17,6 +17,9 @@ func TestString(t *testing.T) {
 		{xdr.OperationResultCodeOpBadAuth, "op_bad_auth", nil},
 		{xdr.CreateAccountResultCodeCreateAccountLowReserve, "op_low_reserve", nil},
 		{xdr.PaymentResultCodePaymentSrcNoTrust, "op_src_no_trust", nil},
+		{xdr.TransactionResultCodeTxBadSponsorship, "tx_bad_sponsorship", nil},
+		{xdr.ClaimClaimableBalanceResultCodeClaimClaimableBalanceCannotClaim, "op_cannot_claim", nil},
+		{xdr.RevokeSponsorshipResultCodeRevokeSponsorshipNotSponsor, "op_not_sponsor", nil},
 		{0, "", ErrUnknownCode},
 	}
 
@@ -84,7 +84,8 @@ func dataEntryToLedgerKeyString(data xdr.DataEntry) (string, error) {
 
 // InsertAccountData creates a row in the accounts_data table.
 // Returns number of rows affected and error.
-func (q *Q) InsertAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) (int64, error) {
+func (q *Q) InsertAccountData(entry xdr.LedgerEntry) (int64, error) {
+	data := entry.Data.MustData()
 	// Add lkey only when inserting rows
 	key, err := dataEntryToLedgerKeyString(data)
 	if err != nil {
@@ -92,13 +93,14 @@ func (q *Q) InsertAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32)
 	}
 
 	sql := sq.Insert("accounts_data").
-		Columns("ledger_key", "account_id", "name", "value", "last_modified_ledger").
+		Columns("ledger_key", "account_id", "name", "value", "last_modified_ledger", "sponsor").
 		Values(
 			key,
 			data.AccountId.Address(),
 			data.DataName,
 			AccountDataValue(data.DataValue),
-			lastModifiedLedger,
+			entry.LastModifiedLedgerSeq,
+			ledgerEntrySponsor(entry),
 		)
 
 	result, err := q.Exec(sql)
@@ -111,7 +113,8 @@ func (q *Q) InsertAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32)
 
 // UpdateAccountData updates a row in the accounts_data table.
 // Returns number of rows affected and error.
-func (q *Q) UpdateAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) (int64, error) {
+func (q *Q) UpdateAccountData(entry xdr.LedgerEntry) (int64, error) {
+	data := entry.Data.MustData()
 	key, err := dataEntryToLedgerKeyString(data)
 	if err != nil {
 		return 0, errors.Wrap(err, "Error running dataEntryToLedgerKeyString")
@@ -120,7 +123,8 @@ func (q *Q) UpdateAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32)
 	sql := sq.Update("accounts_data").
 		SetMap(map[string]interface{}{
 			"value":                AccountDataValue(data.DataValue),
-			"last_modified_ledger": lastModifiedLedger,
+			"last_modified_ledger": entry.LastModifiedLedgerSeq,
+			"sponsor":              ledgerEntrySponsor(entry),
 		}).
 		Where(sq.Eq{"ledger_key": key})
 	result, err := q.Exec(sql)
@@ -161,5 +165,6 @@ var selectAccountData = sq.Select(`
 	account_id,
 	name,
 	value,
-	last_modified_ledger
+	last_modified_ledger,
+	sponsor
 `).From("accounts_data")
@@ -2,6 +2,7 @@ package history
 
 import (
 	sq "github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
 	"github.com/lib/pq"
 
 	"github.com/stellar/go/services/horizon/internal/db2"
@@ -52,7 +53,8 @@ func (q *Q) GetAccountsByIDs(ids []string) ([]AccountEntry, error) {
 	return accounts, err
 }
 
-func accountToMap(account xdr.AccountEntry, lastModifiedLedger xdr.Uint32) map[string]interface{} {
+func accountToMap(entry xdr.LedgerEntry) map[string]interface{} {
+	account := entry.Data.MustAccount()
 	var buyingliabilities, sellingliabilities xdr.Int64
 	if account.Ext.V1 != nil {
 		v1 := account.Ext.V1
@@ -79,7 +81,10 @@ func accountToMap(account xdr.AccountEntry, lastModifiedLedger xdr.Uint32) map[s
 		"threshold_low":         account.ThresholdLow(),
 		"threshold_medium":      account.ThresholdMedium(),
 		"threshold_high":        account.ThresholdHigh(),
-		"last_modified_ledger":  lastModifiedLedger,
+		"last_modified_ledger":  entry.LastModifiedLedgerSeq,
+		"sponsor":               ledgerEntrySponsor(entry),
+		"num_sponsored":         account.NumSponsored(),
+		"num_sponsoring":        account.NumSponsoring(),
 	}
 }
 
@@ -92,15 +97,16 @@ func (q *Q) UpsertAccounts(accounts []xdr.LedgerEntry) error {
 	var homeDomain []xdr.String32
 	var balance, buyingLiabilities, sellingLiabilities []xdr.Int64
 	var sequenceNumber []xdr.SequenceNumber
-	var numSubEntries, flags, lastModifiedLedger []xdr.Uint32
+	var numSubEntries, flags, lastModifiedLedger, numSponsored, numSponsoring []xdr.Uint32
 	var masterWeight, thresholdLow, thresholdMedium, thresholdHigh []uint8
+	var sponsor []string
 
 	for _, entry := range accounts {
 		if entry.Data.Type != xdr.LedgerEntryTypeAccount {
 			return errors.Errorf("Invalid entry type: %d", entry.Data.Type)
 		}
 
-		m := accountToMap(entry.Data.MustAccount(), entry.LastModifiedLedgerSeq)
+		m := accountToMap(entry)
 
 		accountID = append(accountID, m["account_id"].(string))
 		balance = append(balance, m["balance"].(xdr.Int64))
@@ -116,25 +122,31 @@ func (q *Q) UpsertAccounts(accounts []xdr.LedgerEntry) error {
 		thresholdMedium = append(thresholdMedium, m["threshold_medium"].(uint8))
 		thresholdHigh = append(thresholdHigh, m["threshold_high"].(uint8))
 		lastModifiedLedger = append(lastModifiedLedger, m["last_modified_ledger"].(xdr.Uint32))
+		sponsor = append(sponsor, m["sponsor"].(null.String).String)
+		numSponsored = append(numSponsored, m["num_sponsored"].(xdr.Uint32))
+		numSponsoring = append(numSponsoring, m["num_sponsoring"].(xdr.Uint32))
 	}
 
 	sql := `
 	WITH r AS
 		(SELECT
-			unnest(?::text[]),   /* account_id */
-			unnest(?::bigint[]), /*	balance */
-			unnest(?::bigint[]), /*	buying_liabilities */
-			unnest(?::bigint[]), /*	selling_liabilities */
-			unnest(?::bigint[]), /*	sequence_number */
-			unnest(?::int[]),    /*	num_subentries */
-			unnest(?::text[]),   /*	inflation_destination */
-			unnest(?::int[]),    /*	flags */
-			unnest(?::text[]),   /*	home_domain */
-			unnest(?::int[]),    /*	master_weight */
-			unnest(?::int[]),    /*	threshold_low */
-			unnest(?::int[]),    /*	threshold_medium */
-			unnest(?::int[]),    /*	threshold_high */
-			unnest(?::int[])     /*	last_modified_ledger */
+			unnest(?::text[]) AS account_id,
+			unnest(?::bigint[]) AS balance,
+			unnest(?::bigint[]) AS buying_liabilities,
+			unnest(?::bigint[]) AS selling_liabilities,
+			unnest(?::bigint[]) AS sequence_number,
+			unnest(?::int[]) AS num_subentries,
+			unnest(?::text[]) AS inflation_destination,
+			unnest(?::int[]) AS flags,
+			unnest(?::text[]) AS home_domain,
+			unnest(?::int[]) AS master_weight,
+			unnest(?::int[]) AS threshold_low,
+			unnest(?::int[]) AS threshold_medium,
+			unnest(?::int[]) AS threshold_high,
+			unnest(?::int[]) AS last_modified_ledger,
+			unnest(?::text[]) AS sponsor,
+			unnest(?::int[]) AS num_sponsored,
+			unnest(?::int[]) AS num_sponsoring
 		)
 	INSERT INTO accounts ( 
 		account_id,
@@ -150,9 +162,30 @@ func (q *Q) UpsertAccounts(accounts []xdr.LedgerEntry) error {
 		threshold_low,
 		threshold_medium,
 		threshold_high,
-		last_modified_ledger
+		last_modified_ledger,
+		sponsor,
+		num_sponsored,
+		num_sponsoring
 	)
-	SELECT * from r 
+	SELECT
+		account_id,
+		balance,
+		buying_liabilities,
+		selling_liabilities,
+		sequence_number,
+		num_subentries,
+		inflation_destination,
+		flags,
+		home_domain,
+		master_weight,
+		threshold_low,
+		threshold_medium,
+		threshold_high,
+		last_modified_ledger,
+		NULLIF(sponsor, ''),
+		num_sponsored,
+		num_sponsoring
+	FROM r
 	ON CONFLICT (account_id) DO UPDATE SET 
 		account_id = excluded.account_id,
 		balance = excluded.balance,
@@ -167,7 +200,10 @@ func (q *Q) UpsertAccounts(accounts []xdr.LedgerEntry) error {
 		threshold_low = excluded.threshold_low,
 		threshold_medium = excluded.threshold_medium,
 		threshold_high = excluded.threshold_high,
-		last_modified_ledger = excluded.last_modified_ledger`
+		last_modified_ledger = excluded.last_modified_ledger,
+		sponsor = excluded.sponsor,
+		num_sponsored = excluded.num_sponsored,
+		num_sponsoring = excluded.num_sponsoring`
 
 	_, err := q.ExecRaw(sql,
 		pq.Array(accountID),
@@ -183,7 +219,10 @@ func (q *Q) UpsertAccounts(accounts []xdr.LedgerEntry) error {
 		pq.Array(thresholdLow),
 		pq.Array(thresholdMedium),
 		pq.Array(thresholdHigh),
-		pq.Array(lastModifiedLedger))
+		pq.Array(lastModifiedLedger),
+		pq.Array(sponsor),
+		pq.Array(numSponsored),
+		pq.Array(numSponsoring))
 	return err
 }
 
@@ -265,5 +304,8 @@ var selectAccounts = sq.Select(`
 	threshold_low,
 	threshold_medium,
 	threshold_high,
-	last_modified_ledger
+	last_modified_ledger,
+	sponsor,
+	num_sponsored,
+	num_sponsoring
 `).From("accounts")
@@ -2,6 +2,7 @@ package history
 
 import (
 	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
 
@@ -10,8 +11,11 @@ type accountsBatchInsertBuilder struct {
 	builder db.BatchInsertBuilder
 }
 
-func (i *accountsBatchInsertBuilder) Add(account xdr.AccountEntry, lastModifiedLedger xdr.Uint32) error {
-	return i.builder.Row(accountToMap(account, lastModifiedLedger))
+func (i *accountsBatchInsertBuilder) Add(entry xdr.LedgerEntry) error {
+	if entry.Data.Type != xdr.LedgerEntryTypeAccount {
+		return errors.Errorf("Invalid entry type: %d", entry.Data.Type)
+	}
+	return i.builder.Row(accountToMap(entry))
 }
 
 func (i *accountsBatchInsertBuilder) Exec() error {
@@ -23,7 +23,7 @@ var (
 		Thresholds:    xdr.Thresholds{1, 2, 3, 4},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  3,
 					Selling: 4,
@@ -43,7 +43,7 @@ var (
 		Thresholds:    xdr.Thresholds{5, 6, 7, 8},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  30,
 					Selling: 40,
@@ -62,7 +62,7 @@ var (
 		Thresholds:    xdr.Thresholds{5, 6, 7, 8},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  30,
 					Selling: 40,
@@ -79,9 +79,27 @@ func TestInsertAccount(t *testing.T) {
 	q := &Q{tt.HorizonSession()}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1235)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+		Ext: xdr.LedgerEntryExt{
+			V: 1,
+			V1: &xdr.LedgerEntryExtensionV1{
+				SponsoringId: xdr.MustAddressPtr("GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A"),
+			},
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
@@ -105,6 +123,9 @@ func TestInsertAccount(t *testing.T) {
 	assert.Equal(t, byte(4), accounts[0].ThresholdHigh)
 	assert.Equal(t, int64(3), accounts[0].BuyingLiabilities)
 	assert.Equal(t, int64(4), accounts[0].SellingLiabilities)
+	assert.False(t, accounts[0].Sponsor.Valid)
+
+	assert.Equal(t, "GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A", accounts[1].Sponsor.String)
 }
 
 func TestUpsertAccount(t *testing.T) {
@@ -175,7 +196,7 @@ func TestUpsertAccount(t *testing.T) {
 		},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  xdr.Int64(accounts[0].BuyingLiabilities),
 					Selling: xdr.Int64(accounts[0].SellingLiabilities),
@@ -212,7 +233,7 @@ func TestUpsertAccount(t *testing.T) {
 		},
 		Ext: xdr.AccountEntryExt{
 			V: 1,
-			V1: &xdr.AccountEntryV1{
+			V1: &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  xdr.Int64(accounts[0].BuyingLiabilities),
 					Selling: xdr.Int64(accounts[0].SellingLiabilities),
@@ -234,7 +255,13 @@ func TestRemoveAccount(t *testing.T) {
 	q := &Q{tt.HorizonSession()}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
@@ -263,15 +290,39 @@ func TestAccountsForAsset(t *testing.T) {
 	usdTrustLine.AccountId = account2.AccountId
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1235)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
-	_, err = q.InsertTrustLine(eurTrustLine, 1234)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
-	_, err = q.InsertTrustLine(usdTrustLine, 1235)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
 
 	pq := db2.PageQuery{
@@ -316,28 +367,58 @@ func TestAccountEntriesForSigner(t *testing.T) {
 	usdTrustLine.AccountId = account2.AccountId
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1235)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account3, 1235)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account3,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
-	_, err = q.InsertTrustLine(eurTrustLine, 1234)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
-	_, err = q.InsertTrustLine(usdTrustLine, 1235)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
 
-	_, err = q.CreateAccountSigner(account1.AccountId.Address(), account1.AccountId.Address(), 1)
+	_, err = q.CreateAccountSigner(account1.AccountId.Address(), account1.AccountId.Address(), 1, nil)
 	tt.Assert.NoError(err)
-	_, err = q.CreateAccountSigner(account2.AccountId.Address(), account2.AccountId.Address(), 1)
+	_, err = q.CreateAccountSigner(account2.AccountId.Address(), account2.AccountId.Address(), 1, nil)
 	tt.Assert.NoError(err)
-	_, err = q.CreateAccountSigner(account3.AccountId.Address(), account3.AccountId.Address(), 1)
+	_, err = q.CreateAccountSigner(account3.AccountId.Address(), account3.AccountId.Address(), 1, nil)
 	tt.Assert.NoError(err)
-	_, err = q.CreateAccountSigner(account1.AccountId.Address(), account3.AccountId.Address(), 1)
+	_, err = q.CreateAccountSigner(account1.AccountId.Address(), account3.AccountId.Address(), 1, nil)
 	tt.Assert.NoError(err)
-	_, err = q.CreateAccountSigner(account2.AccountId.Address(), account3.AccountId.Address(), 1)
+	_, err = q.CreateAccountSigner(account2.AccountId.Address(), account3.AccountId.Address(), 1, nil)
 	tt.Assert.NoError(err)
 
 	pq := db2.PageQuery{
@@ -401,7 +482,13 @@ func TestGetAccountByID(t *testing.T) {
 	q := &Q{tt.HorizonSession()}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
@@ -0,0 +1,69 @@
+package history
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+var selectAPIKeys = sq.Select(
+	"id, name, key_hash, per_hour_rate_limit, max_burst, created_at",
+).From("api_keys")
+
+// InsertAPIKey creates an API key and returns its id.
+func (q *Q) InsertAPIKey(apiKey APIKey) (int64, error) {
+	sql := sq.Insert("api_keys").SetMap(map[string]interface{}{
+		"name":                apiKey.Name,
+		"key_hash":            apiKey.KeyHash,
+		"per_hour_rate_limit": apiKey.PerHourRateLimit,
+		"max_burst":           apiKey.MaxBurst,
+		"created_at":          apiKey.CreatedAt,
+	}).Suffix("RETURNING id")
+
+	var id int64
+	err := q.Get(&id, sql)
+	return id, err
+}
+
+// GetAPIKeys loads all the API keys ordered by id.
+func (q *Q) GetAPIKeys() ([]APIKey, error) {
+	var apiKeys []APIKey
+	err := q.Select(&apiKeys, selectAPIKeys.OrderBy("id asc"))
+	return apiKeys, err
+}
+
+// GetAPIKeyByID loads an API key by its id.
+func (q *Q) GetAPIKeyByID(id int64) (APIKey, error) {
+	var apiKey APIKey
+	err := q.Get(&apiKey, selectAPIKeys.Where("id = ?", id))
+	return apiKey, err
+}
+
+// GetAPIKeyByHash loads an API key by the hash of the key.
+func (q *Q) GetAPIKeyByHash(keyHash string) (APIKey, error) {
+	var apiKey APIKey
+	err := q.Get(&apiKey, selectAPIKeys.Where("key_hash = ?", keyHash))
+	return apiKey, err
+}
+
+// UpdateAPIKey updates the name and the quota of an API key. Returns the
+// number of rows affected.
+func (q *Q) UpdateAPIKey(apiKey APIKey) (int64, error) {
+	sql := sq.Update("api_keys").SetMap(map[string]interface{}{
+		"name":                apiKey.Name,
+		"per_hour_rate_limit": apiKey.PerHourRateLimit,
+		"max_burst":           apiKey.MaxBurst,
+	}).Where("id = ?", apiKey.ID)
+	result, err := q.Exec(sql)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// DeleteAPIKey removes an API key. Returns the number of rows affected.
+func (q *Q) DeleteAPIKey(id int64) (int64, error) {
+	result, err := q.Exec(sq.Delete("api_keys").Where("id = ?", id))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,52 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestAPIKeys(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+	q := &Q{tt.HorizonSession()}
+
+	hash := "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	id, err := q.InsertAPIKey(APIKey{
+		Name:             "partner",
+		KeyHash:          hash,
+		PerHourRateLimit: 36000,
+		MaxBurst:         500,
+		CreatedAt:        time.Now().UTC().Truncate(time.Second),
+	})
+	tt.Assert.NoError(err)
+
+	apiKey, err := q.GetAPIKeyByHash(hash)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(id, apiKey.ID)
+	tt.Assert.Equal("partner", apiKey.Name)
+	tt.Assert.Equal(int32(36000), apiKey.PerHourRateLimit)
+	tt.Assert.Equal(int32(500), apiKey.MaxBurst)
+
+	apiKey.PerHourRateLimit = 72000
+	updated, err := q.UpdateAPIKey(apiKey)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(int64(1), updated)
+
+	apiKey, err = q.GetAPIKeyByID(id)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(int32(72000), apiKey.PerHourRateLimit)
+
+	apiKeys, err := q.GetAPIKeys()
+	tt.Assert.NoError(err)
+	tt.Assert.Len(apiKeys, 1)
+
+	deleted, err := q.DeleteAPIKey(id)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(int64(1), deleted)
+
+	_, err = q.GetAPIKeyByHash(hash)
+	tt.Assert.True(q.NoRows(err))
+}
@@ -0,0 +1,243 @@
+package history
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// QClaimableBalances defines claimable balance related queries.
+type QClaimableBalances interface {
+	UpsertClaimableBalances(claimableBalances []xdr.LedgerEntry) error
+	RemoveClaimableBalance(cBalance xdr.ClaimableBalanceEntry) (int64, error)
+	GetClaimableBalancesByID(ids []xdr.ClaimableBalanceId) ([]ClaimableBalance, error)
+	CountClaimableBalances() (int, error)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (c Claimants) Value() (driver.Value, error) {
+	// Convert the nil slice to an empty JSON array.
+	if c == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+func (c *Claimants) Scan(value interface{}) error {
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, c)
+}
+
+// CountClaimableBalances returns the total number of claimable balances in the DB
+func (q *Q) CountClaimableBalances() (int, error) {
+	sql := sq.Select("count(*)").From("claimable_balances")
+
+	var count int
+	if err := q.Get(&count, sql); err != nil {
+		return 0, errors.Wrap(err, "could not run select query")
+	}
+
+	return count, nil
+}
+
+// FindClaimableBalanceByID returns a claimable balance.
+func (q *Q) FindClaimableBalanceByID(balanceID xdr.ClaimableBalanceId) (ClaimableBalance, error) {
+	var cb ClaimableBalance
+	id, err := xdr.MarshalHex(balanceID)
+	if err != nil {
+		return cb, errors.Wrap(err, "cannot marshal balance id")
+	}
+
+	sql := selectClaimableBalances.Limit(1).Where("cb.id = ?", id)
+	err = q.Get(&cb, sql)
+	return cb, err
+}
+
+// GetClaimableBalancesByID finds all claimable balances by ClaimableBalanceId
+func (q *Q) GetClaimableBalancesByID(ids []xdr.ClaimableBalanceId) ([]ClaimableBalance, error) {
+	var cBalances []ClaimableBalance
+	hexIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexID, err := xdr.MarshalHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal balance id")
+		}
+		hexIDs = append(hexIDs, hexID)
+	}
+
+	sql := selectClaimableBalances.Where(map[string]interface{}{"cb.id": hexIDs})
+	err := q.Select(&cBalances, sql)
+	return cBalances, err
+}
+
+// GetClaimableBalances finds all claimable balances matching the filters of
+// the query. Claimable balances are ordered by their id.
+func (q *Q) GetClaimableBalances(query ClaimableBalancesQuery) ([]ClaimableBalance, error) {
+	sql, err := query.PageQuery.ApplyToUsingCursor(selectClaimableBalances, "cb.id", query.PageQuery.Cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply query to page")
+	}
+
+	if query.Asset != nil {
+		asset, err := xdr.MarshalBase64(*query.Asset)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal asset")
+		}
+		sql = sql.Where("cb.asset = ?", asset)
+	}
+
+	if query.Sponsor != nil {
+		sql = sql.Where("cb.sponsor = ?", query.Sponsor.Address())
+	}
+
+	if query.Claimant != nil {
+		destination, err := json.Marshal([]map[string]string{{"destination": query.Claimant.Address()}})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal claimant")
+		}
+		sql = sql.Where("cb.claimants @> ?::jsonb", string(destination))
+	}
+
+	var results []ClaimableBalance
+	if err := q.Select(&results, sql); err != nil {
+		return nil, errors.Wrap(err, "could not run select query")
+	}
+
+	return results, nil
+}
+
+// UpsertClaimableBalances upserts a batch of claimable balances in the
+// claimable_balances table. There's currently no limit of the number of
+// claimable balances this method can accept other than 2GB limit of the query
+// string length what should be enough for each ledger with the current limits.
+func (q *Q) UpsertClaimableBalances(claimableBalances []xdr.LedgerEntry) error {
+	var id, claimants, asset, sponsor []string
+	var amount []xdr.Int64
+	var lastModifiedLedger []xdr.Uint32
+
+	for _, entry := range claimableBalances {
+		if entry.Data.Type != xdr.LedgerEntryTypeClaimableBalance {
+			return errors.Errorf("Invalid entry type: %d", entry.Data.Type)
+		}
+
+		cBalance := entry.Data.MustClaimableBalance()
+		hexID, err := xdr.MarshalHex(cBalance.BalanceId)
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal balance id")
+		}
+
+		claimantsJSON, err := json.Marshal(buildClaimants(cBalance.Claimants))
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal claimants")
+		}
+
+		assetBase64, err := xdr.MarshalBase64(cBalance.Asset)
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal asset")
+		}
+
+		var sponsorAddress string
+		if sponsoringID := entry.SponsoringID(); sponsoringID != nil {
+			sponsorAddress = sponsoringID.Address()
+		}
+
+		id = append(id, hexID)
+		claimants = append(claimants, string(claimantsJSON))
+		asset = append(asset, assetBase64)
+		amount = append(amount, cBalance.Amount)
+		sponsor = append(sponsor, sponsorAddress)
+		lastModifiedLedger = append(lastModifiedLedger, entry.LastModifiedLedgerSeq)
+	}
+
+	sql := `
+	WITH r AS
+		(SELECT
+			unnest(?::text[]) AS id,
+			unnest(?::jsonb[]) AS claimants,
+			unnest(?::text[]) AS asset,
+			unnest(?::bigint[]) AS amount,
+			unnest(?::text[]) AS sponsor,
+			unnest(?::int[]) AS last_modified_ledger
+		)
+	INSERT INTO claimable_balances (
+		id,
+		claimants,
+		asset,
+		amount,
+		sponsor,
+		last_modified_ledger
+	)
+	SELECT id, claimants, asset, amount, NULLIF(sponsor, ''), last_modified_ledger FROM r
+	ON CONFLICT (id) DO UPDATE SET
+		claimants = excluded.claimants,
+		asset = excluded.asset,
+		amount = excluded.amount,
+		sponsor = excluded.sponsor,
+		last_modified_ledger = excluded.last_modified_ledger`
+
+	_, err := q.ExecRaw(sql,
+		pq.Array(id),
+		pq.Array(claimants),
+		pq.Array(asset),
+		pq.Array(amount),
+		pq.Array(sponsor),
+		pq.Array(lastModifiedLedger))
+	return err
+}
+
+// RemoveClaimableBalance deletes a row in the claimable_balances table.
+// Returns number of rows affected and error.
+func (q *Q) RemoveClaimableBalance(cBalance xdr.ClaimableBalanceEntry) (int64, error) {
+	id, err := xdr.MarshalHex(cBalance.BalanceId)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot marshal balance id")
+	}
+
+	sql := sq.Delete("claimable_balances").
+		Where(sq.Eq{"id": id})
+	result, err := q.Exec(sql)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func buildClaimants(claimants []xdr.Claimant) Claimants {
+	hClaimants := Claimants{}
+	for _, c := range claimants {
+		xc := c.MustV0()
+		hClaimants = append(hClaimants, Claimant{
+			Destination: xc.Destination.Address(),
+			Predicate:   xc.Predicate,
+		})
+	}
+	return hClaimants
+}
+
+var selectClaimableBalances = sq.Select(`
+	cb.id,
+	cb.claimants,
+	cb.asset,
+	cb.amount,
+	cb.sponsor,
+	cb.last_modified_ledger
+`).From("claimable_balances cb")
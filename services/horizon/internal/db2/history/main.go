@@ -114,6 +114,77 @@ const (
 	// EffectSequenceBumped occurs when an account bumps their sequence number
 	EffectSequenceBumped EffectType = 43 // from bump_sequence
 
+	// claimable balance effects
+
+	// EffectClaimableBalanceCreated occurs when a claimable balance is created
+	EffectClaimableBalanceCreated EffectType = 50 // from create_claimable_balance
+
+	// EffectClaimableBalanceClaimantCreated occurs when a claimable balance
+	// claimant is created
+	EffectClaimableBalanceClaimantCreated EffectType = 51 // from create_claimable_balance
+
+	// EffectClaimableBalanceClaimed occurs when a claimable balance is claimed
+	EffectClaimableBalanceClaimed EffectType = 52 // from claim_claimable_balance
+
+	// sponsorship effects
+
+	// EffectAccountSponsorshipCreated occurs when an account ledger entry is
+	// sponsored
+	EffectAccountSponsorshipCreated EffectType = 60 // from create_account
+
+	// EffectAccountSponsorshipUpdated occurs when an account ledger entry changes
+	// its sponsor
+	EffectAccountSponsorshipUpdated EffectType = 61 // from revoke_sponsorship
+
+	// EffectAccountSponsorshipRemoved occurs when an account ledger entry stops
+	// being sponsored
+	EffectAccountSponsorshipRemoved EffectType = 62 // from revoke_sponsorship
+
+	// EffectTrustlineSponsorshipCreated occurs when a trustline ledger entry is
+	// sponsored
+	EffectTrustlineSponsorshipCreated EffectType = 63 // from change_trust
+
+	// EffectTrustlineSponsorshipUpdated occurs when a trustline ledger entry
+	// changes its sponsor
+	EffectTrustlineSponsorshipUpdated EffectType = 64 // from revoke_sponsorship
+
+	// EffectTrustlineSponsorshipRemoved occurs when a trustline ledger entry stops
+	// being sponsored
+	EffectTrustlineSponsorshipRemoved EffectType = 65 // from revoke_sponsorship
+
+	// EffectDataSponsorshipCreated occurs when a data ledger entry is sponsored
+	EffectDataSponsorshipCreated EffectType = 66 // from manage_data
+
+	// EffectDataSponsorshipUpdated occurs when a data ledger entry changes its
+	// sponsor
+	EffectDataSponsorshipUpdated EffectType = 67 // from revoke_sponsorship
+
+	// EffectDataSponsorshipRemoved occurs when a data ledger entry stops being
+	// sponsored
+	EffectDataSponsorshipRemoved EffectType = 68 // from revoke_sponsorship
+
+	// EffectClaimableBalanceSponsorshipCreated occurs when a claimable balance
+	// ledger entry is sponsored
+	EffectClaimableBalanceSponsorshipCreated EffectType = 69 // from create_claimable_balance
+
+	// EffectClaimableBalanceSponsorshipUpdated occurs when a claimable balance
+	// ledger entry changes its sponsor
+	EffectClaimableBalanceSponsorshipUpdated EffectType = 70 // from revoke_sponsorship
+
+	// EffectClaimableBalanceSponsorshipRemoved occurs when a claimable balance
+	// ledger entry stops being sponsored
+	EffectClaimableBalanceSponsorshipRemoved EffectType = 71 // from revoke_sponsorship
+
+	// EffectSignerSponsorshipCreated occurs when an account signer is sponsored
+	EffectSignerSponsorshipCreated EffectType = 72 // from set_options
+
+	// EffectSignerSponsorshipUpdated occurs when an account signer changes its
+	// sponsor
+	EffectSignerSponsorshipUpdated EffectType = 73 // from revoke_sponsorship
+
+	// EffectSignerSponsorshipRemoved occurs when an account signer stops being
+	// sponsored
+	EffectSignerSponsorshipRemoved EffectType = 74 // from revoke_sponsorship
 )
 
 // Account is a row of data from the `history_accounts` table
@@ -124,30 +195,34 @@ type Account struct {
 
 // AccountEntry is a row of data from the `account` table
 type AccountEntry struct {
-	AccountID            string `db:"account_id"`
-	Balance              int64  `db:"balance"`
-	BuyingLiabilities    int64  `db:"buying_liabilities"`
-	SellingLiabilities   int64  `db:"selling_liabilities"`
-	SequenceNumber       int64  `db:"sequence_number"`
-	NumSubEntries        uint32 `db:"num_subentries"`
-	InflationDestination string `db:"inflation_destination"`
-	HomeDomain           string `db:"home_domain"`
-	Flags                uint32 `db:"flags"`
-	MasterWeight         byte   `db:"master_weight"`
-	ThresholdLow         byte   `db:"threshold_low"`
-	ThresholdMedium      byte   `db:"threshold_medium"`
-	ThresholdHigh        byte   `db:"threshold_high"`
-	LastModifiedLedger   uint32 `db:"last_modified_ledger"`
+	AccountID            string      `db:"account_id"`
+	Balance              int64       `db:"balance"`
+	BuyingLiabilities    int64       `db:"buying_liabilities"`
+	SellingLiabilities   int64       `db:"selling_liabilities"`
+	SequenceNumber       int64       `db:"sequence_number"`
+	NumSubEntries        uint32      `db:"num_subentries"`
+	InflationDestination string      `db:"inflation_destination"`
+	HomeDomain           string      `db:"home_domain"`
+	Flags                uint32      `db:"flags"`
+	MasterWeight         byte        `db:"master_weight"`
+	ThresholdLow         byte        `db:"threshold_low"`
+	ThresholdMedium      byte        `db:"threshold_medium"`
+	ThresholdHigh        byte        `db:"threshold_high"`
+	LastModifiedLedger   uint32      `db:"last_modified_ledger"`
+	Sponsor              null.String `db:"sponsor"`
+	NumSponsored         uint32      `db:"num_sponsored"`
+	NumSponsoring        uint32      `db:"num_sponsoring"`
 }
 
 type AccountsBatchInsertBuilder interface {
-	Add(account xdr.AccountEntry, lastModifiedLedger xdr.Uint32) error
+	Add(entry xdr.LedgerEntry) error
 	Exec() error
 }
 
 type IngestionQ interface {
 	QAccounts
 	QAssetStats
+	QClaimableBalances
 	QData
 	QEffects
 	QLedgers
@@ -191,9 +266,10 @@ type QAccounts interface {
 
 // AccountSigner is a row of data from the `accounts_signers` table
 type AccountSigner struct {
-	Account string `db:"account_id"`
-	Signer  string `db:"signer"`
-	Weight  int32  `db:"weight"`
+	Account string      `db:"account_id"`
+	Signer  string      `db:"signer"`
+	Weight  int32       `db:"weight"`
+	Sponsor null.String `db:"sponsor"`
 }
 
 type AccountSignersBatchInsertBuilder interface {
@@ -212,12 +288,13 @@ type Data struct {
 	Name               string           `db:"name"`
 	Value              AccountDataValue `db:"value"`
 	LastModifiedLedger uint32           `db:"last_modified_ledger"`
+	Sponsor            null.String      `db:"sponsor"`
 }
 
 type AccountDataValue []byte
 
 type AccountDataBatchInsertBuilder interface {
-	Add(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) error
+	Add(entry xdr.LedgerEntry) error
 	Exec() error
 }
 
@@ -231,8 +308,8 @@ type QData interface {
 	NewAccountDataBatchInsertBuilder(maxBatchSize int) AccountDataBatchInsertBuilder
 	CountAccountsData() (int, error)
 	GetAccountDataByKeys(keys []xdr.LedgerKeyData) ([]Data, error)
-	InsertAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) (int64, error)
-	UpdateAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) (int64, error)
+	InsertAccountData(entry xdr.LedgerEntry) (int64, error)
+	UpdateAccountData(entry xdr.LedgerEntry) (int64, error)
 	RemoveAccountData(key xdr.LedgerKeyData) (int64, error)
 }
 
@@ -426,6 +503,36 @@ type ManageOffer struct {
 	OfferID int64 `json:"offer_id"`
 }
 
+// ClaimableBalance is a row of data from the `claimable_balances` table. The
+// BalanceID is the hex encoded XDR of the xdr.ClaimableBalanceId.
+type ClaimableBalance struct {
+	BalanceID          string      `db:"id"`
+	Claimants          Claimants   `db:"claimants"`
+	Asset              xdr.Asset   `db:"asset"`
+	Amount             xdr.Int64   `db:"amount"`
+	Sponsor            null.String `db:"sponsor"`
+	LastModifiedLedger uint32      `db:"last_modified_ledger"`
+}
+
+// Claimant is a destination of a claimable balance and the predicate which
+// must be satisfied for the destination to claim it.
+type Claimant struct {
+	Destination string             `json:"destination"`
+	Predicate   xdr.ClaimPredicate `json:"predicate"`
+}
+
+// Claimants is the list of claimants of a claimable balance, stored as JSON.
+type Claimants []Claimant
+
+// ClaimableBalancesQuery is a helper struct to configure queries to claimable
+// balances. Asset, Sponsor and Claimant are optional filters.
+type ClaimableBalancesQuery struct {
+	PageQuery db2.PageQuery
+	Asset     *xdr.Asset
+	Sponsor   *xdr.AccountId
+	Claimant  *xdr.AccountId
+}
+
 // Offer is row of data from the `offers` table from horizon DB
 type Offer struct {
 	SellerID string    `db:"seller_id"`
@@ -434,17 +541,18 @@ type Offer struct {
 	SellingAsset xdr.Asset `db:"selling_asset"`
 	BuyingAsset  xdr.Asset `db:"buying_asset"`
 
-	Amount             xdr.Int64 `db:"amount"`
-	Pricen             int32     `db:"pricen"`
-	Priced             int32     `db:"priced"`
-	Price              float64   `db:"price"`
-	Flags              uint32    `db:"flags"`
-	Deleted            bool      `db:"deleted"`
-	LastModifiedLedger uint32    `db:"last_modified_ledger"`
+	Amount             xdr.Int64   `db:"amount"`
+	Pricen             int32       `db:"pricen"`
+	Priced             int32       `db:"priced"`
+	Price              float64     `db:"price"`
+	Flags              uint32      `db:"flags"`
+	Deleted            bool        `db:"deleted"`
+	LastModifiedLedger uint32      `db:"last_modified_ledger"`
+	Sponsor            null.String `db:"sponsor"`
 }
 
 type OffersBatchInsertBuilder interface {
-	Add(offer xdr.OfferEntry, lastModifiedLedger xdr.Uint32) error
+	Add(entry xdr.LedgerEntry) error
 	Exec() error
 }
 
@@ -477,7 +585,7 @@ type QSigners interface {
 	UpdateLastLedgerExpIngest(ledgerSequence uint32) error
 	AccountsForSigner(signer string, page db2.PageQuery) ([]AccountSigner, error)
 	NewAccountSignersBatchInsertBuilder(maxBatchSize int) AccountSignersBatchInsertBuilder
-	CreateAccountSigner(account, signer string, weight int32) (int64, error)
+	CreateAccountSigner(account, signer string, weight int32, sponsor *string) (int64, error)
 	RemoveAccountSigner(account, signer string) (int64, error)
 	SignersForAccounts(accounts []string) ([]AccountSigner, error)
 	CountAccounts() (int, error)
@@ -566,20 +674,21 @@ type TrustLine struct {
 	SellingLiabilities int64         `db:"selling_liabilities"`
 	Flags              uint32        `db:"flags"`
 	LastModifiedLedger uint32        `db:"last_modified_ledger"`
+	Sponsor            null.String   `db:"sponsor"`
 }
 
 // QTrustLines defines trust lines related queries.
 type QTrustLines interface {
 	NewTrustLinesBatchInsertBuilder(maxBatchSize int) TrustLinesBatchInsertBuilder
 	GetTrustLinesByKeys(keys []xdr.LedgerKeyTrustLine) ([]TrustLine, error)
-	InsertTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) (int64, error)
-	UpdateTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) (int64, error)
+	InsertTrustLine(entry xdr.LedgerEntry) (int64, error)
+	UpdateTrustLine(entry xdr.LedgerEntry) (int64, error)
 	UpsertTrustLines(trustLines []xdr.LedgerEntry) error
 	RemoveTrustLine(key xdr.LedgerKeyTrustLine) (int64, error)
 }
 
 type TrustLinesBatchInsertBuilder interface {
-	Add(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) error
+	Add(entry xdr.LedgerEntry) error
 	Exec() error
 }
 
@@ -588,6 +697,59 @@ type trustLinesBatchInsertBuilder struct {
 	builder db.BatchInsertBuilder
 }
 
+// Webhook is a row of data from the `webhooks` table. The optional filters
+// select the operations and effects delivered to the webhook: AccountID for
+// the operations and effects of an account, OperationType for the operations
+// of a type and their effects, and Asset for the ones involving an asset in
+// canonical form (`native` or `CODE:ISSUER`).
+type Webhook struct {
+	ID            int64       `db:"id"`
+	URL           string      `db:"url"`
+	Secret        string      `db:"secret"`
+	AccountID     null.String `db:"account_id"`
+	OperationType null.Int    `db:"operation_type"`
+	Asset         null.String `db:"asset"`
+	CreatedAt     time.Time   `db:"created_at"`
+}
+
+// APIKey is a row of data from the `api_keys` table. Only the hex encoded
+// SHA-256 hash of the key is stored, requests sending the key are rate limited
+// with PerHourRateLimit and MaxBurst instead of the quota of their IP address.
+type APIKey struct {
+	ID               int64     `db:"id"`
+	Name             string    `db:"name"`
+	KeyHash          string    `db:"key_hash"`
+	PerHourRateLimit int32     `db:"per_hour_rate_limit"`
+	MaxBurst         int32     `db:"max_burst"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+// WebhookDelivery is a row of data from the `webhook_deliveries` table, a
+// payload waiting to be delivered to a webhook.
+type WebhookDelivery struct {
+	ID             int64       `db:"id"`
+	WebhookID      int64       `db:"webhook_id"`
+	LedgerSequence int32       `db:"ledger_sequence"`
+	Payload        string      `db:"payload"`
+	Attempts       int32       `db:"attempts"`
+	NextAttemptAt  time.Time   `db:"next_attempt_at"`
+	LastError      null.String `db:"last_error"`
+	CreatedAt      time.Time   `db:"created_at"`
+}
+
+// WebhookDeadLetter is a row of data from the `webhook_dead_letters` table,
+// a delivery abandoned after too many failed attempts.
+type WebhookDeadLetter struct {
+	ID             int64       `db:"id"`
+	WebhookID      int64       `db:"webhook_id"`
+	LedgerSequence int32       `db:"ledger_sequence"`
+	Payload        string      `db:"payload"`
+	Attempts       int32       `db:"attempts"`
+	LastError      null.String `db:"last_error"`
+	CreatedAt      time.Time   `db:"created_at"`
+	FailedAt       time.Time   `db:"failed_at"`
+}
+
 func (q *Q) NewAccountsBatchInsertBuilder(maxBatchSize int) AccountsBatchInsertBuilder {
 	return &accountsBatchInsertBuilder{
 		builder: db.BatchInsertBuilder{
@@ -700,3 +862,13 @@ func (q *Q) DeleteRangeAll(start, end int64) error {
 
 	return nil
 }
+
+// ledgerEntrySponsor returns the address of the sponsor of entry, or an
+// invalid null.String if the entry is not sponsored.
+func ledgerEntrySponsor(entry xdr.LedgerEntry) null.String {
+	var sponsor null.String
+	if sponsoringID := entry.SponsoringID(); sponsoringID != nil {
+		sponsor.SetValid(sponsoringID.Address())
+	}
+	return sponsor
+}
@@ -0,0 +1,32 @@
+package history
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/stellar/go/xdr"
+)
+
+// MockQClaimableBalances is a mock implementation of the QClaimableBalances interface
+type MockQClaimableBalances struct {
+	mock.Mock
+}
+
+func (m *MockQClaimableBalances) UpsertClaimableBalances(claimableBalances []xdr.LedgerEntry) error {
+	a := m.Called(claimableBalances)
+	return a.Error(0)
+}
+
+func (m *MockQClaimableBalances) RemoveClaimableBalance(cBalance xdr.ClaimableBalanceEntry) (int64, error) {
+	a := m.Called(cBalance)
+	return a.Get(0).(int64), a.Error(1)
+}
+
+func (m *MockQClaimableBalances) GetClaimableBalancesByID(ids []xdr.ClaimableBalanceId) ([]ClaimableBalance, error) {
+	a := m.Called(ids)
+	return a.Get(0).([]ClaimableBalance), a.Error(1)
+}
+
+func (m *MockQClaimableBalances) CountClaimableBalances() (int, error) {
+	a := m.Called()
+	return a.Get(0).(int), a.Error(1)
+}
@@ -36,8 +36,8 @@ func (m *MockQOffers) NewOffersBatchInsertBuilder(maxBatchSize int) OffersBatchI
 	return a.Get(0).(OffersBatchInsertBuilder)
 }
 
-func (m *MockQOffers) UpdateOffer(offer xdr.OfferEntry, lastModifiedLedger xdr.Uint32) (int64, error) {
-	a := m.Called(offer, lastModifiedLedger)
+func (m *MockQOffers) UpdateOffer(entry xdr.LedgerEntry) (int64, error) {
+	a := m.Called(entry)
 	return a.Get(0).(int64), a.Error(1)
 }
 
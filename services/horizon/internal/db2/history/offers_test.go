@@ -123,7 +123,13 @@ func assertOfferEntryMatchesDBOffer(t *testing.T, offerEntry xdr.OfferEntry, off
 
 func insertOffer(q *Q, offer xdr.OfferEntry, lastModifiedSeq uint32) error {
 	batch := q.NewOffersBatchInsertBuilder(0)
-	err := batch.Add(offer, xdr.Uint32(lastModifiedSeq))
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: xdr.Uint32(lastModifiedSeq),
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &offer,
+		},
+	})
 	if err != nil {
 		return err
 	}
@@ -252,7 +258,13 @@ func TestUpdateOffer(t *testing.T) {
 	modifiedEurOffer := eurOffer
 	modifiedEurOffer.Amount -= 10
 
-	rowsAffected, err := q.UpdateOffer(modifiedEurOffer, 1235)
+	rowsAffected, err := q.UpdateOffer(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &modifiedEurOffer,
+		},
+	})
 	tt.Assert.NoError(err)
 	tt.Assert.Equal(int64(1), rowsAffected)
 
@@ -2,9 +2,11 @@ package history
 
 import (
 	"encoding/json"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-errors/errors"
+	"github.com/guregu/null"
 	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/services/horizon/internal/toid"
 	"github.com/stellar/go/xdr"
@@ -185,6 +187,128 @@ func (q *OperationsQ) OnlyPayments() *OperationsQ {
 	return q
 }
 
+// searchablePaymentTypes are the types of the operations whose details
+// contain the asset and amount of a payment.
+var searchablePaymentTypes = []xdr.OperationType{
+	xdr.OperationTypePayment,
+	xdr.OperationTypePathPaymentStrictReceive,
+	xdr.OperationTypePathPaymentStrictSend,
+}
+
+// ForTypes filters the query to only operations of the given types.
+func (q *OperationsQ) ForTypes(types ...xdr.OperationType) *OperationsQ {
+	q.sql = q.sql.Where(sq.Eq{"hop.type": types})
+	return q
+}
+
+// ForMemo filters the query to only operations of transactions with the given
+// memo. memo is formatted as in the `history_transactions` table: ids are
+// decimal strings and hashes are base64 encoded.
+func (q *OperationsQ) ForMemo(memoType, memo string) *OperationsQ {
+	q.sql = q.sql.Where("ht.memo_type = ? AND ht.memo = ?", memoType, memo)
+	return q
+}
+
+// ForPaymentAsset filters the query to only payments of the given asset:
+// payments, path payments (by destination asset) and, for the native asset,
+// account creations.
+func (q *OperationsQ) ForPaymentAsset(asset xdr.Asset) *OperationsQ {
+	var assetType, code, issuer string
+	q.Err = asset.Extract(&assetType, &code, &issuer)
+	if q.Err != nil {
+		return q
+	}
+
+	if asset.Type == xdr.AssetTypeAssetTypeNative {
+		q.sql = q.sql.Where(sq.Or{
+			sq.Eq{"hop.type": xdr.OperationTypeCreateAccount},
+			sq.And{
+				sq.Eq{"hop.type": searchablePaymentTypes},
+				sq.Expr("hop.details->>'asset_type' = ?", assetType),
+			},
+		})
+		return q
+	}
+
+	q.sql = q.sql.Where(sq.Eq{"hop.type": searchablePaymentTypes}).Where(
+		"hop.details->>'asset_type' = ? AND "+
+			"hop.details->>'asset_code' = ? AND "+
+			"hop.details->>'asset_issuer' = ?",
+		assetType, code, issuer,
+	)
+	return q
+}
+
+// ForPaymentAmountRange filters the query to only payments, path payments (by
+// destination amount) and account creations (by starting balance) with an
+// amount within [min, max]. Empty bounds are ignored.
+func (q *OperationsQ) ForPaymentAmountRange(min, max string) *OperationsQ {
+	if min == "" && max == "" {
+		return q
+	}
+
+	types := append([]xdr.OperationType{xdr.OperationTypeCreateAccount}, searchablePaymentTypes...)
+	q.sql = q.sql.Where(sq.Eq{"hop.type": types})
+	amount := "COALESCE(hop.details->>'amount', hop.details->>'starting_balance')::numeric"
+	if min != "" {
+		q.sql = q.sql.Where(amount+" >= ?::numeric", min)
+	}
+	if max != "" {
+		q.sql = q.sql.Where(amount+" <= ?::numeric", max)
+	}
+	return q
+}
+
+// ForTimeRange filters the query to only operations in ledgers closed within
+// [start, end). Zero bounds are ignored.
+func (q *OperationsQ) ForTimeRange(start, end time.Time) *OperationsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	if !start.IsZero() {
+		var first null.Int
+		q.Err = q.parent.GetRaw(
+			&first,
+			"SELECT MIN(sequence) FROM history_ledgers WHERE closed_at >= ?",
+			start.UTC(),
+		)
+		if q.Err != nil {
+			return q
+		}
+		if !first.Valid {
+			q.sql = q.sql.Where("false")
+			return q
+		}
+		q.sql = q.sql.Where(
+			q.opIdCol+" >= ?",
+			toid.ID{LedgerSequence: int32(first.Int64)}.ToInt64(),
+		)
+	}
+
+	if !end.IsZero() {
+		var last null.Int
+		q.Err = q.parent.GetRaw(
+			&last,
+			"SELECT MAX(sequence) FROM history_ledgers WHERE closed_at < ?",
+			end.UTC(),
+		)
+		if q.Err != nil {
+			return q
+		}
+		if !last.Valid {
+			q.sql = q.sql.Where("false")
+			return q
+		}
+		q.sql = q.sql.Where(
+			q.opIdCol+" < ?",
+			toid.ID{LedgerSequence: int32(last.Int64) + 1}.ToInt64(),
+		)
+	}
+
+	return q
+}
+
 // IncludeFailed changes the query to include failed transactions.
 func (q *OperationsQ) IncludeFailed() *OperationsQ {
 	q.includeFailed = true
@@ -6,6 +6,8 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestOperationQueries(t *testing.T) {
@@ -85,6 +87,42 @@ func TestOperationQueryBuilder(t *testing.T) {
 	tt.Assert.EqualValues(want, got)
 }
 
+func TestOperationSearchQueryBuilder(t *testing.T) {
+	q := &Q{}
+	usd := xdr.MustNewCreditAsset("USD", "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB")
+
+	opsQ := q.Operations().
+		ForMemo("id", "123").
+		ForPaymentAsset(usd).
+		ForPaymentAmountRange("1000", "").
+		ForTypes(xdr.OperationTypePayment)
+	assert.NoError(t, opsQ.Err)
+	got, args, err := opsQ.sql.ToSql()
+	assert.NoError(t, err)
+	want := "SELECT hop.id, hop.transaction_id, hop.application_order, hop.type, hop.details, hop.source_account, ht.transaction_hash, ht.tx_result, COALESCE(ht.successful, true) as transaction_successful FROM history_operations hop LEFT JOIN history_transactions ht ON ht.id = hop.transaction_id " +
+		"WHERE ht.memo_type = ? AND ht.memo = ? " +
+		"AND hop.type IN (?,?,?) " +
+		"AND hop.details->>'asset_type' = ? AND hop.details->>'asset_code' = ? AND hop.details->>'asset_issuer' = ? " +
+		"AND hop.type IN (?,?,?,?) " +
+		"AND COALESCE(hop.details->>'amount', hop.details->>'starting_balance')::numeric >= ?::numeric " +
+		"AND hop.type IN (?)"
+	assert.Equal(t, want, got)
+	assert.Equal(t, []interface{}{
+		"id", "123",
+		xdr.OperationTypePayment, xdr.OperationTypePathPaymentStrictReceive, xdr.OperationTypePathPaymentStrictSend,
+		"credit_alphanum4", "USD", "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB",
+		xdr.OperationTypeCreateAccount, xdr.OperationTypePayment, xdr.OperationTypePathPaymentStrictReceive, xdr.OperationTypePathPaymentStrictSend,
+		"1000",
+		xdr.OperationTypePayment,
+	}, args)
+
+	opsQ = q.Operations().ForPaymentAsset(xdr.MustNewNativeAsset())
+	assert.NoError(t, opsQ.Err)
+	got, _, err = opsQ.sql.ToSql()
+	assert.NoError(t, err)
+	assert.Contains(t, got, "WHERE (hop.type = ? OR (hop.type IN (?,?,?) AND hop.details->>'asset_type' = ?))")
+}
+
 // TestOperationSuccessfulOnly tests if default query returns operations in
 // successful transactions only.
 // If it's not enclosed in brackets, it may return incorrect result when mixed
@@ -180,7 +180,13 @@ func TestGetOrderBookSummary(t *testing.T) {
 
 			batch := q.NewOffersBatchInsertBuilder(0)
 			for i, offer := range testCase.offers {
-				assert.NoError(t, batch.Add(offer, xdr.Uint32(i+1)))
+				assert.NoError(t, batch.Add(xdr.LedgerEntry{
+					LastModifiedLedgerSeq: xdr.Uint32(i + 1),
+					Data: xdr.LedgerEntryData{
+						Type:  xdr.LedgerEntryTypeOffer,
+						Offer: &offer,
+					},
+				}))
 			}
 			assert.NoError(t, batch.Exec())
 
@@ -214,7 +220,13 @@ func TestGetOrderBookSummaryExcludesRemovedOffers(t *testing.T) {
 
 	batch := q.NewOffersBatchInsertBuilder(0)
 	for i, offer := range offers {
-		assert.NoError(t, batch.Add(offer, xdr.Uint32(i+1)))
+		assert.NoError(t, batch.Add(xdr.LedgerEntry{
+			LastModifiedLedgerSeq: xdr.Uint32(i + 1),
+			Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &offer,
+			},
+		}))
 	}
 	assert.NoError(t, batch.Exec())
 
@@ -2,6 +2,7 @@ package history
 
 import (
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -28,9 +29,21 @@ func TestSequenceProviderGet(t *testing.T) {
 	q := &Q{tt.HorizonSession()}
 
 	batch := q.NewAccountsBatchInsertBuilder(0)
-	err := batch.Add(account1, 1234)
+	err := batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account1,
+		},
+	})
 	assert.NoError(t, err)
-	err = batch.Add(account2, 1235)
+	err = batch.Add(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &account2,
+		},
+	})
 	assert.NoError(t, err)
 	assert.NoError(t, batch.Exec())
 
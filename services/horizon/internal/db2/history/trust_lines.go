@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/guregu/null"
 	"github.com/lib/pq"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
@@ -94,8 +95,9 @@ func (q *Q) GetTrustLinesByKeys(keys []xdr.LedgerKeyTrustLine) ([]TrustLine, err
 
 // InsertTrustLine creates a row in the trust lines table.
 // Returns number of rows affected and error.
-func (q *Q) InsertTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) (int64, error) {
-	m := trustLineToMap(trustLine, lastModifiedLedger)
+func (q *Q) InsertTrustLine(entry xdr.LedgerEntry) (int64, error) {
+	trustLine := entry.Data.MustTrustLine()
+	m := trustLineToMap(entry)
 
 	// Add lkey only when inserting rows
 	key, err := trustLineEntryToLedgerKeyString(trustLine)
@@ -115,7 +117,8 @@ func (q *Q) InsertTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr
 
 // UpdateTrustLine updates a row in the trust lines table.
 // Returns number of rows affected and error.
-func (q *Q) UpdateTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) (int64, error) {
+func (q *Q) UpdateTrustLine(entry xdr.LedgerEntry) (int64, error) {
+	trustLine := entry.Data.MustTrustLine()
 	ledgerKey := xdr.LedgerKey{}
 	err := ledgerKey.SetTrustline(trustLine.AccountId, trustLine.Asset)
 	if err != nil {
@@ -128,7 +131,7 @@ func (q *Q) UpdateTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr
 	}
 
 	sql := sq.Update("trust_lines").
-		SetMap(trustLineToMap(trustLine, lastModifiedLedger)).
+		SetMap(trustLineToMap(entry)).
 		Where(map[string]interface{}{"ledger_key": key})
 	result, err := q.Exec(sql)
 	if err != nil {
@@ -143,7 +146,7 @@ func (q *Q) UpdateTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr
 // accept other than 2GB limit of the query string length what should be enough
 // for each ledger with the current limits.
 func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
-	var ledgerKey, accountID, assetIssuer, assetCode []string
+	var ledgerKey, accountID, assetIssuer, assetCode, sponsor []string
 	var balance, limit, buyingLiabilities, sellingLiabilities []xdr.Int64
 	var flags, lastModifiedLedger []xdr.Uint32
 	var assetType []xdr.AssetType
@@ -158,7 +161,7 @@ func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
 			return errors.Wrap(err, "Error running trustLineEntryToLedgerKeyString")
 		}
 
-		m := trustLineToMap(entry.Data.MustTrustLine(), entry.LastModifiedLedgerSeq)
+		m := trustLineToMap(entry)
 
 		ledgerKey = append(ledgerKey, key)
 		accountID = append(accountID, m["account_id"].(string))
@@ -171,22 +174,24 @@ func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
 		sellingLiabilities = append(sellingLiabilities, m["selling_liabilities"].(xdr.Int64))
 		flags = append(flags, m["flags"].(xdr.Uint32))
 		lastModifiedLedger = append(lastModifiedLedger, m["last_modified_ledger"].(xdr.Uint32))
+		sponsor = append(sponsor, m["sponsor"].(null.String).String)
 	}
 
 	sql := `
 	WITH r AS
 		(SELECT
-			unnest(?::text[]),
-			unnest(?::text[]),
-			unnest(?::int[]),
-			unnest(?::text[]),
-			unnest(?::text[]),
-			unnest(?::bigint[]),
-			unnest(?::bigint[]),
-			unnest(?::bigint[]),
-			unnest(?::bigint[]),
-			unnest(?::int[]),
-			unnest(?::int[])
+			unnest(?::text[]) AS ledger_key,
+			unnest(?::text[]) AS account_id,
+			unnest(?::int[]) AS asset_type,
+			unnest(?::text[]) AS asset_issuer,
+			unnest(?::text[]) AS asset_code,
+			unnest(?::bigint[]) AS balance,
+			unnest(?::bigint[]) AS trust_line_limit,
+			unnest(?::bigint[]) AS buying_liabilities,
+			unnest(?::bigint[]) AS selling_liabilities,
+			unnest(?::int[]) AS flags,
+			unnest(?::int[]) AS last_modified_ledger,
+			unnest(?::text[]) AS sponsor
 		)
 	INSERT INTO trust_lines ( 
 		ledger_key,
@@ -199,9 +204,23 @@ func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
 		buying_liabilities,
 		selling_liabilities,
 		flags,
-		last_modified_ledger
+		last_modified_ledger,
+		sponsor
 	)
-	SELECT * from r 
+	SELECT
+		ledger_key,
+		account_id,
+		asset_type,
+		asset_issuer,
+		asset_code,
+		balance,
+		trust_line_limit,
+		buying_liabilities,
+		selling_liabilities,
+		flags,
+		last_modified_ledger,
+		NULLIF(sponsor, '')
+	FROM r
 	ON CONFLICT (ledger_key) DO UPDATE SET 
 		ledger_key = excluded.ledger_key,
 		account_id = excluded.account_id,
@@ -213,7 +232,8 @@ func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
 		buying_liabilities = excluded.buying_liabilities,
 		selling_liabilities = excluded.selling_liabilities,
 		flags = excluded.flags,
-		last_modified_ledger = excluded.last_modified_ledger`
+		last_modified_ledger = excluded.last_modified_ledger,
+		sponsor = excluded.sponsor`
 
 	_, err := q.ExecRaw(sql,
 		pq.Array(ledgerKey),
@@ -226,7 +246,8 @@ func (q *Q) UpsertTrustLines(trustLines []xdr.LedgerEntry) error {
 		pq.Array(buyingLiabilities),
 		pq.Array(sellingLiabilities),
 		pq.Array(flags),
-		pq.Array(lastModifiedLedger))
+		pq.Array(lastModifiedLedger),
+		pq.Array(sponsor))
 	return err
 }
 
@@ -284,7 +305,8 @@ func ledgerKeyTrustLineToString(trustLineKey xdr.LedgerKeyTrustLine) (string, er
 	return base64.StdEncoding.EncodeToString(key), nil
 }
 
-func trustLineToMap(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) map[string]interface{} {
+func trustLineToMap(entry xdr.LedgerEntry) map[string]interface{} {
+	trustLine := entry.Data.MustTrustLine()
 	var assetType xdr.AssetType
 	var assetCode, assetIssuer string
 	trustLine.Asset.MustExtract(&assetType, &assetCode, &assetIssuer)
@@ -306,7 +328,8 @@ func trustLineToMap(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32)
 		"buying_liabilities":   buyingliabilities,
 		"selling_liabilities":  sellingliabilities,
 		"flags":                trustLine.Flags,
-		"last_modified_ledger": lastModifiedLedger,
+		"last_modified_ledger": entry.LastModifiedLedgerSeq,
+		"sponsor":              ledgerEntrySponsor(entry),
 	}
 }
 
@@ -320,5 +343,6 @@ var selectTrustLines = sq.Select(`
 	buying_liabilities,
 	selling_liabilities,
 	flags,
-	last_modified_ledger
+	last_modified_ledger,
+	sponsor
 `).From("trust_lines")
@@ -5,10 +5,10 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
-// Add adds a new trust line entry to the batch. `lastModifiedLedger` is another
-// parameter because `xdr.TrustLineEntry` does not have a field to hold this value.
-func (i *trustLinesBatchInsertBuilder) Add(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) error {
-	m := trustLineToMap(trustLine, lastModifiedLedger)
+// Add adds a new trust line entry to the batch.
+func (i *trustLinesBatchInsertBuilder) Add(entry xdr.LedgerEntry) error {
+	trustLine := entry.Data.MustTrustLine()
+	m := trustLineToMap(entry)
 
 	// Add lkey only when inserting rows
 	key, err := trustLineEntryToLedgerKeyString(trustLine)
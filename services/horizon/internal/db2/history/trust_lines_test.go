@@ -82,11 +82,23 @@ func TestInsertTrustLine(t *testing.T) {
 	test.ResetHorizonDB(t, tt.HorizonDB)
 	q := &Q{tt.HorizonSession()}
 
-	rows, err := q.InsertTrustLine(eurTrustLine, 1234)
+	rows, err := q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), rows)
 
-	rows, err = q.InsertTrustLine(usdTrustLine, 1235)
+	rows, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), rows)
 
@@ -106,14 +118,26 @@ func TestUpdateTrustLine(t *testing.T) {
 	test.ResetHorizonDB(t, tt.HorizonDB)
 	q := &Q{tt.HorizonSession()}
 
-	rows, err := q.InsertTrustLine(eurTrustLine, 1234)
+	rows, err := q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), rows)
 
 	modifiedTrustLine := eurTrustLine
 	modifiedTrustLine.Balance = 30000
 
-	rows, err = q.UpdateTrustLine(modifiedTrustLine, 1235)
+	rows, err = q.UpdateTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &modifiedTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), rows)
 
@@ -281,7 +305,13 @@ func TestRemoveTrustLine(t *testing.T) {
 	test.ResetHorizonDB(t, tt.HorizonDB)
 	q := &Q{tt.HorizonSession()}
 
-	rows, err := q.InsertTrustLine(eurTrustLine, 1234)
+	rows, err := q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), rows)
 
@@ -305,11 +335,29 @@ func TestGetSortedTrustLinesByAccountsID(t *testing.T) {
 	test.ResetHorizonDB(t, tt.HorizonDB)
 	q := &Q{tt.HorizonSession()}
 
-	_, err := q.InsertTrustLine(eurTrustLine, 1234)
+	_, err := q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
-	_, err = q.InsertTrustLine(usdTrustLine, 1235)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
-	_, err = q.InsertTrustLine(usdTrustLine2, 1235)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1235,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &usdTrustLine2,
+		},
+	})
 	tt.Assert.NoError(err)
 
 	ids := []string{
@@ -350,7 +398,13 @@ func TestGetTrustLinesByAccountID(t *testing.T) {
 	test.ResetHorizonDB(t, tt.HorizonDB)
 	q := &Q{tt.HorizonSession()}
 
-	_, err := q.InsertTrustLine(eurTrustLine, 1234)
+	_, err := q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
 
 	record, err := q.GetSortedTrustLinesByAccountID(eurTrustLine.AccountId.Address())
@@ -402,7 +456,13 @@ func TestAssetsForAddress(t *testing.T) {
 	err := q.UpsertAccounts(ledgerEntries)
 	assert.NoError(t, err)
 
-	_, err = q.InsertTrustLine(eurTrustLine, 1234)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &eurTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
 
 	brlTrustLine := xdr.TrustLineEntry{
@@ -422,7 +482,13 @@ func TestAssetsForAddress(t *testing.T) {
 		},
 	}
 
-	_, err = q.InsertTrustLine(brlTrustLine, 1234)
+	_, err = q.InsertTrustLine(xdr.LedgerEntry{
+		LastModifiedLedgerSeq: 1234,
+		Data: xdr.LedgerEntryData{
+			Type:      xdr.LedgerEntryTypeTrustline,
+			TrustLine: &brlTrustLine,
+		},
+	})
 	tt.Assert.NoError(err)
 
 	err = q.BeginTx(&sql.TxOptions{
@@ -0,0 +1,169 @@
+package history
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+)
+
+var selectWebhooks = sq.Select(
+	"id, url, secret, account_id, operation_type, asset, created_at",
+).From("webhooks")
+
+var selectWebhookDeadLetters = sq.Select(
+	"id, webhook_id, ledger_sequence, payload, attempts, last_error, created_at, failed_at",
+).From("webhook_dead_letters")
+
+// InsertWebhook registers a webhook and returns its id.
+func (q *Q) InsertWebhook(webhook Webhook) (int64, error) {
+	sql := sq.Insert("webhooks").SetMap(map[string]interface{}{
+		"url":            webhook.URL,
+		"secret":         webhook.Secret,
+		"account_id":     webhook.AccountID,
+		"operation_type": webhook.OperationType,
+		"asset":          webhook.Asset,
+		"created_at":     webhook.CreatedAt,
+	}).Suffix("RETURNING id")
+
+	var id int64
+	err := q.Get(&id, sql)
+	return id, err
+}
+
+// GetWebhooks loads all the webhooks ordered by id.
+func (q *Q) GetWebhooks() ([]Webhook, error) {
+	var webhooks []Webhook
+	err := q.Select(&webhooks, selectWebhooks.OrderBy("id asc"))
+	return webhooks, err
+}
+
+// GetWebhookByID loads a webhook by its id.
+func (q *Q) GetWebhookByID(id int64) (Webhook, error) {
+	var webhook Webhook
+	err := q.Get(&webhook, selectWebhooks.Where("id = ?", id))
+	return webhook, err
+}
+
+// DeleteWebhook removes a webhook along with its pending deliveries and dead
+// letters.
+func (q *Q) DeleteWebhook(id int64) (int64, error) {
+	result, err := q.Exec(sq.Delete("webhooks").Where("id = ?", id))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// InsertWebhookDeliveries enqueues payloads to be delivered.
+func (q *Q) InsertWebhookDeliveries(deliveries []WebhookDelivery) error {
+	builder := &db.BatchInsertBuilder{
+		Table:        q.GetTable("webhook_deliveries"),
+		MaxBatchSize: 1000,
+	}
+	for _, delivery := range deliveries {
+		err := builder.Row(map[string]interface{}{
+			"webhook_id":      delivery.WebhookID,
+			"ledger_sequence": delivery.LedgerSequence,
+			"payload":         delivery.Payload,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"created_at":      delivery.CreatedAt,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not insert webhook delivery")
+		}
+	}
+	return builder.Exec()
+}
+
+// ClaimWebhookDeliveries returns up to limit deliveries due at now and
+// postpones their next attempt to now+lease, so that they are not claimed
+// again while being delivered. A delivery which is neither removed nor
+// retried before the lease expires, because the process delivering it
+// stopped, is claimed again.
+func (q *Q) ClaimWebhookDeliveries(now time.Time, lease time.Duration, limit uint64) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	err := q.SelectRaw(&deliveries, `
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE next_attempt_at <= ?
+			ORDER BY id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, webhook_id, ledger_sequence, payload, attempts, next_attempt_at, last_error, created_at`,
+		now.Add(lease), now, limit,
+	)
+	return deliveries, err
+}
+
+// DeleteWebhookDelivery removes a delivered payload.
+func (q *Q) DeleteWebhookDelivery(id int64) error {
+	_, err := q.Exec(sq.Delete("webhook_deliveries").Where("id = ?", id))
+	return err
+}
+
+// RetryWebhookDelivery records a failed attempt to deliver a payload and
+// schedules the next one.
+func (q *Q) RetryWebhookDelivery(id int64, nextAttemptAt time.Time, lastError string) error {
+	sql := sq.Update("webhook_deliveries").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", lastError).
+		Where("id = ?", id)
+	_, err := q.Exec(sql)
+	return err
+}
+
+// DeadLetterWebhookDelivery records a failed attempt to deliver a payload and
+// moves it to the dead letters.
+func (q *Q) DeadLetterWebhookDelivery(id int64, failedAt time.Time, lastError string) error {
+	_, err := q.ExecRaw(`
+		WITH moved AS (
+			DELETE FROM webhook_deliveries WHERE id = ?
+			RETURNING id, webhook_id, ledger_sequence, payload, attempts, created_at
+		)
+		INSERT INTO webhook_dead_letters
+			(id, webhook_id, ledger_sequence, payload, attempts, last_error, created_at, failed_at)
+		SELECT id, webhook_id, ledger_sequence, payload, attempts + 1, ?, created_at, ?
+		FROM moved`,
+		id, lastError, failedAt,
+	)
+	return err
+}
+
+// GetWebhookDeadLetters loads a page of the dead letters of a webhook.
+func (q *Q) GetWebhookDeadLetters(webhookID int64, page db2.PageQuery) ([]WebhookDeadLetter, error) {
+	sql, err := page.ApplyTo(selectWebhookDeadLetters.Where("webhook_id = ?", webhookID), "id")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply query to page")
+	}
+	var deadLetters []WebhookDeadLetter
+	err = q.Select(&deadLetters, sql)
+	return deadLetters, err
+}
+
+// RequeueWebhookDeadLetters moves the dead letters of a webhook back to the
+// deliveries, to be attempted again from now. It returns the number of
+// requeued deliveries.
+func (q *Q) RequeueWebhookDeadLetters(webhookID int64, now time.Time) (int64, error) {
+	result, err := q.ExecRaw(`
+		WITH moved AS (
+			DELETE FROM webhook_dead_letters WHERE webhook_id = ?
+			RETURNING webhook_id, ledger_sequence, payload, created_at
+		)
+		INSERT INTO webhook_deliveries
+			(webhook_id, ledger_sequence, payload, attempts, next_attempt_at, created_at)
+		SELECT webhook_id, ledger_sequence, payload, 0, ?, created_at
+		FROM moved`,
+		webhookID, now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,88 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/test"
+)
+
+func TestWebhooks(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+	q := &Q{tt.HorizonSession()}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	id, err := q.InsertWebhook(Webhook{
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		AccountID: null.StringFrom("GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"),
+		CreatedAt: now,
+	})
+	tt.Assert.NoError(err)
+
+	webhook, err := q.GetWebhookByID(id)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal("https://example.com/hook", webhook.URL)
+	tt.Assert.Equal("GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB", webhook.AccountID.String)
+	tt.Assert.False(webhook.OperationType.Valid)
+	tt.Assert.False(webhook.Asset.Valid)
+
+	webhooks, err := q.GetWebhooks()
+	tt.Assert.NoError(err)
+	tt.Assert.Len(webhooks, 1)
+
+	err = q.InsertWebhookDeliveries([]WebhookDelivery{
+		{WebhookID: id, LedgerSequence: 1, Payload: `{"id":"1"}`, NextAttemptAt: now, CreatedAt: now},
+		{WebhookID: id, LedgerSequence: 1, Payload: `{"id":"2"}`, NextAttemptAt: now, CreatedAt: now},
+		{WebhookID: id, LedgerSequence: 2, Payload: `{"id":"3"}`, NextAttemptAt: now.Add(time.Hour), CreatedAt: now},
+	})
+	tt.Assert.NoError(err)
+
+	deliveries, err := q.ClaimWebhookDeliveries(now, time.Minute, 10)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(deliveries, 2)
+
+	// claimed deliveries are leased
+	leased, err := q.ClaimWebhookDeliveries(now, time.Minute, 10)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(leased, 0)
+
+	tt.Assert.NoError(q.DeleteWebhookDelivery(deliveries[0].ID))
+	tt.Assert.NoError(q.RetryWebhookDelivery(deliveries[1].ID, now.Add(2*time.Minute), "timeout"))
+
+	deliveries, err = q.ClaimWebhookDeliveries(now.Add(2*time.Minute), time.Minute, 10)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(deliveries, 1)
+	tt.Assert.Equal(int32(1), deliveries[0].Attempts)
+	tt.Assert.Equal("timeout", deliveries[0].LastError.String)
+
+	tt.Assert.NoError(q.DeadLetterWebhookDelivery(deliveries[0].ID, now, "500"))
+	deadLetters, err := q.GetWebhookDeadLetters(id, db2.MustPageQuery("", false, db2.OrderAscending, 10))
+	tt.Assert.NoError(err)
+	tt.Assert.Len(deadLetters, 1)
+	tt.Assert.Equal(deliveries[0].ID, deadLetters[0].ID)
+	tt.Assert.Equal(int32(2), deadLetters[0].Attempts)
+	tt.Assert.Equal("500", deadLetters[0].LastError.String)
+
+	requeued, err := q.RequeueWebhookDeadLetters(id, now)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(int64(1), requeued)
+	deadLetters, err = q.GetWebhookDeadLetters(id, db2.MustPageQuery("", false, db2.OrderAscending, 10))
+	tt.Assert.NoError(err)
+	tt.Assert.Len(deadLetters, 0)
+
+	deliveries, err = q.ClaimWebhookDeliveries(now, time.Minute, 10)
+	tt.Assert.NoError(err)
+	tt.Assert.Len(deliveries, 1)
+	tt.Assert.Equal(int32(0), deliveries[0].Attempts)
+
+	deleted, err := q.DeleteWebhook(id)
+	tt.Assert.NoError(err)
+	tt.Assert.Equal(int64(1), deleted)
+	_, err = q.GetWebhookByID(id)
+	tt.Assert.True(q.NoRows(err))
+}
@@ -34,6 +34,11 @@
 // migrations/39_history_trades_indices.sql (183B)
 // migrations/3_use_sequence_in_history_accounts.sql (447B)
 // migrations/40_fix_inner_tx_max_fee_constraint.sql (392B)
+// migrations/41_webhooks.sql (1.415kB)
+// migrations/42_operation_search_indices.sql (418B)
+// migrations/43_claimable_balances.sql (599B)
+// migrations/44_api_keys.sql (315B)
+// migrations/45_add_sponsors_to_state_tables.sql (1.158kB)
 // migrations/4_add_protocol_version.sql (188B)
 // migrations/5_create_trades_table.sql (1.1kB)
 // migrations/6_create_assets_table.sql (366B)
@@ -789,6 +794,106 @@ func migrations40_fix_inner_tx_max_fee_constraintSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations41_webhooksSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x94\xcf\x8e\xd3\x30\x10\xc6\xef\x79\x8a\x39\xb6\x82\x95\xb8\xc0\xa5\xa7\x6c\xe3\x45\x15\x21\x5d\xa5\xa9\xc4\x9e\xac\xa9\x3d\xa4\x86\xd4\x0e\xf6\x74\xbb\xe5\xe9\x11\x38\x4d\x97\x92\x2d\x3d\xf4\xb2\xc7\xcc\xfc\xe6\xcb\xfc\xf9\xe4\x9b\x1b\x78\xb3\x31\xb5\x47\x26\x58\xb6\x49\x32\x2d\x45\x5a\x09\xa8\xd2\xdb\x5c\xc0\x8e\x56\x6b\xe7\xbe\x07\x18\x25\x00\x00\x46\xc3\xca\xd4\x81\xbc\xc1\x06\xee\xcb\xd9\xe7\xb4\x7c\x80\x4f\xe2\xe1\xed\x9f\xec\xd6\x37\xc0\xf4\xc4\x50\xcc\x2b\x28\x96\x79\x1e\xc3\x81\x94\x27\x1e\xca\xa0\x52\x6e\x6b\x59\x1a\x0d\x6a\x8d\x1e\x15\x93\x87\x47\xf4\x7b\x63\xeb\xd1\xfb\x0f\xe3\x48\xb9\x96\x3c\xb2\x71\x56\xf2\xbe\x25\x30\x96\xa9\x26\xdf\x29\x84\xd0\x49\xc7\x6f\xe5\x09\x99\xb4\x44\x06\x36\x1b\x0a\x8c\x9b\x16\x76\x86\xd7\x6e\x1b\x23\xf0\xd3\x59\xea\xdb\x48\xc6\x93\xe1\x81\xa5\xa6\xc6\x3c\x92\x37\x74\xd9\xe8\x87\xb2\x48\x19\x7b\x9c\x14\x4a\x71\x27\x4a\x51\x4c\xc5\xe2\x40\x05\x18\x19\x3d\x86\x79\x01\x99\xc8\x45\x25\x60\x9a\x2e\xa6\x69\x26\xa2\x54\x43\xba\x26\x2f\x03\xfd\xd8\x92\x55\xfd\xbc\xbd\x60\xa4\x5a\xdc\x37\x0e\x35\x7c\x0b\xce\xae\x4e\x72\xc8\x4c\x9b\x96\xc3\x3f\xa5\x90\x89\xbb\x74\x99\x57\xf0\x2e\x8a\x58\x7a\x62\xd9\xd1\x97\xae\xac\x6b\x12\x03\x4b\xf2\xde\xf9\xeb\x2c\x7f\x56\x64\xe2\xcb\xc0\xf2\xe5\x69\x8b\xf3\x62\x80\x82\xe5\x62\x56\x7c\x84\xdb\xaa\x14\x62\x74\x52\x31\x9e\xfc\xf7\x1f\x87\x90\xd1\x17\xc8\x1f\xe1\x33\xde\x41\x2d\x1b\x62\x26\xff\xb7\x7b\x7e\xfb\xe2\x95\x5a\xe7\x7a\x67\x8f\x4a\x5f\xd1\x34\xd7\xb1\xca\x71\xd7\x2f\x1e\xf2\xd9\x39\x5e\x3e\xe5\xf3\x77\x30\x73\x3b\x9b\x24\x59\x39\xbf\x3f\x77\x5a\x85\x41\xa1\xa6\xc9\x30\xd8\xbb\xe7\x0c\x16\x40\x61\x50\xa8\x69\x92\xfc\x1a\x00\xc9\xb1\x2a\xe3\x87\x05\x00\x00")
+
+func migrations41_webhooksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations41_webhooksSql,
+		"migrations/41_webhooks.sql",
+	)
+}
+
+func migrations41_webhooksSql() (*asset, error) {
+	bytes, err := migrations41_webhooksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/41_webhooks.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe4, 0x6e, 0x86, 0x26, 0x1c, 0xbf, 0x64, 0xb5, 0x65, 0x8f, 0xc8, 0xd2, 0x7, 0xd7, 0x73, 0x54, 0xe0, 0x51, 0xf, 0x99, 0x11, 0xc, 0x27, 0x3b, 0x9a, 0xef, 0x51, 0x20, 0xa9, 0x73, 0xd6, 0x69}}
+	return a, nil
+}
+
+var _migrations42_operation_search_indicesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x51\x6b\xb3\x30\x14\x86\xef\xf3\x2b\xde\xbb\x2a\x9f\x5e\xf4\xdb\x5d\x65\x05\x99\xb2\x09\x25\x0e\x5b\xd9\xca\x18\x92\xd6\xc3\x1a\x98\x26\x24\x67\xac\xfe\xfb\xa1\x30\x70\x43\xd8\xdd\x0b\x79\xc2\xc3\x79\xe2\x18\xff\x3a\xfd\xe6\x14\x13\x6a\x2b\xc4\x5d\x95\xa7\x87\x1c\x85\xcc\xf2\x67\x5c\xd8\x5d\x9b\xd3\xd0\x74\xd4\x19\x94\x12\x17\xed\xd9\xb8\xa1\x61\xa7\x7a\xaf\xce\xac\x4d\xef\x51\xef\x0b\x79\x8f\x13\x3b\x22\x04\x23\xd9\xf0\x60\x29\xc2\x38\x43\x3c\x3d\xe4\x55\x3e\x6d\x14\x7b\xc8\xf2\x00\x59\xef\x76\xc9\x2f\x8f\xb1\xa3\xc6\xaa\xa1\xa3\x9e\x1b\xe5\x3d\xf1\xdc\x67\x2c\x39\xb5\x60\x0b\x82\x96\x58\xe9\x77\x8f\x78\xbb\xc5\x6a\xfa\x37\xd9\x57\x9b\x0d\xd3\x95\xc3\x30\xc2\x22\x73\x36\xed\x9f\x8c\xf6\xfe\x83\xdc\x8c\xd2\xed\xf7\x39\xc1\xe8\xc0\x2d\x52\x79\x44\x90\x56\x55\x7a\x7c\x59\x47\xf8\x1f\x61\x7d\xf3\x1a\x86\x89\x10\xf3\xaa\x99\xf9\xec\x85\xc8\xaa\xf2\x71\xa1\x6a\xf2\xe3\x61\x21\x43\x22\xbe\x06\x00\xdd\x5f\x64\x29\xa2\x01\x00\x00")
+
+func migrations42_operation_search_indicesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations42_operation_search_indicesSql,
+		"migrations/42_operation_search_indices.sql",
+	)
+}
+
+func migrations42_operation_search_indicesSql() (*asset, error) {
+	bytes, err := migrations42_operation_search_indicesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/42_operation_search_indices.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0x81, 0x51, 0xde, 0x20, 0x7a, 0x69, 0x48, 0x6, 0x5f, 0xf8, 0xc8, 0x5a, 0x31, 0x30, 0xc2, 0xa0, 0xa1, 0x7a, 0xe9, 0xfa, 0xca, 0x6b, 0x41, 0xc1, 0x3d, 0x0, 0x4, 0xcd, 0x97, 0xc3, 0x2c}}
+	return a, nil
+}
+
+var _migrations43_claimable_balancesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x4f\x83\x30\x14\xc7\xef\xfd\x2b\xde\x11\xa2\x4b\xbc\xe8\x85\xc4\x84\x41\xa3\x64\x08\x0b\x42\xe2\x4e\xcd\xa3\x54\x56\x03\x2d\x69\xeb\x8f\xfd\xf7\x66\x83\xcd\x69\xa6\xee\xc4\xe1\x7d\xf8\xb4\xdf\x6f\xdf\x6c\x06\x17\xbd\x6c\x0d\x3a\x01\xd5\x40\x48\x54\xd0\xb0\xa4\x50\x86\xf3\x94\x02\xef\x50\xf6\x58\x77\x82\xd5\xd8\xa1\xe2\xc2\x82\x47\x00\x00\x64\x03\x4e\x7c\x38\xc8\xf2\x12\xb2\x2a\x4d\x61\x59\x24\x0f\x61\xb1\x82\x05\x5d\x5d\xee\x88\xf1\x57\xe5\x2c\xbc\x58\xad\xea\x03\x39\x4e\xd1\x5a\xe1\xbe\x2b\xa6\x41\xaf\x5f\x95\x83\x5a\xb6\x52\x1d\xe9\xa3\x7b\x1a\x2d\xc0\x9b\xa6\xb7\x70\xe5\x8f\xb8\x1d\xb4\xb2\xda\x00\x5f\xa3\x41\xee\x84\x81\x37\x34\x1b\xa9\x5a\xef\xfa\x66\x42\x3a\xb4\x8e\xf5\xba\x91\xcf\x52\x34\xac\x13\x4d\x2b\x0c\x48\xe5\xc4\xf6\xbb\x3f\x80\xf8\xc1\x21\x7a\x92\xc5\xf4\xe9\x44\x74\x56\x6f\xd8\x78\xef\x3c\x3b\xd5\x4c\xf5\x98\x64\x77\x30\x2f\x0b\x4a\xbd\x1d\xe7\x07\xe7\x28\xf7\x11\xfe\x97\x4e\xe4\x79\xda\xaf\xfe\xff\x12\xb7\x52\x79\x3f\x5e\x8a\x0d\xe8\xd6\x4c\x0f\x76\x5b\xc9\xf1\x76\xc4\xfa\x5d\x11\x12\x17\xf9\xf2\xf7\xed\xe0\x68\x39\x36\x22\x20\x9f\x03\x00\x45\x5d\x99\x3c\x57\x02\x00\x00")
+
+func migrations43_claimable_balancesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations43_claimable_balancesSql,
+		"migrations/43_claimable_balances.sql",
+	)
+}
+
+func migrations43_claimable_balancesSql() (*asset, error) {
+	bytes, err := migrations43_claimable_balancesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/43_claimable_balances.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x98, 0xd7, 0x1a, 0x17, 0xea, 0x13, 0xa4, 0xa9, 0xe3, 0xf8, 0xc4, 0x43, 0x1f, 0x87, 0x71, 0x94, 0x4b, 0xd, 0x6d, 0xeb, 0xb4, 0x48, 0xf1, 0xc3, 0x1c, 0x8d, 0xf0, 0xfe, 0x9d, 0x5e, 0xcf, 0xb0}}
+	return a, nil
+}
+
+var _migrations44_api_keysSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xb1\x6a\xf3\x40\x10\x84\xfb\x7b\x8a\x29\x6d\xfe\xdf\x5d\x48\xe3\x4a\x89\x55\x98\x28\xb2\x23\xa4\xc2\xd5\xb1\x3e\x2d\xba\xc5\x3e\x49\xdc\xad\xb0\x9d\xa7\x0f\x89\xc0\x04\x43\xda\x99\x6f\x97\xe1\x5b\xad\xf0\x2f\x48\x17\x49\x19\xcd\x68\xcc\x6b\x95\x67\x75\x8e\x3a\x7b\x29\x72\xd0\x28\xf6\xc4\xb7\x84\x85\x01\x00\x69\x71\x94\x2e\x71\x14\x3a\x63\x5f\x6d\xdf\xb3\xea\x80\xb7\xfc\xf0\xff\xa7\xed\x29\x30\x94\xaf\x8a\x72\x57\xa3\x6c\x8a\x62\xce\x4f\x7c\xb3\x9e\x92\x87\xf3\x14\xc9\x29\xc7\xc5\xf3\xd3\xf2\x0e\xa1\x29\xb7\x1f\x4d\x3e\xb3\x23\x47\xeb\x87\x29\xda\xef\x3d\xf6\x2c\x41\x14\xd2\x2b\x77\x1c\x1f\xbe\x06\xba\xda\xe3\x14\xd3\x5f\xbd\x8b\x4c\xca\xad\x25\x85\x4a\xe0\xa4\x14\x46\x5c\x44\xfd\x30\xcd\x09\x3e\x87\x9e\xef\x47\x66\xb9\x36\xe6\xb7\x8b\xcd\x70\xe9\x8d\xd9\x54\xbb\xfd\xa3\x0b\x47\xc9\x51\xcb\x6b\xf3\x35\x00\xa7\x30\x3b\xcd\x3b\x01\x00\x00")
+
+func migrations44_api_keysSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations44_api_keysSql,
+		"migrations/44_api_keys.sql",
+	)
+}
+
+func migrations44_api_keysSql() (*asset, error) {
+	bytes, err := migrations44_api_keysSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/44_api_keys.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x93, 0x97, 0x5b, 0x2, 0x6f, 0x94, 0xb9, 0x4a, 0xc4, 0x90, 0x6e, 0x29, 0xee, 0x8f, 0xbc, 0xdf, 0x7d, 0xfb, 0x18, 0x6a, 0x3, 0x7c, 0xf0, 0xd5, 0x5d, 0x82, 0xa0, 0x5f, 0xf2, 0x14, 0x88, 0x73}}
+	return a, nil
+}
+
+var _migrations45_add_sponsors_to_state_tablesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x4f\x6b\x83\x30\x18\xc6\xef\xfd\x14\xef\xd1\x32\x0a\xbd\x6c\x97\xb2\x81\x35\xd9\x56\x26\x3a\x5c\x84\xdd\x24\xd3\x34\x0b\xac\xb1\x24\x71\xa3\xdf\x7e\x74\xc6\x4e\x8b\xa1\xb1\x67\x9f\x3f\x3f\x1e\xdf\x2c\x16\x70\xb3\x13\x5c\x51\xc3\x20\xdf\xcf\x66\x61\x4c\x70\x06\x24\x5c\xc7\x18\x68\x59\xd6\x8d\x34\x1a\x42\x84\x40\xef\x6b\xa9\x6b\x05\xe5\x27\x55\xb4\x34\x4c\xc1\x37\x55\x07\x21\x79\x70\x7b\x37\x5f\xb9\x7d\xb2\xd9\x15\xd6\xcb\x2a\x10\xd2\x30\xce\x14\x24\x29\x81\x24\x8f\x63\x40\xf8\x31\xcc\x63\x02\x4b\x88\x9e\x71\xf4\x02\xc1\x50\xff\x70\x0f\x4b\xcf\x74\x21\xf9\xb4\xf8\xa3\xc1\xe6\x47\x19\x0e\x09\x86\x4d\x82\xf0\xfb\xa9\xa0\xf8\x38\x74\x52\x48\x93\xff\xde\xfc\x6d\x93\x3c\xc1\x9a\x64\x18\x07\xf6\xfb\x7c\x35\xbe\x5c\x51\x51\x43\x7d\xe6\x1b\x07\x38\xba\x1d\x14\x6d\xf2\x14\x14\x2d\xb8\x64\x4a\x5f\x4f\x63\x03\x5c\x40\x5d\xbe\x07\x93\x51\x8d\x36\xc5\x97\x90\x6c\x3a\x4e\xcf\x7b\x46\xd2\x4f\xf5\x80\xa8\xb7\xdb\x6b\xe6\x68\x6d\x67\xd5\x36\xcb\xd1\xda\x7f\x63\xa8\xfe\x91\x43\x8e\x6e\x40\x40\x59\xfa\xda\xa1\x38\x4e\xfe\x4f\xd2\x3b\x61\x56\x79\x0a\x85\xe4\xe3\xca\xf6\x8e\x2e\x57\x9f\x7e\xaf\x5b\xda\xdf\xdf\xad\xb2\x53\x0d\x05\xbf\x03\x00\x95\x71\x60\x21\x86\x04\x00\x00")
+
+func migrations45_add_sponsors_to_state_tablesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations45_add_sponsors_to_state_tablesSql,
+		"migrations/45_add_sponsors_to_state_tables.sql",
+	)
+}
+
+func migrations45_add_sponsors_to_state_tablesSql() (*asset, error) {
+	bytes, err := migrations45_add_sponsors_to_state_tablesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/45_add_sponsors_to_state_tables.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe0, 0xce, 0xff, 0xb3, 0x12, 0xf5, 0x48, 0x96, 0xa0, 0x54, 0x56, 0xd5, 0xb8, 0xa, 0x28, 0x12, 0x55, 0xb9, 0x4b, 0x25, 0x8e, 0x50, 0x9c, 0xc5, 0x1c, 0xc8, 0xfd, 0xbf, 0x8f, 0x33, 0xb5, 0xac}}
+	return a, nil
+}
+
 var _migrations4_add_protocol_versionSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xcd\xb1\x0a\xc2\x30\x10\x06\xe0\x3d\x4f\xf1\xef\x52\x70\xef\x14\x4d\x9d\xce\x44\x4a\x32\x38\x15\xd1\xa3\x06\x6a\xae\x5c\x82\xe2\xdb\xbb\xba\x88\x4f\xf0\x75\x1d\x36\x8f\x3c\xeb\xa5\x31\xd2\x6a\x2c\xc5\x61\x44\xb4\x3b\x1a\x10\x3c\x9d\x71\xcf\xb5\x89\xbe\xa7\x85\x6f\x33\x6b\x85\x01\xac\x73\xd8\x07\x4a\x47\x8f\x55\xa5\xc9\x55\x96\xe9\xc9\x5a\xb3\x14\xe4\xd2\x78\x66\x85\x1b\x0e\x36\x51\xc4\x16\x3e\x44\xf8\x44\xd4\x1b\xf3\x6d\x39\x79\x95\xff\x9a\x1b\xc3\xe9\x97\xd5\x9b\x4f\x00\x00\x00\xff\xff\x83\xbb\x30\x2e\xbc\x00\x00\x00")
 
 func migrations4_add_protocol_versionSqlBytes() ([]byte, error) {
@@ -1054,6 +1159,11 @@ var _bindata = map[string]func() (*asset, error){
 	"migrations/39_history_trades_indices.sql":                migrations39_history_trades_indicesSql,
 	"migrations/3_use_sequence_in_history_accounts.sql":       migrations3_use_sequence_in_history_accountsSql,
 	"migrations/40_fix_inner_tx_max_fee_constraint.sql":       migrations40_fix_inner_tx_max_fee_constraintSql,
+	"migrations/41_webhooks.sql":                              migrations41_webhooksSql,
+	"migrations/42_operation_search_indices.sql":              migrations42_operation_search_indicesSql,
+	"migrations/43_claimable_balances.sql":                    migrations43_claimable_balancesSql,
+	"migrations/44_api_keys.sql":                              migrations44_api_keysSql,
+	"migrations/45_add_sponsors_to_state_tables.sql":          migrations45_add_sponsors_to_state_tablesSql,
 	"migrations/4_add_protocol_version.sql":                   migrations4_add_protocol_versionSql,
 	"migrations/5_create_trades_table.sql":                    migrations5_create_trades_tableSql,
 	"migrations/6_create_assets_table.sql":                    migrations6_create_assets_tableSql,
@@ -1139,6 +1249,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"39_history_trades_indices.sql":                &bintree{migrations39_history_trades_indicesSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql":       &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
 		"40_fix_inner_tx_max_fee_constraint.sql":       &bintree{migrations40_fix_inner_tx_max_fee_constraintSql, map[string]*bintree{}},
+		"41_webhooks.sql":                              &bintree{migrations41_webhooksSql, map[string]*bintree{}},
+		"42_operation_search_indices.sql":              &bintree{migrations42_operation_search_indicesSql, map[string]*bintree{}},
+		"43_claimable_balances.sql":                    &bintree{migrations43_claimable_balancesSql, map[string]*bintree{}},
+		"44_api_keys.sql":                              &bintree{migrations44_api_keysSql, map[string]*bintree{}},
+		"45_add_sponsors_to_state_tables.sql":          &bintree{migrations45_add_sponsors_to_state_tablesSql, map[string]*bintree{}},
 		"4_add_protocol_version.sql":                   &bintree{migrations4_add_protocol_versionSql, map[string]*bintree{}},
 		"5_create_trades_table.sql":                    &bintree{migrations5_create_trades_tableSql, map[string]*bintree{}},
 		"6_create_assets_table.sql":                    &bintree{migrations6_create_assets_tableSql, map[string]*bintree{}},
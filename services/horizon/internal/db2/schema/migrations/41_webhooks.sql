@@ -0,0 +1,44 @@
+-- +migrate Up
+
+CREATE TABLE webhooks (
+    id bigserial PRIMARY KEY,
+    url text NOT NULL,
+    secret text NOT NULL,
+    account_id character varying(56),
+    operation_type integer,
+    asset text,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    webhook_id bigint NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    ledger_sequence integer NOT NULL,
+    payload jsonb NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp without time zone NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE INDEX webhook_deliveries_next_attempt_at ON webhook_deliveries USING BTREE(next_attempt_at);
+CREATE INDEX webhook_deliveries_webhook_id ON webhook_deliveries USING BTREE(webhook_id);
+
+CREATE TABLE webhook_dead_letters (
+    id bigint PRIMARY KEY,
+    webhook_id bigint NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    ledger_sequence integer NOT NULL,
+    payload jsonb NOT NULL,
+    attempts integer NOT NULL,
+    last_error text,
+    created_at timestamp without time zone NOT NULL,
+    failed_at timestamp without time zone NOT NULL
+);
+
+CREATE INDEX webhook_dead_letters_webhook_id ON webhook_dead_letters USING BTREE(webhook_id);
+
+-- +migrate Down
+
+DROP TABLE webhook_dead_letters cascade;
+DROP TABLE webhook_deliveries cascade;
+DROP TABLE webhooks cascade;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+CREATE INDEX htrx_by_memo ON history_transactions USING btree (memo_type, memo) WHERE memo IS NOT NULL;
+CREATE INDEX hop_by_payment_asset ON history_operations USING btree (((details ->> 'asset_type'::text)), ((details ->> 'asset_code'::text)), ((details ->> 'asset_issuer'::text)), id) WHERE (type = ANY (ARRAY[1, 2, 13]));
+
+-- +migrate Down
+
+DROP INDEX htrx_by_memo;
+DROP INDEX hop_by_payment_asset;
@@ -0,0 +1,14 @@
+-- +migrate Up
+
+CREATE TABLE api_keys (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    key_hash character(64) NOT NULL UNIQUE,
+    per_hour_rate_limit integer NOT NULL,
+    max_burst integer NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+-- +migrate Down
+
+DROP TABLE api_keys cascade;
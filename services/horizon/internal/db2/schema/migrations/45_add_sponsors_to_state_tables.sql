@@ -0,0 +1,28 @@
+-- +migrate Up
+
+ALTER TABLE accounts ADD sponsor character varying(56);
+ALTER TABLE accounts ADD num_sponsored integer NOT NULL DEFAULT 0 CHECK (num_sponsored >= 0);
+ALTER TABLE accounts ADD num_sponsoring integer NOT NULL DEFAULT 0 CHECK (num_sponsoring >= 0);
+CREATE INDEX accounts_by_sponsor ON accounts USING BTREE(sponsor);
+
+ALTER TABLE accounts_data ADD sponsor character varying(56);
+CREATE INDEX accounts_data_by_sponsor ON accounts_data USING BTREE(sponsor);
+
+ALTER TABLE accounts_signers ADD sponsor character varying(56);
+CREATE INDEX accounts_signers_by_sponsor ON accounts_signers USING BTREE(sponsor);
+
+ALTER TABLE trust_lines ADD sponsor character varying(56);
+CREATE INDEX trust_lines_by_sponsor ON trust_lines USING BTREE(sponsor);
+
+ALTER TABLE offers ADD sponsor character varying(56);
+CREATE INDEX offers_by_sponsor ON offers USING BTREE(sponsor);
+
+-- +migrate Down
+
+ALTER TABLE accounts DROP sponsor;
+ALTER TABLE accounts DROP num_sponsored;
+ALTER TABLE accounts DROP num_sponsoring;
+ALTER TABLE accounts_data DROP sponsor;
+ALTER TABLE accounts_signers DROP sponsor;
+ALTER TABLE trust_lines DROP sponsor;
+ALTER TABLE offers DROP sponsor;
@@ -447,6 +447,12 @@ func (r resumeState) run(s *system) (transition, error) {
 		return retryResume(r), errors.Wrap(err, "Error running processors on ledger")
 	}
 
+	// Deliveries are enqueued in the ingestion transaction so that they are
+	// committed along with the ledger.
+	if err = s.webhooks.EnqueueLedger(ingestLedger); err != nil {
+		return retryResume(r), errors.Wrap(err, "Error enqueuing webhook deliveries")
+	}
+
 	if err = s.completeIngestion(ingestLedger); err != nil {
 		return retryResume(r), err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/stellar/go/exp/ingest/ledgerbackend"
 	"github.com/stellar/go/historyarchive"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/webhooks"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
 	logpkg "github.com/stellar/go/support/log"
@@ -41,7 +42,10 @@ const (
 	//      trustlines.
 	// - 10: Fixes a bug in meta processing (fees are now processed before
 	//      everything else).
-	CurrentVersion = 10
+	// - 11: Added claimable balances.
+	// - 12: Added sponsors of accounts, account data, offers, signers and
+	//      trust lines, and sponsorship effects.
+	CurrentVersion = 12
 
 	// MaxDBConnections is the size of the postgres connection pool dedicated to Horizon ingestion:
 	//  * Ledger ingestion,
@@ -98,6 +102,11 @@ type Metrics struct {
 	StateInvalidGauge prometheus.GaugeFunc
 }
 
+// webhookEnqueuer enqueues the webhook deliveries of an ingested ledger.
+type webhookEnqueuer interface {
+	EnqueueLedger(sequence uint32) error
+}
+
 type System interface {
 	Run()
 	Metrics() Metrics
@@ -116,6 +125,7 @@ type system struct {
 
 	historyQ history.IngestionQ
 	runner   ProcessorRunnerInterface
+	webhooks webhookEnqueuer
 
 	ledgerBackend  ledgerbackend.LedgerBackend
 	historyAdapter adapters.HistoryArchiveAdapterInterface
@@ -203,6 +213,7 @@ func NewSystem(config Config) (System, error) {
 			historyAdapter: historyAdapter,
 			ledgerBackend:  ledgerBackend,
 		},
+		webhooks: webhooks.NewEnqueuer(historyQ),
 	}
 
 	system.initMetrics()
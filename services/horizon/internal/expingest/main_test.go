@@ -231,6 +231,7 @@ type mockDBQ struct {
 
 	history.MockQAccounts
 	history.MockQAssetStats
+	history.MockQClaimableBalances
 	history.MockQData
 	history.MockQEffects
 	history.MockQLedgers
@@ -385,6 +386,15 @@ func (m *mockLedgerBackend) Close() error {
 	return args.Error(0)
 }
 
+type mockWebhookEnqueuer struct {
+	mock.Mock
+}
+
+func (m *mockWebhookEnqueuer) EnqueueLedger(sequence uint32) error {
+	args := m.Called(sequence)
+	return args.Error(0)
+}
+
 type mockProcessorsRunner struct {
 	mock.Mock
 }
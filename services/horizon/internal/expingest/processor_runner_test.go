@@ -206,6 +206,7 @@ func TestProcessorRunnerBuildChangeProcessor(t *testing.T) {
 	assert.True(t, reflect.ValueOf(processor.(groupChangeProcessors)[5]).
 		Elem().FieldByName("useLedgerEntryCache").Bool())
 	assert.IsType(t, &processors.TrustLinesProcessor{}, processor.(groupChangeProcessors)[6])
+	assert.IsType(t, &processors.ClaimableBalancesProcessor{}, processor.(groupChangeProcessors)[7])
 
 	runner = ProcessorRunner{
 		historyQ: q,
@@ -225,6 +226,7 @@ func TestProcessorRunnerBuildChangeProcessor(t *testing.T) {
 	assert.False(t, reflect.ValueOf(processor.(groupChangeProcessors)[5]).
 		Elem().FieldByName("useLedgerEntryCache").Bool())
 	assert.IsType(t, &processors.TrustLinesProcessor{}, processor.(groupChangeProcessors)[6])
+	assert.IsType(t, &processors.ClaimableBalancesProcessor{}, processor.(groupChangeProcessors)[7])
 }
 
 func TestProcessorRunnerBuildTransactionProcessor(t *testing.T) {
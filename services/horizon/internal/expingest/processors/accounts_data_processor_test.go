@@ -51,7 +51,16 @@ func (s *AccountsDataProcessorTestSuiteState) TestCreatesAccounts() {
 		DataValue: []byte{1, 1, 1, 1},
 	}
 	lastModifiedLedgerSeq := xdr.Uint32(123)
-	s.mockBatchInsertBuilder.On("Add", data, lastModifiedLedgerSeq).Return(nil).Once()
+	s.mockBatchInsertBuilder.On(
+		"Add",
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeData,
+				Data: &data,
+			},
+		},
+	).Return(nil).Once()
 
 	err := s.processor.ProcessChange(io.Change{
 		Type: xdr.LedgerEntryTypeData,
@@ -148,8 +157,13 @@ func (s *AccountsDataProcessorTestSuiteLedger) TestNewAccountData() {
 	// We use LedgerEntryChangesCache so all changes are squashed
 	s.mockBatchInsertBuilder.On(
 		"Add",
-		updatedData,
-		lastModifiedLedgerSeq,
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeData,
+				Data: &updatedData,
+			},
+		},
 	).Return(nil).Once()
 }
 
@@ -188,8 +202,13 @@ func (s *AccountsDataProcessorTestSuiteLedger) TestUpdateAccountData() {
 
 	s.mockQ.On(
 		"UpdateAccountData",
-		updatedData,
-		lastModifiedLedgerSeq,
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeData,
+				Data: &updatedData,
+			},
+		},
 	).Return(int64(1), nil).Once()
 }
 
@@ -0,0 +1,90 @@
+package processors
+
+import (
+	ingesterrors "github.com/stellar/go/exp/ingest/errors"
+	"github.com/stellar/go/exp/ingest/io"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+type ClaimableBalancesProcessor struct {
+	qClaimableBalances history.QClaimableBalances
+
+	cache *io.LedgerEntryChangeCache
+}
+
+func NewClaimableBalancesProcessor(Q history.QClaimableBalances) *ClaimableBalancesProcessor {
+	p := &ClaimableBalancesProcessor{qClaimableBalances: Q}
+	p.reset()
+	return p
+}
+
+func (p *ClaimableBalancesProcessor) reset() {
+	p.cache = io.NewLedgerEntryChangeCache()
+}
+
+func (p *ClaimableBalancesProcessor) ProcessChange(change io.Change) error {
+	if change.Type != xdr.LedgerEntryTypeClaimableBalance {
+		return nil
+	}
+
+	err := p.cache.AddChange(change)
+	if err != nil {
+		return errors.Wrap(err, "error adding to ledgerCache")
+	}
+
+	if p.cache.Size() > maxBatchSize {
+		err = p.Commit()
+		if err != nil {
+			return errors.Wrap(err, "error in Commit")
+		}
+		p.reset()
+	}
+
+	return nil
+}
+
+func (p *ClaimableBalancesProcessor) Commit() error {
+	batchUpsertClaimableBalances := []xdr.LedgerEntry{}
+
+	changes := p.cache.GetChanges()
+	for _, change := range changes {
+		switch {
+		case change.Post != nil:
+			// Created and updated
+			batchUpsertClaimableBalances = append(batchUpsertClaimableBalances, *change.Post)
+		case change.Pre != nil && change.Post == nil:
+			// Removed
+			cBalance := change.Pre.Data.MustClaimableBalance()
+			rowsAffected, err := p.qClaimableBalances.RemoveClaimableBalance(cBalance)
+			if err != nil {
+				return err
+			}
+
+			if rowsAffected != 1 {
+				id, err := xdr.MarshalHex(cBalance.BalanceId)
+				if err != nil {
+					return err
+				}
+				return ingesterrors.NewStateError(errors.Errorf(
+					"%d rows affected when removing claimable balance: %s",
+					rowsAffected,
+					id,
+				))
+			}
+		default:
+			return errors.New("Invalid io.Change: change.Pre == nil && change.Post == nil")
+		}
+	}
+
+	// Upsert claimable balances
+	if len(batchUpsertClaimableBalances) > 0 {
+		err := p.qClaimableBalances.UpsertClaimableBalances(batchUpsertClaimableBalances)
+		if err != nil {
+			return errors.Wrap(err, "errors in UpsertClaimableBalances")
+		}
+	}
+
+	return nil
+}
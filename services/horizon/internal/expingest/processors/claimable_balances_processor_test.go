@@ -0,0 +1,151 @@
+//lint:file-ignore U1001 Ignore all unused code, staticcheck doesn't understand testify/suite
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	ingesterrors "github.com/stellar/go/exp/ingest/errors"
+	"github.com/stellar/go/exp/ingest/io"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/xdr"
+)
+
+func TestClaimableBalancesProcessorTestSuite(t *testing.T) {
+	suite.Run(t, new(ClaimableBalancesProcessorTestSuite))
+}
+
+type ClaimableBalancesProcessorTestSuite struct {
+	suite.Suite
+	processor *ClaimableBalancesProcessor
+	mockQ     *history.MockQClaimableBalances
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) SetupTest() {
+	s.mockQ = &history.MockQClaimableBalances{}
+	s.processor = NewClaimableBalancesProcessor(s.mockQ)
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) TearDownTest() {
+	s.mockQ.AssertExpectations(s.T())
+}
+
+func claimableBalanceEntry(amount xdr.Int64) xdr.ClaimableBalanceEntry {
+	return xdr.ClaimableBalanceEntry{
+		BalanceId: xdr.ClaimableBalanceId{
+			Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+			V0:   &xdr.Hash{1, 2, 3},
+		},
+		Claimants: []xdr.Claimant{
+			{
+				Type: xdr.ClaimantTypeClaimantTypeV0,
+				V0: &xdr.ClaimantV0{
+					Destination: xdr.MustAddress("GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"),
+					Predicate: xdr.ClaimPredicate{
+						Type: xdr.ClaimPredicateTypeClaimPredicateUnconditional,
+					},
+				},
+			},
+		},
+		Asset:  xdr.MustNewCreditAsset("EUR", trustLineIssuer.Address()),
+		Amount: amount,
+	}
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) TestCreateAndUpdateClaimableBalance() {
+	created := claimableBalanceEntry(10)
+	updated := claimableBalanceEntry(20)
+
+	s.Assert().NoError(s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeClaimableBalance,
+		Pre:  nil,
+		Post: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type:             xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &created,
+			},
+			LastModifiedLedgerSeq: 123,
+		},
+	}))
+	s.Assert().NoError(s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeClaimableBalance,
+		Pre: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type:             xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &created,
+			},
+			LastModifiedLedgerSeq: 123,
+		},
+		Post: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type:             xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &updated,
+			},
+			LastModifiedLedgerSeq: 124,
+		},
+	}))
+
+	// The changes are compacted into a single upsert
+	s.mockQ.On(
+		"UpsertClaimableBalances",
+		[]xdr.LedgerEntry{
+			{
+				LastModifiedLedgerSeq: 124,
+				Data: xdr.LedgerEntryData{
+					Type:             xdr.LedgerEntryTypeClaimableBalance,
+					ClaimableBalance: &updated,
+				},
+			},
+		},
+	).Return(nil).Once()
+	s.Assert().NoError(s.processor.Commit())
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) TestRemoveClaimableBalance() {
+	removed := claimableBalanceEntry(10)
+
+	s.Assert().NoError(s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeClaimableBalance,
+		Pre: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type:             xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &removed,
+			},
+			LastModifiedLedgerSeq: 123,
+		},
+		Post: nil,
+	}))
+
+	s.mockQ.On("RemoveClaimableBalance", removed).Return(int64(1), nil).Once()
+	s.Assert().NoError(s.processor.Commit())
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) TestRemoveMissingClaimableBalance() {
+	removed := claimableBalanceEntry(10)
+
+	s.Assert().NoError(s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeClaimableBalance,
+		Pre: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type:             xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &removed,
+			},
+			LastModifiedLedgerSeq: 123,
+		},
+		Post: nil,
+	}))
+
+	s.mockQ.On("RemoveClaimableBalance", removed).Return(int64(0), nil).Once()
+	err := s.processor.Commit()
+	s.Assert().IsType(ingesterrors.StateError{}, err)
+	s.Assert().EqualError(err, "0 rows affected when removing claimable balance: "+
+		"000000000102030000000000000000000000000000000000000000000000000000000000")
+}
+
+func (s *ClaimableBalancesProcessorTestSuite) TestIgnoresOtherEntries() {
+	s.Assert().NoError(s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeAccount,
+	}))
+	s.Assert().NoError(s.processor.Commit())
+}
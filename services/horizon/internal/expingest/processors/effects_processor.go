@@ -203,11 +203,196 @@ func (operation *transactionOperationWrapper) effects() (effects []effect, err e
 		effects, err = operation.manageDataEffects()
 	case xdr.OperationTypeBumpSequence:
 		effects, err = operation.bumpSequenceEffects()
+	case xdr.OperationTypeCreateClaimableBalance:
+		effects, err = operation.createClaimableBalanceEffects()
+	case xdr.OperationTypeClaimClaimableBalance:
+		effects, err = operation.claimClaimableBalanceEffects()
+	case xdr.OperationTypeBeginSponsoringFutureReserves,
+		xdr.OperationTypeEndSponsoringFutureReserves,
+		xdr.OperationTypeRevokeSponsorship:
+		// these operations only produce the sponsorship effects added below
+		effects = []effect{}
 	default:
 		return effects, fmt.Errorf("Unknown operation type: %s", op.Body.Type)
 	}
+	if err != nil {
+		return effects, err
+	}
+
+	wrapper := effectsWrapper{
+		effects:   effects,
+		operation: operation,
+	}
+	err = wrapper.addSponsorshipEffects()
+
+	return wrapper.effects, err
+}
+
+type sponsorshipEffectTypes struct {
+	created history.EffectType
+	updated history.EffectType
+	removed history.EffectType
+}
+
+// sponsoringEffectsTable maps ledger entry types to their sponsorship effects.
+// Offers are not included because no effects are produced when offers are
+// created either.
+var sponsoringEffectsTable = map[xdr.LedgerEntryType]sponsorshipEffectTypes{
+	xdr.LedgerEntryTypeAccount: {
+		created: history.EffectAccountSponsorshipCreated,
+		updated: history.EffectAccountSponsorshipUpdated,
+		removed: history.EffectAccountSponsorshipRemoved,
+	},
+	xdr.LedgerEntryTypeTrustline: {
+		created: history.EffectTrustlineSponsorshipCreated,
+		updated: history.EffectTrustlineSponsorshipUpdated,
+		removed: history.EffectTrustlineSponsorshipRemoved,
+	},
+	xdr.LedgerEntryTypeData: {
+		created: history.EffectDataSponsorshipCreated,
+		updated: history.EffectDataSponsorshipUpdated,
+		removed: history.EffectDataSponsorshipRemoved,
+	},
+	xdr.LedgerEntryTypeClaimableBalance: {
+		created: history.EffectClaimableBalanceSponsorshipCreated,
+		updated: history.EffectClaimableBalanceSponsorshipUpdated,
+		removed: history.EffectClaimableBalanceSponsorshipRemoved,
+	},
+}
+
+// addSponsorshipEffects adds an effect for every ledger entry and signer whose
+// sponsor was changed by the operation.
+func (e *effectsWrapper) addSponsorshipEffects() error {
+	changes, err := e.operation.transaction.GetOperationChanges(e.operation.index)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if err := e.addLedgerEntrySponsorshipEffects(change); err != nil {
+			return err
+		}
+		if change.Type == xdr.LedgerEntryTypeAccount {
+			e.addSignerSponsorshipEffects(change)
+		}
+	}
+
+	return nil
+}
+
+func (e *effectsWrapper) addLedgerEntrySponsorshipEffects(change io.Change) error {
+	effectTypes, found := sponsoringEffectsTable[change.Type]
+	if !found {
+		return nil
+	}
+
+	var formerSponsor, newSponsor string
+	if change.Pre != nil {
+		if sponsoringID := change.Pre.SponsoringID(); sponsoringID != nil {
+			formerSponsor = sponsoringID.Address()
+		}
+	}
+	if change.Post != nil {
+		if sponsoringID := change.Post.SponsoringID(); sponsoringID != nil {
+			newSponsor = sponsoringID.Address()
+		}
+	}
+	if formerSponsor == newSponsor {
+		return nil
+	}
+
+	var effectType history.EffectType
+	details := map[string]interface{}{}
+	switch {
+	case formerSponsor == "":
+		effectType = effectTypes.created
+		details["sponsor"] = newSponsor
+	case newSponsor == "":
+		effectType = effectTypes.removed
+		details["former_sponsor"] = formerSponsor
+	default:
+		effectType = effectTypes.updated
+		details["former_sponsor"] = formerSponsor
+		details["new_sponsor"] = newSponsor
+	}
+
+	entry := change.Pre
+	if change.Post != nil {
+		entry = change.Post
+	}
+
+	var account string
+	switch change.Type {
+	case xdr.LedgerEntryTypeAccount:
+		accountEntry := entry.Data.MustAccount()
+		account = accountEntry.AccountId.Address()
+	case xdr.LedgerEntryTypeTrustline:
+		trustLine := entry.Data.MustTrustLine()
+		account = trustLine.AccountId.Address()
+		details["asset"] = trustLine.Asset.StringCanonical()
+	case xdr.LedgerEntryTypeData:
+		dataEntry := entry.Data.MustData()
+		account = dataEntry.AccountId.Address()
+		details["data_name"] = string(dataEntry.DataName)
+	case xdr.LedgerEntryTypeClaimableBalance:
+		balanceID, err := xdr.MarshalHex(entry.Data.MustClaimableBalance().BalanceId)
+		if err != nil {
+			return errors.Wrap(err, "Invalid balanceId in claimable balance entry")
+		}
+		// claimable balances have no owner, so the effect belongs to the
+		// operation source account
+		account = e.operation.SourceAccount().Address()
+		details["balance_id"] = balanceID
+	}
+
+	e.add(account, effectType, details)
+	return nil
+}
+
+func (e *effectsWrapper) addSignerSponsorshipEffects(change io.Change) {
+	var account string
+	formerSponsors := map[string]xdr.AccountId{}
+	newSponsors := map[string]xdr.AccountId{}
+	if change.Pre != nil {
+		accountEntry := change.Pre.Data.MustAccount()
+		account = accountEntry.AccountId.Address()
+		formerSponsors = accountEntry.SponsorPerSigner()
+	}
+	if change.Post != nil {
+		accountEntry := change.Post.Data.MustAccount()
+		account = accountEntry.AccountId.Address()
+		newSponsors = accountEntry.SponsorPerSigner()
+	}
 
-	return effects, err
+	var signers []string
+	for signer := range formerSponsors {
+		signers = append(signers, signer)
+	}
+	for signer := range newSponsors {
+		if _, ok := formerSponsors[signer]; !ok {
+			signers = append(signers, signer)
+		}
+	}
+	sort.Strings(signers)
+
+	for _, signer := range signers {
+		formerSponsor, hadSponsor := formerSponsors[signer]
+		newSponsor, hasSponsor := newSponsors[signer]
+		details := map[string]interface{}{"signer": signer}
+
+		switch {
+		case !hadSponsor:
+			details["sponsor"] = newSponsor.Address()
+			e.add(account, history.EffectSignerSponsorshipCreated, details)
+		case !hasSponsor:
+			details["former_sponsor"] = formerSponsor.Address()
+			e.add(account, history.EffectSignerSponsorshipRemoved, details)
+		case !formerSponsor.Equals(newSponsor):
+			details["former_sponsor"] = formerSponsor.Address()
+			details["new_sponsor"] = newSponsor.Address()
+			e.add(account, history.EffectSignerSponsorshipUpdated, details)
+		}
+	}
 }
 
 func (operation *transactionOperationWrapper) accountCreatedEffects() []effect {
@@ -687,6 +872,100 @@ func (operation *transactionOperationWrapper) bumpSequenceEffects() ([]effect, e
 	return effects.effects, nil
 }
 
+func (operation *transactionOperationWrapper) createClaimableBalanceEffects() ([]effect, error) {
+	op := operation.operation.Body.MustCreateClaimableBalanceOp()
+	result := operation.OperationResult().MustCreateClaimableBalanceResult()
+	effects := effectsWrapper{
+		effects:   []effect{},
+		operation: operation,
+	}
+	source := operation.SourceAccount()
+
+	balanceID, err := xdr.MarshalHex(result.MustBalanceId())
+	if err != nil {
+		return effects.effects, errors.Wrap(err, "Invalid balanceId in op result")
+	}
+
+	details := map[string]interface{}{
+		"balance_id": balanceID,
+		"amount":     amount.String(op.Amount),
+		"asset":      op.Asset.StringCanonical(),
+	}
+	effects.add(source.Address(), history.EffectClaimableBalanceCreated, details)
+
+	for _, c := range op.Claimants {
+		cv0 := c.MustV0()
+		effects.add(
+			cv0.Destination.Address(),
+			history.EffectClaimableBalanceClaimantCreated,
+			map[string]interface{}{
+				"balance_id": balanceID,
+				"amount":     amount.String(op.Amount),
+				"asset":      op.Asset.StringCanonical(),
+				"predicate":  cv0.Predicate,
+			},
+		)
+	}
+
+	debitDetails := map[string]interface{}{"amount": amount.String(op.Amount)}
+	assetDetails(debitDetails, op.Asset, "")
+	effects.add(source.Address(), history.EffectAccountDebited, debitDetails)
+
+	return effects.effects, nil
+}
+
+func (operation *transactionOperationWrapper) claimClaimableBalanceEffects() ([]effect, error) {
+	op := operation.operation.Body.MustClaimClaimableBalanceOp()
+	effects := effectsWrapper{
+		effects:   []effect{},
+		operation: operation,
+	}
+	source := operation.SourceAccount()
+
+	balanceID, err := xdr.MarshalHex(op.BalanceId)
+	if err != nil {
+		return effects.effects, errors.Wrap(err, "Invalid balanceId in op")
+	}
+
+	changes, err := operation.transaction.GetOperationChanges(operation.index)
+	if err != nil {
+		return effects.effects, err
+	}
+
+	// the claimed balance is removed from the ledger, its amount and asset
+	// are only available in the state before the operation
+	var cBalance *xdr.ClaimableBalanceEntry
+	for _, change := range changes {
+		if change.Type != xdr.LedgerEntryTypeClaimableBalance || change.Pre == nil {
+			continue
+		}
+		entry := change.Pre.Data.MustClaimableBalance()
+		if entry.BalanceId.Equals(op.BalanceId) {
+			cBalance = &entry
+			break
+		}
+	}
+	if cBalance == nil {
+		return effects.effects, errors.Errorf("could not find claimable balance %s in the operation changes", balanceID)
+	}
+
+	effects.add(
+		source.Address(),
+		history.EffectClaimableBalanceClaimed,
+		map[string]interface{}{
+			"balance_id": balanceID,
+			"amount":     amount.String(cBalance.Amount),
+			"asset":      cBalance.Asset.StringCanonical(),
+		},
+	)
+
+	creditDetails := map[string]interface{}{"amount": amount.String(cBalance.Amount)}
+	assetDetails(creditDetails, cBalance.Asset, "")
+	effects.add(source.Address(), history.EffectAccountCredited, creditDetails)
+
+	return effects.effects, nil
+}
+
 func effectFlagDetails(flagDetails map[string]interface{}, flagPtr *xdr.Uint32, setValue bool) {
 	if flagPtr != nil {
 		flags := xdr.AccountFlags(*flagPtr)
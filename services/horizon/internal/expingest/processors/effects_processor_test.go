@@ -1382,8 +1382,10 @@ func TestOperationEffectsAllowTrustAuthorizedToMaintainLiabilities(t *testing.T)
 	}
 
 	operation := transactionOperationWrapper{
-		index:          0,
-		transaction:    io.LedgerTransaction{},
+		index: 0,
+		transaction: io.LedgerTransaction{
+			Meta: createTransactionMeta([]xdr.OperationMeta{}),
+		},
 		operation:      op,
 		ledgerSequence: 1,
 	}
@@ -1407,3 +1409,355 @@ func TestOperationEffectsAllowTrustAuthorizedToMaintainLiabilities(t *testing.T)
 	}
 	tt.Equal(expected, effects)
 }
+
+func TestOperationEffectsClaimableBalances(t *testing.T) {
+	tt := assert.New(t)
+	source := xdr.MustAddress("GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD")
+	claimant := xdr.MustAddress("GDQNY3PBOJOKYZSRMK2S7LHHGWZIUISD4QORETLMXEWXBI7KFZZMKTL3")
+	asset := xdr.MustNewCreditAsset("COP", "GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD")
+	balanceID := xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &xdr.Hash{1, 2, 3},
+	}
+	hexBalanceID, err := xdr.MarshalHex(balanceID)
+	tt.NoError(err)
+	predicate := xdr.ClaimPredicate{
+		Type: xdr.ClaimPredicateTypeClaimPredicateUnconditional,
+	}
+
+	transaction := io.LedgerTransaction{
+		Result: xdr.TransactionResultPair{
+			Result: xdr.TransactionResult{
+				Result: xdr.TransactionResultResult{
+					Code: xdr.TransactionResultCodeTxSuccess,
+					Results: &[]xdr.OperationResult{
+						{
+							Code: xdr.OperationResultCodeOpInner,
+							Tr: &xdr.OperationResultTr{
+								Type: xdr.OperationTypeCreateClaimableBalance,
+								CreateClaimableBalanceResult: &xdr.CreateClaimableBalanceResult{
+									Code:      xdr.CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess,
+									BalanceId: &balanceID,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	// the balance is sponsored by the source account
+	createdEntry := xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeClaimableBalance,
+			ClaimableBalance: &xdr.ClaimableBalanceEntry{
+				BalanceId: balanceID,
+				Claimants: []xdr.Claimant{
+					{
+						Type: xdr.ClaimantTypeClaimantTypeV0,
+						V0: &xdr.ClaimantV0{
+							Destination: claimant,
+							Predicate:   predicate,
+						},
+					},
+				},
+				Asset:  asset,
+				Amount: 100000000,
+			},
+		},
+		Ext: xdr.LedgerEntryExt{
+			V: 1,
+			V1: &xdr.LedgerEntryExtensionV1{
+				SponsoringId: &source,
+			},
+		},
+	}
+	transaction.Meta = createTransactionMeta([]xdr.OperationMeta{
+		{
+			Changes: []xdr.LedgerEntryChange{
+				{
+					Type:    xdr.LedgerEntryChangeTypeLedgerEntryCreated,
+					Created: &createdEntry,
+				},
+			},
+		},
+	})
+	muxedSource := source.ToMuxedAccount()
+	operation := transactionOperationWrapper{
+		index:       0,
+		transaction: transaction,
+		operation: xdr.Operation{
+			SourceAccount: &muxedSource,
+			Body: xdr.OperationBody{
+				Type: xdr.OperationTypeCreateClaimableBalance,
+				CreateClaimableBalanceOp: &xdr.CreateClaimableBalanceOp{
+					Asset:  asset,
+					Amount: 100000000,
+					Claimants: []xdr.Claimant{
+						{
+							Type: xdr.ClaimantTypeClaimantTypeV0,
+							V0: &xdr.ClaimantV0{
+								Destination: claimant,
+								Predicate:   predicate,
+							},
+						},
+					},
+				},
+			},
+		},
+		ledgerSequence: 1,
+	}
+
+	effects, err := operation.effects()
+	tt.NoError(err)
+	tt.Equal([]effect{
+		{
+			address:     source.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"balance_id": hexBalanceID,
+				"amount":     "10.0000000",
+				"asset":      "COP:GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD",
+			},
+			effectType: history.EffectClaimableBalanceCreated,
+			order:      uint32(1),
+		},
+		{
+			address:     claimant.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"balance_id": hexBalanceID,
+				"amount":     "10.0000000",
+				"asset":      "COP:GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD",
+				"predicate":  predicate,
+			},
+			effectType: history.EffectClaimableBalanceClaimantCreated,
+			order:      uint32(2),
+		},
+		{
+			address:     source.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"amount":       "10.0000000",
+				"asset_code":   "COP",
+				"asset_issuer": "GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD",
+				"asset_type":   "credit_alphanum4",
+			},
+			effectType: history.EffectAccountDebited,
+			order:      uint32(3),
+		},
+		{
+			address:     source.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"balance_id": hexBalanceID,
+				"sponsor":    source.Address(),
+			},
+			effectType: history.EffectClaimableBalanceSponsorshipCreated,
+			order:      uint32(4),
+		},
+	}, effects)
+
+	// the claimed balance is read from the state before the operation
+	claimedEntry := createdEntry
+	balanceKey := xdr.LedgerKey{}
+	tt.NoError(balanceKey.SetClaimableBalance(balanceID))
+	operation.transaction.Meta = createTransactionMeta([]xdr.OperationMeta{
+		{
+			Changes: []xdr.LedgerEntryChange{
+				{
+					Type:  xdr.LedgerEntryChangeTypeLedgerEntryState,
+					State: &claimedEntry,
+				},
+				{
+					Type:    xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+					Removed: &balanceKey,
+				},
+			},
+		},
+	})
+	muxedClaimant := claimant.ToMuxedAccount()
+	operation.operation = xdr.Operation{
+		SourceAccount: &muxedClaimant,
+		Body: xdr.OperationBody{
+			Type: xdr.OperationTypeClaimClaimableBalance,
+			ClaimClaimableBalanceOp: &xdr.ClaimClaimableBalanceOp{
+				BalanceId: balanceID,
+			},
+		},
+	}
+
+	effects, err = operation.effects()
+	tt.NoError(err)
+	tt.Equal([]effect{
+		{
+			address:     claimant.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"balance_id": hexBalanceID,
+				"amount":     "10.0000000",
+				"asset":      "COP:GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD",
+			},
+			effectType: history.EffectClaimableBalanceClaimed,
+			order:      uint32(1),
+		},
+		{
+			address:     claimant.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"amount":       "10.0000000",
+				"asset_code":   "COP",
+				"asset_issuer": "GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD",
+				"asset_type":   "credit_alphanum4",
+			},
+			effectType: history.EffectAccountCredited,
+			order:      uint32(2),
+		},
+		{
+			address:     claimant.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"balance_id":     hexBalanceID,
+				"former_sponsor": source.Address(),
+			},
+			effectType: history.EffectClaimableBalanceSponsorshipRemoved,
+			order:      uint32(3),
+		},
+	}, effects)
+}
+
+func TestOperationEffectsRevokeSponsorship(t *testing.T) {
+	tt := assert.New(t)
+	source := xdr.MustAddress("GDRW375MAYR46ODGF2WGANQC2RRZL7O246DYHHCGWTV2RE7IHE2QUQLD")
+	newSponsor := xdr.MustAddress("GDQNY3PBOJOKYZSRMK2S7LHHGWZIUISD4QORETLMXEWXBI7KFZZMKTL3")
+	account := xdr.MustAddress("GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV")
+	signerKey := xdr.MustSigner("GCCCU34WDY2RATQTOOQKY6SZWU6J5DONY42SWGW2CIXGW4LICAGNRZKX")
+
+	accountEntry := func(sponsor *xdr.AccountId) *xdr.LedgerEntry {
+		return &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: account,
+					Signers:   []xdr.Signer{{Key: signerKey, Weight: 1}},
+					Ext: xdr.AccountEntryExt{
+						V: 1,
+						V1: &xdr.AccountEntryExtensionV1{
+							Ext: xdr.AccountEntryExtensionV1Ext{
+								V: 2,
+								V2: &xdr.AccountEntryExtensionV2{
+									NumSponsored:        1,
+									SignerSponsoringIDs: []xdr.SponsorshipDescriptor{sponsor},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	muxedSource := source.ToMuxedAccount()
+	operation := transactionOperationWrapper{
+		index: 0,
+		transaction: io.LedgerTransaction{
+			Meta: createTransactionMeta([]xdr.OperationMeta{
+				{
+					Changes: []xdr.LedgerEntryChange{
+						{
+							Type:  xdr.LedgerEntryChangeTypeLedgerEntryState,
+							State: accountEntry(&source),
+						},
+						{
+							Type:    xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+							Updated: accountEntry(&newSponsor),
+						},
+					},
+				},
+			}),
+		},
+		operation: xdr.Operation{
+			SourceAccount: &muxedSource,
+			Body: xdr.OperationBody{
+				Type: xdr.OperationTypeRevokeSponsorship,
+				RevokeSponsorshipOp: &xdr.RevokeSponsorshipOp{
+					Type: xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner,
+					Signer: &xdr.RevokeSponsorshipOpSigner{
+						AccountId: account,
+						SignerKey: signerKey,
+					},
+				},
+			},
+		},
+		ledgerSequence: 1,
+	}
+
+	effects, err := operation.effects()
+	tt.NoError(err)
+	tt.Equal([]effect{
+		{
+			address:     account.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"signer":         signerKey.Address(),
+				"former_sponsor": source.Address(),
+				"new_sponsor":    newSponsor.Address(),
+			},
+			effectType: history.EffectSignerSponsorshipUpdated,
+			order:      uint32(1),
+		},
+	}, effects)
+
+	trustLine := xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeTrustline,
+			TrustLine: &xdr.TrustLineEntry{
+				AccountId: account,
+				Asset:     xdr.MustNewCreditAsset("COP", source.Address()),
+			},
+		},
+		Ext: xdr.LedgerEntryExt{
+			V: 1,
+			V1: &xdr.LedgerEntryExtensionV1{
+				SponsoringId: &source,
+			},
+		},
+	}
+	unsponsoredTrustLine := trustLine
+	unsponsoredTrustLine.Ext = xdr.LedgerEntryExt{}
+	trustLineKey := trustLine.LedgerKey()
+
+	operation.transaction.Meta = createTransactionMeta([]xdr.OperationMeta{
+		{
+			Changes: []xdr.LedgerEntryChange{
+				{
+					Type:  xdr.LedgerEntryChangeTypeLedgerEntryState,
+					State: &trustLine,
+				},
+				{
+					Type:    xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+					Updated: &unsponsoredTrustLine,
+				},
+			},
+		},
+	})
+	operation.operation.Body.RevokeSponsorshipOp = &xdr.RevokeSponsorshipOp{
+		Type:      xdr.RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry,
+		LedgerKey: &trustLineKey,
+	}
+
+	effects, err = operation.effects()
+	tt.NoError(err)
+	tt.Equal([]effect{
+		{
+			address:     account.Address(),
+			operationID: 4294967297,
+			details: map[string]interface{}{
+				"asset":          "COP:" + source.Address(),
+				"former_sponsor": source.Address(),
+			},
+			effectType: history.EffectTrustlineSponsorshipRemoved,
+			order:      uint32(1),
+		},
+	}, effects)
+}
@@ -52,19 +52,20 @@ func (s *OffersProcessorTestSuiteState) TestCreateOffer() {
 		Price:    xdr.Price{1, 2},
 	}
 	lastModifiedLedgerSeq := xdr.Uint32(123)
+	entry := xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &offer,
+		},
+		LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+	}
 	s.mockBatchInsertBuilder.
-		On("Add", offer, lastModifiedLedgerSeq).Return(nil).Once()
+		On("Add", entry).Return(nil).Once()
 
 	err := s.processor.ProcessChange(io.Change{
 		Type: xdr.LedgerEntryTypeOffer,
 		Pre:  nil,
-		Post: &xdr.LedgerEntry{
-			Data: xdr.LedgerEntryData{
-				Type:  xdr.LedgerEntryTypeOffer,
-				Offer: &offer,
-			},
-			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
-		},
+		Post: &entry,
 	})
 	s.Assert().NoError(err)
 }
@@ -164,8 +165,13 @@ func (s *OffersProcessorTestSuiteLedger) setupInsertOffer() {
 	// We use LedgerEntryChangesCache so all changes are squashed
 	s.mockBatchInsertBuilder.On(
 		"Add",
-		updatedOffer,
-		lastModifiedLedgerSeq,
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &updatedOffer,
+			},
+		},
 	).Return(nil).Once()
 
 	s.mockBatchInsertBuilder.On("Exec").Return(nil).Once()
@@ -231,8 +237,13 @@ func (s *OffersProcessorTestSuiteLedger) TestUpdateOfferNoRowsAffected() {
 
 	s.mockQ.On(
 		"UpdateOffer",
-		updatedOffer,
-		lastModifiedLedgerSeq,
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &updatedOffer,
+			},
+		},
 	).Return(int64(0), nil).Once()
 
 	err = s.processor.Commit()
@@ -318,8 +329,13 @@ func (s *OffersProcessorTestSuiteLedger) TestProcessUpgradeChange() {
 	// We use LedgerEntryChangesCache so all changes are squashed
 	s.mockBatchInsertBuilder.On(
 		"Add",
-		updatedOffer,
-		lastModifiedLedgerSeq,
+		xdr.LedgerEntry{
+			LastModifiedLedgerSeq: lastModifiedLedgerSeq,
+			Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &updatedOffer,
+			},
+		},
 	).Return(nil).Once()
 
 	s.mockBatchInsertBuilder.On("Exec").Return(nil).Once()
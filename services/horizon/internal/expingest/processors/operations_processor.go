@@ -283,6 +283,46 @@ func (operation *transactionOperationWrapper) Details() map[string]interface{} {
 	case xdr.OperationTypeBumpSequence:
 		op := operation.operation.Body.MustBumpSequenceOp()
 		details["bump_to"] = fmt.Sprintf("%d", op.BumpTo)
+	case xdr.OperationTypeCreateClaimableBalance:
+		op := operation.operation.Body.MustCreateClaimableBalanceOp()
+		details["asset"] = op.Asset.StringCanonical()
+		details["amount"] = amount.String(op.Amount)
+		var claimants history.Claimants
+		for _, c := range op.Claimants {
+			cv0 := c.MustV0()
+			claimants = append(claimants, history.Claimant{
+				Destination: cv0.Destination.Address(),
+				Predicate:   cv0.Predicate,
+			})
+		}
+		details["claimants"] = claimants
+	case xdr.OperationTypeClaimClaimableBalance:
+		op := operation.operation.Body.MustClaimClaimableBalanceOp()
+		balanceID, err := xdr.MarshalHex(op.BalanceId)
+		if err != nil {
+			panic(fmt.Errorf("Invalid balanceId in op: %d", operation.index))
+		}
+		details["balance_id"] = balanceID
+		details["claimant"] = source.Address()
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		op := operation.operation.Body.MustBeginSponsoringFutureReservesOp()
+		details["sponsored_id"] = op.SponsoredId.Address()
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		beginSponsorshipOp := operation.findInitatingBeginSponsoringOp()
+		if beginSponsorshipOp != nil {
+			details["begin_sponsor"] = beginSponsorshipOp.SourceAccount().Address()
+		}
+	case xdr.OperationTypeRevokeSponsorship:
+		op := operation.operation.Body.MustRevokeSponsorshipOp()
+		switch op.Type {
+		case xdr.RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry:
+			if err := addLedgerKeyDetails(details, *op.LedgerKey); err != nil {
+				panic(err)
+			}
+		case xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner:
+			details["signer_account_id"] = op.Signer.AccountId.Address()
+			details["signer_key"] = op.Signer.SignerKey.Address()
+		}
 	default:
 		panic(fmt.Errorf("Unknown operation type: %s", operation.OperationType()))
 	}
@@ -290,6 +330,56 @@ func (operation *transactionOperationWrapper) Details() map[string]interface{} {
 	return details
 }
 
+// findInitatingBeginSponsoringOp returns the BeginSponsoringFutureReserves
+// operation which started the sponsorship ended by this
+// EndSponsoringFutureReserves operation, or nil if there is none.
+func (operation *transactionOperationWrapper) findInitatingBeginSponsoringOp() *transactionOperationWrapper {
+	if !operation.transaction.Result.Successful() {
+		// Failed transactions may not have a compliant sandwich structure
+		// we can rely on (e.g. invalid nesting or a Begin operation with the wrong sponsoree ID)
+		return nil
+	}
+
+	sponsoree := operation.SourceAccount()
+	ops := operation.transaction.Envelope.Operations()
+	for i := int(operation.index) - 1; i >= 0; i-- {
+		if beginOp, ok := ops[i].Body.GetBeginSponsoringFutureReservesOp(); ok &&
+			beginOp.SponsoredId.Equals(*sponsoree) {
+			return &transactionOperationWrapper{
+				index:          uint32(i),
+				transaction:    operation.transaction,
+				operation:      ops[i],
+				ledgerSequence: operation.ledgerSequence,
+			}
+		}
+	}
+	return nil
+}
+
+// addLedgerKeyDetails sets the details identifying the ledger entry of a
+// revoke_sponsorship operation.
+func addLedgerKeyDetails(result map[string]interface{}, ledgerKey xdr.LedgerKey) error {
+	switch ledgerKey.Type {
+	case xdr.LedgerEntryTypeAccount:
+		result["account_id"] = ledgerKey.Account.AccountId.Address()
+	case xdr.LedgerEntryTypeClaimableBalance:
+		marshalHex, err := xdr.MarshalHex(ledgerKey.ClaimableBalance.BalanceId)
+		if err != nil {
+			return errors.Wrapf(err, "in claimable balance")
+		}
+		result["claimable_balance_id"] = marshalHex
+	case xdr.LedgerEntryTypeData:
+		result["data_account_id"] = ledgerKey.Data.AccountId.Address()
+		result["data_name"] = string(ledgerKey.Data.DataName)
+	case xdr.LedgerEntryTypeOffer:
+		result["offer_id"] = fmt.Sprintf("%d", ledgerKey.Offer.OfferId)
+	case xdr.LedgerEntryTypeTrustline:
+		result["trustline_account_id"] = ledgerKey.TrustLine.AccountId.Address()
+		result["trustline_asset"] = ledgerKey.TrustLine.Asset.StringCanonical()
+	}
+	return nil
+}
+
 // assetDetails sets the details for `a` on `result` using keys with `prefix`
 func assetDetails(result map[string]interface{}, a xdr.Asset, prefix string) error {
 	var (
@@ -375,6 +465,21 @@ func (operation *transactionOperationWrapper) Participants() ([]xdr.AccountId, e
 		// the only direct participant is the source_account
 	case xdr.OperationTypeBumpSequence:
 		// the only direct participant is the source_account
+	case xdr.OperationTypeCreateClaimableBalance:
+		for _, c := range op.Body.MustCreateClaimableBalanceOp().Claimants {
+			participants = append(participants, c.MustV0().Destination)
+		}
+	case xdr.OperationTypeClaimClaimableBalance:
+		// the only direct participant is the source_account
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		participants = append(participants, op.Body.MustBeginSponsoringFutureReservesOp().SponsoredId)
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		beginSponsorshipOp := operation.findInitatingBeginSponsoringOp()
+		if beginSponsorshipOp != nil {
+			participants = append(participants, *beginSponsorshipOp.SourceAccount())
+		}
+	case xdr.OperationTypeRevokeSponsorship:
+		// the only direct participant is the source_account
 	default:
 		return participants, fmt.Errorf("Unknown operation type: %s", op.Body.Type)
 	}
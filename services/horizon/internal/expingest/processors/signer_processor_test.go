@@ -133,6 +133,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestNewAccount() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			int32(1),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -196,6 +197,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestNewSigner() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV",
 			int32(10),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -205,6 +207,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestNewSigner() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCAHY6JSXQFKWKP6R7U5JPXDVNV4DJWOWRFLY3Y6YPBF64QRL4BPFDNS",
 			int32(15),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -247,6 +250,74 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestNewSigner() {
 	s.Assert().NoError(s.processor.Commit())
 }
 
+func (s *AccountsSignerProcessorTestSuiteLedger) TestSignerSponsorChanged() {
+	sponsor := "GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A"
+	sponsorID := xdr.MustAddress(sponsor)
+
+	// Remove old signer
+	s.mockQ.
+		On(
+			"RemoveAccountSigner",
+			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
+			"GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV",
+		).
+		Return(int64(1), nil).Once()
+
+	// Create the signer with its new sponsor
+	s.mockQ.
+		On(
+			"CreateAccountSigner",
+			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
+			"GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV",
+			int32(10),
+			&sponsor,
+		).
+		Return(int64(1), nil).Once()
+
+	signers := []xdr.Signer{
+		{
+			Key:    xdr.MustSigner("GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV"),
+			Weight: 10,
+		},
+	}
+
+	err := s.processor.ProcessChange(io.Change{
+		Type: xdr.LedgerEntryTypeAccount,
+		Pre: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+					Signers:   signers,
+				},
+			},
+		},
+		Post: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+					Signers:   signers,
+					Ext: xdr.AccountEntryExt{
+						V: 1,
+						V1: &xdr.AccountEntryExtensionV1{
+							Ext: xdr.AccountEntryExtensionV1Ext{
+								V: 2,
+								V2: &xdr.AccountEntryExtensionV2{
+									NumSponsored:        1,
+									SignerSponsoringIDs: []xdr.SponsorshipDescriptor{&sponsorID},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	s.Assert().NoError(err)
+	s.Assert().NoError(s.processor.Commit())
+}
+
 func (s *AccountsSignerProcessorTestSuiteLedger) TestSignerRemoved() {
 	// Remove old signers
 	s.mockQ.
@@ -272,6 +343,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestSignerRemoved() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCAHY6JSXQFKWKP6R7U5JPXDVNV4DJWOWRFLY3Y6YPBF64QRL4BPFDNS",
 			int32(15),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -341,6 +413,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestSignerPreAuthTxRemovedTxFai
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV",
 			int32(10),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -416,6 +489,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestNewAccountNoRowsAffected()
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			int32(1),
+			(*string)(nil),
 		).
 		Return(int64(0), nil).Once()
 
@@ -498,6 +572,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestProcessUpgradeChange() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV",
 			int32(12),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -507,6 +582,7 @@ func (s *AccountsSignerProcessorTestSuiteLedger) TestProcessUpgradeChange() {
 			"GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML",
 			"GCAHY6JSXQFKWKP6R7U5JPXDVNV4DJWOWRFLY3Y6YPBF64QRL4BPFDNS",
 			int32(15),
+			(*string)(nil),
 		).
 		Return(int64(1), nil).Once()
 
@@ -1,6 +1,8 @@
 package processors
 
 import (
+	"github.com/guregu/null"
+
 	ingesterrors "github.com/stellar/go/exp/ingest/errors"
 	"github.com/stellar/go/exp/ingest/io"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
@@ -60,12 +62,19 @@ func (p *SignersProcessor) ProcessChange(change io.Change) error {
 
 	accountEntry := change.Post.Data.MustAccount()
 	account := accountEntry.AccountId.Address()
+	sponsors := accountEntry.SponsorPerSigner()
 
 	for signer, weight := range accountEntry.SignerSummary() {
+		var sponsor null.String
+		if sponsorDesc, isSponsored := sponsors[signer]; isSponsored {
+			sponsor = null.StringFrom(sponsorDesc.Address())
+		}
+
 		err := p.batch.Add(history.AccountSigner{
 			Account: account,
 			Signer:  signer,
 			Weight:  weight,
+			Sponsor: sponsor,
 		})
 		if err != nil {
 			return errors.Wrap(err, "Error adding row to accountSignerBatch")
@@ -109,8 +118,20 @@ func (p *SignersProcessor) Commit() error {
 
 		if change.Post != nil {
 			postAccountEntry := change.Post.Data.MustAccount()
+			sponsors := postAccountEntry.SponsorPerSigner()
 			for signer, weight := range postAccountEntry.SignerSummary() {
-				rowsAffected, err := p.signersQ.CreateAccountSigner(postAccountEntry.AccountId.Address(), signer, weight)
+				var sponsor *string
+				if sponsorDesc, isSponsored := sponsors[signer]; isSponsored {
+					s := sponsorDesc.Address()
+					sponsor = &s
+				}
+
+				rowsAffected, err := p.signersQ.CreateAccountSigner(
+					postAccountEntry.AccountId.Address(),
+					signer,
+					weight,
+					sponsor,
+				)
 				if err != nil {
 					return errors.Wrap(err, "Error inserting a signer")
 				}
@@ -25,6 +25,7 @@ type ResumeTestTestSuite struct {
 	historyAdapter    *adapters.MockHistoryArchiveAdapter
 	runner            *mockProcessorsRunner
 	stellarCoreClient *mockStellarCoreClient
+	webhooks          *mockWebhookEnqueuer
 	system            *system
 }
 
@@ -34,6 +35,7 @@ func (s *ResumeTestTestSuite) SetupTest() {
 	s.historyAdapter = &adapters.MockHistoryArchiveAdapter{}
 	s.runner = &mockProcessorsRunner{}
 	s.stellarCoreClient = &mockStellarCoreClient{}
+	s.webhooks = &mockWebhookEnqueuer{}
 	s.system = &system{
 		ctx:               context.Background(),
 		historyQ:          s.historyQ,
@@ -41,6 +43,7 @@ func (s *ResumeTestTestSuite) SetupTest() {
 		runner:            s.runner,
 		ledgerBackend:     s.ledgerBackend,
 		stellarCoreClient: s.stellarCoreClient,
+		webhooks:          s.webhooks,
 	}
 	s.system.initMetrics()
 
@@ -54,6 +57,7 @@ func (s *ResumeTestTestSuite) TearDownTest() {
 	s.historyAdapter.AssertExpectations(t)
 	s.ledgerBackend.AssertExpectations(t)
 	s.stellarCoreClient.AssertExpectations(t)
+	s.webhooks.AssertExpectations(t)
 }
 
 func (s *ResumeTestTestSuite) TestInvalidParam() {
@@ -277,6 +281,7 @@ func (s *ResumeTestTestSuite) mockSuccessfulIngestion() {
 	s.ledgerBackend.On("GetLatestLedgerSequence").Return(uint32(111), nil).Once()
 
 	s.runner.On("RunAllProcessorsOnLedger", uint32(102)).Return(io.StatsChangeProcessorResults{}, io.StatsLedgerTransactionProcessorResults{}, nil).Once()
+	s.webhooks.On("EnqueueLedger", uint32(102)).Return(nil).Once()
 	s.historyQ.On("UpdateLastLedgerExpIngest", uint32(102)).Return(nil).Once()
 	s.historyQ.On("Commit").Return(nil).Once()
 
@@ -331,6 +336,29 @@ func (s *ResumeTestTestSuite) TestIngestAllMasterNode() {
 	)
 }
 
+func (s *ResumeTestTestSuite) TestEnqueueWebhookDeliveriesError() {
+	s.historyQ.On("Begin").Return(nil).Once()
+	s.historyQ.On("GetLastLedgerExpIngest").Return(uint32(100), nil).Once()
+	s.historyQ.On("GetExpIngestVersion").Return(CurrentVersion, nil).Once()
+	s.historyQ.On("GetLatestLedger").Return(uint32(0), nil)
+
+	s.ledgerBackend.On("IsPrepared", ledgerbackend.UnboundedRange(101)).Return(true, nil).Once()
+	s.ledgerBackend.On("GetLatestLedgerSequence").Return(uint32(111), nil).Once()
+
+	s.runner.On("RunAllProcessorsOnLedger", uint32(101)).Return(io.StatsChangeProcessorResults{}, io.StatsLedgerTransactionProcessorResults{}, nil).Once()
+	s.webhooks.On("EnqueueLedger", uint32(101)).Return(errors.New("my error")).Once()
+
+	next, err := resumeState{latestSuccessfullyProcessedLedger: 100}.run(s.system)
+	s.Assert().EqualError(err, "Error enqueuing webhook deliveries: my error")
+	s.Assert().Equal(
+		transition{
+			node:          resumeState{latestSuccessfullyProcessedLedger: 100},
+			sleepDuration: defaultSleep,
+		},
+		next,
+	)
+}
+
 func (s *ResumeTestTestSuite) TestErrorSettingCursorIgnored() {
 	s.historyQ.On("Begin").Return(nil).Once()
 	s.historyQ.On("GetLastLedgerExpIngest").Return(uint32(100), nil).Once()
@@ -341,6 +369,7 @@ func (s *ResumeTestTestSuite) TestErrorSettingCursorIgnored() {
 	s.ledgerBackend.On("GetLatestLedgerSequence").Return(uint32(111), nil).Once()
 
 	s.runner.On("RunAllProcessorsOnLedger", uint32(101)).Return(io.StatsChangeProcessorResults{}, io.StatsLedgerTransactionProcessorResults{}, nil).Once()
+	s.webhooks.On("EnqueueLedger", uint32(101)).Return(nil).Once()
 	s.historyQ.On("UpdateLastLedgerExpIngest", uint32(101)).Return(nil).Once()
 	s.historyQ.On("Commit").Return(nil).Once()
 
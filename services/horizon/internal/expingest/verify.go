@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/guregu/null"
 	ingesterrors "github.com/stellar/go/exp/ingest/errors"
 	"github.com/stellar/go/exp/ingest/verify"
 	"github.com/stellar/go/historyarchive"
@@ -26,7 +27,7 @@ const assetStatsBatchSize = 500
 // check them.
 // There is a test that checks it, to fix it: update the actual `verifyState`
 // method instead of just updating this value!
-const stateVerifierExpectedIngestionVersion = 10
+const stateVerifierExpectedIngestionVersion = 12
 
 // verifyState is called as a go routine from pipeline post hook every 64
 // ledgers. It checks if the state is correct. If another go routine is already
@@ -149,6 +150,7 @@ func (s *system) verifyState(verifyAgainstLatestCheckpoint bool) error {
 		data := make([]xdr.LedgerKeyData, 0, verifyBatchSize)
 		offers := make([]int64, 0, verifyBatchSize)
 		trustLines := make([]xdr.LedgerKeyTrustLine, 0, verifyBatchSize)
+		cBalances := make([]xdr.ClaimableBalanceId, 0, verifyBatchSize)
 		for _, key := range keys {
 			switch key.Type {
 			case xdr.LedgerEntryTypeAccount:
@@ -159,6 +161,8 @@ func (s *system) verifyState(verifyAgainstLatestCheckpoint bool) error {
 				offers = append(offers, int64(key.Offer.OfferId))
 			case xdr.LedgerEntryTypeTrustline:
 				trustLines = append(trustLines, *key.TrustLine)
+			case xdr.LedgerEntryTypeClaimableBalance:
+				cBalances = append(cBalances, key.ClaimableBalance.BalanceId)
 			default:
 				return errors.New("GetLedgerKeys return unexpected type")
 			}
@@ -184,6 +188,11 @@ func (s *system) verifyState(verifyAgainstLatestCheckpoint bool) error {
 			return errors.Wrap(err, "addTrustLinesToStateVerifier failed")
 		}
 
+		err = addClaimableBalanceToStateVerifier(verifier, historyQ, cBalances)
+		if err != nil {
+			return errors.Wrap(err, "addClaimableBalanceToStateVerifier failed")
+		}
+
 		total += len(keys)
 		localLog.WithField("total", total).Info("Batch added to StateVerifier")
 	}
@@ -210,7 +219,12 @@ func (s *system) verifyState(verifyAgainstLatestCheckpoint bool) error {
 		return errors.Wrap(err, "Error running historyQ.CountTrustLines")
 	}
 
-	err = verifier.Verify(countAccounts + countData + countOffers + countTrustLines)
+	countClaimableBalances, err := historyQ.CountClaimableBalances()
+	if err != nil {
+		return errors.Wrap(err, "Error running historyQ.CountClaimableBalances")
+	}
+
+	err = verifier.Verify(countAccounts + countData + countOffers + countTrustLines + countClaimableBalances)
 	if err != nil {
 		return errors.Wrap(err, "verifier.Verify failed")
 	}
@@ -292,6 +306,8 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 
 	masterWeightMap := make(map[string]int32)
 	signersMap := make(map[string][]xdr.Signer)
+	// map[accountID]map[signerKey]sponsor
+	signerSponsorsMap := make(map[string]map[string]string)
 	for _, row := range signers {
 		if row.Account == row.Signer {
 			masterWeightMap[row.Account] = row.Weight
@@ -303,6 +319,12 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 					Weight: xdr.Uint32(row.Weight),
 				},
 			)
+			if row.Sponsor.Valid {
+				if signerSponsorsMap[row.Account] == nil {
+					signerSponsorsMap[row.Account] = make(map[string]string)
+				}
+				signerSponsorsMap[row.Account][row.Signer] = row.Sponsor.String
+			}
 		}
 	}
 
@@ -325,6 +347,7 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 			)
 		}
 
+		signers := xdr.SortSignersByKey(signersMap[row.AccountID])
 		account := &xdr.AccountEntry{
 			AccountId:     xdr.MustAddress(row.AccountID),
 			Balance:       xdr.Int64(row.Balance),
@@ -339,10 +362,10 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 				row.ThresholdMedium,
 				row.ThresholdHigh,
 			},
-			Signers: xdr.SortSignersByKey(signersMap[row.AccountID]),
+			Signers: signers,
 			Ext: xdr.AccountEntryExt{
 				V: 1,
-				V1: &xdr.AccountEntryV1{
+				V1: &xdr.AccountEntryExtensionV1{
 					Liabilities: xdr.Liabilities{
 						Buying:  xdr.Int64(row.BuyingLiabilities),
 						Selling: xdr.Int64(row.SellingLiabilities),
@@ -351,6 +374,24 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 			},
 		}
 
+		sponsorsPerSigner := signerSponsorsMap[row.AccountID]
+		if row.NumSponsored != 0 || row.NumSponsoring != 0 || len(sponsorsPerSigner) > 0 {
+			signerSponsoringIDs := make([]xdr.SponsorshipDescriptor, len(signers))
+			for i, signer := range signers {
+				if sponsor, ok := sponsorsPerSigner[signer.Key.Address()]; ok {
+					signerSponsoringIDs[i] = xdr.MustAddressPtr(sponsor)
+				}
+			}
+			account.Ext.V1.Ext = xdr.AccountEntryExtensionV1Ext{
+				V: 2,
+				V2: &xdr.AccountEntryExtensionV2{
+					NumSponsored:        xdr.Uint32(row.NumSponsored),
+					NumSponsoring:       xdr.Uint32(row.NumSponsoring),
+					SignerSponsoringIDs: signerSponsoringIDs,
+				},
+			}
+		}
+
 		entry := xdr.LedgerEntry{
 			LastModifiedLedgerSeq: xdr.Uint32(row.LastModifiedLedger),
 			Data: xdr.LedgerEntryData{
@@ -358,6 +399,7 @@ func addAccountsToStateVerifier(verifier *verify.StateVerifier, q history.Ingest
 				Account: account,
 			},
 		}
+		addLedgerEntrySponsor(&entry, row.Sponsor)
 
 		err = verifier.Write(entry)
 		if err != nil {
@@ -390,6 +432,7 @@ func addDataToStateVerifier(verifier *verify.StateVerifier, q history.IngestionQ
 				},
 			},
 		}
+		addLedgerEntrySponsor(&entry, row.Sponsor)
 		err := verifier.Write(entry)
 		if err != nil {
 			return err
@@ -432,6 +475,7 @@ func addOffersToStateVerifier(
 				},
 			},
 		}
+		addLedgerEntrySponsor(&entry, row.Sponsor)
 
 		err := verifier.Write(entry)
 		if err != nil {
@@ -482,6 +526,7 @@ func addTrustLinesToStateVerifier(
 				TrustLine: &trustline,
 			},
 		}
+		addLedgerEntrySponsor(&entry, row.Sponsor)
 		if err := verifier.Write(entry); err != nil {
 			return err
 		}
@@ -495,17 +540,108 @@ func addTrustLinesToStateVerifier(
 	return nil
 }
 
+func addClaimableBalanceToStateVerifier(
+	verifier *verify.StateVerifier,
+	q history.IngestionQ,
+	ids []xdr.ClaimableBalanceId,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	cBalances, err := q.GetClaimableBalancesByID(ids)
+	if err != nil {
+		return errors.Wrap(err, "Error running history.Q.GetClaimableBalancesByID")
+	}
+
+	for _, row := range cBalances {
+		var balanceID xdr.ClaimableBalanceId
+		if err := xdr.SafeUnmarshalHex(row.BalanceID, &balanceID); err != nil {
+			return err
+		}
+
+		claimants := make([]xdr.Claimant, 0, len(row.Claimants))
+		for _, claimant := range row.Claimants {
+			claimants = append(claimants, xdr.Claimant{
+				Type: xdr.ClaimantTypeClaimantTypeV0,
+				V0: &xdr.ClaimantV0{
+					Destination: xdr.MustAddress(claimant.Destination),
+					Predicate:   claimant.Predicate,
+				},
+			})
+		}
+
+		entry := xdr.LedgerEntry{
+			LastModifiedLedgerSeq: xdr.Uint32(row.LastModifiedLedger),
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeClaimableBalance,
+				ClaimableBalance: &xdr.ClaimableBalanceEntry{
+					BalanceId: balanceID,
+					Claimants: claimants,
+					Asset:     row.Asset,
+					Amount:    row.Amount,
+				},
+			},
+		}
+		addLedgerEntrySponsor(&entry, row.Sponsor)
+
+		if err := verifier.Write(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addLedgerEntrySponsor(entry *xdr.LedgerEntry, sponsor null.String) {
+	if !sponsor.Valid {
+		return
+	}
+
+	entry.Ext = xdr.LedgerEntryExt{
+		V: 1,
+		V1: &xdr.LedgerEntryExtensionV1{
+			SponsoringId: xdr.MustAddressPtr(sponsor.String),
+		},
+	}
+}
+
 func transformEntry(entry xdr.LedgerEntry) (bool, xdr.LedgerEntry) {
+	// Entries can have ext=1 without a sponsor. For those, drop the
+	// extension.
+	if entry.SponsoringID() == nil {
+		entry.Ext = xdr.LedgerEntryExt{}
+	}
+
 	switch entry.Data.Type {
 	case xdr.LedgerEntryTypeAccount:
 		accountEntry := entry.Data.Account
+		sponsorsPerSigner := accountEntry.SponsorPerSigner()
 		// Sort signers
 		accountEntry.Signers = xdr.SortSignersByKey(accountEntry.Signers)
+		// Account can have ext=2 without any sponsorships. For those, drop
+		// ext=2. Otherwise sort the signer sponsoring IDs along the signers.
+		if v1, ok := accountEntry.Ext.GetV1(); ok {
+			if v2, ok := v1.Ext.GetV2(); ok {
+				if v2.NumSponsored == 0 && v2.NumSponsoring == 0 && len(sponsorsPerSigner) == 0 {
+					accountEntry.Ext.V1.Ext = xdr.AccountEntryExtensionV1Ext{}
+				} else {
+					signerSponsoringIDs := make([]xdr.SponsorshipDescriptor, len(accountEntry.Signers))
+					for i, signer := range accountEntry.Signers {
+						if sponsor, ok := sponsorsPerSigner[signer.Key.Address()]; ok {
+							sponsor := sponsor
+							signerSponsoringIDs[i] = &sponsor
+						}
+					}
+					accountEntry.Ext.V1.Ext.V2.SignerSponsoringIDs = signerSponsoringIDs
+				}
+			}
+		}
 		// Account can have ext=0. For those, create ext=1
 		// with 0 liabilities.
 		if accountEntry.Ext.V == 0 {
 			accountEntry.Ext.V = 1
-			accountEntry.Ext.V1 = &xdr.AccountEntryV1{
+			accountEntry.Ext.V1 = &xdr.AccountEntryExtensionV1{
 				Liabilities: xdr.Liabilities{
 					Buying:  0,
 					Selling: 0,
@@ -535,6 +671,9 @@ func transformEntry(entry xdr.LedgerEntry) (bool, xdr.LedgerEntry) {
 	case xdr.LedgerEntryTypeData:
 		// Full check of data object
 		return false, entry
+	case xdr.LedgerEntryTypeClaimableBalance:
+		// Full check of claimable balance object
+		return false, entry
 	default:
 		panic("Invalid type")
 	}
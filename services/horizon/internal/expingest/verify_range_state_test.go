@@ -257,6 +257,7 @@ func (s *VerifyRangeStateTestSuite) TestSuccessWithVerify() {
 	// TODO: add accounts data, trustlines and asset stats
 	clonedQ.MockQData.On("CountAccountsData").Return(0, nil).Once()
 	clonedQ.MockQAssetStats.On("CountTrustLines").Return(0, nil).Once()
+	clonedQ.MockQClaimableBalances.On("CountClaimableBalances").Return(0, nil).Once()
 	clonedQ.MockQAssetStats.On("GetAssetStats", "", "", db2.PageQuery{
 		Order: "asc",
 		Limit: assetStatsBatchSize,
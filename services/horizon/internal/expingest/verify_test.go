@@ -0,0 +1,69 @@
+package expingest
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func accountEntryWithV2(signers []xdr.Signer, v2 xdr.AccountEntryExtensionV2) xdr.LedgerEntry {
+	return xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeAccount,
+			Account: &xdr.AccountEntry{
+				AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+				Signers:   signers,
+				Ext: xdr.AccountEntryExt{
+					V: 1,
+					V1: &xdr.AccountEntryExtensionV1{
+						Ext: xdr.AccountEntryExtensionV1Ext{
+							V:  2,
+							V2: &v2,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestTransformEntryDropsEmptySponsorshipExtension(t *testing.T) {
+	entry := accountEntryWithV2(nil, xdr.AccountEntryExtensionV2{})
+	entry.Ext = xdr.LedgerEntryExt{
+		V:  1,
+		V1: &xdr.LedgerEntryExtensionV1{},
+	}
+
+	ignore, transformed := transformEntry(entry)
+	assert.False(t, ignore)
+	assert.Equal(t, int32(0), transformed.Ext.V)
+	assert.Equal(t, int32(0), transformed.Data.Account.Ext.V1.Ext.V)
+}
+
+func TestTransformEntrySortsSignerSponsors(t *testing.T) {
+	sponsor := xdr.MustAddress("GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A")
+	signers := []xdr.Signer{
+		{
+			Key:    xdr.MustSigner("GCCCU34WDY2RATQTOOQKY6SZWU6J5DONY42SWGW2CIXGW4LICAGNRZKX"),
+			Weight: 1,
+		},
+		{
+			Key:    xdr.MustSigner("GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV"),
+			Weight: 2,
+		},
+	}
+	entry := accountEntryWithV2(signers, xdr.AccountEntryExtensionV2{
+		NumSponsored:        1,
+		SignerSponsoringIDs: []xdr.SponsorshipDescriptor{&sponsor, nil},
+	})
+
+	_, transformed := transformEntry(entry)
+	account := transformed.Data.Account
+	assert.Equal(t, "GCBBDQLCTNASZJ3MTKAOYEOWRGSHDFAJVI7VPZUOP7KXNHYR3HP2BUKV", account.Signers[0].Key.Address())
+	assert.Equal(t, "GCCCU34WDY2RATQTOOQKY6SZWU6J5DONY42SWGW2CIXGW4LICAGNRZKX", account.Signers[1].Key.Address())
+
+	sponsoringIDs := account.SignerSponsoringIDs()
+	assert.Nil(t, sponsoringIDs[0])
+	assert.Equal(t, sponsor, *sponsoringIDs[1])
+}
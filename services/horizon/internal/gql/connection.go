@@ -0,0 +1,55 @@
+package gql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stellar/go/services/horizon/internal/db2"
+)
+
+// pageArgs are the arguments of the connection fields.
+type pageArgs struct {
+	First int32
+	After *string
+	Order string
+}
+
+type pageInfo struct {
+	HasNextPage bool
+	EndCursor   *string
+}
+
+// pageQuery returns the page query of a connection, whose limit includes one
+// more record than requested to find if there is a next page.
+func (args pageArgs) pageQuery() (db2.PageQuery, error) {
+	if args.First < 1 || args.First > db2.MaxPageSize {
+		return db2.PageQuery{}, fmt.Errorf("first must be between 1 and %d", db2.MaxPageSize)
+	}
+	cursor := ""
+	if args.After != nil {
+		cursor = *args.After
+	}
+	pq, err := db2.NewPageQuery(cursor, true, strings.ToLower(args.Order), uint64(args.First))
+	if err != nil {
+		return pq, err
+	}
+	pq.Limit++
+	return pq, nil
+}
+
+// page returns the number of the fetched records which belong to the page
+// requested by args and the page info of the connection. cursor returns the
+// cursor of the i-th record.
+func (args pageArgs) page(fetched int, cursor func(i int) string) (int, pageInfo) {
+	var info pageInfo
+	n := fetched
+	if n > int(args.First) {
+		n = int(args.First)
+		info.HasNextPage = true
+	}
+	if n > 0 {
+		endCursor := cursor(n - 1)
+		info.EndCursor = &endCursor
+	}
+	return n, info
+}
@@ -0,0 +1,165 @@
+// Package gql implements the GraphQL API of Horizon, which exposes the
+// resources of the REST API backed by the same history.Q queries.
+//
+// The cost of a query is the number of records it may load: one for each
+// object and the requested page size for each connection. The cost is charged
+// as the fields are resolved, since the query parser of graphql-go is not
+// exported, and every database query after the first one of a request counts
+// as an additional request for the rate limiter. When the configured limit is
+// reached or the client is rate limited, the fields which are not resolved yet
+// are null and the response holds the data resolved so far together with the
+// error, as specified by GraphQL for field errors. Clients must check the
+// errors of a response before using its data.
+package gql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	horizonContext "github.com/stellar/go/services/horizon/internal/context"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+	"github.com/stellar/throttled"
+)
+
+const (
+	// DefaultMaxCost is the default maximum number of records loaded by a
+	// query.
+	DefaultMaxCost = 1000
+	// DefaultMaxDepth is the default maximum nesting depth of a query.
+	DefaultMaxDepth = 10
+)
+
+var (
+	// ErrRateLimited is returned when a query runs more database queries
+	// than allowed by the rate limiter.
+	ErrRateLimited = errors.New("Rate limit exceeded")
+
+	// errInternal is returned in place of the database errors to avoid
+	// exposing the underlying implementation.
+	errInternal = errors.New("could not retrieve the requested data")
+)
+
+// Config configures the GraphQL handler.
+type Config struct {
+	// MaxCost is the maximum number of records loaded by a query, it
+	// defaults to DefaultMaxCost. A query reaching it returns a partial
+	// result with an error.
+	MaxCost int
+	// MaxDepth is the maximum nesting depth of a query, it defaults to
+	// DefaultMaxDepth.
+	MaxDepth int
+	// RateLimiter is charged for the database queries run by a query after
+	// the first one, which is covered by the rate limiting of the request.
+	RateLimiter *throttled.HTTPRateLimiter
+}
+
+// Handler serves the GraphQL queries posted as JSON. The requests must go
+// through the history or state middleware, which provide the database
+// session used by the resolvers.
+type Handler struct {
+	config Config
+	relay  relay.Handler
+}
+
+// NewHandler parses the schema and returns a handler serving it.
+func NewHandler(config Config) *Handler {
+	if config.MaxCost == 0 {
+		config.MaxCost = DefaultMaxCost
+	}
+	if config.MaxDepth == 0 {
+		config.MaxDepth = DefaultMaxDepth
+	}
+	opts := []graphql.SchemaOpt{
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(config.MaxDepth),
+		// the resolvers share the database transaction of the request,
+		// which cannot run concurrent queries
+		graphql.MaxParallelism(1),
+	}
+	return &Handler{
+		config: config,
+		relay:  relay.Handler{Schema: graphql.MustParseSchema(Schema, &resolver{}, opts...)},
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cost := &queryCost{
+		request:     r,
+		rateLimiter: h.config.RateLimiter,
+		maxCost:     h.config.MaxCost,
+	}
+	ctx := context.WithValue(r.Context(), &queryCostContextKey, cost)
+	h.relay.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// resolver resolves the fields of the Query type.
+type resolver struct{}
+
+type contextKey string
+
+var queryCostContextKey = contextKey("query_cost")
+
+// queryCost tracks the records loaded and the database queries run by a
+// query.
+type queryCost struct {
+	request     *http.Request
+	rateLimiter *throttled.HTTPRateLimiter
+	maxCost     int
+
+	mutex   sync.Mutex
+	cost    int
+	queries int
+}
+
+// charge records a database query loading up to records records. An error is
+// returned if the query must not run.
+func (c *queryCost) charge(records int) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.cost+records > c.maxCost {
+		return fmt.Errorf("query cost exceeds the limit of %d records", c.maxCost)
+	}
+	c.cost += records
+	c.queries++
+	if c.queries == 1 || c.rateLimiter == nil {
+		return nil
+	}
+
+	limited, _, err := c.rateLimiter.RateLimiter.RateLimit(c.rateLimiter.VaryBy.Key(c.request), 1)
+	if err != nil {
+		return errors.Wrap(err, "RateLimiter error")
+	}
+	if limited {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// load charges the cost of loading records records and returns the
+// history.Q of the request.
+func load(ctx context.Context, records int) (*history.Q, error) {
+	if cost, ok := ctx.Value(&queryCostContextKey).(*queryCost); ok {
+		if err := cost.charge(records); err != nil {
+			return nil, err
+		}
+	}
+	q, err := horizonContext.HistoryQFromContext(ctx)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return q, nil
+}
+
+// internalError logs err and returns the error exposed to the client.
+func internalError(ctx context.Context, err error) error {
+	log.Ctx(ctx).WithStack(err).WithError(err).Error("GraphQL query failed")
+	return errInternal
+}
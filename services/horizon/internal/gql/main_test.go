@@ -0,0 +1,124 @@
+package gql
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/throttled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	graphql.MustParseSchema(Schema, &resolver{}, graphql.UseFieldResolvers())
+}
+
+// query posts query to handler and returns the error messages of the
+// response. The request has no database session, so the resolvers which pass
+// the cost checks fail to load their data.
+func query(t *testing.T, handler http.Handler, query string) []string {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	var messages []string
+	for _, e := range response.Errors {
+		messages = append(messages, e.Message)
+	}
+	return messages
+}
+
+func TestMaxCost(t *testing.T) {
+	handler := NewHandler(Config{MaxCost: 15})
+
+	errors := query(t, handler, `{ ledgers(first: 20) { edges { cursor } } }`)
+	assert.Equal(t, []string{"query cost exceeds the limit of 15 records"}, errors)
+
+	errors = query(t, handler, `{
+		a: ledgers(first: 10) { edges { cursor } }
+		b: ledgers(first: 10) { edges { cursor } }
+	}`)
+	assert.ElementsMatch(t, []string{
+		errInternal.Error(),
+		"query cost exceeds the limit of 15 records",
+	}, errors)
+
+	// the cost is tracked per request
+	errors = query(t, handler, `{ ledgers(first: 15) { edges { cursor } } }`)
+	assert.Equal(t, []string{errInternal.Error()}, errors)
+}
+
+func TestMaxDepth(t *testing.T) {
+	handler := NewHandler(Config{MaxDepth: 3})
+	errors := query(t, handler, `{ account(id: "GABC") { transactions { edges { cursor } } } }`)
+	require.Len(t, errors, 1)
+	assert.Contains(t, errors[0], "exceeds max depth 3")
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter, err := throttled.NewGCRARateLimiter(10, throttled.RateQuota{
+		MaxRate:  throttled.PerHour(1),
+		MaxBurst: 0,
+	})
+	require.NoError(t, err)
+	handler := NewHandler(Config{
+		RateLimiter: &throttled.HTTPRateLimiter{RateLimiter: limiter, VaryBy: &throttled.VaryBy{RemoteAddr: true}},
+	})
+
+	// the first database query is free and the limiter allows one more
+	errors := query(t, handler, `{
+		a: ledger(sequence: 1) { hash }
+		b: ledger(sequence: 2) { hash }
+		c: ledger(sequence: 3) { hash }
+	}`)
+	assert.ElementsMatch(t, []string{
+		errInternal.Error(),
+		errInternal.Error(),
+		ErrRateLimited.Error(),
+	}, errors)
+}
+
+func TestPageArgs(t *testing.T) {
+	after := "12345"
+	pq, err := pageArgs{First: 10, After: &after, Order: "DESC"}.pageQuery()
+	require.NoError(t, err)
+	assert.Equal(t, db2.PageQuery{Cursor: "12345", Order: db2.OrderDescending, Limit: 11}, pq)
+
+	_, err = pageArgs{First: 0, Order: "ASC"}.pageQuery()
+	assert.EqualError(t, err, "first must be between 1 and 200")
+	_, err = pageArgs{First: 201, Order: "ASC"}.pageQuery()
+	assert.EqualError(t, err, "first must be between 1 and 200")
+	after = "invalid"
+	_, err = pageArgs{First: 10, After: &after, Order: "ASC"}.pageQuery()
+	assert.Equal(t, db2.ErrInvalidCursor, err)
+
+	cursors := []string{"1", "2", "3"}
+	cursor := func(i int) string { return cursors[i] }
+	n, info := pageArgs{First: 2}.page(3, cursor)
+	assert.Equal(t, 2, n)
+	assert.True(t, info.HasNextPage)
+	assert.Equal(t, "2", *info.EndCursor)
+
+	n, info = pageArgs{First: 5}.page(3, cursor)
+	assert.Equal(t, 3, n)
+	assert.False(t, info.HasNextPage)
+	assert.Equal(t, "3", *info.EndCursor)
+
+	n, info = pageArgs{First: 5}.page(0, cursor)
+	assert.Equal(t, 0, n)
+	assert.Nil(t, info.EndCursor)
+}
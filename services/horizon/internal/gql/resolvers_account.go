@@ -0,0 +1,57 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/support/errors"
+)
+
+// Account resolves the account() GraphQL query.
+func (r *resolver) Account(ctx context.Context, args struct{ ID string }) (*account, error) {
+	q, err := load(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := actions.AccountInfo(ctx, q, args.ID)
+	if q.NoRows(errors.Cause(err)) {
+		return nil, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return newAccount(*resource), nil
+}
+
+// Offers resolves the offers of an account.
+func (a *account) Offers(ctx context.Context, args pageArgs) (*offerConnection, error) {
+	return findOffers(ctx, a.ID, args)
+}
+
+// Transactions resolves the transactions of an account.
+func (a *account) Transactions(ctx context.Context, args struct {
+	IncludeFailed bool
+	pageArgs
+}) (*transactionConnection, error) {
+	return findTransactions(ctx, transactionFilter{
+		account:       a.ID,
+		includeFailed: args.IncludeFailed,
+	}, args.pageArgs)
+}
+
+// Operations resolves the operations of an account.
+func (a *account) Operations(ctx context.Context, args struct {
+	OnlyPayments  bool
+	IncludeFailed bool
+	pageArgs
+}) (*operationConnection, error) {
+	return findOperations(ctx, operationFilter{
+		account:       a.ID,
+		onlyPayments:  args.OnlyPayments,
+		includeFailed: args.IncludeFailed,
+	}, args.pageArgs)
+}
+
+// Trades resolves the trades of an account.
+func (a *account) Trades(ctx context.Context, args pageArgs) (*tradeConnection, error) {
+	return findTrades(ctx, a.ID, args)
+}
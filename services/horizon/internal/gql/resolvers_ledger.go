@@ -0,0 +1,89 @@
+package gql
+
+import (
+	"context"
+
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+)
+
+type ledgerConnection struct {
+	Edges    []ledgerEdge
+	PageInfo pageInfo
+}
+
+type ledgerEdge struct {
+	Cursor string
+	Node   *ledger
+}
+
+// Ledger resolves the ledger() GraphQL query.
+func (r *resolver) Ledger(ctx context.Context, args struct{ Sequence int32 }) (*ledger, error) {
+	q, err := load(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	var row history.Ledger
+	err = q.LedgerBySequence(&row, args.Sequence)
+	if q.NoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	var resource protocol.Ledger
+	resourceadapter.PopulateLedger(ctx, &resource, row)
+	return newLedger(resource), nil
+}
+
+// Ledgers resolves the ledgers() GraphQL query.
+func (r *resolver) Ledgers(ctx context.Context, args pageArgs) (*ledgerConnection, error) {
+	pq, err := args.pageQuery()
+	if err != nil {
+		return nil, err
+	}
+	q, err := load(ctx, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+	var rows []history.Ledger
+	if err = q.Ledgers().Page(pq).Select(&rows); err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	n, info := args.page(len(rows), func(i int) string { return rows[i].PagingToken() })
+	connection := &ledgerConnection{Edges: []ledgerEdge{}, PageInfo: info}
+	for _, row := range rows[:n] {
+		var resource protocol.Ledger
+		resourceadapter.PopulateLedger(ctx, &resource, row)
+		connection.Edges = append(connection.Edges, ledgerEdge{
+			Cursor: resource.PT,
+			Node:   newLedger(resource),
+		})
+	}
+	return connection, nil
+}
+
+// Transactions resolves the transactions of a ledger.
+func (l *ledger) Transactions(ctx context.Context, args struct {
+	IncludeFailed bool
+	pageArgs
+}) (*transactionConnection, error) {
+	return findTransactions(ctx, transactionFilter{
+		ledger:        l.Sequence,
+		includeFailed: args.IncludeFailed,
+	}, args.pageArgs)
+}
+
+// Operations resolves the operations of a ledger.
+func (l *ledger) Operations(ctx context.Context, args struct {
+	OnlyPayments  bool
+	IncludeFailed bool
+	pageArgs
+}) (*operationConnection, error) {
+	return findOperations(ctx, operationFilter{
+		ledger:        l.Sequence,
+		onlyPayments:  args.OnlyPayments,
+		includeFailed: args.IncludeFailed,
+	}, args.pageArgs)
+}
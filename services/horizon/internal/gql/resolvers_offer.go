@@ -0,0 +1,112 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+)
+
+type offerConnection struct {
+	Edges    []offerEdge
+	PageInfo pageInfo
+}
+
+type offerEdge struct {
+	Cursor string
+	Node   offer
+}
+
+type tradeConnection struct {
+	Edges    []tradeEdge
+	PageInfo pageInfo
+}
+
+type tradeEdge struct {
+	Cursor string
+	Node   trade
+}
+
+// Offers resolves the offers() GraphQL query.
+func (r *resolver) Offers(ctx context.Context, args struct {
+	Seller *string
+	pageArgs
+}) (*offerConnection, error) {
+	seller := ""
+	if args.Seller != nil {
+		seller = *args.Seller
+	}
+	return findOffers(ctx, seller, args.pageArgs)
+}
+
+// Trades resolves the trades() GraphQL query.
+func (r *resolver) Trades(ctx context.Context, args pageArgs) (*tradeConnection, error) {
+	return findTrades(ctx, "", args)
+}
+
+func findOffers(ctx context.Context, seller string, args pageArgs) (*offerConnection, error) {
+	pq, err := args.pageQuery()
+	if err != nil {
+		return nil, err
+	}
+	q, err := load(ctx, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.GetOffers(history.OffersQuery{PageQuery: pq, SellerID: seller})
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	n, info := args.page(len(rows), func(i int) string { return fmt.Sprintf("%d", rows[i].OfferID) })
+	connection := &offerConnection{Edges: []offerEdge{}, PageInfo: info}
+	for _, row := range rows[:n] {
+		var resource protocol.Offer
+		resourceadapter.PopulateOffer(ctx, &resource, row, nil)
+		connection.Edges = append(connection.Edges, offerEdge{
+			Cursor: resource.PT,
+			Node:   newOffer(resource),
+		})
+	}
+	return connection, nil
+}
+
+func findTrades(ctx context.Context, account string, args pageArgs) (*tradeConnection, error) {
+	pq, err := args.pageQuery()
+	if err != nil {
+		return nil, err
+	}
+	q, err := load(ctx, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+
+	query := q.Trades()
+	if account != "" {
+		query.ForAccount(account)
+	}
+	var rows []history.Trade
+	err = query.Page(pq).Select(&rows)
+	connection := &tradeConnection{Edges: []tradeEdge{}}
+	if q.NoRows(errors.Cause(err)) {
+		// the account is not in the history
+		return connection, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	n, info := args.page(len(rows), func(i int) string { return rows[i].PagingToken() })
+	connection.PageInfo = info
+	for _, row := range rows[:n] {
+		var resource protocol.Trade
+		resourceadapter.PopulateTrade(ctx, &resource, row)
+		connection.Edges = append(connection.Edges, tradeEdge{
+			Cursor: resource.PT,
+			Node:   newTrade(resource),
+		})
+	}
+	return connection, nil
+}
@@ -0,0 +1,229 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+)
+
+type transactionConnection struct {
+	Edges    []transactionEdge
+	PageInfo pageInfo
+}
+
+type transactionEdge struct {
+	Cursor string
+	Node   *transaction
+}
+
+type operationConnection struct {
+	Edges    []operationEdge
+	PageInfo pageInfo
+}
+
+type operationEdge struct {
+	Cursor string
+	Node   *operation
+}
+
+// transactionFilter selects the transactions of a connection.
+type transactionFilter struct {
+	account       string
+	ledger        int32
+	includeFailed bool
+}
+
+// operationFilter selects the operations of a connection.
+type operationFilter struct {
+	account       string
+	ledger        int32
+	transaction   string
+	onlyPayments  bool
+	includeFailed bool
+}
+
+// Transaction resolves the transaction() GraphQL query.
+func (r *resolver) Transaction(ctx context.Context, args struct{ Hash string }) (*transaction, error) {
+	q, err := load(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	var row history.Transaction
+	err = q.TransactionByHash(&row, args.Hash)
+	if q.NoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	var resource protocol.Transaction
+	if err = resourceadapter.PopulateTransaction(ctx, args.Hash, &resource, row); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return newTransaction(resource), nil
+}
+
+// Transactions resolves the transactions() GraphQL query.
+func (r *resolver) Transactions(ctx context.Context, args struct {
+	Ledger        *int32
+	IncludeFailed bool
+	pageArgs
+}) (*transactionConnection, error) {
+	filter := transactionFilter{includeFailed: args.IncludeFailed}
+	if args.Ledger != nil {
+		filter.ledger = *args.Ledger
+	}
+	return findTransactions(ctx, filter, args.pageArgs)
+}
+
+// Operations resolves the operations() GraphQL query.
+func (r *resolver) Operations(ctx context.Context, args struct {
+	Ledger        *int32
+	OnlyPayments  bool
+	IncludeFailed bool
+	pageArgs
+}) (*operationConnection, error) {
+	filter := operationFilter{
+		onlyPayments:  args.OnlyPayments,
+		includeFailed: args.IncludeFailed,
+	}
+	if args.Ledger != nil {
+		filter.ledger = *args.Ledger
+	}
+	return findOperations(ctx, filter, args.pageArgs)
+}
+
+// Operations resolves the operations of a transaction.
+func (t *transaction) Operations(ctx context.Context, args pageArgs) (*operationConnection, error) {
+	return findOperations(ctx, operationFilter{transaction: t.Hash}, args)
+}
+
+func findTransactions(ctx context.Context, filter transactionFilter, args pageArgs) (*transactionConnection, error) {
+	pq, err := args.pageQuery()
+	if err != nil {
+		return nil, err
+	}
+	q, err := load(ctx, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+
+	query := q.Transactions()
+	if filter.account != "" {
+		query.ForAccount(filter.account)
+	}
+	if filter.ledger > 0 {
+		query.ForLedger(filter.ledger)
+	}
+	if filter.includeFailed {
+		query.IncludeFailed()
+	}
+	var rows []history.Transaction
+	err = query.Page(pq).Select(&rows)
+	connection := &transactionConnection{Edges: []transactionEdge{}}
+	if q.NoRows(errors.Cause(err)) {
+		// the account or the ledger is not in the history
+		return connection, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	n, info := args.page(len(rows), func(i int) string { return rows[i].PagingToken() })
+	connection.PageInfo = info
+	for _, row := range rows[:n] {
+		var resource protocol.Transaction
+		if err = resourceadapter.PopulateTransaction(ctx, row.TransactionHash, &resource, row); err != nil {
+			return nil, internalError(ctx, err)
+		}
+		connection.Edges = append(connection.Edges, transactionEdge{
+			Cursor: resource.PT,
+			Node:   newTransaction(resource),
+		})
+	}
+	return connection, nil
+}
+
+func findOperations(ctx context.Context, filter operationFilter, args pageArgs) (*operationConnection, error) {
+	pq, err := args.pageQuery()
+	if err != nil {
+		return nil, err
+	}
+	q, err := load(ctx, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+
+	query := q.Operations()
+	if filter.account != "" {
+		query.ForAccount(filter.account)
+	}
+	if filter.ledger > 0 {
+		query.ForLedger(filter.ledger)
+	}
+	if filter.transaction != "" {
+		query.ForTransaction(filter.transaction)
+	}
+	// the operations of a transaction are included even if it failed
+	if filter.transaction != "" || filter.includeFailed {
+		query.IncludeFailed()
+	}
+	if filter.onlyPayments {
+		query.OnlyPayments()
+	}
+	rows, _, err := query.Page(pq).Fetch()
+	connection := &operationConnection{Edges: []operationEdge{}}
+	if q.NoRows(errors.Cause(err)) {
+		// the account, the ledger or the transaction is not in the history
+		return connection, nil
+	} else if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	n, info := args.page(len(rows), func(i int) string { return rows[i].PagingToken() })
+	connection.PageInfo = info
+	rows = rows[:n]
+
+	ledgerCache := history.LedgerCache{}
+	for _, row := range rows {
+		ledgerCache.Queue(row.LedgerSequence())
+	}
+	if err = ledgerCache.Load(q); err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	for _, row := range rows {
+		ledger, found := ledgerCache.Records[row.LedgerSequence()]
+		if !found {
+			return nil, internalError(ctx, errors.Errorf("could not find ledger data for sequence %d", row.LedgerSequence()))
+		}
+		resource, err := resourceadapter.NewOperation(ctx, row, row.TransactionHash, nil, ledger)
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		details, err := json.Marshal(resource)
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		connection.Edges = append(connection.Edges, operationEdge{
+			Cursor: row.PagingToken(),
+			Node: &operation{
+				ID:                    fmt.Sprintf("%d", row.ID),
+				PagingToken:           row.PagingToken(),
+				Type:                  operations.TypeNames[row.Type],
+				TypeI:                 int32(row.Type),
+				SourceAccount:         row.SourceAccount,
+				TransactionHash:       row.TransactionHash,
+				TransactionSuccessful: row.TransactionSuccessful,
+				CreatedAt:             graphql.Time{Time: ledger.ClosedAt},
+				Details:               string(details),
+			},
+		})
+	}
+	return connection, nil
+}
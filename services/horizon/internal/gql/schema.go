@@ -0,0 +1,276 @@
+package gql
+
+// Schema is the GraphQL schema served by Horizon.
+//
+// Lists are exposed as connections: a page of edges holding a node and its
+// cursor, which is the paging_token of the node in the REST API. A
+// connection is paged with the first, after and order arguments, which
+// behave like the limit, cursor and order parameters of the REST endpoints.
+const Schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# retrieve an account by its address.
+	account(id: String!): Account
+
+	# retrieve a ledger by its sequence.
+	ledger(sequence: Int!): Ledger
+
+	# retrieve the ledgers.
+	ledgers(first: Int = 10, after: String, order: Order = ASC): LedgerConnection!
+
+	# retrieve a transaction by its hash.
+	transaction(hash: String!): Transaction
+
+	# retrieve the transactions of the network, optionally of a ledger.
+	transactions(
+		ledger: Int
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): TransactionConnection!
+
+	# retrieve the operations of the network, optionally of a ledger.
+	operations(
+		ledger: Int
+		onlyPayments: Boolean = false
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): OperationConnection!
+
+	# retrieve the offers, optionally of a seller.
+	offers(seller: String, first: Int = 10, after: String, order: Order = ASC): OfferConnection!
+
+	# retrieve the trades of the network.
+	trades(first: Int = 10, after: String, order: Order = ASC): TradeConnection!
+}
+
+scalar Time
+
+enum Order {
+	ASC
+	DESC
+}
+
+type PageInfo {
+	hasNextPage: Boolean!
+	endCursor: String
+}
+
+type Asset {
+	type: String!
+	code: String!
+	issuer: String!
+}
+
+type Account {
+	id: String!
+	sequence: String!
+	subentryCount: Int!
+	homeDomain: String!
+	inflationDestination: String!
+	lastModifiedLedger: Int!
+	thresholds: Thresholds!
+	flags: AccountFlags!
+	balances: [Balance!]!
+	signers: [Signer!]!
+	data: [DataEntry!]!
+	offers(first: Int = 10, after: String, order: Order = ASC): OfferConnection!
+	transactions(
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): TransactionConnection!
+	operations(
+		onlyPayments: Boolean = false
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): OperationConnection!
+	trades(first: Int = 10, after: String, order: Order = ASC): TradeConnection!
+}
+
+type Thresholds {
+	lowThreshold: Int!
+	medThreshold: Int!
+	highThreshold: Int!
+}
+
+type AccountFlags {
+	authRequired: Boolean!
+	authRevocable: Boolean!
+	authImmutable: Boolean!
+}
+
+type Balance {
+	asset: Asset!
+	balance: String!
+	limit: String!
+	buyingLiabilities: String!
+	sellingLiabilities: String!
+	isAuthorized: Boolean!
+}
+
+type Signer {
+	key: String!
+	weight: Int!
+	type: String!
+}
+
+type DataEntry {
+	name: String!
+	# the base64 encoded value.
+	value: String!
+}
+
+type Offer {
+	id: String!
+	pagingToken: String!
+	seller: String!
+	selling: Asset!
+	buying: Asset!
+	amount: String!
+	price: String!
+	lastModifiedLedger: Int!
+}
+
+type OfferConnection {
+	edges: [OfferEdge!]!
+	pageInfo: PageInfo!
+}
+
+type OfferEdge {
+	cursor: String!
+	node: Offer!
+}
+
+type Ledger {
+	sequence: Int!
+	hash: String!
+	prevHash: String!
+	pagingToken: String!
+	closedAt: Time!
+	successfulTransactionCount: Int!
+	failedTransactionCount: Int!
+	operationCount: Int!
+	totalCoins: String!
+	feePool: String!
+	baseFee: Int!
+	baseReserve: Int!
+	maxTxSetSize: Int!
+	protocolVersion: Int!
+	transactions(
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): TransactionConnection!
+	operations(
+		onlyPayments: Boolean = false
+		includeFailed: Boolean = false
+		first: Int = 10
+		after: String
+		order: Order = ASC
+	): OperationConnection!
+}
+
+type LedgerConnection {
+	edges: [LedgerEdge!]!
+	pageInfo: PageInfo!
+}
+
+type LedgerEdge {
+	cursor: String!
+	node: Ledger!
+}
+
+type Transaction {
+	hash: String!
+	pagingToken: String!
+	ledger: Int!
+	createdAt: Time!
+	sourceAccount: String!
+	sourceAccountSequence: String!
+	feeAccount: String!
+	feeCharged: String!
+	maxFee: String!
+	operationCount: Int!
+	successful: Boolean!
+	memoType: String!
+	memo: String!
+	envelopeXdr: String!
+	resultXdr: String!
+	resultMetaXdr: String!
+	feeMetaXdr: String!
+	operations(first: Int = 10, after: String, order: Order = ASC): OperationConnection!
+}
+
+type TransactionConnection {
+	edges: [TransactionEdge!]!
+	pageInfo: PageInfo!
+}
+
+type TransactionEdge {
+	cursor: String!
+	node: Transaction!
+}
+
+type Operation {
+	id: String!
+	pagingToken: String!
+	type: String!
+	typeI: Int!
+	sourceAccount: String!
+	transactionHash: String!
+	transactionSuccessful: Boolean!
+	createdAt: Time!
+	# the operation as returned by the REST API, JSON encoded, which includes
+	# the fields specific to its type.
+	details: String!
+}
+
+type OperationConnection {
+	edges: [OperationEdge!]!
+	pageInfo: PageInfo!
+}
+
+type OperationEdge {
+	cursor: String!
+	node: Operation!
+}
+
+type Trade {
+	id: String!
+	pagingToken: String!
+	ledgerCloseTime: Time!
+	offerId: String!
+	baseOfferId: String!
+	baseAccount: String!
+	baseAmount: String!
+	baseAsset: Asset!
+	counterOfferId: String!
+	counterAccount: String!
+	counterAmount: String!
+	counterAsset: Asset!
+	baseIsSeller: Boolean!
+	# the price of the trade, as a fraction n/d.
+	price: String!
+}
+
+type TradeConnection {
+	edges: [TradeEdge!]!
+	pageInfo: PageInfo!
+}
+
+type TradeEdge {
+	cursor: String!
+	node: Trade!
+}
+`
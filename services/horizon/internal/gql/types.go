@@ -0,0 +1,257 @@
+package gql
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/graph-gophers/graphql-go"
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+)
+
+// The types below represent the resources of the REST API, with some type
+// adaptations to match the GraphQL type system.
+
+type asset struct {
+	Type   string
+	Code   string
+	Issuer string
+}
+
+type account struct {
+	ID                   string
+	Sequence             string
+	SubentryCount        int32
+	HomeDomain           string
+	InflationDestination string
+	LastModifiedLedger   int32
+	Thresholds           thresholds
+	Flags                protocol.AccountFlags
+	Balances             []balance
+	Signers              []protocol.Signer
+	Data                 []dataEntry
+}
+
+type thresholds struct {
+	LowThreshold  int32
+	MedThreshold  int32
+	HighThreshold int32
+}
+
+type balance struct {
+	Asset              asset
+	Balance            string
+	Limit              string
+	BuyingLiabilities  string
+	SellingLiabilities string
+	IsAuthorized       bool
+}
+
+type dataEntry struct {
+	Name  string
+	Value string
+}
+
+type offer struct {
+	ID                 string
+	PagingToken        string
+	Seller             string
+	Selling            asset
+	Buying             asset
+	Amount             string
+	Price              string
+	LastModifiedLedger int32
+}
+
+type ledger struct {
+	Sequence                   int32
+	Hash                       string
+	PrevHash                   string
+	PagingToken                string
+	ClosedAt                   graphql.Time
+	SuccessfulTransactionCount int32
+	FailedTransactionCount     int32
+	OperationCount             int32
+	TotalCoins                 string
+	FeePool                    string
+	BaseFee                    int32
+	BaseReserve                int32
+	MaxTxSetSize               int32
+	ProtocolVersion            int32
+}
+
+type transaction struct {
+	Hash                  string
+	PagingToken           string
+	Ledger                int32
+	CreatedAt             graphql.Time
+	SourceAccount         string
+	SourceAccountSequence string
+	FeeAccount            string
+	FeeCharged            string
+	MaxFee                string
+	OperationCount        int32
+	Successful            bool
+	MemoType              string
+	Memo                  string
+	EnvelopeXdr           string
+	ResultXdr             string
+	ResultMetaXdr         string
+	FeeMetaXdr            string
+}
+
+type operation struct {
+	ID                    string
+	PagingToken           string
+	Type                  string
+	TypeI                 int32
+	SourceAccount         string
+	TransactionHash       string
+	TransactionSuccessful bool
+	CreatedAt             graphql.Time
+	Details               string
+}
+
+type trade struct {
+	ID              string
+	PagingToken     string
+	LedgerCloseTime graphql.Time
+	OfferID         string
+	BaseOfferID     string
+	BaseAccount     string
+	BaseAmount      string
+	BaseAsset       asset
+	CounterOfferID  string
+	CounterAccount  string
+	CounterAmount   string
+	CounterAsset    asset
+	BaseIsSeller    bool
+	Price           string
+}
+
+func newAsset(a base.Asset) asset {
+	return asset{Type: a.Type, Code: a.Code, Issuer: a.Issuer}
+}
+
+func newAccount(resource protocol.Account) *account {
+	a := &account{
+		ID:                   resource.ID,
+		Sequence:             resource.Sequence,
+		SubentryCount:        resource.SubentryCount,
+		HomeDomain:           resource.HomeDomain,
+		InflationDestination: resource.InflationDestination,
+		LastModifiedLedger:   int32(resource.LastModifiedLedger),
+		Thresholds: thresholds{
+			LowThreshold:  int32(resource.Thresholds.LowThreshold),
+			MedThreshold:  int32(resource.Thresholds.MedThreshold),
+			HighThreshold: int32(resource.Thresholds.HighThreshold),
+		},
+		Flags:   resource.Flags,
+		Signers: resource.Signers,
+	}
+	for _, b := range resource.Balances {
+		a.Balances = append(a.Balances, balance{
+			Asset:              newAsset(b.Asset),
+			Balance:            b.Balance,
+			Limit:              b.Limit,
+			BuyingLiabilities:  b.BuyingLiabilities,
+			SellingLiabilities: b.SellingLiabilities,
+			// native balances are always authorized
+			IsAuthorized: b.IsAuthorized == nil || *b.IsAuthorized,
+		})
+	}
+	for name, value := range resource.Data {
+		a.Data = append(a.Data, dataEntry{Name: name, Value: value})
+	}
+	sort.Slice(a.Data, func(i, j int) bool {
+		return a.Data[i].Name < a.Data[j].Name
+	})
+	return a
+}
+
+func newOffer(resource protocol.Offer) offer {
+	return offer{
+		ID:                 fmt.Sprintf("%d", resource.ID),
+		PagingToken:        resource.PT,
+		Seller:             resource.Seller,
+		Selling:            newAsset(base.Asset(resource.Selling)),
+		Buying:             newAsset(base.Asset(resource.Buying)),
+		Amount:             resource.Amount,
+		Price:              resource.Price,
+		LastModifiedLedger: resource.LastModifiedLedger,
+	}
+}
+
+func newLedger(resource protocol.Ledger) *ledger {
+	l := &ledger{
+		Sequence:                   resource.Sequence,
+		Hash:                       resource.Hash,
+		PrevHash:                   resource.PrevHash,
+		PagingToken:                resource.PT,
+		ClosedAt:                   graphql.Time{Time: resource.ClosedAt},
+		SuccessfulTransactionCount: resource.SuccessfulTransactionCount,
+		OperationCount:             resource.OperationCount,
+		TotalCoins:                 resource.TotalCoins,
+		FeePool:                    resource.FeePool,
+		BaseFee:                    resource.BaseFee,
+		BaseReserve:                resource.BaseReserve,
+		MaxTxSetSize:               resource.MaxTxSetSize,
+		ProtocolVersion:            resource.ProtocolVersion,
+	}
+	if resource.FailedTransactionCount != nil {
+		l.FailedTransactionCount = *resource.FailedTransactionCount
+	}
+	return l
+}
+
+func newTransaction(resource protocol.Transaction) *transaction {
+	return &transaction{
+		Hash:                  resource.Hash,
+		PagingToken:           resource.PT,
+		Ledger:                resource.Ledger,
+		CreatedAt:             graphql.Time{Time: resource.LedgerCloseTime},
+		SourceAccount:         resource.Account,
+		SourceAccountSequence: resource.AccountSequence,
+		FeeAccount:            resource.FeeAccount,
+		FeeCharged:            fmt.Sprintf("%d", resource.FeeCharged),
+		MaxFee:                fmt.Sprintf("%d", resource.MaxFee),
+		OperationCount:        resource.OperationCount,
+		Successful:            resource.Successful,
+		MemoType:              resource.MemoType,
+		Memo:                  resource.Memo,
+		EnvelopeXdr:           resource.EnvelopeXdr,
+		ResultXdr:             resource.ResultXdr,
+		ResultMetaXdr:         resource.ResultMetaXdr,
+		FeeMetaXdr:            resource.FeeMetaXdr,
+	}
+}
+
+func newTrade(resource protocol.Trade) trade {
+	t := trade{
+		ID:              resource.ID,
+		PagingToken:     resource.PT,
+		LedgerCloseTime: graphql.Time{Time: resource.LedgerCloseTime},
+		OfferID:         resource.OfferID,
+		BaseOfferID:     resource.BaseOfferID,
+		BaseAccount:     resource.BaseAccount,
+		BaseAmount:      resource.BaseAmount,
+		BaseAsset: asset{
+			Type:   resource.BaseAssetType,
+			Code:   resource.BaseAssetCode,
+			Issuer: resource.BaseAssetIssuer,
+		},
+		CounterOfferID: resource.CounterOfferID,
+		CounterAccount: resource.CounterAccount,
+		CounterAmount:  resource.CounterAmount,
+		CounterAsset: asset{
+			Type:   resource.CounterAssetType,
+			Code:   resource.CounterAssetCode,
+			Issuer: resource.CounterAssetIssuer,
+		},
+		BaseIsSeller: resource.BaseIsSeller,
+	}
+	if resource.Price != nil {
+		t.Price = fmt.Sprintf("%d/%d", resource.Price.N, resource.Price.D)
+	}
+	return t
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/services/horizon/internal/apikeys"
 	horizonContext "github.com/stellar/go/services/horizon/internal/context"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/errors"
@@ -148,7 +150,7 @@ func logStartOfRequest(ctx context.Context, r *http.Request, streaming bool) {
 		"ip":             remoteAddrIP(r),
 		"ip_port":        r.RemoteAddr,
 		"method":         r.Method,
-		"path":           r.URL.String(),
+		"path":           loggedURL(r.URL),
 		"streaming":      streaming,
 		"referer":        referer,
 	}).Info("Starting request")
@@ -179,7 +181,7 @@ func logEndOfRequest(ctx context.Context, r *http.Request, requestDurationSummar
 		"ip":             remoteAddrIP(r),
 		"ip_port":        r.RemoteAddr,
 		"method":         r.Method,
-		"path":           r.URL.String(),
+		"path":           loggedURL(r.URL),
 		"route":          routePattern,
 		"status":         mw.Status(),
 		"streaming":      streaming,
@@ -194,6 +196,23 @@ func logEndOfRequest(ctx context.Context, r *http.Request, requestDurationSummar
 	}).Observe(float64(duration.Seconds()))
 }
 
+// loggedURL returns u with the value of the API key query parameter redacted,
+// so that API keys do not end up in the logs.
+func loggedURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	redacted := *u
+	params := strings.Split(u.RawQuery, "&")
+	for i, param := range params {
+		if key := strings.SplitN(param, "=", 2)[0]; key == apikeys.QueryParam {
+			params[i] = apikeys.QueryParam + "=REDACTED"
+		}
+	}
+	redacted.RawQuery = strings.Join(params, "&")
+	return redacted.String()
+}
+
 func firstXForwardedFor(r *http.Request) string {
 	return strings.TrimSpace(strings.SplitN(r.Header.Get("X-Forwarded-For"), ",", 2)[0])
 }
@@ -220,8 +239,28 @@ func recoverMiddleware(h http.Handler) http.Handler {
 // is not in a stale state, which is when the difference between latest core
 // ledger and latest history ledger is higher than the given threshold
 func NewHistoryMiddleware(staleThreshold int32, session *db.Session) func(http.Handler) http.Handler {
+	staleHistoryMiddleware := NewStaleHistoryMiddleware(staleThreshold)
 	return func(h http.Handler) http.Handler {
+		return staleHistoryMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestSession := session.Clone()
+			requestSession.Ctx = r.Context()
+			h.ServeHTTP(w, r.WithContext(
+				context.WithValue(
+					r.Context(),
+					&horizonContext.SessionContextKey,
+					requestSession,
+				),
+			))
+		}))
+	}
+}
 
+// NewStaleHistoryMiddleware ensures Horizon is not in a stale state, like
+// NewHistoryMiddleware, without adding a session to the request context. It
+// is used by the endpoints reading the history tables within the session of
+// another middleware.
+func NewStaleHistoryMiddleware(staleThreshold int32) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if staleThreshold > 0 {
 				ls := ledger.CurrentState()
@@ -236,16 +275,7 @@ func NewHistoryMiddleware(staleThreshold int32, session *db.Session) func(http.H
 					return
 				}
 			}
-
-			requestSession := session.Clone()
-			requestSession.Ctx = r.Context()
-			h.ServeHTTP(w, r.WithContext(
-				context.WithValue(
-					r.Context(),
-					&horizonContext.SessionContextKey,
-					requestSession,
-				),
-			))
+			h.ServeHTTP(w, r)
 		})
 	}
 }
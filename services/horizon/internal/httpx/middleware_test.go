@@ -0,0 +1,24 @@
+package httpx
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggedURL(t *testing.T) {
+	for raw, logged := range map[string]string{
+		"/ledgers":                "/ledgers",
+		"/ledgers?limit=2":        "/ledgers?limit=2",
+		"/ledgers?api_key=secret": "/ledgers?api_key=REDACTED",
+		"/ledgers?limit=2&api_key=secret&order=a": "/ledgers?limit=2&api_key=REDACTED&order=a",
+		"/ledgers?api_key":                        "/ledgers?api_key=REDACTED",
+		"/ledgers?api_key_x=1":                    "/ledgers?api_key_x=1",
+	} {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		assert.Equal(t, logged, loggedURL(u), raw)
+	}
+}
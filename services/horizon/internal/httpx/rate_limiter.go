@@ -1,19 +1,44 @@
 package httpx
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/stellar/throttled"
 
+	"github.com/stellar/go/services/horizon/internal/apikeys"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	hProblem "github.com/stellar/go/services/horizon/internal/render/problem"
+	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/render/problem"
 )
 
 const lruCacheSize = 50000
 
+const (
+	// apiKeyPrefix prefixes the rate limiter keys of requests with an API
+	// key, which can't be confused with IP addresses.
+	apiKeyPrefix = "apikey:"
+	// lookupPrefix prefixes the rate limiter keys charged for the lookups of
+	// API keys which are not cached.
+	lookupPrefix = "lookup:"
+)
+
+// routeCosts are the number of requests charged for the endpoints which are
+// more expensive to serve than a lookup by id. Requests to other endpoints
+// cost one request.
+var routeCosts = []struct {
+	prefix string
+	cost   int
+}{
+	{"/paths", 10},
+	{"/trade_aggregations", 5},
+}
+
 type historyLedgerSourceFactory struct {
 	updateFrequency time.Duration
 }
@@ -38,18 +63,188 @@ func (v VaryByRemoteIP) Key(r *http.Request) string {
 	return remoteAddrIP(r)
 }
 
-func newRateLimiter(rateQuota *throttled.RateQuota) (*throttled.HTTPRateLimiter, error) {
-	rateLimiter, err := throttled.NewGCRARateLimiter(lruCacheSize, *rateQuota)
+// VaryByAPIKey keys requests by the hash of their API key and their remote
+// IP or, for requests without an API key, by remote IP.
+type VaryByAPIKey struct{}
+
+func (v VaryByAPIKey) Key(r *http.Request) string {
+	if key := apikeys.FromRequest(r); key != "" {
+		return apiKeyPrefix + apikeys.Hash(key) + "@" + remoteAddrIP(r)
+	}
+	return remoteAddrIP(r)
+}
+
+// splitAPIKey returns the hash of the API key and the remote IP of a key
+// returned by VaryByAPIKey for a request with an API key.
+func splitAPIKey(key string) (keyHash, ip string) {
+	key = strings.TrimPrefix(key, apiKeyPrefix)
+	i := strings.Index(key, "@")
+	if i == -1 {
+		return key, ""
+	}
+	return key[:i], key[i+1:]
+}
+
+// quotaSource returns the quota of an API key given its hash.
+type quotaSource interface {
+	Cached(keyHash string) (quota throttled.RateQuota, found bool, err error)
+	Get(keyHash string) (throttled.RateQuota, error)
+}
+
+// keyedRateLimiter is a throttled.RateLimiter limiting the keys of
+// VaryByAPIKey with the quota of their API key, or defaultQuota for IP
+// addresses. Keys sharing a quota share a limiter created by newLimiter,
+// which stores the state of the keys in memory unless it is replaced by a
+// limiter shared between instances.
+type keyedRateLimiter struct {
+	defaultQuota throttled.RateQuota
+	quotas       quotaSource
+	newLimiter   func(quota throttled.RateQuota) (throttled.RateLimiter, error)
+
+	mutex    sync.Mutex
+	limiters map[throttled.RateQuota]throttled.RateLimiter
+}
+
+func newKeyedRateLimiter(defaultQuota throttled.RateQuota, quotas quotaSource) *keyedRateLimiter {
+	return &keyedRateLimiter{
+		defaultQuota: defaultQuota,
+		quotas:       quotas,
+		newLimiter: func(quota throttled.RateQuota) (throttled.RateLimiter, error) {
+			return throttled.NewGCRARateLimiter(lruCacheSize, quota)
+		},
+		limiters: map[throttled.RateQuota]throttled.RateLimiter{},
+	}
+}
+
+// RateLimit implements throttled.RateLimiter. Requests with an API key which
+// does not exist are charged to their IP address, and rejected with
+// apikeys.ErrUnknownKey unless the IP address is out of quota. Looking up a
+// key which is not cached is charged to the IP address too, so that random
+// keys can't make every request query the DB.
+func (l *keyedRateLimiter) RateLimit(key string, quantity int) (bool, throttled.RateLimitResult, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return l.rateLimit(l.defaultQuota, key, quantity)
+	}
+
+	keyHash, ip := splitAPIKey(key)
+	quota, found, err := l.quotas.Cached(keyHash)
+	if !found {
+		limited, result, lookupErr := l.rateLimit(l.defaultQuota, lookupPrefix+ip, 1)
+		if lookupErr != nil || limited {
+			return limited, result, lookupErr
+		}
+		quota, err = l.quotas.Get(keyHash)
+	}
+
+	if err == apikeys.ErrUnknownKey {
+		limited, result, rateLimitErr := l.rateLimit(l.defaultQuota, ip, quantity)
+		if rateLimitErr != nil || limited {
+			return limited, result, rateLimitErr
+		}
+		return false, result, err
+	}
+	if err != nil {
+		return false, throttled.RateLimitResult{}, err
+	}
+	return l.rateLimit(quota, apiKeyPrefix+keyHash, quantity)
+}
+
+func (l *keyedRateLimiter) rateLimit(quota throttled.RateQuota, key string, quantity int) (bool, throttled.RateLimitResult, error) {
+	limiter, err := l.limiter(quota)
+	if err != nil {
+		return false, throttled.RateLimitResult{}, errors.Wrap(err, "could not create rate limiter")
+	}
+
+	// a quantity greater than the burst would never be permitted
+	if quantity > quota.MaxBurst+1 {
+		quantity = quota.MaxBurst + 1
+	}
+	return limiter.RateLimit(key, quantity)
+}
+
+func (l *keyedRateLimiter) limiter(quota throttled.RateQuota) (throttled.RateLimiter, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if limiter, ok := l.limiters[quota]; ok {
+		return limiter, nil
+	}
+	limiter, err := l.newLimiter(quota)
 	if err != nil {
 		return nil, err
 	}
+	l.limiters[quota] = limiter
+	return limiter, nil
+}
+
+func newRateLimiter(rateQuota *throttled.RateQuota, quotas quotaSource) (*throttled.HTTPRateLimiter, error) {
+	// validate the default quota upfront, API key quotas are validated when
+	// they are created
+	if _, err := throttled.NewGCRARateLimiter(1, *rateQuota); err != nil {
+		return nil, err
+	}
 
 	result := &throttled.HTTPRateLimiter{
-		RateLimiter: rateLimiter,
+		RateLimiter: newKeyedRateLimiter(*rateQuota, quotas),
 		DeniedHandler: http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
 			problem.Render(request.Context(), w, hProblem.RateLimitExceeded)
 		}),
-		VaryBy: VaryByRemoteIP{},
+		Error: func(w http.ResponseWriter, request *http.Request, err error) {
+			if errors.Cause(err) == apikeys.ErrUnknownKey {
+				err = hProblem.InvalidAPIKey
+			}
+			problem.Render(request.Context(), w, err)
+		},
+		VaryBy: VaryByAPIKey{},
 	}
 	return result, nil
 }
+
+// routeCost returns the number of requests charged for r.
+func routeCost(r *http.Request) int {
+	for _, route := range routeCosts {
+		if strings.HasPrefix(r.URL.Path, route.prefix) {
+			return route.cost
+		}
+	}
+	return 1
+}
+
+// rateLimitMiddleware is the equivalent of the RateLimit middleware of
+// throttled.HTTPRateLimiter charging requests the cost of their route.
+func rateLimitMiddleware(rateLimiter *throttled.HTTPRateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limited, result, err := rateLimiter.RateLimiter.RateLimit(
+				rateLimiter.VaryBy.Key(r),
+				routeCost(r),
+			)
+			if err != nil {
+				rateLimiter.Error(w, r, err)
+				return
+			}
+
+			setRateLimitHeaders(w, result)
+			if limited {
+				rateLimiter.DeniedHandler.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func setRateLimitHeaders(w http.ResponseWriter, result throttled.RateLimitResult) {
+	if v := result.Limit; v >= 0 {
+		w.Header().Add("X-RateLimit-Limit", strconv.Itoa(v))
+	}
+	if v := result.Remaining; v >= 0 {
+		w.Header().Add("X-RateLimit-Remaining", strconv.Itoa(v))
+	}
+	if v := result.ResetAfter; v >= 0 {
+		w.Header().Add("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil(v.Seconds()))))
+	}
+	if v := result.RetryAfter; v >= 0 {
+		w.Header().Add("Retry-After", strconv.Itoa(int(math.Ceil(v.Seconds()))))
+	}
+}
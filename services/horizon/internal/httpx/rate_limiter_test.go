@@ -0,0 +1,169 @@
+package httpx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stellar/throttled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stellar/go/services/horizon/internal/apikeys"
+)
+
+// testQuotas never caches quotas, every call to Get is a lookup.
+type testQuotas struct {
+	quotas  map[string]throttled.RateQuota
+	lookups int
+}
+
+func (q *testQuotas) Cached(keyHash string) (throttled.RateQuota, bool, error) {
+	return throttled.RateQuota{}, false, nil
+}
+
+func (q *testQuotas) Get(keyHash string) (throttled.RateQuota, error) {
+	q.lookups++
+	quota, ok := q.quotas[keyHash]
+	if !ok {
+		return quota, apikeys.ErrUnknownKey
+	}
+	return quota, nil
+}
+
+func TestVaryByAPIKey(t *testing.T) {
+	r := httptest.NewRequest("GET", "/ledgers", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	assert.Equal(t, "10.0.0.1", VaryByAPIKey{}.Key(r))
+
+	r.Header.Set(apikeys.Header, "foo")
+	key := VaryByAPIKey{}.Key(r)
+	assert.Equal(t, apiKeyPrefix+apikeys.Hash("foo")+"@10.0.0.1", key)
+
+	keyHash, ip := splitAPIKey(key)
+	assert.Equal(t, apikeys.Hash("foo"), keyHash)
+	assert.Equal(t, "10.0.0.1", ip)
+}
+
+func TestRouteCost(t *testing.T) {
+	for path, cost := range map[string]int{
+		"/ledgers/1":                         1,
+		"/accounts/GABC/payments":            1,
+		"/paths/strict-receive":              10,
+		"/paths/strict-send":                 10,
+		"/trade_aggregations?resolution=60":  5,
+		"/transactions?cursor=now&limit=200": 1,
+	} {
+		assert.Equal(t, cost, routeCost(httptest.NewRequest("GET", path, nil)), path)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	quotas := &testQuotas{quotas: map[string]throttled.RateQuota{
+		apikeys.Hash("partner"): {MaxRate: throttled.PerHour(3600), MaxBurst: 19},
+	}}
+	rateLimiter, err := newRateLimiter(&throttled.RateQuota{
+		MaxRate:  throttled.PerHour(3600),
+		MaxBurst: 9,
+	}, quotas)
+	require.NoError(t, err)
+
+	handler := rateLimitMiddleware(rateLimiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	request := func(path, apiKey string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", path, nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		if apiKey != "" {
+			r.Header.Set(apikeys.Header, apiKey)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request("/ledgers/1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "10", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "9", w.Header().Get("X-RateLimit-Remaining"))
+
+	// path finding costs 10 requests, more than the remaining ones
+	w = request("/paths/strict-send", "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+
+	w = request("/trade_aggregations", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "4", w.Header().Get("X-RateLimit-Remaining"))
+
+	// the API key has its own quota, independent of the IP address
+	w = request("/paths/strict-send", "partner")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "20", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "10", w.Header().Get("X-RateLimit-Remaining"))
+
+	w = request("/ledgers/1", "unknown")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid_api_key")
+
+	// the unknown key was charged to the IP address
+	w = request("/ledgers/1", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Remaining"))
+}
+
+func TestRateLimitMiddlewareUnknownKeys(t *testing.T) {
+	quotas := &testQuotas{}
+	rateLimiter, err := newRateLimiter(&throttled.RateQuota{
+		MaxRate:  throttled.PerHour(3600),
+		MaxBurst: 9,
+	}, quotas)
+	require.NoError(t, err)
+
+	handler := rateLimitMiddleware(rateLimiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	codes := map[int]int{}
+	for i := 0; i < 100; i++ {
+		r := httptest.NewRequest("GET", "/ledgers/1", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		r.Header.Set(apikeys.Header, fmt.Sprintf("random-%d", i))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		codes[w.Code]++
+	}
+
+	// random keys are rejected until the IP address is out of quota, and
+	// are not looked up anymore once it is out of lookups
+	assert.Equal(t, map[int]int{
+		http.StatusUnauthorized:    10,
+		http.StatusTooManyRequests: 90,
+	}, codes)
+	assert.Equal(t, 10, quotas.lookups)
+
+	// other IP addresses are not affected
+	r := httptest.NewRequest("GET", "/ledgers/1", nil)
+	r.RemoteAddr = "10.0.0.2:1234"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestKeyedRateLimiterCapsQuantity(t *testing.T) {
+	limiter := newKeyedRateLimiter(throttled.RateQuota{
+		MaxRate:  throttled.PerHour(3600),
+		MaxBurst: 4,
+	}, &testQuotas{})
+
+	// a request costing more than the burst is permitted once the whole
+	// burst is available
+	limited, result, err := limiter.RateLimit("10.0.0.1", 10)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	assert.Equal(t, 0, result.Remaining)
+
+	limited, _, err = limiter.RateLimit("10.0.0.1", 1)
+	require.NoError(t, err)
+	assert.True(t, limited)
+}
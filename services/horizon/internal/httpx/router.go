@@ -17,9 +17,12 @@ import (
 	"github.com/stellar/throttled"
 
 	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/services/horizon/internal/apikeys"
+	"github.com/stellar/go/services/horizon/internal/gql"
 	"github.com/stellar/go/services/horizon/internal/paths"
 	"github.com/stellar/go/services/horizon/internal/render/sse"
 	"github.com/stellar/go/services/horizon/internal/txsub"
+	"github.com/stellar/go/services/horizon/internal/webhooks"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/render/problem"
 )
@@ -56,7 +59,7 @@ func NewRouter(config *RouterConfig, serverMetrics *ServerMetrics) (*Router, err
 	var rateLimiter *throttled.HTTPRateLimiter
 	if config.RateQuota != nil {
 		var err error
-		rateLimiter, err = newRateLimiter(config.RateQuota)
+		rateLimiter, err = newRateLimiter(config.RateQuota, apikeys.NewQuotas(config.DBSession))
 		if err != nil {
 			return nil, fmt.Errorf("unable to create RateLimiter: %v", err)
 		}
@@ -89,7 +92,7 @@ func (r *Router) addMiddleware(config *RouterConfig,
 	r.Use(c.Handler)
 
 	if rateLimitter != nil {
-		r.Use(rateLimitter.RateLimit)
+		r.Use(rateLimitMiddleware(rateLimitter))
 	}
 
 	// Internal middlewares
@@ -146,6 +149,11 @@ func (r *Router) addRoutes(config *RouterConfig, rateLimiter *throttled.HTTPRate
 			r.Method(http.MethodGet, "/{offer_id}", ObjectActionHandler{actions.GetOfferByID{}})
 		})
 
+		r.Route("/claimable_balances", func(r chi.Router) {
+			r.Method(http.MethodGet, "/", restPageHandler(actions.GetClaimableBalancesHandler{}))
+			r.Method(http.MethodGet, "/{id}", ObjectActionHandler{actions.GetClaimableBalanceByIDHandler{}})
+		})
+
 		r.Method(http.MethodGet, "/assets", restPageHandler(actions.AssetStatsHandler{}))
 
 		findPaths := ObjectActionHandler{actions.FindPathsHandler{
@@ -174,6 +182,12 @@ func (r *Router) addRoutes(config *RouterConfig, rateLimiter *throttled.HTTPRate
 				action:        actions.GetOrderbookHandler{},
 			},
 		)
+
+		// the GraphQL resolvers query both the state and the history tables
+		// within the repeatable read transaction of the request, so the
+		// history must not be stale either
+		r.With(NewStaleHistoryMiddleware(int32(config.StaleThreshold))).
+			Method(http.MethodPost, "/graphql", gql.NewHandler(gql.Config{RateLimiter: rateLimiter}))
 	})
 
 	// account actions - /accounts/{account_id} has been created above so we
@@ -232,6 +246,7 @@ func (r *Router) addRoutes(config *RouterConfig, rateLimiter *throttled.HTTPRate
 		r.Method(http.MethodGet, "/", streamableHistoryPageHandler(actions.GetOperationsHandler{
 			OnlyPayments: false,
 		}, streamHandler))
+		r.Method(http.MethodGet, "/search", restPageHandler(actions.SearchOperationsHandler{}))
 		r.Method(http.MethodGet, "/{id}", ObjectActionHandler{actions.GetOperationByIDHandler{}})
 		r.Method(http.MethodGet, "/{op_id}/effects", streamableHistoryPageHandler(actions.GetEffectsHandler{}, streamHandler))
 	})
@@ -278,6 +293,8 @@ func (r *Router) addRoutes(config *RouterConfig, rateLimiter *throttled.HTTPRate
 	})
 
 	// internal
+	r.Internal.Mount("/webhooks", webhooks.Handler(config.DBSession))
+	r.Internal.Mount("/api_keys", apikeys.Handler(config.DBSession))
 	r.Internal.Get("/metrics", promhttp.HandlerFor(config.PrometheusRegistry, promhttp.HandlerOpts{}).ServeHTTP)
 	r.Internal.Get("/debug/pprof/heap", pprof.Index)
 	r.Internal.Get("/debug/pprof/profile", pprof.Profile)
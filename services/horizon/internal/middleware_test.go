@@ -359,3 +359,24 @@ func TestCheckHistoryStaleMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestStaleHistoryMiddleware(t *testing.T) {
+	defer ledger.SetState(ledger.CurrentState())
+	request, err := http.NewRequest("POST", "http://localhost/graphql", nil)
+	assert.NoError(t, err)
+
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	handler := httpx.NewStaleHistoryMiddleware(1)(http.HandlerFunc(endpoint))
+
+	ledger.SetState(ledger.State{CoreLatest: 4, HistoryLatest: 2})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, request)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	ledger.SetState(ledger.State{CoreLatest: 6, HistoryLatest: 5})
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, request)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -23,12 +23,23 @@ var (
 		Type:   "rate_limit_exceeded",
 		Title:  "Rate Limit Exceeded",
 		Status: 429,
-		Detail: "The rate limit for the requesting IP address is over its alloted " +
-			"limit.  The allowed limit and requests left per time period are " +
+		Detail: "The rate limit for the requesting IP address or API key is over " +
+			"its alloted limit.  The allowed limit and requests left per time period are " +
 			"communicated to clients via the http response headers 'X-RateLimit-*' " +
 			"headers.",
 	}
 
+	// InvalidAPIKey is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	InvalidAPIKey = problem.P{
+		Type:   "invalid_api_key",
+		Title:  "Invalid API Key",
+		Status: http.StatusUnauthorized,
+		Detail: "The API key sent in the 'X-API-Key' header or in the 'api_key' " +
+			"query parameter does not exist.  Requests without an API key are " +
+			"rate limited by IP address.",
+	}
+
 	// NotImplemented is a well-known problem type.  Use it as a shortcut
 	// in your actions.
 	NotImplemented = problem.P{
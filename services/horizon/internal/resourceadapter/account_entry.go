@@ -31,6 +31,9 @@ func PopulateAccountEntry(
 	dest.InflationDestination = account.InflationDestination
 	dest.HomeDomain = account.HomeDomain
 	dest.LastModifiedLedger = account.LastModifiedLedger
+	dest.NumSponsoring = account.NumSponsoring
+	dest.NumSponsored = account.NumSponsored
+	dest.Sponsor = account.Sponsor.String
 	if ledger != nil {
 		dest.LastModifiedTime = &ledger.ClosedAt
 	}
@@ -77,6 +80,7 @@ func PopulateAccountEntry(
 		dest.Signers[i].Weight = signer.Weight
 		dest.Signers[i].Key = signer.Signer
 		dest.Signers[i].Type = protocol.MustKeyTypeFromAddress(signer.Signer)
+		dest.Signers[i].Sponsor = signer.Sponsor.String
 
 		if account.AccountID == signer.Signer {
 			masterKeyIncluded = true
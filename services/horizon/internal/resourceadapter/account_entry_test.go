@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/guregu/null"
 	"github.com/stellar/go/amount"
 	. "github.com/stellar/go/protocols/horizon"
 	protocol "github.com/stellar/go/protocols/horizon"
@@ -37,6 +38,8 @@ var (
 
 	inflationDest = xdr.MustAddress("GBUH7T6U36DAVEKECMKN5YEBQYZVRBPNSZAAKBCO6P5HBMDFSQMQL4Z4")
 
+	sponsor = xdr.MustAddress("GAHK7EEG2WWHVKDNT4CEQFZGKF2LGDSW2IVM4S5DP42RBW3K6BTODB4A")
+
 	account = history.AccountEntry{
 		AccountID:            accountID.Address(),
 		Balance:              20000,
@@ -51,6 +54,9 @@ var (
 		SellingLiabilities:   4,
 		BuyingLiabilities:    3,
 		LastModifiedLedger:   1000,
+		Sponsor:              null.StringFrom(sponsor.Address()),
+		NumSponsored:         2,
+		NumSponsoring:        1,
 	}
 
 	ledgerWithCloseTime = &history.Ledger{
@@ -89,6 +95,7 @@ var (
 			SellingLiabilities: 2,
 			BuyingLiabilities:  1,
 			LastModifiedLedger: 900,
+			Sponsor:            null.StringFrom(sponsor.Address()),
 		},
 	}
 
@@ -103,6 +110,7 @@ var (
 			Account: accountID.Address(),
 			Signer:  "GCMQBJWOLTCSSMWNVDJAXL6E42SADH563IL5MN5B6RBBP4XP7TBRLJKE",
 			Weight:  int32(1),
+			Sponsor: null.StringFrom(sponsor.Address()),
 		},
 		{
 			Account: accountID.Address(),
@@ -134,6 +142,9 @@ func TestPopulateAccountEntry(t *testing.T) {
 	tt.Equal(account.LastModifiedLedger, hAccount.LastModifiedLedger)
 	tt.NotNil(hAccount.LastModifiedTime)
 	tt.Equal(ledgerWithCloseTime.ClosedAt, *hAccount.LastModifiedTime)
+	tt.Equal(account.Sponsor.String, hAccount.Sponsor)
+	tt.Equal(account.NumSponsored, hAccount.NumSponsored)
+	tt.Equal(account.NumSponsoring, hAccount.NumSponsoring)
 
 	wantAccountThresholds := AccountThresholds{
 		LowThreshold:  account.ThresholdLow,
@@ -172,6 +183,7 @@ func TestPopulateAccountEntry(t *testing.T) {
 		tt.Equal(amount.StringFromInt64(t.Limit), ht.Limit)
 		tt.Equal(t.LastModifiedLedger, ht.LastModifiedLedger)
 		tt.Equal(t.IsAuthorized(), *ht.IsAuthorized)
+		tt.Equal(t.Sponsor.String, ht.Sponsor)
 	}
 
 	native := hAccount.Balances[len(hAccount.Balances)-1]
@@ -190,6 +202,7 @@ func TestPopulateAccountEntry(t *testing.T) {
 		tt.Equal(s.Signer, hs.Key)
 		tt.Equal(s.Weight, hs.Weight)
 		tt.Equal(protocol.MustKeyTypeFromAddress(s.Signer), hs.Type)
+		tt.Equal(s.Sponsor.String, hs.Sponsor)
 	}
 
 	links, err := json.Marshal(hAccount.Links)
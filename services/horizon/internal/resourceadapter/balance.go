@@ -22,6 +22,7 @@ func PopulateBalance(dest *protocol.Balance, row history.TrustLine) (err error)
 	dest.Issuer = row.AssetIssuer
 	dest.Code = row.AssetCode
 	dest.LastModifiedLedger = row.LastModifiedLedger
+	dest.Sponsor = row.Sponsor.String
 	isAuthorized := row.IsAuthorized()
 	dest.IsAuthorized = &isAuthorized
 	dest.IsAuthorizedToMaintainLiabilities = &isAuthorized
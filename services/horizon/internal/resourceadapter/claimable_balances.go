@@ -0,0 +1,40 @@
+package resourceadapter
+
+import (
+	"context"
+
+	"github.com/stellar/go/amount"
+	protocol "github.com/stellar/go/protocols/horizon"
+	horizonContext "github.com/stellar/go/services/horizon/internal/context"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/render/hal"
+)
+
+// PopulateClaimableBalance constructs a claimable balance response struct from
+// a row extracted from the horizon claimable_balances table.
+func PopulateClaimableBalance(
+	ctx context.Context,
+	dest *protocol.ClaimableBalance,
+	row history.ClaimableBalance,
+	ledger *history.Ledger,
+) {
+	dest.BalanceID = row.BalanceID
+	dest.PT = row.BalanceID
+	dest.Asset = row.Asset.StringCanonical()
+	dest.Amount = amount.String(row.Amount)
+	dest.Sponsor = row.Sponsor.String
+	dest.Claimants = make([]protocol.Claimant, len(row.Claimants))
+	for i, claimant := range row.Claimants {
+		dest.Claimants[i] = protocol.Claimant{
+			Destination: claimant.Destination,
+			Predicate:   claimant.Predicate,
+		}
+	}
+
+	dest.LastModifiedLedger = row.LastModifiedLedger
+	if ledger != nil {
+		dest.LastModifiedTime = &ledger.ClosedAt
+	}
+	lb := hal.LinkBuilder{Base: horizonContext.BaseURL(ctx)}
+	dest.Links.Self = lb.Linkf("/claimable_balances/%s", row.BalanceID)
+}
@@ -36,6 +36,24 @@ var EffectTypeNames = map[history.EffectType]string{
 	history.EffectDataRemoved:                              "data_removed",
 	history.EffectDataUpdated:                              "data_updated",
 	history.EffectSequenceBumped:                           "sequence_bumped",
+	history.EffectClaimableBalanceCreated:                  "claimable_balance_created",
+	history.EffectClaimableBalanceClaimantCreated:          "claimable_balance_claimant_created",
+	history.EffectClaimableBalanceClaimed:                  "claimable_balance_claimed",
+	history.EffectAccountSponsorshipCreated:                "account_sponsorship_created",
+	history.EffectAccountSponsorshipUpdated:                "account_sponsorship_updated",
+	history.EffectAccountSponsorshipRemoved:                "account_sponsorship_removed",
+	history.EffectTrustlineSponsorshipCreated:              "trustline_sponsorship_created",
+	history.EffectTrustlineSponsorshipUpdated:              "trustline_sponsorship_updated",
+	history.EffectTrustlineSponsorshipRemoved:              "trustline_sponsorship_removed",
+	history.EffectDataSponsorshipCreated:                   "data_sponsorship_created",
+	history.EffectDataSponsorshipUpdated:                   "data_sponsorship_updated",
+	history.EffectDataSponsorshipRemoved:                   "data_sponsorship_removed",
+	history.EffectClaimableBalanceSponsorshipCreated:       "claimable_balance_sponsorship_created",
+	history.EffectClaimableBalanceSponsorshipUpdated:       "claimable_balance_sponsorship_updated",
+	history.EffectClaimableBalanceSponsorshipRemoved:       "claimable_balance_sponsorship_removed",
+	history.EffectSignerSponsorshipCreated:                 "signer_sponsorship_created",
+	history.EffectSignerSponsorshipUpdated:                 "signer_sponsorship_updated",
+	history.EffectSignerSponsorshipRemoved:                 "signer_sponsorship_removed",
 }
 
 // NewEffect creates a new effect resource from the provided database representation
@@ -135,6 +153,78 @@ func NewEffect(
 			e.NewSeq = hsb.NewSeq
 		}
 		result = e
+	case history.EffectClaimableBalanceCreated:
+		e := effects.ClaimableBalanceCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectClaimableBalanceClaimantCreated:
+		e := effects.ClaimableBalanceClaimantCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectClaimableBalanceClaimed:
+		e := effects.ClaimableBalanceClaimed{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectAccountSponsorshipCreated:
+		e := effects.AccountSponsorshipCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectAccountSponsorshipUpdated:
+		e := effects.AccountSponsorshipUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectAccountSponsorshipRemoved:
+		e := effects.AccountSponsorshipRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectTrustlineSponsorshipCreated:
+		e := effects.TrustlineSponsorshipCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectTrustlineSponsorshipUpdated:
+		e := effects.TrustlineSponsorshipUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectTrustlineSponsorshipRemoved:
+		e := effects.TrustlineSponsorshipRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectDataSponsorshipCreated:
+		e := effects.DataSponsorshipCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectDataSponsorshipUpdated:
+		e := effects.DataSponsorshipUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectDataSponsorshipRemoved:
+		e := effects.DataSponsorshipRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectClaimableBalanceSponsorshipCreated:
+		e := effects.ClaimableBalanceSponsorshipCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectClaimableBalanceSponsorshipUpdated:
+		e := effects.ClaimableBalanceSponsorshipUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectClaimableBalanceSponsorshipRemoved:
+		e := effects.ClaimableBalanceSponsorshipRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectSignerSponsorshipCreated:
+		e := effects.SignerSponsorshipCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectSignerSponsorshipUpdated:
+		e := effects.SignerSponsorshipUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectSignerSponsorshipRemoved:
+		e := effects.SignerSponsorshipRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	default:
 		result = basev
 	}
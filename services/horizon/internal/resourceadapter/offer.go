@@ -30,6 +30,7 @@ func PopulateOffer(ctx context.Context, dest *protocol.Offer, row history.Offer,
 	if ledger != nil {
 		dest.LastModifiedTime = &ledger.ClosedAt
 	}
+	dest.Sponsor = row.Sponsor.String
 	lb := hal.LinkBuilder{horizonContext.BaseURL(ctx)}
 	dest.Links.Self = lb.Linkf("/offers/%d", row.OfferID)
 	dest.Links.OfferMaker = lb.Linkf("/accounts/%s", row.SellerID)
@@ -108,6 +108,26 @@ func NewOperation(
 		e.Payment.Base = base
 		err = operationRow.UnmarshalDetails(&e)
 		result = e
+	case xdr.OperationTypeCreateClaimableBalance:
+		e := operations.CreateClaimableBalance{Base: base}
+		err = operationRow.UnmarshalDetails(&e)
+		result = e
+	case xdr.OperationTypeClaimClaimableBalance:
+		e := operations.ClaimClaimableBalance{Base: base}
+		err = operationRow.UnmarshalDetails(&e)
+		result = e
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		e := operations.BeginSponsoringFutureReserves{Base: base}
+		err = operationRow.UnmarshalDetails(&e)
+		result = e
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		e := operations.EndSponsoringFutureReserves{Base: base}
+		err = operationRow.UnmarshalDetails(&e)
+		result = e
+	case xdr.OperationTypeRevokeSponsorship:
+		e := operations.RevokeSponsorship{Base: base}
+		err = operationRow.UnmarshalDetails(&e)
+		result = e
 	default:
 		result = base
 	}
@@ -130,6 +130,35 @@ func TestPopulateOperation_AllowTrust(t *testing.T) {
 	tt.Equal(false, rsp["authorize_to_maintain_liabilities"])
 }
 
+func TestPopulateOperation_RevokeSponsorship(t *testing.T) {
+	tt := assert.New(t)
+	ctx, _ := test.ContextWithLogBuffer()
+
+	operationsRow := history.Operation{
+		TransactionSuccessful: true,
+		Type:                  xdr.OperationTypeRevokeSponsorship,
+		DetailsString:         null.StringFrom(`{"offer_id": "1000"}`),
+	}
+	resource, err := NewOperation(ctx, operationsRow, "", nil, history.Ledger{})
+	tt.NoError(err)
+
+	op, ok := resource.(operations.RevokeSponsorship)
+	tt.True(ok)
+	tt.Equal("revoke_sponsorship", op.Type)
+	if tt.NotNil(op.OfferID) {
+		tt.Equal(int64(1000), *op.OfferID)
+	}
+	tt.Nil(op.AccountID)
+	tt.Nil(op.SignerKey)
+
+	data, err := json.Marshal(resource)
+	tt.NoError(err)
+	var rsp map[string]interface{}
+	tt.NoError(json.Unmarshal(data, &rsp))
+	tt.Equal("1000", rsp["offer_id"])
+	tt.NotContains(rsp, "account_id")
+}
+
 func getJSONResponse(details string) (rsp map[string]interface{}, err error) {
 	ctx, _ := test.ContextWithLogBuffer()
 	transactionRow := history.Transaction{
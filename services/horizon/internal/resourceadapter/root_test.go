@@ -15,6 +15,7 @@ func TestPopulateRoot(t *testing.T) {
 	res := &horizon.Root{}
 	templates := map[string]string{
 		"accounts":           "/accounts{?signer,asset_type,asset_issuer,asset_code}",
+		"claimableBalances":  "/claimable_balances",
 		"offers":             "/offers",
 		"strictReceivePaths": "/paths/strict-receive",
 		"strictSendPaths":    "/paths/strict-send",
@@ -76,6 +77,7 @@ func TestPopulateRoot(t *testing.T) {
 	)
 
 	assert.Equal(t, templates["accounts"], res.Links.Accounts.Href)
+	assert.Equal(t, templates["claimableBalances"], res.Links.ClaimableBalances.Href)
 	assert.Equal(t, "/offers/{offer_id}", res.Links.Offer.Href)
 	assert.Equal(
 		t,
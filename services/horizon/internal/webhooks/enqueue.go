@@ -0,0 +1,307 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+const pageSize = 200
+
+// Enqueuer enqueues the deliveries of ingested ledgers.
+type Enqueuer struct {
+	historyQ *history.Q
+}
+
+// NewEnqueuer returns an Enqueuer using historyQ, which should be the session
+// in which ledgers are ingested so that deliveries are committed along with
+// the ledger.
+func NewEnqueuer(historyQ *history.Q) *Enqueuer {
+	return &Enqueuer{historyQ: historyQ}
+}
+
+// EnqueueLedger enqueues the deliveries of the operations and effects of the
+// given ledger to all the matching webhooks.
+func (e *Enqueuer) EnqueueLedger(sequence uint32) error {
+	webhooks, err := e.historyQ.GetWebhooks()
+	if err != nil {
+		return errors.Wrap(err, "could not load webhooks")
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+	_, err = enqueue(e.historyQ, webhooks, int32(sequence), time.Now().UTC())
+	return err
+}
+
+// Replay enqueues again the deliveries of the ledgers in [from, to] to
+// webhook. It returns the number of enqueued deliveries.
+func Replay(q *history.Q, webhook history.Webhook, from, to int32) (int, error) {
+	now := time.Now().UTC()
+	total := 0
+	for sequence := from; sequence <= to; sequence++ {
+		n, err := enqueue(q, []history.Webhook{webhook}, sequence, now)
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// event is an operation or effect of a ledger along with what webhooks filter
+// on.
+type event struct {
+	id            string
+	typ           string
+	accounts      map[string]bool
+	operationType xdr.OperationType
+	assets        map[string]bool
+	data          json.RawMessage
+}
+
+func (ev event) matches(webhook history.Webhook) bool {
+	if webhook.AccountID.Valid && !ev.accounts[webhook.AccountID.String] {
+		return false
+	}
+	if webhook.OperationType.Valid && int64(ev.operationType) != webhook.OperationType.Int64 {
+		return false
+	}
+	if webhook.Asset.Valid && !ev.assets[webhook.Asset.String] {
+		return false
+	}
+	return true
+}
+
+// enqueue enqueues the deliveries of the given ledger to the matching
+// webhooks and returns their number. Ledgers which are not in the history
+// have no deliveries.
+func enqueue(q *history.Q, webhooks []history.Webhook, sequence int32, now time.Time) (int, error) {
+	var ledger history.Ledger
+	err := q.LedgerBySequence(&ledger, sequence)
+	if q.NoRows(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "could not load ledger")
+	}
+
+	events, err := loadEvents(q, webhooks, ledger)
+	if err != nil {
+		return 0, err
+	}
+
+	var deliveries []history.WebhookDelivery
+	for _, webhook := range webhooks {
+		for _, ev := range events {
+			if !ev.matches(webhook) {
+				continue
+			}
+			payload, err := json.Marshal(Payload{
+				ID:        ev.id,
+				WebhookID: webhook.ID,
+				Type:      ev.typ,
+				Ledger:    sequence,
+				Data:      ev.data,
+			})
+			if err != nil {
+				return 0, errors.Wrap(err, "could not marshal payload")
+			}
+			deliveries = append(deliveries, history.WebhookDelivery{
+				WebhookID:      webhook.ID,
+				LedgerSequence: sequence,
+				Payload:        string(payload),
+				NextAttemptAt:  now,
+				CreatedAt:      now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	if err := q.InsertWebhookDeliveries(deliveries); err != nil {
+		return 0, errors.Wrap(err, "could not insert webhook deliveries")
+	}
+	return len(deliveries), nil
+}
+
+// loadEvents loads the successful operations of ledger and their effects.
+func loadEvents(q *history.Q, webhooks []history.Webhook, ledger history.Ledger) ([]event, error) {
+	ctx := q.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	operations, err := loadOperations(q, ledger.Sequence, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(operations) == 0 {
+		return nil, nil
+	}
+
+	// participants maps the operation ids to the filtered accounts which
+	// participate in them.
+	participants := map[int64]map[string]bool{}
+	for _, account := range filteredAccounts(webhooks) {
+		accountOperations, err := loadOperations(q, ledger.Sequence, account)
+		if err != nil {
+			return nil, err
+		}
+		for _, operation := range accountOperations {
+			if participants[operation.ID] == nil {
+				participants[operation.ID] = map[string]bool{}
+			}
+			participants[operation.ID][account] = true
+		}
+	}
+
+	var events []event
+	operationTypes := map[int64]xdr.OperationType{}
+	for _, operation := range operations {
+		operationTypes[operation.ID] = operation.Type
+
+		resource, err := resourceadapter.NewOperation(
+			ctx, operation, operation.TransactionHash, nil, ledger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build operation resource")
+		}
+		data, err := json.Marshal(resource)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal operation resource")
+		}
+		var details map[string]interface{}
+		if err := operation.UnmarshalDetails(&details); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal operation details")
+		}
+		events = append(events, event{
+			id:            operation.PagingToken(),
+			typ:           PayloadTypeOperation,
+			accounts:      participants[operation.ID],
+			operationType: operation.Type,
+			assets:        assets(details),
+			data:          data,
+		})
+	}
+
+	effects, err := loadEffects(q, ledger.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	for _, effect := range effects {
+		operationType, ok := operationTypes[effect.HistoryOperationID]
+		if !ok {
+			// effects of failed transactions are not ingested so this
+			// should never happen
+			return nil, errors.Errorf("operation %d of effect not found", effect.HistoryOperationID)
+		}
+
+		resource, err := resourceadapter.NewEffect(ctx, effect, ledger)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build effect resource")
+		}
+		data, err := json.Marshal(resource)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal effect resource")
+		}
+		var details map[string]interface{}
+		if err := effect.UnmarshalDetails(&details); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal effect details")
+		}
+		events = append(events, event{
+			id:            effect.PagingToken(),
+			typ:           PayloadTypeEffect,
+			accounts:      map[string]bool{effect.Account: true},
+			operationType: operationType,
+			assets:        assets(details),
+			data:          data,
+		})
+	}
+	return events, nil
+}
+
+func filteredAccounts(webhooks []history.Webhook) []string {
+	var accounts []string
+	seen := map[string]bool{}
+	for _, webhook := range webhooks {
+		if !webhook.AccountID.Valid || seen[webhook.AccountID.String] {
+			continue
+		}
+		seen[webhook.AccountID.String] = true
+		accounts = append(accounts, webhook.AccountID.String)
+	}
+	return accounts
+}
+
+// loadOperations loads the successful operations of a ledger, only those in
+// which account participates if account is not empty.
+func loadOperations(q *history.Q, sequence int32, account string) ([]history.Operation, error) {
+	var all []history.Operation
+	pq := db2.PageQuery{Order: db2.OrderAscending, Limit: pageSize}
+	for {
+		query := q.Operations()
+		if account != "" {
+			query.ForAccount(account)
+		}
+		operations, _, err := query.ForLedger(sequence).Page(pq).Fetch()
+		if account != "" && q.NoRows(errors.Cause(err)) {
+			// the account is not in the history
+			return nil, nil
+		} else if err != nil {
+			return nil, errors.Wrap(err, "could not load operations")
+		}
+		all = append(all, operations...)
+		if len(operations) < pageSize {
+			return all, nil
+		}
+		pq.Cursor = operations[len(operations)-1].PagingToken()
+	}
+}
+
+func loadEffects(q *history.Q, sequence int32) ([]history.Effect, error) {
+	var all []history.Effect
+	pq := db2.PageQuery{Order: db2.OrderAscending, Limit: pageSize}
+	for {
+		var effects []history.Effect
+		err := q.Effects().ForLedger(sequence).Page(pq).Select(&effects)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load effects")
+		}
+		all = append(all, effects...)
+		if len(effects) < pageSize {
+			return all, nil
+		}
+		pq.Cursor = effects[len(effects)-1].PagingToken()
+	}
+}
+
+// assetDetailPrefixes are the prefixes of the asset_type, asset_code and
+// asset_issuer keys in the details of operations and effects.
+var assetDetailPrefixes = []string{"", "selling_", "buying_", "source_", "sold_", "bought_"}
+
+// assets returns the assets found in details, in the format defined by
+// SEP-0011.
+func assets(details map[string]interface{}) map[string]bool {
+	found := map[string]bool{}
+	for _, prefix := range assetDetailPrefixes {
+		assetType, _ := details[prefix+"asset_type"].(string)
+		switch assetType {
+		case "":
+			continue
+		case "native":
+			found["native"] = true
+		default:
+			code, _ := details[prefix+"asset_code"].(string)
+			issuer, _ := details[prefix+"asset_issuer"].(string)
+			found[code+":"+issuer] = true
+		}
+	}
+	return found
+}
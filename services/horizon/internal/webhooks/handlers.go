@@ -0,0 +1,391 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/guregu/null"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/httpjson"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+// MaxReplayLedgers is the maximum number of ledgers which can be replayed in
+// a single request.
+const MaxReplayLedgers = 1000
+
+// Webhook is the resource representing a registered webhook. The secret is
+// never rendered.
+type Webhook struct {
+	ID            int64       `json:"id"`
+	URL           string      `json:"url"`
+	AccountID     null.String `json:"account_id"`
+	OperationType null.Int    `json:"operation_type"`
+	Asset         null.String `json:"asset"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// DeadLetter is the resource representing a delivery which failed
+// MaxAttempts times.
+type DeadLetter struct {
+	ID        string          `json:"id"`
+	PT        string          `json:"paging_token"`
+	Ledger    int32           `json:"ledger"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int32           `json:"attempts"`
+	LastError null.String     `json:"last_error"`
+	CreatedAt time.Time       `json:"created_at"`
+	FailedAt  time.Time       `json:"failed_at"`
+}
+
+// webhookRequest is the body of a request registering a webhook.
+type webhookRequest struct {
+	URL           string  `json:"url"`
+	Secret        string  `json:"secret"`
+	AccountID     *string `json:"account_id"`
+	OperationType *int32  `json:"operation_type"`
+	Asset         *string `json:"asset"`
+}
+
+// replayRequest is the body of a request replaying a range of ledgers.
+type replayRequest struct {
+	FromLedger int32  `json:"from_ledger"`
+	ToLedger   *int32 `json:"to_ledger"`
+}
+
+// Handler serves the webhooks admin endpoints:
+//
+//	POST   /                            registers a webhook
+//	GET    /                            lists the webhooks
+//	GET    /{id}                        shows a webhook
+//	DELETE /{id}                        deletes a webhook and its deliveries
+//	GET    /{id}/dead_letters           lists the dead letters of a webhook
+//	POST   /{id}/dead_letters/requeue   requeues the dead letters of a webhook
+//	POST   /{id}/replay                 enqueues again the deliveries of a
+//	                                    range of ledgers
+func Handler(session *db.Session) http.Handler {
+	h := handler{session: session}
+	r := chi.NewRouter()
+	r.Post("/", h.create)
+	r.Get("/", h.list)
+	r.Route("/{id}", func(r chi.Router) {
+		r.Get("/", h.show)
+		r.Delete("/", h.delete)
+		r.Get("/dead_letters", h.deadLetters)
+		r.Post("/dead_letters/requeue", h.requeue)
+		r.Post("/replay", h.replay)
+	})
+	return r
+}
+
+type handler struct {
+	session *db.Session
+}
+
+func (h handler) historyQ(r *http.Request) *history.Q {
+	session := h.session.Clone()
+	session.Ctx = r.Context()
+	return &history.Q{Session: session}
+}
+
+func (h handler) create(w http.ResponseWriter, r *http.Request) {
+	var req webhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Render(r.Context(), w, problem.MakeInvalidFieldProblem("body", err))
+		return
+	}
+	webhook, err := req.webhook()
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+
+	webhook.ID, err = h.historyQ(r).InsertWebhook(webhook)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	httpjson.RenderStatus(w, http.StatusCreated, newWebhook(webhook), httpjson.JSON)
+}
+
+// webhook validates req and returns the webhook to register.
+func (req webhookRequest) webhook() (history.Webhook, error) {
+	webhook := history.Webhook{
+		URL:       req.URL,
+		Secret:    req.Secret,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return webhook, problem.MakeInvalidFieldProblem(
+			"url", errors.New("url must be an absolute http or https url"),
+		)
+	}
+	if req.Secret == "" {
+		return webhook, problem.MakeInvalidFieldProblem(
+			"secret", errors.New("secret must not be empty"),
+		)
+	}
+	if req.AccountID != nil {
+		if _, err := keypair.ParseAddress(*req.AccountID); err != nil {
+			return webhook, problem.MakeInvalidFieldProblem(
+				"account_id", errors.New("account_id must be a valid account address"),
+			)
+		}
+		webhook.AccountID = null.StringFrom(*req.AccountID)
+	}
+	if req.OperationType != nil {
+		if !xdr.OperationType(0).ValidEnum(*req.OperationType) {
+			return webhook, problem.MakeInvalidFieldProblem(
+				"operation_type", errors.New("operation_type must be a valid operation type"),
+			)
+		}
+		webhook.OperationType = null.IntFrom(int64(*req.OperationType))
+	}
+	if req.Asset != nil {
+		asset := *req.Asset
+		parsed, err := xdr.BuildAssets(asset)
+		if err != nil || len(parsed) != 1 {
+			return webhook, problem.MakeInvalidFieldProblem(
+				"asset", errors.New("asset must be native or of the form code:issuer"),
+			)
+		}
+		if parsed[0].Type == xdr.AssetTypeAssetTypeNative {
+			asset = "native"
+		}
+		webhook.Asset = null.StringFrom(asset)
+	}
+	return webhook, nil
+}
+
+func (h handler) list(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.historyQ(r).GetWebhooks()
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	records := []Webhook{}
+	for _, webhook := range webhooks {
+		records = append(records, newWebhook(webhook))
+	}
+	httpjson.Render(w, map[string]interface{}{"records": records}, httpjson.JSON)
+}
+
+func (h handler) show(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	httpjson.Render(w, newWebhook(webhook), httpjson.JSON)
+}
+
+func (h handler) delete(w http.ResponseWriter, r *http.Request) {
+	id, err := webhookID(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	deleted, err := h.historyQ(r).DeleteWebhook(id)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	if deleted == 0 {
+		problem.Render(r.Context(), w, problem.NotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h handler) deadLetters(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	pq, err := pageQuery(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	deadLetters, err := h.historyQ(r).GetWebhookDeadLetters(webhook.ID, pq)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	records := []DeadLetter{}
+	for _, deadLetter := range deadLetters {
+		records = append(records, newDeadLetter(deadLetter))
+	}
+	httpjson.Render(w, map[string]interface{}{"records": records}, httpjson.JSON)
+}
+
+func (h handler) requeue(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	requeued, err := h.historyQ(r).RequeueWebhookDeadLetters(webhook.ID, time.Now().UTC())
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	httpjson.Render(w, map[string]int64{"requeued": requeued}, httpjson.JSON)
+}
+
+func (h handler) replay(w http.ResponseWriter, r *http.Request) {
+	webhook, err := h.load(r)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	var req replayRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Render(r.Context(), w, problem.MakeInvalidFieldProblem("body", err))
+		return
+	}
+	from, to, err := req.ledgers()
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+
+	q := h.historyQ(r)
+	if to == 0 {
+		latest, err := q.GetLatestLedger()
+		if err != nil {
+			problem.Render(r.Context(), w, err)
+			return
+		}
+		to = int32(latest)
+		if to-from >= MaxReplayLedgers {
+			to = from + MaxReplayLedgers - 1
+		}
+	}
+
+	// enqueue all the deliveries or none of them
+	if err = q.Begin(); err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	defer q.Rollback()
+	enqueued, err := Replay(q, webhook, from, to)
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	if err = q.Commit(); err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	httpjson.Render(w, map[string]interface{}{
+		"from_ledger": from,
+		"to_ledger":   to,
+		"enqueued":    enqueued,
+	}, httpjson.JSON)
+}
+
+// ledgers validates req and returns the range of ledgers to replay. to is 0
+// if it should be the latest ingested ledger.
+func (req replayRequest) ledgers() (from, to int32, err error) {
+	if req.FromLedger <= 0 {
+		return 0, 0, problem.MakeInvalidFieldProblem(
+			"from_ledger", errors.New("from_ledger must be a positive ledger sequence"),
+		)
+	}
+	if req.ToLedger == nil {
+		return req.FromLedger, 0, nil
+	}
+	to = *req.ToLedger
+	if to < req.FromLedger {
+		return 0, 0, problem.MakeInvalidFieldProblem(
+			"to_ledger", errors.New("to_ledger must not be lower than from_ledger"),
+		)
+	}
+	if to-req.FromLedger >= MaxReplayLedgers {
+		return 0, 0, problem.MakeInvalidFieldProblem(
+			"to_ledger", fmt.Errorf("at most %d ledgers can be replayed at once", MaxReplayLedgers),
+		)
+	}
+	return req.FromLedger, to, nil
+}
+
+// load loads the webhook identified by the id url parameter.
+func (h handler) load(r *http.Request) (history.Webhook, error) {
+	id, err := webhookID(r)
+	if err != nil {
+		return history.Webhook{}, err
+	}
+	q := h.historyQ(r)
+	webhook, err := q.GetWebhookByID(id)
+	if q.NoRows(err) {
+		return webhook, problem.NotFound
+	}
+	return webhook, err
+}
+
+func webhookID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, problem.MakeInvalidFieldProblem("id", errors.New("id must be a webhook id"))
+	}
+	return id, nil
+}
+
+func pageQuery(r *http.Request) (db2.PageQuery, error) {
+	query := r.URL.Query()
+	limit := uint64(db2.DefaultPageSize)
+	if s := query.Get("limit"); s != "" {
+		var err error
+		limit, err = strconv.ParseUint(s, 10, 64)
+		if err != nil || limit == 0 || limit > db2.MaxPageSize {
+			return db2.PageQuery{}, problem.MakeInvalidFieldProblem(
+				"limit", fmt.Errorf("limit must be between 1 and %d", db2.MaxPageSize),
+			)
+		}
+	}
+	pq, err := db2.NewPageQuery(query.Get("cursor"), true, strings.ToLower(query.Get("order")), limit)
+	if err != nil {
+		return pq, problem.MakeInvalidFieldProblem("cursor", err)
+	}
+	return pq, nil
+}
+
+func newWebhook(row history.Webhook) Webhook {
+	return Webhook{
+		ID:            row.ID,
+		URL:           row.URL,
+		AccountID:     row.AccountID,
+		OperationType: row.OperationType,
+		Asset:         row.Asset,
+		CreatedAt:     row.CreatedAt,
+	}
+}
+
+func newDeadLetter(row history.WebhookDeadLetter) DeadLetter {
+	id := strconv.FormatInt(row.ID, 10)
+	return DeadLetter{
+		ID:        id,
+		PT:        id,
+		Ledger:    row.LedgerSequence,
+		Payload:   json.RawMessage(row.Payload),
+		Attempts:  row.Attempts,
+		LastError: row.LastError,
+		CreatedAt: row.CreatedAt,
+		FailedAt:  row.FailedAt,
+	}
+}
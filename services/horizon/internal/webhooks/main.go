@@ -0,0 +1,79 @@
+// Package webhooks delivers the operations and effects of ingested ledgers to
+// the webhooks registered through the admin router.
+//
+// Deliveries are enqueued in the Horizon DB in the same transaction in which
+// a ledger is ingested and they are removed only once the webhook responded
+// with a 2xx status code, so every payload is delivered at least once.
+// Receivers should use the payload id to discard duplicates. Failed deliveries
+// are retried with an exponential backoff and moved to the dead letters after
+// MaxAttempts attempts.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/stellar/go/support/log"
+)
+
+const (
+	// SignatureHeader is the header containing the signature of a payload.
+	SignatureHeader = "X-Stellar-Signature"
+	// DeliveryHeader is the header containing the id of a delivery.
+	DeliveryHeader = "X-Stellar-Delivery"
+
+	// MaxAttempts is the number of attempts after which a delivery is moved
+	// to the dead letters.
+	MaxAttempts = 10
+
+	minBackoff = 10 * time.Second
+	maxBackoff = time.Hour
+)
+
+var logger = log.DefaultLogger.WithField("service", "webhooks")
+
+// Payload is the JSON body POSTed to a webhook for every matching operation
+// or effect.
+type Payload struct {
+	// ID is the paging token of the operation or effect.
+	ID        string          `json:"id"`
+	WebhookID int64           `json:"webhook_id"`
+	Type      string          `json:"type"`
+	Ledger    int32           `json:"ledger"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Payload types.
+const (
+	PayloadTypeOperation = "operation"
+	PayloadTypeEffect    = "effect"
+)
+
+// Sign returns the value of the SignatureHeader for body, the hex encoded
+// HMAC-SHA256 of body keyed with the webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is the SignatureHeader of body.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// backoff returns the delay before the next attempt of a delivery which
+// failed attempts times.
+func backoff(attempts int32) time.Duration {
+	delay := minBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,160 @@
+package webhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	account = "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"
+	usd     = "USD:GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := Sign("secret", body)
+	assert.Equal(t, "sha256=6146142a2ce0159e84c0767881e4ec80bc397da62526e7d19f70795eb79460c0", signature)
+	assert.True(t, VerifySignature("secret", body, signature))
+	assert.False(t, VerifySignature("other", body, signature))
+	assert.False(t, VerifySignature("secret", []byte(`{"id":"2"}`), signature))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, backoff(1))
+	assert.Equal(t, 20*time.Second, backoff(2))
+	assert.Equal(t, 80*time.Second, backoff(4))
+	assert.Equal(t, 2560*time.Second, backoff(9))
+	assert.Equal(t, time.Hour, backoff(10))
+	assert.Equal(t, time.Hour, backoff(100))
+}
+
+func TestAssets(t *testing.T) {
+	assert.Equal(t, map[string]bool{}, assets(map[string]interface{}{"amount": "1.0"}))
+	assert.Equal(t, map[string]bool{"native": true}, assets(map[string]interface{}{
+		"asset_type": "native",
+	}))
+	assert.Equal(t, map[string]bool{"native": true, usd: true}, assets(map[string]interface{}{
+		"selling_asset_type":   "native",
+		"buying_asset_type":    "credit_alphanum4",
+		"buying_asset_code":    "USD",
+		"buying_asset_issuer":  account,
+		"unrelated_asset_type": "credit_alphanum4",
+	}))
+}
+
+func TestEventMatches(t *testing.T) {
+	ev := event{
+		accounts:      map[string]bool{account: true},
+		operationType: xdr.OperationTypePayment,
+		assets:        map[string]bool{usd: true},
+	}
+
+	assert.True(t, ev.matches(history.Webhook{}))
+	assert.True(t, ev.matches(history.Webhook{
+		AccountID:     null.StringFrom(account),
+		OperationType: null.IntFrom(int64(xdr.OperationTypePayment)),
+		Asset:         null.StringFrom(usd),
+	}))
+	assert.False(t, ev.matches(history.Webhook{
+		AccountID: null.StringFrom("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"),
+	}))
+	assert.False(t, ev.matches(history.Webhook{
+		OperationType: null.IntFrom(int64(xdr.OperationTypeCreateAccount)),
+	}))
+	assert.False(t, ev.matches(history.Webhook{Asset: null.StringFrom("native")}))
+
+	// operations without filtered participants
+	assert.False(t, event{}.matches(history.Webhook{AccountID: null.StringFrom(account)}))
+}
+
+func TestWebhookRequest(t *testing.T) {
+	str := func(s string) *string { return &s }
+	i32 := func(i int32) *int32 { return &i }
+
+	webhook, err := webhookRequest{
+		URL:           "https://example.com/hook",
+		Secret:        "secret",
+		AccountID:     str(account),
+		OperationType: i32(1),
+		Asset:         str("NATIVE"),
+	}.webhook()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", webhook.URL)
+	assert.Equal(t, null.StringFrom(account), webhook.AccountID)
+	assert.Equal(t, null.IntFrom(1), webhook.OperationType)
+	assert.Equal(t, null.StringFrom("native"), webhook.Asset)
+
+	for _, testCase := range []struct {
+		field   string
+		request webhookRequest
+	}{
+		{"url", webhookRequest{URL: "example.com", Secret: "secret"}},
+		{"url", webhookRequest{URL: "ftp://example.com", Secret: "secret"}},
+		{"secret", webhookRequest{URL: "https://example.com"}},
+		{"account_id", webhookRequest{URL: "https://example.com", Secret: "secret", AccountID: str("GABC")}},
+		{"operation_type", webhookRequest{URL: "https://example.com", Secret: "secret", OperationType: i32(100)}},
+		{"asset", webhookRequest{URL: "https://example.com", Secret: "secret", Asset: str("USD")}},
+	} {
+		_, err := testCase.request.webhook()
+		require.Error(t, err)
+		p, ok := err.(*problem.P)
+		require.True(t, ok)
+		assert.Equal(t, testCase.field, p.Extras["invalid_field"])
+	}
+}
+
+func TestReplayRequest(t *testing.T) {
+	i32 := func(i int32) *int32 { return &i }
+
+	from, to, err := replayRequest{FromLedger: 10}.ledgers()
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), from)
+	assert.Equal(t, int32(0), to)
+
+	from, to, err = replayRequest{FromLedger: 10, ToLedger: i32(1009)}.ledgers()
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), from)
+	assert.Equal(t, int32(1009), to)
+
+	_, _, err = replayRequest{}.ledgers()
+	assert.Error(t, err)
+	_, _, err = replayRequest{FromLedger: 10, ToLedger: i32(9)}.ledgers()
+	assert.Error(t, err)
+	_, _, err = replayRequest{FromLedger: 10, ToLedger: i32(1010)}.ledgers()
+	assert.Error(t, err)
+}
+
+func TestPost(t *testing.T) {
+	payload := `{"id":"1"}`
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, payload, string(body))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.True(t, VerifySignature("secret", body, r.Header.Get(SignatureHeader)))
+		assert.Equal(t, "7", r.Header.Get(DeliveryHeader))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	w := &Worker{client: server.Client()}
+	webhook := history.Webhook{URL: server.URL, Secret: "secret"}
+	delivery := history.WebhookDelivery{ID: 7, Payload: payload}
+
+	assert.NoError(t, w.post(context.Background(), webhook, delivery))
+
+	status = http.StatusInternalServerError
+	assert.EqualError(t, w.post(context.Background(), webhook, delivery), "unexpected status code 500")
+}
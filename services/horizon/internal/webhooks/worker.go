@@ -0,0 +1,174 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+)
+
+const (
+	pollInterval    = time.Second
+	deliveryTimeout = 10 * time.Second
+	batchSize       = 50
+	// lease is how long claimed deliveries are not claimed again. It must be
+	// longer than deliveryTimeout.
+	lease = time.Minute
+)
+
+// Worker POSTs the enqueued deliveries to their webhooks. Several workers,
+// in one or more Horizon instances, can run concurrently.
+type Worker struct {
+	historyQ *history.Q
+	client   *http.Client
+}
+
+// NewWorker returns a Worker delivering the payloads enqueued in session.
+func NewWorker(session *db.Session) *Worker {
+	return &Worker{
+		historyQ: &history.Q{Session: session},
+		client:   &http.Client{Timeout: deliveryTimeout},
+	}
+}
+
+// Run delivers the enqueued payloads until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := w.deliverBatch(ctx)
+			if err != nil {
+				logger.WithError(err).Error("could not deliver webhook payloads")
+				break
+			}
+			if n < batchSize {
+				break
+			}
+		}
+	}
+}
+
+// deliverBatch claims up to batchSize due deliveries and delivers them
+// concurrently. It returns the number of claimed deliveries.
+func (w *Worker) deliverBatch(ctx context.Context) (int, error) {
+	deliveries, err := w.historyQ.ClaimWebhookDeliveries(time.Now().UTC(), lease, batchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not claim webhook deliveries")
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	webhooks := map[int64]history.Webhook{}
+	for _, delivery := range deliveries {
+		if _, ok := webhooks[delivery.WebhookID]; ok {
+			continue
+		}
+		webhook, err := w.historyQ.GetWebhookByID(delivery.WebhookID)
+		if w.historyQ.NoRows(err) {
+			// the webhook was deleted along with its deliveries
+			continue
+		} else if err != nil {
+			return 0, errors.Wrap(err, "could not load webhook")
+		}
+		webhooks[webhook.ID] = webhook
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func(delivery history.WebhookDelivery) {
+			defer wg.Done()
+			w.deliver(ctx, webhook, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// deliver POSTs delivery to webhook and records the outcome. Errors recording
+// the outcome are logged, the delivery is claimed again when its lease
+// expires.
+func (w *Worker) deliver(ctx context.Context, webhook history.Webhook, delivery history.WebhookDelivery) {
+	l := logger.WithFields(log.F{
+		"webhook_id":  webhook.ID,
+		"delivery_id": delivery.ID,
+		"attempts":    delivery.Attempts,
+	})
+
+	postErr := w.post(ctx, webhook, delivery)
+	if postErr == nil {
+		if err := w.historyQ.DeleteWebhookDelivery(delivery.ID); err != nil {
+			l.WithError(err).Error("could not delete webhook delivery")
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// shutting down, the delivery is attempted again when its lease
+		// expires
+		return
+	}
+
+	now := time.Now().UTC()
+	attempts := delivery.Attempts + 1
+	if attempts >= MaxAttempts {
+		l.WithError(postErr).Warn("webhook delivery failed, moving it to the dead letters")
+		if err := w.historyQ.DeadLetterWebhookDelivery(delivery.ID, now, postErr.Error()); err != nil {
+			l.WithError(err).Error("could not move webhook delivery to the dead letters")
+		}
+		return
+	}
+
+	l.WithError(postErr).Info("webhook delivery failed, retrying later")
+	err := w.historyQ.RetryWebhookDelivery(delivery.ID, now.Add(backoff(attempts)), postErr.Error())
+	if err != nil {
+		l.WithError(err).Error("could not retry webhook delivery")
+	}
+}
+
+func (w *Worker) post(ctx context.Context, webhook history.Webhook, delivery history.WebhookDelivery) error {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so that the connection can be reused
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
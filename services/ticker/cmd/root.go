@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -51,13 +53,26 @@ func init() {
 }
 
 func initConfig() {
+	// the ticker uses its own client rather than the default clients of
+	// horizonclient, so that its retry policy does not leak into them
 	if UseTestNet {
 		Logger.Debug("Using Stellar Default Test Network")
-		Client = horizonclient.DefaultTestNetClient
+		Client = &horizonclient.Client{
+			HorizonURL: horizonclient.DefaultTestNetClient.HorizonURL,
+			HTTP:       http.DefaultClient,
+		}
 	} else {
 		Logger.Debug("Using Stellar Default Public Network")
-		Client = horizonclient.DefaultPublicNetClient
+		Client = &horizonclient.Client{
+			HorizonURL: horizonclient.DefaultPublicNetClient.HorizonURL,
+			HTTP:       http.DefaultClient,
+		}
 	}
+	Client.SetRetryPolicy(&horizonclient.RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     time.Minute,
+	})
 }
 
 func Execute() {
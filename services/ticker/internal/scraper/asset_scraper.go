@@ -1,6 +1,7 @@
 package scraper
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"math"
@@ -15,7 +16,6 @@ import (
 
 	horizonclient "github.com/stellar/go/clients/horizonclient"
 	hProtocol "github.com/stellar/go/protocols/horizon"
-	"github.com/stellar/go/services/ticker/internal/utils"
 	"github.com/stellar/go/support/errors"
 )
 
@@ -303,45 +303,19 @@ func (c *ScraperConfig) parallelProcessAssets(assets []hProtocol.AssetStat, para
 
 // retrieveAssets retrieves existing assets from the Horizon API. If limit=0, will fetch all assets.
 func (c *ScraperConfig) retrieveAssets(limit int) (assets []hProtocol.AssetStat, err error) {
-	r := horizonclient.AssetRequest{Limit: 200}
-
-	assetsPage, err := c.Client.Assets(r)
-	if err != nil {
-		return
-	}
-
 	c.Logger.Infoln("Fetching assets from Horizon")
 
-	for assetsPage.Links.Next.Href != assetsPage.Links.Self.Href {
-		err = utils.Retry(5, 5*time.Second, c.Logger, func() error {
-			assetsPage, err = c.Client.Assets(r)
-			if err != nil {
-				c.Logger.Infoln("Horizon rate limit reached!")
-			}
-			return err
-		})
-		if err != nil {
-			return
-		}
-		assets = append(assets, assetsPage.Embedded.Records...)
-
-		if limit != 0 { // for performance reasons, only perform these additional checks when limit != 0
-			numAssets := len(assets)
-			if numAssets >= limit {
-				diff := numAssets - limit
-				assets = assets[0 : numAssets-diff]
-				break
-			}
-		}
-
-		nextURL := assetsPage.Links.Next.Href
-		n, err := nextCursor(nextURL)
-		if err != nil {
-			return assets, err
-		}
-		c.Logger.Debugln("Cursor currently at:", n)
-
-		r = horizonclient.AssetRequest{Limit: 200, Cursor: n}
+	it := c.Client.IterateAssets(
+		context.Background(),
+		horizonclient.AssetRequest{Limit: 200},
+		horizonclient.IteratorOptions{MaxItems: limit, Prefetch: true},
+	)
+	defer it.Close()
+	for it.Next() {
+		assets = append(assets, it.Asset())
+	}
+	if err = it.Err(); err != nil {
+		return
 	}
 
 	c.Logger.Infof("Fetched: %d assets\n", len(assets))
@@ -33,9 +33,9 @@ func TestVersion(t *testing.T) {
 			ExpectedVersionByte: VersionByteHashX,
 		},
 		{
-			Name:                "Other (0x60)",
+			Name:                "MuxedAccount",
 			Address:             "MBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG",
-			ExpectedVersionByte: VersionByte(0x60),
+			ExpectedVersionByte: VersionByteMuxedAccount,
 		},
 	}
 
@@ -0,0 +1,102 @@
+package strkey
+
+import (
+	"encoding/binary"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// MuxedAccount is a multiplexed account as defined in SEP-23: an ed25519
+// account and a 64-bit id which distinguishes the virtual accounts sharing it.
+type MuxedAccount struct {
+	id      uint64
+	ed25519 [32]byte
+}
+
+// SetID sets the id of the multiplexed account.
+func (m *MuxedAccount) SetID(id uint64) {
+	m.id = id
+}
+
+// SetAccountID sets the underlying account of the multiplexed account from its
+// G... address.
+func (m *MuxedAccount) SetAccountID(address string) error {
+	raw, err := Decode(VersionByteAccountID, address)
+	if err != nil {
+		return err
+	}
+	if len(raw) != 32 {
+		return errors.New("invalid account id")
+	}
+	copy(m.ed25519[:], raw)
+	return nil
+}
+
+// ID returns the id of the multiplexed account.
+func (m *MuxedAccount) ID() uint64 {
+	return m.id
+}
+
+// AccountID returns the G... address of the underlying account.
+func (m *MuxedAccount) AccountID() (string, error) {
+	return Encode(VersionByteAccountID, m.ed25519[:])
+}
+
+// Ed25519 returns the public key of the underlying account.
+func (m *MuxedAccount) Ed25519() [32]byte {
+	return m.ed25519
+}
+
+// Address returns the M... address of the multiplexed account. The encoded
+// payload is the ed25519 public key followed by the big-endian id.
+func (m *MuxedAccount) Address() (string, error) {
+	raw := make([]byte, 40)
+	copy(raw, m.ed25519[:])
+	binary.BigEndian.PutUint64(raw[32:], m.id)
+	return Encode(VersionByteMuxedAccount, raw)
+}
+
+// NewMuxedAccount returns the multiplexed account with the given id on top of
+// the account with the given G... address.
+func NewMuxedAccount(accountID string, id uint64) (*MuxedAccount, error) {
+	m := &MuxedAccount{id: id}
+	if err := m.SetAccountID(accountID); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DecodeMuxedAccount decodes an M... address.
+func DecodeMuxedAccount(address string) (*MuxedAccount, error) {
+	raw, err := Decode(VersionByteMuxedAccount, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 40 {
+		return nil, errors.New("invalid muxed account")
+	}
+	m := &MuxedAccount{id: binary.BigEndian.Uint64(raw[32:])}
+	copy(m.ed25519[:], raw[:32])
+	return m, nil
+}
+
+// MustDecodeMuxedAccount is like DecodeMuxedAccount, but panics on error
+func MustDecodeMuxedAccount(address string) *MuxedAccount {
+	m, err := DecodeMuxedAccount(address)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// IsValidMuxedAccountEd25519PublicKey validates a stellar multiplexed account
+// address.
+func IsValidMuxedAccountEd25519PublicKey(i interface{}) bool {
+	enc, ok := i.(string)
+	if !ok {
+		return false
+	}
+
+	_, err := DecodeMuxedAccount(enc)
+	return err == nil
+}
@@ -0,0 +1,68 @@
+package strkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuxedAccount(t *testing.T) {
+	cases := []struct {
+		Name      string
+		AccountID string
+		ID        uint64
+		Address   string
+	}{
+		{
+			Name:      "zero id",
+			AccountID: "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ",
+			ID:        0,
+			Address:   "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ",
+		},
+		{
+			Name:      "large id",
+			AccountID: "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ",
+			ID:        9223372036854775808,
+			Address:   "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK",
+		},
+	}
+
+	for _, kase := range cases {
+		m, err := NewMuxedAccount(kase.AccountID, kase.ID)
+		require.NoError(t, err, kase.Name)
+		address, err := m.Address()
+		require.NoError(t, err, kase.Name)
+		assert.Equal(t, kase.Address, address, kase.Name)
+
+		decoded, err := DecodeMuxedAccount(kase.Address)
+		require.NoError(t, err, kase.Name)
+		assert.Equal(t, kase.ID, decoded.ID(), kase.Name)
+		accountID, err := decoded.AccountID()
+		require.NoError(t, err, kase.Name)
+		assert.Equal(t, kase.AccountID, accountID, kase.Name)
+		assert.True(t, IsValidMuxedAccountEd25519PublicKey(kase.Address), kase.Name)
+	}
+}
+
+func TestDecodeMuxedAccountErrors(t *testing.T) {
+	// an account id is not a muxed account
+	_, err := DecodeMuxedAccount("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ")
+	assert.Equal(t, ErrInvalidVersionByte, err)
+
+	// invalid checksum
+	_, err = DecodeMuxedAccount("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUR")
+	assert.Error(t, err)
+
+	// the payload is too short
+	short := MustEncode(VersionByteMuxedAccount, make([]byte, 32))
+	_, err = DecodeMuxedAccount(short)
+	assert.EqualError(t, err, "invalid muxed account")
+
+	assert.False(t, IsValidMuxedAccountEd25519PublicKey(short))
+	assert.False(t, IsValidMuxedAccountEd25519PublicKey(""))
+	assert.False(t, IsValidMuxedAccountEd25519PublicKey(42))
+
+	_, err = NewMuxedAccount("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ", 1)
+	assert.Equal(t, ErrInvalidVersionByte, err)
+}
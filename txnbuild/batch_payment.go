@@ -0,0 +1,231 @@
+package txnbuild
+
+import (
+	"encoding/hex"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// MaxOperationsPerTransaction is the maximum number of operations in a transaction.
+const MaxOperationsPerTransaction = 100
+
+// BatchPayment is a single payment of a PaymentBatch.
+type BatchPayment struct {
+	Destination string
+	// Asset is the asset paid, the native asset if nil.
+	Asset  Asset
+	Amount string
+	// Memo is the memo required by the destination, if any. A payment with a memo is
+	// sent in a transaction of its own, since a memo applies to a whole transaction.
+	Memo Memo
+}
+
+// PaymentBatchParams are the parameters of NewPaymentBatch.
+type PaymentBatchParams struct {
+	// SourceAccount pays for all the payments. Its sequence number is incremented
+	// once for every transaction of the batch, and is left unchanged if the batch
+	// cannot be built.
+	SourceAccount Account
+	Payments      []BatchPayment
+	BaseFee       int64
+	Timebounds    Timebounds
+	// MaxFeePerTransaction is the maximum fee in stroops of a single transaction of
+	// the batch. It limits the number of operations in a transaction to
+	// MaxFeePerTransaction / BaseFee. There is no limit if it is zero.
+	MaxFeePerTransaction int64
+	// MaxOperations limits the number of operations in a transaction. It defaults
+	// to MaxOperationsPerTransaction.
+	MaxOperations int
+	// AccountExists reports whether an account exists. Native payments to accounts
+	// which do not exist are made with a CreateAccount operation. All accounts are
+	// assumed to exist if it is nil.
+	AccountExists func(address string) bool
+	// MemoRequired reports whether an account requires incoming payments to carry a
+	// memo, see SEP-29. Payments without a memo to such accounts are rejected. No
+	// account requires a memo if it is nil.
+	MemoRequired func(address string) bool
+}
+
+// PaymentBatch is a list of transactions making a batch of payments, built by
+// NewPaymentBatch.
+type PaymentBatch struct {
+	// Transactions are the unsigned transactions of the batch, to be signed and
+	// submitted in order.
+	Transactions []*Transaction
+	// Payments lists the payments of the batch in the order they were given.
+	Payments []BatchPaymentPlacement
+}
+
+// BatchPaymentPlacement describes how a payment of a PaymentBatch is made.
+type BatchPaymentPlacement struct {
+	BatchPayment
+	// Transaction is the index of the transaction containing the payment in
+	// PaymentBatch.Transactions.
+	Transaction int
+	// Operation is the index of the payment's operation in its transaction.
+	Operation int
+	// CreateAccount is true if the payment creates the destination account.
+	CreateAccount bool
+}
+
+// BatchPaymentReport reports the hash of the transaction making a payment.
+type BatchPaymentReport struct {
+	BatchPaymentPlacement
+	TransactionHash string
+}
+
+// NewPaymentBatch splits a list of payments into as few transactions as possible,
+// respecting the operation limit and fee budget of a transaction.
+func NewPaymentBatch(params PaymentBatchParams) (*PaymentBatch, error) {
+	if params.SourceAccount == nil {
+		return nil, errors.New("batch has no source account")
+	}
+	if len(params.Payments) == 0 {
+		return nil, errors.New("batch has no payments")
+	}
+	if params.BaseFee < MinBaseFee {
+		return nil, errors.Errorf("base fee cannot be lower than network minimum of %d", MinBaseFee)
+	}
+
+	maxOps := params.MaxOperations
+	if maxOps <= 0 || maxOps > MaxOperationsPerTransaction {
+		maxOps = MaxOperationsPerTransaction
+	}
+	if params.MaxFeePerTransaction > 0 {
+		if budget := params.MaxFeePerTransaction / params.BaseFee; budget < int64(maxOps) {
+			maxOps = int(budget)
+		}
+		if maxOps < 1 {
+			return nil, errors.Errorf("fee budget of %d stroops cannot pay for a single operation", params.MaxFeePerTransaction)
+		}
+	}
+
+	sequence, err := params.SourceAccount.GetSequenceNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not obtain account sequence")
+	}
+	// the transactions are built from a copy of the source account, so that its
+	// sequence number is only incremented once the whole batch is built
+	source := &SimpleAccount{AccountID: params.SourceAccount.GetAccountID(), Sequence: sequence}
+
+	batch := &PaymentBatch{}
+	created := map[string]bool{}
+	var pending []Operation
+	var pendingPlacements []int
+
+	flush := func(memo Memo) error {
+		if len(pending) == 0 {
+			return nil
+		}
+		tx, err := NewTransaction(TransactionParams{
+			SourceAccount:        source,
+			IncrementSequenceNum: true,
+			Operations:           pending,
+			BaseFee:              params.BaseFee,
+			Memo:                 memo,
+			Timebounds:           params.Timebounds,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "could not build transaction %d", len(batch.Transactions))
+		}
+		for op, i := range pendingPlacements {
+			batch.Payments[i].Transaction = len(batch.Transactions)
+			batch.Payments[i].Operation = op
+		}
+		batch.Transactions = append(batch.Transactions, tx)
+		pending, pendingPlacements = nil, nil
+		return nil
+	}
+
+	for i, payment := range params.Payments {
+		if params.MemoRequired != nil && payment.Memo == nil && params.MemoRequired(payment.Destination) {
+			return nil, errors.Errorf("payment %d: destination %s requires a memo", i, payment.Destination)
+		}
+
+		op, createAccount, err := batchPaymentOperation(payment, params.AccountExists, created)
+		if err != nil {
+			return nil, errors.Wrapf(err, "payment %d", i)
+		}
+		batch.Payments = append(batch.Payments, BatchPaymentPlacement{
+			BatchPayment:  payment,
+			CreateAccount: createAccount,
+		})
+
+		if payment.Memo != nil {
+			// flush the transaction in progress, its sequence number comes first
+			if err = flush(nil); err != nil {
+				return nil, err
+			}
+			pending, pendingPlacements = []Operation{op}, []int{i}
+			if err = flush(payment.Memo); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pending = append(pending, op)
+		pendingPlacements = append(pendingPlacements, i)
+		if len(pending) == maxOps {
+			if err = flush(nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(nil); err != nil {
+		return nil, err
+	}
+
+	for range batch.Transactions {
+		if _, err := params.SourceAccount.IncrementSequenceNumber(); err != nil {
+			return nil, errors.Wrap(err, "could not increment account sequence")
+		}
+	}
+	return batch, nil
+}
+
+// batchPaymentOperation returns the operation making payment, and whether it creates
+// the destination account.
+func batchPaymentOperation(payment BatchPayment, accountExists func(string) bool, created map[string]bool) (Operation, bool, error) {
+	if err := validateStellarPublicKey(payment.Destination); err != nil {
+		return nil, false, errors.Wrap(err, "invalid destination")
+	}
+	asset := payment.Asset
+	if asset == nil {
+		asset = NativeAsset{}
+	}
+
+	exists := accountExists == nil || created[payment.Destination] || accountExists(payment.Destination)
+	if exists {
+		op := &Payment{Destination: payment.Destination, Amount: payment.Amount, Asset: asset}
+		return op, false, op.Validate()
+	}
+	if !asset.IsNative() {
+		return nil, false, errors.Errorf("destination %s does not exist and cannot receive %s", payment.Destination, asset.GetCode())
+	}
+	created[payment.Destination] = true
+	op := &CreateAccount{Destination: payment.Destination, Amount: payment.Amount}
+	return op, true, op.Validate()
+}
+
+// Report returns the payments of the batch along with the hash of the transaction
+// making each payment. Signing does not change the hash of a transaction, so the
+// report can be produced before the transactions are signed.
+func (b *PaymentBatch) Report(network string) ([]BatchPaymentReport, error) {
+	hashes := make([]string, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		hash, err := tx.Hash(network)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not hash transaction %d", i)
+		}
+		hashes[i] = hex.EncodeToString(hash[:])
+	}
+
+	report := make([]BatchPaymentReport, 0, len(b.Payments))
+	for _, placement := range b.Payments {
+		report = append(report, BatchPaymentReport{
+			BatchPaymentPlacement: placement,
+			TransactionHash:       hashes[placement.Transaction],
+		})
+	}
+	return report, nil
+}
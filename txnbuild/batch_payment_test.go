@@ -0,0 +1,204 @@
+package txnbuild
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBatchPayments(n int) []BatchPayment {
+	payments := make([]BatchPayment, 0, n)
+	for i := 0; i < n; i++ {
+		payments = append(payments, BatchPayment{Destination: keypair.MustRandom().Address(), Amount: "1"})
+	}
+	return payments
+}
+
+func TestNewPaymentBatchChunks(t *testing.T) {
+	source := &SimpleAccount{AccountID: newKeypair0().Address(), Sequence: 10}
+	batch, err := NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: source,
+		Payments:      newBatchPayments(250),
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, batch.Transactions, 3)
+	assert.Len(t, batch.Transactions[0].Operations(), 100)
+	assert.Len(t, batch.Transactions[1].Operations(), 100)
+	assert.Len(t, batch.Transactions[2].Operations(), 50)
+	for i, tx := range batch.Transactions {
+		assert.Equal(t, int64(11+i), tx.SourceAccount().Sequence)
+	}
+	assert.Equal(t, int64(13), source.Sequence)
+
+	require.Len(t, batch.Payments, 250)
+	assert.Equal(t, 1, batch.Payments[150].Transaction)
+	assert.Equal(t, 50, batch.Payments[150].Operation)
+	op := batch.Transactions[1].Operations()[50].(*Payment)
+	assert.Equal(t, batch.Payments[150].Destination, op.Destination)
+	assert.Equal(t, NativeAsset{}, op.Asset)
+}
+
+func TestNewPaymentBatchFeeBudget(t *testing.T) {
+	batch, err := NewPaymentBatch(PaymentBatchParams{
+		SourceAccount:        &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments:             newBatchPayments(25),
+		BaseFee:              200,
+		MaxFeePerTransaction: 2000,
+		Timebounds:           NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 3)
+	for _, tx := range batch.Transactions {
+		assert.LessOrEqual(t, tx.MaxFee(), int64(2000))
+	}
+	assert.Len(t, batch.Transactions[2].Operations(), 5)
+
+	_, err = NewPaymentBatch(PaymentBatchParams{
+		SourceAccount:        &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments:             newBatchPayments(1),
+		BaseFee:              200,
+		MaxFeePerTransaction: 100,
+		Timebounds:           NewInfiniteTimeout(),
+	})
+	assert.EqualError(t, err, "fee budget of 100 stroops cannot pay for a single operation")
+}
+
+func TestNewPaymentBatchCreatesAccounts(t *testing.T) {
+	kp1, kp2 := newKeypair1(), newKeypair2()
+	usd := CreditAsset{Code: "USD", Issuer: kp1.Address()}
+	newAccount := keypair.MustRandom().Address()
+	existing := map[string]bool{kp1.Address(): true, kp2.Address(): true}
+
+	batch, err := NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments: []BatchPayment{
+			{Destination: kp1.Address(), Amount: "5", Asset: usd},
+			{Destination: newAccount, Amount: "10"},
+			{Destination: newAccount, Amount: "3"},
+			{Destination: kp2.Address(), Amount: "1"},
+		},
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+		AccountExists: func(address string) bool { return existing[address] },
+	})
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+
+	ops := batch.Transactions[0].Operations()
+	assert.Equal(t, &Payment{Destination: kp1.Address(), Amount: "5", Asset: usd}, ops[0])
+	assert.Equal(t, &CreateAccount{Destination: newAccount, Amount: "10"}, ops[1])
+	// the account exists once it has been created
+	assert.Equal(t, &Payment{Destination: newAccount, Amount: "3", Asset: NativeAsset{}}, ops[2])
+	assert.False(t, batch.Payments[0].CreateAccount)
+	assert.True(t, batch.Payments[1].CreateAccount)
+	assert.False(t, batch.Payments[2].CreateAccount)
+
+	_, err = NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments:      []BatchPayment{{Destination: newAccount, Amount: "5", Asset: usd}},
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+		AccountExists: func(address string) bool { return existing[address] },
+	})
+	assert.EqualError(t, err, "payment 0: destination "+newAccount+" does not exist and cannot receive USD")
+}
+
+func TestNewPaymentBatchMemos(t *testing.T) {
+	exchange := newKeypair1().Address()
+	payments := newBatchPayments(3)
+	payments = append(payments[:1], append([]BatchPayment{{Destination: exchange, Amount: "7", Memo: MemoID(1234)}}, payments[1:]...)...)
+
+	params := PaymentBatchParams{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address(), Sequence: 1},
+		Payments:      payments,
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+		MemoRequired:  func(address string) bool { return address == exchange },
+	}
+	batch, err := NewPaymentBatch(params)
+	require.NoError(t, err)
+
+	require.Len(t, batch.Transactions, 3)
+	assert.Len(t, batch.Transactions[0].Operations(), 1)
+	assert.Nil(t, batch.Transactions[0].Memo())
+	assert.Len(t, batch.Transactions[1].Operations(), 1)
+	assert.Equal(t, MemoID(1234), batch.Transactions[1].Memo())
+	assert.Len(t, batch.Transactions[2].Operations(), 2)
+	assert.Equal(t, []int{0, 1, 2, 2}, []int{
+		batch.Payments[0].Transaction, batch.Payments[1].Transaction,
+		batch.Payments[2].Transaction, batch.Payments[3].Transaction,
+	})
+
+	params.SourceAccount = &SimpleAccount{AccountID: newKeypair0().Address(), Sequence: 1}
+	params.Payments = []BatchPayment{{Destination: exchange, Amount: "7"}}
+	_, err = NewPaymentBatch(params)
+	assert.EqualError(t, err, "payment 0: destination "+exchange+" requires a memo")
+}
+
+func TestPaymentBatchReport(t *testing.T) {
+	batch, err := NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments:      newBatchPayments(150),
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+
+	report, err := batch.Report(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	require.Len(t, report, 150)
+
+	signed, err := batch.Transactions[1].Sign(network.TestNetworkPassphrase, newKeypair0())
+	require.NoError(t, err)
+	hash, err := signed.Hash(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(hash[:]), report[120].TransactionHash)
+	assert.Equal(t, batch.Payments[120].Destination, report[120].Destination)
+	assert.NotEqual(t, report[0].TransactionHash, report[120].TransactionHash)
+}
+
+func TestNewPaymentBatchErrors(t *testing.T) {
+	_, err := NewPaymentBatch(PaymentBatchParams{Payments: newBatchPayments(1), BaseFee: MinBaseFee})
+	assert.EqualError(t, err, "batch has no source account")
+
+	_, err = NewPaymentBatch(PaymentBatchParams{SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()}, BaseFee: MinBaseFee})
+	assert.EqualError(t, err, "batch has no payments")
+
+	_, err = NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()},
+		Payments:      []BatchPayment{{Destination: "GABC", Amount: "1"}},
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	assert.Contains(t, err.Error(), "payment 0: invalid destination")
+}
+
+func TestNewPaymentBatchErrorsKeepSequence(t *testing.T) {
+	source := &SimpleAccount{AccountID: newKeypair0().Address(), Sequence: 10}
+	_, err := NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: source,
+		Payments:      newBatchPayments(10),
+		BaseFee:       MinBaseFee,
+	})
+	assert.Contains(t, err.Error(), "could not build transaction 0")
+	assert.Equal(t, int64(10), source.Sequence)
+
+	// the first transaction is built before the invalid payment is found
+	payments := newBatchPayments(150)
+	payments[120].Destination = "GABC"
+	_, err = NewPaymentBatch(PaymentBatchParams{
+		SourceAccount: source,
+		Payments:      payments,
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	assert.Contains(t, err.Error(), "payment 120: invalid destination")
+	assert.Equal(t, int64(10), source.Sequence)
+}
@@ -0,0 +1,39 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsoringFutureReservesRoundTrip(t *testing.T) {
+	begin := &BeginSponsoringFutureReserves{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair0().Address()},
+		SponsoredID:   newKeypair1().Address(),
+	}
+	xdrOp, err := begin.BuildXDR()
+	require.NoError(t, err)
+	var parsedBegin BeginSponsoringFutureReserves
+	require.NoError(t, parsedBegin.FromXDR(xdrOp))
+	assert.Equal(t, begin, &parsedBegin)
+
+	end := &EndSponsoringFutureReserves{
+		SourceAccount: &SimpleAccount{AccountID: newKeypair1().Address()},
+	}
+	xdrOp, err = end.BuildXDR()
+	require.NoError(t, err)
+	var parsedEnd EndSponsoringFutureReserves
+	require.NoError(t, parsedEnd.FromXDR(xdrOp))
+	assert.Equal(t, end, &parsedEnd)
+
+	assert.Error(t, parsedBegin.FromXDR(xdrOp))
+}
+
+func TestBeginSponsoringFutureReservesValidate(t *testing.T) {
+	begin := BeginSponsoringFutureReserves{SponsoredID: "GABC"}
+	err := begin.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: SponsoredID, Error: GABC is not a valid stellar public key")
+	}
+}
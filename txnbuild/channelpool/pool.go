@@ -0,0 +1,333 @@
+/*
+Package channelpool provides a pool of channel accounts for submitting transactions
+concurrently on behalf of a single account.
+
+Every transaction consumes a sequence number of its source account, so transactions
+with the same source account must be submitted one at a time. A Pool uses a set of
+channel accounts as transaction source accounts instead, and the account which is
+actually paying becomes the source account of the operations. Transactions using
+different channels can be built and submitted in parallel.
+*/
+package channelpool
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+// Channel is a channel account handed out by a Pool. It must be released with
+// Pool.Release once the transaction using it has been submitted.
+type Channel struct {
+	pool    *Pool
+	keypair *keypair.Full
+	account txnbuild.SimpleAccount
+	// stale is true if the sequence number of the account must be loaded from
+	// Horizon before the channel is used.
+	stale bool
+	// acquired is true while the channel is handed out by the pool. It is
+	// guarded by the mutex of the pool.
+	acquired bool
+}
+
+// Address returns the address of the channel account.
+func (c *Channel) Address() string {
+	return c.keypair.Address()
+}
+
+// Account returns the channel account, to be used as the source account of a
+// transaction with IncrementSequenceNum set.
+func (c *Channel) Account() txnbuild.Account {
+	return &c.account
+}
+
+// Keypair returns the keypair which signs for the channel account.
+func (c *Channel) Keypair() *keypair.Full {
+	return c.keypair
+}
+
+// Pool manages a fixed set of channel accounts. It is safe for concurrent use.
+type Pool struct {
+	client            horizonclient.ClientInterface
+	networkPassphrase string
+	free              chan *Channel
+	mutex             sync.Mutex
+	// MaxBadSeqRetries is the number of times Submit retries a transaction which
+	// failed with tx_bad_seq after refreshing the sequence number of the channel.
+	MaxBadSeqRetries int
+}
+
+// NewPool returns a Pool of the channel accounts controlled by channelKeys. The
+// channel accounts must exist and must have their master key as a signer. Their
+// sequence numbers are loaded from Horizon the first time they are used.
+func NewPool(client horizonclient.ClientInterface, networkPassphrase string, channelKeys []*keypair.Full) (*Pool, error) {
+	if client == nil {
+		return nil, errors.New("horizon client is missing")
+	}
+	if len(channelKeys) == 0 {
+		return nil, errors.New("at least one channel account is required")
+	}
+
+	p := &Pool{
+		client:            client,
+		networkPassphrase: networkPassphrase,
+		free:              make(chan *Channel, len(channelKeys)),
+		MaxBadSeqRetries:  1,
+	}
+	seen := map[string]bool{}
+	for _, kp := range channelKeys {
+		if seen[kp.Address()] {
+			return nil, errors.Errorf("channel account %s is duplicated", kp.Address())
+		}
+		seen[kp.Address()] = true
+		p.free <- &Channel{
+			pool:    p,
+			keypair: kp,
+			account: txnbuild.SimpleAccount{AccountID: kp.Address()},
+			stale:   true,
+		}
+	}
+	return p, nil
+}
+
+// Size returns the number of channel accounts in the pool.
+func (p *Pool) Size() int {
+	return cap(p.free)
+}
+
+// Acquire returns a free channel, blocking until one is released or ctx is done.
+// The sequence number of the channel is loaded from Horizon if it is not known.
+func (p *Pool) Acquire(ctx context.Context) (*Channel, error) {
+	var c *Channel
+	select {
+	case c = <-p.free:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mutex.Lock()
+	c.acquired = true
+	p.mutex.Unlock()
+
+	if c.stale {
+		if err := p.refresh(c); err != nil {
+			p.Release(c, nil)
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Release returns c to the pool. submitErr is the result of submitting the last
+// transaction built with the channel. If the transaction may not have consumed a
+// sequence number the channel's sequence number is reloaded before its next use.
+//
+// Release panics if c was not acquired from p or was already released, like
+// unlocking an unlocked sync.Mutex, since the channel would otherwise be handed
+// out twice.
+func (p *Pool) Release(c *Channel, submitErr error) {
+	p.mutex.Lock()
+	if c.pool != p || !c.acquired {
+		p.mutex.Unlock()
+		panic("channelpool: release of a channel which is not acquired from the pool")
+	}
+	c.acquired = false
+	p.mutex.Unlock()
+
+	if submitErr != nil && !consumedSequence(submitErr) {
+		c.stale = true
+	}
+	p.free <- c
+}
+
+func (p *Pool) refresh(c *Channel) error {
+	account, err := p.client.AccountDetail(horizonclient.AccountRequest{AccountID: c.Address()})
+	if err != nil {
+		return errors.Wrapf(err, "could not load channel account %s", c.Address())
+	}
+	sequence, err := account.GetSequenceNumber()
+	if err != nil {
+		return errors.Wrapf(err, "could not load channel account %s", c.Address())
+	}
+	c.account.Sequence = sequence
+	c.stale = false
+	return nil
+}
+
+// Submit builds a transaction from params using a free channel as the source
+// account, signs it with the channel key and signers, and submits it.
+//
+// params.SourceAccount is the account on whose behalf the transaction is submitted.
+// It becomes the source account of every operation which does not have one, and its
+// sequence number is not used. signers must sign for it and for any other operation
+// source account.
+//
+// If the transaction fails with tx_bad_seq, the sequence number of the channel is
+// refreshed from Horizon and the transaction is submitted again up to
+// MaxBadSeqRetries times.
+func (p *Pool) Submit(ctx context.Context, params txnbuild.TransactionParams, signers ...*keypair.Full) (hProtocol.Transaction, error) {
+	if params.SourceAccount == nil {
+		return hProtocol.Transaction{}, errors.New("transaction has no source account")
+	}
+	payer := &txnbuild.SimpleAccount{AccountID: params.SourceAccount.GetAccountID()}
+	operations := make([]txnbuild.Operation, 0, len(params.Operations))
+	for _, op := range params.Operations {
+		op, err := withSourceAccount(op, payer)
+		if err != nil {
+			return hProtocol.Transaction{}, err
+		}
+		operations = append(operations, op)
+	}
+	params.Operations = operations
+	params.IncrementSequenceNum = true
+
+	c, err := p.Acquire(ctx)
+	if err != nil {
+		return hProtocol.Transaction{}, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		var resp hProtocol.Transaction
+		resp, err = p.submit(c, params, signers)
+		if err == nil || !isBadSeq(err) || attempt >= p.MaxBadSeqRetries {
+			p.Release(c, err)
+			return resp, err
+		}
+		if err = p.refresh(c); err != nil {
+			p.Release(c, err)
+			return resp, err
+		}
+	}
+}
+
+func (p *Pool) submit(c *Channel, params txnbuild.TransactionParams, signers []*keypair.Full) (hProtocol.Transaction, error) {
+	params.SourceAccount = c.Account()
+	sequence := c.account.Sequence
+	tx, err := txnbuild.NewTransaction(params)
+	if err != nil {
+		// the sequence number was not consumed if the transaction was not built
+		c.account.Sequence = sequence
+		return hProtocol.Transaction{}, errors.Wrap(err, "could not build transaction")
+	}
+	tx, err = tx.Sign(p.networkPassphrase, append([]*keypair.Full{c.keypair}, signers...)...)
+	if err != nil {
+		return hProtocol.Transaction{}, errors.Wrap(err, "could not sign transaction")
+	}
+	return p.client.SubmitTransaction(tx)
+}
+
+// consumedSequence returns true if err is a Horizon error reporting that the
+// transaction was included in a ledger but failed, which consumes its sequence
+// number.
+func consumedSequence(err error) bool {
+	return transactionResultCode(err) == "tx_failed"
+}
+
+func isBadSeq(err error) bool {
+	return transactionResultCode(err) == "tx_bad_seq"
+}
+
+func transactionResultCode(err error) string {
+	hErr := horizonclient.GetError(err)
+	if hErr == nil {
+		return ""
+	}
+	codes, err := hErr.ResultCodes()
+	if err != nil {
+		return ""
+	}
+	return codes.TransactionCode
+}
+
+// withSourceAccount returns a copy of op with source as its source account if it
+// does not have one.
+func withSourceAccount(op txnbuild.Operation, source txnbuild.Account) (txnbuild.Operation, error) {
+	if op.GetSourceAccount() != nil {
+		return op, nil
+	}
+
+	switch o := op.(type) {
+	case *txnbuild.CreateAccount:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.Payment:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.PathPaymentStrictReceive:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.PathPaymentStrictSend:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.ManageSellOffer:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.ManageBuyOffer:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.CreatePassiveSellOffer:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.SetOptions:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.ChangeTrust:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.AllowTrust:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.AccountMerge:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.Inflation:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.ManageData:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.BumpSequence:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.CreateClaimableBalance:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.ClaimClaimableBalance:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.BeginSponsoringFutureReserves:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.EndSponsoringFutureReserves:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	case *txnbuild.RevokeSponsorship:
+		c := *o
+		c.SourceAccount = source
+		return &c, nil
+	}
+	return nil, errors.Errorf("unsupported operation type %T", op)
+}
@@ -0,0 +1,256 @@
+package channelpool
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func resultCodeError(code string) error {
+	return &horizonclient.Error{
+		Response: &http.Response{StatusCode: http.StatusBadRequest},
+		Problem: problem.P{
+			Type:   "https://stellar.org/horizon-errors/transaction_failed",
+			Status: http.StatusBadRequest,
+			Extras: map[string]interface{}{
+				"result_codes": map[string]interface{}{"transaction": code},
+			},
+		},
+	}
+}
+
+func paymentParams(payer string) txnbuild.TransactionParams {
+	return txnbuild.TransactionParams{
+		SourceAccount: &txnbuild.SimpleAccount{AccountID: payer, Sequence: 1},
+		Operations: []txnbuild.Operation{
+			&txnbuild.Payment{Destination: keypair.MustRandom().Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+		},
+		BaseFee:    txnbuild.MinBaseFee,
+		Timebounds: txnbuild.NewInfiniteTimeout(),
+	}
+}
+
+// submittedBy matches a transaction sourced from channel with the given sequence
+// number whose operations are sourced from payer.
+func submittedBy(channel string, sequence int64, payer string) interface{} {
+	return mock.MatchedBy(func(tx *txnbuild.Transaction) bool {
+		for _, op := range tx.Operations() {
+			if op.GetSourceAccount() == nil || op.GetSourceAccount().GetAccountID() != payer {
+				return false
+			}
+		}
+		return tx.SourceAccount().AccountID == channel &&
+			tx.SourceAccount().Sequence == sequence &&
+			len(tx.Signatures()) == 2
+	})
+}
+
+func TestPoolSubmit(t *testing.T) {
+	payer := keypair.MustRandom()
+	channel := keypair.MustRandom()
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	require.NoError(t, err)
+
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "100"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 101, payer.Address())).
+		Return(hProtocol.Transaction{Hash: "tx1"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 102, payer.Address())).
+		Return(hProtocol.Transaction{Hash: "tx2"}, nil).Once()
+
+	resp, err := pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	require.NoError(t, err)
+	assert.Equal(t, "tx1", resp.Hash)
+
+	// the sequence number is only loaded once
+	resp, err = pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	require.NoError(t, err)
+	assert.Equal(t, "tx2", resp.Hash)
+	client.AssertExpectations(t)
+}
+
+func TestPoolSubmitBadSeq(t *testing.T) {
+	payer := keypair.MustRandom()
+	channel := keypair.MustRandom()
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	require.NoError(t, err)
+
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "100"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 101, payer.Address())).
+		Return(hProtocol.Transaction{}, resultCodeError("tx_bad_seq")).Once()
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "200"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 201, payer.Address())).
+		Return(hProtocol.Transaction{Hash: "tx"}, nil).Once()
+
+	resp, err := pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	require.NoError(t, err)
+	assert.Equal(t, "tx", resp.Hash)
+	client.AssertExpectations(t)
+
+	// retries are bounded
+	pool.MaxBadSeqRetries = 0
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 202, payer.Address())).
+		Return(hProtocol.Transaction{}, resultCodeError("tx_bad_seq")).Once()
+	_, err = pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	assert.Equal(t, "tx_bad_seq", transactionResultCode(err))
+	client.AssertExpectations(t)
+}
+
+func TestPoolReleaseAfterFailure(t *testing.T) {
+	payer := keypair.MustRandom()
+	channel := keypair.MustRandom()
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	require.NoError(t, err)
+
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "100"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 101, payer.Address())).
+		Return(hProtocol.Transaction{}, resultCodeError("tx_failed")).Once()
+	// a failed transaction consumes its sequence number
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 102, payer.Address())).
+		Return(hProtocol.Transaction{}, resultCodeError("tx_insufficient_fee")).Once()
+	// a rejected transaction does not, so the sequence number is reloaded
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "101"}, nil).Once()
+	client.On("SubmitTransaction", submittedBy(channel.Address(), 102, payer.Address())).
+		Return(hProtocol.Transaction{Hash: "tx"}, nil).Once()
+
+	_, err = pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	assert.Equal(t, "tx_failed", transactionResultCode(err))
+	_, err = pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	assert.Equal(t, "tx_insufficient_fee", transactionResultCode(err))
+	resp, err := pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+	require.NoError(t, err)
+	assert.Equal(t, "tx", resp.Hash)
+	client.AssertExpectations(t)
+}
+
+func TestPoolConcurrentSubmit(t *testing.T) {
+	payer := keypair.MustRandom()
+	channels := []*keypair.Full{keypair.MustRandom(), keypair.MustRandom(), keypair.MustRandom()}
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, channels)
+	require.NoError(t, err)
+	assert.Equal(t, 3, pool.Size())
+
+	var mu sync.Mutex
+	submitted := map[string]map[int64]bool{}
+	for _, channel := range channels {
+		submitted[channel.Address()] = map[int64]bool{}
+		client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+			Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "0"}, nil).Once()
+	}
+	client.On("SubmitTransaction", mock.AnythingOfType("*txnbuild.Transaction")).
+		Run(func(args mock.Arguments) {
+			tx := args.Get(0).(*txnbuild.Transaction)
+			mu.Lock()
+			defer mu.Unlock()
+			sequences := submitted[tx.SourceAccount().AccountID]
+			assert.False(t, sequences[tx.SourceAccount().Sequence], "sequence number reused")
+			sequences[tx.SourceAccount().Sequence] = true
+		}).
+		Return(hProtocol.Transaction{}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pool.Submit(context.Background(), paymentParams(payer.Address()), payer)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, sequences := range submitted {
+		total += len(sequences)
+	}
+	assert.Equal(t, 30, total)
+	client.AssertExpectations(t)
+}
+
+func TestPoolAcquire(t *testing.T) {
+	channel := keypair.MustRandom()
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	require.NoError(t, err)
+
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "100"}, nil).Once()
+	c, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, channel.Address(), c.Address())
+	sequence, err := c.Account().GetSequenceNumber()
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), sequence)
+
+	// no channel is free until c is released
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = pool.Acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	pool.Release(c, nil)
+	c, err = pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, channel.Address(), c.Address())
+	client.AssertExpectations(t)
+}
+
+func TestPoolReleaseNotAcquired(t *testing.T) {
+	channel := keypair.MustRandom()
+	client := &horizonclient.MockClient{}
+	pool, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	require.NoError(t, err)
+	other, err := NewPool(client, network.TestNetworkPassphrase, []*keypair.Full{keypair.MustRandom()})
+	require.NoError(t, err)
+
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: channel.Address()}).
+		Return(hProtocol.Account{AccountID: channel.Address(), Sequence: "100"}, nil).Once()
+	c, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	// a channel of another pool
+	assert.Panics(t, func() { other.Release(c, nil) })
+
+	pool.Release(c, nil)
+	// a double release would hand out the channel twice
+	assert.Panics(t, func() { pool.Release(c, nil) })
+
+	c, err = pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, channel.Address(), c.Address())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = pool.Acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	client.AssertExpectations(t)
+}
+
+func TestNewPoolErrors(t *testing.T) {
+	channel := keypair.MustRandom()
+	_, err := NewPool(nil, network.TestNetworkPassphrase, []*keypair.Full{channel})
+	assert.EqualError(t, err, "horizon client is missing")
+	_, err = NewPool(&horizonclient.MockClient{}, network.TestNetworkPassphrase, nil)
+	assert.EqualError(t, err, "at least one channel account is required")
+	_, err = NewPool(&horizonclient.MockClient{}, network.TestNetworkPassphrase, []*keypair.Full{channel, channel})
+	assert.EqualError(t, err, "channel account "+channel.Address()+" is duplicated")
+}
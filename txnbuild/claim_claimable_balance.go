@@ -0,0 +1,71 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ClaimClaimableBalance represents the Stellar claim claimable balance operation. See
+// https://www.stellar.org/developers/guides/concepts/list-of-operations.html
+//
+// BalanceID is the hex encoded XDR of the claimable balance id, as returned
+// by Horizon.
+type ClaimClaimableBalance struct {
+	BalanceID     string
+	SourceAccount Account
+}
+
+// BuildXDR for ClaimClaimableBalance returns a fully configured XDR Operation.
+func (cb *ClaimClaimableBalance) BuildXDR() (xdr.Operation, error) {
+	var xdrBalanceID xdr.ClaimableBalanceId
+	err := xdr.SafeUnmarshalHex(cb.BalanceID, &xdrBalanceID)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse claimable balance id")
+	}
+	xdrOp := xdr.ClaimClaimableBalanceOp{
+		BalanceId: xdrBalanceID,
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeClaimClaimableBalance, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, cb.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for ClaimClaimableBalance initialises the txnbuild struct from the corresponding xdr Operation.
+func (cb *ClaimClaimableBalance) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetClaimClaimableBalanceOp()
+	if !ok {
+		return errors.New("error parsing claim_claimable_balance operation from xdr")
+	}
+
+	cb.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	balanceID, err := xdr.MarshalHex(result.BalanceId)
+	if err != nil {
+		return errors.Wrap(err, "error parsing BalanceID in claim_claimable_balance operation")
+	}
+	cb.BalanceID = balanceID
+
+	return nil
+}
+
+// Validate for ClaimClaimableBalance validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (cb *ClaimClaimableBalance) Validate() error {
+	var xdrBalanceID xdr.ClaimableBalanceId
+	err := xdr.SafeUnmarshalHex(cb.BalanceID, &xdrBalanceID)
+	if err != nil {
+		return NewValidationError("BalanceID", err.Error())
+	}
+
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation, or nil if not
+// set.
+func (cb *ClaimClaimableBalance) GetSourceAccount() Account {
+	return cb.SourceAccount
+}
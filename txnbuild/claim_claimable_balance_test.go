@@ -0,0 +1,33 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimClaimableBalanceRoundTrip(t *testing.T) {
+	claim := &ClaimClaimableBalance{
+		SourceAccount: &SimpleAccount{AccountID: "GB7BDSZU2Y27LYNLALKKALB52WS2IZWYBDGY6EQBLEED3TJOCVMZRH7H"},
+		BalanceID:     "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+	}
+
+	xdrOp, err := claim.BuildXDR()
+	require.NoError(t, err)
+
+	var parsed ClaimClaimableBalance
+	require.NoError(t, parsed.FromXDR(xdrOp))
+	assert.Equal(t, claim, &parsed)
+}
+
+func TestClaimClaimableBalanceValidate(t *testing.T) {
+	claim := ClaimClaimableBalance{BalanceID: "da0d57da"}
+	err := claim.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: BalanceID")
+	}
+
+	_, err = claim.BuildXDR()
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateClaimableBalanceRoundTrip(t *testing.T) {
+	and := AndPredicate(BeforeAbsoluteTimePredicate(100), BeforeRelativeTimePredicate(10))
+	createNativeBalance := &CreateClaimableBalance{
+		SourceAccount: &SimpleAccount{AccountID: "GB7BDSZU2Y27LYNLALKKALB52WS2IZWYBDGY6EQBLEED3TJOCVMZRH7H"},
+		Amount:        "1234.0000000",
+		Asset:         NativeAsset{},
+		Destinations: []Claimant{
+			NewClaimant(newKeypair1().Address(), &and),
+			NewClaimant(newKeypair1().Address(), nil),
+		},
+	}
+
+	not := NotPredicate(BeforeRelativeTimePredicate(10))
+	or := OrPredicate(BeforeAbsoluteTimePredicate(100), not)
+	createAssetBalance := &CreateClaimableBalance{
+		Amount: "99.0000000",
+		Asset: CreditAsset{
+			Code:   "COP",
+			Issuer: "GB56OJGSA6VHEUFZDX6AL2YDVG2TS5JDZYQJHDYHBDH7PCD5NIQKLSDO",
+		},
+		Destinations: []Claimant{
+			NewClaimant(newKeypair1().Address(), &or),
+		},
+	}
+
+	for _, op := range []*CreateClaimableBalance{createNativeBalance, createAssetBalance} {
+		xdrOp, err := op.BuildXDR()
+		require.NoError(t, err)
+
+		var parsed CreateClaimableBalance
+		require.NoError(t, parsed.FromXDR(xdrOp))
+		assert.Equal(t, op, &parsed)
+	}
+}
+
+func TestCreateClaimableBalanceValidate(t *testing.T) {
+	op := CreateClaimableBalance{
+		Amount: "10",
+		Asset:  NativeAsset{},
+	}
+	err := op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: Destinations, Error: at least one destination is required")
+	}
+
+	op.Destinations = []Claimant{NewClaimant("GABC", nil)}
+	err = op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: Destinations, Error: GABC is not a valid stellar public key")
+	}
+
+	op.Destinations = []Claimant{NewClaimant(newKeypair1().Address(), nil)}
+	op.Amount = "-1"
+	err = op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: Amount, Error: amount can not be negative")
+	}
+}
+
+func TestClaimableBalanceID(t *testing.T) {
+	aKeys := keypair.MustParseFull("SCZANGBA5YHTNYVVV4C3U252E2B6P6F5T3U6MM63WBSBZATAQI3EBTQ4")
+	aAccount := SimpleAccount{AccountID: aKeys.Address(), Sequence: 123}
+
+	bCanClaim := BeforeRelativeTimePredicate(60)
+	aCanReclaim := NotPredicate(BeforeAbsoluteTimePredicate(1600000000))
+	claimableBalanceEntry := CreateClaimableBalance{
+		Destinations: []Claimant{
+			NewClaimant("GA2C5RFPE6GCKMY3US5PAB6UZLKIGSPIUKSLRB6Q723BM2OARMDUYEJ5", &bCanClaim),
+			NewClaimant(aKeys.Address(), &aCanReclaim),
+		},
+		Asset:  NativeAsset{},
+		Amount: "420",
+	}
+
+	tx, err := NewTransaction(
+		TransactionParams{
+			SourceAccount:        &aAccount,
+			IncrementSequenceNum: true,
+			BaseFee:              MinBaseFee,
+			Timebounds:           NewInfiniteTimeout(),
+			Operations:           []Operation{&claimableBalanceEntry, &Inflation{}},
+		},
+	)
+	require.NoError(t, err)
+
+	// The id is derived from the source account, the sequence number and the
+	// operation index, so it can be computed before the transaction is submitted.
+	balanceID, err := tx.ClaimableBalanceID(0)
+	require.NoError(t, err)
+	assert.Equal(t, "0000000095001252ab3b4d16adbfa5364ce526dfcda03cb2258b827edbb2e0450087be51", balanceID)
+
+	var xdrBalanceID xdr.ClaimableBalanceId
+	require.NoError(t, xdr.SafeUnmarshalHex(balanceID, &xdrBalanceID))
+	assert.Equal(t, xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0, xdrBalanceID.Type)
+
+	_, err = tx.ClaimableBalanceID(1)
+	assert.EqualError(t, err, "operation is not CreateClaimableBalance")
+
+	_, err = tx.ClaimableBalanceID(2)
+	assert.EqualError(t, err, "invalid operation index")
+}
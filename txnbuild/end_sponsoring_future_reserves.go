@@ -0,0 +1,46 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// EndSponsoringFutureReserves represents the Stellar end sponsoring future reserves operation. See
+// https://www.stellar.org/developers/guides/concepts/list-of-operations.html
+type EndSponsoringFutureReserves struct {
+	SourceAccount Account
+}
+
+// BuildXDR for EndSponsoringFutureReserves returns a fully configured XDR Operation.
+func (es *EndSponsoringFutureReserves) BuildXDR() (xdr.Operation, error) {
+	opType := xdr.OperationTypeEndSponsoringFutureReserves
+	body, err := xdr.NewOperationBody(opType, nil)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, es.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for EndSponsoringFutureReserves initialises the txnbuild struct from the corresponding xdr Operation.
+func (es *EndSponsoringFutureReserves) FromXDR(xdrOp xdr.Operation) error {
+	if xdrOp.Body.Type != xdr.OperationTypeEndSponsoringFutureReserves {
+		return errors.New("error parsing end_sponsoring_future_reserves operation from xdr")
+	}
+
+	es.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	return nil
+}
+
+// Validate for EndSponsoringFutureReserves is just a method that implements the Operation interface. No logic is
+// needed because the operation has no fields to validate.
+func (es *EndSponsoringFutureReserves) Validate() error {
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation, or nil if not
+// set.
+func (es *EndSponsoringFutureReserves) GetSourceAccount() Account {
+	return es.SourceAccount
+}
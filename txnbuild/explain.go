@@ -0,0 +1,360 @@
+package txnbuild
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// TransactionExplanation is a human readable description of a transaction, produced
+// by ExplainTransaction. String renders it as plain text and JSON as structured JSON.
+type TransactionExplanation struct {
+	// FeeBump is set if the transaction is wrapped in a fee bump transaction.
+	FeeBump *FeeBumpExplanation `json:"fee_bump,omitempty"`
+
+	SourceAccount AccountExplanation `json:"source_account"`
+	Sequence      int64              `json:"sequence,string"`
+	// MaxFee is the maximum fee in stroops the source account pays for the
+	// transaction.
+	MaxFee int64         `json:"max_fee"`
+	Memo   *MemoTemplate `json:"memo,omitempty"`
+	// ValidAfter and ValidUntil are the time bounds of the transaction. They are
+	// nil if the transaction has no lower or upper time bound.
+	ValidAfter *time.Time             `json:"valid_after,omitempty"`
+	ValidUntil *time.Time             `json:"valid_until,omitempty"`
+	Operations []OperationExplanation `json:"operations"`
+	Signatures int                    `json:"signatures"`
+}
+
+// FeeBumpExplanation describes the fee bump transaction wrapping a transaction.
+type FeeBumpExplanation struct {
+	FeeAccount AccountExplanation `json:"fee_account"`
+	MaxFee     int64              `json:"max_fee"`
+	Signatures int                `json:"signatures"`
+}
+
+// AccountExplanation identifies an account. MuxedID is set if the account was given
+// as a muxed account.
+type AccountExplanation struct {
+	Address string  `json:"address"`
+	MuxedID *uint64 `json:"muxed_id,omitempty"`
+}
+
+// OperationExplanation describes a single operation of a transaction.
+type OperationExplanation struct {
+	Type string `json:"type"`
+	// SourceAccount is the account the operation applies to, which is the
+	// transaction source account if the operation has no source account.
+	SourceAccount AccountExplanation `json:"source_account"`
+	// Summary is a one sentence description of the operation.
+	Summary string `json:"summary"`
+	// Details contains the parameters of the operation, in the same form as in a
+	// TransactionTemplate.
+	Details OperationTemplate `json:"details"`
+}
+
+// ExplainTransaction returns a human readable description of tx.
+func ExplainTransaction(tx *GenericTransaction) (TransactionExplanation, error) {
+	var e TransactionExplanation
+	inner, ok := tx.Transaction()
+	if feeBump, isFeeBump := tx.FeeBump(); isFeeBump {
+		inner, ok = feeBump.InnerTransaction(), true
+		e.FeeBump = &FeeBumpExplanation{
+			FeeAccount: explainAccount(feeBump.envelope.FeeBumpAccount()),
+			MaxFee:     feeBump.MaxFee(),
+			Signatures: len(feeBump.Signatures()),
+		}
+	}
+	if !ok {
+		return e, errors.New("transaction is empty")
+	}
+
+	e.SourceAccount = explainAccount(inner.envelope.SourceAccount())
+	e.Sequence = inner.SourceAccount().Sequence
+	e.MaxFee = inner.MaxFee()
+	e.Signatures = len(inner.Signatures())
+	if minTime := inner.Timebounds().MinTime; minTime > 0 {
+		t := time.Unix(minTime, 0).UTC()
+		e.ValidAfter = &t
+	}
+	if maxTime := inner.Timebounds().MaxTime; maxTime > 0 {
+		t := time.Unix(maxTime, 0).UTC()
+		e.ValidUntil = &t
+	}
+	if inner.Memo() != nil {
+		memo, err := newMemoTemplate(inner.Memo())
+		if err != nil {
+			return e, err
+		}
+		e.Memo = &memo
+	}
+
+	e.Operations = []OperationExplanation{}
+	for i, xdrOp := range inner.envelope.Operations() {
+		opExplanation, err := explainOperation(xdrOp, e.SourceAccount)
+		if err != nil {
+			return e, errors.Wrapf(err, "could not explain operation %d", i)
+		}
+		e.Operations = append(e.Operations, opExplanation)
+	}
+	return e, nil
+}
+
+// JSON returns the indented JSON encoding of the explanation.
+func (e TransactionExplanation) JSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// String returns the explanation as plain text.
+func (e TransactionExplanation) String() string {
+	var b strings.Builder
+	if e.FeeBump != nil {
+		fmt.Fprintf(&b, "Fee bump transaction paid by %s, max fee %d stroops, %d signature(s)\n",
+			e.FeeBump.FeeAccount, e.FeeBump.MaxFee, e.FeeBump.Signatures)
+	}
+	fmt.Fprintf(&b, "Transaction from %s with sequence number %d, max fee %d stroops, %d signature(s)\n",
+		e.SourceAccount, e.Sequence, e.MaxFee, e.Signatures)
+	if e.Memo != nil {
+		fmt.Fprintf(&b, "Memo %s '%s'\n", e.Memo.Type, e.Memo.Value)
+	}
+	switch {
+	case e.ValidAfter != nil && e.ValidUntil != nil:
+		fmt.Fprintf(&b, "Valid from %s until %s\n", e.ValidAfter.Format(time.RFC3339), e.ValidUntil.Format(time.RFC3339))
+	case e.ValidAfter != nil:
+		fmt.Fprintf(&b, "Valid from %s, never expires\n", e.ValidAfter.Format(time.RFC3339))
+	case e.ValidUntil != nil:
+		fmt.Fprintf(&b, "Valid until %s\n", e.ValidUntil.Format(time.RFC3339))
+	default:
+		b.WriteString("Never expires\n")
+	}
+	for i, op := range e.Operations {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, op.Summary)
+	}
+	return b.String()
+}
+
+// String returns the abbreviated address of the account, followed by its muxed id if
+// it has one.
+func (a AccountExplanation) String() string {
+	address := a.Address
+	if len(address) > 8 {
+		address = address[:4] + "…" + address[len(address)-4:]
+	}
+	if a.MuxedID != nil {
+		return fmt.Sprintf("%s (muxed id %d)", address, *a.MuxedID)
+	}
+	return address
+}
+
+func explainAccount(account xdr.MuxedAccount) AccountExplanation {
+	aid := account.ToAccountId()
+	e := AccountExplanation{Address: aid.Address()}
+	if account.Type == xdr.CryptoKeyTypeKeyTypeMuxedEd25519 {
+		id := uint64(account.Med25519.Id)
+		e.MuxedID = &id
+	}
+	return e
+}
+
+func explainAccountID(address string) AccountExplanation {
+	return AccountExplanation{Address: address}
+}
+
+// explainAsset returns "XLM" for the native asset and "CODE (issuer GABC…)" for
+// credit assets.
+func explainAsset(asset Asset) string {
+	if asset == nil || asset.IsNative() {
+		return "XLM"
+	}
+	if asset.GetIssuer() == "" {
+		return asset.GetCode()
+	}
+	return fmt.Sprintf("%s (issuer %s)", asset.GetCode(), explainAccountID(asset.GetIssuer()))
+}
+
+func explainPath(path []Asset) string {
+	if len(path) == 0 {
+		return ""
+	}
+	assets := make([]string, 0, len(path))
+	for _, asset := range path {
+		assets = append(assets, explainAsset(asset))
+	}
+	return " via " + strings.Join(assets, ", ")
+}
+
+// explainDataValue returns the value of a data entry quoted if it is printable text
+// and base64 encoded otherwise.
+func explainDataValue(value []byte) string {
+	if utf8.Valid(value) && strings.IndexFunc(string(value), func(r rune) bool { return !unicode.IsPrint(r) }) < 0 {
+		return fmt.Sprintf("'%s'", value)
+	}
+	return "base64 " + base64.StdEncoding.EncodeToString(value)
+}
+
+func explainOperation(xdrOp xdr.Operation, txSource AccountExplanation) (OperationExplanation, error) {
+	op, err := operationFromXDR(xdrOp)
+	if err != nil {
+		return OperationExplanation{}, err
+	}
+	details, err := newOperationTemplate(op)
+	if err != nil {
+		return OperationExplanation{}, err
+	}
+
+	e := OperationExplanation{Type: details.Type, SourceAccount: txSource, Details: details}
+	if xdrOp.SourceAccount != nil {
+		e.SourceAccount = explainAccount(*xdrOp.SourceAccount)
+	}
+	source := e.SourceAccount
+
+	switch o := op.(type) {
+	case *CreateAccount:
+		e.Summary = fmt.Sprintf("Account %s creates account %s with a starting balance of %s XLM",
+			source, explainAccountID(o.Destination), o.Amount)
+	case *Payment:
+		destination := explainAccount(xdrOp.Body.MustPaymentOp().Destination)
+		e.Summary = fmt.Sprintf("Account %s pays %s %s to %s", source, o.Amount, explainAsset(o.Asset), destination)
+	case *PathPaymentStrictReceive:
+		destination := explainAccount(xdrOp.Body.MustPathPaymentStrictReceiveOp().Destination)
+		e.Summary = fmt.Sprintf("Account %s pays %s %s to %s, sending at most %s %s%s",
+			source, o.DestAmount, explainAsset(o.DestAsset), destination,
+			o.SendMax, explainAsset(o.SendAsset), explainPath(o.Path))
+	case *PathPaymentStrictSend:
+		destination := explainAccount(xdrOp.Body.MustPathPaymentStrictSendOp().Destination)
+		e.Summary = fmt.Sprintf("Account %s sends %s %s to %s, who receives at least %s %s%s",
+			source, o.SendAmount, explainAsset(o.SendAsset), destination,
+			o.DestMin, explainAsset(o.DestAsset), explainPath(o.Path))
+	case *ManageSellOffer:
+		e.Summary = explainOffer(source, "sell", o.Amount, explainAsset(o.Selling), explainAsset(o.Buying), o.Price, o.OfferID)
+	case *ManageBuyOffer:
+		e.Summary = explainOffer(source, "buy", o.Amount, explainAsset(o.Buying), explainAsset(o.Selling), o.Price, o.OfferID)
+	case *CreatePassiveSellOffer:
+		e.Summary = fmt.Sprintf("Account %s creates a passive offer to sell %s %s for %s at a price of %s",
+			source, o.Amount, explainAsset(o.Selling), explainAsset(o.Buying), o.Price)
+	case *SetOptions:
+		e.Summary = explainSetOptions(source, o)
+	case *ChangeTrust:
+		switch o.Limit {
+		case "0":
+			e.Summary = fmt.Sprintf("Account %s removes its trustline to %s", source, explainAsset(o.Line))
+		case MaxTrustlineLimit:
+			e.Summary = fmt.Sprintf("Account %s trusts %s without a limit", source, explainAsset(o.Line))
+		default:
+			e.Summary = fmt.Sprintf("Account %s trusts %s up to a limit of %s", source, explainAsset(o.Line), o.Limit)
+		}
+	case *AllowTrust:
+		action := "revokes the authorization of"
+		if o.Authorize {
+			action = "authorizes"
+		} else if o.AuthorizeToMaintainLiabilities {
+			action = "authorizes to maintain liabilities"
+		}
+		e.Summary = fmt.Sprintf("Account %s %s %s to hold %s", source, action, explainAccountID(o.Trustor), explainAsset(o.Type))
+	case *AccountMerge:
+		destination := explainAccount(xdrOp.Body.MustDestination())
+		e.Summary = fmt.Sprintf("Account %s is merged into %s", source, destination)
+	case *Inflation:
+		e.Summary = fmt.Sprintf("Account %s runs inflation", source)
+	case *ManageData:
+		if o.Value == nil {
+			e.Summary = fmt.Sprintf("Account %s deletes data entry '%s'", source, o.Name)
+		} else {
+			e.Summary = fmt.Sprintf("Account %s sets data entry '%s' to %s", source, o.Name, explainDataValue(o.Value))
+		}
+	case *BumpSequence:
+		e.Summary = fmt.Sprintf("Account %s bumps its sequence number to %d", source, o.BumpTo)
+	case *CreateClaimableBalance:
+		claimants := make([]string, 0, len(o.Destinations))
+		for _, claimant := range o.Destinations {
+			claimants = append(claimants, explainAccountID(claimant.Destination).String())
+		}
+		e.Summary = fmt.Sprintf("Account %s creates a claimable balance of %s %s claimable by %s",
+			source, o.Amount, explainAsset(o.Asset), strings.Join(claimants, ", "))
+	case *ClaimClaimableBalance:
+		e.Summary = fmt.Sprintf("Account %s claims claimable balance %s", source, o.BalanceID)
+	case *BeginSponsoringFutureReserves:
+		e.Summary = fmt.Sprintf("Account %s begins sponsoring the future reserves of %s", source, explainAccountID(o.SponsoredID))
+	case *EndSponsoringFutureReserves:
+		e.Summary = fmt.Sprintf("Account %s ends the sponsorship of its future reserves", source)
+	case *RevokeSponsorship:
+		e.Summary = fmt.Sprintf("Account %s revokes the sponsorship of %s", source, explainSponsorship(o))
+	default:
+		return e, errors.Errorf("unknown operation type %T", op)
+	}
+	return e, nil
+}
+
+func explainOffer(source AccountExplanation, side, amount, asset, counterAsset, price string, offerID int64) string {
+	if offerID != 0 && amount == "0" {
+		return fmt.Sprintf("Account %s deletes offer %d", source, offerID)
+	}
+	action := "offers"
+	if offerID != 0 {
+		action = fmt.Sprintf("updates offer %d", offerID)
+	}
+	return fmt.Sprintf("Account %s %s to %s %s %s for %s at a price of %s", source, action, side, amount, asset, counterAsset, price)
+}
+
+func explainSetOptions(source AccountExplanation, o *SetOptions) string {
+	var changes []string
+	if o.InflationDestination != nil {
+		changes = append(changes, "sets the inflation destination to "+explainAccountID(*o.InflationDestination).String())
+	}
+	if len(o.SetFlags) > 0 {
+		changes = append(changes, "sets flags "+strings.Join(accountFlagStrings(o.SetFlags), ", "))
+	}
+	if len(o.ClearFlags) > 0 {
+		changes = append(changes, "clears flags "+strings.Join(accountFlagStrings(o.ClearFlags), ", "))
+	}
+	if o.MasterWeight != nil {
+		changes = append(changes, fmt.Sprintf("sets the master key weight to %d", *o.MasterWeight))
+	}
+	if o.LowThreshold != nil {
+		changes = append(changes, fmt.Sprintf("sets the low threshold to %d", *o.LowThreshold))
+	}
+	if o.MediumThreshold != nil {
+		changes = append(changes, fmt.Sprintf("sets the medium threshold to %d", *o.MediumThreshold))
+	}
+	if o.HighThreshold != nil {
+		changes = append(changes, fmt.Sprintf("sets the high threshold to %d", *o.HighThreshold))
+	}
+	if o.HomeDomain != nil {
+		changes = append(changes, fmt.Sprintf("sets the home domain to '%s'", *o.HomeDomain))
+	}
+	if o.Signer != nil {
+		if o.Signer.Weight == 0 {
+			changes = append(changes, "removes signer "+explainAccountID(o.Signer.Address).String())
+		} else {
+			changes = append(changes, fmt.Sprintf("sets signer %s with weight %d", explainAccountID(o.Signer.Address), o.Signer.Weight))
+		}
+	}
+	if len(changes) == 0 {
+		return fmt.Sprintf("Account %s sets no options", source)
+	}
+	return fmt.Sprintf("Account %s %s", source, strings.Join(changes, ", "))
+}
+
+func explainSponsorship(o *RevokeSponsorship) string {
+	switch o.SponsorshipType {
+	case RevokeSponsorshipTypeAccount:
+		return "account " + explainAccountID(*o.Account).String()
+	case RevokeSponsorshipTypeTrustLine:
+		return fmt.Sprintf("the %s trustline of %s", explainAsset(o.TrustLine.Asset), explainAccountID(o.TrustLine.Account))
+	case RevokeSponsorshipTypeOffer:
+		return fmt.Sprintf("offer %d of %s", o.Offer.OfferID, explainAccountID(o.Offer.SellerAccountAddress))
+	case RevokeSponsorshipTypeData:
+		return fmt.Sprintf("data entry '%s' of %s", o.Data.DataName, explainAccountID(o.Data.Account))
+	case RevokeSponsorshipTypeClaimableBalance:
+		return "claimable balance " + *o.ClaimableBalance
+	default:
+		return fmt.Sprintf("signer %s of %s", explainAccountID(o.Signer.SignerAddress), explainAccountID(o.Signer.AccountID))
+	}
+}
@@ -0,0 +1,147 @@
+package txnbuild
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainTransaction(t *testing.T) {
+	tx := newTemplateTestTx(t)
+	tx, err := tx.Sign(network.TestNetworkPassphrase, newKeypair0())
+	require.NoError(t, err)
+	txeB64, err := tx.Base64()
+	require.NoError(t, err)
+	parsed, err := TransactionFromXDR(txeB64)
+	require.NoError(t, err)
+
+	e, err := ExplainTransaction(parsed)
+	require.NoError(t, err)
+	assert.Nil(t, e.FeeBump)
+	assert.Equal(t, newKeypair0().Address(), e.SourceAccount.Address)
+	assert.Equal(t, int64(9606132444168200), e.Sequence)
+	assert.Equal(t, int64(1500), e.MaxFee)
+	assert.Equal(t, 1, e.Signatures)
+	assert.Equal(t, &MemoTemplate{Type: "text", Value: "template"}, e.Memo)
+	require.NotNil(t, e.ValidUntil)
+	assert.Equal(t, "2020-04-27T15:06:40Z", e.ValidAfter.Format("2006-01-02T15:04:05Z07:00"))
+
+	summaries := []string{
+		"Account GDQN…KTL3 creates account GAS4…5LVP with a starting balance of 10.0000000 XLM",
+		"Account GB7B…RH7H pays 1.5000000 USD (issuer GAS4…5LVP) to GAS4…5LVP",
+		"Account GDQN…KTL3 pays 5.0000000 USD (issuer GAS4…5LVP) to GAS4…5LVP, sending at most 20.0000000 XLM via EURTOKEN (issuer GB7B…RH7H)",
+		"Account GDQN…KTL3 sends 5.0000000 USD (issuer GAS4…5LVP) to GAS4…5LVP, who receives at least 1.0000000 XLM via EURTOKEN (issuer GB7B…RH7H), XLM",
+		"Account GDQN…KTL3 updates offer 42 to sell 100.0000000 XLM for USD (issuer GAS4…5LVP) at a price of 0.5",
+		"Account GDQN…KTL3 offers to buy 10.0000000 EURTOKEN (issuer GB7B…RH7H) for USD (issuer GAS4…5LVP) at a price of 3",
+		"Account GDQN…KTL3 creates a passive offer to sell 7.0000000 EURTOKEN (issuer GB7B…RH7H) for XLM at a price of 1.25",
+		"Account GDQN…KTL3 sets the inflation destination to GAS4…5LVP, sets flags auth_required, auth_revocable, " +
+			"clears flags auth_immutable, sets the master key weight to 10, sets the low threshold to 1, " +
+			"sets the medium threshold to 2, sets the high threshold to 3, sets the home domain to 'example.com', " +
+			"sets signer GB7B…RH7H with weight 5",
+		"Account GDQN…KTL3 trusts USD (issuer GAS4…5LVP) up to a limit of 1000.0000000",
+		"Account GDQN…KTL3 authorizes GAS4…5LVP to hold USD",
+		"Account GB7B…RH7H is merged into GAS4…5LVP",
+		"Account GDQN…KTL3 runs inflation",
+		"Account GDQN…KTL3 sets data entry 'key' to base64 AAEC/w==",
+		"Account GDQN…KTL3 deletes data entry 'deleted'",
+		"Account GDQN…KTL3 bumps its sequence number to 9606132444168300",
+	}
+	require.Len(t, e.Operations, len(summaries))
+	for i, summary := range summaries {
+		assert.Equal(t, summary, e.Operations[i].Summary)
+	}
+	assert.Equal(t, "payment", e.Operations[1].Type)
+	assert.Equal(t, newKeypair2().Address(), e.Operations[1].SourceAccount.Address)
+	assert.Equal(t, "1.5000000", e.Operations[1].Details.Amount)
+
+	text := e.String()
+	assert.True(t, strings.HasPrefix(text, "Transaction from GDQN…KTL3 with sequence number 9606132444168200, max fee 1500 stroops, 1 signature(s)\n"+
+		"Memo text 'template'\n"+
+		"Valid from 2020-04-27T15:06:40Z until 2020-04-27T16:06:40Z\n"+
+		"1. Account GDQN…KTL3 creates account"), text)
+	assert.Contains(t, text, "\n15. Account GDQN…KTL3 bumps its sequence number to 9606132444168300\n")
+
+	data, err := e.JSON()
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "9606132444168200", decoded["sequence"])
+	assert.Equal(t, "2020-04-27T16:06:40Z", decoded["valid_until"])
+	assert.Len(t, decoded["operations"], len(summaries))
+}
+
+func TestExplainSponsorshipOperations(t *testing.T) {
+	tx := newSponsorshipTemplateTestTx(t)
+	e, err := ExplainTransaction(&GenericTransaction{simple: tx})
+	require.NoError(t, err)
+
+	summaries := []string{
+		"Account GDQN…KTL3 creates a claimable balance of 10.0000000 USD (issuer GAS4…5LVP) claimable by GAS4…5LVP, GB7B…RH7H",
+		"Account GAS4…5LVP claims claimable balance 00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+		"Account GDQN…KTL3 begins sponsoring the future reserves of GAS4…5LVP",
+		"Account GAS4…5LVP ends the sponsorship of its future reserves",
+		"Account GDQN…KTL3 revokes the sponsorship of account GAS4…5LVP",
+		"Account GDQN…KTL3 revokes the sponsorship of the USD (issuer GAS4…5LVP) trustline of GAS4…5LVP",
+		"Account GDQN…KTL3 revokes the sponsorship of offer 7 of GAS4…5LVP",
+		"Account GDQN…KTL3 revokes the sponsorship of data entry 'key' of GAS4…5LVP",
+		"Account GDQN…KTL3 revokes the sponsorship of claimable balance 00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be",
+		"Account GDQN…KTL3 revokes the sponsorship of signer GB7B…RH7H of GAS4…5LVP",
+	}
+	require.Len(t, e.Operations, len(summaries))
+	for i, summary := range summaries {
+		assert.Equal(t, summary, e.Operations[i].Summary)
+	}
+	assert.Equal(t, "create_claimable_balance", e.Operations[0].Type)
+	require.Len(t, e.Operations[0].Details.Claimants, 2)
+	assert.True(t, e.Operations[0].Details.Claimants[0].Predicate.Unconditional)
+}
+
+func TestExplainFeeBumpTransactionWithMuxedAccounts(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	muxedDestination := xdr.MuxedAccount{
+		Type:     xdr.CryptoKeyTypeKeyTypeMuxedEd25519,
+		Med25519: &xdr.MuxedAccountMed25519{Id: 42, Ed25519: *xdr.MustAddress(kp1.Address()).Ed25519},
+	}
+	inner := newSignatureRequestTx(t, &Payment{Destination: muxedDestination.Address(), Amount: "10", Asset: NativeAsset{}})
+	convertToV1Tx(inner)
+
+	// txnbuild cannot build transactions with a muxed source account yet, so it is
+	// set on the envelope
+	inner.envelope.V1.Tx.SourceAccount = xdr.MuxedAccount{
+		Type:     xdr.CryptoKeyTypeKeyTypeMuxedEd25519,
+		Med25519: &xdr.MuxedAccountMed25519{Id: 7, Ed25519: *xdr.MustAddress(kp0.Address()).Ed25519},
+	}
+
+	feeBump, err := NewFeeBumpTransaction(FeeBumpTransactionParams{
+		Inner:      inner,
+		FeeAccount: kp1.Address(),
+		BaseFee:    200,
+	})
+	require.NoError(t, err)
+	txeB64, err := feeBump.Base64()
+	require.NoError(t, err)
+	parsed, err := TransactionFromXDR(txeB64)
+	require.NoError(t, err)
+
+	e, err := ExplainTransaction(parsed)
+	require.NoError(t, err)
+	require.NotNil(t, e.FeeBump)
+	assert.Equal(t, kp1.Address(), e.FeeBump.FeeAccount.Address)
+	assert.Equal(t, int64(400), e.FeeBump.MaxFee)
+	require.NotNil(t, e.SourceAccount.MuxedID)
+	assert.Equal(t, uint64(7), *e.SourceAccount.MuxedID)
+	assert.Nil(t, e.ValidUntil)
+	require.Len(t, e.Operations, 1)
+	assert.Equal(t, "Account GDQN…KTL3 (muxed id 7) pays 10.0000000 XLM to GAS4…5LVP (muxed id 42)", e.Operations[0].Summary)
+
+	text := e.String()
+	assert.Equal(t, "Fee bump transaction paid by GAS4…5LVP, max fee 400 stroops, 0 signature(s)\n"+
+		"Transaction from GDQN…KTL3 (muxed id 7) with sequence number 2, max fee 100 stroops, 0 signature(s)\n"+
+		"Never expires\n"+
+		"1. Account GDQN…KTL3 (muxed id 7) pays 10.0000000 XLM to GAS4…5LVP (muxed id 42)\n", text)
+}
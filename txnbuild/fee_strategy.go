@@ -0,0 +1,156 @@
+package txnbuild
+
+import (
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+)
+
+// FeeStrategy determines the base fee of a transaction. NewTransaction consults the
+// FeeStrategy in TransactionParams when no BaseFee is provided.
+type FeeStrategy interface {
+	BaseFee() (int64, error)
+}
+
+// FeeStatsSource provides the fee statistics reported by Horizon. It is satisfied by
+// horizonclient.Client and horizonclient.MockClient.
+type FeeStatsSource interface {
+	FeeStats() (hProtocol.FeeStats, error)
+}
+
+// FeePercentile selects a percentile of a Horizon fee distribution.
+type FeePercentile int
+
+// FeePercentile10 to FeePercentile99 enumerate the percentiles reported by Horizon's
+// /fee_stats endpoint.
+const (
+	FeePercentile10 FeePercentile = 10
+	FeePercentile20 FeePercentile = 20
+	FeePercentile30 FeePercentile = 30
+	FeePercentile40 FeePercentile = 40
+	FeePercentile50 FeePercentile = 50
+	FeePercentile60 FeePercentile = 60
+	FeePercentile70 FeePercentile = 70
+	FeePercentile80 FeePercentile = 80
+	FeePercentile90 FeePercentile = 90
+	FeePercentile95 FeePercentile = 95
+	FeePercentile99 FeePercentile = 99
+)
+
+// value returns the fee of the percentile in the given distribution.
+func (p FeePercentile) value(d hProtocol.FeeDistribution) (int64, error) {
+	switch p {
+	case FeePercentile10:
+		return d.P10, nil
+	case FeePercentile20:
+		return d.P20, nil
+	case FeePercentile30:
+		return d.P30, nil
+	case FeePercentile40:
+		return d.P40, nil
+	case FeePercentile50:
+		return d.P50, nil
+	case FeePercentile60:
+		return d.P60, nil
+	case FeePercentile70:
+		return d.P70, nil
+	case FeePercentile80:
+		return d.P80, nil
+	case FeePercentile90:
+		return d.P90, nil
+	case FeePercentile95:
+		return d.P95, nil
+	case FeePercentile99:
+		return d.P99, nil
+	}
+	return 0, errors.Errorf("fee percentile %d is not supported", p)
+}
+
+// FeeStatsStrategy is a FeeStrategy which derives the base fee from the fee statistics
+// of recent ledgers reported by Horizon.
+type FeeStatsStrategy struct {
+	// Source provides the fee statistics, usually a horizonclient.Client.
+	Source FeeStatsSource
+	// Percentile of the fee distribution to use. Defaults to FeePercentile50.
+	Percentile FeePercentile
+	// UseMaxFee selects the max_fee distribution, the fees transactions were
+	// willing to pay, instead of the fee_charged distribution.
+	UseMaxFee bool
+	// MaxBaseFee caps the base fee returned by the strategy. No cap is applied
+	// if it is zero.
+	MaxBaseFee int64
+}
+
+// BaseFee returns the fee of the configured percentile. The fee is never lower than
+// MinBaseFee or the base fee of the last ledger.
+func (s FeeStatsStrategy) BaseFee() (int64, error) {
+	if s.Source == nil {
+		return 0, errors.New("fee stats source is missing")
+	}
+
+	stats, err := s.Source.FeeStats()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not obtain fee stats")
+	}
+
+	percentile := s.Percentile
+	if percentile == 0 {
+		percentile = FeePercentile50
+	}
+	distribution := stats.FeeCharged
+	if s.UseMaxFee {
+		distribution = stats.MaxFee
+	}
+	fee, err := percentile.value(distribution)
+	if err != nil {
+		return 0, err
+	}
+
+	if fee < stats.LastLedgerBaseFee {
+		fee = stats.LastLedgerBaseFee
+	}
+	if fee < MinBaseFee {
+		fee = MinBaseFee
+	}
+	if s.MaxBaseFee > 0 && fee > s.MaxBaseFee {
+		fee = s.MaxBaseFee
+	}
+	return fee, nil
+}
+
+// FixedFeeStrategy is a FeeStrategy which always returns the same base fee.
+type FixedFeeStrategy int64
+
+// BaseFee returns the fixed base fee.
+func (s FixedFeeStrategy) BaseFee() (int64, error) {
+	return int64(s), nil
+}
+
+// BumpFee wraps a transaction which is stuck because its fee is too low in a
+// FeeBumpTransaction paid for by feeAccount. The base fee of the fee bump transaction
+// is determined by strategy, which usually selects a higher percentile than the one
+// used to build the inner transaction. The base fee is never lower than the base fee
+// of the inner transaction. V0 transactions, which cannot be fee bumped, are wrapped
+// as the equivalent V1 transaction, which has the same hash and signatures; inner
+// itself is not modified.
+func BumpFee(inner *Transaction, feeAccount string, strategy FeeStrategy) (*FeeBumpTransaction, error) {
+	if inner == nil {
+		return nil, errors.New("inner transaction is missing")
+	}
+	if strategy == nil {
+		return nil, errors.New("fee strategy is missing")
+	}
+
+	baseFee, err := strategy.BaseFee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine base fee")
+	}
+	if baseFee < inner.BaseFee() {
+		baseFee = inner.BaseFee()
+	}
+
+	return NewFeeBumpTransaction(FeeBumpTransactionParams{
+		Inner:      v1Transaction(inner),
+		FeeAccount: feeAccount,
+		BaseFee:    baseFee,
+	})
+}
@@ -0,0 +1,153 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFeeStatsSource struct {
+	stats hProtocol.FeeStats
+	err   error
+}
+
+func (m mockFeeStatsSource) FeeStats() (hProtocol.FeeStats, error) {
+	return m.stats, m.err
+}
+
+var cannedFeeStats = hProtocol.FeeStats{
+	LastLedger:        22606298,
+	LastLedgerBaseFee: 100,
+	FeeCharged: hProtocol.FeeDistribution{
+		Min: 100, Mode: 100, P10: 100, P20: 100, P30: 100, P40: 100, P50: 150,
+		P60: 200, P70: 300, P80: 400, P90: 800, P95: 1000, P99: 2000, Max: 5000,
+	},
+	MaxFee: hProtocol.FeeDistribution{
+		Min: 100, Mode: 200, P10: 100, P20: 100, P30: 200, P40: 200, P50: 300,
+		P60: 400, P70: 600, P80: 800, P90: 1500, P95: 2500, P99: 10000, Max: 100000,
+	},
+}
+
+func TestFeeStatsStrategy(t *testing.T) {
+	source := mockFeeStatsSource{stats: cannedFeeStats}
+
+	fee, err := FeeStatsStrategy{Source: source}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), fee)
+
+	fee, err = FeeStatsStrategy{Source: source, Percentile: FeePercentile90}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(800), fee)
+
+	fee, err = FeeStatsStrategy{Source: source, Percentile: FeePercentile99, UseMaxFee: true}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), fee)
+
+	fee, err = FeeStatsStrategy{Source: source, Percentile: FeePercentile99, MaxBaseFee: 1000}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), fee)
+
+	_, err = FeeStatsStrategy{Source: source, Percentile: 42}.BaseFee()
+	assert.EqualError(t, err, "fee percentile 42 is not supported")
+}
+
+func TestFeeStatsStrategyMinimumFee(t *testing.T) {
+	stats := cannedFeeStats
+	stats.FeeCharged.P50 = 0
+	fee, err := FeeStatsStrategy{Source: mockFeeStatsSource{stats: stats}}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(MinBaseFee), fee)
+
+	stats.LastLedgerBaseFee = 500
+	fee, err = FeeStatsStrategy{Source: mockFeeStatsSource{stats: stats}}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), fee)
+}
+
+func TestFeeStatsStrategyErrors(t *testing.T) {
+	_, err := FeeStatsStrategy{}.BaseFee()
+	assert.EqualError(t, err, "fee stats source is missing")
+
+	_, err = FeeStatsStrategy{Source: mockFeeStatsSource{err: errors.New("horizon is down")}}.BaseFee()
+	assert.EqualError(t, err, "could not obtain fee stats: horizon is down")
+}
+
+func TestNewTransactionFeeStrategy(t *testing.T) {
+	kp0 := newKeypair0()
+	params := TransactionParams{
+		SourceAccount:        &SimpleAccount{AccountID: kp0.Address(), Sequence: 1},
+		IncrementSequenceNum: false,
+		Operations:           []Operation{&BumpSequence{BumpTo: 2}, &BumpSequence{BumpTo: 3}},
+		FeeStrategy:          FeeStatsStrategy{Source: mockFeeStatsSource{stats: cannedFeeStats}, Percentile: FeePercentile90},
+		Timebounds:           NewInfiniteTimeout(),
+	}
+
+	tx, err := NewTransaction(params)
+	require.NoError(t, err)
+	assert.Equal(t, int64(800), tx.BaseFee())
+	assert.Equal(t, int64(1600), tx.MaxFee())
+
+	// an explicit base fee takes precedence over the strategy
+	params.BaseFee = 200
+	tx, err = NewTransaction(params)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), tx.BaseFee())
+
+	params.BaseFee = 0
+	params.FeeStrategy = FeeStatsStrategy{Source: mockFeeStatsSource{err: errors.New("horizon is down")}}
+	_, err = NewTransaction(params)
+	assert.EqualError(t, err, "could not obtain base fee from fee strategy: could not obtain fee stats: horizon is down")
+}
+
+func TestBumpFee(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	inner, err := NewTransaction(TransactionParams{
+		SourceAccount: &SimpleAccount{AccountID: kp0.Address(), Sequence: 1},
+		Operations:    []Operation{&BumpSequence{BumpTo: 2}},
+		FeeStrategy:   FeeStatsStrategy{Source: mockFeeStatsSource{stats: cannedFeeStats}},
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), inner.BaseFee())
+	inner, err = inner.Sign(network.TestNetworkPassphrase, kp0)
+	require.NoError(t, err)
+	innerHash, err := inner.HashHex(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	innerB64, err := inner.Base64()
+	require.NoError(t, err)
+
+	feeBump, err := BumpFee(inner, kp1.Address(), FeeStatsStrategy{
+		Source:     mockFeeStatsSource{stats: cannedFeeStats},
+		Percentile: FeePercentile99,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), feeBump.BaseFee())
+	assert.Equal(t, int64(4000), feeBump.MaxFee())
+	assert.Equal(t, kp1.Address(), feeBump.FeeAccount())
+
+	// the V0 transaction is wrapped as a V1 transaction with the same hash
+	// and signatures, without modifying it
+	wrapped := feeBump.InnerTransaction()
+	assert.Equal(t, xdr.EnvelopeTypeEnvelopeTypeTx, wrapped.envelope.Type)
+	wrappedHash, err := wrapped.HashHex(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, innerHash, wrappedHash)
+	assert.Equal(t, inner.Signatures(), wrapped.Signatures())
+	assert.Equal(t, xdr.EnvelopeTypeEnvelopeTypeTxV0, inner.envelope.Type)
+	b64, err := inner.Base64()
+	require.NoError(t, err)
+	assert.Equal(t, innerB64, b64)
+
+	// the fee bump never bids less than the inner transaction
+	feeBump, err = BumpFee(inner, kp1.Address(), FixedFeeStrategy(MinBaseFee))
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), feeBump.BaseFee())
+
+	_, err = BumpFee(inner, kp1.Address(), nil)
+	assert.EqualError(t, err, "fee strategy is missing")
+}
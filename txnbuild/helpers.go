@@ -2,9 +2,12 @@ package txnbuild
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/stellar/go/amount"
 	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
 )
 
 // validateStellarPublicKey returns an error if a public key is invalid. Otherwise, it returns nil.
@@ -20,6 +23,18 @@ func validateStellarPublicKey(publicKey string) error {
 	return nil
 }
 
+// validateStellarMuxedAccount checks if the string provided is a valid stellar account id (G...) or
+// multiplexed account (M...). It returns an error if it is not.
+func validateStellarMuxedAccount(address string) error {
+	if strings.HasPrefix(address, "M") {
+		_, err := strkey.DecodeMuxedAccount(address)
+		return err
+	}
+
+	_, err := xdr.AddressToAccountId(address)
+	return err
+}
+
 // validateStellarAsset checks if the asset supplied is a valid stellar Asset. It returns an error if the asset is
 // nil, has an invalid asset code or issuer.
 func validateStellarAsset(asset Asset) error {
@@ -106,18 +106,7 @@ func convertToV1Tx(tx *Transaction) {
 	// remove manual envelope type configuration because
 	// once protocol 13 is enabled txnbuild will generate
 	// v1 transaction envelopes by default
-	tx.envelope.V1 = &xdr.TransactionV1Envelope{
-		Tx: xdr.Transaction{
-			SourceAccount: tx.envelope.SourceAccount(),
-			Fee:           xdr.Uint32(tx.envelope.Fee()),
-			SeqNum:        xdr.SequenceNumber(tx.envelope.SeqNum()),
-			TimeBounds:    tx.envelope.V0.Tx.TimeBounds,
-			Memo:          tx.envelope.Memo(),
-			Operations:    tx.envelope.Operations(),
-		},
-	}
-	tx.envelope.Type = xdr.EnvelopeTypeEnvelopeTypeTx
-	tx.envelope.V0 = nil
+	*tx = *v1Transaction(tx)
 }
 
 func TestValidateStellarPublicKey(t *testing.T) {
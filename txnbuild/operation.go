@@ -1,6 +1,7 @@
 package txnbuild
 
 import (
+	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
 
@@ -12,7 +13,8 @@ type Operation interface {
 	GetSourceAccount() Account
 }
 
-// SetOpSourceAccount sets the source account ID on an Operation.
+// SetOpSourceAccount sets the source account ID on an Operation. The source account
+// may be an account id (G...) or a multiplexed account (M...).
 func SetOpSourceAccount(op *xdr.Operation, sourceAccount Account) {
 	if sourceAccount == nil {
 		return
@@ -54,17 +56,29 @@ func operationFromXDR(xdrOp xdr.Operation) (Operation, error) {
 		newOp = &ManageBuyOffer{}
 	case xdr.OperationTypePathPaymentStrictSend:
 		newOp = &PathPaymentStrictSend{}
+	case xdr.OperationTypeCreateClaimableBalance:
+		newOp = &CreateClaimableBalance{}
+	case xdr.OperationTypeClaimClaimableBalance:
+		newOp = &ClaimClaimableBalance{}
+	case xdr.OperationTypeBeginSponsoringFutureReserves:
+		newOp = &BeginSponsoringFutureReserves{}
+	case xdr.OperationTypeEndSponsoringFutureReserves:
+		newOp = &EndSponsoringFutureReserves{}
+	case xdr.OperationTypeRevokeSponsorship:
+		newOp = &RevokeSponsorship{}
+	default:
+		return nil, errors.Errorf("unknown operation type: %s", xdrOp.Body.Type)
 	}
 
 	err := newOp.FromXDR(xdrOp)
 	return newOp, err
 }
 
-// accountFromXDR returns a txnbuild Account from a XDR Account.
+// accountFromXDR returns a txnbuild Account from a XDR Account. Multiplexed
+// accounts keep their M... address.
 func accountFromXDR(account *xdr.MuxedAccount) Account {
 	if account != nil {
-		aid := account.ToAccountId()
-		return &SimpleAccount{AccountID: aid.Address()}
+		return &SimpleAccount{AccountID: account.Address()}
 	}
 	return nil
 }
@@ -416,3 +416,73 @@ func TestBumpSequenceFromXDR(t *testing.T) {
 		assert.Equal(t, int64(45), bs.BumpTo, "BumpTo should match")
 	}
 }
+
+func TestMuxedAccountsRoundTrip(t *testing.T) {
+	kp0, kp1, kp2 := newKeypair0(), newKeypair1(), newKeypair2()
+	muxed := func(address string, id uint64) string {
+		aid := xdr.MustAddress(address)
+		m := xdr.MuxedAccount{
+			Type:     xdr.CryptoKeyTypeKeyTypeMuxedEd25519,
+			Med25519: &xdr.MuxedAccountMed25519{Id: xdr.Uint64(id), Ed25519: *aid.Ed25519},
+		}
+		return m.Address()
+	}
+	source := &SimpleAccount{AccountID: muxed(kp0.Address(), 1)}
+	destination := muxed(kp1.Address(), 2)
+	usd := CreditAsset{Code: "USD", Issuer: kp2.Address()}
+
+	operations := []Operation{
+		&Payment{Destination: destination, Amount: "10.0000000", Asset: NativeAsset{}, SourceAccount: source},
+		&PathPaymentStrictReceive{
+			SendAsset: NativeAsset{}, SendMax: "20.0000000", Destination: destination,
+			DestAsset: usd, DestAmount: "5.0000000", Path: []Asset{}, SourceAccount: source,
+		},
+		&PathPaymentStrictSend{
+			SendAsset: NativeAsset{}, SendAmount: "20.0000000", Destination: destination,
+			DestAsset: usd, DestMin: "5.0000000", Path: []Asset{}, SourceAccount: source,
+		},
+		&AccountMerge{Destination: destination, SourceAccount: source},
+		// a plain account id is still a plain account id
+		&Payment{Destination: kp1.Address(), Amount: "1.0000000", Asset: usd, SourceAccount: &SimpleAccount{AccountID: kp2.Address()}},
+	}
+	tx, err := NewTransaction(TransactionParams{
+		SourceAccount: &SimpleAccount{AccountID: kp0.Address(), Sequence: 1},
+		Operations:    operations,
+		BaseFee:       MinBaseFee,
+		Timebounds:    NewInfiniteTimeout(),
+	})
+	assert.NoError(t, err)
+
+	xdrOps := tx.envelope.Operations()
+	assert.Equal(t, xdr.CryptoKeyTypeKeyTypeMuxedEd25519, xdrOps[0].SourceAccount.Type)
+	assert.Equal(t, xdr.Uint64(1), xdrOps[0].SourceAccount.Med25519.Id)
+	assert.Equal(t, xdr.Uint64(2), xdrOps[0].Body.PaymentOp.Destination.Med25519.Id)
+	assert.Equal(t, xdr.Uint64(2), xdrOps[3].Body.Destination.Med25519.Id)
+	assert.Equal(t, xdr.CryptoKeyTypeKeyTypeEd25519, xdrOps[4].Body.PaymentOp.Destination.Type)
+
+	txeB64, err := tx.Base64()
+	assert.NoError(t, err)
+	parsed, err := TransactionFromXDR(txeB64)
+	assert.NoError(t, err)
+	parsedTx, ok := parsed.Transaction()
+	assert.True(t, ok)
+	assert.Equal(t, operations, parsedTx.Operations())
+}
+
+func TestMuxedAccountValidation(t *testing.T) {
+	payment := Payment{
+		Destination: "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUR",
+		Amount:      "10",
+		Asset:       NativeAsset{},
+	}
+	err := payment.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: Destination")
+	}
+
+	payment.Destination = "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAAACJUQ"
+	assert.NoError(t, payment.Validate())
+
+	merge := AccountMerge{Destination: payment.Destination}
+	assert.NoError(t, merge.Validate())
+}
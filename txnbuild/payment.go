@@ -60,8 +60,7 @@ func (p *Payment) FromXDR(xdrOp xdr.Operation) error {
 	}
 
 	p.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
-	destAID := result.Destination.ToAccountId()
-	p.Destination = destAID.Address()
+	p.Destination = result.Destination.Address()
 	p.Amount = amount.String(result.Amount)
 
 	asset, err := assetFromXDR(result.Asset)
@@ -76,7 +75,7 @@ func (p *Payment) FromXDR(xdrOp xdr.Operation) error {
 // Validate for Payment validates the required struct fields. It returns an error if any
 // of the fields are invalid. Otherwise, it returns nil.
 func (p *Payment) Validate() error {
-	_, err := xdr.AddressToAccountId(p.Destination)
+	err := validateStellarMuxedAccount(p.Destination)
 	if err != nil {
 		return NewValidationError("Destination", err.Error())
 	}
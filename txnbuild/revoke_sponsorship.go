@@ -0,0 +1,252 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// RevokeSponsorshipType is the type of sponsorship to revoke.
+type RevokeSponsorshipType int
+
+// RevokeSponsorshipType values
+const (
+	RevokeSponsorshipTypeAccount RevokeSponsorshipType = iota + 1
+	RevokeSponsorshipTypeTrustLine
+	RevokeSponsorshipTypeOffer
+	RevokeSponsorshipTypeData
+	RevokeSponsorshipTypeClaimableBalance
+	RevokeSponsorshipTypeSigner
+)
+
+// RevokeSponsorship represents the Stellar revoke sponsorship operation. See
+// https://www.stellar.org/developers/guides/concepts/list-of-operations.html
+//
+// SponsorshipType determines which of the other fields identifies the
+// sponsored ledger entry or signer.
+type RevokeSponsorship struct {
+	SponsorshipType RevokeSponsorshipType
+	// Account ID (strkey)
+	Account   *string
+	TrustLine *TrustLineID
+	Offer     *OfferID
+	Data      *DataID
+	// Claimable Balance ID
+	ClaimableBalance *string
+	Signer           *SignerID
+	SourceAccount    Account
+}
+
+// SignerID identifies a signer of an account.
+type SignerID struct {
+	AccountID     string
+	SignerAddress string
+}
+
+// TrustLineID identifies a trust line.
+type TrustLineID struct {
+	Account string
+	Asset   Asset
+}
+
+// OfferID identifies an offer.
+type OfferID struct {
+	SellerAccountAddress string
+	OfferID              int64
+}
+
+// DataID identifies a data entry.
+type DataID struct {
+	Account  string
+	DataName string
+}
+
+// BuildXDR for RevokeSponsorship returns a fully configured XDR Operation.
+func (r *RevokeSponsorship) BuildXDR() (xdr.Operation, error) {
+	xdrOp := xdr.RevokeSponsorshipOp{}
+	switch r.SponsorshipType {
+	case RevokeSponsorshipTypeAccount, RevokeSponsorshipTypeTrustLine, RevokeSponsorshipTypeOffer,
+		RevokeSponsorshipTypeData, RevokeSponsorshipTypeClaimableBalance:
+		key, err := r.ledgerKey()
+		if err != nil {
+			return xdr.Operation{}, err
+		}
+		xdrOp.Type = xdr.RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry
+		xdrOp.LedgerKey = &key
+	case RevokeSponsorshipTypeSigner:
+		if r.Signer == nil {
+			return xdr.Operation{}, errors.New("Signer can not be nil")
+		}
+		var signer xdr.RevokeSponsorshipOpSigner
+		if err := signer.AccountId.SetAddress(r.Signer.AccountID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "incorrect Signer account address")
+		}
+		if err := signer.SignerKey.SetAddress(r.Signer.SignerAddress); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "incorrect Signer address")
+		}
+		xdrOp.Type = xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner
+		xdrOp.Signer = &signer
+	default:
+		return xdr.Operation{}, errors.Errorf("unknown SponsorshipType: %d", r.SponsorshipType)
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeRevokeSponsorship, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, r.SourceAccount)
+	return op, nil
+}
+
+// ledgerKey returns the key of the sponsored ledger entry.
+func (r *RevokeSponsorship) ledgerKey() (xdr.LedgerKey, error) {
+	var key xdr.LedgerKey
+	var account xdr.AccountId
+	var err error
+
+	switch r.SponsorshipType {
+	case RevokeSponsorshipTypeAccount:
+		if r.Account == nil {
+			return key, errors.New("Account can not be nil")
+		}
+		if err = account.SetAddress(*r.Account); err != nil {
+			return key, errors.Wrap(err, "incorrect Account address")
+		}
+		err = key.SetAccount(account)
+	case RevokeSponsorshipTypeTrustLine:
+		if r.TrustLine == nil {
+			return key, errors.New("TrustLine can not be nil")
+		}
+		if err = account.SetAddress(r.TrustLine.Account); err != nil {
+			return key, errors.Wrap(err, "incorrect TrustLine account address")
+		}
+		if r.TrustLine.Asset == nil {
+			return key, errors.New("TrustLine asset can not be nil")
+		}
+		var asset xdr.Asset
+		if asset, err = r.TrustLine.Asset.ToXDR(); err != nil {
+			return key, errors.Wrap(err, "incorrect TrustLine asset")
+		}
+		err = key.SetTrustline(account, asset)
+	case RevokeSponsorshipTypeOffer:
+		if r.Offer == nil {
+			return key, errors.New("Offer can not be nil")
+		}
+		if err = account.SetAddress(r.Offer.SellerAccountAddress); err != nil {
+			return key, errors.Wrap(err, "incorrect Offer seller address")
+		}
+		err = key.SetOffer(account, uint64(r.Offer.OfferID))
+	case RevokeSponsorshipTypeData:
+		if r.Data == nil {
+			return key, errors.New("Data can not be nil")
+		}
+		if err = account.SetAddress(r.Data.Account); err != nil {
+			return key, errors.Wrap(err, "incorrect Data account address")
+		}
+		err = key.SetData(account, r.Data.DataName)
+	case RevokeSponsorshipTypeClaimableBalance:
+		if r.ClaimableBalance == nil {
+			return key, errors.New("ClaimableBalance can not be nil")
+		}
+		var balanceID xdr.ClaimableBalanceId
+		if err = xdr.SafeUnmarshalHex(*r.ClaimableBalance, &balanceID); err != nil {
+			return key, errors.Wrap(err, "incorrect ClaimableBalance id")
+		}
+		err = key.SetClaimableBalance(balanceID)
+	}
+	if err != nil {
+		return key, errors.Wrap(err, "failed to build ledger key")
+	}
+	return key, nil
+}
+
+// FromXDR for RevokeSponsorship initialises the txnbuild struct from the corresponding xdr Operation.
+func (r *RevokeSponsorship) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetRevokeSponsorshipOp()
+	if !ok {
+		return errors.New("error parsing revoke_sponsorship operation from xdr")
+	}
+	r.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	switch result.Type {
+	case xdr.RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry:
+		key := result.MustLedgerKey()
+		switch key.Type {
+		case xdr.LedgerEntryTypeAccount:
+			r.SponsorshipType = RevokeSponsorshipTypeAccount
+			account := key.MustAccount()
+			address := account.AccountId.Address()
+			r.Account = &address
+		case xdr.LedgerEntryTypeTrustline:
+			tl := key.MustTrustLine()
+			asset, err := assetFromXDR(tl.Asset)
+			if err != nil {
+				return errors.Wrap(err, "error parsing TrustLine asset")
+			}
+			r.SponsorshipType = RevokeSponsorshipTypeTrustLine
+			r.TrustLine = &TrustLineID{Account: tl.AccountId.Address(), Asset: asset}
+		case xdr.LedgerEntryTypeOffer:
+			offer := key.MustOffer()
+			r.SponsorshipType = RevokeSponsorshipTypeOffer
+			r.Offer = &OfferID{
+				SellerAccountAddress: offer.SellerId.Address(),
+				OfferID:              int64(offer.OfferId),
+			}
+		case xdr.LedgerEntryTypeData:
+			data := key.MustData()
+			r.SponsorshipType = RevokeSponsorshipTypeData
+			r.Data = &DataID{Account: data.AccountId.Address(), DataName: string(data.DataName)}
+		case xdr.LedgerEntryTypeClaimableBalance:
+			balanceID, err := xdr.MarshalHex(key.MustClaimableBalance().BalanceId)
+			if err != nil {
+				return errors.Wrap(err, "error parsing ClaimableBalance id")
+			}
+			r.SponsorshipType = RevokeSponsorshipTypeClaimableBalance
+			r.ClaimableBalance = &balanceID
+		default:
+			return errors.Errorf("unknown ledger key type: %d", key.Type)
+		}
+	case xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner:
+		signer := result.MustSigner()
+		r.SponsorshipType = RevokeSponsorshipTypeSigner
+		r.Signer = &SignerID{
+			AccountID:     signer.AccountId.Address(),
+			SignerAddress: signer.SignerKey.Address(),
+		}
+	default:
+		return errors.Errorf("unknown revoke sponsorship type: %d", result.Type)
+	}
+	return nil
+}
+
+// Validate for RevokeSponsorship validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (r *RevokeSponsorship) Validate() error {
+	switch r.SponsorshipType {
+	case RevokeSponsorshipTypeSigner:
+		if r.Signer == nil {
+			return NewValidationError("Signer", "Signer can not be nil")
+		}
+		if err := validateStellarPublicKey(r.Signer.AccountID); err != nil {
+			return NewValidationError("Signer", err.Error())
+		}
+		var signerKey xdr.SignerKey
+		if err := signerKey.SetAddress(r.Signer.SignerAddress); err != nil {
+			return NewValidationError("Signer", err.Error())
+		}
+	case RevokeSponsorshipTypeAccount, RevokeSponsorshipTypeTrustLine, RevokeSponsorshipTypeOffer,
+		RevokeSponsorshipTypeData, RevokeSponsorshipTypeClaimableBalance:
+		if _, err := r.ledgerKey(); err != nil {
+			return NewValidationError("SponsorshipType", err.Error())
+		}
+	default:
+		return NewValidationError("SponsorshipType", "unknown SponsorshipType")
+	}
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation, or nil if not
+// set.
+func (r *RevokeSponsorship) GetSourceAccount() Account {
+	return r.SourceAccount
+}
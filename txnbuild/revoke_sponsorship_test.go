@@ -0,0 +1,69 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeSponsorshipRoundTrip(t *testing.T) {
+	accountAddress := newKeypair1().Address()
+	balanceID := "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be"
+	usd := CreditAsset{Code: "USD", Issuer: newKeypair2().Address()}
+
+	for _, op := range []*RevokeSponsorship{
+		{
+			SourceAccount:   &SimpleAccount{AccountID: newKeypair0().Address()},
+			SponsorshipType: RevokeSponsorshipTypeAccount,
+			Account:         &accountAddress,
+		},
+		{
+			SponsorshipType: RevokeSponsorshipTypeTrustLine,
+			TrustLine:       &TrustLineID{Account: accountAddress, Asset: usd},
+		},
+		{
+			SponsorshipType: RevokeSponsorshipTypeOffer,
+			Offer:           &OfferID{SellerAccountAddress: accountAddress, OfferID: 1234},
+		},
+		{
+			SponsorshipType: RevokeSponsorshipTypeData,
+			Data:            &DataID{Account: accountAddress, DataName: "foobar"},
+		},
+		{
+			SponsorshipType:  RevokeSponsorshipTypeClaimableBalance,
+			ClaimableBalance: &balanceID,
+		},
+		{
+			SponsorshipType: RevokeSponsorshipTypeSigner,
+			Signer:          &SignerID{AccountID: accountAddress, SignerAddress: newKeypair2().Address()},
+		},
+	} {
+		xdrOp, err := op.BuildXDR()
+		require.NoError(t, err)
+
+		var parsed RevokeSponsorship
+		require.NoError(t, parsed.FromXDR(xdrOp))
+		assert.Equal(t, op, &parsed)
+	}
+}
+
+func TestRevokeSponsorshipValidate(t *testing.T) {
+	op := RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeAccount}
+	err := op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: SponsorshipType, Error: Account can not be nil")
+	}
+
+	op = RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeSigner, Signer: &SignerID{AccountID: "GABC"}}
+	err = op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: Signer, Error: GABC is not a valid stellar public key")
+	}
+
+	op = RevokeSponsorship{}
+	err = op.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Field: SponsorshipType, Error: unknown SponsorshipType")
+	}
+}
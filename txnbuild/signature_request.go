@@ -0,0 +1,398 @@
+package txnbuild
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ThresholdCategory identifies which of an account's thresholds an operation must meet.
+// See https://www.stellar.org/developers/guides/concepts/multi-sig.html#thresholds
+type ThresholdCategory int
+
+// ThresholdCategoryLow, ThresholdCategoryMedium and ThresholdCategoryHigh enumerate the
+// threshold categories of an account.
+const (
+	ThresholdCategoryLow ThresholdCategory = iota
+	ThresholdCategoryMedium
+	ThresholdCategoryHigh
+)
+
+// AccountSigners describes the signers and thresholds of an account whose authorization is
+// required by a transaction.
+type AccountSigners struct {
+	Signers         SignerSummary
+	LowThreshold    Threshold
+	MediumThreshold Threshold
+	HighThreshold   Threshold
+}
+
+// threshold returns the threshold of the given category.
+func (a AccountSigners) threshold(category ThresholdCategory) Threshold {
+	switch category {
+	case ThresholdCategoryHigh:
+		return a.HighThreshold
+	case ThresholdCategoryMedium:
+		return a.MediumThreshold
+	default:
+		return a.LowThreshold
+	}
+}
+
+// SignatureRequirement reports the signing status of an account whose authorization is
+// required by a transaction.
+type SignatureRequirement struct {
+	// Account is the address of the account which must authorize the transaction.
+	Account string
+	// Category is the highest threshold category required by the operations sourced
+	// from Account.
+	Category ThresholdCategory
+	// Threshold is the weight which must be reached for Category.
+	Threshold Threshold
+	// Weight is the sum of the weights of the signers which have signed.
+	Weight int32
+	// Signed is the list of signers of Account which have signed.
+	Signed []string
+	// Met is true if enough signers have signed for Threshold to be reached.
+	Met bool
+}
+
+// SignatureRequest tracks the collection of signatures for a Transaction or
+// FeeBumpTransaction which must be signed by several parties, possibly on different
+// machines. Signatures can be added directly or merged from other copies of the same
+// transaction envelope, and every signature is verified against the network hash of
+// the transaction before it is accepted.
+//
+// For a FeeBumpTransaction only the signatures of the fee account are collected. The
+// inner transaction must be fully signed before it is wrapped.
+type SignatureRequest struct {
+	network    string
+	hash       [32]byte
+	simple     *Transaction
+	feeBump    *FeeBumpTransaction
+	accounts   map[string]AccountSigners
+	categories map[string]ThresholdCategory
+	signatures []xdr.DecoratedSignature
+}
+
+// NewSignatureRequest returns a SignatureRequest for tx. accounts must contain the
+// signers and thresholds of the transaction source account and of every operation
+// source account.
+func NewSignatureRequest(tx *Transaction, network string, accounts map[string]AccountSigners) (*SignatureRequest, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is missing")
+	}
+
+	categories := map[string]ThresholdCategory{
+		tx.SourceAccount().AccountID: ThresholdCategoryLow,
+	}
+	for _, op := range tx.envelope.Operations() {
+		source := tx.SourceAccount().AccountID
+		if op.SourceAccount != nil {
+			aid := op.SourceAccount.ToAccountId()
+			source = aid.Address()
+		}
+		category := operationThresholdCategory(op)
+		if current, ok := categories[source]; !ok || category > current {
+			categories[source] = category
+		}
+	}
+
+	r := &SignatureRequest{
+		network:    network,
+		simple:     tx,
+		categories: categories,
+	}
+	if err := r.init(tx.Hash, tx.Signatures(), accounts); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewFeeBumpSignatureRequest returns a SignatureRequest for the fee account of tx.
+// accounts must contain the signers and thresholds of the fee account.
+func NewFeeBumpSignatureRequest(tx *FeeBumpTransaction, network string, accounts map[string]AccountSigners) (*SignatureRequest, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is missing")
+	}
+
+	r := &SignatureRequest{
+		network: network,
+		feeBump: tx,
+		categories: map[string]ThresholdCategory{
+			tx.FeeAccount(): ThresholdCategoryLow,
+		},
+	}
+	if err := r.init(tx.Hash, tx.Signatures(), accounts); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *SignatureRequest) init(
+	hash func(string) ([32]byte, error),
+	signatures []xdr.DecoratedSignature,
+	accounts map[string]AccountSigners,
+) error {
+	for account := range r.categories {
+		if _, ok := accounts[account]; !ok {
+			return errors.Errorf("signers of account %s are missing", account)
+		}
+	}
+	r.accounts = accounts
+
+	var err error
+	r.hash, err = hash(r.network)
+	if err != nil {
+		return errors.Wrap(err, "failed to hash transaction")
+	}
+
+	for _, sig := range signatures {
+		if err = r.addSignature(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// operationThresholdCategory returns the threshold category required by op.
+func operationThresholdCategory(op xdr.Operation) ThresholdCategory {
+	switch op.Body.Type {
+	case xdr.OperationTypeAllowTrust, xdr.OperationTypeBumpSequence, xdr.OperationTypeInflation:
+		return ThresholdCategoryLow
+	case xdr.OperationTypeAccountMerge:
+		return ThresholdCategoryHigh
+	case xdr.OperationTypeSetOptions:
+		so := op.Body.MustSetOptionsOp()
+		if so.MasterWeight != nil || so.LowThreshold != nil || so.MedThreshold != nil ||
+			so.HighThreshold != nil || so.Signer != nil {
+			return ThresholdCategoryHigh
+		}
+	}
+	return ThresholdCategoryMedium
+}
+
+// Sign adds signatures derived from the given list of keypairs.
+func (r *SignatureRequest) Sign(kps ...*keypair.Full) error {
+	for _, kp := range kps {
+		sig, err := kp.SignDecorated(r.hash[:])
+		if err != nil {
+			return errors.Wrap(err, "failed to sign transaction")
+		}
+		if err = r.addSignature(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddSignatureBase64 adds a base64-encoded signature produced by publicKey.
+func (r *SignatureRequest) AddSignatureBase64(publicKey, signature string) error {
+	sigs, err := concatSignatureBase64(r.envelope(), nil, r.network, publicKey, signature)
+	if err != nil {
+		return err
+	}
+	return r.addSignature(sigs[0])
+}
+
+// Merge adds the signatures found in txeB64, which must be a base64 XDR encoded copy
+// of the same transaction envelope.
+func (r *SignatureRequest) Merge(txeB64 string) error {
+	parsed, err := TransactionFromXDR(txeB64)
+	if err != nil {
+		return err
+	}
+
+	var hash [32]byte
+	var signatures []xdr.DecoratedSignature
+	if tx, ok := parsed.Transaction(); ok && r.simple != nil {
+		hash, err = tx.Hash(r.network)
+		signatures = tx.Signatures()
+	} else if tx, ok := parsed.FeeBump(); ok && r.feeBump != nil {
+		hash, err = tx.Hash(r.network)
+		signatures = tx.Signatures()
+	} else {
+		return errors.New("transaction type does not match the signature request")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to hash transaction")
+	}
+	if hash != r.hash {
+		return errors.New("transaction hash does not match the signature request")
+	}
+
+	for _, sig := range signatures {
+		if err = r.addSignature(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSignature adds sig if it verifies for one of the known signers. Signatures
+// which have already been collected are ignored.
+func (r *SignatureRequest) addSignature(sig xdr.DecoratedSignature) error {
+	for _, existing := range r.signatures {
+		if existing.Hint == sig.Hint && bytes.Equal(existing.Signature, sig.Signature) {
+			return nil
+		}
+	}
+
+	candidate := []xdr.DecoratedSignature{sig}
+	for _, signer := range r.knownSigners() {
+		// pre authorized transaction signers do not consume signatures
+		if version, err := strkey.Version(signer); err != nil || version == strkey.VersionByteHashTx {
+			continue
+		}
+		if len(r.signersFound(signer, candidate)) > 0 {
+			r.signatures = append(r.signatures, sig)
+			return nil
+		}
+	}
+	return errors.New("signature does not verify for any of the transaction signers")
+}
+
+// knownSigners returns the signers of every account in the request.
+func (r *SignatureRequest) knownSigners() []string {
+	seen := map[string]bool{}
+	signers := []string{}
+	for account := range r.categories {
+		for signer := range r.accounts[account].Signers {
+			if !seen[signer] {
+				seen[signer] = true
+				signers = append(signers, signer)
+			}
+		}
+	}
+	sort.Strings(signers)
+	return signers
+}
+
+// signersFound returns the subset of signers which have a matching signature. Pre
+// authorized transaction signers match if they are the hash of this transaction.
+func (r *SignatureRequest) signersFound(signer string, signatures []xdr.DecoratedSignature) []string {
+	version, payload, err := strkey.DecodeAny(signer)
+	if err != nil {
+		return nil
+	}
+
+	switch version {
+	case strkey.VersionByteAccountID:
+		found, err := verifyHashSignatures(r.hash, signatures, signer)
+		if err != nil {
+			return nil
+		}
+		return found
+	case strkey.VersionByteHashTx:
+		if bytes.Equal(payload, r.hash[:]) {
+			return []string{signer}
+		}
+	case strkey.VersionByteHashX:
+		for _, sig := range signatures {
+			h := sha256.Sum256(sig.Signature)
+			if bytes.Equal(payload, h[:]) {
+				return []string{signer}
+			}
+		}
+	}
+	return nil
+}
+
+// Requirements returns the signing status of every account whose authorization is
+// required, sorted by account address.
+func (r *SignatureRequest) Requirements() []SignatureRequirement {
+	accounts := make([]string, 0, len(r.categories))
+	for account := range r.categories {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	requirements := make([]SignatureRequirement, 0, len(accounts))
+	for _, account := range accounts {
+		signers := r.accounts[account]
+		category := r.categories[account]
+		requirement := SignatureRequirement{
+			Account:   account,
+			Category:  category,
+			Threshold: signers.threshold(category),
+			Signed:    []string{},
+		}
+
+		names := make([]string, 0, len(signers.Signers))
+		for signer := range signers.Signers {
+			names = append(names, signer)
+		}
+		sort.Strings(names)
+		for _, signer := range names {
+			if len(r.signersFound(signer, r.signatures)) == 0 {
+				continue
+			}
+			requirement.Signed = append(requirement.Signed, signer)
+			requirement.Weight += signers.Signers[signer]
+		}
+
+		// stellar-core requires at least one signature even if the threshold is 0
+		requirement.Met = requirement.Weight > 0 && requirement.Weight >= int32(requirement.Threshold)
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
+
+// Ready returns true if the thresholds of all the accounts whose authorization is
+// required have been met.
+func (r *SignatureRequest) Ready() bool {
+	for _, requirement := range r.Requirements() {
+		if !requirement.Met {
+			return false
+		}
+	}
+	return true
+}
+
+// Signatures returns the list of verified signatures collected so far.
+// The contents of the returned slice should not be modified.
+func (r *SignatureRequest) Signatures() []xdr.DecoratedSignature {
+	return r.signatures
+}
+
+// Transaction returns the Transaction with all the collected signatures, or false if
+// the request is for a FeeBumpTransaction.
+func (r *SignatureRequest) Transaction() (*Transaction, bool) {
+	if r.simple == nil {
+		return nil, false
+	}
+	newTx := new(Transaction)
+	*newTx = *r.simple
+	newTx.signatures = r.signatures
+	return newTx, true
+}
+
+// FeeBump returns the FeeBumpTransaction with all the collected signatures, or false
+// if the request is for a Transaction.
+func (r *SignatureRequest) FeeBump() (*FeeBumpTransaction, bool) {
+	if r.feeBump == nil {
+		return nil, false
+	}
+	newTx := new(FeeBumpTransaction)
+	*newTx = *r.feeBump
+	newTx.signatures = r.signatures
+	return newTx, true
+}
+
+// Base64 returns the base 64 XDR representation of the transaction envelope with all
+// the collected signatures.
+func (r *SignatureRequest) Base64() (string, error) {
+	return marshallBase64(r.envelope(), r.signatures)
+}
+
+func (r *SignatureRequest) envelope() xdr.TransactionEnvelope {
+	if r.feeBump != nil {
+		return r.feeBump.envelope
+	}
+	return r.simple.envelope
+}
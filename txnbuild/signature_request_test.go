@@ -0,0 +1,247 @@
+package txnbuild
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/strkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSignatureRequestTx(t *testing.T, ops ...Operation) *Transaction {
+	kp0 := newKeypair0()
+	tx, err := NewTransaction(
+		TransactionParams{
+			SourceAccount:        &SimpleAccount{AccountID: kp0.Address(), Sequence: 1},
+			IncrementSequenceNum: true,
+			Operations:           ops,
+			BaseFee:              MinBaseFee,
+			Timebounds:           NewInfiniteTimeout(),
+		},
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestSignatureRequestMissingAccounts(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	tx := newSignatureRequestTx(t, &BumpSequence{BumpTo: 5, SourceAccount: &SimpleAccount{AccountID: kp1.Address()}})
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp0.Address(): {Signers: SignerSummary{kp0.Address(): 1}},
+	})
+	assert.EqualError(t, err, "signers of account "+kp1.Address()+" are missing")
+	assert.Nil(t, request)
+}
+
+func TestSignatureRequestThresholds(t *testing.T) {
+	kp0, kp1, kp2 := newKeypair0(), newKeypair1(), newKeypair2()
+	tx := newSignatureRequestTx(t,
+		&BumpSequence{BumpTo: 5},
+		&SetOptions{MasterWeight: NewThreshold(10)},
+	)
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp0.Address(): {
+			Signers:         SignerSummary{kp0.Address(): 1, kp1.Address(): 2, kp2.Address(): 2},
+			LowThreshold:    1,
+			MediumThreshold: 2,
+			HighThreshold:   3,
+		},
+	})
+	require.NoError(t, err)
+
+	requirements := request.Requirements()
+	require.Len(t, requirements, 1)
+	assert.Equal(t, ThresholdCategoryHigh, requirements[0].Category)
+	assert.Equal(t, Threshold(3), requirements[0].Threshold)
+	assert.False(t, requirements[0].Met)
+	assert.False(t, request.Ready())
+
+	require.NoError(t, request.Sign(kp1))
+	assert.Equal(t, int32(2), request.Requirements()[0].Weight)
+	assert.False(t, request.Ready())
+
+	// signing twice with the same key does not add weight
+	require.NoError(t, request.Sign(kp1))
+	assert.Len(t, request.Signatures(), 1)
+	assert.False(t, request.Ready())
+
+	require.NoError(t, request.Sign(kp0))
+	requirements = request.Requirements()
+	assert.Equal(t, int32(3), requirements[0].Weight)
+	assert.ElementsMatch(t, []string{kp0.Address(), kp1.Address()}, requirements[0].Signed)
+	assert.True(t, request.Ready())
+
+	signed, ok := request.Transaction()
+	require.True(t, ok)
+	found, err := verifyTxSignatures(signed, network.TestNetworkPassphrase, kp0.Address(), kp1.Address())
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+}
+
+func TestSignatureRequestOperationSources(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	tx := newSignatureRequestTx(t,
+		&Payment{
+			Destination:   kp0.Address(),
+			Amount:        "10",
+			Asset:         NativeAsset{},
+			SourceAccount: &SimpleAccount{AccountID: kp1.Address()},
+		},
+	)
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp0.Address(): {Signers: SignerSummary{kp0.Address(): 1}},
+		kp1.Address(): {Signers: SignerSummary{kp1.Address(): 1}, MediumThreshold: 1},
+	})
+	require.NoError(t, err)
+
+	requirements := request.Requirements()
+	require.Len(t, requirements, 2)
+	for _, requirement := range requirements {
+		switch requirement.Account {
+		case kp0.Address():
+			assert.Equal(t, ThresholdCategoryLow, requirement.Category)
+		case kp1.Address():
+			assert.Equal(t, ThresholdCategoryMedium, requirement.Category)
+		}
+	}
+
+	require.NoError(t, request.Sign(kp0))
+	assert.False(t, request.Ready())
+	require.NoError(t, request.Sign(kp1))
+	assert.True(t, request.Ready())
+}
+
+func TestSignatureRequestRejectsUnknownSignatures(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	tx := newSignatureRequestTx(t, &BumpSequence{BumpTo: 5})
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp0.Address(): {Signers: SignerSummary{kp0.Address(): 1}},
+	})
+	require.NoError(t, err)
+
+	err = request.Sign(kp1)
+	assert.EqualError(t, err, "signature does not verify for any of the transaction signers")
+
+	// a signature for another network does not verify
+	hash, err := tx.Hash(network.PublicNetworkPassphrase)
+	require.NoError(t, err)
+	sig, err := kp0.Sign(hash[:])
+	require.NoError(t, err)
+	err = request.AddSignatureBase64(kp0.Address(), base64.StdEncoding.EncodeToString(sig))
+	assert.Contains(t, err.Error(), "failed to verify the signature")
+
+	hash, err = tx.Hash(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	sig, err = kp0.Sign(hash[:])
+	require.NoError(t, err)
+	require.NoError(t, request.AddSignatureBase64(kp0.Address(), base64.StdEncoding.EncodeToString(sig)))
+	assert.True(t, request.Ready())
+}
+
+func TestSignatureRequestMerge(t *testing.T) {
+	kp0, kp1, kp2 := newKeypair0(), newKeypair1(), newKeypair2()
+	tx := newSignatureRequestTx(t, &SetOptions{HighThreshold: NewThreshold(2)})
+	accounts := map[string]AccountSigners{
+		kp0.Address(): {
+			Signers:       SignerSummary{kp0.Address(): 1, kp1.Address(): 1, kp2.Address(): 1},
+			HighThreshold: 3,
+		},
+	}
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, accounts)
+	require.NoError(t, err)
+	require.NoError(t, request.Sign(kp0))
+
+	// each cosigner signs their own copy of the transaction
+	copy1, err := tx.Sign(network.TestNetworkPassphrase, kp1)
+	require.NoError(t, err)
+	copy1B64, err := copy1.Base64()
+	require.NoError(t, err)
+	copy2, err := tx.Sign(network.TestNetworkPassphrase, kp2)
+	require.NoError(t, err)
+	copy2B64, err := copy2.Base64()
+	require.NoError(t, err)
+
+	require.NoError(t, request.Merge(copy1B64))
+	assert.False(t, request.Ready())
+	require.NoError(t, request.Merge(copy2B64))
+	require.NoError(t, request.Merge(copy2B64))
+	assert.True(t, request.Ready())
+	assert.Len(t, request.Signatures(), 3)
+
+	b64, err := request.Base64()
+	require.NoError(t, err)
+	parsed, err := TransactionFromXDR(b64)
+	require.NoError(t, err)
+	signed, ok := parsed.Transaction()
+	require.True(t, ok)
+	assert.Len(t, signed.Signatures(), 3)
+
+	other := newSignatureRequestTx(t, &SetOptions{HighThreshold: NewThreshold(3)})
+	other, err = other.Sign(network.TestNetworkPassphrase, kp1)
+	require.NoError(t, err)
+	otherB64, err := other.Base64()
+	require.NoError(t, err)
+	err = request.Merge(otherB64)
+	assert.EqualError(t, err, "transaction hash does not match the signature request")
+}
+
+func TestSignatureRequestPreAuthTx(t *testing.T) {
+	kp0 := newKeypair0()
+	tx := newSignatureRequestTx(t, &BumpSequence{BumpTo: 5})
+	hash, err := tx.Hash(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	preAuth, err := strkey.Encode(strkey.VersionByteHashTx, hash[:])
+	require.NoError(t, err)
+
+	request, err := NewSignatureRequest(tx, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp0.Address(): {Signers: SignerSummary{kp0.Address(): 0, preAuth: 1}},
+	})
+	require.NoError(t, err)
+	assert.True(t, request.Ready())
+	assert.Empty(t, request.Signatures())
+}
+
+func TestFeeBumpSignatureRequest(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	inner := newSignatureRequestTx(t, &BumpSequence{BumpTo: 5})
+	convertToV1Tx(inner)
+	inner, err := inner.Sign(network.TestNetworkPassphrase, kp0)
+	require.NoError(t, err)
+
+	feeBump, err := NewFeeBumpTransaction(FeeBumpTransactionParams{
+		Inner:      inner,
+		FeeAccount: kp1.Address(),
+		BaseFee:    MinBaseFee,
+	})
+	require.NoError(t, err)
+
+	request, err := NewFeeBumpSignatureRequest(feeBump, network.TestNetworkPassphrase, map[string]AccountSigners{
+		kp1.Address(): {Signers: SignerSummary{kp1.Address(): 1}},
+	})
+	require.NoError(t, err)
+	assert.False(t, request.Ready())
+
+	assert.Error(t, request.Sign(kp0))
+	require.NoError(t, request.Sign(kp1))
+	assert.True(t, request.Ready())
+
+	_, ok := request.Transaction()
+	assert.False(t, ok)
+	signed, ok := request.FeeBump()
+	require.True(t, ok)
+	assert.Len(t, signed.Signatures(), 1)
+	assert.Len(t, signed.InnerTransaction().Signatures(), 1)
+	assert.Len(t, feeBump.Signatures(), 0)
+
+	random := keypair.MustRandom()
+	err = request.Sign(random)
+	assert.Error(t, err)
+}
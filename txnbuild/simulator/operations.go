@@ -0,0 +1,495 @@
+package simulator
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/stellar/go/xdr"
+)
+
+// maxSigners is the maximum number of signers an account can have.
+const maxSigners = 20
+
+func (s *Simulator) applyOperation(ltx *ledgerTxn, source xdr.AccountId, op xdr.Operation) (xdr.OperationResult, error) {
+	account, err := ltx.loadAccount(source)
+	if err != nil {
+		return xdr.OperationResult{}, err
+	}
+	if account == nil {
+		return xdr.OperationResult{Code: xdr.OperationResultCodeOpNoAccount}, nil
+	}
+
+	var tr xdr.OperationResultTr
+	switch op.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		var code xdr.CreateAccountResultCode
+		code, err = s.createAccount(ltx, account, op.Body.MustCreateAccountOp())
+		tr.CreateAccountResult = &xdr.CreateAccountResult{Code: code}
+	case xdr.OperationTypePayment:
+		var code xdr.PaymentResultCode
+		code, err = s.payment(ltx, account, op.Body.MustPaymentOp())
+		tr.PaymentResult = &xdr.PaymentResult{Code: code}
+	case xdr.OperationTypeSetOptions:
+		var code xdr.SetOptionsResultCode
+		code, err = s.setOptions(ltx, account, op.Body.MustSetOptionsOp())
+		tr.SetOptionsResult = &xdr.SetOptionsResult{Code: code}
+	case xdr.OperationTypeChangeTrust:
+		var code xdr.ChangeTrustResultCode
+		code, err = s.changeTrust(ltx, account, op.Body.MustChangeTrustOp())
+		tr.ChangeTrustResult = &xdr.ChangeTrustResult{Code: code}
+	case xdr.OperationTypeAllowTrust:
+		var code xdr.AllowTrustResultCode
+		code, err = s.allowTrust(ltx, account, op.Body.MustAllowTrustOp())
+		tr.AllowTrustResult = &xdr.AllowTrustResult{Code: code}
+	case xdr.OperationTypeAccountMerge:
+		tr.AccountMergeResult, err = s.accountMerge(ltx, account, op.Body.MustDestination())
+	case xdr.OperationTypeManageData:
+		var code xdr.ManageDataResultCode
+		code, err = s.manageData(ltx, account, op.Body.MustManageDataOp())
+		tr.ManageDataResult = &xdr.ManageDataResult{Code: code}
+	case xdr.OperationTypeBumpSequence:
+		var code xdr.BumpSequenceResultCode
+		code, err = s.bumpSequence(account, op.Body.MustBumpSequenceOp())
+		tr.BumpSeqResult = &xdr.BumpSequenceResult{Code: code}
+	default:
+		return xdr.OperationResult{}, ErrUnsupportedOperation
+	}
+	if err != nil {
+		return xdr.OperationResult{}, err
+	}
+
+	tr.Type = op.Body.Type
+	return xdr.OperationResult{Code: xdr.OperationResultCodeOpInner, Tr: &tr}, nil
+}
+
+func (s *Simulator) createAccount(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.CreateAccountOp) (xdr.CreateAccountResultCode, error) {
+	if op.StartingBalance <= 0 || op.Destination.Equals(source.AccountId) {
+		return xdr.CreateAccountResultCodeCreateAccountMalformed, nil
+	}
+
+	dest, err := ltx.loadAccount(op.Destination)
+	if err != nil {
+		return 0, err
+	}
+	if dest != nil {
+		return xdr.CreateAccountResultCodeCreateAccountAlreadyExist, nil
+	}
+	if int64(op.StartingBalance) < 2*s.baseReserve() {
+		return xdr.CreateAccountResultCodeCreateAccountLowReserve, nil
+	}
+	if s.availableBalance(*source) < int64(op.StartingBalance) {
+		return xdr.CreateAccountResultCodeCreateAccountUnderfunded, nil
+	}
+
+	source.Balance -= op.StartingBalance
+	err = ltx.create(xdr.LedgerEntryTypeAccount, xdr.AccountEntry{
+		AccountId:  op.Destination,
+		Balance:    op.StartingBalance,
+		SeqNum:     xdr.SequenceNumber(int64(s.LedgerSequence) << 32),
+		Thresholds: xdr.Thresholds{1, 0, 0, 0},
+	})
+	return xdr.CreateAccountResultCodeCreateAccountSuccess, err
+}
+
+func (s *Simulator) payment(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.PaymentOp) (xdr.PaymentResultCode, error) {
+	if op.Amount <= 0 {
+		return xdr.PaymentResultCodePaymentMalformed, nil
+	}
+
+	destID := op.Destination.ToAccountId()
+	dest, err := ltx.loadAccount(destID)
+	if err != nil {
+		return 0, err
+	}
+	if dest == nil {
+		return xdr.PaymentResultCodePaymentNoDestination, nil
+	}
+
+	if op.Asset.Type == xdr.AssetTypeAssetTypeNative {
+		if s.availableBalance(*source) < int64(op.Amount) {
+			return xdr.PaymentResultCodePaymentUnderfunded, nil
+		}
+		if int64(dest.Balance) > math.MaxInt64-int64(op.Amount)-buyingLiabilities(*dest) {
+			return xdr.PaymentResultCodePaymentLineFull, nil
+		}
+		source.Balance -= op.Amount
+		dest.Balance += op.Amount
+		return xdr.PaymentResultCodePaymentSuccess, nil
+	}
+
+	issuer := assetIssuer(op.Asset)
+	var sourceLine, destLine *xdr.TrustLineEntry
+	if !source.AccountId.Equals(issuer) {
+		sourceLine, err = ltx.loadTrustLine(source.AccountId, op.Asset)
+		if err != nil {
+			return 0, err
+		}
+		if sourceLine == nil {
+			return xdr.PaymentResultCodePaymentSrcNoTrust, nil
+		}
+		if !xdr.TrustLineFlags(sourceLine.Flags).IsAuthorized() {
+			return xdr.PaymentResultCodePaymentSrcNotAuthorized, nil
+		}
+		if int64(sourceLine.Balance)-trustLineSellingLiabilities(*sourceLine) < int64(op.Amount) {
+			return xdr.PaymentResultCodePaymentUnderfunded, nil
+		}
+	}
+	if !destID.Equals(issuer) {
+		destLine, err = ltx.loadTrustLine(destID, op.Asset)
+		if err != nil {
+			return 0, err
+		}
+		if destLine == nil {
+			return xdr.PaymentResultCodePaymentNoTrust, nil
+		}
+		if !xdr.TrustLineFlags(destLine.Flags).IsAuthorized() {
+			return xdr.PaymentResultCodePaymentNotAuthorized, nil
+		}
+		if int64(destLine.Limit)-int64(destLine.Balance)-trustLineBuyingLiabilities(*destLine) < int64(op.Amount) {
+			return xdr.PaymentResultCodePaymentLineFull, nil
+		}
+	}
+
+	if sourceLine != nil {
+		sourceLine.Balance -= op.Amount
+	}
+	if destLine != nil {
+		destLine.Balance += op.Amount
+	}
+	return xdr.PaymentResultCodePaymentSuccess, nil
+}
+
+func (s *Simulator) setOptions(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.SetOptionsOp) (xdr.SetOptionsResultCode, error) {
+	if op.InflationDest != nil {
+		dest, err := ltx.loadAccount(*op.InflationDest)
+		if err != nil {
+			return 0, err
+		}
+		if dest == nil {
+			return xdr.SetOptionsResultCodeSetOptionsInvalidInflation, nil
+		}
+	}
+
+	var setFlags, clearFlags xdr.Uint32
+	if op.SetFlags != nil {
+		setFlags = *op.SetFlags
+	}
+	if op.ClearFlags != nil {
+		clearFlags = *op.ClearFlags
+	}
+	if setFlags&clearFlags != 0 {
+		return xdr.SetOptionsResultCodeSetOptionsBadFlags, nil
+	}
+	if (setFlags|clearFlags)&^xdr.MaskAccountFlags != 0 {
+		return xdr.SetOptionsResultCodeSetOptionsUnknownFlag, nil
+	}
+	if (setFlags|clearFlags) != 0 && xdr.AccountFlags(source.Flags).IsAuthImmutable() {
+		return xdr.SetOptionsResultCodeSetOptionsCantChange, nil
+	}
+
+	for _, weight := range []*xdr.Uint32{op.MasterWeight, op.LowThreshold, op.MedThreshold, op.HighThreshold} {
+		if weight != nil && *weight > math.MaxUint8 {
+			return xdr.SetOptionsResultCodeSetOptionsThresholdOutOfRange, nil
+		}
+	}
+
+	if op.Signer != nil {
+		if op.Signer.Weight > math.MaxUint8 {
+			return xdr.SetOptionsResultCodeSetOptionsThresholdOutOfRange, nil
+		}
+		if op.Signer.Key.Address() == source.AccountId.Address() {
+			return xdr.SetOptionsResultCodeSetOptionsBadSigner, nil
+		}
+		if code := s.updateSigner(source, *op.Signer); code != xdr.SetOptionsResultCodeSetOptionsSuccess {
+			return code, nil
+		}
+	}
+
+	if op.InflationDest != nil {
+		dest := *op.InflationDest
+		source.InflationDest = &dest
+	}
+	source.Flags = (source.Flags | setFlags) &^ clearFlags
+	if op.MasterWeight != nil {
+		source.Thresholds[0] = byte(*op.MasterWeight)
+	}
+	if op.LowThreshold != nil {
+		source.Thresholds[1] = byte(*op.LowThreshold)
+	}
+	if op.MedThreshold != nil {
+		source.Thresholds[2] = byte(*op.MedThreshold)
+	}
+	if op.HighThreshold != nil {
+		source.Thresholds[3] = byte(*op.HighThreshold)
+	}
+	if op.HomeDomain != nil {
+		source.HomeDomain = *op.HomeDomain
+	}
+	return xdr.SetOptionsResultCodeSetOptionsSuccess, nil
+}
+
+func (s *Simulator) updateSigner(source *xdr.AccountEntry, signer xdr.Signer) xdr.SetOptionsResultCode {
+	for i, existing := range source.Signers {
+		if !existing.Key.Equals(signer.Key) {
+			continue
+		}
+		if signer.Weight == 0 {
+			source.Signers = append(source.Signers[:i], source.Signers[i+1:]...)
+			source.NumSubEntries--
+		} else {
+			source.Signers[i].Weight = signer.Weight
+		}
+		return xdr.SetOptionsResultCodeSetOptionsSuccess
+	}
+
+	if signer.Weight == 0 {
+		return xdr.SetOptionsResultCodeSetOptionsSuccess
+	}
+	if len(source.Signers) >= maxSigners {
+		return xdr.SetOptionsResultCodeSetOptionsTooManySigners
+	}
+	if !s.canAddSubEntry(*source) {
+		return xdr.SetOptionsResultCodeSetOptionsLowReserve
+	}
+
+	// stellar-core keeps signers sorted by their XDR encoded key
+	encoded, _ := signer.Key.MarshalBinary()
+	i := 0
+	for ; i < len(source.Signers); i++ {
+		other, _ := source.Signers[i].Key.MarshalBinary()
+		if bytes.Compare(encoded, other) < 0 {
+			break
+		}
+	}
+	source.Signers = append(source.Signers, xdr.Signer{})
+	copy(source.Signers[i+1:], source.Signers[i:])
+	source.Signers[i] = signer
+	source.NumSubEntries++
+	return xdr.SetOptionsResultCodeSetOptionsSuccess
+}
+
+func (s *Simulator) changeTrust(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.ChangeTrustOp) (xdr.ChangeTrustResultCode, error) {
+	if op.Line.Type == xdr.AssetTypeAssetTypeNative || op.Limit < 0 {
+		return xdr.ChangeTrustResultCodeChangeTrustMalformed, nil
+	}
+	issuerID := assetIssuer(op.Line)
+	if issuerID.Equals(source.AccountId) {
+		return xdr.ChangeTrustResultCodeChangeTrustSelfNotAllowed, nil
+	}
+
+	line, err := ltx.loadTrustLine(source.AccountId, op.Line)
+	if err != nil {
+		return 0, err
+	}
+
+	if line != nil {
+		if int64(op.Limit) < int64(line.Balance)+trustLineBuyingLiabilities(*line) {
+			return xdr.ChangeTrustResultCodeChangeTrustInvalidLimit, nil
+		}
+		if op.Limit == 0 {
+			var key xdr.LedgerKey
+			if err = key.SetTrustline(source.AccountId, op.Line); err != nil {
+				return 0, err
+			}
+			source.NumSubEntries--
+			return xdr.ChangeTrustResultCodeChangeTrustSuccess, ltx.remove(key)
+		}
+		issuer, err := ltx.loadAccount(issuerID)
+		if err != nil {
+			return 0, err
+		}
+		if issuer == nil {
+			return xdr.ChangeTrustResultCodeChangeTrustNoIssuer, nil
+		}
+		line.Limit = op.Limit
+		return xdr.ChangeTrustResultCodeChangeTrustSuccess, nil
+	}
+
+	if op.Limit == 0 {
+		return xdr.ChangeTrustResultCodeChangeTrustInvalidLimit, nil
+	}
+	issuer, err := ltx.loadAccount(issuerID)
+	if err != nil {
+		return 0, err
+	}
+	if issuer == nil {
+		return xdr.ChangeTrustResultCodeChangeTrustNoIssuer, nil
+	}
+	if !s.canAddSubEntry(*source) {
+		return xdr.ChangeTrustResultCodeChangeTrustLowReserve, nil
+	}
+
+	var flags xdr.Uint32
+	if !xdr.AccountFlags(issuer.Flags).IsAuthRequired() {
+		flags = xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag)
+	}
+	source.NumSubEntries++
+	err = ltx.create(xdr.LedgerEntryTypeTrustline, xdr.TrustLineEntry{
+		AccountId: source.AccountId,
+		Asset:     op.Line,
+		Limit:     op.Limit,
+		Flags:     flags,
+	})
+	return xdr.ChangeTrustResultCodeChangeTrustSuccess, err
+}
+
+func (s *Simulator) allowTrust(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.AllowTrustOp) (xdr.AllowTrustResultCode, error) {
+	if op.Asset.Type == xdr.AssetTypeAssetTypeNative || op.Authorize&^xdr.MaskTrustlineFlagsV13 != 0 ||
+		op.Authorize == xdr.MaskTrustlineFlagsV13 {
+		return xdr.AllowTrustResultCodeAllowTrustMalformed, nil
+	}
+	if op.Trustor.Equals(source.AccountId) {
+		return xdr.AllowTrustResultCodeAllowTrustSelfNotAllowed, nil
+	}
+	flags := xdr.AccountFlags(source.Flags)
+	if !flags.IsAuthRequired() {
+		return xdr.AllowTrustResultCodeAllowTrustTrustNotRequired, nil
+	}
+	if !flags.IsAuthRevocable() && op.Authorize != xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag) {
+		return xdr.AllowTrustResultCodeAllowTrustCantRevoke, nil
+	}
+
+	var asset xdr.Asset
+	switch op.Asset.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		code := op.Asset.MustAssetCode4()
+		asset.Type = op.Asset.Type
+		asset.AlphaNum4 = &xdr.AssetAlphaNum4{AssetCode: code, Issuer: source.AccountId}
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		code := op.Asset.MustAssetCode12()
+		asset.Type = op.Asset.Type
+		asset.AlphaNum12 = &xdr.AssetAlphaNum12{AssetCode: code, Issuer: source.AccountId}
+	}
+
+	line, err := ltx.loadTrustLine(op.Trustor, asset)
+	if err != nil {
+		return 0, err
+	}
+	if line == nil {
+		return xdr.AllowTrustResultCodeAllowTrustNoTrustLine, nil
+	}
+	line.Flags = op.Authorize
+	return xdr.AllowTrustResultCodeAllowTrustSuccess, nil
+}
+
+func (s *Simulator) accountMerge(ltx *ledgerTxn, source *xdr.AccountEntry, destination xdr.MuxedAccount) (*xdr.AccountMergeResult, error) {
+	destID := destination.ToAccountId()
+	if destID.Equals(source.AccountId) {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeMalformed}, nil
+	}
+	dest, err := ltx.loadAccount(destID)
+	if err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeNoAccount}, nil
+	}
+	if xdr.AccountFlags(source.Flags).IsAuthImmutable() {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeImmutableSet}, nil
+	}
+	if int(source.NumSubEntries) != len(source.Signers) {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeHasSubEntries}, nil
+	}
+	if int64(source.SeqNum) >= int64(s.LedgerSequence)<<32 {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeSeqnumTooFar}, nil
+	}
+	balance := source.Balance
+	if int64(dest.Balance) > math.MaxInt64-int64(balance)-buyingLiabilities(*dest) {
+		return &xdr.AccountMergeResult{Code: xdr.AccountMergeResultCodeAccountMergeDestFull}, nil
+	}
+
+	dest.Balance += balance
+	if err = ltx.remove(source.AccountId.LedgerKey()); err != nil {
+		return nil, err
+	}
+	return &xdr.AccountMergeResult{
+		Code:                 xdr.AccountMergeResultCodeAccountMergeSuccess,
+		SourceAccountBalance: &balance,
+	}, nil
+}
+
+func (s *Simulator) manageData(ltx *ledgerTxn, source *xdr.AccountEntry, op xdr.ManageDataOp) (xdr.ManageDataResultCode, error) {
+	name := string(op.DataName)
+	if len(name) == 0 {
+		return xdr.ManageDataResultCodeManageDataInvalidName, nil
+	}
+
+	data, err := ltx.loadData(source.AccountId, name)
+	if err != nil {
+		return 0, err
+	}
+
+	if op.DataValue == nil {
+		if data == nil {
+			return xdr.ManageDataResultCodeManageDataNameNotFound, nil
+		}
+		var key xdr.LedgerKey
+		if err = key.SetData(source.AccountId, name); err != nil {
+			return 0, err
+		}
+		source.NumSubEntries--
+		return xdr.ManageDataResultCodeManageDataSuccess, ltx.remove(key)
+	}
+
+	if data != nil {
+		data.DataValue = *op.DataValue
+		return xdr.ManageDataResultCodeManageDataSuccess, nil
+	}
+	if !s.canAddSubEntry(*source) {
+		return xdr.ManageDataResultCodeManageDataLowReserve, nil
+	}
+	source.NumSubEntries++
+	err = ltx.create(xdr.LedgerEntryTypeData, xdr.DataEntry{
+		AccountId: source.AccountId,
+		DataName:  op.DataName,
+		DataValue: *op.DataValue,
+	})
+	return xdr.ManageDataResultCodeManageDataSuccess, err
+}
+
+func (s *Simulator) bumpSequence(source *xdr.AccountEntry, op xdr.BumpSequenceOp) (xdr.BumpSequenceResultCode, error) {
+	if op.BumpTo < 0 {
+		return xdr.BumpSequenceResultCodeBumpSequenceBadSeq, nil
+	}
+	if op.BumpTo > source.SeqNum {
+		source.SeqNum = op.BumpTo
+	}
+	return xdr.BumpSequenceResultCodeBumpSequenceSuccess, nil
+}
+
+// canAddSubEntry returns true if the account can afford the reserve of one
+// additional subentry.
+func (s *Simulator) canAddSubEntry(account xdr.AccountEntry) bool {
+	return s.availableBalance(account) >= s.baseReserve()
+}
+
+func assetIssuer(asset xdr.Asset) xdr.AccountId {
+	switch asset.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		return asset.MustAlphaNum4().Issuer
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		return asset.MustAlphaNum12().Issuer
+	}
+	return xdr.AccountId{}
+}
+
+func buyingLiabilities(account xdr.AccountEntry) int64 {
+	if v1, ok := account.Ext.GetV1(); ok {
+		return int64(v1.Liabilities.Buying)
+	}
+	return 0
+}
+
+func trustLineBuyingLiabilities(line xdr.TrustLineEntry) int64 {
+	if v1, ok := line.Ext.GetV1(); ok {
+		return int64(v1.Liabilities.Buying)
+	}
+	return 0
+}
+
+func trustLineSellingLiabilities(line xdr.TrustLineEntry) int64 {
+	if v1, ok := line.Ext.GetV1(); ok {
+		return int64(v1.Liabilities.Selling)
+	}
+	return 0
+}
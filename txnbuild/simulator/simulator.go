@@ -0,0 +1,399 @@
+/*
+Package simulator predicts the outcome of txnbuild transactions without submitting them to the network.
+
+A Simulator applies a Transaction or FeeBumpTransaction to an in-memory LedgerState built from a snapshot of
+xdr.LedgerEntry values (accounts, trustlines, offers and data entries). It returns the xdr.TransactionResult that
+stellar-core would be expected to produce together with the ledger entry changes caused by the transaction, so that
+doomed transactions can be rejected before paying any fees.
+
+The simulator only models the subset of the protocol which can be decided from the snapshot alone. Signatures are
+not checked, the transaction is assumed to be included in a ledger without surge pricing, and operations which
+require order book matching (path payments and offers) or network wide state (inflation) are rejected with
+ErrUnsupportedOperation.
+*/
+package simulator
+
+import (
+	"time"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// DefaultBaseReserve is the base reserve, in stroops, used when
+// Simulator.BaseReserve is not set.
+const DefaultBaseReserve = 5000000
+
+// ErrUnsupportedOperation is returned when a transaction contains an
+// operation which the simulator cannot apply.
+var ErrUnsupportedOperation = errors.New("operation type is not supported by the simulator")
+
+// Simulator applies transactions to a LedgerState in memory.
+type Simulator struct {
+	// NetworkPassphrase is used to compute the inner transaction hash of fee
+	// bump transactions.
+	NetworkPassphrase string
+	// BaseFee is the network base fee in stroops. Defaults to
+	// txnbuild.MinBaseFee.
+	BaseFee int64
+	// BaseReserve is the network base reserve in stroops. Defaults to
+	// DefaultBaseReserve.
+	BaseReserve int64
+	// LedgerSequence is the sequence of the ledger in which the transaction is
+	// assumed to be applied.
+	LedgerSequence uint32
+	// CloseTime is the close time of the ledger in which the transaction is
+	// assumed to be applied. Defaults to the current time.
+	CloseTime time.Time
+}
+
+// Result is the predicted outcome of a transaction.
+type Result struct {
+	// TransactionResult is the result stellar-core is expected to return.
+	TransactionResult xdr.TransactionResult
+	// FeeChanges are the ledger entry changes caused by charging the fee and
+	// consuming the sequence number. They are empty if the transaction would
+	// be rejected before being included in a ledger.
+	FeeChanges xdr.LedgerEntryChanges
+	// OperationChanges are the ledger entry changes of each operation. They
+	// are empty if the transaction failed.
+	OperationChanges []xdr.LedgerEntryChanges
+}
+
+// Successful returns true if the transaction is predicted to succeed.
+func (r Result) Successful() bool {
+	return r.TransactionResult.Successful()
+}
+
+// ResultCode returns the predicted transaction result code. For fee bump
+// transactions which fail, the code of the inner transaction is returned.
+func (r Result) ResultCode() xdr.TransactionResultCode {
+	if pair, ok := r.TransactionResult.Result.GetInnerResultPair(); ok {
+		return pair.Result.Result.Code
+	}
+	return r.TransactionResult.Result.Code
+}
+
+// Simulate applies tx to state and returns the predicted result. state is
+// modified in place to reflect the applied transaction, use
+// LedgerState.Clone to keep the original snapshot intact.
+func (s *Simulator) Simulate(state *LedgerState, tx *txnbuild.Transaction) (Result, error) {
+	env, err := tx.TxEnvelope()
+	if err != nil {
+		return Result{}, errors.Wrap(err, "could not obtain transaction envelope")
+	}
+	if err = checkSupported(env.Operations()); err != nil {
+		return Result{}, err
+	}
+
+	code, feeCharged, err := s.checkValid(state, env, true)
+	if err != nil {
+		return Result{}, err
+	}
+	if code != xdr.TransactionResultCodeTxSuccess {
+		return newResult(code, 0, nil), nil
+	}
+
+	ltx := newLedgerTxn(state, s.LedgerSequence)
+	if err = s.processFeeAndSeqNum(ltx, env.SourceAccount().ToAccountId(), feeCharged, env.SeqNum()); err != nil {
+		return Result{}, err
+	}
+	result := Result{FeeChanges: ltx.commit()}
+
+	code, opResults, opChanges, err := s.applyOperations(state, env)
+	if err != nil {
+		return Result{}, err
+	}
+	result.TransactionResult = newResult(code, feeCharged, opResults).TransactionResult
+	result.OperationChanges = opChanges
+	return result, nil
+}
+
+// SimulateFeeBump applies the fee bump transaction tx to state and returns
+// the predicted result. state is modified in place to reflect the applied
+// transaction, use LedgerState.Clone to keep the original snapshot intact.
+func (s *Simulator) SimulateFeeBump(state *LedgerState, tx *txnbuild.FeeBumpTransaction) (Result, error) {
+	env, err := tx.TxEnvelope()
+	if err != nil {
+		return Result{}, errors.Wrap(err, "could not obtain transaction envelope")
+	}
+	innerEnv := xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1:   env.FeeBump.Tx.InnerTx.V1,
+	}
+	if err = checkSupported(innerEnv.Operations()); err != nil {
+		return Result{}, err
+	}
+	innerHash, err := network.HashTransactionInEnvelope(innerEnv, s.NetworkPassphrase)
+	if err != nil {
+		return Result{}, errors.Wrap(err, "could not hash inner transaction")
+	}
+
+	feeSource := env.FeeBumpAccount().ToAccountId()
+	feeSourceAccount, exists := state.Account(feeSource.Address())
+	if !exists {
+		return newResult(xdr.TransactionResultCodeTxNoAccount, 0, nil), nil
+	}
+	numOps := int64(len(innerEnv.Operations()))
+	feeCharged := s.baseFee() * (numOps + 1)
+	if env.FeeBumpFee() < feeCharged {
+		return newResult(xdr.TransactionResultCodeTxInsufficientFee, 0, nil), nil
+	}
+	if s.availableBalance(feeSourceAccount) < feeCharged {
+		return newResult(xdr.TransactionResultCodeTxInsufficientBalance, 0, nil), nil
+	}
+
+	innerCode, _, err := s.checkValid(state, innerEnv, false)
+	if err != nil {
+		return Result{}, err
+	}
+	if innerCode != xdr.TransactionResultCodeTxSuccess {
+		return newFeeBumpResult(xdr.TransactionResultCodeTxFeeBumpInnerFailed, 0, innerHash, innerCode, nil), nil
+	}
+
+	ltx := newLedgerTxn(state, s.LedgerSequence)
+	account, err := ltx.loadAccount(feeSource)
+	if err != nil {
+		return Result{}, err
+	}
+	account.Balance -= xdr.Int64(feeCharged)
+	if err = s.processFeeAndSeqNum(ltx, innerEnv.SourceAccount().ToAccountId(), 0, innerEnv.SeqNum()); err != nil {
+		return Result{}, err
+	}
+	result := Result{FeeChanges: ltx.commit()}
+
+	innerCode, opResults, opChanges, err := s.applyOperations(state, innerEnv)
+	if err != nil {
+		return Result{}, err
+	}
+	outerCode := xdr.TransactionResultCodeTxFeeBumpInnerSuccess
+	if innerCode != xdr.TransactionResultCodeTxSuccess {
+		outerCode = xdr.TransactionResultCodeTxFeeBumpInnerFailed
+	}
+	result.TransactionResult = newFeeBumpResult(outerCode, feeCharged, innerHash, innerCode, opResults).TransactionResult
+	result.OperationChanges = opChanges
+	return result, nil
+}
+
+// checkValid performs the validity checks stellar-core runs before a
+// transaction is included in a ledger. It returns the fee which would be
+// charged when chargeFee is true.
+func (s *Simulator) checkValid(state *LedgerState, env xdr.TransactionEnvelope, chargeFee bool) (xdr.TransactionResultCode, int64, error) {
+	if tb := env.TimeBounds(); tb != nil {
+		closeTime := s.closeTime().Unix()
+		if tb.MinTime != 0 && int64(tb.MinTime) > closeTime {
+			return xdr.TransactionResultCodeTxTooEarly, 0, nil
+		}
+		if tb.MaxTime != 0 && int64(tb.MaxTime) < closeTime {
+			return xdr.TransactionResultCodeTxTooLate, 0, nil
+		}
+	}
+
+	numOps := int64(len(env.Operations()))
+	if numOps == 0 {
+		return xdr.TransactionResultCodeTxMissingOperation, 0, nil
+	}
+
+	source := env.SourceAccount().ToAccountId()
+	account, exists := state.Account(source.Address())
+	if !exists {
+		return xdr.TransactionResultCodeTxNoAccount, 0, nil
+	}
+
+	var feeCharged int64
+	if chargeFee {
+		feeCharged = s.baseFee() * numOps
+		if int64(env.Fee()) < feeCharged {
+			return xdr.TransactionResultCodeTxInsufficientFee, 0, nil
+		}
+	}
+
+	if int64(account.SeqNum)+1 != env.SeqNum() {
+		return xdr.TransactionResultCodeTxBadSeq, 0, nil
+	}
+
+	if s.availableBalance(account) < feeCharged {
+		return xdr.TransactionResultCodeTxInsufficientBalance, 0, nil
+	}
+
+	return xdr.TransactionResultCodeTxSuccess, feeCharged, nil
+}
+
+func (s *Simulator) processFeeAndSeqNum(ltx *ledgerTxn, source xdr.AccountId, fee int64, seqNum int64) error {
+	account, err := ltx.loadAccount(source)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return errors.New("transaction source account not found")
+	}
+	account.Balance -= xdr.Int64(fee)
+	account.SeqNum = xdr.SequenceNumber(seqNum)
+	return nil
+}
+
+// applyOperations applies every operation of the transaction atomically. If
+// any operation fails none of the operation changes are written to state.
+func (s *Simulator) applyOperations(
+	state *LedgerState,
+	env xdr.TransactionEnvelope,
+) (xdr.TransactionResultCode, []xdr.OperationResult, []xdr.LedgerEntryChanges, error) {
+	scratch, err := state.Clone()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	txSource := env.SourceAccount().ToAccountId()
+	code := xdr.TransactionResultCodeTxSuccess
+	results := make([]xdr.OperationResult, 0, len(env.Operations()))
+	changes := make([]xdr.LedgerEntryChanges, 0, len(env.Operations()))
+	for _, op := range env.Operations() {
+		source := txSource
+		if op.SourceAccount != nil {
+			source = op.SourceAccount.ToAccountId()
+		}
+
+		ltx := newLedgerTxn(scratch, s.LedgerSequence)
+		result, err := s.applyOperation(ltx, source, op)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		results = append(results, result)
+		if !operationSuccessful(result) {
+			code = xdr.TransactionResultCodeTxFailed
+		}
+		// like Stellar Core, the remaining operations of a failed
+		// transaction are applied for their results only, they do not see
+		// the changes of one another
+		if code != xdr.TransactionResultCodeTxSuccess {
+			continue
+		}
+		changes = append(changes, ltx.commit())
+	}
+
+	if code != xdr.TransactionResultCodeTxSuccess {
+		return code, results, nil, nil
+	}
+	state.entries = scratch.entries
+	return code, results, changes, nil
+}
+
+func (s *Simulator) baseFee() int64 {
+	if s.BaseFee == 0 {
+		return txnbuild.MinBaseFee
+	}
+	return s.BaseFee
+}
+
+func (s *Simulator) baseReserve() int64 {
+	if s.BaseReserve == 0 {
+		return DefaultBaseReserve
+	}
+	return s.BaseReserve
+}
+
+func (s *Simulator) closeTime() time.Time {
+	if s.CloseTime.IsZero() {
+		return time.Now()
+	}
+	return s.CloseTime
+}
+
+// minimumBalance returns the balance an account must hold given its number of
+// subentries.
+func (s *Simulator) minimumBalance(account xdr.AccountEntry) int64 {
+	return (2 + int64(account.NumSubEntries)) * s.baseReserve()
+}
+
+// availableBalance returns the native balance an account can spend.
+func (s *Simulator) availableBalance(account xdr.AccountEntry) int64 {
+	available := int64(account.Balance) - s.minimumBalance(account)
+	if v1, ok := account.Ext.GetV1(); ok {
+		available -= int64(v1.Liabilities.Selling)
+	}
+	return available
+}
+
+func checkSupported(ops []xdr.Operation) error {
+	for _, op := range ops {
+		switch op.Body.Type {
+		case xdr.OperationTypeCreateAccount,
+			xdr.OperationTypePayment,
+			xdr.OperationTypeSetOptions,
+			xdr.OperationTypeChangeTrust,
+			xdr.OperationTypeAllowTrust,
+			xdr.OperationTypeAccountMerge,
+			xdr.OperationTypeManageData,
+			xdr.OperationTypeBumpSequence:
+		default:
+			return errors.Wrap(ErrUnsupportedOperation, op.Body.Type.String())
+		}
+	}
+	return nil
+}
+
+func operationSuccessful(result xdr.OperationResult) bool {
+	if result.Code != xdr.OperationResultCodeOpInner {
+		return false
+	}
+	tr := result.MustTr()
+	switch tr.Type {
+	case xdr.OperationTypeCreateAccount:
+		return tr.MustCreateAccountResult().Code == xdr.CreateAccountResultCodeCreateAccountSuccess
+	case xdr.OperationTypePayment:
+		return tr.MustPaymentResult().Code == xdr.PaymentResultCodePaymentSuccess
+	case xdr.OperationTypeSetOptions:
+		return tr.MustSetOptionsResult().Code == xdr.SetOptionsResultCodeSetOptionsSuccess
+	case xdr.OperationTypeChangeTrust:
+		return tr.MustChangeTrustResult().Code == xdr.ChangeTrustResultCodeChangeTrustSuccess
+	case xdr.OperationTypeAllowTrust:
+		return tr.MustAllowTrustResult().Code == xdr.AllowTrustResultCodeAllowTrustSuccess
+	case xdr.OperationTypeAccountMerge:
+		return tr.MustAccountMergeResult().Code == xdr.AccountMergeResultCodeAccountMergeSuccess
+	case xdr.OperationTypeManageData:
+		return tr.MustManageDataResult().Code == xdr.ManageDataResultCodeManageDataSuccess
+	case xdr.OperationTypeBumpSequence:
+		return tr.MustBumpSeqResult().Code == xdr.BumpSequenceResultCodeBumpSequenceSuccess
+	}
+	return false
+}
+
+func newResult(code xdr.TransactionResultCode, feeCharged int64, opResults []xdr.OperationResult) Result {
+	result := xdr.TransactionResultResult{Code: code}
+	if code == xdr.TransactionResultCodeTxSuccess || code == xdr.TransactionResultCodeTxFailed {
+		result.Results = &opResults
+	}
+	return Result{
+		TransactionResult: xdr.TransactionResult{
+			FeeCharged: xdr.Int64(feeCharged),
+			Result:     result,
+		},
+	}
+}
+
+func newFeeBumpResult(
+	code xdr.TransactionResultCode,
+	feeCharged int64,
+	innerHash [32]byte,
+	innerCode xdr.TransactionResultCode,
+	opResults []xdr.OperationResult,
+) Result {
+	inner := xdr.InnerTransactionResultResult{Code: innerCode}
+	if innerCode == xdr.TransactionResultCodeTxSuccess || innerCode == xdr.TransactionResultCodeTxFailed {
+		inner.Results = &opResults
+	}
+	return Result{
+		TransactionResult: xdr.TransactionResult{
+			FeeCharged: xdr.Int64(feeCharged),
+			Result: xdr.TransactionResultResult{
+				Code: code,
+				InnerResultPair: &xdr.InnerTransactionResultPair{
+					TransactionHash: xdr.Hash(innerHash),
+					Result:          xdr.InnerTransactionResult{Result: inner},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,370 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	kp0    = keypair.MustParseFull("SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R")
+	kp1    = keypair.MustParseFull("SBMSVD4KKELKGZXHBUQTIROWUAPQASDX7KEJITARP4VMZ6KLUHOGPTYW")
+	issuer = keypair.MustParseFull("SBZVMB74Z76QZ3ZOY7UTDFYKMEGKW5XFJEB6PFKBF4UYSSWHG4EDH7PY")
+	usd    = txnbuild.CreditAsset{Code: "USD", Issuer: issuer.Address()}
+)
+
+func accountEntry(address string, balance int64, seqNum int64) xdr.LedgerEntry {
+	return xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeAccount,
+			Account: &xdr.AccountEntry{
+				AccountId:  xdr.MustAddress(address),
+				Balance:    xdr.Int64(balance),
+				SeqNum:     xdr.SequenceNumber(seqNum),
+				Thresholds: xdr.Thresholds{1, 0, 0, 0},
+			},
+		},
+	}
+}
+
+func trustLineEntry(address string, asset txnbuild.Asset, balance, limit int64) xdr.LedgerEntry {
+	xdrAsset, err := asset.ToXDR()
+	if err != nil {
+		panic(err)
+	}
+	return xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeTrustline,
+			TrustLine: &xdr.TrustLineEntry{
+				AccountId: xdr.MustAddress(address),
+				Asset:     xdrAsset,
+				Balance:   xdr.Int64(balance),
+				Limit:     xdr.Int64(limit),
+				Flags:     xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag),
+			},
+		},
+	}
+}
+
+func newState(t *testing.T, entries ...xdr.LedgerEntry) *LedgerState {
+	state, err := NewLedgerState(entries...)
+	require.NoError(t, err)
+	return state
+}
+
+func newTx(t *testing.T, source string, seqNum int64, ops ...txnbuild.Operation) *txnbuild.Transaction {
+	tx, err := txnbuild.NewTransaction(txnbuild.TransactionParams{
+		SourceAccount:        &txnbuild.SimpleAccount{AccountID: source, Sequence: seqNum},
+		IncrementSequenceNum: true,
+		Operations:           ops,
+		BaseFee:              txnbuild.MinBaseFee,
+		Timebounds:           txnbuild.NewInfiniteTimeout(),
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+// newV1Tx converts tx into a V1 transaction so that it can be wrapped in a
+// fee bump transaction.
+func newV1Tx(t *testing.T, tx *txnbuild.Transaction) *txnbuild.Transaction {
+	env, err := tx.TxEnvelope()
+	require.NoError(t, err)
+	v0 := env.V0.Tx
+	var sourceAccount xdr.MuxedAccount
+	require.NoError(t, sourceAccount.SetAddress(tx.SourceAccount().AccountID))
+	v1 := xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{
+			Tx: xdr.Transaction{
+				SourceAccount: sourceAccount,
+				Fee:           v0.Fee,
+				SeqNum:        v0.SeqNum,
+				TimeBounds:    v0.TimeBounds,
+				Memo:          v0.Memo,
+				Operations:    v0.Operations,
+			},
+		},
+	}
+	b64, err := xdr.MarshalBase64(v1)
+	require.NoError(t, err)
+	parsed, err := txnbuild.TransactionFromXDR(b64)
+	require.NoError(t, err)
+	converted, ok := parsed.Transaction()
+	require.True(t, ok)
+	return converted
+}
+
+func newSimulator() *Simulator {
+	return &Simulator{
+		NetworkPassphrase: network.TestNetworkPassphrase,
+		LedgerSequence:    100,
+		CloseTime:         time.Unix(1600000000, 0),
+	}
+}
+
+func opCode(t *testing.T, result Result, i int) xdr.OperationResult {
+	results, ok := result.TransactionResult.OperationResults()
+	require.True(t, ok)
+	return results[i]
+}
+
+func TestSimulateNativePayment(t *testing.T) {
+	state := newState(t,
+		accountEntry(kp0.Address(), 1000000000, 10),
+		accountEntry(kp1.Address(), 100000000, 20),
+	)
+	tx := newTx(t, kp0.Address(), 10, &txnbuild.Payment{
+		Destination: kp1.Address(),
+		Amount:      "10",
+		Asset:       txnbuild.NativeAsset{},
+	})
+
+	result, err := newSimulator().Simulate(state, tx)
+	require.NoError(t, err)
+	assert.True(t, result.Successful())
+	assert.Equal(t, xdr.Int64(100), result.TransactionResult.FeeCharged)
+	assert.Equal(t, xdr.PaymentResultCodePaymentSuccess, opCode(t, result, 0).MustTr().MustPaymentResult().Code)
+
+	require.Len(t, result.FeeChanges, 2)
+	require.Len(t, result.OperationChanges, 1)
+	assert.Len(t, result.OperationChanges[0], 4)
+
+	source, ok := state.Account(kp0.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000-100-100000000), source.Balance)
+	assert.Equal(t, xdr.SequenceNumber(11), source.SeqNum)
+	dest, ok := state.Account(kp1.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(200000000), dest.Balance)
+}
+
+func TestSimulateTransactionErrors(t *testing.T) {
+	state := newState(t, accountEntry(kp0.Address(), 1000000000, 10))
+	payment := &txnbuild.Payment{Destination: kp1.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}}
+
+	result, err := newSimulator().Simulate(state, newTx(t, kp0.Address(), 11, payment))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxBadSeq, result.ResultCode())
+	assert.Empty(t, result.FeeChanges)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp1.Address(), 10, payment))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxNoAccount, result.ResultCode())
+
+	tx, err := txnbuild.NewTransaction(txnbuild.TransactionParams{
+		SourceAccount:        &txnbuild.SimpleAccount{AccountID: kp0.Address(), Sequence: 10},
+		IncrementSequenceNum: true,
+		Operations:           []txnbuild.Operation{payment},
+		BaseFee:              txnbuild.MinBaseFee,
+		Timebounds:           txnbuild.NewTimebounds(0, 1500000000),
+	})
+	require.NoError(t, err)
+	result, err = newSimulator().Simulate(state, tx)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxTooLate, result.ResultCode())
+
+	sim := newSimulator()
+	sim.BaseFee = 200
+	result, err = sim.Simulate(state, newTx(t, kp0.Address(), 10, payment))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxInsufficientFee, result.ResultCode())
+
+	account, ok := state.Account(kp0.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000), account.Balance)
+}
+
+func TestSimulateFailedOperationRollsBack(t *testing.T) {
+	state := newState(t,
+		accountEntry(kp0.Address(), 1000000000, 10),
+		accountEntry(issuer.Address(), 1000000000, 30),
+	)
+	tx := newTx(t, kp0.Address(), 10,
+		&txnbuild.ChangeTrust{Line: usd, Limit: "1000"},
+		&txnbuild.Payment{Destination: issuer.Address(), Amount: "10", Asset: usd},
+		&txnbuild.Payment{Destination: kp1.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+	)
+
+	result, err := newSimulator().Simulate(state, tx)
+	require.NoError(t, err)
+	assert.False(t, result.Successful())
+	assert.Equal(t, xdr.TransactionResultCodeTxFailed, result.ResultCode())
+	assert.Equal(t, xdr.ChangeTrustResultCodeChangeTrustSuccess, opCode(t, result, 0).MustTr().MustChangeTrustResult().Code)
+	assert.Equal(t, xdr.PaymentResultCodePaymentUnderfunded, opCode(t, result, 1).MustTr().MustPaymentResult().Code)
+	assert.Equal(t, xdr.PaymentResultCodePaymentNoDestination, opCode(t, result, 2).MustTr().MustPaymentResult().Code)
+	assert.Empty(t, result.OperationChanges)
+
+	account, ok := state.Account(kp0.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000-300), account.Balance)
+	assert.Equal(t, xdr.SequenceNumber(11), account.SeqNum)
+	assert.Equal(t, xdr.Uint32(0), account.NumSubEntries)
+	assert.Len(t, state.Entries(), 2)
+}
+
+func TestSimulateOperationsAfterFailureDoNotSeeChanges(t *testing.T) {
+	state := newState(t, accountEntry(kp0.Address(), 1000000000, 10))
+	tx := newTx(t, kp0.Address(), 10,
+		&txnbuild.Payment{Destination: issuer.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+		&txnbuild.CreateAccount{Destination: kp1.Address(), Amount: "10"},
+		&txnbuild.Payment{Destination: kp1.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+	)
+
+	result, err := newSimulator().Simulate(state, tx)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxFailed, result.ResultCode())
+	assert.Equal(t, xdr.PaymentResultCodePaymentNoDestination, opCode(t, result, 0).MustTr().MustPaymentResult().Code)
+	assert.Equal(t, xdr.CreateAccountResultCodeCreateAccountSuccess,
+		opCode(t, result, 1).MustTr().MustCreateAccountResult().Code)
+	assert.Equal(t, xdr.PaymentResultCodePaymentNoDestination, opCode(t, result, 2).MustTr().MustPaymentResult().Code)
+	assert.Len(t, state.Entries(), 1)
+}
+
+func TestSimulateCreditPayment(t *testing.T) {
+	state := newState(t,
+		accountEntry(kp0.Address(), 1000000000, 10),
+		accountEntry(kp1.Address(), 1000000000, 20),
+		accountEntry(issuer.Address(), 1000000000, 30),
+		trustLineEntry(kp0.Address(), usd, 1000000000, 10000000000),
+	)
+	payment := &txnbuild.Payment{Destination: kp1.Address(), Amount: "10", Asset: usd}
+
+	result, err := newSimulator().Simulate(state, newTx(t, kp0.Address(), 10, payment))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.PaymentResultCodePaymentNoTrust, opCode(t, result, 0).MustTr().MustPaymentResult().Code)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp1.Address(), 20,
+		&txnbuild.ChangeTrust{Line: usd, Limit: "50", SourceAccount: &txnbuild.SimpleAccount{AccountID: kp1.Address()}},
+	))
+	require.NoError(t, err)
+	require.True(t, result.Successful())
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp0.Address(), 11, payment))
+	require.NoError(t, err)
+	require.True(t, result.Successful())
+
+	var key xdr.LedgerKey
+	require.NoError(t, key.SetTrustline(xdr.MustAddress(kp1.Address()), xdr.MustNewCreditAsset("USD", issuer.Address())))
+	entry, ok := state.Get(key)
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(100000000), entry.Data.MustTrustLine().Balance)
+
+	account, ok := state.Account(kp1.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Uint32(1), account.NumSubEntries)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp0.Address(), 12,
+		&txnbuild.Payment{Destination: kp1.Address(), Amount: "41", Asset: usd},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.PaymentResultCodePaymentLineFull, opCode(t, result, 0).MustTr().MustPaymentResult().Code)
+}
+
+func TestSimulateCreateAccountAndMerge(t *testing.T) {
+	state := newState(t, accountEntry(kp0.Address(), 1000000000, 10))
+
+	result, err := newSimulator().Simulate(state, newTx(t, kp0.Address(), 10,
+		&txnbuild.CreateAccount{Destination: kp1.Address(), Amount: "0.5"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.CreateAccountResultCodeCreateAccountLowReserve,
+		opCode(t, result, 0).MustTr().MustCreateAccountResult().Code)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp0.Address(), 11,
+		&txnbuild.CreateAccount{Destination: kp1.Address(), Amount: "5"},
+		&txnbuild.ManageData{Name: "name", Value: []byte("value"), SourceAccount: &txnbuild.SimpleAccount{AccountID: kp1.Address()}},
+	))
+	require.NoError(t, err)
+	require.True(t, result.Successful())
+	created, ok := state.Account(kp1.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.SequenceNumber(100<<32), created.SeqNum)
+	assert.Equal(t, xdr.Uint32(1), created.NumSubEntries)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp1.Address(), 100<<32,
+		&txnbuild.AccountMerge{Destination: kp0.Address()},
+	))
+	require.NoError(t, err)
+	merge := opCode(t, result, 0).MustTr().MustAccountMergeResult()
+	assert.Equal(t, xdr.AccountMergeResultCodeAccountMergeHasSubEntries, merge.Code)
+
+	result, err = newSimulator().Simulate(state, newTx(t, kp1.Address(), (100<<32)+1,
+		&txnbuild.ManageData{Name: "name"},
+		&txnbuild.AccountMerge{Destination: kp0.Address()},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, xdr.AccountMergeResultCodeAccountMergeSeqnumTooFar,
+		opCode(t, result, 1).MustTr().MustAccountMergeResult().Code)
+
+	sim := newSimulator()
+	sim.LedgerSequence = 101
+	result, err = sim.Simulate(state, newTx(t, kp1.Address(), (100<<32)+2,
+		&txnbuild.ManageData{Name: "name"},
+		&txnbuild.AccountMerge{Destination: kp0.Address()},
+	))
+	require.NoError(t, err)
+	require.True(t, result.Successful())
+	merge = opCode(t, result, 1).MustTr().MustAccountMergeResult()
+	assert.Equal(t, xdr.Int64(50000000-100-200-200), *merge.SourceAccountBalance)
+	_, ok = state.Account(kp1.Address())
+	assert.False(t, ok)
+	assert.Len(t, state.Entries(), 1)
+}
+
+func TestSimulateFeeBump(t *testing.T) {
+	state := newState(t,
+		accountEntry(kp0.Address(), 1000000000, 10),
+		accountEntry(kp1.Address(), 1000000000, 20),
+	)
+	inner := newV1Tx(t, newTx(t, kp0.Address(), 10, &txnbuild.BumpSequence{BumpTo: 50}))
+	inner, err := inner.Sign(network.TestNetworkPassphrase, kp0)
+	require.NoError(t, err)
+	feeBump, err := txnbuild.NewFeeBumpTransaction(txnbuild.FeeBumpTransactionParams{
+		Inner:      inner,
+		FeeAccount: kp1.Address(),
+		BaseFee:    500,
+	})
+	require.NoError(t, err)
+
+	result, err := newSimulator().SimulateFeeBump(state, feeBump)
+	require.NoError(t, err)
+	assert.True(t, result.Successful())
+	assert.Equal(t, xdr.TransactionResultCodeTxFeeBumpInnerSuccess, result.TransactionResult.Result.Code)
+	assert.Equal(t, xdr.Int64(200), result.TransactionResult.FeeCharged)
+	innerHash, err := inner.Hash(network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.Hash(innerHash), result.TransactionResult.Result.MustInnerResultPair().TransactionHash)
+
+	source, ok := state.Account(kp0.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000), source.Balance)
+	assert.Equal(t, xdr.SequenceNumber(50), source.SeqNum)
+	feeAccount, ok := state.Account(kp1.Address())
+	require.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000-200), feeAccount.Balance)
+
+	result, err = newSimulator().SimulateFeeBump(state, feeBump)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.TransactionResultCodeTxFeeBumpInnerFailed, result.TransactionResult.Result.Code)
+	assert.Equal(t, xdr.TransactionResultCodeTxBadSeq, result.ResultCode())
+}
+
+func TestSimulateUnsupportedOperation(t *testing.T) {
+	state := newState(t, accountEntry(kp0.Address(), 1000000000, 10))
+	tx := newTx(t, kp0.Address(), 10, &txnbuild.ManageSellOffer{
+		Selling: txnbuild.NativeAsset{},
+		Buying:  usd,
+		Amount:  "10",
+		Price:   "1",
+	})
+
+	_, err := newSimulator().Simulate(state, tx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), ErrUnsupportedOperation.Error())
+}
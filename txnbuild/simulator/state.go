@@ -0,0 +1,256 @@
+package simulator
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// LedgerState is an in-memory snapshot of ledger entries which a Simulator
+// reads from and applies transactions to.
+type LedgerState struct {
+	entries map[string]xdr.LedgerEntry
+}
+
+// NewLedgerState returns a LedgerState populated with the given entries.
+// An error is returned if two entries share the same ledger key.
+func NewLedgerState(entries ...xdr.LedgerEntry) (*LedgerState, error) {
+	state := &LedgerState{entries: map[string]xdr.LedgerEntry{}}
+	for _, entry := range entries {
+		key, err := ledgerKeyString(entry.LedgerKey())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := state.entries[key]; ok {
+			return nil, errors.New("duplicate ledger entry in snapshot")
+		}
+		state.entries[key] = entry
+	}
+	return state, nil
+}
+
+// Get returns the ledger entry identified by key and a boolean which is true
+// if the entry exists in the snapshot.
+func (s *LedgerState) Get(key xdr.LedgerKey) (xdr.LedgerEntry, bool) {
+	k, err := ledgerKeyString(key)
+	if err != nil {
+		return xdr.LedgerEntry{}, false
+	}
+	entry, ok := s.entries[k]
+	return entry, ok
+}
+
+// Account returns the account entry for the given address and a boolean
+// which is true if the account exists in the snapshot.
+func (s *LedgerState) Account(address string) (xdr.AccountEntry, bool) {
+	aid, err := xdr.AddressToAccountId(address)
+	if err != nil {
+		return xdr.AccountEntry{}, false
+	}
+	entry, ok := s.Get(aid.LedgerKey())
+	if !ok {
+		return xdr.AccountEntry{}, false
+	}
+	return entry.Data.MustAccount(), true
+}
+
+// Entries returns all the ledger entries in the snapshot in no particular
+// order.
+func (s *LedgerState) Entries() []xdr.LedgerEntry {
+	entries := make([]xdr.LedgerEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Clone returns a deep copy of the snapshot which can be modified without
+// affecting the original.
+func (s *LedgerState) Clone() (*LedgerState, error) {
+	clone := &LedgerState{entries: make(map[string]xdr.LedgerEntry, len(s.entries))}
+	for key, entry := range s.entries {
+		copied, err := copyEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		clone.entries[key] = copied
+	}
+	return clone, nil
+}
+
+func ledgerKeyString(key xdr.LedgerKey) (string, error) {
+	b, err := key.MarshalBinaryCompress()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal ledger key")
+	}
+	return string(b), nil
+}
+
+func copyEntry(entry xdr.LedgerEntry) (xdr.LedgerEntry, error) {
+	var clone xdr.LedgerEntry
+	b, err := entry.MarshalBinary()
+	if err != nil {
+		return clone, errors.Wrap(err, "could not marshal ledger entry")
+	}
+	if err = xdr.SafeUnmarshal(b, &clone); err != nil {
+		return clone, errors.Wrap(err, "could not unmarshal ledger entry")
+	}
+	return clone, nil
+}
+
+// ledgerTxn is a nested view on top of a LedgerState which records every
+// entry it touches so that changes can be committed or discarded as a unit
+// and reported as xdr.LedgerEntryChanges.
+type ledgerTxn struct {
+	state    *LedgerState
+	ledger   uint32
+	original map[string]*xdr.LedgerEntry
+	current  map[string]*xdr.LedgerEntry
+	order    []string
+}
+
+func newLedgerTxn(state *LedgerState, ledger uint32) *ledgerTxn {
+	return &ledgerTxn{
+		state:    state,
+		ledger:   ledger,
+		original: map[string]*xdr.LedgerEntry{},
+		current:  map[string]*xdr.LedgerEntry{},
+	}
+}
+
+// load returns a mutable copy of the entry identified by key, or nil if the
+// entry does not exist.
+func (t *ledgerTxn) load(key xdr.LedgerKey) (*xdr.LedgerEntry, error) {
+	k, err := ledgerKeyString(key)
+	if err != nil {
+		return nil, err
+	}
+	if entry, ok := t.current[k]; ok {
+		return entry, nil
+	}
+
+	entry, ok := t.state.entries[k]
+	if !ok {
+		return nil, nil
+	}
+	original, err := copyEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+	current, err := copyEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+	t.original[k] = &original
+	t.current[k] = &current
+	t.order = append(t.order, k)
+	return &current, nil
+}
+
+func (t *ledgerTxn) loadAccount(aid xdr.AccountId) (*xdr.AccountEntry, error) {
+	entry, err := t.load(aid.LedgerKey())
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return entry.Data.Account, nil
+}
+
+func (t *ledgerTxn) loadTrustLine(aid xdr.AccountId, asset xdr.Asset) (*xdr.TrustLineEntry, error) {
+	var key xdr.LedgerKey
+	if err := key.SetTrustline(aid, asset); err != nil {
+		return nil, err
+	}
+	entry, err := t.load(key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return entry.Data.TrustLine, nil
+}
+
+func (t *ledgerTxn) loadData(aid xdr.AccountId, name string) (*xdr.DataEntry, error) {
+	var key xdr.LedgerKey
+	if err := key.SetData(aid, name); err != nil {
+		return nil, err
+	}
+	entry, err := t.load(key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return entry.Data.Data, nil
+}
+
+// create adds a new entry. The caller must have checked that no entry with
+// the same key exists.
+func (t *ledgerTxn) create(entryType xdr.LedgerEntryType, body interface{}) error {
+	data, err := xdr.NewLedgerEntryData(entryType, body)
+	if err != nil {
+		return errors.Wrap(err, "could not create ledger entry")
+	}
+	entry := &xdr.LedgerEntry{Data: data}
+	k, err := ledgerKeyString(entry.LedgerKey())
+	if err != nil {
+		return err
+	}
+	if _, ok := t.original[k]; !ok {
+		if _, seen := t.current[k]; !seen {
+			t.order = append(t.order, k)
+		}
+	}
+	t.current[k] = entry
+	return nil
+}
+
+func (t *ledgerTxn) remove(key xdr.LedgerKey) error {
+	k, err := ledgerKeyString(key)
+	if err != nil {
+		return err
+	}
+	t.current[k] = nil
+	return nil
+}
+
+// commit writes the changes to the underlying state and returns them in the
+// order the entries were first touched.
+func (t *ledgerTxn) commit() xdr.LedgerEntryChanges {
+	changes := xdr.LedgerEntryChanges{}
+	for _, k := range t.order {
+		original, current := t.original[k], t.current[k]
+		switch {
+		case original == nil && current == nil:
+			continue
+		case original == nil:
+			current.LastModifiedLedgerSeq = xdr.Uint32(t.ledger)
+			changes = append(changes, xdr.LedgerEntryChange{
+				Type:    xdr.LedgerEntryChangeTypeLedgerEntryCreated,
+				Created: current,
+			})
+			t.state.entries[k] = *current
+		case current == nil:
+			key := original.LedgerKey()
+			changes = append(changes,
+				xdr.LedgerEntryChange{
+					Type:  xdr.LedgerEntryChangeTypeLedgerEntryState,
+					State: original,
+				},
+				xdr.LedgerEntryChange{
+					Type:    xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+					Removed: &key,
+				},
+			)
+			delete(t.state.entries, k)
+		default:
+			current.LastModifiedLedgerSeq = xdr.Uint32(t.ledger)
+			changes = append(changes,
+				xdr.LedgerEntryChange{
+					Type:  xdr.LedgerEntryChangeTypeLedgerEntryState,
+					State: original,
+				},
+				xdr.LedgerEntryChange{
+					Type:    xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+					Updated: current,
+				},
+			)
+			t.state.entries[k] = *current
+		}
+	}
+	return changes
+}
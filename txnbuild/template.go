@@ -0,0 +1,219 @@
+package txnbuild
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"gopkg.in/yaml.v2"
+)
+
+// TransactionTemplate is a human readable, declarative description of a transaction
+// which can be encoded as JSON or YAML. A TransactionTemplate can be built into a
+// transaction with Build, and any GenericTransaction can be rendered back into a
+// TransactionTemplate with NewTransactionTemplate.
+//
+// Templates do not carry signatures. Transactions built from a template must be
+// signed before they are submitted.
+type TransactionTemplate struct {
+	SourceAccount string `json:"source_account" yaml:"source_account"`
+	// Sequence is the sequence number of the transaction, which is one more than
+	// the current sequence number of the source account.
+	Sequence   int64               `json:"sequence,string" yaml:"sequence"`
+	BaseFee    int64               `json:"base_fee" yaml:"base_fee"`
+	Memo       *MemoTemplate       `json:"memo,omitempty" yaml:"memo,omitempty"`
+	Timebounds TimeboundsTemplate  `json:"timebounds" yaml:"timebounds"`
+	Operations []OperationTemplate `json:"operations" yaml:"operations"`
+	// V1 is set if the transaction has a V1 envelope rather than a V0 one. The
+	// transactions wrapped in fee bump transactions always have V1 envelopes.
+	V1 bool `json:"v1,omitempty" yaml:"v1,omitempty"`
+	// FeeBump is set if the transaction is wrapped in a fee bump transaction.
+	FeeBump *FeeBumpTemplate `json:"fee_bump,omitempty" yaml:"fee_bump,omitempty"`
+}
+
+// MemoTemplate describes a transaction memo. Type is one of "text", "id", "hash" or
+// "return". Hash and return memos are hex encoded.
+type MemoTemplate struct {
+	Type  string `json:"type" yaml:"type"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// TimeboundsTemplate describes the time bounds of a transaction as unix timestamps.
+// A MaxTime of 0 means the transaction never expires.
+type TimeboundsTemplate struct {
+	MinTime int64 `json:"min_time" yaml:"min_time"`
+	MaxTime int64 `json:"max_time" yaml:"max_time"`
+}
+
+// FeeBumpTemplate describes the fee bump transaction wrapping a transaction.
+type FeeBumpTemplate struct {
+	FeeAccount string `json:"fee_account" yaml:"fee_account"`
+	BaseFee    int64  `json:"base_fee" yaml:"base_fee"`
+}
+
+// ParseTransactionTemplateJSON decodes a TransactionTemplate from JSON. Like
+// ParseTransactionTemplateYAML, it rejects unknown fields.
+func ParseTransactionTemplateJSON(data []byte) (TransactionTemplate, error) {
+	var t TransactionTemplate
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&t)
+	if err != nil {
+		return t, errors.Wrap(err, "could not decode transaction template")
+	}
+	return t, nil
+}
+
+// ParseTransactionTemplateYAML decodes a TransactionTemplate from YAML.
+func ParseTransactionTemplateYAML(data []byte) (TransactionTemplate, error) {
+	var t TransactionTemplate
+	err := yaml.UnmarshalStrict(data, &t)
+	if err != nil {
+		return t, errors.Wrap(err, "could not decode transaction template")
+	}
+	return t, nil
+}
+
+// JSON returns the indented JSON encoding of the template.
+func (t TransactionTemplate) JSON() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// YAML returns the YAML encoding of the template.
+func (t TransactionTemplate) YAML() ([]byte, error) {
+	return yaml.Marshal(t)
+}
+
+// NewTransactionTemplate renders a GenericTransaction, usually obtained from
+// TransactionFromXDR, into a TransactionTemplate.
+func NewTransactionTemplate(tx *GenericTransaction) (TransactionTemplate, error) {
+	var t TransactionTemplate
+	inner, ok := tx.Transaction()
+	if feeBump, isFeeBump := tx.FeeBump(); isFeeBump {
+		inner, ok = feeBump.InnerTransaction(), true
+		t.FeeBump = &FeeBumpTemplate{
+			FeeAccount: feeBump.FeeAccount(),
+			BaseFee:    feeBump.BaseFee(),
+		}
+	}
+	if !ok {
+		return t, errors.New("transaction is empty")
+	}
+
+	t.SourceAccount = inner.SourceAccount().AccountID
+	t.V1 = inner.envelope.Type == xdr.EnvelopeTypeEnvelopeTypeTx
+	t.Sequence = inner.SourceAccount().Sequence
+	t.BaseFee = inner.BaseFee()
+	t.Timebounds = TimeboundsTemplate{
+		MinTime: inner.Timebounds().MinTime,
+		MaxTime: inner.Timebounds().MaxTime,
+	}
+
+	if inner.Memo() != nil {
+		memo, err := newMemoTemplate(inner.Memo())
+		if err != nil {
+			return t, err
+		}
+		t.Memo = &memo
+	}
+
+	for i, op := range inner.Operations() {
+		opTemplate, err := newOperationTemplate(op)
+		if err != nil {
+			return t, errors.Wrapf(err, "could not render operation %d", i)
+		}
+		t.Operations = append(t.Operations, opTemplate)
+	}
+	return t, nil
+}
+
+// Build returns the transaction described by the template. The transaction is built
+// with a V1 envelope if the template is V1 or describes a fee bump transaction, as
+// V1 is the only envelope type which can be fee bumped.
+func (t TransactionTemplate) Build() (*GenericTransaction, error) {
+	params := TransactionParams{
+		SourceAccount:        &SimpleAccount{AccountID: t.SourceAccount, Sequence: t.Sequence},
+		IncrementSequenceNum: false,
+		BaseFee:              t.BaseFee,
+		Timebounds:           NewTimebounds(t.Timebounds.MinTime, t.Timebounds.MaxTime),
+	}
+
+	if t.Memo != nil {
+		memo, err := t.Memo.toMemo()
+		if err != nil {
+			return nil, err
+		}
+		params.Memo = memo
+	}
+
+	for i, opTemplate := range t.Operations {
+		op, err := opTemplate.toOperation()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid operation %d", i)
+		}
+		params.Operations = append(params.Operations, op)
+	}
+
+	tx, err := NewTransaction(params)
+	if err != nil {
+		return nil, err
+	}
+	if t.V1 || t.FeeBump != nil {
+		tx = v1Transaction(tx)
+	}
+	if t.FeeBump == nil {
+		return &GenericTransaction{simple: tx}, nil
+	}
+
+	feeBump, err := NewFeeBumpTransaction(FeeBumpTransactionParams{
+		Inner:      tx,
+		FeeAccount: t.FeeBump.FeeAccount,
+		BaseFee:    t.FeeBump.BaseFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &GenericTransaction{feeBump: feeBump}, nil
+}
+
+func newMemoTemplate(memo Memo) (MemoTemplate, error) {
+	switch m := memo.(type) {
+	case MemoText:
+		return MemoTemplate{Type: "text", Value: string(m)}, nil
+	case MemoID:
+		return MemoTemplate{Type: "id", Value: strconv.FormatUint(uint64(m), 10)}, nil
+	case MemoHash:
+		return MemoTemplate{Type: "hash", Value: hex.EncodeToString(m[:])}, nil
+	case MemoReturn:
+		return MemoTemplate{Type: "return", Value: hex.EncodeToString(m[:])}, nil
+	}
+	return MemoTemplate{}, errors.Errorf("unknown memo type %T", memo)
+}
+
+func (m MemoTemplate) toMemo() (Memo, error) {
+	switch m.Type {
+	case "text":
+		return MemoText(m.Value), nil
+	case "id":
+		id, err := strconv.ParseUint(m.Value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid id memo %s", m.Value)
+		}
+		return MemoID(id), nil
+	case "hash", "return":
+		var hash [32]byte
+		b, err := hex.DecodeString(m.Value)
+		if err != nil || len(b) != len(hash) {
+			return nil, errors.Errorf("%s memo must be a hex encoded 32 byte value", m.Type)
+		}
+		copy(hash[:], b)
+		if m.Type == "hash" {
+			return MemoHash(hash), nil
+		}
+		return MemoReturn(hash), nil
+	}
+	return nil, errors.Errorf("unknown memo type %s", m.Type)
+}
@@ -0,0 +1,628 @@
+package txnbuild
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// OperationTemplate describes a single operation of a TransactionTemplate. Type is the
+// snake case name of the operation used by Horizon, e.g. "create_account" or
+// "path_payment_strict_send", and determines which of the other fields are used.
+//
+// Assets are written as "native" or "CODE:ISSUER". Amounts and prices are decimal
+// strings, as in the operation structs.
+type OperationTemplate struct {
+	Type          string `json:"type" yaml:"type"`
+	SourceAccount string `json:"source_account,omitempty" yaml:"source_account,omitempty"`
+
+	// create_account, payment, path payments and account_merge
+	Destination string `json:"destination,omitempty" yaml:"destination,omitempty"`
+	Amount      string `json:"amount,omitempty" yaml:"amount,omitempty"`
+	Asset       string `json:"asset,omitempty" yaml:"asset,omitempty"`
+
+	// path_payment_strict_receive and path_payment_strict_send
+	SendAsset  string   `json:"send_asset,omitempty" yaml:"send_asset,omitempty"`
+	SendMax    string   `json:"send_max,omitempty" yaml:"send_max,omitempty"`
+	SendAmount string   `json:"send_amount,omitempty" yaml:"send_amount,omitempty"`
+	DestAsset  string   `json:"dest_asset,omitempty" yaml:"dest_asset,omitempty"`
+	DestAmount string   `json:"dest_amount,omitempty" yaml:"dest_amount,omitempty"`
+	DestMin    string   `json:"dest_min,omitempty" yaml:"dest_min,omitempty"`
+	Path       []string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// manage_sell_offer, manage_buy_offer and create_passive_sell_offer
+	Selling string `json:"selling,omitempty" yaml:"selling,omitempty"`
+	Buying  string `json:"buying,omitempty" yaml:"buying,omitempty"`
+	Price   string `json:"price,omitempty" yaml:"price,omitempty"`
+	OfferID int64  `json:"offer_id,omitempty" yaml:"offer_id,omitempty"`
+
+	// change_trust
+	Line  string `json:"line,omitempty" yaml:"line,omitempty"`
+	Limit string `json:"limit,omitempty" yaml:"limit,omitempty"`
+
+	// allow_trust
+	Trustor                        string `json:"trustor,omitempty" yaml:"trustor,omitempty"`
+	AssetCode                      string `json:"asset_code,omitempty" yaml:"asset_code,omitempty"`
+	Authorize                      bool   `json:"authorize,omitempty" yaml:"authorize,omitempty"`
+	AuthorizeToMaintainLiabilities bool   `json:"authorize_to_maintain_liabilities,omitempty" yaml:"authorize_to_maintain_liabilities,omitempty"`
+
+	// set_options
+	InflationDestination *string         `json:"inflation_destination,omitempty" yaml:"inflation_destination,omitempty"`
+	SetFlags             []string        `json:"set_flags,omitempty" yaml:"set_flags,omitempty"`
+	ClearFlags           []string        `json:"clear_flags,omitempty" yaml:"clear_flags,omitempty"`
+	MasterWeight         *Threshold      `json:"master_weight,omitempty" yaml:"master_weight,omitempty"`
+	LowThreshold         *Threshold      `json:"low_threshold,omitempty" yaml:"low_threshold,omitempty"`
+	MediumThreshold      *Threshold      `json:"medium_threshold,omitempty" yaml:"medium_threshold,omitempty"`
+	HighThreshold        *Threshold      `json:"high_threshold,omitempty" yaml:"high_threshold,omitempty"`
+	HomeDomain           *string         `json:"home_domain,omitempty" yaml:"home_domain,omitempty"`
+	Signer               *SignerTemplate `json:"signer,omitempty" yaml:"signer,omitempty"`
+
+	// manage_data. Value is base64 encoded, a missing value deletes the entry.
+	Name  string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Value *string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// bump_sequence
+	BumpTo int64 `json:"bump_to,omitempty" yaml:"bump_to,omitempty"`
+
+	// create_claimable_balance uses Amount and Asset as well
+	Claimants []ClaimantTemplate `json:"claimants,omitempty" yaml:"claimants,omitempty"`
+
+	// claim_claimable_balance. The balance id is hex encoded, as returned by Horizon.
+	BalanceID string `json:"balance_id,omitempty" yaml:"balance_id,omitempty"`
+
+	// begin_sponsoring_future_reserves
+	SponsoredID string `json:"sponsored_id,omitempty" yaml:"sponsored_id,omitempty"`
+
+	// revoke_sponsorship
+	Sponsorship *SponsorshipTemplate `json:"sponsorship,omitempty" yaml:"sponsorship,omitempty"`
+}
+
+// ClaimantTemplate describes a claimant of a create_claimable_balance operation.
+type ClaimantTemplate struct {
+	Destination string                 `json:"destination" yaml:"destination"`
+	Predicate   ClaimPredicateTemplate `json:"predicate" yaml:"predicate"`
+}
+
+// ClaimPredicateTemplate describes a claim predicate. Exactly one of the fields is
+// set. AbsBefore is a unix timestamp and RelBefore a number of seconds.
+type ClaimPredicateTemplate struct {
+	Unconditional bool                     `json:"unconditional,omitempty" yaml:"unconditional,omitempty"`
+	And           []ClaimPredicateTemplate `json:"and,omitempty" yaml:"and,omitempty"`
+	Or            []ClaimPredicateTemplate `json:"or,omitempty" yaml:"or,omitempty"`
+	Not           *ClaimPredicateTemplate  `json:"not,omitempty" yaml:"not,omitempty"`
+	AbsBefore     *int64                   `json:"abs_before,omitempty" yaml:"abs_before,omitempty"`
+	RelBefore     *int64                   `json:"rel_before,omitempty" yaml:"rel_before,omitempty"`
+}
+
+// SponsorshipTemplate describes the sponsorship removed by a revoke_sponsorship
+// operation. Type is one of "account", "trustline", "offer", "data",
+// "claimable_balance" or "signer", and determines which of the other fields are used.
+type SponsorshipTemplate struct {
+	Type      string `json:"type" yaml:"type"`
+	Account   string `json:"account,omitempty" yaml:"account,omitempty"`
+	Asset     string `json:"asset,omitempty" yaml:"asset,omitempty"`
+	OfferID   int64  `json:"offer_id,omitempty" yaml:"offer_id,omitempty"`
+	DataName  string `json:"data_name,omitempty" yaml:"data_name,omitempty"`
+	BalanceID string `json:"balance_id,omitempty" yaml:"balance_id,omitempty"`
+	Signer    string `json:"signer,omitempty" yaml:"signer,omitempty"`
+}
+
+// SignerTemplate describes the signer of a set_options operation.
+type SignerTemplate struct {
+	Address string    `json:"address" yaml:"address"`
+	Weight  Threshold `json:"weight" yaml:"weight"`
+}
+
+var accountFlagNames = map[AccountFlag]string{
+	AuthRequired:  "auth_required",
+	AuthRevocable: "auth_revocable",
+	AuthImmutable: "auth_immutable",
+}
+
+func newOperationTemplate(op Operation) (OperationTemplate, error) {
+	var t OperationTemplate
+	var err error
+	if op.GetSourceAccount() != nil {
+		t.SourceAccount = op.GetSourceAccount().GetAccountID()
+	}
+
+	switch o := op.(type) {
+	case *CreateAccount:
+		t.Type = "create_account"
+		t.Destination = o.Destination
+		t.Amount = o.Amount
+	case *Payment:
+		t.Type = "payment"
+		t.Destination = o.Destination
+		t.Amount = o.Amount
+		t.Asset, err = assetString(o.Asset)
+	case *PathPaymentStrictReceive:
+		t.Type = "path_payment_strict_receive"
+		t.Destination = o.Destination
+		t.SendMax = o.SendMax
+		t.DestAmount = o.DestAmount
+		t.SendAsset, t.DestAsset, t.Path, err = pathAssetStrings(o.SendAsset, o.DestAsset, o.Path)
+	case *PathPaymentStrictSend:
+		t.Type = "path_payment_strict_send"
+		t.Destination = o.Destination
+		t.SendAmount = o.SendAmount
+		t.DestMin = o.DestMin
+		t.SendAsset, t.DestAsset, t.Path, err = pathAssetStrings(o.SendAsset, o.DestAsset, o.Path)
+	case *ManageSellOffer:
+		t.Type = "manage_sell_offer"
+		t.Amount, t.Price, t.OfferID = o.Amount, o.Price, o.OfferID
+		t.Selling, t.Buying, err = offerAssetStrings(o.Selling, o.Buying)
+	case *ManageBuyOffer:
+		t.Type = "manage_buy_offer"
+		t.Amount, t.Price, t.OfferID = o.Amount, o.Price, o.OfferID
+		t.Selling, t.Buying, err = offerAssetStrings(o.Selling, o.Buying)
+	case *CreatePassiveSellOffer:
+		t.Type = "create_passive_sell_offer"
+		t.Amount, t.Price = o.Amount, o.Price
+		t.Selling, t.Buying, err = offerAssetStrings(o.Selling, o.Buying)
+	case *SetOptions:
+		t.Type = "set_options"
+		t.InflationDestination = o.InflationDestination
+		t.MasterWeight = o.MasterWeight
+		t.LowThreshold = o.LowThreshold
+		t.MediumThreshold = o.MediumThreshold
+		t.HighThreshold = o.HighThreshold
+		t.HomeDomain = o.HomeDomain
+		if o.Signer != nil {
+			t.Signer = &SignerTemplate{Address: o.Signer.Address, Weight: o.Signer.Weight}
+		}
+		t.SetFlags = accountFlagStrings(o.SetFlags)
+		t.ClearFlags = accountFlagStrings(o.ClearFlags)
+	case *ChangeTrust:
+		t.Type = "change_trust"
+		t.Limit = o.Limit
+		t.Line, err = assetString(o.Line)
+	case *AllowTrust:
+		t.Type = "allow_trust"
+		t.Trustor = o.Trustor
+		t.Authorize = o.Authorize
+		t.AuthorizeToMaintainLiabilities = o.AuthorizeToMaintainLiabilities
+		if o.Type != nil {
+			t.AssetCode = o.Type.GetCode()
+		}
+	case *AccountMerge:
+		t.Type = "account_merge"
+		t.Destination = o.Destination
+	case *Inflation:
+		t.Type = "inflation"
+	case *ManageData:
+		t.Type = "manage_data"
+		t.Name = o.Name
+		if o.Value != nil {
+			value := base64.StdEncoding.EncodeToString(o.Value)
+			t.Value = &value
+		}
+	case *BumpSequence:
+		t.Type = "bump_sequence"
+		t.BumpTo = o.BumpTo
+	case *CreateClaimableBalance:
+		t.Type = "create_claimable_balance"
+		t.Amount = o.Amount
+		if t.Asset, err = assetString(o.Asset); err == nil {
+			t.Claimants, err = claimantTemplates(o.Destinations)
+		}
+	case *ClaimClaimableBalance:
+		t.Type = "claim_claimable_balance"
+		t.BalanceID = o.BalanceID
+	case *BeginSponsoringFutureReserves:
+		t.Type = "begin_sponsoring_future_reserves"
+		t.SponsoredID = o.SponsoredID
+	case *EndSponsoringFutureReserves:
+		t.Type = "end_sponsoring_future_reserves"
+	case *RevokeSponsorship:
+		t.Type = "revoke_sponsorship"
+		t.Sponsorship, err = sponsorshipTemplate(o)
+	default:
+		return t, errors.Errorf("unknown operation type %T", op)
+	}
+	return t, err
+}
+
+func (t OperationTemplate) toOperation() (Operation, error) {
+	var sourceAccount Account
+	if t.SourceAccount != "" {
+		sourceAccount = &SimpleAccount{AccountID: t.SourceAccount}
+	}
+
+	var err error
+	switch t.Type {
+	case "create_account":
+		return &CreateAccount{
+			Destination:   t.Destination,
+			Amount:        t.Amount,
+			SourceAccount: sourceAccount,
+		}, nil
+	case "payment":
+		op := &Payment{Destination: t.Destination, Amount: t.Amount, SourceAccount: sourceAccount}
+		op.Asset, err = parseAssetString(t.Asset)
+		return op, err
+	case "path_payment_strict_receive":
+		op := &PathPaymentStrictReceive{
+			Destination:   t.Destination,
+			SendMax:       t.SendMax,
+			DestAmount:    t.DestAmount,
+			SourceAccount: sourceAccount,
+		}
+		op.SendAsset, op.DestAsset, op.Path, err = parsePathAssets(t.SendAsset, t.DestAsset, t.Path)
+		return op, err
+	case "path_payment_strict_send":
+		op := &PathPaymentStrictSend{
+			Destination:   t.Destination,
+			SendAmount:    t.SendAmount,
+			DestMin:       t.DestMin,
+			SourceAccount: sourceAccount,
+		}
+		op.SendAsset, op.DestAsset, op.Path, err = parsePathAssets(t.SendAsset, t.DestAsset, t.Path)
+		return op, err
+	case "manage_sell_offer":
+		op := &ManageSellOffer{Amount: t.Amount, Price: t.Price, OfferID: t.OfferID, SourceAccount: sourceAccount}
+		op.Selling, op.Buying, err = parseOfferAssets(t.Selling, t.Buying)
+		return op, err
+	case "manage_buy_offer":
+		op := &ManageBuyOffer{Amount: t.Amount, Price: t.Price, OfferID: t.OfferID, SourceAccount: sourceAccount}
+		op.Selling, op.Buying, err = parseOfferAssets(t.Selling, t.Buying)
+		return op, err
+	case "create_passive_sell_offer":
+		op := &CreatePassiveSellOffer{Amount: t.Amount, Price: t.Price, SourceAccount: sourceAccount}
+		op.Selling, op.Buying, err = parseOfferAssets(t.Selling, t.Buying)
+		return op, err
+	case "set_options":
+		op := &SetOptions{
+			InflationDestination: t.InflationDestination,
+			MasterWeight:         t.MasterWeight,
+			LowThreshold:         t.LowThreshold,
+			MediumThreshold:      t.MediumThreshold,
+			HighThreshold:        t.HighThreshold,
+			HomeDomain:           t.HomeDomain,
+			SourceAccount:        sourceAccount,
+		}
+		if t.Signer != nil {
+			op.Signer = &Signer{Address: t.Signer.Address, Weight: t.Signer.Weight}
+		}
+		if op.SetFlags, err = parseAccountFlags(t.SetFlags); err != nil {
+			return nil, err
+		}
+		op.ClearFlags, err = parseAccountFlags(t.ClearFlags)
+		return op, err
+	case "change_trust":
+		op := &ChangeTrust{Limit: t.Limit, SourceAccount: sourceAccount}
+		op.Line, err = parseAssetString(t.Line)
+		return op, err
+	case "allow_trust":
+		return &AllowTrust{
+			Trustor:                        t.Trustor,
+			Type:                           CreditAsset{Code: t.AssetCode},
+			Authorize:                      t.Authorize,
+			AuthorizeToMaintainLiabilities: t.AuthorizeToMaintainLiabilities,
+			SourceAccount:                  sourceAccount,
+		}, nil
+	case "account_merge":
+		return &AccountMerge{Destination: t.Destination, SourceAccount: sourceAccount}, nil
+	case "inflation":
+		return &Inflation{SourceAccount: sourceAccount}, nil
+	case "manage_data":
+		op := &ManageData{Name: t.Name, SourceAccount: sourceAccount}
+		if t.Value != nil {
+			op.Value, err = base64.StdEncoding.DecodeString(*t.Value)
+			if err != nil {
+				return nil, errors.Wrap(err, "manage_data value must be base64 encoded")
+			}
+		}
+		return op, nil
+	case "bump_sequence":
+		return &BumpSequence{BumpTo: t.BumpTo, SourceAccount: sourceAccount}, nil
+	case "create_claimable_balance":
+		op := &CreateClaimableBalance{Amount: t.Amount, SourceAccount: sourceAccount}
+		if op.Asset, err = parseAssetString(t.Asset); err != nil {
+			return nil, err
+		}
+		for _, claimant := range t.Claimants {
+			predicate, err := claimant.Predicate.toXDR()
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid predicate for claimant %s", claimant.Destination)
+			}
+			op.Destinations = append(op.Destinations, Claimant{Destination: claimant.Destination, Predicate: predicate})
+		}
+		return op, nil
+	case "claim_claimable_balance":
+		return &ClaimClaimableBalance{BalanceID: t.BalanceID, SourceAccount: sourceAccount}, nil
+	case "begin_sponsoring_future_reserves":
+		return &BeginSponsoringFutureReserves{SponsoredID: t.SponsoredID, SourceAccount: sourceAccount}, nil
+	case "end_sponsoring_future_reserves":
+		return &EndSponsoringFutureReserves{SourceAccount: sourceAccount}, nil
+	case "revoke_sponsorship":
+		if t.Sponsorship == nil {
+			return nil, errors.New("revoke_sponsorship requires a sponsorship")
+		}
+		op, err := t.Sponsorship.toOperation()
+		if err != nil {
+			return nil, err
+		}
+		op.SourceAccount = sourceAccount
+		return op, nil
+	}
+	return nil, errors.Errorf("unknown operation type %q", t.Type)
+}
+
+// assetString returns the "native" or "CODE:ISSUER" representation of an asset.
+func assetString(asset Asset) (string, error) {
+	if asset == nil {
+		return "", errors.New("asset is missing")
+	}
+	if asset.IsNative() {
+		return "native", nil
+	}
+	return asset.GetCode() + ":" + asset.GetIssuer(), nil
+}
+
+// parseAssetString parses an asset in the format returned by assetString.
+func parseAssetString(s string) (Asset, error) {
+	if s == "native" {
+		return NativeAsset{}, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("asset %q must be \"native\" or \"CODE:ISSUER\"", s)
+	}
+	return CreditAsset{Code: parts[0], Issuer: parts[1]}, nil
+}
+
+func pathAssetStrings(sendAsset, destAsset Asset, path []Asset) (string, string, []string, error) {
+	send, err := assetString(sendAsset)
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "invalid send asset")
+	}
+	dest, err := assetString(destAsset)
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "invalid dest asset")
+	}
+	var pathStrings []string
+	for _, asset := range path {
+		s, err := assetString(asset)
+		if err != nil {
+			return "", "", nil, errors.Wrap(err, "invalid path asset")
+		}
+		pathStrings = append(pathStrings, s)
+	}
+	return send, dest, pathStrings, nil
+}
+
+func parsePathAssets(sendAsset, destAsset string, path []string) (Asset, Asset, []Asset, error) {
+	send, err := parseAssetString(sendAsset)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "invalid send_asset")
+	}
+	dest, err := parseAssetString(destAsset)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "invalid dest_asset")
+	}
+	var pathAssets []Asset
+	for _, s := range path {
+		asset, err := parseAssetString(s)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "invalid path")
+		}
+		pathAssets = append(pathAssets, asset)
+	}
+	return send, dest, pathAssets, nil
+}
+
+func offerAssetStrings(selling, buying Asset) (string, string, error) {
+	s, err := assetString(selling)
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid selling asset")
+	}
+	b, err := assetString(buying)
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid buying asset")
+	}
+	return s, b, nil
+}
+
+func parseOfferAssets(selling, buying string) (Asset, Asset, error) {
+	s, err := parseAssetString(selling)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid selling")
+	}
+	b, err := parseAssetString(buying)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid buying")
+	}
+	return s, b, nil
+}
+
+func accountFlagStrings(flags []AccountFlag) []string {
+	var names []string
+	for _, flag := range flags {
+		names = append(names, accountFlagNames[flag])
+	}
+	return names
+}
+
+func parseAccountFlags(names []string) ([]AccountFlag, error) {
+	var flags []AccountFlag
+	for _, name := range names {
+		found := false
+		for flag, flagName := range accountFlagNames {
+			if flagName == name {
+				flags = append(flags, flag)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("unknown account flag %q", name)
+		}
+	}
+	return flags, nil
+}
+
+func claimantTemplates(claimants []Claimant) ([]ClaimantTemplate, error) {
+	var templates []ClaimantTemplate
+	for _, claimant := range claimants {
+		predicate, err := claimPredicateTemplate(claimant.Predicate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid predicate for claimant %s", claimant.Destination)
+		}
+		templates = append(templates, ClaimantTemplate{Destination: claimant.Destination, Predicate: predicate})
+	}
+	return templates, nil
+}
+
+func claimPredicateTemplate(predicate xdr.ClaimPredicate) (ClaimPredicateTemplate, error) {
+	var t ClaimPredicateTemplate
+	switch predicate.Type {
+	case xdr.ClaimPredicateTypeClaimPredicateUnconditional:
+		t.Unconditional = true
+	case xdr.ClaimPredicateTypeClaimPredicateAnd, xdr.ClaimPredicateTypeClaimPredicateOr:
+		var predicates []xdr.ClaimPredicate
+		if predicate.Type == xdr.ClaimPredicateTypeClaimPredicateAnd {
+			predicates = predicate.MustAndPredicates()
+		} else {
+			predicates = predicate.MustOrPredicates()
+		}
+		var list []ClaimPredicateTemplate
+		for _, p := range predicates {
+			inner, err := claimPredicateTemplate(p)
+			if err != nil {
+				return t, err
+			}
+			list = append(list, inner)
+		}
+		if predicate.Type == xdr.ClaimPredicateTypeClaimPredicateAnd {
+			t.And = list
+		} else {
+			t.Or = list
+		}
+	case xdr.ClaimPredicateTypeClaimPredicateNot:
+		notPredicate := predicate.MustNotPredicate()
+		if notPredicate == nil {
+			return t, errors.New("not predicate is missing its predicate")
+		}
+		inner, err := claimPredicateTemplate(*notPredicate)
+		if err != nil {
+			return t, err
+		}
+		t.Not = &inner
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		absBefore := int64(predicate.MustAbsBefore())
+		t.AbsBefore = &absBefore
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		relBefore := int64(predicate.MustRelBefore())
+		t.RelBefore = &relBefore
+	default:
+		return t, errors.Errorf("unknown claim predicate type %d", predicate.Type)
+	}
+	return t, nil
+}
+
+func (t ClaimPredicateTemplate) toXDR() (xdr.ClaimPredicate, error) {
+	switch {
+	case t.Unconditional:
+		return UnconditionalPredicate, nil
+	case len(t.And) == 2:
+		left, right, err := claimPredicatePair(t.And)
+		return AndPredicate(left, right), err
+	case len(t.Or) == 2:
+		left, right, err := claimPredicatePair(t.Or)
+		return OrPredicate(left, right), err
+	case t.Not != nil:
+		inner, err := t.Not.toXDR()
+		return NotPredicate(inner), err
+	case t.AbsBefore != nil:
+		return BeforeAbsoluteTimePredicate(*t.AbsBefore), nil
+	case t.RelBefore != nil:
+		return BeforeRelativeTimePredicate(*t.RelBefore), nil
+	}
+	return xdr.ClaimPredicate{}, errors.New("predicate must be unconditional, not, abs_before, rel_before or have two and/or predicates")
+}
+
+func claimPredicatePair(pair []ClaimPredicateTemplate) (xdr.ClaimPredicate, xdr.ClaimPredicate, error) {
+	left, err := pair[0].toXDR()
+	if err != nil {
+		return left, xdr.ClaimPredicate{}, err
+	}
+	right, err := pair[1].toXDR()
+	return left, right, err
+}
+
+func sponsorshipTemplate(op *RevokeSponsorship) (*SponsorshipTemplate, error) {
+	var t SponsorshipTemplate
+	var err error
+	switch op.SponsorshipType {
+	case RevokeSponsorshipTypeAccount:
+		t.Type = "account"
+		if op.Account != nil {
+			t.Account = *op.Account
+		}
+	case RevokeSponsorshipTypeTrustLine:
+		t.Type = "trustline"
+		if op.TrustLine != nil {
+			t.Account = op.TrustLine.Account
+			t.Asset, err = assetString(op.TrustLine.Asset)
+		}
+	case RevokeSponsorshipTypeOffer:
+		t.Type = "offer"
+		if op.Offer != nil {
+			t.Account, t.OfferID = op.Offer.SellerAccountAddress, op.Offer.OfferID
+		}
+	case RevokeSponsorshipTypeData:
+		t.Type = "data"
+		if op.Data != nil {
+			t.Account, t.DataName = op.Data.Account, op.Data.DataName
+		}
+	case RevokeSponsorshipTypeClaimableBalance:
+		t.Type = "claimable_balance"
+		if op.ClaimableBalance != nil {
+			t.BalanceID = *op.ClaimableBalance
+		}
+	case RevokeSponsorshipTypeSigner:
+		t.Type = "signer"
+		if op.Signer != nil {
+			t.Account, t.Signer = op.Signer.AccountID, op.Signer.SignerAddress
+		}
+	default:
+		return nil, errors.Errorf("unknown sponsorship type %d", op.SponsorshipType)
+	}
+	return &t, err
+}
+
+func (t SponsorshipTemplate) toOperation() (*RevokeSponsorship, error) {
+	op := &RevokeSponsorship{}
+	switch t.Type {
+	case "account":
+		account := t.Account
+		op.SponsorshipType = RevokeSponsorshipTypeAccount
+		op.Account = &account
+	case "trustline":
+		asset, err := parseAssetString(t.Asset)
+		if err != nil {
+			return nil, err
+		}
+		op.SponsorshipType = RevokeSponsorshipTypeTrustLine
+		op.TrustLine = &TrustLineID{Account: t.Account, Asset: asset}
+	case "offer":
+		op.SponsorshipType = RevokeSponsorshipTypeOffer
+		op.Offer = &OfferID{SellerAccountAddress: t.Account, OfferID: t.OfferID}
+	case "data":
+		op.SponsorshipType = RevokeSponsorshipTypeData
+		op.Data = &DataID{Account: t.Account, DataName: t.DataName}
+	case "claimable_balance":
+		balanceID := t.BalanceID
+		op.SponsorshipType = RevokeSponsorshipTypeClaimableBalance
+		op.ClaimableBalance = &balanceID
+	case "signer":
+		op.SponsorshipType = RevokeSponsorshipTypeSigner
+		op.Signer = &SignerID{AccountID: t.Account, SignerAddress: t.Signer}
+	default:
+		return nil, errors.Errorf("unknown sponsorship type %q", t.Type)
+	}
+	return op, nil
+}
@@ -0,0 +1,240 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplateTestTx(t *testing.T) *Transaction {
+	kp0, kp1, kp2 := newKeypair0(), newKeypair1(), newKeypair2()
+	usd := CreditAsset{Code: "USD", Issuer: kp1.Address()}
+	eur := CreditAsset{Code: "EURTOKEN", Issuer: kp2.Address()}
+
+	tx, err := NewTransaction(TransactionParams{
+		SourceAccount:        &SimpleAccount{AccountID: kp0.Address(), Sequence: 9606132444168199},
+		IncrementSequenceNum: true,
+		BaseFee:              MinBaseFee,
+		Memo:                 MemoText("template"),
+		Timebounds:           NewTimebounds(1588000000, 1588003600),
+		Operations: []Operation{
+			&CreateAccount{Destination: kp1.Address(), Amount: "10"},
+			&Payment{Destination: kp1.Address(), Amount: "1.5", Asset: usd, SourceAccount: &SimpleAccount{AccountID: kp2.Address()}},
+			&PathPaymentStrictReceive{SendAsset: NativeAsset{}, SendMax: "20", Destination: kp1.Address(), DestAsset: usd, DestAmount: "5", Path: []Asset{eur}},
+			&PathPaymentStrictSend{SendAsset: usd, SendAmount: "5", Destination: kp1.Address(), DestAsset: NativeAsset{}, DestMin: "1", Path: []Asset{eur, NativeAsset{}}},
+			&ManageSellOffer{Selling: NativeAsset{}, Buying: usd, Amount: "100", Price: "0.5", OfferID: 42},
+			&ManageBuyOffer{Selling: usd, Buying: eur, Amount: "10", Price: "3"},
+			&CreatePassiveSellOffer{Selling: eur, Buying: NativeAsset{}, Amount: "7", Price: "1.25"},
+			&SetOptions{
+				InflationDestination: NewInflationDestination(kp1.Address()),
+				SetFlags:             []AccountFlag{AuthRequired, AuthRevocable},
+				ClearFlags:           []AccountFlag{AuthImmutable},
+				MasterWeight:         NewThreshold(10),
+				LowThreshold:         NewThreshold(1),
+				MediumThreshold:      NewThreshold(2),
+				HighThreshold:        NewThreshold(3),
+				HomeDomain:           NewHomeDomain("example.com"),
+				Signer:               &Signer{Address: kp2.Address(), Weight: 5},
+			},
+			&ChangeTrust{Line: usd, Limit: "1000"},
+			&AllowTrust{Trustor: kp1.Address(), Type: CreditAsset{Code: "USD"}, Authorize: true},
+			&AccountMerge{Destination: kp1.Address(), SourceAccount: &SimpleAccount{AccountID: kp2.Address()}},
+			&Inflation{},
+			&ManageData{Name: "key", Value: []byte{0, 1, 2, 255}},
+			&ManageData{Name: "deleted"},
+			&BumpSequence{BumpTo: 9606132444168300},
+		},
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func assertTemplateRoundTrip(t *testing.T, expected string) {
+	parsed, err := TransactionFromXDR(expected)
+	require.NoError(t, err)
+	template, err := NewTransactionTemplate(parsed)
+	require.NoError(t, err)
+
+	jsonData, err := template.JSON()
+	require.NoError(t, err)
+	fromJSON, err := ParseTransactionTemplateJSON(jsonData)
+	require.NoError(t, err)
+	assert.Equal(t, template, fromJSON)
+
+	yamlData, err := template.YAML()
+	require.NoError(t, err)
+	fromYAML, err := ParseTransactionTemplateYAML(yamlData)
+	require.NoError(t, err)
+	assert.Equal(t, template, fromYAML)
+
+	for _, decoded := range []TransactionTemplate{fromJSON, fromYAML} {
+		built, err := decoded.Build()
+		require.NoError(t, err)
+		var actual string
+		if feeBump, ok := built.FeeBump(); ok {
+			actual, err = feeBump.Base64()
+		} else {
+			tx, _ := built.Transaction()
+			actual, err = tx.Base64()
+		}
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func TestTransactionTemplateRoundTrip(t *testing.T) {
+	tx := newTemplateTestTx(t)
+	expected, err := tx.Base64()
+	require.NoError(t, err)
+	assertTemplateRoundTrip(t, expected)
+}
+
+func TestTransactionTemplateV1RoundTrip(t *testing.T) {
+	tx := newTemplateTestTx(t)
+	convertToV1Tx(tx)
+	expected, err := tx.Base64()
+	require.NoError(t, err)
+	assertTemplateRoundTrip(t, expected)
+}
+
+func TestTransactionTemplateFeeBumpRoundTrip(t *testing.T) {
+	tx := newTemplateTestTx(t)
+	convertToV1Tx(tx)
+	feeBump, err := NewFeeBumpTransaction(FeeBumpTransactionParams{
+		Inner:      tx,
+		FeeAccount: newKeypair1().Address(),
+		BaseFee:    2 * MinBaseFee,
+	})
+	require.NoError(t, err)
+	expected, err := feeBump.Base64()
+	require.NoError(t, err)
+	assertTemplateRoundTrip(t, expected)
+}
+
+func TestTransactionTemplateMemos(t *testing.T) {
+	kp0 := newKeypair0()
+	hash := [32]byte{1, 2, 3}
+	for _, memo := range []Memo{MemoID(18446744073709551615), MemoHash(hash), MemoReturn(hash)} {
+		tx, err := NewTransaction(TransactionParams{
+			SourceAccount: &SimpleAccount{AccountID: kp0.Address(), Sequence: 1},
+			BaseFee:       MinBaseFee,
+			Memo:          memo,
+			Timebounds:    NewInfiniteTimeout(),
+			Operations:    []Operation{&BumpSequence{BumpTo: 2}},
+		})
+		require.NoError(t, err)
+		expected, err := tx.Base64()
+		require.NoError(t, err)
+		assertTemplateRoundTrip(t, expected)
+	}
+}
+
+func TestParseTransactionTemplateYAML(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	data := `
+source_account: ` + kp0.Address() + `
+sequence: 2
+base_fee: 100
+memo:
+  type: text
+  value: hello
+timebounds:
+  min_time: 0
+  max_time: 0
+operations:
+- type: payment
+  destination: ` + kp1.Address() + `
+  amount: "12.5"
+  asset: native
+- type: change_trust
+  line: USD:` + kp1.Address() + `
+`
+	template, err := ParseTransactionTemplateYAML([]byte(data))
+	require.NoError(t, err)
+	built, err := template.Build()
+	require.NoError(t, err)
+	tx, ok := built.Transaction()
+	require.True(t, ok)
+
+	assert.Equal(t, int64(2), tx.SourceAccount().Sequence)
+	assert.Equal(t, MemoText("hello"), tx.Memo())
+	require.Len(t, tx.Operations(), 2)
+	assert.Equal(t, &Payment{Destination: kp1.Address(), Amount: "12.5", Asset: NativeAsset{}}, tx.Operations()[0])
+	assert.Equal(t, CreditAsset{Code: "USD", Issuer: kp1.Address()}, tx.Operations()[1].(*ChangeTrust).Line)
+}
+
+func TestTransactionTemplateErrors(t *testing.T) {
+	_, err := ParseTransactionTemplateYAML([]byte("unknown_field: 1"))
+	assert.Error(t, err)
+
+	_, err = ParseTransactionTemplateJSON([]byte(`{
+		"source_account": "` + newKeypair0().Address() + `",
+		"operations": [{"type": "payment", "ammount": "10", "asset": "native"}]
+	}`))
+	assert.EqualError(t, err, `could not decode transaction template: json: unknown field "ammount"`)
+
+	template := TransactionTemplate{
+		SourceAccount: newKeypair0().Address(),
+		BaseFee:       MinBaseFee,
+		Operations:    []OperationTemplate{{Type: "liquidity_pool_deposit"}},
+	}
+	_, err = template.Build()
+	assert.EqualError(t, err, `invalid operation 0: unknown operation type "liquidity_pool_deposit"`)
+
+	template.Operations = []OperationTemplate{{Type: "payment", Asset: "USD"}}
+	_, err = template.Build()
+	assert.EqualError(t, err, `invalid operation 0: asset "USD" must be "native" or "CODE:ISSUER"`)
+
+	template.Operations = []OperationTemplate{{Type: "set_options", SetFlags: []string{"auth_forever"}}}
+	_, err = template.Build()
+	assert.EqualError(t, err, `invalid operation 0: unknown account flag "auth_forever"`)
+
+	template.Operations = []OperationTemplate{{Type: "inflation"}}
+	template.Memo = &MemoTemplate{Type: "hash", Value: "abcd"}
+	_, err = template.Build()
+	assert.EqualError(t, err, "hash memo must be a hex encoded 32 byte value")
+}
+
+func newSponsorshipTemplateTestTx(t *testing.T) *Transaction {
+	kp0, kp1, kp2 := newKeypair0(), newKeypair1(), newKeypair2()
+	usd := CreditAsset{Code: "USD", Issuer: kp1.Address()}
+	balanceID := "00000000da0d57da7d4850e7fc10d2a9d0ebc731f7afb40574c03395b17d49149b91f5be"
+	account := kp1.Address()
+	predicate := AndPredicate(
+		NotPredicate(BeforeRelativeTimePredicate(3600)),
+		OrPredicate(BeforeAbsoluteTimePredicate(1600000000), UnconditionalPredicate),
+	)
+
+	tx, err := NewTransaction(TransactionParams{
+		SourceAccount:        &SimpleAccount{AccountID: kp0.Address(), Sequence: 9606132444168199},
+		IncrementSequenceNum: true,
+		BaseFee:              MinBaseFee,
+		Timebounds:           NewTimebounds(1588000000, 1588003600),
+		Operations: []Operation{
+			&CreateClaimableBalance{
+				Amount:       "10",
+				Asset:        usd,
+				Destinations: []Claimant{NewClaimant(kp1.Address(), nil), NewClaimant(kp2.Address(), &predicate)},
+			},
+			&ClaimClaimableBalance{BalanceID: balanceID, SourceAccount: &SimpleAccount{AccountID: kp1.Address()}},
+			&BeginSponsoringFutureReserves{SponsoredID: kp1.Address()},
+			&EndSponsoringFutureReserves{SourceAccount: &SimpleAccount{AccountID: kp1.Address()}},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeAccount, Account: &account},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeTrustLine, TrustLine: &TrustLineID{Account: kp1.Address(), Asset: usd}},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeOffer, Offer: &OfferID{SellerAccountAddress: kp1.Address(), OfferID: 7}},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeData, Data: &DataID{Account: kp1.Address(), DataName: "key"}},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeClaimableBalance, ClaimableBalance: &balanceID},
+			&RevokeSponsorship{SponsorshipType: RevokeSponsorshipTypeSigner, Signer: &SignerID{AccountID: kp1.Address(), SignerAddress: kp2.Address()}},
+		},
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestTransactionTemplateSponsorshipRoundTrip(t *testing.T) {
+	tx := newSponsorshipTemplateTestTx(t)
+	expected, err := tx.Base64()
+	require.NoError(t, err)
+	assertTemplateRoundTrip(t, expected)
+}
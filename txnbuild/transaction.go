@@ -195,6 +195,31 @@ func cloneEnvelope(e xdr.TransactionEnvelope, signatures []xdr.DecoratedSignatur
 	return clone, nil
 }
 
+// v1Transaction returns a copy of tx with a V1 envelope if tx has a V0
+// envelope, or tx itself otherwise. V0 and V1 envelopes of a transaction have
+// the same hash so the signatures of tx remain valid.
+func v1Transaction(tx *Transaction) *Transaction {
+	if tx.envelope.Type != xdr.EnvelopeTypeEnvelopeTypeTxV0 {
+		return tx
+	}
+	v1 := new(Transaction)
+	*v1 = *tx
+	v1.envelope = xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{
+			Tx: xdr.Transaction{
+				SourceAccount: tx.envelope.SourceAccount(),
+				Fee:           xdr.Uint32(tx.envelope.Fee()),
+				SeqNum:        xdr.SequenceNumber(tx.envelope.SeqNum()),
+				TimeBounds:    tx.envelope.TimeBounds(),
+				Memo:          tx.envelope.Memo(),
+				Operations:    tx.envelope.Operations(),
+			},
+		},
+	}
+	return v1
+}
+
 // Transaction represents a Stellar transaction. See
 // https://www.stellar.org/developers/guides/concepts/transactions.html
 // A Transaction may be wrapped by a FeeBumpTransaction in which case
@@ -260,6 +285,41 @@ func (t *Transaction) HashHex(network string) (string, error) {
 	return hashHex(t.envelope, network)
 }
 
+// ClaimableBalanceID returns the hex encoded id of the claimable balance created
+// by the CreateClaimableBalance operation at operationIndex. The id is derived from
+// the transaction source account, the sequence number and the operation index.
+func (t *Transaction) ClaimableBalanceID(operationIndex int) (string, error) {
+	if operationIndex < 0 || operationIndex >= len(t.operations) {
+		return "", errors.New("invalid operation index")
+	}
+	if _, ok := t.operations[operationIndex].(*CreateClaimableBalance); !ok {
+		return "", errors.New("operation is not CreateClaimableBalance")
+	}
+
+	// The source account is the account id of the transaction source even if
+	// the transaction was submitted from a multiplexed account.
+	sourceAccount := t.envelope.SourceAccount().ToAccountId()
+	operationID := xdr.OperationId{
+		Type: xdr.EnvelopeTypeEnvelopeTypeOpId,
+		Id: &xdr.OperationIdId{
+			SourceAccount: sourceAccount.ToMuxedAccount(),
+			SeqNum:        xdr.SequenceNumber(t.envelope.SeqNum()),
+			OpNum:         xdr.Uint32(operationIndex),
+		},
+	}
+	binaryDump, err := operationID.MarshalBinary()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal operation id")
+	}
+
+	hash := xdr.Hash(sha256.Sum256(binaryDump))
+	balanceID := xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &hash,
+	}
+	return xdr.MarshalHex(balanceID)
+}
+
 // Sign returns a new Transaction instance which extends the current instance
 // with additional signatures derived from the given list of keypair instances.
 func (t *Transaction) Sign(network string, kps ...*keypair.Full) (*Transaction, error) {
@@ -561,8 +621,10 @@ type TransactionParams struct {
 	IncrementSequenceNum bool
 	Operations           []Operation
 	BaseFee              int64
-	Memo                 Memo
-	Timebounds           Timebounds
+	// FeeStrategy is consulted to determine the base fee when BaseFee is zero.
+	FeeStrategy FeeStrategy
+	Memo        Memo
+	Timebounds  Timebounds
 }
 
 // NewTransaction returns a new Transaction instance
@@ -574,6 +636,13 @@ func NewTransaction(params TransactionParams) (*Transaction, error) {
 		return nil, errors.New("transaction has no source account")
 	}
 
+	if params.BaseFee == 0 && params.FeeStrategy != nil {
+		params.BaseFee, err = params.FeeStrategy.BaseFee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not obtain base fee from fee strategy")
+		}
+	}
+
 	if params.IncrementSequenceNum {
 		sequence, err = params.SourceAccount.IncrementSequenceNumber()
 	} else {
@@ -741,15 +810,51 @@ func NewFeeBumpTransaction(params FeeBumpTransactionParams) (*FeeBumpTransaction
 	return tx, nil
 }
 
+// ChallengeTxParams are the parameters of a SEP 10 challenge transaction built by
+// NewChallengeTx.
+type ChallengeTxParams struct {
+	ServerSignerSecret string
+	ClientAccountID    string
+	// HomeDomain is the home domain of the anchor the client is authenticating
+	// with. It is the key of the first manage_data operation, "<HomeDomain> auth".
+	HomeDomain string
+	Network    string
+	// Timebound is the time duration the transaction is valid for, and must be
+	// greater than 1s (300s is recommended).
+	Timebound time.Duration
+	// ClientDomain is the optional home domain of the wallet used by the client. If
+	// it is set, a client_domain manage_data operation sourced from
+	// ClientDomainAccountID is added and the challenge must also be signed by
+	// ClientDomainAccountID, the SIGNING_KEY of the client domain.
+	ClientDomain          string
+	ClientDomainAccountID string
+}
+
+// ClientDomainOperationName is the key of the manage_data operation which attributes a
+// SEP 10 challenge to the domain of the client's wallet.
+const ClientDomainOperationName = "client_domain"
+
 // BuildChallengeTx is a factory method that creates a valid SEP 10 challenge, for use in web authentication.
 // "timebound" is the time duration the transaction should be valid for, and must be greater than 1s (300s is recommended).
 // More details on SEP 10: https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0010.md
 func BuildChallengeTx(serverSignerSecret, clientAccountID, anchorName, network string, timebound time.Duration) (*Transaction, error) {
-	if timebound < time.Second {
+	return NewChallengeTx(ChallengeTxParams{
+		ServerSignerSecret: serverSignerSecret,
+		ClientAccountID:    clientAccountID,
+		HomeDomain:         anchorName,
+		Network:            network,
+		Timebound:          timebound,
+	})
+}
+
+// NewChallengeTx creates a valid SEP 10 challenge, for use in web authentication. Unlike
+// BuildChallengeTx it can attribute the challenge to a client domain.
+func NewChallengeTx(params ChallengeTxParams) (*Transaction, error) {
+	if params.Timebound < time.Second {
 		return nil, errors.New("provided timebound must be at least 1s (300s is recommended)")
 	}
 
-	serverKP, err := keypair.Parse(serverSignerSecret)
+	serverKP, err := keypair.Parse(params.ServerSignerSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -765,8 +870,8 @@ func BuildChallengeTx(serverSignerSecret, clientAccountID, anchorName, network s
 		return nil, errors.New("64 byte long random nonce required")
 	}
 
-	if _, err = xdr.AddressToAccountId(clientAccountID); err != nil {
-		return nil, errors.Wrapf(err, "%s is not a valid account id", clientAccountID)
+	if _, err = xdr.AddressToAccountId(params.ClientAccountID); err != nil {
+		return nil, errors.Wrapf(err, "%s is not a valid account id", params.ClientAccountID)
 	}
 
 	// represent server signing account as SimpleAccount
@@ -777,11 +882,29 @@ func BuildChallengeTx(serverSignerSecret, clientAccountID, anchorName, network s
 
 	// represent client account as SimpleAccount
 	ca := SimpleAccount{
-		AccountID: clientAccountID,
+		AccountID: params.ClientAccountID,
+	}
+
+	operations := []Operation{
+		&ManageData{
+			SourceAccount: &ca,
+			Name:          params.HomeDomain + " auth",
+			Value:         []byte(randomNonceToString),
+		},
+	}
+	if params.ClientDomain != "" {
+		if _, err = xdr.AddressToAccountId(params.ClientDomainAccountID); err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid client domain account id", params.ClientDomainAccountID)
+		}
+		operations = append(operations, &ManageData{
+			SourceAccount: &SimpleAccount{AccountID: params.ClientDomainAccountID},
+			Name:          ClientDomainOperationName,
+			Value:         []byte(params.ClientDomain),
+		})
 	}
 
 	currentTime := time.Now().UTC()
-	maxTime := currentTime.Add(timebound)
+	maxTime := currentTime.Add(params.Timebound)
 
 	// Create a SEP 10 compatible response. See
 	// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0010.md#response
@@ -789,22 +912,16 @@ func BuildChallengeTx(serverSignerSecret, clientAccountID, anchorName, network s
 		TransactionParams{
 			SourceAccount:        &sa,
 			IncrementSequenceNum: false,
-			Operations: []Operation{
-				&ManageData{
-					SourceAccount: &ca,
-					Name:          anchorName + " auth",
-					Value:         []byte(randomNonceToString),
-				},
-			},
-			BaseFee:    MinBaseFee,
-			Memo:       nil,
-			Timebounds: NewTimebounds(currentTime.Unix(), maxTime.Unix()),
+			Operations:           operations,
+			BaseFee:              MinBaseFee,
+			Memo:                 nil,
+			Timebounds:           NewTimebounds(currentTime.Unix(), maxTime.Unix()),
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	tx, err = tx.Sign(network, serverKP.(*keypair.Full))
+	tx, err = tx.Sign(params.Network, serverKP.(*keypair.Full))
 	if err != nil {
 		return nil, err
 	}
@@ -824,6 +941,27 @@ func generateRandomNonce(n int) ([]byte, error) {
 	return binary, err
 }
 
+// ChallengeTxOptions configures how ReadChallengeTxWithOptions and the
+// VerifyChallengeTx...WithOptions functions validate a SEP 10 challenge.
+type ChallengeTxOptions struct {
+	// HomeDomains is the list of home domains accepted by the server. The key of
+	// the first manage_data operation must be "<home domain> auth" for one of
+	// them. At least one home domain is required.
+	HomeDomains []string
+}
+
+// Challenge is a SEP 10 challenge transaction decoded by ReadChallengeTxWithOptions.
+type Challenge struct {
+	Transaction     *Transaction
+	ClientAccountID string
+	// HomeDomain is the home domain the challenge was issued for.
+	HomeDomain string
+	// ClientDomain and ClientDomainAccountID are set if the challenge contains a
+	// client_domain manage_data operation.
+	ClientDomain          string
+	ClientDomainAccountID string
+}
+
 // ReadChallengeTx reads a SEP 10 challenge transaction and returns the decoded
 // transaction and client account ID contained within.
 //
@@ -835,81 +973,166 @@ func generateRandomNonce(n int) ([]byte, error) {
 // - VerifyChallengeTxThreshold
 // - VerifyChallengeTxSigners
 func ReadChallengeTx(challengeTx, serverAccountID, network string) (tx *Transaction, clientAccountID string, err error) {
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, nil)
+	return challenge.Transaction, challenge.ClientAccountID, err
+}
+
+// ReadChallengeTxWithOptions reads a SEP 10 challenge transaction which may carry
+// a client_domain manage_data operation, and verifies that it was issued for one of
+// the home domains accepted in opts and is signed by the server.
+//
+// Like ReadChallengeTx it does not verify the signatures of the client. Use
+// VerifyChallengeTxThresholdWithOptions or VerifyChallengeTxSignersWithOptions to
+// completely verify the transaction.
+func ReadChallengeTxWithOptions(challengeTx, serverAccountID, network string, opts ChallengeTxOptions) (*Challenge, error) {
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, &opts)
+	if err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// readChallengeTx reads a SEP 10 challenge transaction. If opts is nil the
+// challenge must consist of a single manage_data operation, as required by
+// ReadChallengeTx.
+func readChallengeTx(challengeTx, serverAccountID, network string, opts *ChallengeTxOptions) (challenge Challenge, err error) {
+	// a challenge issued for any home domain would be accepted otherwise
+	if opts != nil && len(opts.HomeDomains) == 0 {
+		return challenge, errors.New("at least one accepted home domain is required")
+	}
+
 	parsed, err := TransactionFromXDR(challengeTx)
 	if err != nil {
-		return tx, clientAccountID, errors.Wrap(err, "could not parse challenge")
+		return challenge, errors.Wrap(err, "could not parse challenge")
 	}
 
-	var isSimple bool
-	tx, isSimple = parsed.Transaction()
+	tx, isSimple := parsed.Transaction()
+	challenge.Transaction = tx
 	if !isSimple {
-		return tx, clientAccountID, errors.New("challenge cannot be a fee bump transaction")
+		return challenge, errors.New("challenge cannot be a fee bump transaction")
 	}
 
 	// Enforce no muxed accounts (at least until we understand their impact)
 	if tx.envelope.SourceAccount().Type == xdr.CryptoKeyTypeKeyTypeMuxedEd25519 {
 		err = errors.New("invalid source account: only valid Ed25519 accounts are allowed in challenge transactions")
-		return tx, clientAccountID, err
+		return challenge, err
 	}
 
 	// verify transaction source
 	if tx.SourceAccount().AccountID != serverAccountID {
-		return tx, clientAccountID, errors.New("transaction source account is not equal to server's account")
+		return challenge, errors.New("transaction source account is not equal to server's account")
 	}
 
 	// verify sequence number
 	if tx.SourceAccount().Sequence != 0 {
-		return tx, clientAccountID, errors.New("transaction sequence number must be 0")
+		return challenge, errors.New("transaction sequence number must be 0")
 	}
 
 	// verify timebounds
 	if tx.Timebounds().MaxTime == TimeoutInfinite {
-		return tx, clientAccountID, errors.New("transaction requires non-infinite timebounds")
+		return challenge, errors.New("transaction requires non-infinite timebounds")
 	}
 	currentTime := time.Now().UTC().Unix()
 	if currentTime < tx.Timebounds().MinTime || currentTime > tx.Timebounds().MaxTime {
-		return tx, clientAccountID, errors.Errorf("transaction is not within range of the specified timebounds (currentTime=%d, MinTime=%d, MaxTime=%d)",
+		return challenge, errors.Errorf("transaction is not within range of the specified timebounds (currentTime=%d, MinTime=%d, MaxTime=%d)",
 			currentTime, tx.Timebounds().MinTime, tx.Timebounds().MaxTime)
 	}
 
 	// verify operation
 	operations := tx.Operations()
-	if len(operations) != 1 {
-		return tx, clientAccountID, errors.New("transaction requires a single manage_data operation")
+	if opts == nil && len(operations) != 1 {
+		return challenge, errors.New("transaction requires a single manage_data operation")
+	}
+	if len(operations) == 0 {
+		return challenge, errors.New("transaction requires at least one manage_data operation")
 	}
 	op, ok := operations[0].(*ManageData)
 	if !ok {
-		return tx, clientAccountID, errors.New("operation type should be manage_data")
+		return challenge, errors.New("operation type should be manage_data")
 	}
 	if op.SourceAccount == nil {
-		return tx, clientAccountID, errors.New("operation should have a source account")
+		return challenge, errors.New("operation should have a source account")
 	}
-	clientAccountID = op.SourceAccount.GetAccountID()
+	challenge.ClientAccountID = op.SourceAccount.GetAccountID()
 	rawOperations := tx.envelope.Operations()
-	if len(rawOperations) > 0 && rawOperations[0].SourceAccount.Type == xdr.CryptoKeyTypeKeyTypeMuxedEd25519 {
-		err = errors.New("invalid operation source account: only valid Ed25519 accounts are allowed in challenge transactions")
-		return tx, clientAccountID, err
+	for _, rawOp := range rawOperations {
+		if rawOp.SourceAccount != nil && rawOp.SourceAccount.Type == xdr.CryptoKeyTypeKeyTypeMuxedEd25519 {
+			err = errors.New("invalid operation source account: only valid Ed25519 accounts are allowed in challenge transactions")
+			return challenge, err
+		}
 	}
 
 	// verify manage data value
 	nonceB64 := string(op.Value)
 	if len(nonceB64) != 64 {
-		return tx, clientAccountID, errors.New("random nonce encoded as base64 should be 64 bytes long")
+		return challenge, errors.New("random nonce encoded as base64 should be 64 bytes long")
 	}
 	nonceBytes, err := base64.StdEncoding.DecodeString(nonceB64)
 	if err != nil {
-		return tx, clientAccountID, errors.Wrap(err, "failed to decode random nonce provided in manage_data operation")
+		return challenge, errors.Wrap(err, "failed to decode random nonce provided in manage_data operation")
 	}
 	if len(nonceBytes) != 48 {
-		return tx, clientAccountID, errors.New("random nonce before encoding as base64 should be 48 bytes long")
+		return challenge, errors.New("random nonce before encoding as base64 should be 48 bytes long")
+	}
+
+	if opts != nil {
+		if err = readChallengeTxDomains(&challenge, op.Name, operations[1:], serverAccountID, *opts); err != nil {
+			return challenge, err
+		}
 	}
 
 	err = verifyTxSignature(tx, network, serverAccountID)
 	if err != nil {
-		return tx, clientAccountID, err
+		return challenge, err
 	}
 
-	return tx, clientAccountID, nil
+	return challenge, nil
+}
+
+// readChallengeTxDomains verifies the home domain in the key of the first operation of
+// a challenge and the operations which follow it. Subsequent operations must be
+// manage_data operations sourced from the server account, except for a single
+// client_domain operation.
+func readChallengeTxDomains(challenge *Challenge, key string, operations []Operation, serverAccountID string, opts ChallengeTxOptions) error {
+	if !strings.HasSuffix(key, " auth") {
+		return errors.New("operation key should be the home domain followed by \" auth\"")
+	}
+	challenge.HomeDomain = strings.TrimSuffix(key, " auth")
+	matched := false
+	for _, homeDomain := range opts.HomeDomains {
+		if homeDomain == challenge.HomeDomain {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return errors.Errorf("operation key does not match any accepted home domain: %s", challenge.HomeDomain)
+	}
+
+	for _, operation := range operations {
+		op, ok := operation.(*ManageData)
+		if !ok {
+			return errors.New("operation type should be manage_data")
+		}
+		if op.SourceAccount == nil {
+			return errors.New("operation should have a source account")
+		}
+		if op.Name != ClientDomainOperationName {
+			if op.SourceAccount.GetAccountID() != serverAccountID {
+				return errors.New("subsequent operations are unrecognized")
+			}
+			continue
+		}
+		if challenge.ClientDomainAccountID != "" {
+			return errors.New("transaction has more than one client_domain operation")
+		}
+		if len(op.Value) == 0 {
+			return errors.New("client_domain operation should have a value")
+		}
+		challenge.ClientDomain = string(op.Value)
+		challenge.ClientDomainAccountID = op.SourceAccount.GetAccountID()
+	}
+	return nil
 }
 
 // VerifyChallengeTxThreshold verifies that for a SEP 10 challenge transaction
@@ -929,12 +1152,32 @@ func ReadChallengeTx(challengeTx, serverAccountID, network string) (tx *Transact
 //    server account or one of the signers provided in the arguments.
 //  - The signatures are all valid but do not meet the threshold.
 func VerifyChallengeTxThreshold(challengeTx, serverAccountID, network string, threshold Threshold, signerSummary SignerSummary) (signersFound []string, err error) {
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, nil)
+	if err != nil {
+		return nil, err
+	}
+	return verifyChallengeTxThreshold(challenge, serverAccountID, network, threshold, signerSummary)
+}
+
+// VerifyChallengeTxThresholdWithOptions is like VerifyChallengeTxThreshold but reads the
+// challenge with ReadChallengeTxWithOptions. If the challenge contains a client_domain
+// operation it must also be signed by the client domain account, which is not
+// included in the returned signers.
+func VerifyChallengeTxThresholdWithOptions(challengeTx, serverAccountID, network string, opts ChallengeTxOptions, threshold Threshold, signerSummary SignerSummary) ([]string, error) {
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, &opts)
+	if err != nil {
+		return nil, err
+	}
+	return verifyChallengeTxThreshold(challenge, serverAccountID, network, threshold, signerSummary)
+}
+
+func verifyChallengeTxThreshold(challenge Challenge, serverAccountID, network string, threshold Threshold, signerSummary SignerSummary) ([]string, error) {
 	signers := make([]string, 0, len(signerSummary))
 	for s := range signerSummary {
 		signers = append(signers, s)
 	}
 
-	signersFound, err = VerifyChallengeTxSigners(challengeTx, serverAccountID, network, signers...)
+	signersFound, err := verifyChallengeTxSigners(challenge, serverAccountID, network, signers...)
 	if err != nil {
 		return nil, err
 	}
@@ -969,10 +1212,27 @@ func VerifyChallengeTxThreshold(challengeTx, serverAccountID, network string, th
 //    server account or one of the signers provided in the arguments.
 func VerifyChallengeTxSigners(challengeTx, serverAccountID, network string, signers ...string) ([]string, error) {
 	// Read the transaction which validates its structure.
-	tx, _, err := ReadChallengeTx(challengeTx, serverAccountID, network)
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, nil)
+	if err != nil {
+		return nil, err
+	}
+	return verifyChallengeTxSigners(challenge, serverAccountID, network, signers...)
+}
+
+// VerifyChallengeTxSignersWithOptions is like VerifyChallengeTxSigners but reads the
+// challenge with ReadChallengeTxWithOptions. If the challenge contains a client_domain
+// operation it must also be signed by the client domain account, which is not
+// included in the returned signers.
+func VerifyChallengeTxSignersWithOptions(challengeTx, serverAccountID, network string, opts ChallengeTxOptions, signers ...string) ([]string, error) {
+	challenge, err := readChallengeTx(challengeTx, serverAccountID, network, &opts)
 	if err != nil {
 		return nil, err
 	}
+	return verifyChallengeTxSigners(challenge, serverAccountID, network, signers...)
+}
+
+func verifyChallengeTxSigners(challenge Challenge, serverAccountID, network string, signers ...string) ([]string, error) {
+	tx := challenge.Transaction
 
 	// Ensure the server account ID is an address and not a seed.
 	serverKP, err := keypair.ParseAddress(serverAccountID)
@@ -993,6 +1253,11 @@ func VerifyChallengeTxSigners(challengeTx, serverAccountID, network string, sign
 		if signer == serverKP.Address() {
 			continue
 		}
+		// The client domain account attributes the challenge to a wallet and
+		// does not authenticate the client either.
+		if signer == challenge.ClientDomainAccountID {
+			continue
+		}
 		// Deduplicate.
 		if _, seen := clientSignersSeen[signer]; seen {
 			continue
@@ -1014,24 +1279,34 @@ func VerifyChallengeTxSigners(challengeTx, serverAccountID, network string, sign
 		return nil, errors.New("no verifiable signers provided, at least one G... address must be provided")
 	}
 
-	// Verify all the transaction's signers (server and client) in one
-	// hit. We do this in one hit here even though the server signature was
-	// checked in the ReadChallengeTx to ensure that every signature and signer
-	// are consumed only once on the transaction.
-	allSigners := append([]string{serverKP.Address()}, clientSigners...)
+	// Verify all the transaction's signers (server, client domain and client)
+	// in one hit. We do this in one hit here even though the server signature
+	// was checked in the ReadChallengeTx to ensure that every signature and
+	// signer are consumed only once on the transaction.
+	allSigners := []string{serverKP.Address()}
+	if challenge.ClientDomainAccountID != "" {
+		allSigners = append(allSigners, challenge.ClientDomainAccountID)
+	}
+	allSigners = append(allSigners, clientSigners...)
 	allSignersFound, err := verifyTxSignatures(tx, network, allSigners...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Confirm the server is in the list of signers found and remove it.
+	// Confirm the server and client domain are in the list of signers found
+	// and remove them.
 	serverSignerFound := false
+	clientDomainSignerFound := false
 	signersFound := make([]string, 0, len(allSignersFound)-1)
 	for _, signer := range allSignersFound {
 		if signer == serverKP.Address() {
 			serverSignerFound = true
 			continue
 		}
+		if signer == challenge.ClientDomainAccountID {
+			clientDomainSignerFound = true
+			continue
+		}
 		signersFound = append(signersFound, signer)
 	}
 
@@ -1040,6 +1315,11 @@ func VerifyChallengeTxSigners(challengeTx, serverAccountID, network string, sign
 		return nil, errors.Errorf("transaction not signed by %s", serverKP.Address())
 	}
 
+	// Confirm we matched a signature to the client domain signer.
+	if challenge.ClientDomainAccountID != "" && !clientDomainSignerFound {
+		return nil, errors.Errorf("transaction not signed by client domain account %s", challenge.ClientDomainAccountID)
+	}
+
 	// Confirm we matched signatures to the client signers.
 	if len(signersFound) == 0 {
 		return nil, errors.Errorf("transaction not signed by %s", strings.Join(clientSigners, ", "))
@@ -1070,7 +1350,13 @@ func verifyTxSignatures(tx *Transaction, network string, signers ...string) ([]s
 	if err != nil {
 		return nil, err
 	}
+	return verifyHashSignatures(txHash, tx.Signatures(), signers...)
+}
 
+// verifyHashSignatures checks which of the signers have a signature for the
+// transaction hash in the list of decorated signatures. Each signature is
+// consumed by at most one signer.
+func verifyHashSignatures(txHash [32]byte, signatures []xdr.DecoratedSignature, signers ...string) ([]string, error) {
 	// find and verify signatures
 	signatureUsed := map[int]bool{}
 	signersFound := map[string]struct{}{}
@@ -1080,7 +1366,7 @@ func verifyTxSignatures(tx *Transaction, network string, signers ...string) ([]s
 			return nil, errors.Wrap(err, "signer not address")
 		}
 
-		for i, decSig := range tx.Signatures() {
+		for i, decSig := range signatures {
 			if signatureUsed[i] {
 				continue
 			}
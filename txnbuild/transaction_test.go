@@ -2945,3 +2945,176 @@ func TestVerifyTxSignatureInvalid(t *testing.T) {
 		assert.Contains(t, err.Error(), "transaction not signed by GATBMIXTHXYKSUZSZUEJKACZ2OS2IYUWP2AIF3CA32PIDLJ67CH6Y5UY")
 	}
 }
+
+func TestNewChallengeTx_clientDomain(t *testing.T) {
+	serverKP := newKeypair0()
+	clientKP := newKeypair1()
+	clientDomainKP := newKeypair2()
+	tx, err := NewChallengeTx(ChallengeTxParams{
+		ServerSignerSecret:    serverKP.Seed(),
+		ClientAccountID:       clientKP.Address(),
+		HomeDomain:            "anchor.example.com",
+		Network:               network.TestNetworkPassphrase,
+		Timebound:             time.Minute,
+		ClientDomain:          "wallet.example.com",
+		ClientDomainAccountID: clientDomainKP.Address(),
+	})
+	require.NoError(t, err)
+
+	ops := tx.Operations()
+	require.Len(t, ops, 2)
+	assert.Equal(t, "anchor.example.com auth", ops[0].(*ManageData).Name)
+	assert.Equal(t, clientKP.Address(), ops[0].GetSourceAccount().GetAccountID())
+	assert.Equal(t, ClientDomainOperationName, ops[1].(*ManageData).Name)
+	assert.Equal(t, []byte("wallet.example.com"), ops[1].(*ManageData).Value)
+	assert.Equal(t, clientDomainKP.Address(), ops[1].GetSourceAccount().GetAccountID())
+
+	_, err = NewChallengeTx(ChallengeTxParams{
+		ServerSignerSecret: serverKP.Seed(),
+		ClientAccountID:    clientKP.Address(),
+		HomeDomain:         "anchor.example.com",
+		Network:            network.TestNetworkPassphrase,
+		Timebound:          time.Minute,
+		ClientDomain:       "wallet.example.com",
+	})
+	assert.Contains(t, err.Error(), "is not a valid client domain account id")
+}
+
+func TestReadChallengeTxWithOptions_homeDomains(t *testing.T) {
+	serverKP := newKeypair0()
+	clientKP := newKeypair1()
+	tx, err := BuildChallengeTx(serverKP.Seed(), clientKP.Address(), "anchor2.example.com", network.TestNetworkPassphrase, time.Minute)
+	require.NoError(t, err)
+	tx64, err := tx.Base64()
+	require.NoError(t, err)
+
+	challenge, err := ReadChallengeTxWithOptions(tx64, serverKP.Address(), network.TestNetworkPassphrase, ChallengeTxOptions{
+		HomeDomains: []string{"anchor1.example.com", "anchor2.example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, clientKP.Address(), challenge.ClientAccountID)
+	assert.Equal(t, "anchor2.example.com", challenge.HomeDomain)
+	assert.Empty(t, challenge.ClientDomain)
+
+	// a list of home domains is required
+	_, err = ReadChallengeTxWithOptions(tx64, serverKP.Address(), network.TestNetworkPassphrase, ChallengeTxOptions{})
+	assert.EqualError(t, err, "at least one accepted home domain is required")
+	_, err = VerifyChallengeTxSignersWithOptions(tx64, serverKP.Address(), network.TestNetworkPassphrase, ChallengeTxOptions{HomeDomains: []string{}}, clientKP.Address())
+	assert.EqualError(t, err, "at least one accepted home domain is required")
+	_, err = VerifyChallengeTxThresholdWithOptions(tx64, serverKP.Address(), network.TestNetworkPassphrase, ChallengeTxOptions{}, Threshold(1), SignerSummary{clientKP.Address(): 1})
+	assert.EqualError(t, err, "at least one accepted home domain is required")
+
+	_, err = ReadChallengeTxWithOptions(tx64, serverKP.Address(), network.TestNetworkPassphrase, ChallengeTxOptions{
+		HomeDomains: []string{"anchor1.example.com"},
+	})
+	assert.EqualError(t, err, "operation key does not match any accepted home domain: anchor2.example.com")
+}
+
+func TestReadChallengeTxWithOptions_subsequentOperations(t *testing.T) {
+	serverKP := newKeypair0()
+	clientKP := newKeypair1()
+	clientDomainKP := newKeypair2()
+	txSource := NewSimpleAccount(serverKP.Address(), -1)
+	nonce := []byte(base64.StdEncoding.EncodeToString(make([]byte, 48)))
+	authOp := &ManageData{
+		SourceAccount: &SimpleAccount{AccountID: clientKP.Address()},
+		Name:          "testserver auth",
+		Value:         nonce,
+	}
+	serverOp := &ManageData{
+		SourceAccount: &SimpleAccount{AccountID: serverKP.Address()},
+		Name:          "web_auth_domain",
+		Value:         []byte("auth.example.com"),
+	}
+	clientDomainOp := &ManageData{
+		SourceAccount: &SimpleAccount{AccountID: clientDomainKP.Address()},
+		Name:          ClientDomainOperationName,
+		Value:         []byte("wallet.example.com"),
+	}
+
+	newChallenge := func(ops ...Operation) string {
+		txSource.Sequence = -1
+		tx64, err := newSignedTransaction(
+			TransactionParams{
+				SourceAccount:        &txSource,
+				IncrementSequenceNum: true,
+				Operations:           ops,
+				BaseFee:              MinBaseFee,
+				Timebounds:           NewTimeout(1000),
+			},
+			network.TestNetworkPassphrase,
+			serverKP,
+		)
+		require.NoError(t, err)
+		return tx64
+	}
+
+	opts := ChallengeTxOptions{HomeDomains: []string{"testserver"}}
+	challenge, err := ReadChallengeTxWithOptions(newChallenge(authOp, serverOp, clientDomainOp), serverKP.Address(), network.TestNetworkPassphrase, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "testserver", challenge.HomeDomain)
+	assert.Equal(t, "wallet.example.com", challenge.ClientDomain)
+	assert.Equal(t, clientDomainKP.Address(), challenge.ClientDomainAccountID)
+
+	// the legacy reader still requires a single operation
+	_, _, err = ReadChallengeTx(newChallenge(authOp, clientDomainOp), serverKP.Address(), network.TestNetworkPassphrase)
+	assert.EqualError(t, err, "transaction requires a single manage_data operation")
+
+	clientOp := &ManageData{
+		SourceAccount: &SimpleAccount{AccountID: clientKP.Address()},
+		Name:          "other",
+		Value:         []byte("value"),
+	}
+	_, err = ReadChallengeTxWithOptions(newChallenge(authOp, clientOp), serverKP.Address(), network.TestNetworkPassphrase, opts)
+	assert.EqualError(t, err, "subsequent operations are unrecognized")
+
+	_, err = ReadChallengeTxWithOptions(newChallenge(authOp, clientDomainOp, clientDomainOp), serverKP.Address(), network.TestNetworkPassphrase, opts)
+	assert.EqualError(t, err, "transaction has more than one client_domain operation")
+
+	_, err = ReadChallengeTxWithOptions(newChallenge(authOp, &BumpSequence{BumpTo: 1}), serverKP.Address(), network.TestNetworkPassphrase, opts)
+	assert.EqualError(t, err, "operation type should be manage_data")
+}
+
+func TestVerifyChallengeTxWithOptions_clientDomain(t *testing.T) {
+	serverKP := newKeypair0()
+	clientKP := newKeypair1()
+	clientDomainKP := newKeypair2()
+	opts := ChallengeTxOptions{HomeDomains: []string{"anchor.example.com"}}
+	tx, err := NewChallengeTx(ChallengeTxParams{
+		ServerSignerSecret:    serverKP.Seed(),
+		ClientAccountID:       clientKP.Address(),
+		HomeDomain:            "anchor.example.com",
+		Network:               network.TestNetworkPassphrase,
+		Timebound:             time.Minute,
+		ClientDomain:          "wallet.example.com",
+		ClientDomainAccountID: clientDomainKP.Address(),
+	})
+	require.NoError(t, err)
+
+	clientSigned, err := tx.Sign(network.TestNetworkPassphrase, clientKP)
+	require.NoError(t, err)
+	clientSigned64, err := clientSigned.Base64()
+	require.NoError(t, err)
+	_, err = VerifyChallengeTxSignersWithOptions(clientSigned64, serverKP.Address(), network.TestNetworkPassphrase, opts, clientKP.Address())
+	assert.EqualError(t, err, "transaction not signed by client domain account "+clientDomainKP.Address())
+
+	fullySigned, err := clientSigned.Sign(network.TestNetworkPassphrase, clientDomainKP)
+	require.NoError(t, err)
+	fullySigned64, err := fullySigned.Base64()
+	require.NoError(t, err)
+
+	signersFound, err := VerifyChallengeTxSignersWithOptions(fullySigned64, serverKP.Address(), network.TestNetworkPassphrase, opts, clientKP.Address())
+	require.NoError(t, err)
+	assert.Equal(t, []string{clientKP.Address()}, signersFound)
+
+	// the client domain account cannot authenticate the client
+	_, err = VerifyChallengeTxSignersWithOptions(fullySigned64, serverKP.Address(), network.TestNetworkPassphrase, opts, clientDomainKP.Address())
+	assert.EqualError(t, err, "no verifiable signers provided, at least one G... address must be provided")
+
+	signersFound, err = VerifyChallengeTxThresholdWithOptions(fullySigned64, serverKP.Address(), network.TestNetworkPassphrase, opts, Threshold(1), SignerSummary{clientKP.Address(): 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{clientKP.Address()}, signersFound)
+
+	_, err = VerifyChallengeTxThresholdWithOptions(fullySigned64, serverKP.Address(), network.TestNetworkPassphrase, opts, Threshold(2), SignerSummary{clientKP.Address(): 1})
+	assert.EqualError(t, err, "signers with weight 1 do not meet threshold 2")
+}
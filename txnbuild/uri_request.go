@@ -0,0 +1,352 @@
+package txnbuild
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/errors"
+)
+
+// URIScheme is the scheme of SEP-7 URIs.
+// See https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0007.md
+const URIScheme = "web+stellar:"
+
+// URIOperationTx and URIOperationPay enumerate the operations of SEP-7 URIs.
+const (
+	URIOperationTx  = "tx"
+	URIOperationPay = "pay"
+)
+
+// URIMsgMaxLength is the maximum length of the msg parameter of a SEP-7 URI.
+const URIMsgMaxLength = 300
+
+const uriSignaturePrefix = "stellar.sep.7 - URI Scheme"
+
+// URIRequest is a SEP-7 request asking a wallet to sign a transaction (a "tx" URI)
+// or to make a payment (a "pay" URI). Use NewTransactionURIRequest or
+// NewPayURIRequest to create a request and ParseURIRequest to decode one.
+type URIRequest struct {
+	// Operation is URIOperationTx or URIOperationPay.
+	Operation string
+
+	// XDR is the base64 encoded transaction envelope of a tx request.
+	XDR string
+	// PubKey is the account which should sign the transaction of a tx request.
+	PubKey string
+
+	// Destination, Amount, Asset and Memo describe the payment of a pay request. A
+	// nil Asset is the native asset and an empty Amount lets the user choose.
+	Destination string
+	Amount      string
+	Asset       Asset
+	Memo        Memo
+
+	// Callback is the URL the signed transaction is POSTed to instead of being
+	// submitted to the network by the wallet.
+	Callback string
+	// Msg is a message shown to the user, at most URIMsgMaxLength characters long.
+	Msg               string
+	NetworkPassphrase string
+	// OriginDomain is the domain whose URI_REQUEST_SIGNING_KEY signed the request.
+	OriginDomain string
+	// Signature is the base64 encoded signature of the request, set by Sign.
+	Signature string
+}
+
+// NewTransactionURIRequest returns a tx request for tx. pubKey is the account which
+// should sign the transaction and may be empty.
+func NewTransactionURIRequest(tx *Transaction, pubKey string) (*URIRequest, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is missing")
+	}
+	if pubKey != "" {
+		if err := validateStellarPublicKey(pubKey); err != nil {
+			return nil, errors.Wrap(err, "invalid pubkey")
+		}
+	}
+	txeB64, err := tx.Base64()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode transaction")
+	}
+	return &URIRequest{Operation: URIOperationTx, XDR: txeB64, PubKey: pubKey}, nil
+}
+
+// NewPayURIRequest returns a pay request of amount of asset to destination. A nil
+// asset requests a payment in lumens and memo may be nil.
+func NewPayURIRequest(destination, amount string, asset Asset, memo Memo) (*URIRequest, error) {
+	if err := validateStellarPublicKey(destination); err != nil {
+		return nil, errors.Wrap(err, "invalid destination")
+	}
+	if amount != "" {
+		if err := validateAmount(amount); err != nil {
+			return nil, errors.Wrap(err, "invalid amount")
+		}
+	}
+	if asset != nil {
+		if err := validateStellarAsset(asset); err != nil {
+			return nil, errors.Wrap(err, "invalid asset")
+		}
+	}
+	return &URIRequest{
+		Operation:   URIOperationPay,
+		Destination: destination,
+		Amount:      amount,
+		Asset:       asset,
+		Memo:        memo,
+	}, nil
+}
+
+// Transaction returns the transaction of a tx request.
+func (r *URIRequest) Transaction() (*GenericTransaction, error) {
+	if r.Operation != URIOperationTx {
+		return nil, errors.Errorf("%s request has no transaction", r.Operation)
+	}
+	return TransactionFromXDR(r.XDR)
+}
+
+// Sign signs the request with kp, which must be the URI_REQUEST_SIGNING_KEY of
+// OriginDomain, and sets Signature.
+func (r *URIRequest) Sign(kp *keypair.Full) error {
+	if r.OriginDomain == "" {
+		return errors.New("origin domain is required to sign a request")
+	}
+	unsigned, err := r.encode()
+	if err != nil {
+		return err
+	}
+	sig, err := kp.Sign(uriSignaturePayload(unsigned))
+	if err != nil {
+		return errors.Wrap(err, "failed to sign request")
+	}
+	r.Signature = base64.StdEncoding.EncodeToString(sig)
+	return nil
+}
+
+// String returns the SEP-7 URI of the request.
+func (r *URIRequest) String() (string, error) {
+	uri, err := r.encode()
+	if err != nil {
+		return "", err
+	}
+	if r.Signature != "" {
+		uri += "&signature=" + url.QueryEscape(r.Signature)
+	}
+	return uri, nil
+}
+
+// encode returns the URI of the request without the signature parameter.
+func (r *URIRequest) encode() (string, error) {
+	if len(r.Msg) > URIMsgMaxLength {
+		return "", errors.Errorf("msg must be at most %d characters long", URIMsgMaxLength)
+	}
+
+	var params uriParams
+	switch r.Operation {
+	case URIOperationTx:
+		if r.XDR == "" {
+			return "", errors.New("xdr is required")
+		}
+		params.add("xdr", r.XDR)
+		params.add("pubkey", r.PubKey)
+	case URIOperationPay:
+		if r.Destination == "" {
+			return "", errors.New("destination is required")
+		}
+		params.add("destination", r.Destination)
+		params.add("amount", r.Amount)
+		if r.Asset != nil && !r.Asset.IsNative() {
+			params.add("asset_code", r.Asset.GetCode())
+			params.add("asset_issuer", r.Asset.GetIssuer())
+		}
+		if r.Memo != nil {
+			memoType, memo, err := encodeURIMemo(r.Memo)
+			if err != nil {
+				return "", err
+			}
+			params.add("memo", memo)
+			params.add("memo_type", memoType)
+		}
+	default:
+		return "", errors.Errorf("unknown operation %q", r.Operation)
+	}
+
+	if r.Callback != "" {
+		params.add("callback", "url:"+r.Callback)
+	}
+	params.add("msg", r.Msg)
+	params.add("network_passphrase", r.NetworkPassphrase)
+	params.add("origin_domain", r.OriginDomain)
+	return URIScheme + r.Operation + "?" + params.encode(), nil
+}
+
+// ParseURIRequest decodes a SEP-7 URI. The signature is not verified, use
+// VerifyURIRequest or VerifyURIRequestSignature for signed requests.
+func ParseURIRequest(uri string) (*URIRequest, error) {
+	if !strings.HasPrefix(uri, URIScheme) {
+		return nil, errors.Errorf("uri must start with %s", URIScheme)
+	}
+	operation, query := strings.TrimPrefix(uri, URIScheme), ""
+	if i := strings.IndexByte(operation, '?'); i >= 0 {
+		operation, query = operation[:i], operation[i+1:]
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse uri parameters")
+	}
+
+	r := &URIRequest{
+		Operation:         operation,
+		Msg:               values.Get("msg"),
+		NetworkPassphrase: values.Get("network_passphrase"),
+		OriginDomain:      values.Get("origin_domain"),
+		Signature:         values.Get("signature"),
+	}
+	if callback := values.Get("callback"); callback != "" {
+		if !strings.HasPrefix(callback, "url:") {
+			return nil, errors.Errorf("unsupported callback %q", callback)
+		}
+		r.Callback = strings.TrimPrefix(callback, "url:")
+	}
+
+	switch operation {
+	case URIOperationTx:
+		r.XDR = values.Get("xdr")
+		r.PubKey = values.Get("pubkey")
+		if r.XDR == "" {
+			return nil, errors.New("xdr is required")
+		}
+	case URIOperationPay:
+		r.Destination = values.Get("destination")
+		r.Amount = values.Get("amount")
+		if r.Destination == "" {
+			return nil, errors.New("destination is required")
+		}
+		if code := values.Get("asset_code"); code != "" {
+			r.Asset = CreditAsset{Code: code, Issuer: values.Get("asset_issuer")}
+		}
+		if memo := values.Get("memo"); memo != "" {
+			r.Memo, err = decodeURIMemo(values.Get("memo_type"), memo)
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errors.Errorf("unknown operation %q", operation)
+	}
+	return r, nil
+}
+
+// VerifyURIRequestSignature verifies that uri is signed by signingKey.
+func VerifyURIRequestSignature(uri, signingKey string) error {
+	i := strings.LastIndex(uri, "&signature=")
+	if i < 0 {
+		return errors.New("uri is not signed")
+	}
+	sig, err := url.QueryUnescape(uri[i+len("&signature="):])
+	if err != nil {
+		return errors.Wrap(err, "could not unescape signature")
+	}
+	sigBytes, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return errors.Wrap(err, "could not decode signature")
+	}
+
+	kp, err := keypair.ParseAddress(signingKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid signing key")
+	}
+	if err = kp.Verify(uriSignaturePayload(uri[:i]), sigBytes); err != nil {
+		return errors.Wrap(err, "signature is not valid")
+	}
+	return nil
+}
+
+// VerifyURIRequest parses uri and verifies its signature against the
+// URI_REQUEST_SIGNING_KEY published in the stellar.toml of its origin domain.
+func VerifyURIRequest(uri string, client stellartoml.ClientInterface) (*URIRequest, error) {
+	r, err := ParseURIRequest(uri)
+	if err != nil {
+		return nil, err
+	}
+	if r.OriginDomain == "" {
+		return nil, errors.New("uri has no origin domain")
+	}
+
+	toml, err := client.GetStellarToml(r.OriginDomain)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch stellar.toml of %s", r.OriginDomain)
+	}
+	if toml.URIRequestSigningKey == "" {
+		return nil, errors.Errorf("stellar.toml of %s has no URI_REQUEST_SIGNING_KEY", r.OriginDomain)
+	}
+	if err = VerifyURIRequestSignature(uri, toml.URIRequestSigningKey); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// uriSignaturePayload returns the payload signed by the signature of a URI: 35 zero
+// bytes and a 4, followed by the prefix and the URI without its signature.
+func uriSignaturePayload(unsigned string) []byte {
+	payload := make([]byte, 36, 36+len(uriSignaturePrefix)+len(unsigned))
+	payload[35] = 4
+	payload = append(payload, uriSignaturePrefix...)
+	return append(payload, unsigned...)
+}
+
+func encodeURIMemo(memo Memo) (string, string, error) {
+	switch m := memo.(type) {
+	case MemoText:
+		return "MEMO_TEXT", string(m), nil
+	case MemoID:
+		return "MEMO_ID", strconv.FormatUint(uint64(m), 10), nil
+	case MemoHash:
+		return "MEMO_HASH", base64.StdEncoding.EncodeToString(m[:]), nil
+	case MemoReturn:
+		return "MEMO_RETURN", base64.StdEncoding.EncodeToString(m[:]), nil
+	}
+	return "", "", errors.Errorf("unknown memo type %T", memo)
+}
+
+func decodeURIMemo(memoType, memo string) (Memo, error) {
+	switch memoType {
+	case "", "MEMO_TEXT":
+		return MemoText(memo), nil
+	case "MEMO_ID":
+		id, err := strconv.ParseUint(memo, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid id memo")
+		}
+		return MemoID(id), nil
+	case "MEMO_HASH", "MEMO_RETURN":
+		var hash [32]byte
+		b, err := base64.StdEncoding.DecodeString(memo)
+		if err != nil || len(b) != len(hash) {
+			return nil, errors.Errorf("%s must be a base64 encoded 32 byte value", memoType)
+		}
+		copy(hash[:], b)
+		if memoType == "MEMO_HASH" {
+			return MemoHash(hash), nil
+		}
+		return MemoReturn(hash), nil
+	}
+	return nil, errors.Errorf("unknown memo type %q", memoType)
+}
+
+// uriParams encodes URI parameters in the order they are added, skipping empty
+// values, so that the signed URI is deterministic.
+type uriParams []string
+
+func (p *uriParams) add(key, value string) {
+	if value != "" {
+		*p = append(*p, key+"="+url.QueryEscape(value))
+	}
+}
+
+func (p uriParams) encode() string {
+	return strings.Join(p, "&")
+}
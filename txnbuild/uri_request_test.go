@@ -0,0 +1,144 @@
+package txnbuild
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionURIRequest(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	tx := newSignatureRequestTx(t, &BumpSequence{BumpTo: 5})
+
+	request, err := NewTransactionURIRequest(tx, kp0.Address())
+	require.NoError(t, err)
+	request.Callback = "https://example.com/callback?id=1"
+	request.Msg = "order #24 & more"
+	request.NetworkPassphrase = network.TestNetworkPassphrase
+	request.OriginDomain = "example.com"
+	require.NoError(t, request.Sign(kp1))
+
+	uri, err := request.String()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(uri, "web+stellar:tx?xdr="))
+	assert.Contains(t, uri, "&callback=url%3Ahttps%3A%2F%2Fexample.com%2Fcallback%3Fid%3D1&")
+	assert.Contains(t, uri, "&msg=order+%2324+%26+more&")
+	assert.Contains(t, uri, "&origin_domain=example.com&signature=")
+
+	parsed, err := ParseURIRequest(uri)
+	require.NoError(t, err)
+	assert.Equal(t, request, parsed)
+
+	genericTx, err := parsed.Transaction()
+	require.NoError(t, err)
+	parsedTx, ok := genericTx.Transaction()
+	require.True(t, ok)
+	assert.Equal(t, tx.Operations(), parsedTx.Operations())
+
+	require.NoError(t, VerifyURIRequestSignature(uri, kp1.Address()))
+	assert.Error(t, VerifyURIRequestSignature(uri, kp0.Address()))
+
+	tampered := strings.Replace(uri, "example.com", "example.org", 1)
+	assert.Error(t, VerifyURIRequestSignature(tampered, kp1.Address()))
+}
+
+func TestPayURIRequest(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	usd := CreditAsset{Code: "USD", Issuer: kp1.Address()}
+	hash := MemoHash{1, 2, 3}
+
+	request, err := NewPayURIRequest(kp0.Address(), "120.1234567", usd, hash)
+	require.NoError(t, err)
+	uri, err := request.String()
+	require.NoError(t, err)
+	assert.Equal(t, "web+stellar:pay?destination="+kp0.Address()+
+		"&amount=120.1234567&asset_code=USD&asset_issuer="+kp1.Address()+
+		"&memo=AQIDAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA%3D&memo_type=MEMO_HASH", uri)
+
+	parsed, err := ParseURIRequest(uri)
+	require.NoError(t, err)
+	assert.Equal(t, request, parsed)
+
+	for _, memo := range []Memo{MemoText("hello world"), MemoID(42), MemoReturn{4}} {
+		request, err = NewPayURIRequest(kp0.Address(), "", nil, memo)
+		require.NoError(t, err)
+		uri, err = request.String()
+		require.NoError(t, err)
+		parsed, err = ParseURIRequest(uri)
+		require.NoError(t, err)
+		assert.Equal(t, request, parsed)
+	}
+
+	_, err = NewPayURIRequest("GABC", "1", nil, nil)
+	assert.Error(t, err)
+	_, err = NewPayURIRequest(kp0.Address(), "-1", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestURIRequestErrors(t *testing.T) {
+	kp0 := newKeypair0()
+
+	_, err := ParseURIRequest("https://example.com")
+	assert.EqualError(t, err, "uri must start with web+stellar:")
+	_, err = ParseURIRequest("web+stellar:sign?xdr=AAAA")
+	assert.EqualError(t, err, `unknown operation "sign"`)
+	_, err = ParseURIRequest("web+stellar:tx?pubkey=" + kp0.Address())
+	assert.EqualError(t, err, "xdr is required")
+	_, err = ParseURIRequest("web+stellar:pay?destination=" + kp0.Address() + "&memo=1&memo_type=MEMO_FOO")
+	assert.EqualError(t, err, `unknown memo type "MEMO_FOO"`)
+
+	request, err := NewPayURIRequest(kp0.Address(), "1", nil, nil)
+	require.NoError(t, err)
+	assert.EqualError(t, request.Sign(kp0), "origin domain is required to sign a request")
+
+	request.Msg = strings.Repeat("a", URIMsgMaxLength+1)
+	_, err = request.String()
+	assert.EqualError(t, err, "msg must be at most 300 characters long")
+
+	assert.EqualError(t, VerifyURIRequestSignature("web+stellar:pay?destination="+kp0.Address(), kp0.Address()), "uri is not signed")
+}
+
+func TestVerifyURIRequest(t *testing.T) {
+	kp0, kp1 := newKeypair0(), newKeypair1()
+	request, err := NewPayURIRequest(kp0.Address(), "10", nil, MemoText("invoice"))
+	require.NoError(t, err)
+	request.OriginDomain = "example.com"
+	require.NoError(t, request.Sign(kp1))
+	uri, err := request.String()
+	require.NoError(t, err)
+
+	client := &stellartoml.MockClient{}
+	client.On("GetStellarToml", "example.com").
+		Return(&stellartoml.Response{URIRequestSigningKey: kp1.Address()}, nil).Once()
+	verified, err := VerifyURIRequest(uri, client)
+	require.NoError(t, err)
+	assert.Equal(t, request, verified)
+
+	client.On("GetStellarToml", "example.com").
+		Return(&stellartoml.Response{URIRequestSigningKey: kp0.Address()}, nil).Once()
+	_, err = VerifyURIRequest(uri, client)
+	assert.Contains(t, err.Error(), "signature is not valid")
+
+	client.On("GetStellarToml", "example.com").
+		Return(&stellartoml.Response{}, nil).Once()
+	_, err = VerifyURIRequest(uri, client)
+	assert.EqualError(t, err, "stellar.toml of example.com has no URI_REQUEST_SIGNING_KEY")
+
+	client.On("GetStellarToml", "example.com").
+		Return((*stellartoml.Response)(nil), errors.New("not found")).Once()
+	_, err = VerifyURIRequest(uri, client)
+	assert.EqualError(t, err, "could not fetch stellar.toml of example.com: not found")
+	client.AssertExpectations(t)
+
+	request.OriginDomain = ""
+	request.Signature = ""
+	uri, err = request.String()
+	require.NoError(t, err)
+	_, err = VerifyURIRequest(uri, client)
+	assert.EqualError(t, err, "uri has no origin domain")
+}
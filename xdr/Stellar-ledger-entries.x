@@ -78,7 +78,8 @@ enum LedgerEntryType
     ACCOUNT = 0,
     TRUSTLINE = 1,
     OFFER = 2,
-    DATA = 3
+    DATA = 3,
+    CLAIMABLE_BALANCE = 4
 };
 
 struct Signer
@@ -104,6 +105,39 @@ enum AccountFlags
 // mask for all valid flags
 const MASK_ACCOUNT_FLAGS = 0x7;
 
+// maximum number of signers
+const MAX_SIGNERS = 20;
+
+typedef AccountID* SponsorshipDescriptor;
+
+struct AccountEntryExtensionV2
+{
+    uint32 numSponsored;
+    uint32 numSponsoring;
+    SponsorshipDescriptor signerSponsoringIDs<MAX_SIGNERS>;
+
+    union switch (int v)
+    {
+    case 0:
+        void;
+    }
+    ext;
+};
+
+struct AccountEntryExtensionV1
+{
+    Liabilities liabilities;
+
+    union switch (int v)
+    {
+    case 0:
+        void;
+    case 2:
+        AccountEntryExtensionV2 v2;
+    }
+    ext;
+};
+
 /* AccountEntry
 
     Main entry representing a user in Stellar. All transactions are
@@ -128,7 +162,7 @@ struct AccountEntry
     // thresholds stores unsigned bytes: [weight of master|low|medium|high]
     Thresholds thresholds;
 
-    Signer signers<20>; // possible signers for this account
+    Signer signers<MAX_SIGNERS>; // possible signers for this account
 
     // reserved for future use
     union switch (int v)
@@ -136,17 +170,7 @@ struct AccountEntry
     case 0:
         void;
     case 1:
-        struct
-        {
-            Liabilities liabilities;
-
-            union switch (int v)
-            {
-            case 0:
-                void;
-            }
-            ext;
-        } v1;
+        AccountEntryExtensionV1 v1;
     }
     ext;
 };
@@ -260,6 +284,94 @@ struct DataEntry
     ext;
 };
 
+enum ClaimPredicateType
+{
+    CLAIM_PREDICATE_UNCONDITIONAL = 0,
+    CLAIM_PREDICATE_AND = 1,
+    CLAIM_PREDICATE_OR = 2,
+    CLAIM_PREDICATE_NOT = 3,
+    CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME = 4,
+    CLAIM_PREDICATE_BEFORE_RELATIVE_TIME = 5
+};
+
+union ClaimPredicate switch (ClaimPredicateType type)
+{
+case CLAIM_PREDICATE_UNCONDITIONAL:
+    void;
+case CLAIM_PREDICATE_AND:
+    ClaimPredicate andPredicates<2>;
+case CLAIM_PREDICATE_OR:
+    ClaimPredicate orPredicates<2>;
+case CLAIM_PREDICATE_NOT:
+    ClaimPredicate* notPredicate;
+case CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME:
+    int64 absBefore; // Predicate will be true if closeTime < absBefore
+case CLAIM_PREDICATE_BEFORE_RELATIVE_TIME:
+    int64 relBefore; // Seconds since closeTime of the ledger in which the
+                     // ClaimableBalanceEntry was created
+};
+
+enum ClaimantType
+{
+    CLAIMANT_TYPE_V0 = 0
+};
+
+union Claimant switch (ClaimantType type)
+{
+case CLAIMANT_TYPE_V0:
+    struct
+    {
+        AccountID destination;    // The account that can use this condition
+        ClaimPredicate predicate; // Claimable if predicate is true
+    } v0;
+};
+
+enum ClaimableBalanceIDType
+{
+    CLAIMABLE_BALANCE_ID_TYPE_V0 = 0
+};
+
+union ClaimableBalanceID switch (ClaimableBalanceIDType type)
+{
+case CLAIMABLE_BALANCE_ID_TYPE_V0:
+    Hash v0;
+};
+
+struct ClaimableBalanceEntry
+{
+    // Unique identifier for this ClaimableBalanceEntry
+    ClaimableBalanceID balanceID;
+
+    // List of claimants with associated predicate
+    Claimant claimants<10>;
+
+    // Any asset including native
+    Asset asset;
+
+    // Amount of asset
+    int64 amount;
+
+    // reserved for future use
+    union switch (int v)
+    {
+    case 0:
+        void;
+    }
+    ext;
+};
+
+struct LedgerEntryExtensionV1
+{
+    SponsorshipDescriptor sponsoringID;
+
+    union switch (int v)
+    {
+    case 0:
+        void;
+    }
+    ext;
+};
+
 struct LedgerEntry
 {
     uint32 lastModifiedLedgerSeq; // ledger the LedgerEntry was last changed
@@ -274,6 +386,8 @@ struct LedgerEntry
         OfferEntry offer;
     case DATA:
         DataEntry data;
+    case CLAIMABLE_BALANCE:
+        ClaimableBalanceEntry claimableBalance;
     }
     data;
 
@@ -282,6 +396,8 @@ struct LedgerEntry
     {
     case 0:
         void;
+    case 1:
+        LedgerEntryExtensionV1 v1;
     }
     ext;
 };
@@ -296,6 +412,7 @@ enum EnvelopeType
     ENVELOPE_TYPE_TX = 2,
     ENVELOPE_TYPE_AUTH = 3,
     ENVELOPE_TYPE_SCPVALUE = 4,
-    ENVELOPE_TYPE_TX_FEE_BUMP = 5
+    ENVELOPE_TYPE_TX_FEE_BUMP = 5,
+    ENVELOPE_TYPE_OP_ID = 6
 };
 }
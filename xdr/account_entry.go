@@ -28,3 +28,53 @@ func (a *AccountEntry) ThresholdMedium() byte {
 func (a *AccountEntry) ThresholdHigh() byte {
 	return a.Thresholds.ThresholdHigh()
 }
+
+// SignerSponsoringIDs returns the sponsors of the account signers, in the same
+// order as Signers. Unsponsored signers have a nil sponsor.
+func (a *AccountEntry) SignerSponsoringIDs() []SponsorshipDescriptor {
+	if v2, ok := a.extensionV2(); ok {
+		return v2.SignerSponsoringIDs
+	}
+	return make([]SponsorshipDescriptor, len(a.Signers))
+}
+
+// SponsorPerSigner returns the sponsor of each sponsored signer, keyed by the
+// signer address.
+func (a *AccountEntry) SponsorPerSigner() map[string]AccountId {
+	ids := a.SignerSponsoringIDs()
+	signerToSponsor := map[string]AccountId{}
+
+	for i, signer := range a.Signers {
+		if i < len(ids) && ids[i] != nil {
+			signerToSponsor[signer.Key.Address()] = *ids[i]
+		}
+	}
+
+	return signerToSponsor
+}
+
+// NumSponsored returns the number of reserves of the account sponsored by
+// other accounts.
+func (a *AccountEntry) NumSponsored() Uint32 {
+	if v2, ok := a.extensionV2(); ok {
+		return v2.NumSponsored
+	}
+	return 0
+}
+
+// NumSponsoring returns the number of reserves the account sponsors for other
+// accounts.
+func (a *AccountEntry) NumSponsoring() Uint32 {
+	if v2, ok := a.extensionV2(); ok {
+		return v2.NumSponsoring
+	}
+	return 0
+}
+
+func (a *AccountEntry) extensionV2() (AccountEntryExtensionV2, bool) {
+	v1, ok := a.Ext.GetV1()
+	if !ok {
+		return AccountEntryExtensionV2{}, false
+	}
+	return v1.Ext.GetV2()
+}
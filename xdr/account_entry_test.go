@@ -48,3 +48,45 @@ func signer(address string, weight int) (ret Signer) {
 	ret.Weight = Uint32(weight)
 	return
 }
+
+var _ = Describe("xdr.AccountEntry sponsorship", func() {
+	const (
+		address = "GCR22L3WS7TP72S4Z27YTO6JIQYDJK2KLS2TQNHK6Y7XYPA3AGT3X4FH"
+		signer  = "GBFLTCDLOE6YQ74B66RH3S2UW5I2MKZ5VLTM75F4YMIWUIXRIFVNRNIF"
+		sponsor = "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"
+	)
+
+	It("has no sponsorship without the v2 extension", func() {
+		account := AccountEntry{AccountId: MustAddress(address)}
+		Expect(account.NumSponsored()).To(Equal(Uint32(0)))
+		Expect(account.NumSponsoring()).To(Equal(Uint32(0)))
+		Expect(account.SponsorPerSigner()).To(BeEmpty())
+	})
+
+	It("reads the v2 extension", func() {
+		sponsorID := MustAddress(sponsor)
+		account := AccountEntry{
+			AccountId: MustAddress(address),
+			Signers: []Signer{
+				{Key: MustSigner(address), Weight: 1},
+				{Key: MustSigner(signer), Weight: 1},
+			},
+			Ext: AccountEntryExt{
+				V: 1,
+				V1: &AccountEntryExtensionV1{
+					Ext: AccountEntryExtensionV1Ext{
+						V: 2,
+						V2: &AccountEntryExtensionV2{
+							NumSponsored:        2,
+							NumSponsoring:       3,
+							SignerSponsoringIDs: []SponsorshipDescriptor{nil, &sponsorID},
+						},
+					},
+				},
+			},
+		}
+		Expect(account.NumSponsored()).To(Equal(Uint32(2)))
+		Expect(account.NumSponsoring()).To(Equal(Uint32(3)))
+		Expect(account.SponsorPerSigner()).To(Equal(map[string]AccountId{signer: sponsorID}))
+	})
+})
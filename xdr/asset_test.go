@@ -104,6 +104,17 @@ var _ = Describe("xdr.Asset#String()", func() {
 	})
 })
 
+var _ = Describe("xdr.Asset#StringCanonical()", func() {
+	It("returns 'native' for the native asset", func() {
+		Expect(MustNewNativeAsset().StringCanonical()).To(Equal("native"))
+	})
+
+	It("returns 'code:issuer' for credit assets", func() {
+		asset := MustNewCreditAsset("USD", "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")
+		Expect(asset.StringCanonical()).To(Equal("USD:GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"))
+	})
+})
+
 var _ = Describe("xdr.Asset#Equals()", func() {
 	var (
 		issuer1       AccountId
@@ -0,0 +1,162 @@
+package xdr
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// claimPredicateJSON is the JSON representation of a ClaimPredicate. Exactly
+// one of its fields is set.
+type claimPredicateJSON struct {
+	Unconditional  bool                  `json:"unconditional,omitempty"`
+	And            *[]claimPredicateJSON `json:"and,omitempty"`
+	Or             *[]claimPredicateJSON `json:"or,omitempty"`
+	Not            *claimPredicateJSON   `json:"not,omitempty"`
+	AbsBefore      *string               `json:"abs_before,omitempty"`
+	AbsBeforeEpoch *string               `json:"abs_before_epoch,omitempty"`
+	RelBefore      *string               `json:"rel_before,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. Absolute deadlines are rendered as
+// RFC3339 times (abs_before) and unix timestamps (abs_before_epoch), relative
+// deadlines as a number of seconds (rel_before).
+func (c ClaimPredicate) MarshalJSON() ([]byte, error) {
+	payload, err := c.toJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ClaimPredicate) UnmarshalJSON(b []byte) error {
+	var payload claimPredicateJSON
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+
+	predicate, err := payload.toXDR()
+	if err != nil {
+		return err
+	}
+	*c = predicate
+	return nil
+}
+
+func (c ClaimPredicate) toJSON() (claimPredicateJSON, error) {
+	var payload claimPredicateJSON
+
+	switch c.Type {
+	case ClaimPredicateTypeClaimPredicateUnconditional:
+		payload.Unconditional = true
+	case ClaimPredicateTypeClaimPredicateAnd, ClaimPredicateTypeClaimPredicateOr:
+		var predicates []ClaimPredicate
+		if c.Type == ClaimPredicateTypeClaimPredicateAnd {
+			predicates = c.MustAndPredicates()
+		} else {
+			predicates = c.MustOrPredicates()
+		}
+		list := make([]claimPredicateJSON, len(predicates))
+		for i, predicate := range predicates {
+			var err error
+			if list[i], err = predicate.toJSON(); err != nil {
+				return payload, err
+			}
+		}
+		if c.Type == ClaimPredicateTypeClaimPredicateAnd {
+			payload.And = &list
+		} else {
+			payload.Or = &list
+		}
+	case ClaimPredicateTypeClaimPredicateNot:
+		inner := c.MustNotPredicate()
+		if inner == nil {
+			return payload, fmt.Errorf("not predicate is missing its predicate")
+		}
+		not, err := inner.toJSON()
+		if err != nil {
+			return payload, err
+		}
+		payload.Not = &not
+	case ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		absBefore := int64(c.MustAbsBefore())
+		formatted := time.Unix(absBefore, 0).UTC().Format(time.RFC3339)
+		epoch := strconv.FormatInt(absBefore, 10)
+		payload.AbsBefore = &formatted
+		payload.AbsBeforeEpoch = &epoch
+	case ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		relBefore := strconv.FormatInt(int64(c.MustRelBefore()), 10)
+		payload.RelBefore = &relBefore
+	default:
+		return payload, fmt.Errorf("unknown claim predicate type: %v", c.Type)
+	}
+
+	return payload, nil
+}
+
+func (payload claimPredicateJSON) toXDR() (ClaimPredicate, error) {
+	var result ClaimPredicate
+
+	switch {
+	case payload.Unconditional:
+		result.Type = ClaimPredicateTypeClaimPredicateUnconditional
+	case payload.And != nil || payload.Or != nil:
+		list := payload.And
+		result.Type = ClaimPredicateTypeClaimPredicateAnd
+		if list == nil {
+			list = payload.Or
+			result.Type = ClaimPredicateTypeClaimPredicateOr
+		}
+		predicates := make([]ClaimPredicate, len(*list))
+		for i, predicate := range *list {
+			var err error
+			if predicates[i], err = predicate.toXDR(); err != nil {
+				return result, err
+			}
+		}
+		if result.Type == ClaimPredicateTypeClaimPredicateAnd {
+			result.AndPredicates = &predicates
+		} else {
+			result.OrPredicates = &predicates
+		}
+	case payload.Not != nil:
+		inner, err := payload.Not.toXDR()
+		if err != nil {
+			return result, err
+		}
+		innerPtr := &inner
+		result.Type = ClaimPredicateTypeClaimPredicateNot
+		result.NotPredicate = &innerPtr
+	case payload.AbsBeforeEpoch != nil || payload.AbsBefore != nil:
+		var absBefore int64
+		if payload.AbsBeforeEpoch != nil {
+			var err error
+			if absBefore, err = strconv.ParseInt(*payload.AbsBeforeEpoch, 10, 64); err != nil {
+				return result, fmt.Errorf("invalid abs_before_epoch: %v", err)
+			}
+		} else {
+			t, err := time.Parse(time.RFC3339, *payload.AbsBefore)
+			if err != nil {
+				return result, fmt.Errorf("invalid abs_before: %v", err)
+			}
+			absBefore = t.Unix()
+		}
+		value := Int64(absBefore)
+		result.Type = ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime
+		result.AbsBefore = &value
+	case payload.RelBefore != nil:
+		relBefore, err := strconv.ParseInt(*payload.RelBefore, 10, 64)
+		if err != nil {
+			return result, fmt.Errorf("invalid rel_before: %v", err)
+		}
+		value := Int64(relBefore)
+		result.Type = ClaimPredicateTypeClaimPredicateBeforeRelativeTime
+		result.RelBefore = &value
+	default:
+		return result, fmt.Errorf("empty claim predicate")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package xdr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimPredicateJSON(t *testing.T) {
+	absBefore := xdr.Int64(1598440539)
+	relBefore := xdr.Int64(120)
+	notPredicate := &xdr.ClaimPredicate{
+		Type:      xdr.ClaimPredicateTypeClaimPredicateBeforeRelativeTime,
+		RelBefore: &relBefore,
+	}
+	or := []xdr.ClaimPredicate{
+		{
+			Type:      xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime,
+			AbsBefore: &absBefore,
+		},
+		{
+			Type:         xdr.ClaimPredicateTypeClaimPredicateNot,
+			NotPredicate: &notPredicate,
+		},
+	}
+	and := []xdr.ClaimPredicate{
+		{Type: xdr.ClaimPredicateTypeClaimPredicateUnconditional},
+		{Type: xdr.ClaimPredicateTypeClaimPredicateOr, OrPredicates: &or},
+	}
+	predicate := xdr.ClaimPredicate{
+		Type:          xdr.ClaimPredicateTypeClaimPredicateAnd,
+		AndPredicates: &and,
+	}
+
+	serialized, err := json.Marshal(predicate)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"and": [
+			{"unconditional": true},
+			{"or": [
+				{"abs_before": "2020-08-26T11:15:39Z", "abs_before_epoch": "1598440539"},
+				{"not": {"rel_before": "120"}}
+			]}
+		]
+	}`, string(serialized))
+
+	var parsed xdr.ClaimPredicate
+	require.NoError(t, json.Unmarshal(serialized, &parsed))
+	expected, err := xdr.MarshalBase64(predicate)
+	require.NoError(t, err)
+	actual, err := xdr.MarshalBase64(parsed)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// abs_before alone is accepted too
+	require.NoError(t, json.Unmarshal([]byte(`{"abs_before": "2020-08-26T11:15:39Z"}`), &parsed))
+	assert.Equal(t, absBefore, parsed.MustAbsBefore())
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{}`), &parsed), "empty claim predicate")
+	assert.Error(t, json.Unmarshal([]byte(`{"rel_before": "soon"}`), &parsed))
+}
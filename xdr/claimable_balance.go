@@ -0,0 +1,15 @@
+package xdr
+
+// Equals returns true if `other` is equivalent to `id`
+func (id ClaimableBalanceId) Equals(other ClaimableBalanceId) bool {
+	if id.Type != other.Type {
+		return false
+	}
+
+	switch id.Type {
+	case ClaimableBalanceIdTypeClaimableBalanceIdTypeV0:
+		return id.MustV0() == other.MustV0()
+	default:
+		panic("Unknown claimable balance id type")
+	}
+}
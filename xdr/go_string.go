@@ -142,6 +142,16 @@ func (o OperationBody) GoString() string {
 		sb.WriteString(fmt.Sprintf("ManageBuyOfferOp: &%#v", *o.ManageBuyOfferOp))
 	case o.PathPaymentStrictSendOp != nil:
 		sb.WriteString(fmt.Sprintf("PathPaymentStrictSendOp: &%#v", *o.PathPaymentStrictSendOp))
+	case o.CreateClaimableBalanceOp != nil:
+		sb.WriteString(fmt.Sprintf("CreateClaimableBalanceOp: &%#v", *o.CreateClaimableBalanceOp))
+	case o.ClaimClaimableBalanceOp != nil:
+		sb.WriteString(fmt.Sprintf("ClaimClaimableBalanceOp: &%#v", *o.ClaimClaimableBalanceOp))
+	case o.BeginSponsoringFutureReservesOp != nil:
+		sb.WriteString(fmt.Sprintf("BeginSponsoringFutureReservesOp: &%#v", *o.BeginSponsoringFutureReservesOp))
+	case o.RevokeSponsorshipOp != nil:
+		sb.WriteString(fmt.Sprintf("RevokeSponsorshipOp: &%#v", *o.RevokeSponsorshipOp))
+	case o.Type == OperationTypeEndSponsoringFutureReserves:
+		// void arm
 	default:
 		panic("Unknown type")
 	}
@@ -22,3 +22,54 @@ func TestLedgerKeyTrustLineBinaryMaxLength(t *testing.T) {
 	bcompressed := base64.StdEncoding.EncodeToString(compressed)
 	assert.Equal(t, len(bcompressed), 124)
 }
+
+func TestLedgerKeyClaimableBalance(t *testing.T) {
+	balanceID := xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &xdr.Hash{1, 2, 3},
+	}
+	key := &xdr.LedgerKey{}
+	assert.NoError(t, key.SetClaimableBalance(balanceID))
+
+	other := &xdr.LedgerKey{}
+	assert.NoError(t, other.SetClaimableBalance(balanceID))
+	assert.True(t, key.Equals(*other))
+
+	assert.NoError(t, other.SetClaimableBalance(xdr.ClaimableBalanceId{
+		Type: xdr.ClaimableBalanceIdTypeClaimableBalanceIdTypeV0,
+		V0:   &xdr.Hash{3, 2, 1},
+	}))
+	assert.False(t, key.Equals(*other))
+
+	compressed, err := key.MarshalBinaryCompress()
+	assert.NoError(t, err)
+	// type byte, balance id type and hash
+	assert.Len(t, compressed, 1+4+32)
+
+	entry := xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{
+			Type: xdr.LedgerEntryTypeClaimableBalance,
+			ClaimableBalance: &xdr.ClaimableBalanceEntry{
+				BalanceId: balanceID,
+				Asset:     xdr.MustNewNativeAsset(),
+				Amount:    10,
+			},
+		},
+	}
+	assert.True(t, key.Equals(entry.LedgerKey()))
+	assert.Nil(t, entry.SponsoringID())
+
+	sponsor := xdr.MustAddress("GBFLTCDLOE6YQ74B66RH3S2UW5I2MKZ5VLTM75F4YMIWUIXRIFVNRNIF")
+	entry.Ext = xdr.LedgerEntryExt{
+		V:  1,
+		V1: &xdr.LedgerEntryExtensionV1{SponsoringId: &sponsor},
+	}
+	assert.Equal(t, sponsor.Address(), entry.SponsoringID().Address())
+
+	hexID, err := xdr.MarshalHex(balanceID)
+	assert.NoError(t, err)
+	assert.Equal(t, "000000000102030000000000000000000000000000000000000000000000000000000000", hexID)
+	var parsed xdr.ClaimableBalanceId
+	assert.NoError(t, xdr.SafeUnmarshalHex(hexID, &parsed))
+	assert.True(t, balanceID.Equals(parsed))
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
@@ -78,6 +79,31 @@ func MarshalBase64(v interface{}) (string, error) {
 	return base64.StdEncoding.EncodeToString(raw.Bytes()), nil
 }
 
+// MarshalHex marshals `v` into its binary XDR form and encodes it using hex.
+func MarshalHex(v interface{}) (string, error) {
+	var raw bytes.Buffer
+
+	_, err := Marshal(&raw, v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw.Bytes()), nil
+}
+
+// SafeUnmarshalHex decodes the provided hex string before decoding the xdr
+// into the provided destination. Also ensures that the input is fully
+// consumed.
+func SafeUnmarshalHex(data string, dest interface{}) error {
+	raw, err := hex.DecodeString(data)
+	if err != nil {
+		return err
+	}
+
+	return SafeUnmarshal(raw, dest)
+}
+
 func MarshalFramed(w io.Writer, v interface{}) error {
 	var tmp bytes.Buffer
 	n, err := Marshal(&tmp, v)
@@ -22,7 +22,8 @@ func MustMuxedAddressPtr(address string) *MuxedAccount {
 }
 
 // SetAddress modifies the receiver, setting it's value to the MuxedAccount form
-// of the provided address.
+// of the provided address, which is either an account id (G...) or a
+// multiplexed account (M...).
 func (m *MuxedAccount) SetAddress(address string) error {
 	if m == nil {
 		return nil
@@ -41,12 +42,63 @@ func (m *MuxedAccount) SetAddress(address string) error {
 		copy(ui[:], raw)
 		*m, err = NewMuxedAccount(CryptoKeyTypeKeyTypeEd25519, ui)
 		return err
+	case 69:
+		muxed, err := strkey.DecodeMuxedAccount(address)
+		if err != nil {
+			return err
+		}
+		*m, err = NewMuxedAccount(CryptoKeyTypeKeyTypeMuxedEd25519, MuxedAccountMed25519{
+			Id:      Uint64(muxed.ID()),
+			Ed25519: Uint256(muxed.Ed25519()),
+		})
+		return err
 	default:
 		return errors.New("invalid address")
 	}
 
 }
 
+// Address returns the strkey encoded form of this MuxedAccount: an account id
+// (G...) or a multiplexed account (M...). This method will panic if the
+// MuxedAccount is of an unknown type.
+func (m *MuxedAccount) Address() string {
+	address, err := m.GetAddress()
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// GetAddress returns the strkey encoded form of this MuxedAccount, and an error
+// if the MuxedAccount is of an unknown type.
+func (m *MuxedAccount) GetAddress() (string, error) {
+	if m == nil {
+		return "", nil
+	}
+
+	switch m.Type {
+	case CryptoKeyTypeKeyTypeEd25519:
+		ed, ok := m.GetEd25519()
+		if !ok {
+			return "", fmt.Errorf("Could not get Ed25519")
+		}
+		return strkey.Encode(strkey.VersionByteAccountID, ed[:])
+	case CryptoKeyTypeKeyTypeMuxedEd25519:
+		med, ok := m.GetMed25519()
+		if !ok {
+			return "", fmt.Errorf("Could not get Med25519")
+		}
+		aid := m.ToAccountId()
+		muxed, err := strkey.NewMuxedAccount(aid.Address(), uint64(med.Id))
+		if err != nil {
+			return "", err
+		}
+		return muxed.Address()
+	default:
+		return "", fmt.Errorf("Unknown muxed account type: %v", m.Type)
+	}
+}
+
 // ToAccountId transforms a MuxedAccount to an AccountId, dropping the
 // memo Id if necessary
 func (m MuxedAccount) ToAccountId() AccountId {
@@ -49,3 +49,26 @@ var _ = Describe("xdr.MuxedAccount.ToAccountId()", func() {
 		Expect(aid.Address()).To(Equal("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"))
 	})
 })
+
+var _ = Describe("xdr.MuxedAccount#SetAddress() with a muxed address", func() {
+	It("round-trips M addresses", func() {
+		var muxed MuxedAccount
+		err := muxed.SetAddress("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(muxed.Type).To(Equal(CryptoKeyTypeKeyTypeMuxedEd25519))
+		Expect(muxed.Med25519.Id).To(Equal(Uint64(9223372036854775808)))
+		aid := muxed.ToAccountId()
+		Expect(aid.Address()).To(Equal("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"))
+		Expect(muxed.Address()).To(Equal("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK"))
+
+		err = muxed.SetAddress("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(muxed.Address()).To(Equal("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"))
+	})
+
+	It("returns an error when the M address is invalid", func() {
+		var muxed MuxedAccount
+		err := muxed.SetAddress("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLL")
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -1295,16 +1295,18 @@ var (
 //        ACCOUNT = 0,
 //        TRUSTLINE = 1,
 //        OFFER = 2,
-//        DATA = 3
+//        DATA = 3,
+//        CLAIMABLE_BALANCE = 4
 //    };
 //
 type LedgerEntryType int32
 
 const (
-	LedgerEntryTypeAccount   LedgerEntryType = 0
-	LedgerEntryTypeTrustline LedgerEntryType = 1
-	LedgerEntryTypeOffer     LedgerEntryType = 2
-	LedgerEntryTypeData      LedgerEntryType = 3
+	LedgerEntryTypeAccount          LedgerEntryType = 0
+	LedgerEntryTypeTrustline        LedgerEntryType = 1
+	LedgerEntryTypeOffer            LedgerEntryType = 2
+	LedgerEntryTypeData             LedgerEntryType = 3
+	LedgerEntryTypeClaimableBalance LedgerEntryType = 4
 )
 
 var ledgerEntryTypeMap = map[int32]string{
@@ -1312,6 +1314,7 @@ var ledgerEntryTypeMap = map[int32]string{
 	1: "LedgerEntryTypeTrustline",
 	2: "LedgerEntryTypeOffer",
 	3: "LedgerEntryTypeData",
+	4: "LedgerEntryTypeClaimableBalance",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
@@ -1443,27 +1446,39 @@ var (
 //
 const MaskAccountFlags = 0x7
 
-// AccountEntryV1Ext is an XDR NestedUnion defines as:
+// MaxSigners is an XDR Const defines as:
+//
+//   const MAX_SIGNERS = 20;
+//
+const MaxSigners = 20
+
+// SponsorshipDescriptor is an XDR Typedef defines as:
+//
+//   typedef AccountID* SponsorshipDescriptor;
+//
+type SponsorshipDescriptor = *AccountId
+
+// AccountEntryExtensionV2Ext is an XDR NestedUnion defines as:
 //
 //   union switch (int v)
-//                {
-//                case 0:
-//                    void;
-//                }
+//        {
+//        case 0:
+//            void;
+//        }
 //
-type AccountEntryV1Ext struct {
+type AccountEntryExtensionV2Ext struct {
 	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u AccountEntryV1Ext) SwitchFieldName() string {
+func (u AccountEntryExtensionV2Ext) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of AccountEntryV1Ext
-func (u AccountEntryV1Ext) ArmForSwitch(sw int32) (string, bool) {
+// the value for an instance of AccountEntryExtensionV2Ext
+func (u AccountEntryExtensionV2Ext) ArmForSwitch(sw int32) (string, bool) {
 	switch int32(sw) {
 	case 0:
 		return "", true
@@ -1471,8 +1486,8 @@ func (u AccountEntryV1Ext) ArmForSwitch(sw int32) (string, bool) {
 	return "-", false
 }
 
-// NewAccountEntryV1Ext creates a new  AccountEntryV1Ext.
-func NewAccountEntryV1Ext(v int32, value interface{}) (result AccountEntryV1Ext, err error) {
+// NewAccountEntryExtensionV2Ext creates a new  AccountEntryExtensionV2Ext.
+func NewAccountEntryExtensionV2Ext(v int32, value interface{}) (result AccountEntryExtensionV2Ext, err error) {
 	result.V = v
 	switch int32(v) {
 	case 0:
@@ -1482,58 +1497,194 @@ func NewAccountEntryV1Ext(v int32, value interface{}) (result AccountEntryV1Ext,
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AccountEntryV1Ext) MarshalBinary() ([]byte, error) {
+func (s AccountEntryExtensionV2Ext) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AccountEntryV1Ext) UnmarshalBinary(inp []byte) error {
+func (s *AccountEntryExtensionV2Ext) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AccountEntryV1Ext)(nil)
-	_ encoding.BinaryUnmarshaler = (*AccountEntryV1Ext)(nil)
+	_ encoding.BinaryMarshaler   = (*AccountEntryExtensionV2Ext)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountEntryExtensionV2Ext)(nil)
 )
 
-// AccountEntryV1 is an XDR NestedStruct defines as:
+// AccountEntryExtensionV2 is an XDR Struct defines as:
 //
-//   struct
-//            {
-//                Liabilities liabilities;
+//   struct AccountEntryExtensionV2
+//    {
+//        uint32 numSponsored;
+//        uint32 numSponsoring;
+//        SponsorshipDescriptor signerSponsoringIDs<MAX_SIGNERS>;
 //
-//                union switch (int v)
-//                {
-//                case 0:
-//                    void;
-//                }
-//                ext;
-//            }
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
+//    };
+//
+type AccountEntryExtensionV2 struct {
+	NumSponsored        Uint32
+	NumSponsoring       Uint32
+	SignerSponsoringIDs []SponsorshipDescriptor `xdrmaxsize:"20"`
+	Ext                 AccountEntryExtensionV2Ext
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AccountEntryExtensionV2) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AccountEntryExtensionV2) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AccountEntryExtensionV2)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountEntryExtensionV2)(nil)
+)
+
+// AccountEntryExtensionV1Ext is an XDR NestedUnion defines as:
+//
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        case 2:
+//            AccountEntryExtensionV2 v2;
+//        }
+//
+type AccountEntryExtensionV1Ext struct {
+	V  int32
+	V2 *AccountEntryExtensionV2
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u AccountEntryExtensionV1Ext) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of AccountEntryExtensionV1Ext
+func (u AccountEntryExtensionV1Ext) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
+	case 2:
+		return "V2", true
+	}
+	return "-", false
+}
+
+// NewAccountEntryExtensionV1Ext creates a new  AccountEntryExtensionV1Ext.
+func NewAccountEntryExtensionV1Ext(v int32, value interface{}) (result AccountEntryExtensionV1Ext, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	case 2:
+		tv, ok := value.(AccountEntryExtensionV2)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be AccountEntryExtensionV2")
+			return
+		}
+		result.V2 = &tv
+	}
+	return
+}
+
+// MustV2 retrieves the V2 value from the union,
+// panicing if the value is not set.
+func (u AccountEntryExtensionV1Ext) MustV2() AccountEntryExtensionV2 {
+	val, ok := u.GetV2()
+
+	if !ok {
+		panic("arm V2 is not set")
+	}
+
+	return val
+}
+
+// GetV2 retrieves the V2 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u AccountEntryExtensionV1Ext) GetV2() (result AccountEntryExtensionV2, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V2" {
+		result = *u.V2
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AccountEntryExtensionV1Ext) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AccountEntryExtensionV1Ext) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AccountEntryExtensionV1Ext)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountEntryExtensionV1Ext)(nil)
+)
+
+// AccountEntryExtensionV1 is an XDR Struct defines as:
+//
+//   struct AccountEntryExtensionV1
+//    {
+//        Liabilities liabilities;
+//
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        case 2:
+//            AccountEntryExtensionV2 v2;
+//        }
+//        ext;
+//    };
 //
-type AccountEntryV1 struct {
+type AccountEntryExtensionV1 struct {
 	Liabilities Liabilities
-	Ext         AccountEntryV1Ext
+	Ext         AccountEntryExtensionV1Ext
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AccountEntryV1) MarshalBinary() ([]byte, error) {
+func (s AccountEntryExtensionV1) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AccountEntryV1) UnmarshalBinary(inp []byte) error {
+func (s *AccountEntryExtensionV1) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AccountEntryV1)(nil)
-	_ encoding.BinaryUnmarshaler = (*AccountEntryV1)(nil)
+	_ encoding.BinaryMarshaler   = (*AccountEntryExtensionV1)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountEntryExtensionV1)(nil)
 )
 
 // AccountEntryExt is an XDR NestedUnion defines as:
@@ -1543,22 +1694,12 @@ var (
 //        case 0:
 //            void;
 //        case 1:
-//            struct
-//            {
-//                Liabilities liabilities;
-//
-//                union switch (int v)
-//                {
-//                case 0:
-//                    void;
-//                }
-//                ext;
-//            } v1;
+//            AccountEntryExtensionV1 v1;
 //        }
 //
 type AccountEntryExt struct {
 	V  int32
-	V1 *AccountEntryV1
+	V1 *AccountEntryExtensionV1
 }
 
 // SwitchFieldName returns the field name in which this union's
@@ -1586,9 +1727,9 @@ func NewAccountEntryExt(v int32, value interface{}) (result AccountEntryExt, err
 	case 0:
 		// void
 	case 1:
-		tv, ok := value.(AccountEntryV1)
+		tv, ok := value.(AccountEntryExtensionV1)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be AccountEntryV1")
+			err = fmt.Errorf("invalid value, must be AccountEntryExtensionV1")
 			return
 		}
 		result.V1 = &tv
@@ -1598,7 +1739,7 @@ func NewAccountEntryExt(v int32, value interface{}) (result AccountEntryExt, err
 
 // MustV1 retrieves the V1 value from the union,
 // panicing if the value is not set.
-func (u AccountEntryExt) MustV1() AccountEntryV1 {
+func (u AccountEntryExt) MustV1() AccountEntryExtensionV1 {
 	val, ok := u.GetV1()
 
 	if !ok {
@@ -1610,7 +1751,7 @@ func (u AccountEntryExt) MustV1() AccountEntryV1 {
 
 // GetV1 retrieves the V1 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u AccountEntryExt) GetV1() (result AccountEntryV1, ok bool) {
+func (u AccountEntryExt) GetV1() (result AccountEntryExtensionV1, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.V))
 
 	if armName == "V1" {
@@ -1657,7 +1798,7 @@ var (
 //        // thresholds stores unsigned bytes: [weight of master|low|medium|high]
 //        Thresholds thresholds;
 //
-//        Signer signers<20>; // possible signers for this account
+//        Signer signers<MAX_SIGNERS>; // possible signers for this account
 //
 //        // reserved for future use
 //        union switch (int v)
@@ -1665,17 +1806,7 @@ var (
 //        case 0:
 //            void;
 //        case 1:
-//            struct
-//            {
-//                Liabilities liabilities;
-//
-//                union switch (int v)
-//                {
-//                case 0:
-//                    void;
-//                }
-//                ext;
-//            } v1;
+//            AccountEntryExtensionV1 v1;
 //        }
 //        ext;
 //    };
@@ -2298,180 +2429,287 @@ var (
 	_ encoding.BinaryUnmarshaler = (*DataEntry)(nil)
 )
 
-// LedgerEntryData is an XDR NestedUnion defines as:
+// ClaimPredicateType is an XDR Enum defines as:
 //
-//   union switch (LedgerEntryType type)
-//        {
-//        case ACCOUNT:
-//            AccountEntry account;
-//        case TRUSTLINE:
-//            TrustLineEntry trustLine;
-//        case OFFER:
-//            OfferEntry offer;
-//        case DATA:
-//            DataEntry data;
-//        }
+//   enum ClaimPredicateType
+//    {
+//        CLAIM_PREDICATE_UNCONDITIONAL = 0,
+//        CLAIM_PREDICATE_AND = 1,
+//        CLAIM_PREDICATE_OR = 2,
+//        CLAIM_PREDICATE_NOT = 3,
+//        CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME = 4,
+//        CLAIM_PREDICATE_BEFORE_RELATIVE_TIME = 5
+//    };
 //
-type LedgerEntryData struct {
-	Type      LedgerEntryType
-	Account   *AccountEntry
-	TrustLine *TrustLineEntry
-	Offer     *OfferEntry
-	Data      *DataEntry
+type ClaimPredicateType int32
+
+const (
+	ClaimPredicateTypeClaimPredicateUnconditional      ClaimPredicateType = 0
+	ClaimPredicateTypeClaimPredicateAnd                ClaimPredicateType = 1
+	ClaimPredicateTypeClaimPredicateOr                 ClaimPredicateType = 2
+	ClaimPredicateTypeClaimPredicateNot                ClaimPredicateType = 3
+	ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime ClaimPredicateType = 4
+	ClaimPredicateTypeClaimPredicateBeforeRelativeTime ClaimPredicateType = 5
+)
+
+var claimPredicateTypeMap = map[int32]string{
+	0: "ClaimPredicateTypeClaimPredicateUnconditional",
+	1: "ClaimPredicateTypeClaimPredicateAnd",
+	2: "ClaimPredicateTypeClaimPredicateOr",
+	3: "ClaimPredicateTypeClaimPredicateNot",
+	4: "ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime",
+	5: "ClaimPredicateTypeClaimPredicateBeforeRelativeTime",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for ClaimPredicateType
+func (e ClaimPredicateType) ValidEnum(v int32) bool {
+	_, ok := claimPredicateTypeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e ClaimPredicateType) String() string {
+	name, _ := claimPredicateTypeMap[int32(e)]
+	return name
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ClaimPredicateType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ClaimPredicateType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ClaimPredicateType)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimPredicateType)(nil)
+)
+
+// ClaimPredicate is an XDR Union defines as:
+//
+//   union ClaimPredicate switch (ClaimPredicateType type)
+//    {
+//    case CLAIM_PREDICATE_UNCONDITIONAL:
+//        void;
+//    case CLAIM_PREDICATE_AND:
+//        ClaimPredicate andPredicates<2>;
+//    case CLAIM_PREDICATE_OR:
+//        ClaimPredicate orPredicates<2>;
+//    case CLAIM_PREDICATE_NOT:
+//        ClaimPredicate* notPredicate;
+//    case CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME:
+//        int64 absBefore; // Predicate will be true if closeTime < absBefore
+//    case CLAIM_PREDICATE_BEFORE_RELATIVE_TIME:
+//        int64 relBefore; // Seconds since closeTime of the ledger in which the
+//                         // ClaimableBalanceEntry was created
+//    };
+//
+type ClaimPredicate struct {
+	Type          ClaimPredicateType
+	AndPredicates *[]ClaimPredicate `xdrmaxsize:"2"`
+	OrPredicates  *[]ClaimPredicate `xdrmaxsize:"2"`
+	NotPredicate  **ClaimPredicate
+	AbsBefore     *Int64
+	RelBefore     *Int64
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u LedgerEntryData) SwitchFieldName() string {
+func (u ClaimPredicate) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerEntryData
-func (u LedgerEntryData) ArmForSwitch(sw int32) (string, bool) {
-	switch LedgerEntryType(sw) {
-	case LedgerEntryTypeAccount:
-		return "Account", true
-	case LedgerEntryTypeTrustline:
-		return "TrustLine", true
-	case LedgerEntryTypeOffer:
-		return "Offer", true
-	case LedgerEntryTypeData:
-		return "Data", true
+// the value for an instance of ClaimPredicate
+func (u ClaimPredicate) ArmForSwitch(sw int32) (string, bool) {
+	switch ClaimPredicateType(sw) {
+	case ClaimPredicateTypeClaimPredicateUnconditional:
+		return "", true
+	case ClaimPredicateTypeClaimPredicateAnd:
+		return "AndPredicates", true
+	case ClaimPredicateTypeClaimPredicateOr:
+		return "OrPredicates", true
+	case ClaimPredicateTypeClaimPredicateNot:
+		return "NotPredicate", true
+	case ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		return "AbsBefore", true
+	case ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		return "RelBefore", true
 	}
 	return "-", false
 }
 
-// NewLedgerEntryData creates a new  LedgerEntryData.
-func NewLedgerEntryData(aType LedgerEntryType, value interface{}) (result LedgerEntryData, err error) {
+// NewClaimPredicate creates a new  ClaimPredicate.
+func NewClaimPredicate(aType ClaimPredicateType, value interface{}) (result ClaimPredicate, err error) {
 	result.Type = aType
-	switch LedgerEntryType(aType) {
-	case LedgerEntryTypeAccount:
-		tv, ok := value.(AccountEntry)
+	switch ClaimPredicateType(aType) {
+	case ClaimPredicateTypeClaimPredicateUnconditional:
+		// void
+	case ClaimPredicateTypeClaimPredicateAnd:
+		tv, ok := value.([]ClaimPredicate)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be AccountEntry")
+			err = fmt.Errorf("invalid value, must be []ClaimPredicate")
 			return
 		}
-		result.Account = &tv
-	case LedgerEntryTypeTrustline:
-		tv, ok := value.(TrustLineEntry)
+		result.AndPredicates = &tv
+	case ClaimPredicateTypeClaimPredicateOr:
+		tv, ok := value.([]ClaimPredicate)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be TrustLineEntry")
+			err = fmt.Errorf("invalid value, must be []ClaimPredicate")
 			return
 		}
-		result.TrustLine = &tv
-	case LedgerEntryTypeOffer:
-		tv, ok := value.(OfferEntry)
+		result.OrPredicates = &tv
+	case ClaimPredicateTypeClaimPredicateNot:
+		tv, ok := value.(*ClaimPredicate)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be OfferEntry")
+			err = fmt.Errorf("invalid value, must be *ClaimPredicate")
 			return
 		}
-		result.Offer = &tv
-	case LedgerEntryTypeData:
-		tv, ok := value.(DataEntry)
+		result.NotPredicate = &tv
+	case ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		tv, ok := value.(Int64)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be DataEntry")
+			err = fmt.Errorf("invalid value, must be Int64")
 			return
 		}
-		result.Data = &tv
+		result.AbsBefore = &tv
+	case ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		tv, ok := value.(Int64)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Int64")
+			return
+		}
+		result.RelBefore = &tv
 	}
 	return
 }
 
-// MustAccount retrieves the Account value from the union,
+// MustAndPredicates retrieves the AndPredicates value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryData) MustAccount() AccountEntry {
-	val, ok := u.GetAccount()
+func (u ClaimPredicate) MustAndPredicates() []ClaimPredicate {
+	val, ok := u.GetAndPredicates()
 
 	if !ok {
-		panic("arm Account is not set")
+		panic("arm AndPredicates is not set")
 	}
 
 	return val
 }
 
-// GetAccount retrieves the Account value from the union,
+// GetAndPredicates retrieves the AndPredicates value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryData) GetAccount() (result AccountEntry, ok bool) {
+func (u ClaimPredicate) GetAndPredicates() (result []ClaimPredicate, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Account" {
-		result = *u.Account
+	if armName == "AndPredicates" {
+		result = *u.AndPredicates
 		ok = true
 	}
 
 	return
 }
 
-// MustTrustLine retrieves the TrustLine value from the union,
+// MustOrPredicates retrieves the OrPredicates value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryData) MustTrustLine() TrustLineEntry {
-	val, ok := u.GetTrustLine()
+func (u ClaimPredicate) MustOrPredicates() []ClaimPredicate {
+	val, ok := u.GetOrPredicates()
 
 	if !ok {
-		panic("arm TrustLine is not set")
+		panic("arm OrPredicates is not set")
 	}
 
 	return val
 }
 
-// GetTrustLine retrieves the TrustLine value from the union,
+// GetOrPredicates retrieves the OrPredicates value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryData) GetTrustLine() (result TrustLineEntry, ok bool) {
+func (u ClaimPredicate) GetOrPredicates() (result []ClaimPredicate, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "TrustLine" {
-		result = *u.TrustLine
+	if armName == "OrPredicates" {
+		result = *u.OrPredicates
 		ok = true
 	}
 
 	return
 }
 
-// MustOffer retrieves the Offer value from the union,
+// MustNotPredicate retrieves the NotPredicate value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryData) MustOffer() OfferEntry {
-	val, ok := u.GetOffer()
+func (u ClaimPredicate) MustNotPredicate() *ClaimPredicate {
+	val, ok := u.GetNotPredicate()
 
 	if !ok {
-		panic("arm Offer is not set")
+		panic("arm NotPredicate is not set")
 	}
 
 	return val
 }
 
-// GetOffer retrieves the Offer value from the union,
+// GetNotPredicate retrieves the NotPredicate value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryData) GetOffer() (result OfferEntry, ok bool) {
+func (u ClaimPredicate) GetNotPredicate() (result *ClaimPredicate, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Offer" {
-		result = *u.Offer
+	if armName == "NotPredicate" {
+		result = *u.NotPredicate
 		ok = true
 	}
 
 	return
 }
 
-// MustData retrieves the Data value from the union,
+// MustAbsBefore retrieves the AbsBefore value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryData) MustData() DataEntry {
-	val, ok := u.GetData()
+func (u ClaimPredicate) MustAbsBefore() Int64 {
+	val, ok := u.GetAbsBefore()
 
 	if !ok {
-		panic("arm Data is not set")
+		panic("arm AbsBefore is not set")
 	}
 
 	return val
 }
 
-// GetData retrieves the Data value from the union,
+// GetAbsBefore retrieves the AbsBefore value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryData) GetData() (result DataEntry, ok bool) {
+func (u ClaimPredicate) GetAbsBefore() (result Int64, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Data" {
-		result = *u.Data
+	if armName == "AbsBefore" {
+		result = *u.AbsBefore
+		ok = true
+	}
+
+	return
+}
+
+// MustRelBefore retrieves the RelBefore value from the union,
+// panicing if the value is not set.
+func (u ClaimPredicate) MustRelBefore() Int64 {
+	val, ok := u.GetRelBefore()
+
+	if !ok {
+		panic("arm RelBefore is not set")
+	}
+
+	return val
+}
+
+// GetRelBefore retrieves the RelBefore value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u ClaimPredicate) GetRelBefore() (result Int64, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "RelBefore" {
+		result = *u.RelBefore
 		ok = true
 	}
 
@@ -2479,449 +2717,436 @@ func (u LedgerEntryData) GetData() (result DataEntry, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntryData) MarshalBinary() ([]byte, error) {
+func (s ClaimPredicate) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntryData) UnmarshalBinary(inp []byte) error {
+func (s *ClaimPredicate) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntryData)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntryData)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimPredicate)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimPredicate)(nil)
 )
 
-// LedgerEntryExt is an XDR NestedUnion defines as:
+// ClaimantType is an XDR Enum defines as:
 //
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
+//   enum ClaimantType
+//    {
+//        CLAIMANT_TYPE_V0 = 0
+//    };
 //
-type LedgerEntryExt struct {
-	V int32
-}
+type ClaimantType int32
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u LedgerEntryExt) SwitchFieldName() string {
-	return "V"
+const (
+	ClaimantTypeClaimantTypeV0 ClaimantType = 0
+)
+
+var claimantTypeMap = map[int32]string{
+	0: "ClaimantTypeClaimantTypeV0",
 }
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerEntryExt
-func (u LedgerEntryExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
-	}
-	return "-", false
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for ClaimantType
+func (e ClaimantType) ValidEnum(v int32) bool {
+	_, ok := claimantTypeMap[v]
+	return ok
 }
 
-// NewLedgerEntryExt creates a new  LedgerEntryExt.
-func NewLedgerEntryExt(v int32, value interface{}) (result LedgerEntryExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
-	}
-	return
+// String returns the name of `e`
+func (e ClaimantType) String() string {
+	name, _ := claimantTypeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntryExt) MarshalBinary() ([]byte, error) {
+func (s ClaimantType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntryExt) UnmarshalBinary(inp []byte) error {
+func (s *ClaimantType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntryExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntryExt)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimantType)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimantType)(nil)
 )
 
-// LedgerEntry is an XDR Struct defines as:
-//
-//   struct LedgerEntry
-//    {
-//        uint32 lastModifiedLedgerSeq; // ledger the LedgerEntry was last changed
-//
-//        union switch (LedgerEntryType type)
-//        {
-//        case ACCOUNT:
-//            AccountEntry account;
-//        case TRUSTLINE:
-//            TrustLineEntry trustLine;
-//        case OFFER:
-//            OfferEntry offer;
-//        case DATA:
-//            DataEntry data;
-//        }
-//        data;
+// ClaimantV0 is an XDR NestedStruct defines as:
 //
-//        // reserved for future use
-//        union switch (int v)
+//   struct
 //        {
-//        case 0:
-//            void;
+//            AccountID destination;    // The account that can use this condition
+//            ClaimPredicate predicate; // Claimable if predicate is true
 //        }
-//        ext;
-//    };
 //
-type LedgerEntry struct {
-	LastModifiedLedgerSeq Uint32
-	Data                  LedgerEntryData
-	Ext                   LedgerEntryExt
+type ClaimantV0 struct {
+	Destination AccountId
+	Predicate   ClaimPredicate
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntry) MarshalBinary() ([]byte, error) {
+func (s ClaimantV0) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntry) UnmarshalBinary(inp []byte) error {
+func (s *ClaimantV0) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntry)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimantV0)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimantV0)(nil)
 )
 
-// EnvelopeType is an XDR Enum defines as:
+// Claimant is an XDR Union defines as:
 //
-//   enum EnvelopeType
+//   union Claimant switch (ClaimantType type)
 //    {
-//        ENVELOPE_TYPE_TX_V0 = 0,
-//        ENVELOPE_TYPE_SCP = 1,
-//        ENVELOPE_TYPE_TX = 2,
-//        ENVELOPE_TYPE_AUTH = 3,
-//        ENVELOPE_TYPE_SCPVALUE = 4,
-//        ENVELOPE_TYPE_TX_FEE_BUMP = 5
+//    case CLAIMANT_TYPE_V0:
+//        struct
+//        {
+//            AccountID destination;    // The account that can use this condition
+//            ClaimPredicate predicate; // Claimable if predicate is true
+//        } v0;
 //    };
 //
-type EnvelopeType int32
-
-const (
-	EnvelopeTypeEnvelopeTypeTxV0      EnvelopeType = 0
-	EnvelopeTypeEnvelopeTypeScp       EnvelopeType = 1
-	EnvelopeTypeEnvelopeTypeTx        EnvelopeType = 2
-	EnvelopeTypeEnvelopeTypeAuth      EnvelopeType = 3
-	EnvelopeTypeEnvelopeTypeScpvalue  EnvelopeType = 4
-	EnvelopeTypeEnvelopeTypeTxFeeBump EnvelopeType = 5
-)
-
-var envelopeTypeMap = map[int32]string{
-	0: "EnvelopeTypeEnvelopeTypeTxV0",
-	1: "EnvelopeTypeEnvelopeTypeScp",
-	2: "EnvelopeTypeEnvelopeTypeTx",
-	3: "EnvelopeTypeEnvelopeTypeAuth",
-	4: "EnvelopeTypeEnvelopeTypeScpvalue",
-	5: "EnvelopeTypeEnvelopeTypeTxFeeBump",
+type Claimant struct {
+	Type ClaimantType
+	V0   *ClaimantV0
 }
 
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for EnvelopeType
-func (e EnvelopeType) ValidEnum(v int32) bool {
-	_, ok := envelopeTypeMap[v]
-	return ok
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u Claimant) SwitchFieldName() string {
+	return "Type"
 }
 
-// String returns the name of `e`
-func (e EnvelopeType) String() string {
-	name, _ := envelopeTypeMap[int32(e)]
-	return name
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of Claimant
+func (u Claimant) ArmForSwitch(sw int32) (string, bool) {
+	switch ClaimantType(sw) {
+	case ClaimantTypeClaimantTypeV0:
+		return "V0", true
+	}
+	return "-", false
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s EnvelopeType) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+// NewClaimant creates a new  Claimant.
+func NewClaimant(aType ClaimantType, value interface{}) (result Claimant, err error) {
+	result.Type = aType
+	switch ClaimantType(aType) {
+	case ClaimantTypeClaimantTypeV0:
+		tv, ok := value.(ClaimantV0)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ClaimantV0")
+			return
+		}
+		result.V0 = &tv
+	}
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *EnvelopeType) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+// MustV0 retrieves the V0 value from the union,
+// panicing if the value is not set.
+func (u Claimant) MustV0() ClaimantV0 {
+	val, ok := u.GetV0()
+
+	if !ok {
+		panic("arm V0 is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*EnvelopeType)(nil)
-	_ encoding.BinaryUnmarshaler = (*EnvelopeType)(nil)
-)
+// GetV0 retrieves the V0 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u Claimant) GetV0() (result ClaimantV0, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// UpgradeType is an XDR Typedef defines as:
-//
-//   typedef opaque UpgradeType<128>;
-//
-type UpgradeType []byte
+	if armName == "V0" {
+		result = *u.V0
+		ok = true
+	}
 
-// XDRMaxSize implements the Sized interface for UpgradeType
-func (e UpgradeType) XDRMaxSize() int {
-	return 128
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s UpgradeType) MarshalBinary() ([]byte, error) {
+func (s Claimant) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *UpgradeType) UnmarshalBinary(inp []byte) error {
+func (s *Claimant) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*UpgradeType)(nil)
-	_ encoding.BinaryUnmarshaler = (*UpgradeType)(nil)
+	_ encoding.BinaryMarshaler   = (*Claimant)(nil)
+	_ encoding.BinaryUnmarshaler = (*Claimant)(nil)
 )
 
-// StellarValueType is an XDR Enum defines as:
+// ClaimableBalanceIdType is an XDR Enum defines as:
 //
-//   enum StellarValueType
+//   enum ClaimableBalanceIDType
 //    {
-//        STELLAR_VALUE_BASIC = 0,
-//        STELLAR_VALUE_SIGNED = 1
+//        CLAIMABLE_BALANCE_ID_TYPE_V0 = 0
 //    };
 //
-type StellarValueType int32
+type ClaimableBalanceIdType int32
 
 const (
-	StellarValueTypeStellarValueBasic  StellarValueType = 0
-	StellarValueTypeStellarValueSigned StellarValueType = 1
+	ClaimableBalanceIdTypeClaimableBalanceIdTypeV0 ClaimableBalanceIdType = 0
 )
 
-var stellarValueTypeMap = map[int32]string{
-	0: "StellarValueTypeStellarValueBasic",
-	1: "StellarValueTypeStellarValueSigned",
+var claimableBalanceIdTypeMap = map[int32]string{
+	0: "ClaimableBalanceIdTypeClaimableBalanceIdTypeV0",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for StellarValueType
-func (e StellarValueType) ValidEnum(v int32) bool {
-	_, ok := stellarValueTypeMap[v]
+// the Enum interface for ClaimableBalanceIdType
+func (e ClaimableBalanceIdType) ValidEnum(v int32) bool {
+	_, ok := claimableBalanceIdTypeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e StellarValueType) String() string {
-	name, _ := stellarValueTypeMap[int32(e)]
+func (e ClaimableBalanceIdType) String() string {
+	name, _ := claimableBalanceIdTypeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s StellarValueType) MarshalBinary() ([]byte, error) {
+func (s ClaimableBalanceIdType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *StellarValueType) UnmarshalBinary(inp []byte) error {
+func (s *ClaimableBalanceIdType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*StellarValueType)(nil)
-	_ encoding.BinaryUnmarshaler = (*StellarValueType)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimableBalanceIdType)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimableBalanceIdType)(nil)
 )
 
-// LedgerCloseValueSignature is an XDR Struct defines as:
+// ClaimableBalanceId is an XDR Union defines as:
 //
-//   struct LedgerCloseValueSignature
+//   union ClaimableBalanceID switch (ClaimableBalanceIDType type)
 //    {
-//        NodeID nodeID;       // which node introduced the value
-//        Signature signature; // nodeID's signature
+//    case CLAIMABLE_BALANCE_ID_TYPE_V0:
+//        Hash v0;
 //    };
 //
-type LedgerCloseValueSignature struct {
-	NodeId    NodeId
-	Signature Signature
+type ClaimableBalanceId struct {
+	Type ClaimableBalanceIdType
+	V0   *Hash
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerCloseValueSignature) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u ClaimableBalanceId) SwitchFieldName() string {
+	return "Type"
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerCloseValueSignature) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of ClaimableBalanceId
+func (u ClaimableBalanceId) ArmForSwitch(sw int32) (string, bool) {
+	switch ClaimableBalanceIdType(sw) {
+	case ClaimableBalanceIdTypeClaimableBalanceIdTypeV0:
+		return "V0", true
+	}
+	return "-", false
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*LedgerCloseValueSignature)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerCloseValueSignature)(nil)
-)
+// NewClaimableBalanceId creates a new  ClaimableBalanceId.
+func NewClaimableBalanceId(aType ClaimableBalanceIdType, value interface{}) (result ClaimableBalanceId, err error) {
+	result.Type = aType
+	switch ClaimableBalanceIdType(aType) {
+	case ClaimableBalanceIdTypeClaimableBalanceIdTypeV0:
+		tv, ok := value.(Hash)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Hash")
+			return
+		}
+		result.V0 = &tv
+	}
+	return
+}
 
-// StellarValueExt is an XDR NestedUnion defines as:
+// MustV0 retrieves the V0 value from the union,
+// panicing if the value is not set.
+func (u ClaimableBalanceId) MustV0() Hash {
+	val, ok := u.GetV0()
+
+	if !ok {
+		panic("arm V0 is not set")
+	}
+
+	return val
+}
+
+// GetV0 retrieves the V0 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u ClaimableBalanceId) GetV0() (result Hash, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "V0" {
+		result = *u.V0
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ClaimableBalanceId) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ClaimableBalanceId) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ClaimableBalanceId)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimableBalanceId)(nil)
+)
+
+// ClaimableBalanceEntryExt is an XDR NestedUnion defines as:
 //
-//   union switch (StellarValueType v)
+//   union switch (int v)
 //        {
-//        case STELLAR_VALUE_BASIC:
+//        case 0:
 //            void;
-//        case STELLAR_VALUE_SIGNED:
-//            LedgerCloseValueSignature lcValueSignature;
 //        }
 //
-type StellarValueExt struct {
-	V                StellarValueType
-	LcValueSignature *LedgerCloseValueSignature
+type ClaimableBalanceEntryExt struct {
+	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u StellarValueExt) SwitchFieldName() string {
+func (u ClaimableBalanceEntryExt) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of StellarValueExt
-func (u StellarValueExt) ArmForSwitch(sw int32) (string, bool) {
-	switch StellarValueType(sw) {
-	case StellarValueTypeStellarValueBasic:
+// the value for an instance of ClaimableBalanceEntryExt
+func (u ClaimableBalanceEntryExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
 		return "", true
-	case StellarValueTypeStellarValueSigned:
-		return "LcValueSignature", true
 	}
 	return "-", false
 }
 
-// NewStellarValueExt creates a new  StellarValueExt.
-func NewStellarValueExt(v StellarValueType, value interface{}) (result StellarValueExt, err error) {
+// NewClaimableBalanceEntryExt creates a new  ClaimableBalanceEntryExt.
+func NewClaimableBalanceEntryExt(v int32, value interface{}) (result ClaimableBalanceEntryExt, err error) {
 	result.V = v
-	switch StellarValueType(v) {
-	case StellarValueTypeStellarValueBasic:
+	switch int32(v) {
+	case 0:
 		// void
-	case StellarValueTypeStellarValueSigned:
-		tv, ok := value.(LedgerCloseValueSignature)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerCloseValueSignature")
-			return
-		}
-		result.LcValueSignature = &tv
-	}
-	return
-}
-
-// MustLcValueSignature retrieves the LcValueSignature value from the union,
-// panicing if the value is not set.
-func (u StellarValueExt) MustLcValueSignature() LedgerCloseValueSignature {
-	val, ok := u.GetLcValueSignature()
-
-	if !ok {
-		panic("arm LcValueSignature is not set")
-	}
-
-	return val
-}
-
-// GetLcValueSignature retrieves the LcValueSignature value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarValueExt) GetLcValueSignature() (result LedgerCloseValueSignature, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
-
-	if armName == "LcValueSignature" {
-		result = *u.LcValueSignature
-		ok = true
 	}
-
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s StellarValueExt) MarshalBinary() ([]byte, error) {
+func (s ClaimableBalanceEntryExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *StellarValueExt) UnmarshalBinary(inp []byte) error {
+func (s *ClaimableBalanceEntryExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*StellarValueExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*StellarValueExt)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimableBalanceEntryExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimableBalanceEntryExt)(nil)
 )
 
-// StellarValue is an XDR Struct defines as:
+// ClaimableBalanceEntry is an XDR Struct defines as:
 //
-//   struct StellarValue
+//   struct ClaimableBalanceEntry
 //    {
-//        Hash txSetHash;      // transaction set to apply to previous ledger
-//        TimePoint closeTime; // network close time
+//        // Unique identifier for this ClaimableBalanceEntry
+//        ClaimableBalanceID balanceID;
 //
-//        // upgrades to apply to the previous ledger (usually empty)
-//        // this is a vector of encoded 'LedgerUpgrade' so that nodes can drop
-//        // unknown steps during consensus if needed.
-//        // see notes below on 'LedgerUpgrade' for more detail
-//        // max size is dictated by number of upgrade types (+ room for future)
-//        UpgradeType upgrades<6>;
+//        // List of claimants with associated predicate
+//        Claimant claimants<10>;
+//
+//        // Any asset including native
+//        Asset asset;
+//
+//        // Amount of asset
+//        int64 amount;
 //
 //        // reserved for future use
-//        union switch (StellarValueType v)
+//        union switch (int v)
 //        {
-//        case STELLAR_VALUE_BASIC:
+//        case 0:
 //            void;
-//        case STELLAR_VALUE_SIGNED:
-//            LedgerCloseValueSignature lcValueSignature;
 //        }
 //        ext;
 //    };
 //
-type StellarValue struct {
-	TxSetHash Hash
-	CloseTime TimePoint
-	Upgrades  []UpgradeType `xdrmaxsize:"6"`
-	Ext       StellarValueExt
+type ClaimableBalanceEntry struct {
+	BalanceId ClaimableBalanceId
+	Claimants []Claimant `xdrmaxsize:"10"`
+	Asset     Asset
+	Amount    Int64
+	Ext       ClaimableBalanceEntryExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s StellarValue) MarshalBinary() ([]byte, error) {
+func (s ClaimableBalanceEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *StellarValue) UnmarshalBinary(inp []byte) error {
+func (s *ClaimableBalanceEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*StellarValue)(nil)
-	_ encoding.BinaryUnmarshaler = (*StellarValue)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimableBalanceEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimableBalanceEntry)(nil)
 )
 
-// LedgerHeaderExt is an XDR NestedUnion defines as:
+// LedgerEntryExtensionV1Ext is an XDR NestedUnion defines as:
 //
 //   union switch (int v)
 //        {
@@ -2929,19 +3154,19 @@ var (
 //            void;
 //        }
 //
-type LedgerHeaderExt struct {
+type LedgerEntryExtensionV1Ext struct {
 	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u LedgerHeaderExt) SwitchFieldName() string {
+func (u LedgerEntryExtensionV1Ext) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerHeaderExt
-func (u LedgerHeaderExt) ArmForSwitch(sw int32) (string, bool) {
+// the value for an instance of LedgerEntryExtensionV1Ext
+func (u LedgerEntryExtensionV1Ext) ArmForSwitch(sw int32) (string, bool) {
 	switch int32(sw) {
 	case 0:
 		return "", true
@@ -2949,8 +3174,8 @@ func (u LedgerHeaderExt) ArmForSwitch(sw int32) (string, bool) {
 	return "-", false
 }
 
-// NewLedgerHeaderExt creates a new  LedgerHeaderExt.
-func NewLedgerHeaderExt(v int32, value interface{}) (result LedgerHeaderExt, err error) {
+// NewLedgerEntryExtensionV1Ext creates a new  LedgerEntryExtensionV1Ext.
+func NewLedgerEntryExtensionV1Ext(v int32, value interface{}) (result LedgerEntryExtensionV1Ext, err error) {
 	result.V = v
 	switch int32(v) {
 	case 0:
@@ -2960,55 +3185,29 @@ func NewLedgerHeaderExt(v int32, value interface{}) (result LedgerHeaderExt, err
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerHeaderExt) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryExtensionV1Ext) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerHeaderExt) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryExtensionV1Ext) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerHeaderExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerHeaderExt)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryExtensionV1Ext)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryExtensionV1Ext)(nil)
 )
 
-// LedgerHeader is an XDR Struct defines as:
+// LedgerEntryExtensionV1 is an XDR Struct defines as:
 //
-//   struct LedgerHeader
+//   struct LedgerEntryExtensionV1
 //    {
-//        uint32 ledgerVersion;    // the protocol version of the ledger
-//        Hash previousLedgerHash; // hash of the previous ledger header
-//        StellarValue scpValue;   // what consensus agreed to
-//        Hash txSetResultHash;    // the TransactionResultSet that led to this ledger
-//        Hash bucketListHash;     // hash of the ledger state
-//
-//        uint32 ledgerSeq; // sequence number of this ledger
-//
-//        int64 totalCoins; // total number of stroops in existence.
-//                          // 10,000,000 stroops in 1 XLM
-//
-//        int64 feePool;       // fees burned since last inflation run
-//        uint32 inflationSeq; // inflation sequence number
-//
-//        uint64 idPool; // last used global ID, used for generating objects
-//
-//        uint32 baseFee;     // base fee per operation in stroops
-//        uint32 baseReserve; // account base reserve in stroops
-//
-//        uint32 maxTxSetSize; // maximum size a transaction set can be
-//
-//        Hash skipList[4]; // hashes of ledgers in the past. allows you to jump back
-//                          // in time without walking the chain back ledger by ledger
-//                          // each slot contains the oldest ledger that is mod of
-//                          // either 50  5000  50000 or 500000 depending on index
-//                          // skipList[0] mod(50), skipList[1] mod(5000), etc
+//        SponsorshipDescriptor sponsoringID;
 //
-//        // reserved for future use
 //        union switch (int v)
 //        {
 //        case 0:
@@ -3017,1465 +3216,1504 @@ var (
 //        ext;
 //    };
 //
-type LedgerHeader struct {
-	LedgerVersion      Uint32
-	PreviousLedgerHash Hash
-	ScpValue           StellarValue
-	TxSetResultHash    Hash
-	BucketListHash     Hash
-	LedgerSeq          Uint32
-	TotalCoins         Int64
-	FeePool            Int64
-	InflationSeq       Uint32
-	IdPool             Uint64
-	BaseFee            Uint32
-	BaseReserve        Uint32
-	MaxTxSetSize       Uint32
-	SkipList           [4]Hash
-	Ext                LedgerHeaderExt
+type LedgerEntryExtensionV1 struct {
+	SponsoringId SponsorshipDescriptor
+	Ext          LedgerEntryExtensionV1Ext
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerHeader) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryExtensionV1) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerHeader) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryExtensionV1) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerHeader)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerHeader)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryExtensionV1)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryExtensionV1)(nil)
 )
 
-// LedgerUpgradeType is an XDR Enum defines as:
+// LedgerEntryData is an XDR NestedUnion defines as:
 //
-//   enum LedgerUpgradeType
-//    {
-//        LEDGER_UPGRADE_VERSION = 1,
-//        LEDGER_UPGRADE_BASE_FEE = 2,
-//        LEDGER_UPGRADE_MAX_TX_SET_SIZE = 3,
-//        LEDGER_UPGRADE_BASE_RESERVE = 4
-//    };
+//   union switch (LedgerEntryType type)
+//        {
+//        case ACCOUNT:
+//            AccountEntry account;
+//        case TRUSTLINE:
+//            TrustLineEntry trustLine;
+//        case OFFER:
+//            OfferEntry offer;
+//        case DATA:
+//            DataEntry data;
+//        case CLAIMABLE_BALANCE:
+//            ClaimableBalanceEntry claimableBalance;
+//        }
 //
-type LedgerUpgradeType int32
-
-const (
-	LedgerUpgradeTypeLedgerUpgradeVersion      LedgerUpgradeType = 1
-	LedgerUpgradeTypeLedgerUpgradeBaseFee      LedgerUpgradeType = 2
-	LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize LedgerUpgradeType = 3
-	LedgerUpgradeTypeLedgerUpgradeBaseReserve  LedgerUpgradeType = 4
-)
-
-var ledgerUpgradeTypeMap = map[int32]string{
-	1: "LedgerUpgradeTypeLedgerUpgradeVersion",
-	2: "LedgerUpgradeTypeLedgerUpgradeBaseFee",
-	3: "LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize",
-	4: "LedgerUpgradeTypeLedgerUpgradeBaseReserve",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for LedgerUpgradeType
-func (e LedgerUpgradeType) ValidEnum(v int32) bool {
-	_, ok := ledgerUpgradeTypeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e LedgerUpgradeType) String() string {
-	name, _ := ledgerUpgradeTypeMap[int32(e)]
-	return name
+type LedgerEntryData struct {
+	Type             LedgerEntryType
+	Account          *AccountEntry
+	TrustLine        *TrustLineEntry
+	Offer            *OfferEntry
+	Data             *DataEntry
+	ClaimableBalance *ClaimableBalanceEntry
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerUpgradeType) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerUpgradeType) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*LedgerUpgradeType)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerUpgradeType)(nil)
-)
-
-// LedgerUpgrade is an XDR Union defines as:
-//
-//   union LedgerUpgrade switch (LedgerUpgradeType type)
-//    {
-//    case LEDGER_UPGRADE_VERSION:
-//        uint32 newLedgerVersion; // update ledgerVersion
-//    case LEDGER_UPGRADE_BASE_FEE:
-//        uint32 newBaseFee; // update baseFee
-//    case LEDGER_UPGRADE_MAX_TX_SET_SIZE:
-//        uint32 newMaxTxSetSize; // update maxTxSetSize
-//    case LEDGER_UPGRADE_BASE_RESERVE:
-//        uint32 newBaseReserve; // update baseReserve
-//    };
-//
-type LedgerUpgrade struct {
-	Type             LedgerUpgradeType
-	NewLedgerVersion *Uint32
-	NewBaseFee       *Uint32
-	NewMaxTxSetSize  *Uint32
-	NewBaseReserve   *Uint32
-}
-
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u LedgerUpgrade) SwitchFieldName() string {
-	return "Type"
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u LedgerEntryData) SwitchFieldName() string {
+	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerUpgrade
-func (u LedgerUpgrade) ArmForSwitch(sw int32) (string, bool) {
-	switch LedgerUpgradeType(sw) {
-	case LedgerUpgradeTypeLedgerUpgradeVersion:
-		return "NewLedgerVersion", true
-	case LedgerUpgradeTypeLedgerUpgradeBaseFee:
-		return "NewBaseFee", true
-	case LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
-		return "NewMaxTxSetSize", true
-	case LedgerUpgradeTypeLedgerUpgradeBaseReserve:
-		return "NewBaseReserve", true
+// the value for an instance of LedgerEntryData
+func (u LedgerEntryData) ArmForSwitch(sw int32) (string, bool) {
+	switch LedgerEntryType(sw) {
+	case LedgerEntryTypeAccount:
+		return "Account", true
+	case LedgerEntryTypeTrustline:
+		return "TrustLine", true
+	case LedgerEntryTypeOffer:
+		return "Offer", true
+	case LedgerEntryTypeData:
+		return "Data", true
+	case LedgerEntryTypeClaimableBalance:
+		return "ClaimableBalance", true
 	}
 	return "-", false
 }
 
-// NewLedgerUpgrade creates a new  LedgerUpgrade.
-func NewLedgerUpgrade(aType LedgerUpgradeType, value interface{}) (result LedgerUpgrade, err error) {
+// NewLedgerEntryData creates a new  LedgerEntryData.
+func NewLedgerEntryData(aType LedgerEntryType, value interface{}) (result LedgerEntryData, err error) {
 	result.Type = aType
-	switch LedgerUpgradeType(aType) {
-	case LedgerUpgradeTypeLedgerUpgradeVersion:
-		tv, ok := value.(Uint32)
+	switch LedgerEntryType(aType) {
+	case LedgerEntryTypeAccount:
+		tv, ok := value.(AccountEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint32")
+			err = fmt.Errorf("invalid value, must be AccountEntry")
 			return
 		}
-		result.NewLedgerVersion = &tv
-	case LedgerUpgradeTypeLedgerUpgradeBaseFee:
-		tv, ok := value.(Uint32)
+		result.Account = &tv
+	case LedgerEntryTypeTrustline:
+		tv, ok := value.(TrustLineEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint32")
+			err = fmt.Errorf("invalid value, must be TrustLineEntry")
 			return
 		}
-		result.NewBaseFee = &tv
-	case LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
-		tv, ok := value.(Uint32)
+		result.TrustLine = &tv
+	case LedgerEntryTypeOffer:
+		tv, ok := value.(OfferEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint32")
+			err = fmt.Errorf("invalid value, must be OfferEntry")
 			return
 		}
-		result.NewMaxTxSetSize = &tv
-	case LedgerUpgradeTypeLedgerUpgradeBaseReserve:
-		tv, ok := value.(Uint32)
+		result.Offer = &tv
+	case LedgerEntryTypeData:
+		tv, ok := value.(DataEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint32")
+			err = fmt.Errorf("invalid value, must be DataEntry")
 			return
 		}
-		result.NewBaseReserve = &tv
+		result.Data = &tv
+	case LedgerEntryTypeClaimableBalance:
+		tv, ok := value.(ClaimableBalanceEntry)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ClaimableBalanceEntry")
+			return
+		}
+		result.ClaimableBalance = &tv
 	}
 	return
 }
 
-// MustNewLedgerVersion retrieves the NewLedgerVersion value from the union,
+// MustAccount retrieves the Account value from the union,
 // panicing if the value is not set.
-func (u LedgerUpgrade) MustNewLedgerVersion() Uint32 {
-	val, ok := u.GetNewLedgerVersion()
+func (u LedgerEntryData) MustAccount() AccountEntry {
+	val, ok := u.GetAccount()
 
 	if !ok {
-		panic("arm NewLedgerVersion is not set")
+		panic("arm Account is not set")
 	}
 
 	return val
 }
 
-// GetNewLedgerVersion retrieves the NewLedgerVersion value from the union,
+// GetAccount retrieves the Account value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerUpgrade) GetNewLedgerVersion() (result Uint32, ok bool) {
+func (u LedgerEntryData) GetAccount() (result AccountEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "NewLedgerVersion" {
-		result = *u.NewLedgerVersion
+	if armName == "Account" {
+		result = *u.Account
 		ok = true
 	}
 
 	return
 }
 
-// MustNewBaseFee retrieves the NewBaseFee value from the union,
+// MustTrustLine retrieves the TrustLine value from the union,
 // panicing if the value is not set.
-func (u LedgerUpgrade) MustNewBaseFee() Uint32 {
-	val, ok := u.GetNewBaseFee()
+func (u LedgerEntryData) MustTrustLine() TrustLineEntry {
+	val, ok := u.GetTrustLine()
 
 	if !ok {
-		panic("arm NewBaseFee is not set")
+		panic("arm TrustLine is not set")
 	}
 
 	return val
 }
 
-// GetNewBaseFee retrieves the NewBaseFee value from the union,
+// GetTrustLine retrieves the TrustLine value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerUpgrade) GetNewBaseFee() (result Uint32, ok bool) {
+func (u LedgerEntryData) GetTrustLine() (result TrustLineEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "NewBaseFee" {
-		result = *u.NewBaseFee
+	if armName == "TrustLine" {
+		result = *u.TrustLine
 		ok = true
 	}
 
 	return
 }
 
-// MustNewMaxTxSetSize retrieves the NewMaxTxSetSize value from the union,
+// MustOffer retrieves the Offer value from the union,
 // panicing if the value is not set.
-func (u LedgerUpgrade) MustNewMaxTxSetSize() Uint32 {
-	val, ok := u.GetNewMaxTxSetSize()
+func (u LedgerEntryData) MustOffer() OfferEntry {
+	val, ok := u.GetOffer()
 
 	if !ok {
-		panic("arm NewMaxTxSetSize is not set")
+		panic("arm Offer is not set")
 	}
 
 	return val
 }
 
-// GetNewMaxTxSetSize retrieves the NewMaxTxSetSize value from the union,
+// GetOffer retrieves the Offer value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerUpgrade) GetNewMaxTxSetSize() (result Uint32, ok bool) {
+func (u LedgerEntryData) GetOffer() (result OfferEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "NewMaxTxSetSize" {
-		result = *u.NewMaxTxSetSize
+	if armName == "Offer" {
+		result = *u.Offer
 		ok = true
 	}
 
 	return
 }
 
-// MustNewBaseReserve retrieves the NewBaseReserve value from the union,
+// MustData retrieves the Data value from the union,
 // panicing if the value is not set.
-func (u LedgerUpgrade) MustNewBaseReserve() Uint32 {
-	val, ok := u.GetNewBaseReserve()
+func (u LedgerEntryData) MustData() DataEntry {
+	val, ok := u.GetData()
 
 	if !ok {
-		panic("arm NewBaseReserve is not set")
+		panic("arm Data is not set")
 	}
 
 	return val
 }
 
-// GetNewBaseReserve retrieves the NewBaseReserve value from the union,
+// GetData retrieves the Data value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerUpgrade) GetNewBaseReserve() (result Uint32, ok bool) {
+func (u LedgerEntryData) GetData() (result DataEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "NewBaseReserve" {
-		result = *u.NewBaseReserve
+	if armName == "Data" {
+		result = *u.Data
 		ok = true
 	}
 
 	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerUpgrade) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustClaimableBalance retrieves the ClaimableBalance value from the union,
+// panicing if the value is not set.
+func (u LedgerEntryData) MustClaimableBalance() ClaimableBalanceEntry {
+	val, ok := u.GetClaimableBalance()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerUpgrade) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm ClaimableBalance is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*LedgerUpgrade)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerUpgrade)(nil)
-)
+// GetClaimableBalance retrieves the ClaimableBalance value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerEntryData) GetClaimableBalance() (result ClaimableBalanceEntry, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// LedgerKeyAccount is an XDR NestedStruct defines as:
-//
-//   struct
-//        {
-//            AccountID accountID;
-//        }
-//
-type LedgerKeyAccount struct {
-	AccountId AccountId
+	if armName == "ClaimableBalance" {
+		result = *u.ClaimableBalance
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerKeyAccount) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryData) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerKeyAccount) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryData) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerKeyAccount)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerKeyAccount)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryData)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryData)(nil)
 )
 
-// LedgerKeyTrustLine is an XDR NestedStruct defines as:
+// LedgerEntryExt is an XDR NestedUnion defines as:
 //
-//   struct
+//   union switch (int v)
 //        {
-//            AccountID accountID;
-//            Asset asset;
+//        case 0:
+//            void;
+//        case 1:
+//            LedgerEntryExtensionV1 v1;
 //        }
 //
-type LedgerKeyTrustLine struct {
-	AccountId AccountId
-	Asset     Asset
+type LedgerEntryExt struct {
+	V  int32
+	V1 *LedgerEntryExtensionV1
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerKeyTrustLine) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u LedgerEntryExt) SwitchFieldName() string {
+	return "V"
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerKeyTrustLine) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of LedgerEntryExt
+func (u LedgerEntryExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
+	case 1:
+		return "V1", true
+	}
+	return "-", false
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*LedgerKeyTrustLine)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerKeyTrustLine)(nil)
-)
-
-// LedgerKeyOffer is an XDR NestedStruct defines as:
-//
-//   struct
-//        {
-//            AccountID sellerID;
-//            int64 offerID;
-//        }
-//
-type LedgerKeyOffer struct {
-	SellerId AccountId
-	OfferId  Int64
+// NewLedgerEntryExt creates a new  LedgerEntryExt.
+func NewLedgerEntryExt(v int32, value interface{}) (result LedgerEntryExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	case 1:
+		tv, ok := value.(LedgerEntryExtensionV1)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerEntryExtensionV1")
+			return
+		}
+		result.V1 = &tv
+	}
+	return
+}
+
+// MustV1 retrieves the V1 value from the union,
+// panicing if the value is not set.
+func (u LedgerEntryExt) MustV1() LedgerEntryExtensionV1 {
+	val, ok := u.GetV1()
+
+	if !ok {
+		panic("arm V1 is not set")
+	}
+
+	return val
+}
+
+// GetV1 retrieves the V1 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerEntryExt) GetV1() (result LedgerEntryExtensionV1, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V1" {
+		result = *u.V1
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerKeyOffer) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerKeyOffer) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerKeyOffer)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerKeyOffer)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryExt)(nil)
 )
 
-// LedgerKeyData is an XDR NestedStruct defines as:
+// LedgerEntry is an XDR Struct defines as:
 //
-//   struct
+//   struct LedgerEntry
+//    {
+//        uint32 lastModifiedLedgerSeq; // ledger the LedgerEntry was last changed
+//
+//        union switch (LedgerEntryType type)
 //        {
-//            AccountID accountID;
-//            string64 dataName;
+//        case ACCOUNT:
+//            AccountEntry account;
+//        case TRUSTLINE:
+//            TrustLineEntry trustLine;
+//        case OFFER:
+//            OfferEntry offer;
+//        case DATA:
+//            DataEntry data;
+//        case CLAIMABLE_BALANCE:
+//            ClaimableBalanceEntry claimableBalance;
 //        }
+//        data;
 //
-type LedgerKeyData struct {
-	AccountId AccountId
-	DataName  String64
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        case 1:
+//            LedgerEntryExtensionV1 v1;
+//        }
+//        ext;
+//    };
+//
+type LedgerEntry struct {
+	LastModifiedLedgerSeq Uint32
+	Data                  LedgerEntryData
+	Ext                   LedgerEntryExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerKeyData) MarshalBinary() ([]byte, error) {
+func (s LedgerEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerKeyData) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerKeyData)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerKeyData)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntry)(nil)
 )
 
-// LedgerKey is an XDR Union defines as:
+// EnvelopeType is an XDR Enum defines as:
 //
-//   union LedgerKey switch (LedgerEntryType type)
+//   enum EnvelopeType
 //    {
-//    case ACCOUNT:
-//        struct
-//        {
-//            AccountID accountID;
-//        } account;
-//
-//    case TRUSTLINE:
-//        struct
-//        {
-//            AccountID accountID;
-//            Asset asset;
-//        } trustLine;
-//
-//    case OFFER:
-//        struct
-//        {
-//            AccountID sellerID;
-//            int64 offerID;
-//        } offer;
-//
-//    case DATA:
-//        struct
-//        {
-//            AccountID accountID;
-//            string64 dataName;
-//        } data;
+//        ENVELOPE_TYPE_TX_V0 = 0,
+//        ENVELOPE_TYPE_SCP = 1,
+//        ENVELOPE_TYPE_TX = 2,
+//        ENVELOPE_TYPE_AUTH = 3,
+//        ENVELOPE_TYPE_SCPVALUE = 4,
+//        ENVELOPE_TYPE_TX_FEE_BUMP = 5,
+//        ENVELOPE_TYPE_OP_ID = 6
 //    };
 //
-type LedgerKey struct {
-	Type      LedgerEntryType
-	Account   *LedgerKeyAccount
-	TrustLine *LedgerKeyTrustLine
-	Offer     *LedgerKeyOffer
-	Data      *LedgerKeyData
-}
+type EnvelopeType int32
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u LedgerKey) SwitchFieldName() string {
-	return "Type"
-}
+const (
+	EnvelopeTypeEnvelopeTypeTxV0      EnvelopeType = 0
+	EnvelopeTypeEnvelopeTypeScp       EnvelopeType = 1
+	EnvelopeTypeEnvelopeTypeTx        EnvelopeType = 2
+	EnvelopeTypeEnvelopeTypeAuth      EnvelopeType = 3
+	EnvelopeTypeEnvelopeTypeScpvalue  EnvelopeType = 4
+	EnvelopeTypeEnvelopeTypeTxFeeBump EnvelopeType = 5
+	EnvelopeTypeEnvelopeTypeOpId      EnvelopeType = 6
+)
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerKey
-func (u LedgerKey) ArmForSwitch(sw int32) (string, bool) {
-	switch LedgerEntryType(sw) {
-	case LedgerEntryTypeAccount:
-		return "Account", true
-	case LedgerEntryTypeTrustline:
-		return "TrustLine", true
-	case LedgerEntryTypeOffer:
-		return "Offer", true
-	case LedgerEntryTypeData:
-		return "Data", true
-	}
-	return "-", false
+var envelopeTypeMap = map[int32]string{
+	0: "EnvelopeTypeEnvelopeTypeTxV0",
+	1: "EnvelopeTypeEnvelopeTypeScp",
+	2: "EnvelopeTypeEnvelopeTypeTx",
+	3: "EnvelopeTypeEnvelopeTypeAuth",
+	4: "EnvelopeTypeEnvelopeTypeScpvalue",
+	5: "EnvelopeTypeEnvelopeTypeTxFeeBump",
+	6: "EnvelopeTypeEnvelopeTypeOpId",
 }
 
-// NewLedgerKey creates a new  LedgerKey.
-func NewLedgerKey(aType LedgerEntryType, value interface{}) (result LedgerKey, err error) {
-	result.Type = aType
-	switch LedgerEntryType(aType) {
-	case LedgerEntryTypeAccount:
-		tv, ok := value.(LedgerKeyAccount)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerKeyAccount")
-			return
-		}
-		result.Account = &tv
-	case LedgerEntryTypeTrustline:
-		tv, ok := value.(LedgerKeyTrustLine)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerKeyTrustLine")
-			return
-		}
-		result.TrustLine = &tv
-	case LedgerEntryTypeOffer:
-		tv, ok := value.(LedgerKeyOffer)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerKeyOffer")
-			return
-		}
-		result.Offer = &tv
-	case LedgerEntryTypeData:
-		tv, ok := value.(LedgerKeyData)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerKeyData")
-			return
-		}
-		result.Data = &tv
-	}
-	return
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for EnvelopeType
+func (e EnvelopeType) ValidEnum(v int32) bool {
+	_, ok := envelopeTypeMap[v]
+	return ok
 }
 
-// MustAccount retrieves the Account value from the union,
-// panicing if the value is not set.
-func (u LedgerKey) MustAccount() LedgerKeyAccount {
-	val, ok := u.GetAccount()
+// String returns the name of `e`
+func (e EnvelopeType) String() string {
+	name, _ := envelopeTypeMap[int32(e)]
+	return name
+}
 
-	if !ok {
-		panic("arm Account is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s EnvelopeType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *EnvelopeType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetAccount retrieves the Account value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerKey) GetAccount() (result LedgerKeyAccount, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*EnvelopeType)(nil)
+	_ encoding.BinaryUnmarshaler = (*EnvelopeType)(nil)
+)
 
-	if armName == "Account" {
-		result = *u.Account
-		ok = true
-	}
+// UpgradeType is an XDR Typedef defines as:
+//
+//   typedef opaque UpgradeType<128>;
+//
+type UpgradeType []byte
 
-	return
+// XDRMaxSize implements the Sized interface for UpgradeType
+func (e UpgradeType) XDRMaxSize() int {
+	return 128
 }
 
-// MustTrustLine retrieves the TrustLine value from the union,
-// panicing if the value is not set.
-func (u LedgerKey) MustTrustLine() LedgerKeyTrustLine {
-	val, ok := u.GetTrustLine()
-
-	if !ok {
-		panic("arm TrustLine is not set")
-	}
-
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s UpgradeType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetTrustLine retrieves the TrustLine value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerKey) GetTrustLine() (result LedgerKeyTrustLine, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "TrustLine" {
-		result = *u.TrustLine
-		ok = true
-	}
-
-	return
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *UpgradeType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// MustOffer retrieves the Offer value from the union,
-// panicing if the value is not set.
-func (u LedgerKey) MustOffer() LedgerKeyOffer {
-	val, ok := u.GetOffer()
-
-	if !ok {
-		panic("arm Offer is not set")
-	}
-
-	return val
-}
+var (
+	_ encoding.BinaryMarshaler   = (*UpgradeType)(nil)
+	_ encoding.BinaryUnmarshaler = (*UpgradeType)(nil)
+)
 
-// GetOffer retrieves the Offer value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerKey) GetOffer() (result LedgerKeyOffer, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// StellarValueType is an XDR Enum defines as:
+//
+//   enum StellarValueType
+//    {
+//        STELLAR_VALUE_BASIC = 0,
+//        STELLAR_VALUE_SIGNED = 1
+//    };
+//
+type StellarValueType int32
 
-	if armName == "Offer" {
-		result = *u.Offer
-		ok = true
-	}
+const (
+	StellarValueTypeStellarValueBasic  StellarValueType = 0
+	StellarValueTypeStellarValueSigned StellarValueType = 1
+)
 
-	return
+var stellarValueTypeMap = map[int32]string{
+	0: "StellarValueTypeStellarValueBasic",
+	1: "StellarValueTypeStellarValueSigned",
 }
 
-// MustData retrieves the Data value from the union,
-// panicing if the value is not set.
-func (u LedgerKey) MustData() LedgerKeyData {
-	val, ok := u.GetData()
-
-	if !ok {
-		panic("arm Data is not set")
-	}
-
-	return val
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for StellarValueType
+func (e StellarValueType) ValidEnum(v int32) bool {
+	_, ok := stellarValueTypeMap[v]
+	return ok
 }
 
-// GetData retrieves the Data value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerKey) GetData() (result LedgerKeyData, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "Data" {
-		result = *u.Data
-		ok = true
-	}
-
-	return
+// String returns the name of `e`
+func (e StellarValueType) String() string {
+	name, _ := stellarValueTypeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerKey) MarshalBinary() ([]byte, error) {
+func (s StellarValueType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerKey) UnmarshalBinary(inp []byte) error {
+func (s *StellarValueType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerKey)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerKey)(nil)
+	_ encoding.BinaryMarshaler   = (*StellarValueType)(nil)
+	_ encoding.BinaryUnmarshaler = (*StellarValueType)(nil)
 )
 
-// BucketEntryType is an XDR Enum defines as:
+// LedgerCloseValueSignature is an XDR Struct defines as:
 //
-//   enum BucketEntryType
+//   struct LedgerCloseValueSignature
 //    {
-//        METAENTRY =
-//            -1, // At-and-after protocol 11: bucket metadata, should come first.
-//        LIVEENTRY = 0, // Before protocol 11: created-or-updated;
-//                       // At-and-after protocol 11: only updated.
-//        DEADENTRY = 1,
-//        INITENTRY = 2 // At-and-after protocol 11: only created.
+//        NodeID nodeID;       // which node introduced the value
+//        Signature signature; // nodeID's signature
 //    };
 //
-type BucketEntryType int32
-
-const (
-	BucketEntryTypeMetaentry BucketEntryType = -1
-	BucketEntryTypeLiveentry BucketEntryType = 0
-	BucketEntryTypeDeadentry BucketEntryType = 1
-	BucketEntryTypeInitentry BucketEntryType = 2
-)
-
-var bucketEntryTypeMap = map[int32]string{
-	-1: "BucketEntryTypeMetaentry",
-	0:  "BucketEntryTypeLiveentry",
-	1:  "BucketEntryTypeDeadentry",
-	2:  "BucketEntryTypeInitentry",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for BucketEntryType
-func (e BucketEntryType) ValidEnum(v int32) bool {
-	_, ok := bucketEntryTypeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e BucketEntryType) String() string {
-	name, _ := bucketEntryTypeMap[int32(e)]
-	return name
+type LedgerCloseValueSignature struct {
+	NodeId    NodeId
+	Signature Signature
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BucketEntryType) MarshalBinary() ([]byte, error) {
+func (s LedgerCloseValueSignature) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BucketEntryType) UnmarshalBinary(inp []byte) error {
+func (s *LedgerCloseValueSignature) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BucketEntryType)(nil)
-	_ encoding.BinaryUnmarshaler = (*BucketEntryType)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerCloseValueSignature)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerCloseValueSignature)(nil)
 )
 
-// BucketMetadataExt is an XDR NestedUnion defines as:
+// StellarValueExt is an XDR NestedUnion defines as:
 //
-//   union switch (int v)
+//   union switch (StellarValueType v)
 //        {
-//        case 0:
+//        case STELLAR_VALUE_BASIC:
 //            void;
+//        case STELLAR_VALUE_SIGNED:
+//            LedgerCloseValueSignature lcValueSignature;
 //        }
 //
-type BucketMetadataExt struct {
-	V int32
+type StellarValueExt struct {
+	V                StellarValueType
+	LcValueSignature *LedgerCloseValueSignature
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u BucketMetadataExt) SwitchFieldName() string {
+func (u StellarValueExt) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of BucketMetadataExt
-func (u BucketMetadataExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
+// the value for an instance of StellarValueExt
+func (u StellarValueExt) ArmForSwitch(sw int32) (string, bool) {
+	switch StellarValueType(sw) {
+	case StellarValueTypeStellarValueBasic:
 		return "", true
+	case StellarValueTypeStellarValueSigned:
+		return "LcValueSignature", true
 	}
 	return "-", false
 }
 
-// NewBucketMetadataExt creates a new  BucketMetadataExt.
-func NewBucketMetadataExt(v int32, value interface{}) (result BucketMetadataExt, err error) {
+// NewStellarValueExt creates a new  StellarValueExt.
+func NewStellarValueExt(v StellarValueType, value interface{}) (result StellarValueExt, err error) {
 	result.V = v
-	switch int32(v) {
-	case 0:
+	switch StellarValueType(v) {
+	case StellarValueTypeStellarValueBasic:
 		// void
+	case StellarValueTypeStellarValueSigned:
+		tv, ok := value.(LedgerCloseValueSignature)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerCloseValueSignature")
+			return
+		}
+		result.LcValueSignature = &tv
+	}
+	return
+}
+
+// MustLcValueSignature retrieves the LcValueSignature value from the union,
+// panicing if the value is not set.
+func (u StellarValueExt) MustLcValueSignature() LedgerCloseValueSignature {
+	val, ok := u.GetLcValueSignature()
+
+	if !ok {
+		panic("arm LcValueSignature is not set")
+	}
+
+	return val
+}
+
+// GetLcValueSignature retrieves the LcValueSignature value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarValueExt) GetLcValueSignature() (result LedgerCloseValueSignature, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "LcValueSignature" {
+		result = *u.LcValueSignature
+		ok = true
 	}
+
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BucketMetadataExt) MarshalBinary() ([]byte, error) {
+func (s StellarValueExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BucketMetadataExt) UnmarshalBinary(inp []byte) error {
+func (s *StellarValueExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BucketMetadataExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*BucketMetadataExt)(nil)
+	_ encoding.BinaryMarshaler   = (*StellarValueExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*StellarValueExt)(nil)
 )
 
-// BucketMetadata is an XDR Struct defines as:
+// StellarValue is an XDR Struct defines as:
 //
-//   struct BucketMetadata
+//   struct StellarValue
 //    {
-//        // Indicates the protocol version used to create / merge this bucket.
-//        uint32 ledgerVersion;
+//        Hash txSetHash;      // transaction set to apply to previous ledger
+//        TimePoint closeTime; // network close time
+//
+//        // upgrades to apply to the previous ledger (usually empty)
+//        // this is a vector of encoded 'LedgerUpgrade' so that nodes can drop
+//        // unknown steps during consensus if needed.
+//        // see notes below on 'LedgerUpgrade' for more detail
+//        // max size is dictated by number of upgrade types (+ room for future)
+//        UpgradeType upgrades<6>;
 //
 //        // reserved for future use
-//        union switch (int v)
+//        union switch (StellarValueType v)
 //        {
-//        case 0:
+//        case STELLAR_VALUE_BASIC:
 //            void;
+//        case STELLAR_VALUE_SIGNED:
+//            LedgerCloseValueSignature lcValueSignature;
 //        }
 //        ext;
 //    };
 //
-type BucketMetadata struct {
-	LedgerVersion Uint32
-	Ext           BucketMetadataExt
+type StellarValue struct {
+	TxSetHash Hash
+	CloseTime TimePoint
+	Upgrades  []UpgradeType `xdrmaxsize:"6"`
+	Ext       StellarValueExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BucketMetadata) MarshalBinary() ([]byte, error) {
+func (s StellarValue) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BucketMetadata) UnmarshalBinary(inp []byte) error {
+func (s *StellarValue) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BucketMetadata)(nil)
-	_ encoding.BinaryUnmarshaler = (*BucketMetadata)(nil)
+	_ encoding.BinaryMarshaler   = (*StellarValue)(nil)
+	_ encoding.BinaryUnmarshaler = (*StellarValue)(nil)
 )
 
-// BucketEntry is an XDR Union defines as:
-//
-//   union BucketEntry switch (BucketEntryType type)
-//    {
-//    case LIVEENTRY:
-//    case INITENTRY:
-//        LedgerEntry liveEntry;
+// LedgerHeaderExt is an XDR NestedUnion defines as:
 //
-//    case DEADENTRY:
-//        LedgerKey deadEntry;
-//    case METAENTRY:
-//        BucketMetadata metaEntry;
-//    };
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
 //
-type BucketEntry struct {
-	Type      BucketEntryType
-	LiveEntry *LedgerEntry
-	DeadEntry *LedgerKey
-	MetaEntry *BucketMetadata
+type LedgerHeaderExt struct {
+	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u BucketEntry) SwitchFieldName() string {
-	return "Type"
+func (u LedgerHeaderExt) SwitchFieldName() string {
+	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of BucketEntry
-func (u BucketEntry) ArmForSwitch(sw int32) (string, bool) {
-	switch BucketEntryType(sw) {
-	case BucketEntryTypeLiveentry:
-		return "LiveEntry", true
-	case BucketEntryTypeInitentry:
-		return "LiveEntry", true
-	case BucketEntryTypeDeadentry:
-		return "DeadEntry", true
-	case BucketEntryTypeMetaentry:
-		return "MetaEntry", true
+// the value for an instance of LedgerHeaderExt
+func (u LedgerHeaderExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
 	}
 	return "-", false
 }
 
-// NewBucketEntry creates a new  BucketEntry.
-func NewBucketEntry(aType BucketEntryType, value interface{}) (result BucketEntry, err error) {
-	result.Type = aType
-	switch BucketEntryType(aType) {
-	case BucketEntryTypeLiveentry:
-		tv, ok := value.(LedgerEntry)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerEntry")
-			return
-		}
-		result.LiveEntry = &tv
-	case BucketEntryTypeInitentry:
-		tv, ok := value.(LedgerEntry)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerEntry")
-			return
-		}
-		result.LiveEntry = &tv
-	case BucketEntryTypeDeadentry:
-		tv, ok := value.(LedgerKey)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerKey")
-			return
-		}
-		result.DeadEntry = &tv
-	case BucketEntryTypeMetaentry:
-		tv, ok := value.(BucketMetadata)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be BucketMetadata")
-			return
-		}
-		result.MetaEntry = &tv
-	}
-	return
-}
-
-// MustLiveEntry retrieves the LiveEntry value from the union,
-// panicing if the value is not set.
-func (u BucketEntry) MustLiveEntry() LedgerEntry {
-	val, ok := u.GetLiveEntry()
-
-	if !ok {
-		panic("arm LiveEntry is not set")
-	}
-
-	return val
-}
-
-// GetLiveEntry retrieves the LiveEntry value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u BucketEntry) GetLiveEntry() (result LedgerEntry, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "LiveEntry" {
-		result = *u.LiveEntry
-		ok = true
-	}
-
-	return
-}
-
-// MustDeadEntry retrieves the DeadEntry value from the union,
-// panicing if the value is not set.
-func (u BucketEntry) MustDeadEntry() LedgerKey {
-	val, ok := u.GetDeadEntry()
-
-	if !ok {
-		panic("arm DeadEntry is not set")
-	}
-
-	return val
-}
-
-// GetDeadEntry retrieves the DeadEntry value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u BucketEntry) GetDeadEntry() (result LedgerKey, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "DeadEntry" {
-		result = *u.DeadEntry
-		ok = true
-	}
-
-	return
-}
-
-// MustMetaEntry retrieves the MetaEntry value from the union,
-// panicing if the value is not set.
-func (u BucketEntry) MustMetaEntry() BucketMetadata {
-	val, ok := u.GetMetaEntry()
-
-	if !ok {
-		panic("arm MetaEntry is not set")
-	}
-
-	return val
-}
-
-// GetMetaEntry retrieves the MetaEntry value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u BucketEntry) GetMetaEntry() (result BucketMetadata, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "MetaEntry" {
-		result = *u.MetaEntry
-		ok = true
+// NewLedgerHeaderExt creates a new  LedgerHeaderExt.
+func NewLedgerHeaderExt(v int32, value interface{}) (result LedgerHeaderExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
 	}
-
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BucketEntry) MarshalBinary() ([]byte, error) {
+func (s LedgerHeaderExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BucketEntry) UnmarshalBinary(inp []byte) error {
+func (s *LedgerHeaderExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BucketEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*BucketEntry)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerHeaderExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerHeaderExt)(nil)
 )
 
-// TransactionSet is an XDR Struct defines as:
+// LedgerHeader is an XDR Struct defines as:
 //
-//   struct TransactionSet
+//   struct LedgerHeader
 //    {
-//        Hash previousLedgerHash;
-//        TransactionEnvelope txs<>;
+//        uint32 ledgerVersion;    // the protocol version of the ledger
+//        Hash previousLedgerHash; // hash of the previous ledger header
+//        StellarValue scpValue;   // what consensus agreed to
+//        Hash txSetResultHash;    // the TransactionResultSet that led to this ledger
+//        Hash bucketListHash;     // hash of the ledger state
+//
+//        uint32 ledgerSeq; // sequence number of this ledger
+//
+//        int64 totalCoins; // total number of stroops in existence.
+//                          // 10,000,000 stroops in 1 XLM
+//
+//        int64 feePool;       // fees burned since last inflation run
+//        uint32 inflationSeq; // inflation sequence number
+//
+//        uint64 idPool; // last used global ID, used for generating objects
+//
+//        uint32 baseFee;     // base fee per operation in stroops
+//        uint32 baseReserve; // account base reserve in stroops
+//
+//        uint32 maxTxSetSize; // maximum size a transaction set can be
+//
+//        Hash skipList[4]; // hashes of ledgers in the past. allows you to jump back
+//                          // in time without walking the chain back ledger by ledger
+//                          // each slot contains the oldest ledger that is mod of
+//                          // either 50  5000  50000 or 500000 depending on index
+//                          // skipList[0] mod(50), skipList[1] mod(5000), etc
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
 //    };
 //
-type TransactionSet struct {
+type LedgerHeader struct {
+	LedgerVersion      Uint32
 	PreviousLedgerHash Hash
-	Txs                []TransactionEnvelope
+	ScpValue           StellarValue
+	TxSetResultHash    Hash
+	BucketListHash     Hash
+	LedgerSeq          Uint32
+	TotalCoins         Int64
+	FeePool            Int64
+	InflationSeq       Uint32
+	IdPool             Uint64
+	BaseFee            Uint32
+	BaseReserve        Uint32
+	MaxTxSetSize       Uint32
+	SkipList           [4]Hash
+	Ext                LedgerHeaderExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionSet) MarshalBinary() ([]byte, error) {
+func (s LedgerHeader) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionSet) UnmarshalBinary(inp []byte) error {
+func (s *LedgerHeader) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionSet)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionSet)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerHeader)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerHeader)(nil)
 )
 
-// TransactionResultPair is an XDR Struct defines as:
+// LedgerUpgradeType is an XDR Enum defines as:
 //
-//   struct TransactionResultPair
+//   enum LedgerUpgradeType
 //    {
-//        Hash transactionHash;
-//        TransactionResult result; // result for the transaction
+//        LEDGER_UPGRADE_VERSION = 1,
+//        LEDGER_UPGRADE_BASE_FEE = 2,
+//        LEDGER_UPGRADE_MAX_TX_SET_SIZE = 3,
+//        LEDGER_UPGRADE_BASE_RESERVE = 4
 //    };
 //
-type TransactionResultPair struct {
-	TransactionHash Hash
-	Result          TransactionResult
-}
+type LedgerUpgradeType int32
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionResultPair) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+const (
+	LedgerUpgradeTypeLedgerUpgradeVersion      LedgerUpgradeType = 1
+	LedgerUpgradeTypeLedgerUpgradeBaseFee      LedgerUpgradeType = 2
+	LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize LedgerUpgradeType = 3
+	LedgerUpgradeTypeLedgerUpgradeBaseReserve  LedgerUpgradeType = 4
+)
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionResultPair) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+var ledgerUpgradeTypeMap = map[int32]string{
+	1: "LedgerUpgradeTypeLedgerUpgradeVersion",
+	2: "LedgerUpgradeTypeLedgerUpgradeBaseFee",
+	3: "LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize",
+	4: "LedgerUpgradeTypeLedgerUpgradeBaseReserve",
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionResultPair)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionResultPair)(nil)
-)
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for LedgerUpgradeType
+func (e LedgerUpgradeType) ValidEnum(v int32) bool {
+	_, ok := ledgerUpgradeTypeMap[v]
+	return ok
+}
 
-// TransactionResultSet is an XDR Struct defines as:
-//
-//   struct TransactionResultSet
-//    {
-//        TransactionResultPair results<>;
-//    };
-//
-type TransactionResultSet struct {
-	Results []TransactionResultPair
+// String returns the name of `e`
+func (e LedgerUpgradeType) String() string {
+	name, _ := ledgerUpgradeTypeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionResultSet) MarshalBinary() ([]byte, error) {
+func (s LedgerUpgradeType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionResultSet) UnmarshalBinary(inp []byte) error {
+func (s *LedgerUpgradeType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionResultSet)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionResultSet)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerUpgradeType)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerUpgradeType)(nil)
 )
 
-// TransactionHistoryEntryExt is an XDR NestedUnion defines as:
+// LedgerUpgrade is an XDR Union defines as:
 //
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
+//   union LedgerUpgrade switch (LedgerUpgradeType type)
+//    {
+//    case LEDGER_UPGRADE_VERSION:
+//        uint32 newLedgerVersion; // update ledgerVersion
+//    case LEDGER_UPGRADE_BASE_FEE:
+//        uint32 newBaseFee; // update baseFee
+//    case LEDGER_UPGRADE_MAX_TX_SET_SIZE:
+//        uint32 newMaxTxSetSize; // update maxTxSetSize
+//    case LEDGER_UPGRADE_BASE_RESERVE:
+//        uint32 newBaseReserve; // update baseReserve
+//    };
 //
-type TransactionHistoryEntryExt struct {
-	V int32
+type LedgerUpgrade struct {
+	Type             LedgerUpgradeType
+	NewLedgerVersion *Uint32
+	NewBaseFee       *Uint32
+	NewMaxTxSetSize  *Uint32
+	NewBaseReserve   *Uint32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u TransactionHistoryEntryExt) SwitchFieldName() string {
-	return "V"
+func (u LedgerUpgrade) SwitchFieldName() string {
+	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionHistoryEntryExt
-func (u TransactionHistoryEntryExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
+// the value for an instance of LedgerUpgrade
+func (u LedgerUpgrade) ArmForSwitch(sw int32) (string, bool) {
+	switch LedgerUpgradeType(sw) {
+	case LedgerUpgradeTypeLedgerUpgradeVersion:
+		return "NewLedgerVersion", true
+	case LedgerUpgradeTypeLedgerUpgradeBaseFee:
+		return "NewBaseFee", true
+	case LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
+		return "NewMaxTxSetSize", true
+	case LedgerUpgradeTypeLedgerUpgradeBaseReserve:
+		return "NewBaseReserve", true
 	}
 	return "-", false
 }
 
-// NewTransactionHistoryEntryExt creates a new  TransactionHistoryEntryExt.
-func NewTransactionHistoryEntryExt(v int32, value interface{}) (result TransactionHistoryEntryExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
+// NewLedgerUpgrade creates a new  LedgerUpgrade.
+func NewLedgerUpgrade(aType LedgerUpgradeType, value interface{}) (result LedgerUpgrade, err error) {
+	result.Type = aType
+	switch LedgerUpgradeType(aType) {
+	case LedgerUpgradeTypeLedgerUpgradeVersion:
+		tv, ok := value.(Uint32)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint32")
+			return
+		}
+		result.NewLedgerVersion = &tv
+	case LedgerUpgradeTypeLedgerUpgradeBaseFee:
+		tv, ok := value.(Uint32)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint32")
+			return
+		}
+		result.NewBaseFee = &tv
+	case LedgerUpgradeTypeLedgerUpgradeMaxTxSetSize:
+		tv, ok := value.(Uint32)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint32")
+			return
+		}
+		result.NewMaxTxSetSize = &tv
+	case LedgerUpgradeTypeLedgerUpgradeBaseReserve:
+		tv, ok := value.(Uint32)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint32")
+			return
+		}
+		result.NewBaseReserve = &tv
 	}
 	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionHistoryEntryExt) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustNewLedgerVersion retrieves the NewLedgerVersion value from the union,
+// panicing if the value is not set.
+func (u LedgerUpgrade) MustNewLedgerVersion() Uint32 {
+	val, ok := u.GetNewLedgerVersion()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionHistoryEntryExt) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm NewLedgerVersion is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionHistoryEntryExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionHistoryEntryExt)(nil)
-)
+// GetNewLedgerVersion retrieves the NewLedgerVersion value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerUpgrade) GetNewLedgerVersion() (result Uint32, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// TransactionHistoryEntry is an XDR Struct defines as:
-//
-//   struct TransactionHistoryEntry
-//    {
-//        uint32 ledgerSeq;
-//        TransactionSet txSet;
-//
-//        // reserved for future use
-//        union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//        ext;
-//    };
-//
-type TransactionHistoryEntry struct {
-	LedgerSeq Uint32
-	TxSet     TransactionSet
-	Ext       TransactionHistoryEntryExt
-}
+	if armName == "NewLedgerVersion" {
+		result = *u.NewLedgerVersion
+		ok = true
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionHistoryEntry) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionHistoryEntry) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// MustNewBaseFee retrieves the NewBaseFee value from the union,
+// panicing if the value is not set.
+func (u LedgerUpgrade) MustNewBaseFee() Uint32 {
+	val, ok := u.GetNewBaseFee()
 
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionHistoryEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionHistoryEntry)(nil)
-)
+	if !ok {
+		panic("arm NewBaseFee is not set")
+	}
 
-// TransactionHistoryResultEntryExt is an XDR NestedUnion defines as:
-//
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//
-type TransactionHistoryResultEntryExt struct {
-	V int32
+	return val
 }
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u TransactionHistoryResultEntryExt) SwitchFieldName() string {
-	return "V"
-}
+// GetNewBaseFee retrieves the NewBaseFee value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerUpgrade) GetNewBaseFee() (result Uint32, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionHistoryResultEntryExt
-func (u TransactionHistoryResultEntryExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
+	if armName == "NewBaseFee" {
+		result = *u.NewBaseFee
+		ok = true
 	}
-	return "-", false
-}
 
-// NewTransactionHistoryResultEntryExt creates a new  TransactionHistoryResultEntryExt.
-func NewTransactionHistoryResultEntryExt(v int32, value interface{}) (result TransactionHistoryResultEntryExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
-	}
 	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionHistoryResultEntryExt) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustNewMaxTxSetSize retrieves the NewMaxTxSetSize value from the union,
+// panicing if the value is not set.
+func (u LedgerUpgrade) MustNewMaxTxSetSize() Uint32 {
+	val, ok := u.GetNewMaxTxSetSize()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionHistoryResultEntryExt) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm NewMaxTxSetSize is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionHistoryResultEntryExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionHistoryResultEntryExt)(nil)
-)
+// GetNewMaxTxSetSize retrieves the NewMaxTxSetSize value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerUpgrade) GetNewMaxTxSetSize() (result Uint32, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// TransactionHistoryResultEntry is an XDR Struct defines as:
-//
-//   struct TransactionHistoryResultEntry
-//    {
-//        uint32 ledgerSeq;
-//        TransactionResultSet txResultSet;
-//
-//        // reserved for future use
-//        union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//        ext;
-//    };
-//
-type TransactionHistoryResultEntry struct {
-	LedgerSeq   Uint32
-	TxResultSet TransactionResultSet
-	Ext         TransactionHistoryResultEntryExt
+	if armName == "NewMaxTxSetSize" {
+		result = *u.NewMaxTxSetSize
+		ok = true
+	}
+
+	return
+}
+
+// MustNewBaseReserve retrieves the NewBaseReserve value from the union,
+// panicing if the value is not set.
+func (u LedgerUpgrade) MustNewBaseReserve() Uint32 {
+	val, ok := u.GetNewBaseReserve()
+
+	if !ok {
+		panic("arm NewBaseReserve is not set")
+	}
+
+	return val
+}
+
+// GetNewBaseReserve retrieves the NewBaseReserve value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerUpgrade) GetNewBaseReserve() (result Uint32, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "NewBaseReserve" {
+		result = *u.NewBaseReserve
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionHistoryResultEntry) MarshalBinary() ([]byte, error) {
+func (s LedgerUpgrade) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionHistoryResultEntry) UnmarshalBinary(inp []byte) error {
+func (s *LedgerUpgrade) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionHistoryResultEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionHistoryResultEntry)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerUpgrade)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerUpgrade)(nil)
 )
 
-// LedgerHeaderHistoryEntryExt is an XDR NestedUnion defines as:
+// LedgerKeyAccount is an XDR NestedStruct defines as:
 //
-//   union switch (int v)
+//   struct
 //        {
-//        case 0:
-//            void;
+//            AccountID accountID;
 //        }
 //
-type LedgerHeaderHistoryEntryExt struct {
-	V int32
+type LedgerKeyAccount struct {
+	AccountId AccountId
 }
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u LedgerHeaderHistoryEntryExt) SwitchFieldName() string {
-	return "V"
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s LedgerKeyAccount) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerHeaderHistoryEntryExt
-func (u LedgerHeaderHistoryEntryExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
-	}
-	return "-", false
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *LedgerKeyAccount) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// NewLedgerHeaderHistoryEntryExt creates a new  LedgerHeaderHistoryEntryExt.
-func NewLedgerHeaderHistoryEntryExt(v int32, value interface{}) (result LedgerHeaderHistoryEntryExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
-	}
-	return
+var (
+	_ encoding.BinaryMarshaler   = (*LedgerKeyAccount)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKeyAccount)(nil)
+)
+
+// LedgerKeyTrustLine is an XDR NestedStruct defines as:
+//
+//   struct
+//        {
+//            AccountID accountID;
+//            Asset asset;
+//        }
+//
+type LedgerKeyTrustLine struct {
+	AccountId AccountId
+	Asset     Asset
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerHeaderHistoryEntryExt) MarshalBinary() ([]byte, error) {
+func (s LedgerKeyTrustLine) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerHeaderHistoryEntryExt) UnmarshalBinary(inp []byte) error {
+func (s *LedgerKeyTrustLine) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerHeaderHistoryEntryExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerHeaderHistoryEntryExt)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerKeyTrustLine)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKeyTrustLine)(nil)
 )
 
-// LedgerHeaderHistoryEntry is an XDR Struct defines as:
-//
-//   struct LedgerHeaderHistoryEntry
-//    {
-//        Hash hash;
-//        LedgerHeader header;
+// LedgerKeyOffer is an XDR NestedStruct defines as:
 //
-//        // reserved for future use
-//        union switch (int v)
+//   struct
 //        {
-//        case 0:
-//            void;
+//            AccountID sellerID;
+//            int64 offerID;
 //        }
-//        ext;
-//    };
 //
-type LedgerHeaderHistoryEntry struct {
-	Hash   Hash
-	Header LedgerHeader
-	Ext    LedgerHeaderHistoryEntryExt
+type LedgerKeyOffer struct {
+	SellerId AccountId
+	OfferId  Int64
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerHeaderHistoryEntry) MarshalBinary() ([]byte, error) {
+func (s LedgerKeyOffer) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerHeaderHistoryEntry) UnmarshalBinary(inp []byte) error {
+func (s *LedgerKeyOffer) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerHeaderHistoryEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerHeaderHistoryEntry)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerKeyOffer)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKeyOffer)(nil)
 )
 
-// LedgerScpMessages is an XDR Struct defines as:
+// LedgerKeyData is an XDR NestedStruct defines as:
 //
-//   struct LedgerSCPMessages
-//    {
-//        uint32 ledgerSeq;
-//        SCPEnvelope messages<>;
-//    };
+//   struct
+//        {
+//            AccountID accountID;
+//            string64 dataName;
+//        }
 //
-type LedgerScpMessages struct {
-	LedgerSeq Uint32
-	Messages  []ScpEnvelope
+type LedgerKeyData struct {
+	AccountId AccountId
+	DataName  String64
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerScpMessages) MarshalBinary() ([]byte, error) {
+func (s LedgerKeyData) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerScpMessages) UnmarshalBinary(inp []byte) error {
+func (s *LedgerKeyData) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerScpMessages)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerScpMessages)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerKeyData)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKeyData)(nil)
 )
 
-// ScpHistoryEntryV0 is an XDR Struct defines as:
+// LedgerKeyClaimableBalance is an XDR NestedStruct defines as:
 //
-//   struct SCPHistoryEntryV0
-//    {
-//        SCPQuorumSet quorumSets<>; // additional quorum sets used by ledgerMessages
-//        LedgerSCPMessages ledgerMessages;
-//    };
+//   struct
+//        {
+//            ClaimableBalanceID balanceID;
+//        }
 //
-type ScpHistoryEntryV0 struct {
-	QuorumSets     []ScpQuorumSet
-	LedgerMessages LedgerScpMessages
+type LedgerKeyClaimableBalance struct {
+	BalanceId ClaimableBalanceId
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ScpHistoryEntryV0) MarshalBinary() ([]byte, error) {
+func (s LedgerKeyClaimableBalance) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ScpHistoryEntryV0) UnmarshalBinary(inp []byte) error {
+func (s *LedgerKeyClaimableBalance) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ScpHistoryEntryV0)(nil)
-	_ encoding.BinaryUnmarshaler = (*ScpHistoryEntryV0)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerKeyClaimableBalance)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKeyClaimableBalance)(nil)
 )
 
-// ScpHistoryEntry is an XDR Union defines as:
+// LedgerKey is an XDR Union defines as:
 //
-//   union SCPHistoryEntry switch (int v)
+//   union LedgerKey switch (LedgerEntryType type)
 //    {
-//    case 0:
-//        SCPHistoryEntryV0 v0;
+//    case ACCOUNT:
+//        struct
+//        {
+//            AccountID accountID;
+//        } account;
+//
+//    case TRUSTLINE:
+//        struct
+//        {
+//            AccountID accountID;
+//            Asset asset;
+//        } trustLine;
+//
+//    case OFFER:
+//        struct
+//        {
+//            AccountID sellerID;
+//            int64 offerID;
+//        } offer;
+//
+//    case DATA:
+//        struct
+//        {
+//            AccountID accountID;
+//            string64 dataName;
+//        } data;
+//
+//    case CLAIMABLE_BALANCE:
+//        struct
+//        {
+//            ClaimableBalanceID balanceID;
+//        } claimableBalance;
 //    };
 //
-type ScpHistoryEntry struct {
-	V  int32
-	V0 *ScpHistoryEntryV0
+type LedgerKey struct {
+	Type             LedgerEntryType
+	Account          *LedgerKeyAccount
+	TrustLine        *LedgerKeyTrustLine
+	Offer            *LedgerKeyOffer
+	Data             *LedgerKeyData
+	ClaimableBalance *LedgerKeyClaimableBalance
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ScpHistoryEntry) SwitchFieldName() string {
-	return "V"
+func (u LedgerKey) SwitchFieldName() string {
+	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ScpHistoryEntry
-func (u ScpHistoryEntry) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "V0", true
+// the value for an instance of LedgerKey
+func (u LedgerKey) ArmForSwitch(sw int32) (string, bool) {
+	switch LedgerEntryType(sw) {
+	case LedgerEntryTypeAccount:
+		return "Account", true
+	case LedgerEntryTypeTrustline:
+		return "TrustLine", true
+	case LedgerEntryTypeOffer:
+		return "Offer", true
+	case LedgerEntryTypeData:
+		return "Data", true
+	case LedgerEntryTypeClaimableBalance:
+		return "ClaimableBalance", true
 	}
 	return "-", false
 }
 
-// NewScpHistoryEntry creates a new  ScpHistoryEntry.
-func NewScpHistoryEntry(v int32, value interface{}) (result ScpHistoryEntry, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		tv, ok := value.(ScpHistoryEntryV0)
+// NewLedgerKey creates a new  LedgerKey.
+func NewLedgerKey(aType LedgerEntryType, value interface{}) (result LedgerKey, err error) {
+	result.Type = aType
+	switch LedgerEntryType(aType) {
+	case LedgerEntryTypeAccount:
+		tv, ok := value.(LedgerKeyAccount)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be ScpHistoryEntryV0")
+			err = fmt.Errorf("invalid value, must be LedgerKeyAccount")
 			return
 		}
-		result.V0 = &tv
+		result.Account = &tv
+	case LedgerEntryTypeTrustline:
+		tv, ok := value.(LedgerKeyTrustLine)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKeyTrustLine")
+			return
+		}
+		result.TrustLine = &tv
+	case LedgerEntryTypeOffer:
+		tv, ok := value.(LedgerKeyOffer)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKeyOffer")
+			return
+		}
+		result.Offer = &tv
+	case LedgerEntryTypeData:
+		tv, ok := value.(LedgerKeyData)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKeyData")
+			return
+		}
+		result.Data = &tv
+	case LedgerEntryTypeClaimableBalance:
+		tv, ok := value.(LedgerKeyClaimableBalance)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKeyClaimableBalance")
+			return
+		}
+		result.ClaimableBalance = &tv
 	}
 	return
 }
 
-// MustV0 retrieves the V0 value from the union,
+// MustAccount retrieves the Account value from the union,
 // panicing if the value is not set.
-func (u ScpHistoryEntry) MustV0() ScpHistoryEntryV0 {
-	val, ok := u.GetV0()
+func (u LedgerKey) MustAccount() LedgerKeyAccount {
+	val, ok := u.GetAccount()
 
 	if !ok {
-		panic("arm V0 is not set")
+		panic("arm Account is not set")
 	}
 
 	return val
 }
 
-// GetV0 retrieves the V0 value from the union,
+// GetAccount retrieves the Account value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u ScpHistoryEntry) GetV0() (result ScpHistoryEntryV0, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
+func (u LedgerKey) GetAccount() (result LedgerKeyAccount, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "V0" {
-		result = *u.V0
+	if armName == "Account" {
+		result = *u.Account
+		ok = true
+	}
+
+	return
+}
+
+// MustTrustLine retrieves the TrustLine value from the union,
+// panicing if the value is not set.
+func (u LedgerKey) MustTrustLine() LedgerKeyTrustLine {
+	val, ok := u.GetTrustLine()
+
+	if !ok {
+		panic("arm TrustLine is not set")
+	}
+
+	return val
+}
+
+// GetTrustLine retrieves the TrustLine value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerKey) GetTrustLine() (result LedgerKeyTrustLine, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "TrustLine" {
+		result = *u.TrustLine
+		ok = true
+	}
+
+	return
+}
+
+// MustOffer retrieves the Offer value from the union,
+// panicing if the value is not set.
+func (u LedgerKey) MustOffer() LedgerKeyOffer {
+	val, ok := u.GetOffer()
+
+	if !ok {
+		panic("arm Offer is not set")
+	}
+
+	return val
+}
+
+// GetOffer retrieves the Offer value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerKey) GetOffer() (result LedgerKeyOffer, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Offer" {
+		result = *u.Offer
+		ok = true
+	}
+
+	return
+}
+
+// MustData retrieves the Data value from the union,
+// panicing if the value is not set.
+func (u LedgerKey) MustData() LedgerKeyData {
+	val, ok := u.GetData()
+
+	if !ok {
+		panic("arm Data is not set")
+	}
+
+	return val
+}
+
+// GetData retrieves the Data value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerKey) GetData() (result LedgerKeyData, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Data" {
+		result = *u.Data
+		ok = true
+	}
+
+	return
+}
+
+// MustClaimableBalance retrieves the ClaimableBalance value from the union,
+// panicing if the value is not set.
+func (u LedgerKey) MustClaimableBalance() LedgerKeyClaimableBalance {
+	val, ok := u.GetClaimableBalance()
+
+	if !ok {
+		panic("arm ClaimableBalance is not set")
+	}
+
+	return val
+}
+
+// GetClaimableBalance retrieves the ClaimableBalance value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerKey) GetClaimableBalance() (result LedgerKeyClaimableBalance, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ClaimableBalance" {
+		result = *u.ClaimableBalance
 		ok = true
 	}
 
@@ -4483,254 +4721,325 @@ func (u ScpHistoryEntry) GetV0() (result ScpHistoryEntryV0, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ScpHistoryEntry) MarshalBinary() ([]byte, error) {
+func (s LedgerKey) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ScpHistoryEntry) UnmarshalBinary(inp []byte) error {
+func (s *LedgerKey) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ScpHistoryEntry)(nil)
-	_ encoding.BinaryUnmarshaler = (*ScpHistoryEntry)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerKey)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerKey)(nil)
 )
 
-// LedgerEntryChangeType is an XDR Enum defines as:
+// BucketEntryType is an XDR Enum defines as:
 //
-//   enum LedgerEntryChangeType
+//   enum BucketEntryType
 //    {
-//        LEDGER_ENTRY_CREATED = 0, // entry was added to the ledger
-//        LEDGER_ENTRY_UPDATED = 1, // entry was modified in the ledger
-//        LEDGER_ENTRY_REMOVED = 2, // entry was removed from the ledger
-//        LEDGER_ENTRY_STATE = 3    // value of the entry
+//        METAENTRY =
+//            -1, // At-and-after protocol 11: bucket metadata, should come first.
+//        LIVEENTRY = 0, // Before protocol 11: created-or-updated;
+//                       // At-and-after protocol 11: only updated.
+//        DEADENTRY = 1,
+//        INITENTRY = 2 // At-and-after protocol 11: only created.
 //    };
 //
-type LedgerEntryChangeType int32
+type BucketEntryType int32
 
 const (
-	LedgerEntryChangeTypeLedgerEntryCreated LedgerEntryChangeType = 0
-	LedgerEntryChangeTypeLedgerEntryUpdated LedgerEntryChangeType = 1
-	LedgerEntryChangeTypeLedgerEntryRemoved LedgerEntryChangeType = 2
-	LedgerEntryChangeTypeLedgerEntryState   LedgerEntryChangeType = 3
+	BucketEntryTypeMetaentry BucketEntryType = -1
+	BucketEntryTypeLiveentry BucketEntryType = 0
+	BucketEntryTypeDeadentry BucketEntryType = 1
+	BucketEntryTypeInitentry BucketEntryType = 2
 )
 
-var ledgerEntryChangeTypeMap = map[int32]string{
-	0: "LedgerEntryChangeTypeLedgerEntryCreated",
-	1: "LedgerEntryChangeTypeLedgerEntryUpdated",
-	2: "LedgerEntryChangeTypeLedgerEntryRemoved",
-	3: "LedgerEntryChangeTypeLedgerEntryState",
+var bucketEntryTypeMap = map[int32]string{
+	-1: "BucketEntryTypeMetaentry",
+	0:  "BucketEntryTypeLiveentry",
+	1:  "BucketEntryTypeDeadentry",
+	2:  "BucketEntryTypeInitentry",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for LedgerEntryChangeType
-func (e LedgerEntryChangeType) ValidEnum(v int32) bool {
-	_, ok := ledgerEntryChangeTypeMap[v]
+// the Enum interface for BucketEntryType
+func (e BucketEntryType) ValidEnum(v int32) bool {
+	_, ok := bucketEntryTypeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e LedgerEntryChangeType) String() string {
-	name, _ := ledgerEntryChangeTypeMap[int32(e)]
+func (e BucketEntryType) String() string {
+	name, _ := bucketEntryTypeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntryChangeType) MarshalBinary() ([]byte, error) {
+func (s BucketEntryType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntryChangeType) UnmarshalBinary(inp []byte) error {
+func (s *BucketEntryType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntryChangeType)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntryChangeType)(nil)
+	_ encoding.BinaryMarshaler   = (*BucketEntryType)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketEntryType)(nil)
 )
 
-// LedgerEntryChange is an XDR Union defines as:
+// BucketMetadataExt is an XDR NestedUnion defines as:
 //
-//   union LedgerEntryChange switch (LedgerEntryChangeType type)
-//    {
-//    case LEDGER_ENTRY_CREATED:
-//        LedgerEntry created;
-//    case LEDGER_ENTRY_UPDATED:
-//        LedgerEntry updated;
-//    case LEDGER_ENTRY_REMOVED:
-//        LedgerKey removed;
-//    case LEDGER_ENTRY_STATE:
-//        LedgerEntry state;
-//    };
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
 //
-type LedgerEntryChange struct {
-	Type    LedgerEntryChangeType
-	Created *LedgerEntry
-	Updated *LedgerEntry
-	Removed *LedgerKey
-	State   *LedgerEntry
+type BucketMetadataExt struct {
+	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u LedgerEntryChange) SwitchFieldName() string {
-	return "Type"
+func (u BucketMetadataExt) SwitchFieldName() string {
+	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerEntryChange
-func (u LedgerEntryChange) ArmForSwitch(sw int32) (string, bool) {
-	switch LedgerEntryChangeType(sw) {
-	case LedgerEntryChangeTypeLedgerEntryCreated:
-		return "Created", true
-	case LedgerEntryChangeTypeLedgerEntryUpdated:
-		return "Updated", true
-	case LedgerEntryChangeTypeLedgerEntryRemoved:
-		return "Removed", true
-	case LedgerEntryChangeTypeLedgerEntryState:
-		return "State", true
+// the value for an instance of BucketMetadataExt
+func (u BucketMetadataExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
 	}
 	return "-", false
 }
 
-// NewLedgerEntryChange creates a new  LedgerEntryChange.
-func NewLedgerEntryChange(aType LedgerEntryChangeType, value interface{}) (result LedgerEntryChange, err error) {
+// NewBucketMetadataExt creates a new  BucketMetadataExt.
+func NewBucketMetadataExt(v int32, value interface{}) (result BucketMetadataExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	}
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BucketMetadataExt) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BucketMetadataExt) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BucketMetadataExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketMetadataExt)(nil)
+)
+
+// BucketMetadata is an XDR Struct defines as:
+//
+//   struct BucketMetadata
+//    {
+//        // Indicates the protocol version used to create / merge this bucket.
+//        uint32 ledgerVersion;
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
+//    };
+//
+type BucketMetadata struct {
+	LedgerVersion Uint32
+	Ext           BucketMetadataExt
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BucketMetadata) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BucketMetadata) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BucketMetadata)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketMetadata)(nil)
+)
+
+// BucketEntry is an XDR Union defines as:
+//
+//   union BucketEntry switch (BucketEntryType type)
+//    {
+//    case LIVEENTRY:
+//    case INITENTRY:
+//        LedgerEntry liveEntry;
+//
+//    case DEADENTRY:
+//        LedgerKey deadEntry;
+//    case METAENTRY:
+//        BucketMetadata metaEntry;
+//    };
+//
+type BucketEntry struct {
+	Type      BucketEntryType
+	LiveEntry *LedgerEntry
+	DeadEntry *LedgerKey
+	MetaEntry *BucketMetadata
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u BucketEntry) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of BucketEntry
+func (u BucketEntry) ArmForSwitch(sw int32) (string, bool) {
+	switch BucketEntryType(sw) {
+	case BucketEntryTypeLiveentry:
+		return "LiveEntry", true
+	case BucketEntryTypeInitentry:
+		return "LiveEntry", true
+	case BucketEntryTypeDeadentry:
+		return "DeadEntry", true
+	case BucketEntryTypeMetaentry:
+		return "MetaEntry", true
+	}
+	return "-", false
+}
+
+// NewBucketEntry creates a new  BucketEntry.
+func NewBucketEntry(aType BucketEntryType, value interface{}) (result BucketEntry, err error) {
 	result.Type = aType
-	switch LedgerEntryChangeType(aType) {
-	case LedgerEntryChangeTypeLedgerEntryCreated:
+	switch BucketEntryType(aType) {
+	case BucketEntryTypeLiveentry:
 		tv, ok := value.(LedgerEntry)
 		if !ok {
 			err = fmt.Errorf("invalid value, must be LedgerEntry")
 			return
 		}
-		result.Created = &tv
-	case LedgerEntryChangeTypeLedgerEntryUpdated:
+		result.LiveEntry = &tv
+	case BucketEntryTypeInitentry:
 		tv, ok := value.(LedgerEntry)
 		if !ok {
 			err = fmt.Errorf("invalid value, must be LedgerEntry")
 			return
 		}
-		result.Updated = &tv
-	case LedgerEntryChangeTypeLedgerEntryRemoved:
+		result.LiveEntry = &tv
+	case BucketEntryTypeDeadentry:
 		tv, ok := value.(LedgerKey)
 		if !ok {
 			err = fmt.Errorf("invalid value, must be LedgerKey")
 			return
 		}
-		result.Removed = &tv
-	case LedgerEntryChangeTypeLedgerEntryState:
-		tv, ok := value.(LedgerEntry)
+		result.DeadEntry = &tv
+	case BucketEntryTypeMetaentry:
+		tv, ok := value.(BucketMetadata)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerEntry")
+			err = fmt.Errorf("invalid value, must be BucketMetadata")
 			return
 		}
-		result.State = &tv
-	}
-	return
-}
-
-// MustCreated retrieves the Created value from the union,
-// panicing if the value is not set.
-func (u LedgerEntryChange) MustCreated() LedgerEntry {
-	val, ok := u.GetCreated()
-
-	if !ok {
-		panic("arm Created is not set")
-	}
-
-	return val
-}
-
-// GetCreated retrieves the Created value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryChange) GetCreated() (result LedgerEntry, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "Created" {
-		result = *u.Created
-		ok = true
+		result.MetaEntry = &tv
 	}
-
 	return
 }
 
-// MustUpdated retrieves the Updated value from the union,
+// MustLiveEntry retrieves the LiveEntry value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryChange) MustUpdated() LedgerEntry {
-	val, ok := u.GetUpdated()
+func (u BucketEntry) MustLiveEntry() LedgerEntry {
+	val, ok := u.GetLiveEntry()
 
 	if !ok {
-		panic("arm Updated is not set")
+		panic("arm LiveEntry is not set")
 	}
 
 	return val
 }
 
-// GetUpdated retrieves the Updated value from the union,
+// GetLiveEntry retrieves the LiveEntry value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryChange) GetUpdated() (result LedgerEntry, ok bool) {
+func (u BucketEntry) GetLiveEntry() (result LedgerEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Updated" {
-		result = *u.Updated
+	if armName == "LiveEntry" {
+		result = *u.LiveEntry
 		ok = true
 	}
 
 	return
 }
 
-// MustRemoved retrieves the Removed value from the union,
+// MustDeadEntry retrieves the DeadEntry value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryChange) MustRemoved() LedgerKey {
-	val, ok := u.GetRemoved()
+func (u BucketEntry) MustDeadEntry() LedgerKey {
+	val, ok := u.GetDeadEntry()
 
 	if !ok {
-		panic("arm Removed is not set")
+		panic("arm DeadEntry is not set")
 	}
 
 	return val
 }
 
-// GetRemoved retrieves the Removed value from the union,
+// GetDeadEntry retrieves the DeadEntry value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryChange) GetRemoved() (result LedgerKey, ok bool) {
+func (u BucketEntry) GetDeadEntry() (result LedgerKey, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Removed" {
-		result = *u.Removed
+	if armName == "DeadEntry" {
+		result = *u.DeadEntry
 		ok = true
 	}
 
 	return
 }
 
-// MustState retrieves the State value from the union,
+// MustMetaEntry retrieves the MetaEntry value from the union,
 // panicing if the value is not set.
-func (u LedgerEntryChange) MustState() LedgerEntry {
-	val, ok := u.GetState()
+func (u BucketEntry) MustMetaEntry() BucketMetadata {
+	val, ok := u.GetMetaEntry()
 
 	if !ok {
-		panic("arm State is not set")
+		panic("arm MetaEntry is not set")
 	}
 
 	return val
 }
 
-// GetState retrieves the State value from the union,
+// GetMetaEntry retrieves the MetaEntry value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u LedgerEntryChange) GetState() (result LedgerEntry, ok bool) {
+func (u BucketEntry) GetMetaEntry() (result BucketMetadata, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "State" {
-		result = *u.State
+	if armName == "MetaEntry" {
+		result = *u.MetaEntry
 		ok = true
 	}
 
@@ -4738,851 +5047,782 @@ func (u LedgerEntryChange) GetState() (result LedgerEntry, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntryChange) MarshalBinary() ([]byte, error) {
+func (s BucketEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntryChange) UnmarshalBinary(inp []byte) error {
+func (s *BucketEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntryChange)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntryChange)(nil)
+	_ encoding.BinaryMarshaler   = (*BucketEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketEntry)(nil)
 )
 
-// LedgerEntryChanges is an XDR Typedef defines as:
+// TransactionSet is an XDR Struct defines as:
 //
-//   typedef LedgerEntryChange LedgerEntryChanges<>;
+//   struct TransactionSet
+//    {
+//        Hash previousLedgerHash;
+//        TransactionEnvelope txs<>;
+//    };
 //
-type LedgerEntryChanges []LedgerEntryChange
+type TransactionSet struct {
+	PreviousLedgerHash Hash
+	Txs                []TransactionEnvelope
+}
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerEntryChanges) MarshalBinary() ([]byte, error) {
+func (s TransactionSet) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerEntryChanges) UnmarshalBinary(inp []byte) error {
+func (s *TransactionSet) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerEntryChanges)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerEntryChanges)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionSet)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionSet)(nil)
 )
 
-// OperationMeta is an XDR Struct defines as:
+// TransactionResultPair is an XDR Struct defines as:
 //
-//   struct OperationMeta
+//   struct TransactionResultPair
 //    {
-//        LedgerEntryChanges changes;
-//    };
-//
-type OperationMeta struct {
-	Changes LedgerEntryChanges
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s OperationMeta) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *OperationMeta) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*OperationMeta)(nil)
-	_ encoding.BinaryUnmarshaler = (*OperationMeta)(nil)
-)
-
-// TransactionMetaV1 is an XDR Struct defines as:
-//
-//   struct TransactionMetaV1
-//    {
-//        LedgerEntryChanges txChanges; // tx level changes if any
-//        OperationMeta operations<>;   // meta for each operation
+//        Hash transactionHash;
+//        TransactionResult result; // result for the transaction
 //    };
 //
-type TransactionMetaV1 struct {
-	TxChanges  LedgerEntryChanges
-	Operations []OperationMeta
+type TransactionResultPair struct {
+	TransactionHash Hash
+	Result          TransactionResult
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionMetaV1) MarshalBinary() ([]byte, error) {
+func (s TransactionResultPair) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionMetaV1) UnmarshalBinary(inp []byte) error {
+func (s *TransactionResultPair) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionMetaV1)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionMetaV1)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionResultPair)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionResultPair)(nil)
 )
 
-// TransactionMetaV2 is an XDR Struct defines as:
+// TransactionResultSet is an XDR Struct defines as:
 //
-//   struct TransactionMetaV2
+//   struct TransactionResultSet
 //    {
-//        LedgerEntryChanges txChangesBefore; // tx level changes before operations
-//                                            // are applied if any
-//        OperationMeta operations<>;         // meta for each operation
-//        LedgerEntryChanges txChangesAfter;  // tx level changes after operations are
-//                                            // applied if any
+//        TransactionResultPair results<>;
 //    };
 //
-type TransactionMetaV2 struct {
-	TxChangesBefore LedgerEntryChanges
-	Operations      []OperationMeta
-	TxChangesAfter  LedgerEntryChanges
+type TransactionResultSet struct {
+	Results []TransactionResultPair
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionMetaV2) MarshalBinary() ([]byte, error) {
+func (s TransactionResultSet) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionMetaV2) UnmarshalBinary(inp []byte) error {
+func (s *TransactionResultSet) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionMetaV2)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionMetaV2)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionResultSet)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionResultSet)(nil)
 )
 
-// TransactionMeta is an XDR Union defines as:
+// TransactionHistoryEntryExt is an XDR NestedUnion defines as:
 //
-//   union TransactionMeta switch (int v)
-//    {
-//    case 0:
-//        OperationMeta operations<>;
-//    case 1:
-//        TransactionMetaV1 v1;
-//    case 2:
-//        TransactionMetaV2 v2;
-//    };
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
 //
-type TransactionMeta struct {
-	V          int32
-	Operations *[]OperationMeta
-	V1         *TransactionMetaV1
-	V2         *TransactionMetaV2
+type TransactionHistoryEntryExt struct {
+	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u TransactionMeta) SwitchFieldName() string {
+func (u TransactionHistoryEntryExt) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionMeta
-func (u TransactionMeta) ArmForSwitch(sw int32) (string, bool) {
+// the value for an instance of TransactionHistoryEntryExt
+func (u TransactionHistoryEntryExt) ArmForSwitch(sw int32) (string, bool) {
 	switch int32(sw) {
 	case 0:
-		return "Operations", true
-	case 1:
-		return "V1", true
-	case 2:
-		return "V2", true
+		return "", true
 	}
 	return "-", false
 }
 
-// NewTransactionMeta creates a new  TransactionMeta.
-func NewTransactionMeta(v int32, value interface{}) (result TransactionMeta, err error) {
+// NewTransactionHistoryEntryExt creates a new  TransactionHistoryEntryExt.
+func NewTransactionHistoryEntryExt(v int32, value interface{}) (result TransactionHistoryEntryExt, err error) {
 	result.V = v
 	switch int32(v) {
 	case 0:
-		tv, ok := value.([]OperationMeta)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be []OperationMeta")
-			return
-		}
-		result.Operations = &tv
-	case 1:
-		tv, ok := value.(TransactionMetaV1)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionMetaV1")
-			return
-		}
-		result.V1 = &tv
-	case 2:
-		tv, ok := value.(TransactionMetaV2)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionMetaV2")
-			return
-		}
-		result.V2 = &tv
-	}
-	return
-}
-
-// MustOperations retrieves the Operations value from the union,
-// panicing if the value is not set.
-func (u TransactionMeta) MustOperations() []OperationMeta {
-	val, ok := u.GetOperations()
-
-	if !ok {
-		panic("arm Operations is not set")
-	}
-
-	return val
-}
-
-// GetOperations retrieves the Operations value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u TransactionMeta) GetOperations() (result []OperationMeta, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
-
-	if armName == "Operations" {
-		result = *u.Operations
-		ok = true
-	}
-
-	return
-}
-
-// MustV1 retrieves the V1 value from the union,
-// panicing if the value is not set.
-func (u TransactionMeta) MustV1() TransactionMetaV1 {
-	val, ok := u.GetV1()
-
-	if !ok {
-		panic("arm V1 is not set")
-	}
-
-	return val
-}
-
-// GetV1 retrieves the V1 value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u TransactionMeta) GetV1() (result TransactionMetaV1, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
-
-	if armName == "V1" {
-		result = *u.V1
-		ok = true
-	}
-
-	return
-}
-
-// MustV2 retrieves the V2 value from the union,
-// panicing if the value is not set.
-func (u TransactionMeta) MustV2() TransactionMetaV2 {
-	val, ok := u.GetV2()
-
-	if !ok {
-		panic("arm V2 is not set")
-	}
-
-	return val
-}
-
-// GetV2 retrieves the V2 value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u TransactionMeta) GetV2() (result TransactionMetaV2, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
-
-	if armName == "V2" {
-		result = *u.V2
-		ok = true
+		// void
 	}
-
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionMeta) MarshalBinary() ([]byte, error) {
+func (s TransactionHistoryEntryExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionMeta) UnmarshalBinary(inp []byte) error {
+func (s *TransactionHistoryEntryExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionMeta)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionMeta)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionHistoryEntryExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionHistoryEntryExt)(nil)
 )
 
-// TransactionResultMeta is an XDR Struct defines as:
+// TransactionHistoryEntry is an XDR Struct defines as:
 //
-//   struct TransactionResultMeta
+//   struct TransactionHistoryEntry
 //    {
-//        TransactionResultPair result;
-//        LedgerEntryChanges feeProcessing;
-//        TransactionMeta txApplyProcessing;
+//        uint32 ledgerSeq;
+//        TransactionSet txSet;
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
 //    };
 //
-type TransactionResultMeta struct {
-	Result            TransactionResultPair
-	FeeProcessing     LedgerEntryChanges
-	TxApplyProcessing TransactionMeta
+type TransactionHistoryEntry struct {
+	LedgerSeq Uint32
+	TxSet     TransactionSet
+	Ext       TransactionHistoryEntryExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionResultMeta) MarshalBinary() ([]byte, error) {
+func (s TransactionHistoryEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionResultMeta) UnmarshalBinary(inp []byte) error {
+func (s *TransactionHistoryEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionResultMeta)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionResultMeta)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionHistoryEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionHistoryEntry)(nil)
 )
 
-// UpgradeEntryMeta is an XDR Struct defines as:
-//
-//   struct UpgradeEntryMeta
-//    {
-//        LedgerUpgrade upgrade;
-//        LedgerEntryChanges changes;
-//    };
+// TransactionHistoryResultEntryExt is an XDR NestedUnion defines as:
 //
-type UpgradeEntryMeta struct {
-	Upgrade LedgerUpgrade
-	Changes LedgerEntryChanges
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//
+type TransactionHistoryResultEntryExt struct {
+	V int32
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u TransactionHistoryResultEntryExt) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of TransactionHistoryResultEntryExt
+func (u TransactionHistoryResultEntryExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
+	}
+	return "-", false
+}
+
+// NewTransactionHistoryResultEntryExt creates a new  TransactionHistoryResultEntryExt.
+func NewTransactionHistoryResultEntryExt(v int32, value interface{}) (result TransactionHistoryResultEntryExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	}
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s UpgradeEntryMeta) MarshalBinary() ([]byte, error) {
+func (s TransactionHistoryResultEntryExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *UpgradeEntryMeta) UnmarshalBinary(inp []byte) error {
+func (s *TransactionHistoryResultEntryExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*UpgradeEntryMeta)(nil)
-	_ encoding.BinaryUnmarshaler = (*UpgradeEntryMeta)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionHistoryResultEntryExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionHistoryResultEntryExt)(nil)
 )
 
-// LedgerCloseMetaV0 is an XDR Struct defines as:
+// TransactionHistoryResultEntry is an XDR Struct defines as:
 //
-//   struct LedgerCloseMetaV0
+//   struct TransactionHistoryResultEntry
 //    {
-//        LedgerHeaderHistoryEntry ledgerHeader;
-//        // NB: txSet is sorted in "Hash order"
-//        TransactionSet txSet;
-//
-//        // NB: transactions are sorted in apply order here
-//        // fees for all transactions are processed first
-//        // followed by applying transactions
-//        TransactionResultMeta txProcessing<>;
-//
-//        // upgrades are applied last
-//        UpgradeEntryMeta upgradesProcessing<>;
+//        uint32 ledgerSeq;
+//        TransactionResultSet txResultSet;
 //
-//        // other misc information attached to the ledger close
-//        SCPHistoryEntry scpInfo<>;
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
 //    };
 //
-type LedgerCloseMetaV0 struct {
-	LedgerHeader       LedgerHeaderHistoryEntry
-	TxSet              TransactionSet
-	TxProcessing       []TransactionResultMeta
-	UpgradesProcessing []UpgradeEntryMeta
-	ScpInfo            []ScpHistoryEntry
+type TransactionHistoryResultEntry struct {
+	LedgerSeq   Uint32
+	TxResultSet TransactionResultSet
+	Ext         TransactionHistoryResultEntryExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerCloseMetaV0) MarshalBinary() ([]byte, error) {
+func (s TransactionHistoryResultEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerCloseMetaV0) UnmarshalBinary(inp []byte) error {
+func (s *TransactionHistoryResultEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerCloseMetaV0)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerCloseMetaV0)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionHistoryResultEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionHistoryResultEntry)(nil)
 )
 
-// LedgerCloseMeta is an XDR Union defines as:
+// LedgerHeaderHistoryEntryExt is an XDR NestedUnion defines as:
 //
-//   union LedgerCloseMeta switch (int v)
-//    {
-//    case 0:
-//        LedgerCloseMetaV0 v0;
-//    };
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
 //
-type LedgerCloseMeta struct {
-	V  int32
-	V0 *LedgerCloseMetaV0
+type LedgerHeaderHistoryEntryExt struct {
+	V int32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u LedgerCloseMeta) SwitchFieldName() string {
+func (u LedgerHeaderHistoryEntryExt) SwitchFieldName() string {
 	return "V"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of LedgerCloseMeta
-func (u LedgerCloseMeta) ArmForSwitch(sw int32) (string, bool) {
+// the value for an instance of LedgerHeaderHistoryEntryExt
+func (u LedgerHeaderHistoryEntryExt) ArmForSwitch(sw int32) (string, bool) {
 	switch int32(sw) {
 	case 0:
-		return "V0", true
+		return "", true
 	}
 	return "-", false
 }
 
-// NewLedgerCloseMeta creates a new  LedgerCloseMeta.
-func NewLedgerCloseMeta(v int32, value interface{}) (result LedgerCloseMeta, err error) {
+// NewLedgerHeaderHistoryEntryExt creates a new  LedgerHeaderHistoryEntryExt.
+func NewLedgerHeaderHistoryEntryExt(v int32, value interface{}) (result LedgerHeaderHistoryEntryExt, err error) {
 	result.V = v
 	switch int32(v) {
 	case 0:
-		tv, ok := value.(LedgerCloseMetaV0)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be LedgerCloseMetaV0")
-			return
-		}
-		result.V0 = &tv
-	}
-	return
-}
-
-// MustV0 retrieves the V0 value from the union,
-// panicing if the value is not set.
-func (u LedgerCloseMeta) MustV0() LedgerCloseMetaV0 {
-	val, ok := u.GetV0()
-
-	if !ok {
-		panic("arm V0 is not set")
-	}
-
-	return val
-}
-
-// GetV0 retrieves the V0 value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u LedgerCloseMeta) GetV0() (result LedgerCloseMetaV0, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
-
-	if armName == "V0" {
-		result = *u.V0
-		ok = true
+		// void
 	}
-
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s LedgerCloseMeta) MarshalBinary() ([]byte, error) {
+func (s LedgerHeaderHistoryEntryExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *LedgerCloseMeta) UnmarshalBinary(inp []byte) error {
+func (s *LedgerHeaderHistoryEntryExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*LedgerCloseMeta)(nil)
-	_ encoding.BinaryUnmarshaler = (*LedgerCloseMeta)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerHeaderHistoryEntryExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerHeaderHistoryEntryExt)(nil)
 )
 
-// ErrorCode is an XDR Enum defines as:
+// LedgerHeaderHistoryEntry is an XDR Struct defines as:
 //
-//   enum ErrorCode
+//   struct LedgerHeaderHistoryEntry
 //    {
-//        ERR_MISC = 0, // Unspecific error
-//        ERR_DATA = 1, // Malformed data
-//        ERR_CONF = 2, // Misconfiguration error
-//        ERR_AUTH = 3, // Authentication failure
-//        ERR_LOAD = 4  // System overloaded
+//        Hash hash;
+//        LedgerHeader header;
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
 //    };
 //
-type ErrorCode int32
-
-const (
-	ErrorCodeErrMisc ErrorCode = 0
-	ErrorCodeErrData ErrorCode = 1
-	ErrorCodeErrConf ErrorCode = 2
-	ErrorCodeErrAuth ErrorCode = 3
-	ErrorCodeErrLoad ErrorCode = 4
-)
-
-var errorCodeMap = map[int32]string{
-	0: "ErrorCodeErrMisc",
-	1: "ErrorCodeErrData",
-	2: "ErrorCodeErrConf",
-	3: "ErrorCodeErrAuth",
-	4: "ErrorCodeErrLoad",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ErrorCode
-func (e ErrorCode) ValidEnum(v int32) bool {
-	_, ok := errorCodeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e ErrorCode) String() string {
-	name, _ := errorCodeMap[int32(e)]
-	return name
+type LedgerHeaderHistoryEntry struct {
+	Hash   Hash
+	Header LedgerHeader
+	Ext    LedgerHeaderHistoryEntryExt
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ErrorCode) MarshalBinary() ([]byte, error) {
+func (s LedgerHeaderHistoryEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ErrorCode) UnmarshalBinary(inp []byte) error {
+func (s *LedgerHeaderHistoryEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ErrorCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*ErrorCode)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerHeaderHistoryEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerHeaderHistoryEntry)(nil)
 )
 
-// Error is an XDR Struct defines as:
+// LedgerScpMessages is an XDR Struct defines as:
 //
-//   struct Error
+//   struct LedgerSCPMessages
 //    {
-//        ErrorCode code;
-//        string msg<100>;
+//        uint32 ledgerSeq;
+//        SCPEnvelope messages<>;
 //    };
 //
-type Error struct {
-	Code ErrorCode
-	Msg  string `xdrmaxsize:"100"`
+type LedgerScpMessages struct {
+	LedgerSeq Uint32
+	Messages  []ScpEnvelope
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s Error) MarshalBinary() ([]byte, error) {
+func (s LedgerScpMessages) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Error) UnmarshalBinary(inp []byte) error {
+func (s *LedgerScpMessages) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*Error)(nil)
-	_ encoding.BinaryUnmarshaler = (*Error)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerScpMessages)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerScpMessages)(nil)
 )
 
-// AuthCert is an XDR Struct defines as:
+// ScpHistoryEntryV0 is an XDR Struct defines as:
 //
-//   struct AuthCert
+//   struct SCPHistoryEntryV0
 //    {
-//        Curve25519Public pubkey;
-//        uint64 expiration;
-//        Signature sig;
+//        SCPQuorumSet quorumSets<>; // additional quorum sets used by ledgerMessages
+//        LedgerSCPMessages ledgerMessages;
 //    };
 //
-type AuthCert struct {
-	Pubkey     Curve25519Public
-	Expiration Uint64
-	Sig        Signature
+type ScpHistoryEntryV0 struct {
+	QuorumSets     []ScpQuorumSet
+	LedgerMessages LedgerScpMessages
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AuthCert) MarshalBinary() ([]byte, error) {
+func (s ScpHistoryEntryV0) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AuthCert) UnmarshalBinary(inp []byte) error {
+func (s *ScpHistoryEntryV0) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AuthCert)(nil)
-	_ encoding.BinaryUnmarshaler = (*AuthCert)(nil)
+	_ encoding.BinaryMarshaler   = (*ScpHistoryEntryV0)(nil)
+	_ encoding.BinaryUnmarshaler = (*ScpHistoryEntryV0)(nil)
 )
 
-// Hello is an XDR Struct defines as:
+// ScpHistoryEntry is an XDR Union defines as:
 //
-//   struct Hello
+//   union SCPHistoryEntry switch (int v)
 //    {
-//        uint32 ledgerVersion;
-//        uint32 overlayVersion;
-//        uint32 overlayMinVersion;
-//        Hash networkID;
-//        string versionStr<100>;
-//        int listeningPort;
-//        NodeID peerID;
-//        AuthCert cert;
-//        uint256 nonce;
+//    case 0:
+//        SCPHistoryEntryV0 v0;
 //    };
 //
-type Hello struct {
-	LedgerVersion     Uint32
-	OverlayVersion    Uint32
-	OverlayMinVersion Uint32
-	NetworkId         Hash
-	VersionStr        string `xdrmaxsize:"100"`
-	ListeningPort     int32
-	PeerId            NodeId
-	Cert              AuthCert
-	Nonce             Uint256
+type ScpHistoryEntry struct {
+	V  int32
+	V0 *ScpHistoryEntryV0
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s Hello) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u ScpHistoryEntry) SwitchFieldName() string {
+	return "V"
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Hello) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of ScpHistoryEntry
+func (u ScpHistoryEntry) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "V0", true
+	}
+	return "-", false
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*Hello)(nil)
-	_ encoding.BinaryUnmarshaler = (*Hello)(nil)
-)
+// NewScpHistoryEntry creates a new  ScpHistoryEntry.
+func NewScpHistoryEntry(v int32, value interface{}) (result ScpHistoryEntry, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		tv, ok := value.(ScpHistoryEntryV0)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ScpHistoryEntryV0")
+			return
+		}
+		result.V0 = &tv
+	}
+	return
+}
 
-// Auth is an XDR Struct defines as:
-//
-//   struct Auth
-//    {
-//        // Empty message, just to confirm
-//        // establishment of MAC keys.
-//        int unused;
-//    };
-//
-type Auth struct {
-	Unused int32
+// MustV0 retrieves the V0 value from the union,
+// panicing if the value is not set.
+func (u ScpHistoryEntry) MustV0() ScpHistoryEntryV0 {
+	val, ok := u.GetV0()
+
+	if !ok {
+		panic("arm V0 is not set")
+	}
+
+	return val
+}
+
+// GetV0 retrieves the V0 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u ScpHistoryEntry) GetV0() (result ScpHistoryEntryV0, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V0" {
+		result = *u.V0
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s Auth) MarshalBinary() ([]byte, error) {
+func (s ScpHistoryEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Auth) UnmarshalBinary(inp []byte) error {
+func (s *ScpHistoryEntry) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*Auth)(nil)
-	_ encoding.BinaryUnmarshaler = (*Auth)(nil)
+	_ encoding.BinaryMarshaler   = (*ScpHistoryEntry)(nil)
+	_ encoding.BinaryUnmarshaler = (*ScpHistoryEntry)(nil)
 )
 
-// IpAddrType is an XDR Enum defines as:
+// LedgerEntryChangeType is an XDR Enum defines as:
 //
-//   enum IPAddrType
+//   enum LedgerEntryChangeType
 //    {
-//        IPv4 = 0,
-//        IPv6 = 1
+//        LEDGER_ENTRY_CREATED = 0, // entry was added to the ledger
+//        LEDGER_ENTRY_UPDATED = 1, // entry was modified in the ledger
+//        LEDGER_ENTRY_REMOVED = 2, // entry was removed from the ledger
+//        LEDGER_ENTRY_STATE = 3    // value of the entry
 //    };
 //
-type IpAddrType int32
+type LedgerEntryChangeType int32
 
 const (
-	IpAddrTypeIPv4 IpAddrType = 0
-	IpAddrTypeIPv6 IpAddrType = 1
+	LedgerEntryChangeTypeLedgerEntryCreated LedgerEntryChangeType = 0
+	LedgerEntryChangeTypeLedgerEntryUpdated LedgerEntryChangeType = 1
+	LedgerEntryChangeTypeLedgerEntryRemoved LedgerEntryChangeType = 2
+	LedgerEntryChangeTypeLedgerEntryState   LedgerEntryChangeType = 3
 )
 
-var ipAddrTypeMap = map[int32]string{
-	0: "IpAddrTypeIPv4",
-	1: "IpAddrTypeIPv6",
+var ledgerEntryChangeTypeMap = map[int32]string{
+	0: "LedgerEntryChangeTypeLedgerEntryCreated",
+	1: "LedgerEntryChangeTypeLedgerEntryUpdated",
+	2: "LedgerEntryChangeTypeLedgerEntryRemoved",
+	3: "LedgerEntryChangeTypeLedgerEntryState",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for IpAddrType
-func (e IpAddrType) ValidEnum(v int32) bool {
-	_, ok := ipAddrTypeMap[v]
+// the Enum interface for LedgerEntryChangeType
+func (e LedgerEntryChangeType) ValidEnum(v int32) bool {
+	_, ok := ledgerEntryChangeTypeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e IpAddrType) String() string {
-	name, _ := ipAddrTypeMap[int32(e)]
+func (e LedgerEntryChangeType) String() string {
+	name, _ := ledgerEntryChangeTypeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s IpAddrType) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryChangeType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *IpAddrType) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryChangeType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*IpAddrType)(nil)
-	_ encoding.BinaryUnmarshaler = (*IpAddrType)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryChangeType)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryChangeType)(nil)
 )
 
-// PeerAddressIp is an XDR NestedUnion defines as:
+// LedgerEntryChange is an XDR Union defines as:
 //
-//   union switch (IPAddrType type)
-//        {
-//        case IPv4:
-//            opaque ipv4[4];
-//        case IPv6:
-//            opaque ipv6[16];
-//        }
+//   union LedgerEntryChange switch (LedgerEntryChangeType type)
+//    {
+//    case LEDGER_ENTRY_CREATED:
+//        LedgerEntry created;
+//    case LEDGER_ENTRY_UPDATED:
+//        LedgerEntry updated;
+//    case LEDGER_ENTRY_REMOVED:
+//        LedgerKey removed;
+//    case LEDGER_ENTRY_STATE:
+//        LedgerEntry state;
+//    };
 //
-type PeerAddressIp struct {
-	Type IpAddrType
-	Ipv4 *[4]byte  `xdrmaxsize:"4"`
-	Ipv6 *[16]byte `xdrmaxsize:"16"`
+type LedgerEntryChange struct {
+	Type    LedgerEntryChangeType
+	Created *LedgerEntry
+	Updated *LedgerEntry
+	Removed *LedgerKey
+	State   *LedgerEntry
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u PeerAddressIp) SwitchFieldName() string {
+func (u LedgerEntryChange) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of PeerAddressIp
-func (u PeerAddressIp) ArmForSwitch(sw int32) (string, bool) {
-	switch IpAddrType(sw) {
-	case IpAddrTypeIPv4:
-		return "Ipv4", true
-	case IpAddrTypeIPv6:
-		return "Ipv6", true
+// the value for an instance of LedgerEntryChange
+func (u LedgerEntryChange) ArmForSwitch(sw int32) (string, bool) {
+	switch LedgerEntryChangeType(sw) {
+	case LedgerEntryChangeTypeLedgerEntryCreated:
+		return "Created", true
+	case LedgerEntryChangeTypeLedgerEntryUpdated:
+		return "Updated", true
+	case LedgerEntryChangeTypeLedgerEntryRemoved:
+		return "Removed", true
+	case LedgerEntryChangeTypeLedgerEntryState:
+		return "State", true
 	}
 	return "-", false
 }
 
-// NewPeerAddressIp creates a new  PeerAddressIp.
-func NewPeerAddressIp(aType IpAddrType, value interface{}) (result PeerAddressIp, err error) {
+// NewLedgerEntryChange creates a new  LedgerEntryChange.
+func NewLedgerEntryChange(aType LedgerEntryChangeType, value interface{}) (result LedgerEntryChange, err error) {
 	result.Type = aType
-	switch IpAddrType(aType) {
-	case IpAddrTypeIPv4:
-		tv, ok := value.([4]byte)
+	switch LedgerEntryChangeType(aType) {
+	case LedgerEntryChangeTypeLedgerEntryCreated:
+		tv, ok := value.(LedgerEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be [4]byte")
+			err = fmt.Errorf("invalid value, must be LedgerEntry")
 			return
 		}
-		result.Ipv4 = &tv
-	case IpAddrTypeIPv6:
-		tv, ok := value.([16]byte)
+		result.Created = &tv
+	case LedgerEntryChangeTypeLedgerEntryUpdated:
+		tv, ok := value.(LedgerEntry)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be [16]byte")
+			err = fmt.Errorf("invalid value, must be LedgerEntry")
 			return
 		}
-		result.Ipv6 = &tv
+		result.Updated = &tv
+	case LedgerEntryChangeTypeLedgerEntryRemoved:
+		tv, ok := value.(LedgerKey)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKey")
+			return
+		}
+		result.Removed = &tv
+	case LedgerEntryChangeTypeLedgerEntryState:
+		tv, ok := value.(LedgerEntry)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerEntry")
+			return
+		}
+		result.State = &tv
 	}
 	return
 }
 
-// MustIpv4 retrieves the Ipv4 value from the union,
+// MustCreated retrieves the Created value from the union,
 // panicing if the value is not set.
-func (u PeerAddressIp) MustIpv4() [4]byte {
-	val, ok := u.GetIpv4()
+func (u LedgerEntryChange) MustCreated() LedgerEntry {
+	val, ok := u.GetCreated()
 
 	if !ok {
-		panic("arm Ipv4 is not set")
+		panic("arm Created is not set")
 	}
 
 	return val
 }
 
-// GetIpv4 retrieves the Ipv4 value from the union,
+// GetCreated retrieves the Created value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u PeerAddressIp) GetIpv4() (result [4]byte, ok bool) {
+func (u LedgerEntryChange) GetCreated() (result LedgerEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Ipv4" {
-		result = *u.Ipv4
+	if armName == "Created" {
+		result = *u.Created
 		ok = true
 	}
 
 	return
 }
 
-// MustIpv6 retrieves the Ipv6 value from the union,
+// MustUpdated retrieves the Updated value from the union,
 // panicing if the value is not set.
-func (u PeerAddressIp) MustIpv6() [16]byte {
-	val, ok := u.GetIpv6()
+func (u LedgerEntryChange) MustUpdated() LedgerEntry {
+	val, ok := u.GetUpdated()
 
 	if !ok {
-		panic("arm Ipv6 is not set")
+		panic("arm Updated is not set")
 	}
 
 	return val
 }
 
-// GetIpv6 retrieves the Ipv6 value from the union,
+// GetUpdated retrieves the Updated value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u PeerAddressIp) GetIpv6() (result [16]byte, ok bool) {
+func (u LedgerEntryChange) GetUpdated() (result LedgerEntry, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Ipv6" {
-		result = *u.Ipv6
+	if armName == "Updated" {
+		result = *u.Updated
+		ok = true
+	}
+
+	return
+}
+
+// MustRemoved retrieves the Removed value from the union,
+// panicing if the value is not set.
+func (u LedgerEntryChange) MustRemoved() LedgerKey {
+	val, ok := u.GetRemoved()
+
+	if !ok {
+		panic("arm Removed is not set")
+	}
+
+	return val
+}
+
+// GetRemoved retrieves the Removed value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerEntryChange) GetRemoved() (result LedgerKey, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Removed" {
+		result = *u.Removed
+		ok = true
+	}
+
+	return
+}
+
+// MustState retrieves the State value from the union,
+// panicing if the value is not set.
+func (u LedgerEntryChange) MustState() LedgerEntry {
+	val, ok := u.GetState()
+
+	if !ok {
+		panic("arm State is not set")
+	}
+
+	return val
+}
+
+// GetState retrieves the State value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerEntryChange) GetState() (result LedgerEntry, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "State" {
+		result = *u.State
 		ok = true
 	}
 
@@ -5590,1297 +5830,1444 @@ func (u PeerAddressIp) GetIpv6() (result [16]byte, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PeerAddressIp) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryChange) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PeerAddressIp) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryChange) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PeerAddressIp)(nil)
-	_ encoding.BinaryUnmarshaler = (*PeerAddressIp)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryChange)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryChange)(nil)
 )
 
-// PeerAddress is an XDR Struct defines as:
+// LedgerEntryChanges is an XDR Typedef defines as:
 //
-//   struct PeerAddress
-//    {
-//        union switch (IPAddrType type)
-//        {
-//        case IPv4:
-//            opaque ipv4[4];
-//        case IPv6:
-//            opaque ipv6[16];
-//        }
-//        ip;
-//        uint32 port;
-//        uint32 numFailures;
-//    };
+//   typedef LedgerEntryChange LedgerEntryChanges<>;
 //
-type PeerAddress struct {
-	Ip          PeerAddressIp
-	Port        Uint32
-	NumFailures Uint32
-}
+type LedgerEntryChanges []LedgerEntryChange
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PeerAddress) MarshalBinary() ([]byte, error) {
+func (s LedgerEntryChanges) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PeerAddress) UnmarshalBinary(inp []byte) error {
+func (s *LedgerEntryChanges) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PeerAddress)(nil)
-	_ encoding.BinaryUnmarshaler = (*PeerAddress)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerEntryChanges)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerEntryChanges)(nil)
 )
 
-// MessageType is an XDR Enum defines as:
+// OperationMeta is an XDR Struct defines as:
 //
-//   enum MessageType
+//   struct OperationMeta
 //    {
-//        ERROR_MSG = 0,
-//        AUTH = 2,
-//        DONT_HAVE = 3,
-//
-//        GET_PEERS = 4, // gets a list of peers this guy knows about
-//        PEERS = 5,
-//
-//        GET_TX_SET = 6, // gets a particular txset by hash
-//        TX_SET = 7,
-//
-//        TRANSACTION = 8, // pass on a tx you have heard about
-//
-//        // SCP
-//        GET_SCP_QUORUMSET = 9,
-//        SCP_QUORUMSET = 10,
-//        SCP_MESSAGE = 11,
-//        GET_SCP_STATE = 12,
-//
-//        // new messages
-//        HELLO = 13,
-//
-//        SURVEY_REQUEST = 14,
-//        SURVEY_RESPONSE = 15
+//        LedgerEntryChanges changes;
 //    };
 //
-type MessageType int32
-
-const (
-	MessageTypeErrorMsg        MessageType = 0
-	MessageTypeAuth            MessageType = 2
-	MessageTypeDontHave        MessageType = 3
-	MessageTypeGetPeers        MessageType = 4
-	MessageTypePeers           MessageType = 5
-	MessageTypeGetTxSet        MessageType = 6
-	MessageTypeTxSet           MessageType = 7
-	MessageTypeTransaction     MessageType = 8
-	MessageTypeGetScpQuorumset MessageType = 9
-	MessageTypeScpQuorumset    MessageType = 10
-	MessageTypeScpMessage      MessageType = 11
-	MessageTypeGetScpState     MessageType = 12
-	MessageTypeHello           MessageType = 13
-	MessageTypeSurveyRequest   MessageType = 14
-	MessageTypeSurveyResponse  MessageType = 15
-)
-
-var messageTypeMap = map[int32]string{
-	0:  "MessageTypeErrorMsg",
-	2:  "MessageTypeAuth",
-	3:  "MessageTypeDontHave",
-	4:  "MessageTypeGetPeers",
-	5:  "MessageTypePeers",
-	6:  "MessageTypeGetTxSet",
-	7:  "MessageTypeTxSet",
-	8:  "MessageTypeTransaction",
-	9:  "MessageTypeGetScpQuorumset",
-	10: "MessageTypeScpQuorumset",
-	11: "MessageTypeScpMessage",
-	12: "MessageTypeGetScpState",
-	13: "MessageTypeHello",
-	14: "MessageTypeSurveyRequest",
-	15: "MessageTypeSurveyResponse",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for MessageType
-func (e MessageType) ValidEnum(v int32) bool {
-	_, ok := messageTypeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e MessageType) String() string {
-	name, _ := messageTypeMap[int32(e)]
-	return name
+type OperationMeta struct {
+	Changes LedgerEntryChanges
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s MessageType) MarshalBinary() ([]byte, error) {
+func (s OperationMeta) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *MessageType) UnmarshalBinary(inp []byte) error {
+func (s *OperationMeta) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*MessageType)(nil)
-	_ encoding.BinaryUnmarshaler = (*MessageType)(nil)
+	_ encoding.BinaryMarshaler   = (*OperationMeta)(nil)
+	_ encoding.BinaryUnmarshaler = (*OperationMeta)(nil)
 )
 
-// DontHave is an XDR Struct defines as:
+// TransactionMetaV1 is an XDR Struct defines as:
 //
-//   struct DontHave
+//   struct TransactionMetaV1
 //    {
-//        MessageType type;
-//        uint256 reqHash;
+//        LedgerEntryChanges txChanges; // tx level changes if any
+//        OperationMeta operations<>;   // meta for each operation
 //    };
 //
-type DontHave struct {
-	Type    MessageType
-	ReqHash Uint256
+type TransactionMetaV1 struct {
+	TxChanges  LedgerEntryChanges
+	Operations []OperationMeta
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s DontHave) MarshalBinary() ([]byte, error) {
+func (s TransactionMetaV1) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *DontHave) UnmarshalBinary(inp []byte) error {
+func (s *TransactionMetaV1) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*DontHave)(nil)
-	_ encoding.BinaryUnmarshaler = (*DontHave)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionMetaV1)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionMetaV1)(nil)
 )
 
-// SurveyMessageCommandType is an XDR Enum defines as:
+// TransactionMetaV2 is an XDR Struct defines as:
 //
-//   enum SurveyMessageCommandType
+//   struct TransactionMetaV2
 //    {
-//        SURVEY_TOPOLOGY = 0
+//        LedgerEntryChanges txChangesBefore; // tx level changes before operations
+//                                            // are applied if any
+//        OperationMeta operations<>;         // meta for each operation
+//        LedgerEntryChanges txChangesAfter;  // tx level changes after operations are
+//                                            // applied if any
 //    };
 //
-type SurveyMessageCommandType int32
-
-const (
-	SurveyMessageCommandTypeSurveyTopology SurveyMessageCommandType = 0
-)
-
-var surveyMessageCommandTypeMap = map[int32]string{
-	0: "SurveyMessageCommandTypeSurveyTopology",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for SurveyMessageCommandType
-func (e SurveyMessageCommandType) ValidEnum(v int32) bool {
-	_, ok := surveyMessageCommandTypeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e SurveyMessageCommandType) String() string {
-	name, _ := surveyMessageCommandTypeMap[int32(e)]
-	return name
+type TransactionMetaV2 struct {
+	TxChangesBefore LedgerEntryChanges
+	Operations      []OperationMeta
+	TxChangesAfter  LedgerEntryChanges
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SurveyMessageCommandType) MarshalBinary() ([]byte, error) {
+func (s TransactionMetaV2) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SurveyMessageCommandType) UnmarshalBinary(inp []byte) error {
+func (s *TransactionMetaV2) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SurveyMessageCommandType)(nil)
-	_ encoding.BinaryUnmarshaler = (*SurveyMessageCommandType)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionMetaV2)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionMetaV2)(nil)
 )
 
-// SurveyRequestMessage is an XDR Struct defines as:
+// TransactionMeta is an XDR Union defines as:
 //
-//   struct SurveyRequestMessage
+//   union TransactionMeta switch (int v)
 //    {
-//        NodeID surveyorPeerID;
-//        NodeID surveyedPeerID;
-//        uint32 ledgerNum;
-//        Curve25519Public encryptionKey;
-//        SurveyMessageCommandType commandType;
+//    case 0:
+//        OperationMeta operations<>;
+//    case 1:
+//        TransactionMetaV1 v1;
+//    case 2:
+//        TransactionMetaV2 v2;
 //    };
 //
-type SurveyRequestMessage struct {
-	SurveyorPeerId NodeId
-	SurveyedPeerId NodeId
-	LedgerNum      Uint32
-	EncryptionKey  Curve25519Public
-	CommandType    SurveyMessageCommandType
+type TransactionMeta struct {
+	V          int32
+	Operations *[]OperationMeta
+	V1         *TransactionMetaV1
+	V2         *TransactionMetaV2
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u TransactionMeta) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of TransactionMeta
+func (u TransactionMeta) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "Operations", true
+	case 1:
+		return "V1", true
+	case 2:
+		return "V2", true
+	}
+	return "-", false
+}
+
+// NewTransactionMeta creates a new  TransactionMeta.
+func NewTransactionMeta(v int32, value interface{}) (result TransactionMeta, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		tv, ok := value.([]OperationMeta)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be []OperationMeta")
+			return
+		}
+		result.Operations = &tv
+	case 1:
+		tv, ok := value.(TransactionMetaV1)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be TransactionMetaV1")
+			return
+		}
+		result.V1 = &tv
+	case 2:
+		tv, ok := value.(TransactionMetaV2)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be TransactionMetaV2")
+			return
+		}
+		result.V2 = &tv
+	}
+	return
+}
+
+// MustOperations retrieves the Operations value from the union,
+// panicing if the value is not set.
+func (u TransactionMeta) MustOperations() []OperationMeta {
+	val, ok := u.GetOperations()
+
+	if !ok {
+		panic("arm Operations is not set")
+	}
+
+	return val
+}
+
+// GetOperations retrieves the Operations value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u TransactionMeta) GetOperations() (result []OperationMeta, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "Operations" {
+		result = *u.Operations
+		ok = true
+	}
+
+	return
+}
+
+// MustV1 retrieves the V1 value from the union,
+// panicing if the value is not set.
+func (u TransactionMeta) MustV1() TransactionMetaV1 {
+	val, ok := u.GetV1()
+
+	if !ok {
+		panic("arm V1 is not set")
+	}
+
+	return val
+}
+
+// GetV1 retrieves the V1 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u TransactionMeta) GetV1() (result TransactionMetaV1, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V1" {
+		result = *u.V1
+		ok = true
+	}
+
+	return
+}
+
+// MustV2 retrieves the V2 value from the union,
+// panicing if the value is not set.
+func (u TransactionMeta) MustV2() TransactionMetaV2 {
+	val, ok := u.GetV2()
+
+	if !ok {
+		panic("arm V2 is not set")
+	}
+
+	return val
+}
+
+// GetV2 retrieves the V2 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u TransactionMeta) GetV2() (result TransactionMetaV2, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V2" {
+		result = *u.V2
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SurveyRequestMessage) MarshalBinary() ([]byte, error) {
+func (s TransactionMeta) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SurveyRequestMessage) UnmarshalBinary(inp []byte) error {
+func (s *TransactionMeta) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SurveyRequestMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*SurveyRequestMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionMeta)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionMeta)(nil)
 )
 
-// SignedSurveyRequestMessage is an XDR Struct defines as:
+// TransactionResultMeta is an XDR Struct defines as:
 //
-//   struct SignedSurveyRequestMessage
+//   struct TransactionResultMeta
 //    {
-//        Signature requestSignature;
-//        SurveyRequestMessage request;
+//        TransactionResultPair result;
+//        LedgerEntryChanges feeProcessing;
+//        TransactionMeta txApplyProcessing;
 //    };
 //
-type SignedSurveyRequestMessage struct {
-	RequestSignature Signature
-	Request          SurveyRequestMessage
+type TransactionResultMeta struct {
+	Result            TransactionResultPair
+	FeeProcessing     LedgerEntryChanges
+	TxApplyProcessing TransactionMeta
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SignedSurveyRequestMessage) MarshalBinary() ([]byte, error) {
+func (s TransactionResultMeta) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SignedSurveyRequestMessage) UnmarshalBinary(inp []byte) error {
+func (s *TransactionResultMeta) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SignedSurveyRequestMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*SignedSurveyRequestMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionResultMeta)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionResultMeta)(nil)
 )
 
-// EncryptedBody is an XDR Typedef defines as:
+// UpgradeEntryMeta is an XDR Struct defines as:
 //
-//   typedef opaque EncryptedBody<64000>;
+//   struct UpgradeEntryMeta
+//    {
+//        LedgerUpgrade upgrade;
+//        LedgerEntryChanges changes;
+//    };
 //
-type EncryptedBody []byte
-
-// XDRMaxSize implements the Sized interface for EncryptedBody
-func (e EncryptedBody) XDRMaxSize() int {
-	return 64000
+type UpgradeEntryMeta struct {
+	Upgrade LedgerUpgrade
+	Changes LedgerEntryChanges
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s EncryptedBody) MarshalBinary() ([]byte, error) {
+func (s UpgradeEntryMeta) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *EncryptedBody) UnmarshalBinary(inp []byte) error {
+func (s *UpgradeEntryMeta) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*EncryptedBody)(nil)
-	_ encoding.BinaryUnmarshaler = (*EncryptedBody)(nil)
+	_ encoding.BinaryMarshaler   = (*UpgradeEntryMeta)(nil)
+	_ encoding.BinaryUnmarshaler = (*UpgradeEntryMeta)(nil)
 )
 
-// SurveyResponseMessage is an XDR Struct defines as:
+// LedgerCloseMetaV0 is an XDR Struct defines as:
 //
-//   struct SurveyResponseMessage
+//   struct LedgerCloseMetaV0
 //    {
-//        NodeID surveyorPeerID;
-//        NodeID surveyedPeerID;
-//        uint32 ledgerNum;
-//        SurveyMessageCommandType commandType;
-//        EncryptedBody encryptedBody;
+//        LedgerHeaderHistoryEntry ledgerHeader;
+//        // NB: txSet is sorted in "Hash order"
+//        TransactionSet txSet;
+//
+//        // NB: transactions are sorted in apply order here
+//        // fees for all transactions are processed first
+//        // followed by applying transactions
+//        TransactionResultMeta txProcessing<>;
+//
+//        // upgrades are applied last
+//        UpgradeEntryMeta upgradesProcessing<>;
+//
+//        // other misc information attached to the ledger close
+//        SCPHistoryEntry scpInfo<>;
 //    };
 //
-type SurveyResponseMessage struct {
-	SurveyorPeerId NodeId
-	SurveyedPeerId NodeId
-	LedgerNum      Uint32
-	CommandType    SurveyMessageCommandType
-	EncryptedBody  EncryptedBody
+type LedgerCloseMetaV0 struct {
+	LedgerHeader       LedgerHeaderHistoryEntry
+	TxSet              TransactionSet
+	TxProcessing       []TransactionResultMeta
+	UpgradesProcessing []UpgradeEntryMeta
+	ScpInfo            []ScpHistoryEntry
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SurveyResponseMessage) MarshalBinary() ([]byte, error) {
+func (s LedgerCloseMetaV0) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SurveyResponseMessage) UnmarshalBinary(inp []byte) error {
+func (s *LedgerCloseMetaV0) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SurveyResponseMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*SurveyResponseMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerCloseMetaV0)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerCloseMetaV0)(nil)
 )
 
-// SignedSurveyResponseMessage is an XDR Struct defines as:
+// LedgerCloseMeta is an XDR Union defines as:
 //
-//   struct SignedSurveyResponseMessage
+//   union LedgerCloseMeta switch (int v)
 //    {
-//        Signature responseSignature;
-//        SurveyResponseMessage response;
+//    case 0:
+//        LedgerCloseMetaV0 v0;
 //    };
 //
-type SignedSurveyResponseMessage struct {
-	ResponseSignature Signature
-	Response          SurveyResponseMessage
+type LedgerCloseMeta struct {
+	V  int32
+	V0 *LedgerCloseMetaV0
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u LedgerCloseMeta) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of LedgerCloseMeta
+func (u LedgerCloseMeta) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "V0", true
+	}
+	return "-", false
+}
+
+// NewLedgerCloseMeta creates a new  LedgerCloseMeta.
+func NewLedgerCloseMeta(v int32, value interface{}) (result LedgerCloseMeta, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		tv, ok := value.(LedgerCloseMetaV0)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerCloseMetaV0")
+			return
+		}
+		result.V0 = &tv
+	}
+	return
+}
+
+// MustV0 retrieves the V0 value from the union,
+// panicing if the value is not set.
+func (u LedgerCloseMeta) MustV0() LedgerCloseMetaV0 {
+	val, ok := u.GetV0()
+
+	if !ok {
+		panic("arm V0 is not set")
+	}
+
+	return val
+}
+
+// GetV0 retrieves the V0 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u LedgerCloseMeta) GetV0() (result LedgerCloseMetaV0, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V0" {
+		result = *u.V0
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SignedSurveyResponseMessage) MarshalBinary() ([]byte, error) {
+func (s LedgerCloseMeta) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SignedSurveyResponseMessage) UnmarshalBinary(inp []byte) error {
+func (s *LedgerCloseMeta) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SignedSurveyResponseMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*SignedSurveyResponseMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*LedgerCloseMeta)(nil)
+	_ encoding.BinaryUnmarshaler = (*LedgerCloseMeta)(nil)
 )
 
-// PeerStats is an XDR Struct defines as:
+// ErrorCode is an XDR Enum defines as:
 //
-//   struct PeerStats
+//   enum ErrorCode
 //    {
-//        NodeID id;
-//        string versionStr<100>;
-//        uint64 messagesRead;
-//        uint64 messagesWritten;
-//        uint64 bytesRead;
-//        uint64 bytesWritten;
-//        uint64 secondsConnected;
-//
-//        uint64 uniqueFloodBytesRecv;
-//        uint64 duplicateFloodBytesRecv;
-//        uint64 uniqueFetchBytesRecv;
-//        uint64 duplicateFetchBytesRecv;
-//
-//        uint64 uniqueFloodMessageRecv;
-//        uint64 duplicateFloodMessageRecv;
-//        uint64 uniqueFetchMessageRecv;
-//        uint64 duplicateFetchMessageRecv;
+//        ERR_MISC = 0, // Unspecific error
+//        ERR_DATA = 1, // Malformed data
+//        ERR_CONF = 2, // Misconfiguration error
+//        ERR_AUTH = 3, // Authentication failure
+//        ERR_LOAD = 4  // System overloaded
 //    };
 //
-type PeerStats struct {
-	Id                        NodeId
-	VersionStr                string `xdrmaxsize:"100"`
-	MessagesRead              Uint64
-	MessagesWritten           Uint64
-	BytesRead                 Uint64
-	BytesWritten              Uint64
-	SecondsConnected          Uint64
-	UniqueFloodBytesRecv      Uint64
-	DuplicateFloodBytesRecv   Uint64
-	UniqueFetchBytesRecv      Uint64
-	DuplicateFetchBytesRecv   Uint64
-	UniqueFloodMessageRecv    Uint64
-	DuplicateFloodMessageRecv Uint64
-	UniqueFetchMessageRecv    Uint64
-	DuplicateFetchMessageRecv Uint64
+type ErrorCode int32
+
+const (
+	ErrorCodeErrMisc ErrorCode = 0
+	ErrorCodeErrData ErrorCode = 1
+	ErrorCodeErrConf ErrorCode = 2
+	ErrorCodeErrAuth ErrorCode = 3
+	ErrorCodeErrLoad ErrorCode = 4
+)
+
+var errorCodeMap = map[int32]string{
+	0: "ErrorCodeErrMisc",
+	1: "ErrorCodeErrData",
+	2: "ErrorCodeErrConf",
+	3: "ErrorCodeErrAuth",
+	4: "ErrorCodeErrLoad",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for ErrorCode
+func (e ErrorCode) ValidEnum(v int32) bool {
+	_, ok := errorCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e ErrorCode) String() string {
+	name, _ := errorCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PeerStats) MarshalBinary() ([]byte, error) {
+func (s ErrorCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PeerStats) UnmarshalBinary(inp []byte) error {
+func (s *ErrorCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PeerStats)(nil)
-	_ encoding.BinaryUnmarshaler = (*PeerStats)(nil)
+	_ encoding.BinaryMarshaler   = (*ErrorCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ErrorCode)(nil)
 )
 
-// PeerStatList is an XDR Typedef defines as:
+// Error is an XDR Struct defines as:
 //
-//   typedef PeerStats PeerStatList<25>;
+//   struct Error
+//    {
+//        ErrorCode code;
+//        string msg<100>;
+//    };
 //
-type PeerStatList []PeerStats
-
-// XDRMaxSize implements the Sized interface for PeerStatList
-func (e PeerStatList) XDRMaxSize() int {
-	return 25
+type Error struct {
+	Code ErrorCode
+	Msg  string `xdrmaxsize:"100"`
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PeerStatList) MarshalBinary() ([]byte, error) {
+func (s Error) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PeerStatList) UnmarshalBinary(inp []byte) error {
+func (s *Error) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PeerStatList)(nil)
-	_ encoding.BinaryUnmarshaler = (*PeerStatList)(nil)
+	_ encoding.BinaryMarshaler   = (*Error)(nil)
+	_ encoding.BinaryUnmarshaler = (*Error)(nil)
 )
 
-// TopologyResponseBody is an XDR Struct defines as:
+// AuthCert is an XDR Struct defines as:
 //
-//   struct TopologyResponseBody
+//   struct AuthCert
 //    {
-//        PeerStatList inboundPeers;
-//        PeerStatList outboundPeers;
-//
-//        uint32 totalInboundPeerCount;
-//        uint32 totalOutboundPeerCount;
+//        Curve25519Public pubkey;
+//        uint64 expiration;
+//        Signature sig;
 //    };
 //
-type TopologyResponseBody struct {
-	InboundPeers           PeerStatList
-	OutboundPeers          PeerStatList
-	TotalInboundPeerCount  Uint32
-	TotalOutboundPeerCount Uint32
+type AuthCert struct {
+	Pubkey     Curve25519Public
+	Expiration Uint64
+	Sig        Signature
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TopologyResponseBody) MarshalBinary() ([]byte, error) {
+func (s AuthCert) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TopologyResponseBody) UnmarshalBinary(inp []byte) error {
+func (s *AuthCert) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TopologyResponseBody)(nil)
-	_ encoding.BinaryUnmarshaler = (*TopologyResponseBody)(nil)
+	_ encoding.BinaryMarshaler   = (*AuthCert)(nil)
+	_ encoding.BinaryUnmarshaler = (*AuthCert)(nil)
 )
 
-// SurveyResponseBody is an XDR Union defines as:
+// Hello is an XDR Struct defines as:
 //
-//   union SurveyResponseBody switch (SurveyMessageCommandType type)
+//   struct Hello
 //    {
-//    case SURVEY_TOPOLOGY:
-//        TopologyResponseBody topologyResponseBody;
+//        uint32 ledgerVersion;
+//        uint32 overlayVersion;
+//        uint32 overlayMinVersion;
+//        Hash networkID;
+//        string versionStr<100>;
+//        int listeningPort;
+//        NodeID peerID;
+//        AuthCert cert;
+//        uint256 nonce;
 //    };
 //
-type SurveyResponseBody struct {
-	Type                 SurveyMessageCommandType
-	TopologyResponseBody *TopologyResponseBody
+type Hello struct {
+	LedgerVersion     Uint32
+	OverlayVersion    Uint32
+	OverlayMinVersion Uint32
+	NetworkId         Hash
+	VersionStr        string `xdrmaxsize:"100"`
+	ListeningPort     int32
+	PeerId            NodeId
+	Cert              AuthCert
+	Nonce             Uint256
 }
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u SurveyResponseBody) SwitchFieldName() string {
-	return "Type"
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Hello) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of SurveyResponseBody
-func (u SurveyResponseBody) ArmForSwitch(sw int32) (string, bool) {
-	switch SurveyMessageCommandType(sw) {
-	case SurveyMessageCommandTypeSurveyTopology:
-		return "TopologyResponseBody", true
-	}
-	return "-", false
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Hello) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// NewSurveyResponseBody creates a new  SurveyResponseBody.
-func NewSurveyResponseBody(aType SurveyMessageCommandType, value interface{}) (result SurveyResponseBody, err error) {
-	result.Type = aType
-	switch SurveyMessageCommandType(aType) {
-	case SurveyMessageCommandTypeSurveyTopology:
-		tv, ok := value.(TopologyResponseBody)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TopologyResponseBody")
-			return
-		}
-		result.TopologyResponseBody = &tv
-	}
-	return
-}
+var (
+	_ encoding.BinaryMarshaler   = (*Hello)(nil)
+	_ encoding.BinaryUnmarshaler = (*Hello)(nil)
+)
 
-// MustTopologyResponseBody retrieves the TopologyResponseBody value from the union,
-// panicing if the value is not set.
-func (u SurveyResponseBody) MustTopologyResponseBody() TopologyResponseBody {
-	val, ok := u.GetTopologyResponseBody()
+// Auth is an XDR Struct defines as:
+//
+//   struct Auth
+//    {
+//        // Empty message, just to confirm
+//        // establishment of MAC keys.
+//        int unused;
+//    };
+//
+type Auth struct {
+	Unused int32
+}
 
-	if !ok {
-		panic("arm TopologyResponseBody is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Auth) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Auth) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetTopologyResponseBody retrieves the TopologyResponseBody value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u SurveyResponseBody) GetTopologyResponseBody() (result TopologyResponseBody, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*Auth)(nil)
+	_ encoding.BinaryUnmarshaler = (*Auth)(nil)
+)
 
-	if armName == "TopologyResponseBody" {
-		result = *u.TopologyResponseBody
-		ok = true
-	}
+// IpAddrType is an XDR Enum defines as:
+//
+//   enum IPAddrType
+//    {
+//        IPv4 = 0,
+//        IPv6 = 1
+//    };
+//
+type IpAddrType int32
 
-	return
+const (
+	IpAddrTypeIPv4 IpAddrType = 0
+	IpAddrTypeIPv6 IpAddrType = 1
+)
+
+var ipAddrTypeMap = map[int32]string{
+	0: "IpAddrTypeIPv4",
+	1: "IpAddrTypeIPv6",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for IpAddrType
+func (e IpAddrType) ValidEnum(v int32) bool {
+	_, ok := ipAddrTypeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e IpAddrType) String() string {
+	name, _ := ipAddrTypeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SurveyResponseBody) MarshalBinary() ([]byte, error) {
+func (s IpAddrType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SurveyResponseBody) UnmarshalBinary(inp []byte) error {
+func (s *IpAddrType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SurveyResponseBody)(nil)
-	_ encoding.BinaryUnmarshaler = (*SurveyResponseBody)(nil)
+	_ encoding.BinaryMarshaler   = (*IpAddrType)(nil)
+	_ encoding.BinaryUnmarshaler = (*IpAddrType)(nil)
 )
 
-// StellarMessage is an XDR Union defines as:
-//
-//   union StellarMessage switch (MessageType type)
-//    {
-//    case ERROR_MSG:
-//        Error error;
-//    case HELLO:
-//        Hello hello;
-//    case AUTH:
-//        Auth auth;
-//    case DONT_HAVE:
-//        DontHave dontHave;
-//    case GET_PEERS:
-//        void;
-//    case PEERS:
-//        PeerAddress peers<100>;
-//
-//    case GET_TX_SET:
-//        uint256 txSetHash;
-//    case TX_SET:
-//        TransactionSet txSet;
-//
-//    case TRANSACTION:
-//        TransactionEnvelope transaction;
-//
-//    case SURVEY_REQUEST:
-//        SignedSurveyRequestMessage signedSurveyRequestMessage;
-//
-//    case SURVEY_RESPONSE:
-//        SignedSurveyResponseMessage signedSurveyResponseMessage;
+// PeerAddressIp is an XDR NestedUnion defines as:
 //
-//    // SCP
-//    case GET_SCP_QUORUMSET:
-//        uint256 qSetHash;
-//    case SCP_QUORUMSET:
-//        SCPQuorumSet qSet;
-//    case SCP_MESSAGE:
-//        SCPEnvelope envelope;
-//    case GET_SCP_STATE:
-//        uint32 getSCPLedgerSeq; // ledger seq requested ; if 0, requests the latest
-//    };
+//   union switch (IPAddrType type)
+//        {
+//        case IPv4:
+//            opaque ipv4[4];
+//        case IPv6:
+//            opaque ipv6[16];
+//        }
 //
-type StellarMessage struct {
-	Type                        MessageType
-	Error                       *Error
-	Hello                       *Hello
-	Auth                        *Auth
-	DontHave                    *DontHave
-	Peers                       *[]PeerAddress `xdrmaxsize:"100"`
-	TxSetHash                   *Uint256
-	TxSet                       *TransactionSet
-	Transaction                 *TransactionEnvelope
-	SignedSurveyRequestMessage  *SignedSurveyRequestMessage
-	SignedSurveyResponseMessage *SignedSurveyResponseMessage
-	QSetHash                    *Uint256
-	QSet                        *ScpQuorumSet
-	Envelope                    *ScpEnvelope
-	GetScpLedgerSeq             *Uint32
+type PeerAddressIp struct {
+	Type IpAddrType
+	Ipv4 *[4]byte  `xdrmaxsize:"4"`
+	Ipv6 *[16]byte `xdrmaxsize:"16"`
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u StellarMessage) SwitchFieldName() string {
+func (u PeerAddressIp) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of StellarMessage
-func (u StellarMessage) ArmForSwitch(sw int32) (string, bool) {
-	switch MessageType(sw) {
-	case MessageTypeErrorMsg:
-		return "Error", true
-	case MessageTypeHello:
-		return "Hello", true
-	case MessageTypeAuth:
-		return "Auth", true
-	case MessageTypeDontHave:
-		return "DontHave", true
-	case MessageTypeGetPeers:
-		return "", true
-	case MessageTypePeers:
-		return "Peers", true
-	case MessageTypeGetTxSet:
-		return "TxSetHash", true
-	case MessageTypeTxSet:
-		return "TxSet", true
-	case MessageTypeTransaction:
-		return "Transaction", true
-	case MessageTypeSurveyRequest:
-		return "SignedSurveyRequestMessage", true
-	case MessageTypeSurveyResponse:
-		return "SignedSurveyResponseMessage", true
-	case MessageTypeGetScpQuorumset:
-		return "QSetHash", true
-	case MessageTypeScpQuorumset:
-		return "QSet", true
-	case MessageTypeScpMessage:
-		return "Envelope", true
-	case MessageTypeGetScpState:
-		return "GetScpLedgerSeq", true
+// the value for an instance of PeerAddressIp
+func (u PeerAddressIp) ArmForSwitch(sw int32) (string, bool) {
+	switch IpAddrType(sw) {
+	case IpAddrTypeIPv4:
+		return "Ipv4", true
+	case IpAddrTypeIPv6:
+		return "Ipv6", true
 	}
 	return "-", false
 }
 
-// NewStellarMessage creates a new  StellarMessage.
-func NewStellarMessage(aType MessageType, value interface{}) (result StellarMessage, err error) {
+// NewPeerAddressIp creates a new  PeerAddressIp.
+func NewPeerAddressIp(aType IpAddrType, value interface{}) (result PeerAddressIp, err error) {
 	result.Type = aType
-	switch MessageType(aType) {
-	case MessageTypeErrorMsg:
-		tv, ok := value.(Error)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Error")
-			return
-		}
-		result.Error = &tv
-	case MessageTypeHello:
-		tv, ok := value.(Hello)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Hello")
-			return
-		}
-		result.Hello = &tv
-	case MessageTypeAuth:
-		tv, ok := value.(Auth)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Auth")
-			return
-		}
-		result.Auth = &tv
-	case MessageTypeDontHave:
-		tv, ok := value.(DontHave)
+	switch IpAddrType(aType) {
+	case IpAddrTypeIPv4:
+		tv, ok := value.([4]byte)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be DontHave")
+			err = fmt.Errorf("invalid value, must be [4]byte")
 			return
 		}
-		result.DontHave = &tv
-	case MessageTypeGetPeers:
-		// void
-	case MessageTypePeers:
-		tv, ok := value.([]PeerAddress)
+		result.Ipv4 = &tv
+	case IpAddrTypeIPv6:
+		tv, ok := value.([16]byte)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be []PeerAddress")
+			err = fmt.Errorf("invalid value, must be [16]byte")
 			return
 		}
-		result.Peers = &tv
-	case MessageTypeGetTxSet:
-		tv, ok := value.(Uint256)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint256")
-			return
-		}
-		result.TxSetHash = &tv
-	case MessageTypeTxSet:
-		tv, ok := value.(TransactionSet)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionSet")
-			return
-		}
-		result.TxSet = &tv
-	case MessageTypeTransaction:
-		tv, ok := value.(TransactionEnvelope)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionEnvelope")
-			return
-		}
-		result.Transaction = &tv
-	case MessageTypeSurveyRequest:
-		tv, ok := value.(SignedSurveyRequestMessage)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be SignedSurveyRequestMessage")
-			return
-		}
-		result.SignedSurveyRequestMessage = &tv
-	case MessageTypeSurveyResponse:
-		tv, ok := value.(SignedSurveyResponseMessage)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be SignedSurveyResponseMessage")
-			return
-		}
-		result.SignedSurveyResponseMessage = &tv
-	case MessageTypeGetScpQuorumset:
-		tv, ok := value.(Uint256)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint256")
-			return
-		}
-		result.QSetHash = &tv
-	case MessageTypeScpQuorumset:
-		tv, ok := value.(ScpQuorumSet)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be ScpQuorumSet")
-			return
-		}
-		result.QSet = &tv
-	case MessageTypeScpMessage:
-		tv, ok := value.(ScpEnvelope)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be ScpEnvelope")
-			return
-		}
-		result.Envelope = &tv
-	case MessageTypeGetScpState:
-		tv, ok := value.(Uint32)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint32")
-			return
-		}
-		result.GetScpLedgerSeq = &tv
+		result.Ipv6 = &tv
 	}
 	return
 }
 
-// MustError retrieves the Error value from the union,
+// MustIpv4 retrieves the Ipv4 value from the union,
 // panicing if the value is not set.
-func (u StellarMessage) MustError() Error {
-	val, ok := u.GetError()
+func (u PeerAddressIp) MustIpv4() [4]byte {
+	val, ok := u.GetIpv4()
 
 	if !ok {
-		panic("arm Error is not set")
+		panic("arm Ipv4 is not set")
 	}
 
 	return val
 }
 
-// GetError retrieves the Error value from the union,
+// GetIpv4 retrieves the Ipv4 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetError() (result Error, ok bool) {
+func (u PeerAddressIp) GetIpv4() (result [4]byte, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Error" {
-		result = *u.Error
+	if armName == "Ipv4" {
+		result = *u.Ipv4
 		ok = true
 	}
 
 	return
 }
 
-// MustHello retrieves the Hello value from the union,
+// MustIpv6 retrieves the Ipv6 value from the union,
 // panicing if the value is not set.
-func (u StellarMessage) MustHello() Hello {
-	val, ok := u.GetHello()
+func (u PeerAddressIp) MustIpv6() [16]byte {
+	val, ok := u.GetIpv6()
 
 	if !ok {
-		panic("arm Hello is not set")
+		panic("arm Ipv6 is not set")
 	}
 
 	return val
 }
 
-// GetHello retrieves the Hello value from the union,
+// GetIpv6 retrieves the Ipv6 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetHello() (result Hello, ok bool) {
+func (u PeerAddressIp) GetIpv6() (result [16]byte, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Hello" {
-		result = *u.Hello
+	if armName == "Ipv6" {
+		result = *u.Ipv6
 		ok = true
 	}
 
 	return
 }
 
-// MustAuth retrieves the Auth value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustAuth() Auth {
-	val, ok := u.GetAuth()
-
-	if !ok {
-		panic("arm Auth is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PeerAddressIp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PeerAddressIp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetAuth retrieves the Auth value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetAuth() (result Auth, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*PeerAddressIp)(nil)
+	_ encoding.BinaryUnmarshaler = (*PeerAddressIp)(nil)
+)
 
-	if armName == "Auth" {
-		result = *u.Auth
-		ok = true
-	}
+// PeerAddress is an XDR Struct defines as:
+//
+//   struct PeerAddress
+//    {
+//        union switch (IPAddrType type)
+//        {
+//        case IPv4:
+//            opaque ipv4[4];
+//        case IPv6:
+//            opaque ipv6[16];
+//        }
+//        ip;
+//        uint32 port;
+//        uint32 numFailures;
+//    };
+//
+type PeerAddress struct {
+	Ip          PeerAddressIp
+	Port        Uint32
+	NumFailures Uint32
+}
 
-	return
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PeerAddress) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// MustDontHave retrieves the DontHave value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustDontHave() DontHave {
-	val, ok := u.GetDontHave()
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PeerAddress) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if !ok {
-		panic("arm DontHave is not set")
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*PeerAddress)(nil)
+	_ encoding.BinaryUnmarshaler = (*PeerAddress)(nil)
+)
 
-	return val
-}
+// MessageType is an XDR Enum defines as:
+//
+//   enum MessageType
+//    {
+//        ERROR_MSG = 0,
+//        AUTH = 2,
+//        DONT_HAVE = 3,
+//
+//        GET_PEERS = 4, // gets a list of peers this guy knows about
+//        PEERS = 5,
+//
+//        GET_TX_SET = 6, // gets a particular txset by hash
+//        TX_SET = 7,
+//
+//        TRANSACTION = 8, // pass on a tx you have heard about
+//
+//        // SCP
+//        GET_SCP_QUORUMSET = 9,
+//        SCP_QUORUMSET = 10,
+//        SCP_MESSAGE = 11,
+//        GET_SCP_STATE = 12,
+//
+//        // new messages
+//        HELLO = 13,
+//
+//        SURVEY_REQUEST = 14,
+//        SURVEY_RESPONSE = 15
+//    };
+//
+type MessageType int32
 
-// GetDontHave retrieves the DontHave value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetDontHave() (result DontHave, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+const (
+	MessageTypeErrorMsg        MessageType = 0
+	MessageTypeAuth            MessageType = 2
+	MessageTypeDontHave        MessageType = 3
+	MessageTypeGetPeers        MessageType = 4
+	MessageTypePeers           MessageType = 5
+	MessageTypeGetTxSet        MessageType = 6
+	MessageTypeTxSet           MessageType = 7
+	MessageTypeTransaction     MessageType = 8
+	MessageTypeGetScpQuorumset MessageType = 9
+	MessageTypeScpQuorumset    MessageType = 10
+	MessageTypeScpMessage      MessageType = 11
+	MessageTypeGetScpState     MessageType = 12
+	MessageTypeHello           MessageType = 13
+	MessageTypeSurveyRequest   MessageType = 14
+	MessageTypeSurveyResponse  MessageType = 15
+)
 
-	if armName == "DontHave" {
-		result = *u.DontHave
-		ok = true
-	}
+var messageTypeMap = map[int32]string{
+	0:  "MessageTypeErrorMsg",
+	2:  "MessageTypeAuth",
+	3:  "MessageTypeDontHave",
+	4:  "MessageTypeGetPeers",
+	5:  "MessageTypePeers",
+	6:  "MessageTypeGetTxSet",
+	7:  "MessageTypeTxSet",
+	8:  "MessageTypeTransaction",
+	9:  "MessageTypeGetScpQuorumset",
+	10: "MessageTypeScpQuorumset",
+	11: "MessageTypeScpMessage",
+	12: "MessageTypeGetScpState",
+	13: "MessageTypeHello",
+	14: "MessageTypeSurveyRequest",
+	15: "MessageTypeSurveyResponse",
+}
 
-	return
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for MessageType
+func (e MessageType) ValidEnum(v int32) bool {
+	_, ok := messageTypeMap[v]
+	return ok
 }
 
-// MustPeers retrieves the Peers value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustPeers() []PeerAddress {
-	val, ok := u.GetPeers()
+// String returns the name of `e`
+func (e MessageType) String() string {
+	name, _ := messageTypeMap[int32(e)]
+	return name
+}
 
-	if !ok {
-		panic("arm Peers is not set")
-	}
-
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s MessageType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetPeers retrieves the Peers value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetPeers() (result []PeerAddress, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "Peers" {
-		result = *u.Peers
-		ok = true
-	}
-
-	return
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *MessageType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// MustTxSetHash retrieves the TxSetHash value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustTxSetHash() Uint256 {
-	val, ok := u.GetTxSetHash()
+var (
+	_ encoding.BinaryMarshaler   = (*MessageType)(nil)
+	_ encoding.BinaryUnmarshaler = (*MessageType)(nil)
+)
 
-	if !ok {
-		panic("arm TxSetHash is not set")
-	}
+// DontHave is an XDR Struct defines as:
+//
+//   struct DontHave
+//    {
+//        MessageType type;
+//        uint256 reqHash;
+//    };
+//
+type DontHave struct {
+	Type    MessageType
+	ReqHash Uint256
+}
 
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s DontHave) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetTxSetHash retrieves the TxSetHash value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetTxSetHash() (result Uint256, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *DontHave) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if armName == "TxSetHash" {
-		result = *u.TxSetHash
-		ok = true
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*DontHave)(nil)
+	_ encoding.BinaryUnmarshaler = (*DontHave)(nil)
+)
 
-	return
-}
+// SurveyMessageCommandType is an XDR Enum defines as:
+//
+//   enum SurveyMessageCommandType
+//    {
+//        SURVEY_TOPOLOGY = 0
+//    };
+//
+type SurveyMessageCommandType int32
 
-// MustTxSet retrieves the TxSet value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustTxSet() TransactionSet {
-	val, ok := u.GetTxSet()
+const (
+	SurveyMessageCommandTypeSurveyTopology SurveyMessageCommandType = 0
+)
 
-	if !ok {
-		panic("arm TxSet is not set")
-	}
+var surveyMessageCommandTypeMap = map[int32]string{
+	0: "SurveyMessageCommandTypeSurveyTopology",
+}
 
-	return val
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for SurveyMessageCommandType
+func (e SurveyMessageCommandType) ValidEnum(v int32) bool {
+	_, ok := surveyMessageCommandTypeMap[v]
+	return ok
 }
 
-// GetTxSet retrieves the TxSet value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetTxSet() (result TransactionSet, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// String returns the name of `e`
+func (e SurveyMessageCommandType) String() string {
+	name, _ := surveyMessageCommandTypeMap[int32(e)]
+	return name
+}
 
-	if armName == "TxSet" {
-		result = *u.TxSet
-		ok = true
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SurveyMessageCommandType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SurveyMessageCommandType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// MustTransaction retrieves the Transaction value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustTransaction() TransactionEnvelope {
-	val, ok := u.GetTransaction()
+var (
+	_ encoding.BinaryMarshaler   = (*SurveyMessageCommandType)(nil)
+	_ encoding.BinaryUnmarshaler = (*SurveyMessageCommandType)(nil)
+)
 
-	if !ok {
-		panic("arm Transaction is not set")
-	}
+// SurveyRequestMessage is an XDR Struct defines as:
+//
+//   struct SurveyRequestMessage
+//    {
+//        NodeID surveyorPeerID;
+//        NodeID surveyedPeerID;
+//        uint32 ledgerNum;
+//        Curve25519Public encryptionKey;
+//        SurveyMessageCommandType commandType;
+//    };
+//
+type SurveyRequestMessage struct {
+	SurveyorPeerId NodeId
+	SurveyedPeerId NodeId
+	LedgerNum      Uint32
+	EncryptionKey  Curve25519Public
+	CommandType    SurveyMessageCommandType
+}
 
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SurveyRequestMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetTransaction retrieves the Transaction value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetTransaction() (result TransactionEnvelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SurveyRequestMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if armName == "Transaction" {
-		result = *u.Transaction
-		ok = true
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*SurveyRequestMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*SurveyRequestMessage)(nil)
+)
 
-	return
+// SignedSurveyRequestMessage is an XDR Struct defines as:
+//
+//   struct SignedSurveyRequestMessage
+//    {
+//        Signature requestSignature;
+//        SurveyRequestMessage request;
+//    };
+//
+type SignedSurveyRequestMessage struct {
+	RequestSignature Signature
+	Request          SurveyRequestMessage
 }
 
-// MustSignedSurveyRequestMessage retrieves the SignedSurveyRequestMessage value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustSignedSurveyRequestMessage() SignedSurveyRequestMessage {
-	val, ok := u.GetSignedSurveyRequestMessage()
-
-	if !ok {
-		panic("arm SignedSurveyRequestMessage is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SignedSurveyRequestMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SignedSurveyRequestMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetSignedSurveyRequestMessage retrieves the SignedSurveyRequestMessage value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetSignedSurveyRequestMessage() (result SignedSurveyRequestMessage, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*SignedSurveyRequestMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*SignedSurveyRequestMessage)(nil)
+)
 
-	if armName == "SignedSurveyRequestMessage" {
-		result = *u.SignedSurveyRequestMessage
-		ok = true
-	}
+// EncryptedBody is an XDR Typedef defines as:
+//
+//   typedef opaque EncryptedBody<64000>;
+//
+type EncryptedBody []byte
 
-	return
+// XDRMaxSize implements the Sized interface for EncryptedBody
+func (e EncryptedBody) XDRMaxSize() int {
+	return 64000
 }
 
-// MustSignedSurveyResponseMessage retrieves the SignedSurveyResponseMessage value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustSignedSurveyResponseMessage() SignedSurveyResponseMessage {
-	val, ok := u.GetSignedSurveyResponseMessage()
-
-	if !ok {
-		panic("arm SignedSurveyResponseMessage is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s EncryptedBody) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *EncryptedBody) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetSignedSurveyResponseMessage retrieves the SignedSurveyResponseMessage value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetSignedSurveyResponseMessage() (result SignedSurveyResponseMessage, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*EncryptedBody)(nil)
+	_ encoding.BinaryUnmarshaler = (*EncryptedBody)(nil)
+)
 
-	if armName == "SignedSurveyResponseMessage" {
-		result = *u.SignedSurveyResponseMessage
-		ok = true
-	}
-
-	return
+// SurveyResponseMessage is an XDR Struct defines as:
+//
+//   struct SurveyResponseMessage
+//    {
+//        NodeID surveyorPeerID;
+//        NodeID surveyedPeerID;
+//        uint32 ledgerNum;
+//        SurveyMessageCommandType commandType;
+//        EncryptedBody encryptedBody;
+//    };
+//
+type SurveyResponseMessage struct {
+	SurveyorPeerId NodeId
+	SurveyedPeerId NodeId
+	LedgerNum      Uint32
+	CommandType    SurveyMessageCommandType
+	EncryptedBody  EncryptedBody
 }
 
-// MustQSetHash retrieves the QSetHash value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustQSetHash() Uint256 {
-	val, ok := u.GetQSetHash()
-
-	if !ok {
-		panic("arm QSetHash is not set")
-	}
-
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SurveyResponseMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetQSetHash retrieves the QSetHash value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetQSetHash() (result Uint256, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "QSetHash" {
-		result = *u.QSetHash
-		ok = true
-	}
-
-	return
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SurveyResponseMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// MustQSet retrieves the QSet value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustQSet() ScpQuorumSet {
-	val, ok := u.GetQSet()
-
-	if !ok {
-		panic("arm QSet is not set")
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*SurveyResponseMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*SurveyResponseMessage)(nil)
+)
 
-	return val
+// SignedSurveyResponseMessage is an XDR Struct defines as:
+//
+//   struct SignedSurveyResponseMessage
+//    {
+//        Signature responseSignature;
+//        SurveyResponseMessage response;
+//    };
+//
+type SignedSurveyResponseMessage struct {
+	ResponseSignature Signature
+	Response          SurveyResponseMessage
 }
 
-// GetQSet retrieves the QSet value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetQSet() (result ScpQuorumSet, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "QSet" {
-		result = *u.QSet
-		ok = true
-	}
-
-	return
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SignedSurveyResponseMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// MustEnvelope retrieves the Envelope value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustEnvelope() ScpEnvelope {
-	val, ok := u.GetEnvelope()
-
-	if !ok {
-		panic("arm Envelope is not set")
-	}
-
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SignedSurveyResponseMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetEnvelope retrieves the Envelope value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetEnvelope() (result ScpEnvelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "Envelope" {
-		result = *u.Envelope
-		ok = true
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*SignedSurveyResponseMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*SignedSurveyResponseMessage)(nil)
+)
 
-	return
+// PeerStats is an XDR Struct defines as:
+//
+//   struct PeerStats
+//    {
+//        NodeID id;
+//        string versionStr<100>;
+//        uint64 messagesRead;
+//        uint64 messagesWritten;
+//        uint64 bytesRead;
+//        uint64 bytesWritten;
+//        uint64 secondsConnected;
+//
+//        uint64 uniqueFloodBytesRecv;
+//        uint64 duplicateFloodBytesRecv;
+//        uint64 uniqueFetchBytesRecv;
+//        uint64 duplicateFetchBytesRecv;
+//
+//        uint64 uniqueFloodMessageRecv;
+//        uint64 duplicateFloodMessageRecv;
+//        uint64 uniqueFetchMessageRecv;
+//        uint64 duplicateFetchMessageRecv;
+//    };
+//
+type PeerStats struct {
+	Id                        NodeId
+	VersionStr                string `xdrmaxsize:"100"`
+	MessagesRead              Uint64
+	MessagesWritten           Uint64
+	BytesRead                 Uint64
+	BytesWritten              Uint64
+	SecondsConnected          Uint64
+	UniqueFloodBytesRecv      Uint64
+	DuplicateFloodBytesRecv   Uint64
+	UniqueFetchBytesRecv      Uint64
+	DuplicateFetchBytesRecv   Uint64
+	UniqueFloodMessageRecv    Uint64
+	DuplicateFloodMessageRecv Uint64
+	UniqueFetchMessageRecv    Uint64
+	DuplicateFetchMessageRecv Uint64
 }
 
-// MustGetScpLedgerSeq retrieves the GetScpLedgerSeq value from the union,
-// panicing if the value is not set.
-func (u StellarMessage) MustGetScpLedgerSeq() Uint32 {
-	val, ok := u.GetGetScpLedgerSeq()
-
-	if !ok {
-		panic("arm GetScpLedgerSeq is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PeerStats) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PeerStats) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetGetScpLedgerSeq retrieves the GetScpLedgerSeq value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u StellarMessage) GetGetScpLedgerSeq() (result Uint32, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*PeerStats)(nil)
+	_ encoding.BinaryUnmarshaler = (*PeerStats)(nil)
+)
 
-	if armName == "GetScpLedgerSeq" {
-		result = *u.GetScpLedgerSeq
-		ok = true
-	}
+// PeerStatList is an XDR Typedef defines as:
+//
+//   typedef PeerStats PeerStatList<25>;
+//
+type PeerStatList []PeerStats
 
-	return
+// XDRMaxSize implements the Sized interface for PeerStatList
+func (e PeerStatList) XDRMaxSize() int {
+	return 25
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s StellarMessage) MarshalBinary() ([]byte, error) {
+func (s PeerStatList) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *StellarMessage) UnmarshalBinary(inp []byte) error {
+func (s *PeerStatList) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*StellarMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*StellarMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*PeerStatList)(nil)
+	_ encoding.BinaryUnmarshaler = (*PeerStatList)(nil)
 )
 
-// AuthenticatedMessageV0 is an XDR NestedStruct defines as:
+// TopologyResponseBody is an XDR Struct defines as:
 //
-//   struct
-//        {
-//            uint64 sequence;
-//            StellarMessage message;
-//            HmacSha256Mac mac;
-//        }
+//   struct TopologyResponseBody
+//    {
+//        PeerStatList inboundPeers;
+//        PeerStatList outboundPeers;
 //
-type AuthenticatedMessageV0 struct {
-	Sequence Uint64
-	Message  StellarMessage
-	Mac      HmacSha256Mac
+//        uint32 totalInboundPeerCount;
+//        uint32 totalOutboundPeerCount;
+//    };
+//
+type TopologyResponseBody struct {
+	InboundPeers           PeerStatList
+	OutboundPeers          PeerStatList
+	TotalInboundPeerCount  Uint32
+	TotalOutboundPeerCount Uint32
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AuthenticatedMessageV0) MarshalBinary() ([]byte, error) {
+func (s TopologyResponseBody) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AuthenticatedMessageV0) UnmarshalBinary(inp []byte) error {
+func (s *TopologyResponseBody) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AuthenticatedMessageV0)(nil)
-	_ encoding.BinaryUnmarshaler = (*AuthenticatedMessageV0)(nil)
+	_ encoding.BinaryMarshaler   = (*TopologyResponseBody)(nil)
+	_ encoding.BinaryUnmarshaler = (*TopologyResponseBody)(nil)
 )
 
-// AuthenticatedMessage is an XDR Union defines as:
+// SurveyResponseBody is an XDR Union defines as:
 //
-//   union AuthenticatedMessage switch (uint32 v)
+//   union SurveyResponseBody switch (SurveyMessageCommandType type)
 //    {
-//    case 0:
-//        struct
-//        {
-//            uint64 sequence;
-//            StellarMessage message;
-//            HmacSha256Mac mac;
-//        } v0;
+//    case SURVEY_TOPOLOGY:
+//        TopologyResponseBody topologyResponseBody;
 //    };
 //
-type AuthenticatedMessage struct {
-	V  Uint32
-	V0 *AuthenticatedMessageV0
+type SurveyResponseBody struct {
+	Type                 SurveyMessageCommandType
+	TopologyResponseBody *TopologyResponseBody
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u AuthenticatedMessage) SwitchFieldName() string {
-	return "V"
+func (u SurveyResponseBody) SwitchFieldName() string {
+	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of AuthenticatedMessage
-func (u AuthenticatedMessage) ArmForSwitch(sw int32) (string, bool) {
-	switch Uint32(sw) {
-	case 0:
-		return "V0", true
+// the value for an instance of SurveyResponseBody
+func (u SurveyResponseBody) ArmForSwitch(sw int32) (string, bool) {
+	switch SurveyMessageCommandType(sw) {
+	case SurveyMessageCommandTypeSurveyTopology:
+		return "TopologyResponseBody", true
 	}
 	return "-", false
 }
 
-// NewAuthenticatedMessage creates a new  AuthenticatedMessage.
-func NewAuthenticatedMessage(v Uint32, value interface{}) (result AuthenticatedMessage, err error) {
-	result.V = v
-	switch Uint32(v) {
-	case 0:
-		tv, ok := value.(AuthenticatedMessageV0)
+// NewSurveyResponseBody creates a new  SurveyResponseBody.
+func NewSurveyResponseBody(aType SurveyMessageCommandType, value interface{}) (result SurveyResponseBody, err error) {
+	result.Type = aType
+	switch SurveyMessageCommandType(aType) {
+	case SurveyMessageCommandTypeSurveyTopology:
+		tv, ok := value.(TopologyResponseBody)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be AuthenticatedMessageV0")
+			err = fmt.Errorf("invalid value, must be TopologyResponseBody")
 			return
 		}
-		result.V0 = &tv
+		result.TopologyResponseBody = &tv
 	}
 	return
 }
 
-// MustV0 retrieves the V0 value from the union,
+// MustTopologyResponseBody retrieves the TopologyResponseBody value from the union,
 // panicing if the value is not set.
-func (u AuthenticatedMessage) MustV0() AuthenticatedMessageV0 {
-	val, ok := u.GetV0()
+func (u SurveyResponseBody) MustTopologyResponseBody() TopologyResponseBody {
+	val, ok := u.GetTopologyResponseBody()
 
 	if !ok {
-		panic("arm V0 is not set")
+		panic("arm TopologyResponseBody is not set")
 	}
 
 	return val
 }
 
-// GetV0 retrieves the V0 value from the union,
+// GetTopologyResponseBody retrieves the TopologyResponseBody value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u AuthenticatedMessage) GetV0() (result AuthenticatedMessageV0, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.V))
+func (u SurveyResponseBody) GetTopologyResponseBody() (result TopologyResponseBody, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "V0" {
-		result = *u.V0
+	if armName == "TopologyResponseBody" {
+		result = *u.TopologyResponseBody
 		ok = true
 	}
 
@@ -6888,753 +7275,2571 @@ func (u AuthenticatedMessage) GetV0() (result AuthenticatedMessageV0, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AuthenticatedMessage) MarshalBinary() ([]byte, error) {
+func (s SurveyResponseBody) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AuthenticatedMessage) UnmarshalBinary(inp []byte) error {
+func (s *SurveyResponseBody) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AuthenticatedMessage)(nil)
-	_ encoding.BinaryUnmarshaler = (*AuthenticatedMessage)(nil)
+	_ encoding.BinaryMarshaler   = (*SurveyResponseBody)(nil)
+	_ encoding.BinaryUnmarshaler = (*SurveyResponseBody)(nil)
 )
 
-// MuxedAccountMed25519 is an XDR NestedStruct defines as:
+// StellarMessage is an XDR Union defines as:
 //
-//   struct
-//        {
-//            uint64 id;
-//            uint256 ed25519;
-//        }
+//   union StellarMessage switch (MessageType type)
+//    {
+//    case ERROR_MSG:
+//        Error error;
+//    case HELLO:
+//        Hello hello;
+//    case AUTH:
+//        Auth auth;
+//    case DONT_HAVE:
+//        DontHave dontHave;
+//    case GET_PEERS:
+//        void;
+//    case PEERS:
+//        PeerAddress peers<100>;
 //
-type MuxedAccountMed25519 struct {
-	Id      Uint64
-	Ed25519 Uint256
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s MuxedAccountMed25519) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *MuxedAccountMed25519) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*MuxedAccountMed25519)(nil)
-	_ encoding.BinaryUnmarshaler = (*MuxedAccountMed25519)(nil)
-)
-
-// MuxedAccount is an XDR Union defines as:
+//    case GET_TX_SET:
+//        uint256 txSetHash;
+//    case TX_SET:
+//        TransactionSet txSet;
 //
-//   union MuxedAccount switch (CryptoKeyType type)
-//    {
-//    case KEY_TYPE_ED25519:
-//        uint256 ed25519;
-//    case KEY_TYPE_MUXED_ED25519:
-//        struct
-//        {
-//            uint64 id;
-//            uint256 ed25519;
-//        } med25519;
+//    case TRANSACTION:
+//        TransactionEnvelope transaction;
+//
+//    case SURVEY_REQUEST:
+//        SignedSurveyRequestMessage signedSurveyRequestMessage;
+//
+//    case SURVEY_RESPONSE:
+//        SignedSurveyResponseMessage signedSurveyResponseMessage;
+//
+//    // SCP
+//    case GET_SCP_QUORUMSET:
+//        uint256 qSetHash;
+//    case SCP_QUORUMSET:
+//        SCPQuorumSet qSet;
+//    case SCP_MESSAGE:
+//        SCPEnvelope envelope;
+//    case GET_SCP_STATE:
+//        uint32 getSCPLedgerSeq; // ledger seq requested ; if 0, requests the latest
 //    };
 //
-type MuxedAccount struct {
-	Type     CryptoKeyType
-	Ed25519  *Uint256
-	Med25519 *MuxedAccountMed25519
+type StellarMessage struct {
+	Type                        MessageType
+	Error                       *Error
+	Hello                       *Hello
+	Auth                        *Auth
+	DontHave                    *DontHave
+	Peers                       *[]PeerAddress `xdrmaxsize:"100"`
+	TxSetHash                   *Uint256
+	TxSet                       *TransactionSet
+	Transaction                 *TransactionEnvelope
+	SignedSurveyRequestMessage  *SignedSurveyRequestMessage
+	SignedSurveyResponseMessage *SignedSurveyResponseMessage
+	QSetHash                    *Uint256
+	QSet                        *ScpQuorumSet
+	Envelope                    *ScpEnvelope
+	GetScpLedgerSeq             *Uint32
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u MuxedAccount) SwitchFieldName() string {
+func (u StellarMessage) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of MuxedAccount
-func (u MuxedAccount) ArmForSwitch(sw int32) (string, bool) {
-	switch CryptoKeyType(sw) {
-	case CryptoKeyTypeKeyTypeEd25519:
-		return "Ed25519", true
-	case CryptoKeyTypeKeyTypeMuxedEd25519:
-		return "Med25519", true
+// the value for an instance of StellarMessage
+func (u StellarMessage) ArmForSwitch(sw int32) (string, bool) {
+	switch MessageType(sw) {
+	case MessageTypeErrorMsg:
+		return "Error", true
+	case MessageTypeHello:
+		return "Hello", true
+	case MessageTypeAuth:
+		return "Auth", true
+	case MessageTypeDontHave:
+		return "DontHave", true
+	case MessageTypeGetPeers:
+		return "", true
+	case MessageTypePeers:
+		return "Peers", true
+	case MessageTypeGetTxSet:
+		return "TxSetHash", true
+	case MessageTypeTxSet:
+		return "TxSet", true
+	case MessageTypeTransaction:
+		return "Transaction", true
+	case MessageTypeSurveyRequest:
+		return "SignedSurveyRequestMessage", true
+	case MessageTypeSurveyResponse:
+		return "SignedSurveyResponseMessage", true
+	case MessageTypeGetScpQuorumset:
+		return "QSetHash", true
+	case MessageTypeScpQuorumset:
+		return "QSet", true
+	case MessageTypeScpMessage:
+		return "Envelope", true
+	case MessageTypeGetScpState:
+		return "GetScpLedgerSeq", true
 	}
 	return "-", false
 }
 
-// NewMuxedAccount creates a new  MuxedAccount.
-func NewMuxedAccount(aType CryptoKeyType, value interface{}) (result MuxedAccount, err error) {
+// NewStellarMessage creates a new  StellarMessage.
+func NewStellarMessage(aType MessageType, value interface{}) (result StellarMessage, err error) {
 	result.Type = aType
-	switch CryptoKeyType(aType) {
-	case CryptoKeyTypeKeyTypeEd25519:
+	switch MessageType(aType) {
+	case MessageTypeErrorMsg:
+		tv, ok := value.(Error)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Error")
+			return
+		}
+		result.Error = &tv
+	case MessageTypeHello:
+		tv, ok := value.(Hello)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Hello")
+			return
+		}
+		result.Hello = &tv
+	case MessageTypeAuth:
+		tv, ok := value.(Auth)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Auth")
+			return
+		}
+		result.Auth = &tv
+	case MessageTypeDontHave:
+		tv, ok := value.(DontHave)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be DontHave")
+			return
+		}
+		result.DontHave = &tv
+	case MessageTypeGetPeers:
+		// void
+	case MessageTypePeers:
+		tv, ok := value.([]PeerAddress)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be []PeerAddress")
+			return
+		}
+		result.Peers = &tv
+	case MessageTypeGetTxSet:
 		tv, ok := value.(Uint256)
 		if !ok {
 			err = fmt.Errorf("invalid value, must be Uint256")
 			return
 		}
-		result.Ed25519 = &tv
-	case CryptoKeyTypeKeyTypeMuxedEd25519:
-		tv, ok := value.(MuxedAccountMed25519)
+		result.TxSetHash = &tv
+	case MessageTypeTxSet:
+		tv, ok := value.(TransactionSet)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be MuxedAccountMed25519")
+			err = fmt.Errorf("invalid value, must be TransactionSet")
 			return
 		}
-		result.Med25519 = &tv
-	}
-	return
-}
-
-// MustEd25519 retrieves the Ed25519 value from the union,
+		result.TxSet = &tv
+	case MessageTypeTransaction:
+		tv, ok := value.(TransactionEnvelope)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be TransactionEnvelope")
+			return
+		}
+		result.Transaction = &tv
+	case MessageTypeSurveyRequest:
+		tv, ok := value.(SignedSurveyRequestMessage)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be SignedSurveyRequestMessage")
+			return
+		}
+		result.SignedSurveyRequestMessage = &tv
+	case MessageTypeSurveyResponse:
+		tv, ok := value.(SignedSurveyResponseMessage)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be SignedSurveyResponseMessage")
+			return
+		}
+		result.SignedSurveyResponseMessage = &tv
+	case MessageTypeGetScpQuorumset:
+		tv, ok := value.(Uint256)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint256")
+			return
+		}
+		result.QSetHash = &tv
+	case MessageTypeScpQuorumset:
+		tv, ok := value.(ScpQuorumSet)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ScpQuorumSet")
+			return
+		}
+		result.QSet = &tv
+	case MessageTypeScpMessage:
+		tv, ok := value.(ScpEnvelope)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ScpEnvelope")
+			return
+		}
+		result.Envelope = &tv
+	case MessageTypeGetScpState:
+		tv, ok := value.(Uint32)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint32")
+			return
+		}
+		result.GetScpLedgerSeq = &tv
+	}
+	return
+}
+
+// MustError retrieves the Error value from the union,
 // panicing if the value is not set.
-func (u MuxedAccount) MustEd25519() Uint256 {
-	val, ok := u.GetEd25519()
+func (u StellarMessage) MustError() Error {
+	val, ok := u.GetError()
 
 	if !ok {
-		panic("arm Ed25519 is not set")
+		panic("arm Error is not set")
 	}
 
 	return val
 }
 
-// GetEd25519 retrieves the Ed25519 value from the union,
+// GetError retrieves the Error value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u MuxedAccount) GetEd25519() (result Uint256, ok bool) {
+func (u StellarMessage) GetError() (result Error, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Ed25519" {
-		result = *u.Ed25519
+	if armName == "Error" {
+		result = *u.Error
 		ok = true
 	}
 
 	return
 }
 
-// MustMed25519 retrieves the Med25519 value from the union,
+// MustHello retrieves the Hello value from the union,
 // panicing if the value is not set.
-func (u MuxedAccount) MustMed25519() MuxedAccountMed25519 {
-	val, ok := u.GetMed25519()
+func (u StellarMessage) MustHello() Hello {
+	val, ok := u.GetHello()
 
 	if !ok {
-		panic("arm Med25519 is not set")
+		panic("arm Hello is not set")
 	}
 
 	return val
 }
 
-// GetMed25519 retrieves the Med25519 value from the union,
+// GetHello retrieves the Hello value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u MuxedAccount) GetMed25519() (result MuxedAccountMed25519, ok bool) {
+func (u StellarMessage) GetHello() (result Hello, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Med25519" {
-		result = *u.Med25519
+	if armName == "Hello" {
+		result = *u.Hello
 		ok = true
 	}
 
 	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s MuxedAccount) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustAuth retrieves the Auth value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustAuth() Auth {
+	val, ok := u.GetAuth()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *MuxedAccount) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm Auth is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*MuxedAccount)(nil)
-	_ encoding.BinaryUnmarshaler = (*MuxedAccount)(nil)
-)
+// GetAuth retrieves the Auth value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetAuth() (result Auth, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// DecoratedSignature is an XDR Struct defines as:
-//
-//   struct DecoratedSignature
-//    {
-//        SignatureHint hint;  // last 4 bytes of the public key, used as a hint
-//        Signature signature; // actual signature
-//    };
-//
-type DecoratedSignature struct {
-	Hint      SignatureHint
-	Signature Signature
-}
+	if armName == "Auth" {
+		result = *u.Auth
+		ok = true
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s DecoratedSignature) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *DecoratedSignature) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// MustDontHave retrieves the DontHave value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustDontHave() DontHave {
+	val, ok := u.GetDontHave()
 
-var (
-	_ encoding.BinaryMarshaler   = (*DecoratedSignature)(nil)
-	_ encoding.BinaryUnmarshaler = (*DecoratedSignature)(nil)
-)
+	if !ok {
+		panic("arm DontHave is not set")
+	}
 
-// OperationType is an XDR Enum defines as:
-//
-//   enum OperationType
-//    {
-//        CREATE_ACCOUNT = 0,
-//        PAYMENT = 1,
-//        PATH_PAYMENT_STRICT_RECEIVE = 2,
-//        MANAGE_SELL_OFFER = 3,
-//        CREATE_PASSIVE_SELL_OFFER = 4,
-//        SET_OPTIONS = 5,
-//        CHANGE_TRUST = 6,
-//        ALLOW_TRUST = 7,
-//        ACCOUNT_MERGE = 8,
-//        INFLATION = 9,
-//        MANAGE_DATA = 10,
-//        BUMP_SEQUENCE = 11,
-//        MANAGE_BUY_OFFER = 12,
-//        PATH_PAYMENT_STRICT_SEND = 13
-//    };
-//
-type OperationType int32
+	return val
+}
 
-const (
-	OperationTypeCreateAccount            OperationType = 0
-	OperationTypePayment                  OperationType = 1
-	OperationTypePathPaymentStrictReceive OperationType = 2
-	OperationTypeManageSellOffer          OperationType = 3
-	OperationTypeCreatePassiveSellOffer   OperationType = 4
-	OperationTypeSetOptions               OperationType = 5
-	OperationTypeChangeTrust              OperationType = 6
-	OperationTypeAllowTrust               OperationType = 7
-	OperationTypeAccountMerge             OperationType = 8
-	OperationTypeInflation                OperationType = 9
-	OperationTypeManageData               OperationType = 10
-	OperationTypeBumpSequence             OperationType = 11
-	OperationTypeManageBuyOffer           OperationType = 12
-	OperationTypePathPaymentStrictSend    OperationType = 13
-)
+// GetDontHave retrieves the DontHave value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetDontHave() (result DontHave, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-var operationTypeMap = map[int32]string{
-	0:  "OperationTypeCreateAccount",
-	1:  "OperationTypePayment",
-	2:  "OperationTypePathPaymentStrictReceive",
-	3:  "OperationTypeManageSellOffer",
-	4:  "OperationTypeCreatePassiveSellOffer",
-	5:  "OperationTypeSetOptions",
-	6:  "OperationTypeChangeTrust",
-	7:  "OperationTypeAllowTrust",
-	8:  "OperationTypeAccountMerge",
-	9:  "OperationTypeInflation",
-	10: "OperationTypeManageData",
-	11: "OperationTypeBumpSequence",
-	12: "OperationTypeManageBuyOffer",
-	13: "OperationTypePathPaymentStrictSend",
-}
+	if armName == "DontHave" {
+		result = *u.DontHave
+		ok = true
+	}
 
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for OperationType
-func (e OperationType) ValidEnum(v int32) bool {
-	_, ok := operationTypeMap[v]
-	return ok
+	return
 }
 
-// String returns the name of `e`
-func (e OperationType) String() string {
-	name, _ := operationTypeMap[int32(e)]
-	return name
-}
+// MustPeers retrieves the Peers value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustPeers() []PeerAddress {
+	val, ok := u.GetPeers()
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s OperationType) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+	if !ok {
+		panic("arm Peers is not set")
+	}
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *OperationType) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*OperationType)(nil)
-	_ encoding.BinaryUnmarshaler = (*OperationType)(nil)
-)
+// GetPeers retrieves the Peers value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetPeers() (result []PeerAddress, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// CreateAccountOp is an XDR Struct defines as:
-//
-//   struct CreateAccountOp
-//    {
-//        AccountID destination; // account to create
-//        int64 startingBalance; // amount they end up with
-//    };
-//
-type CreateAccountOp struct {
-	Destination     AccountId
-	StartingBalance Int64
+	if armName == "Peers" {
+		result = *u.Peers
+		ok = true
+	}
+
+	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s CreateAccountOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustTxSetHash retrieves the TxSetHash value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustTxSetHash() Uint256 {
+	val, ok := u.GetTxSetHash()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *CreateAccountOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm TxSetHash is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*CreateAccountOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*CreateAccountOp)(nil)
-)
+// GetTxSetHash retrieves the TxSetHash value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetTxSetHash() (result Uint256, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// PaymentOp is an XDR Struct defines as:
-//
-//   struct PaymentOp
-//    {
-//        MuxedAccount destination; // recipient of the payment
-//        Asset asset;              // what they end up with
-//        int64 amount;             // amount they end up with
-//    };
-//
-type PaymentOp struct {
-	Destination MuxedAccount
-	Asset       Asset
-	Amount      Int64
-}
+	if armName == "TxSetHash" {
+		result = *u.TxSetHash
+		ok = true
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PaymentOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PaymentOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// MustTxSet retrieves the TxSet value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustTxSet() TransactionSet {
+	val, ok := u.GetTxSet()
 
-var (
-	_ encoding.BinaryMarshaler   = (*PaymentOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*PaymentOp)(nil)
-)
+	if !ok {
+		panic("arm TxSet is not set")
+	}
 
-// PathPaymentStrictReceiveOp is an XDR Struct defines as:
-//
-//   struct PathPaymentStrictReceiveOp
-//    {
-//        Asset sendAsset; // asset we pay with
-//        int64 sendMax;   // the maximum amount of sendAsset to
-//                         // send (excluding fees).
-//                         // The operation will fail if can't be met
-//
-//        MuxedAccount destination; // recipient of the payment
-//        Asset destAsset;          // what they end up with
-//        int64 destAmount;         // amount they end up with
-//
-//        Asset path<5>; // additional hops it must go through to get there
-//    };
-//
-type PathPaymentStrictReceiveOp struct {
-	SendAsset   Asset
-	SendMax     Int64
-	Destination MuxedAccount
-	DestAsset   Asset
-	DestAmount  Int64
-	Path        []Asset `xdrmaxsize:"5"`
+	return val
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictReceiveOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// GetTxSet retrieves the TxSet value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetTxSet() (result TransactionSet, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictReceiveOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if armName == "TxSet" {
+		result = *u.TxSet
+		ok = true
+	}
+
+	return
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveOp)(nil)
-)
+// MustTransaction retrieves the Transaction value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustTransaction() TransactionEnvelope {
+	val, ok := u.GetTransaction()
 
-// PathPaymentStrictSendOp is an XDR Struct defines as:
-//
-//   struct PathPaymentStrictSendOp
-//    {
-//        Asset sendAsset;  // asset we pay with
-//        int64 sendAmount; // amount of sendAsset to send (excluding fees)
-//
-//        MuxedAccount destination; // recipient of the payment
-//        Asset destAsset;          // what they end up with
-//        int64 destMin;            // the minimum amount of dest asset to
-//                                  // be received
-//                                  // The operation will fail if it can't be met
-//
-//        Asset path<5>; // additional hops it must go through to get there
-//    };
-//
-type PathPaymentStrictSendOp struct {
-	SendAsset   Asset
-	SendAmount  Int64
-	Destination MuxedAccount
-	DestAsset   Asset
-	DestMin     Int64
-	Path        []Asset `xdrmaxsize:"5"`
-}
+	if !ok {
+		panic("arm Transaction is not set")
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictSendOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return val
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictSendOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// GetTransaction retrieves the Transaction value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetTransaction() (result TransactionEnvelope, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendOp)(nil)
-)
+	if armName == "Transaction" {
+		result = *u.Transaction
+		ok = true
+	}
 
-// ManageSellOfferOp is an XDR Struct defines as:
-//
-//   struct ManageSellOfferOp
-//    {
-//        Asset selling;
-//        Asset buying;
-//        int64 amount; // amount being sold. if set to 0, delete the offer
-//        Price price;  // price of thing being sold in terms of what you are buying
-//
-//        // 0=create a new offer, otherwise edit an existing offer
-//        int64 offerID;
-//    };
-//
-type ManageSellOfferOp struct {
-	Selling Asset
-	Buying  Asset
-	Amount  Int64
-	Price   Price
-	OfferId Int64
+	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageSellOfferOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustSignedSurveyRequestMessage retrieves the SignedSurveyRequestMessage value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustSignedSurveyRequestMessage() SignedSurveyRequestMessage {
+	val, ok := u.GetSignedSurveyRequestMessage()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageSellOfferOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm SignedSurveyRequestMessage is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*ManageSellOfferOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageSellOfferOp)(nil)
-)
+// GetSignedSurveyRequestMessage retrieves the SignedSurveyRequestMessage value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetSignedSurveyRequestMessage() (result SignedSurveyRequestMessage, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// ManageBuyOfferOp is an XDR Struct defines as:
-//
-//   struct ManageBuyOfferOp
-//    {
-//        Asset selling;
-//        Asset buying;
-//        int64 buyAmount; // amount being bought. if set to 0, delete the offer
-//        Price price;     // price of thing being bought in terms of what you are
-//                         // selling
-//
-//        // 0=create a new offer, otherwise edit an existing offer
-//        int64 offerID;
-//    };
-//
-type ManageBuyOfferOp struct {
-	Selling   Asset
-	Buying    Asset
-	BuyAmount Int64
-	Price     Price
-	OfferId   Int64
-}
+	if armName == "SignedSurveyRequestMessage" {
+		result = *u.SignedSurveyRequestMessage
+		ok = true
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageBuyOfferOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageBuyOfferOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// MustSignedSurveyResponseMessage retrieves the SignedSurveyResponseMessage value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustSignedSurveyResponseMessage() SignedSurveyResponseMessage {
+	val, ok := u.GetSignedSurveyResponseMessage()
 
-var (
-	_ encoding.BinaryMarshaler   = (*ManageBuyOfferOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferOp)(nil)
+	if !ok {
+		panic("arm SignedSurveyResponseMessage is not set")
+	}
+
+	return val
+}
+
+// GetSignedSurveyResponseMessage retrieves the SignedSurveyResponseMessage value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetSignedSurveyResponseMessage() (result SignedSurveyResponseMessage, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "SignedSurveyResponseMessage" {
+		result = *u.SignedSurveyResponseMessage
+		ok = true
+	}
+
+	return
+}
+
+// MustQSetHash retrieves the QSetHash value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustQSetHash() Uint256 {
+	val, ok := u.GetQSetHash()
+
+	if !ok {
+		panic("arm QSetHash is not set")
+	}
+
+	return val
+}
+
+// GetQSetHash retrieves the QSetHash value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetQSetHash() (result Uint256, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "QSetHash" {
+		result = *u.QSetHash
+		ok = true
+	}
+
+	return
+}
+
+// MustQSet retrieves the QSet value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustQSet() ScpQuorumSet {
+	val, ok := u.GetQSet()
+
+	if !ok {
+		panic("arm QSet is not set")
+	}
+
+	return val
+}
+
+// GetQSet retrieves the QSet value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetQSet() (result ScpQuorumSet, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "QSet" {
+		result = *u.QSet
+		ok = true
+	}
+
+	return
+}
+
+// MustEnvelope retrieves the Envelope value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustEnvelope() ScpEnvelope {
+	val, ok := u.GetEnvelope()
+
+	if !ok {
+		panic("arm Envelope is not set")
+	}
+
+	return val
+}
+
+// GetEnvelope retrieves the Envelope value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetEnvelope() (result ScpEnvelope, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Envelope" {
+		result = *u.Envelope
+		ok = true
+	}
+
+	return
+}
+
+// MustGetScpLedgerSeq retrieves the GetScpLedgerSeq value from the union,
+// panicing if the value is not set.
+func (u StellarMessage) MustGetScpLedgerSeq() Uint32 {
+	val, ok := u.GetGetScpLedgerSeq()
+
+	if !ok {
+		panic("arm GetScpLedgerSeq is not set")
+	}
+
+	return val
+}
+
+// GetGetScpLedgerSeq retrieves the GetScpLedgerSeq value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u StellarMessage) GetGetScpLedgerSeq() (result Uint32, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "GetScpLedgerSeq" {
+		result = *u.GetScpLedgerSeq
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s StellarMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *StellarMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*StellarMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*StellarMessage)(nil)
 )
 
-// CreatePassiveSellOfferOp is an XDR Struct defines as:
+// AuthenticatedMessageV0 is an XDR NestedStruct defines as:
 //
-//   struct CreatePassiveSellOfferOp
+//   struct
+//        {
+//            uint64 sequence;
+//            StellarMessage message;
+//            HmacSha256Mac mac;
+//        }
+//
+type AuthenticatedMessageV0 struct {
+	Sequence Uint64
+	Message  StellarMessage
+	Mac      HmacSha256Mac
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AuthenticatedMessageV0) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AuthenticatedMessageV0) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AuthenticatedMessageV0)(nil)
+	_ encoding.BinaryUnmarshaler = (*AuthenticatedMessageV0)(nil)
+)
+
+// AuthenticatedMessage is an XDR Union defines as:
+//
+//   union AuthenticatedMessage switch (uint32 v)
 //    {
-//        Asset selling; // A
-//        Asset buying;  // B
-//        int64 amount;  // amount taker gets. if set to 0, delete the offer
-//        Price price;   // cost of A in terms of B
+//    case 0:
+//        struct
+//        {
+//            uint64 sequence;
+//            StellarMessage message;
+//            HmacSha256Mac mac;
+//        } v0;
 //    };
 //
-type CreatePassiveSellOfferOp struct {
-	Selling Asset
-	Buying  Asset
-	Amount  Int64
-	Price   Price
+type AuthenticatedMessage struct {
+	V  Uint32
+	V0 *AuthenticatedMessageV0
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s CreatePassiveSellOfferOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u AuthenticatedMessage) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of AuthenticatedMessage
+func (u AuthenticatedMessage) ArmForSwitch(sw int32) (string, bool) {
+	switch Uint32(sw) {
+	case 0:
+		return "V0", true
+	}
+	return "-", false
+}
+
+// NewAuthenticatedMessage creates a new  AuthenticatedMessage.
+func NewAuthenticatedMessage(v Uint32, value interface{}) (result AuthenticatedMessage, err error) {
+	result.V = v
+	switch Uint32(v) {
+	case 0:
+		tv, ok := value.(AuthenticatedMessageV0)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be AuthenticatedMessageV0")
+			return
+		}
+		result.V0 = &tv
+	}
+	return
+}
+
+// MustV0 retrieves the V0 value from the union,
+// panicing if the value is not set.
+func (u AuthenticatedMessage) MustV0() AuthenticatedMessageV0 {
+	val, ok := u.GetV0()
+
+	if !ok {
+		panic("arm V0 is not set")
+	}
+
+	return val
+}
+
+// GetV0 retrieves the V0 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u AuthenticatedMessage) GetV0() (result AuthenticatedMessageV0, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.V))
+
+	if armName == "V0" {
+		result = *u.V0
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AuthenticatedMessage) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AuthenticatedMessage) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AuthenticatedMessage)(nil)
+	_ encoding.BinaryUnmarshaler = (*AuthenticatedMessage)(nil)
+)
+
+// MuxedAccountMed25519 is an XDR NestedStruct defines as:
+//
+//   struct
+//        {
+//            uint64 id;
+//            uint256 ed25519;
+//        }
+//
+type MuxedAccountMed25519 struct {
+	Id      Uint64
+	Ed25519 Uint256
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s MuxedAccountMed25519) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *MuxedAccountMed25519) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*MuxedAccountMed25519)(nil)
+	_ encoding.BinaryUnmarshaler = (*MuxedAccountMed25519)(nil)
+)
+
+// MuxedAccount is an XDR Union defines as:
+//
+//   union MuxedAccount switch (CryptoKeyType type)
+//    {
+//    case KEY_TYPE_ED25519:
+//        uint256 ed25519;
+//    case KEY_TYPE_MUXED_ED25519:
+//        struct
+//        {
+//            uint64 id;
+//            uint256 ed25519;
+//        } med25519;
+//    };
+//
+type MuxedAccount struct {
+	Type     CryptoKeyType
+	Ed25519  *Uint256
+	Med25519 *MuxedAccountMed25519
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u MuxedAccount) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of MuxedAccount
+func (u MuxedAccount) ArmForSwitch(sw int32) (string, bool) {
+	switch CryptoKeyType(sw) {
+	case CryptoKeyTypeKeyTypeEd25519:
+		return "Ed25519", true
+	case CryptoKeyTypeKeyTypeMuxedEd25519:
+		return "Med25519", true
+	}
+	return "-", false
+}
+
+// NewMuxedAccount creates a new  MuxedAccount.
+func NewMuxedAccount(aType CryptoKeyType, value interface{}) (result MuxedAccount, err error) {
+	result.Type = aType
+	switch CryptoKeyType(aType) {
+	case CryptoKeyTypeKeyTypeEd25519:
+		tv, ok := value.(Uint256)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Uint256")
+			return
+		}
+		result.Ed25519 = &tv
+	case CryptoKeyTypeKeyTypeMuxedEd25519:
+		tv, ok := value.(MuxedAccountMed25519)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be MuxedAccountMed25519")
+			return
+		}
+		result.Med25519 = &tv
+	}
+	return
+}
+
+// MustEd25519 retrieves the Ed25519 value from the union,
+// panicing if the value is not set.
+func (u MuxedAccount) MustEd25519() Uint256 {
+	val, ok := u.GetEd25519()
+
+	if !ok {
+		panic("arm Ed25519 is not set")
+	}
+
+	return val
+}
+
+// GetEd25519 retrieves the Ed25519 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u MuxedAccount) GetEd25519() (result Uint256, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Ed25519" {
+		result = *u.Ed25519
+		ok = true
+	}
+
+	return
+}
+
+// MustMed25519 retrieves the Med25519 value from the union,
+// panicing if the value is not set.
+func (u MuxedAccount) MustMed25519() MuxedAccountMed25519 {
+	val, ok := u.GetMed25519()
+
+	if !ok {
+		panic("arm Med25519 is not set")
+	}
+
+	return val
+}
+
+// GetMed25519 retrieves the Med25519 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u MuxedAccount) GetMed25519() (result MuxedAccountMed25519, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Med25519" {
+		result = *u.Med25519
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s MuxedAccount) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *MuxedAccount) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*MuxedAccount)(nil)
+	_ encoding.BinaryUnmarshaler = (*MuxedAccount)(nil)
+)
+
+// DecoratedSignature is an XDR Struct defines as:
+//
+//   struct DecoratedSignature
+//    {
+//        SignatureHint hint;  // last 4 bytes of the public key, used as a hint
+//        Signature signature; // actual signature
+//    };
+//
+type DecoratedSignature struct {
+	Hint      SignatureHint
+	Signature Signature
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s DecoratedSignature) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *DecoratedSignature) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*DecoratedSignature)(nil)
+	_ encoding.BinaryUnmarshaler = (*DecoratedSignature)(nil)
+)
+
+// OperationType is an XDR Enum defines as:
+//
+//   enum OperationType
+//    {
+//        CREATE_ACCOUNT = 0,
+//        PAYMENT = 1,
+//        PATH_PAYMENT_STRICT_RECEIVE = 2,
+//        MANAGE_SELL_OFFER = 3,
+//        CREATE_PASSIVE_SELL_OFFER = 4,
+//        SET_OPTIONS = 5,
+//        CHANGE_TRUST = 6,
+//        ALLOW_TRUST = 7,
+//        ACCOUNT_MERGE = 8,
+//        INFLATION = 9,
+//        MANAGE_DATA = 10,
+//        BUMP_SEQUENCE = 11,
+//        MANAGE_BUY_OFFER = 12,
+//        PATH_PAYMENT_STRICT_SEND = 13,
+//        CREATE_CLAIMABLE_BALANCE = 14,
+//        CLAIM_CLAIMABLE_BALANCE = 15,
+//        BEGIN_SPONSORING_FUTURE_RESERVES = 16,
+//        END_SPONSORING_FUTURE_RESERVES = 17,
+//        REVOKE_SPONSORSHIP = 18
+//    };
+//
+type OperationType int32
+
+const (
+	OperationTypeCreateAccount                 OperationType = 0
+	OperationTypePayment                       OperationType = 1
+	OperationTypePathPaymentStrictReceive      OperationType = 2
+	OperationTypeManageSellOffer               OperationType = 3
+	OperationTypeCreatePassiveSellOffer        OperationType = 4
+	OperationTypeSetOptions                    OperationType = 5
+	OperationTypeChangeTrust                   OperationType = 6
+	OperationTypeAllowTrust                    OperationType = 7
+	OperationTypeAccountMerge                  OperationType = 8
+	OperationTypeInflation                     OperationType = 9
+	OperationTypeManageData                    OperationType = 10
+	OperationTypeBumpSequence                  OperationType = 11
+	OperationTypeManageBuyOffer                OperationType = 12
+	OperationTypePathPaymentStrictSend         OperationType = 13
+	OperationTypeCreateClaimableBalance        OperationType = 14
+	OperationTypeClaimClaimableBalance         OperationType = 15
+	OperationTypeBeginSponsoringFutureReserves OperationType = 16
+	OperationTypeEndSponsoringFutureReserves   OperationType = 17
+	OperationTypeRevokeSponsorship             OperationType = 18
+)
+
+var operationTypeMap = map[int32]string{
+	0:  "OperationTypeCreateAccount",
+	1:  "OperationTypePayment",
+	2:  "OperationTypePathPaymentStrictReceive",
+	3:  "OperationTypeManageSellOffer",
+	4:  "OperationTypeCreatePassiveSellOffer",
+	5:  "OperationTypeSetOptions",
+	6:  "OperationTypeChangeTrust",
+	7:  "OperationTypeAllowTrust",
+	8:  "OperationTypeAccountMerge",
+	9:  "OperationTypeInflation",
+	10: "OperationTypeManageData",
+	11: "OperationTypeBumpSequence",
+	12: "OperationTypeManageBuyOffer",
+	13: "OperationTypePathPaymentStrictSend",
+	14: "OperationTypeCreateClaimableBalance",
+	15: "OperationTypeClaimClaimableBalance",
+	16: "OperationTypeBeginSponsoringFutureReserves",
+	17: "OperationTypeEndSponsoringFutureReserves",
+	18: "OperationTypeRevokeSponsorship",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for OperationType
+func (e OperationType) ValidEnum(v int32) bool {
+	_, ok := operationTypeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e OperationType) String() string {
+	name, _ := operationTypeMap[int32(e)]
+	return name
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s OperationType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *OperationType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*OperationType)(nil)
+	_ encoding.BinaryUnmarshaler = (*OperationType)(nil)
+)
+
+// CreateAccountOp is an XDR Struct defines as:
+//
+//   struct CreateAccountOp
+//    {
+//        AccountID destination; // account to create
+//        int64 startingBalance; // amount they end up with
+//    };
+//
+type CreateAccountOp struct {
+	Destination     AccountId
+	StartingBalance Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s CreateAccountOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *CreateAccountOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*CreateAccountOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateAccountOp)(nil)
+)
+
+// PaymentOp is an XDR Struct defines as:
+//
+//   struct PaymentOp
+//    {
+//        MuxedAccount destination; // recipient of the payment
+//        Asset asset;              // what they end up with
+//        int64 amount;             // amount they end up with
+//    };
+//
+type PaymentOp struct {
+	Destination MuxedAccount
+	Asset       Asset
+	Amount      Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PaymentOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PaymentOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*PaymentOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*PaymentOp)(nil)
+)
+
+// PathPaymentStrictReceiveOp is an XDR Struct defines as:
+//
+//   struct PathPaymentStrictReceiveOp
+//    {
+//        Asset sendAsset; // asset we pay with
+//        int64 sendMax;   // the maximum amount of sendAsset to
+//                         // send (excluding fees).
+//                         // The operation will fail if can't be met
+//
+//        MuxedAccount destination; // recipient of the payment
+//        Asset destAsset;          // what they end up with
+//        int64 destAmount;         // amount they end up with
+//
+//        Asset path<5>; // additional hops it must go through to get there
+//    };
+//
+type PathPaymentStrictReceiveOp struct {
+	SendAsset   Asset
+	SendMax     Int64
+	Destination MuxedAccount
+	DestAsset   Asset
+	DestAmount  Int64
+	Path        []Asset `xdrmaxsize:"5"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PathPaymentStrictReceiveOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PathPaymentStrictReceiveOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveOp)(nil)
+)
+
+// PathPaymentStrictSendOp is an XDR Struct defines as:
+//
+//   struct PathPaymentStrictSendOp
+//    {
+//        Asset sendAsset;  // asset we pay with
+//        int64 sendAmount; // amount of sendAsset to send (excluding fees)
+//
+//        MuxedAccount destination; // recipient of the payment
+//        Asset destAsset;          // what they end up with
+//        int64 destMin;            // the minimum amount of dest asset to
+//                                  // be received
+//                                  // The operation will fail if it can't be met
+//
+//        Asset path<5>; // additional hops it must go through to get there
+//    };
+//
+type PathPaymentStrictSendOp struct {
+	SendAsset   Asset
+	SendAmount  Int64
+	Destination MuxedAccount
+	DestAsset   Asset
+	DestMin     Int64
+	Path        []Asset `xdrmaxsize:"5"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PathPaymentStrictSendOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PathPaymentStrictSendOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendOp)(nil)
+)
+
+// ManageSellOfferOp is an XDR Struct defines as:
+//
+//   struct ManageSellOfferOp
+//    {
+//        Asset selling;
+//        Asset buying;
+//        int64 amount; // amount being sold. if set to 0, delete the offer
+//        Price price;  // price of thing being sold in terms of what you are buying
+//
+//        // 0=create a new offer, otherwise edit an existing offer
+//        int64 offerID;
+//    };
+//
+type ManageSellOfferOp struct {
+	Selling Asset
+	Buying  Asset
+	Amount  Int64
+	Price   Price
+	OfferId Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageSellOfferOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageSellOfferOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageSellOfferOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageSellOfferOp)(nil)
+)
+
+// ManageBuyOfferOp is an XDR Struct defines as:
+//
+//   struct ManageBuyOfferOp
+//    {
+//        Asset selling;
+//        Asset buying;
+//        int64 buyAmount; // amount being bought. if set to 0, delete the offer
+//        Price price;     // price of thing being bought in terms of what you are
+//                         // selling
+//
+//        // 0=create a new offer, otherwise edit an existing offer
+//        int64 offerID;
+//    };
+//
+type ManageBuyOfferOp struct {
+	Selling   Asset
+	Buying    Asset
+	BuyAmount Int64
+	Price     Price
+	OfferId   Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageBuyOfferOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageBuyOfferOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferOp)(nil)
+)
+
+// CreatePassiveSellOfferOp is an XDR Struct defines as:
+//
+//   struct CreatePassiveSellOfferOp
+//    {
+//        Asset selling; // A
+//        Asset buying;  // B
+//        int64 amount;  // amount taker gets. if set to 0, delete the offer
+//        Price price;   // cost of A in terms of B
+//    };
+//
+type CreatePassiveSellOfferOp struct {
+	Selling Asset
+	Buying  Asset
+	Amount  Int64
+	Price   Price
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s CreatePassiveSellOfferOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *CreatePassiveSellOfferOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*CreatePassiveSellOfferOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreatePassiveSellOfferOp)(nil)
+)
+
+// SetOptionsOp is an XDR Struct defines as:
+//
+//   struct SetOptionsOp
+//    {
+//        AccountID* inflationDest; // sets the inflation destination
+//
+//        uint32* clearFlags; // which flags to clear
+//        uint32* setFlags;   // which flags to set
+//
+//        // account threshold manipulation
+//        uint32* masterWeight; // weight of the master account
+//        uint32* lowThreshold;
+//        uint32* medThreshold;
+//        uint32* highThreshold;
+//
+//        string32* homeDomain; // sets the home domain
+//
+//        // Add, update or remove a signer for the account
+//        // signer is deleted if the weight is 0
+//        Signer* signer;
+//    };
+//
+type SetOptionsOp struct {
+	InflationDest *AccountId
+	ClearFlags    *Uint32
+	SetFlags      *Uint32
+	MasterWeight  *Uint32
+	LowThreshold  *Uint32
+	MedThreshold  *Uint32
+	HighThreshold *Uint32
+	HomeDomain    *String32
+	Signer        *Signer
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SetOptionsOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SetOptionsOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*SetOptionsOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*SetOptionsOp)(nil)
+)
+
+// ChangeTrustOp is an XDR Struct defines as:
+//
+//   struct ChangeTrustOp
+//    {
+//        Asset line;
+//
+//        // if limit is set to 0, deletes the trust line
+//        int64 limit;
+//    };
+//
+type ChangeTrustOp struct {
+	Line  Asset
+	Limit Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ChangeTrustOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ChangeTrustOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ChangeTrustOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ChangeTrustOp)(nil)
+)
+
+// AllowTrustOpAsset is an XDR NestedUnion defines as:
+//
+//   union switch (AssetType type)
+//        {
+//        // ASSET_TYPE_NATIVE is not allowed
+//        case ASSET_TYPE_CREDIT_ALPHANUM4:
+//            AssetCode4 assetCode4;
+//
+//        case ASSET_TYPE_CREDIT_ALPHANUM12:
+//            AssetCode12 assetCode12;
+//
+//            // add other asset types here in the future
+//        }
+//
+type AllowTrustOpAsset struct {
+	Type        AssetType
+	AssetCode4  *AssetCode4
+	AssetCode12 *AssetCode12
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u AllowTrustOpAsset) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of AllowTrustOpAsset
+func (u AllowTrustOpAsset) ArmForSwitch(sw int32) (string, bool) {
+	switch AssetType(sw) {
+	case AssetTypeAssetTypeCreditAlphanum4:
+		return "AssetCode4", true
+	case AssetTypeAssetTypeCreditAlphanum12:
+		return "AssetCode12", true
+	}
+	return "-", false
+}
+
+// NewAllowTrustOpAsset creates a new  AllowTrustOpAsset.
+func NewAllowTrustOpAsset(aType AssetType, value interface{}) (result AllowTrustOpAsset, err error) {
+	result.Type = aType
+	switch AssetType(aType) {
+	case AssetTypeAssetTypeCreditAlphanum4:
+		tv, ok := value.(AssetCode4)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be AssetCode4")
+			return
+		}
+		result.AssetCode4 = &tv
+	case AssetTypeAssetTypeCreditAlphanum12:
+		tv, ok := value.(AssetCode12)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be AssetCode12")
+			return
+		}
+		result.AssetCode12 = &tv
+	}
+	return
+}
+
+// MustAssetCode4 retrieves the AssetCode4 value from the union,
+// panicing if the value is not set.
+func (u AllowTrustOpAsset) MustAssetCode4() AssetCode4 {
+	val, ok := u.GetAssetCode4()
+
+	if !ok {
+		panic("arm AssetCode4 is not set")
+	}
+
+	return val
+}
+
+// GetAssetCode4 retrieves the AssetCode4 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u AllowTrustOpAsset) GetAssetCode4() (result AssetCode4, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "AssetCode4" {
+		result = *u.AssetCode4
+		ok = true
+	}
+
+	return
+}
+
+// MustAssetCode12 retrieves the AssetCode12 value from the union,
+// panicing if the value is not set.
+func (u AllowTrustOpAsset) MustAssetCode12() AssetCode12 {
+	val, ok := u.GetAssetCode12()
+
+	if !ok {
+		panic("arm AssetCode12 is not set")
+	}
+
+	return val
+}
+
+// GetAssetCode12 retrieves the AssetCode12 value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u AllowTrustOpAsset) GetAssetCode12() (result AssetCode12, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "AssetCode12" {
+		result = *u.AssetCode12
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AllowTrustOpAsset) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AllowTrustOpAsset) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AllowTrustOpAsset)(nil)
+	_ encoding.BinaryUnmarshaler = (*AllowTrustOpAsset)(nil)
+)
+
+// AllowTrustOp is an XDR Struct defines as:
+//
+//   struct AllowTrustOp
+//    {
+//        AccountID trustor;
+//        union switch (AssetType type)
+//        {
+//        // ASSET_TYPE_NATIVE is not allowed
+//        case ASSET_TYPE_CREDIT_ALPHANUM4:
+//            AssetCode4 assetCode4;
+//
+//        case ASSET_TYPE_CREDIT_ALPHANUM12:
+//            AssetCode12 assetCode12;
+//
+//            // add other asset types here in the future
+//        }
+//        asset;
+//
+//        // 0, or any bitwise combination of TrustLineFlags
+//        uint32 authorize;
+//    };
+//
+type AllowTrustOp struct {
+	Trustor   AccountId
+	Asset     AllowTrustOpAsset
+	Authorize Uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s AllowTrustOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *AllowTrustOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*AllowTrustOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*AllowTrustOp)(nil)
+)
+
+// ManageDataOp is an XDR Struct defines as:
+//
+//   struct ManageDataOp
+//    {
+//        string64 dataName;
+//        DataValue* dataValue; // set to null to clear
+//    };
+//
+type ManageDataOp struct {
+	DataName  String64
+	DataValue *DataValue
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageDataOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageDataOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageDataOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageDataOp)(nil)
+)
+
+// BumpSequenceOp is an XDR Struct defines as:
+//
+//   struct BumpSequenceOp
+//    {
+//        SequenceNumber bumpTo;
+//    };
+//
+type BumpSequenceOp struct {
+	BumpTo SequenceNumber
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BumpSequenceOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BumpSequenceOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BumpSequenceOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*BumpSequenceOp)(nil)
+)
+
+// CreateClaimableBalanceOp is an XDR Struct defines as:
+//
+//   struct CreateClaimableBalanceOp
+//    {
+//        Asset asset;
+//        int64 amount;
+//        Claimant claimants<10>;
+//    };
+//
+type CreateClaimableBalanceOp struct {
+	Asset     Asset
+	Amount    Int64
+	Claimants []Claimant `xdrmaxsize:"10"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s CreateClaimableBalanceOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *CreateClaimableBalanceOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*CreateClaimableBalanceOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateClaimableBalanceOp)(nil)
+)
+
+// ClaimClaimableBalanceOp is an XDR Struct defines as:
+//
+//   struct ClaimClaimableBalanceOp
+//    {
+//        ClaimableBalanceID balanceID;
+//    };
+//
+type ClaimClaimableBalanceOp struct {
+	BalanceId ClaimableBalanceId
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ClaimClaimableBalanceOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ClaimClaimableBalanceOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ClaimClaimableBalanceOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimClaimableBalanceOp)(nil)
+)
+
+// BeginSponsoringFutureReservesOp is an XDR Struct defines as:
+//
+//   struct BeginSponsoringFutureReservesOp
+//    {
+//        AccountID sponsoredID;
+//    };
+//
+type BeginSponsoringFutureReservesOp struct {
+	SponsoredId AccountId
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BeginSponsoringFutureReservesOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BeginSponsoringFutureReservesOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BeginSponsoringFutureReservesOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*BeginSponsoringFutureReservesOp)(nil)
+)
+
+// RevokeSponsorshipType is an XDR Enum defines as:
+//
+//   enum RevokeSponsorshipType
+//    {
+//        REVOKE_SPONSORSHIP_LEDGER_ENTRY = 0,
+//        REVOKE_SPONSORSHIP_SIGNER = 1
+//    };
+//
+type RevokeSponsorshipType int32
+
+const (
+	RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry RevokeSponsorshipType = 0
+	RevokeSponsorshipTypeRevokeSponsorshipSigner      RevokeSponsorshipType = 1
+)
+
+var revokeSponsorshipTypeMap = map[int32]string{
+	0: "RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry",
+	1: "RevokeSponsorshipTypeRevokeSponsorshipSigner",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for RevokeSponsorshipType
+func (e RevokeSponsorshipType) ValidEnum(v int32) bool {
+	_, ok := revokeSponsorshipTypeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e RevokeSponsorshipType) String() string {
+	name, _ := revokeSponsorshipTypeMap[int32(e)]
+	return name
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s RevokeSponsorshipType) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *RevokeSponsorshipType) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*RevokeSponsorshipType)(nil)
+	_ encoding.BinaryUnmarshaler = (*RevokeSponsorshipType)(nil)
+)
+
+// RevokeSponsorshipOpSigner is an XDR NestedStruct defines as:
+//
+//   struct
+//        {
+//            AccountID accountID;
+//            SignerKey signerKey;
+//        }
+//
+type RevokeSponsorshipOpSigner struct {
+	AccountId AccountId
+	SignerKey SignerKey
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s RevokeSponsorshipOpSigner) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *RevokeSponsorshipOpSigner) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*RevokeSponsorshipOpSigner)(nil)
+	_ encoding.BinaryUnmarshaler = (*RevokeSponsorshipOpSigner)(nil)
+)
+
+// RevokeSponsorshipOp is an XDR Union defines as:
+//
+//   union RevokeSponsorshipOp switch (RevokeSponsorshipType type)
+//    {
+//    case REVOKE_SPONSORSHIP_LEDGER_ENTRY:
+//        LedgerKey ledgerKey;
+//    case REVOKE_SPONSORSHIP_SIGNER:
+//        struct
+//        {
+//            AccountID accountID;
+//            SignerKey signerKey;
+//        } signer;
+//    };
+//
+type RevokeSponsorshipOp struct {
+	Type      RevokeSponsorshipType
+	LedgerKey *LedgerKey
+	Signer    *RevokeSponsorshipOpSigner
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u RevokeSponsorshipOp) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of RevokeSponsorshipOp
+func (u RevokeSponsorshipOp) ArmForSwitch(sw int32) (string, bool) {
+	switch RevokeSponsorshipType(sw) {
+	case RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry:
+		return "LedgerKey", true
+	case RevokeSponsorshipTypeRevokeSponsorshipSigner:
+		return "Signer", true
+	}
+	return "-", false
+}
+
+// NewRevokeSponsorshipOp creates a new  RevokeSponsorshipOp.
+func NewRevokeSponsorshipOp(aType RevokeSponsorshipType, value interface{}) (result RevokeSponsorshipOp, err error) {
+	result.Type = aType
+	switch RevokeSponsorshipType(aType) {
+	case RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry:
+		tv, ok := value.(LedgerKey)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerKey")
+			return
+		}
+		result.LedgerKey = &tv
+	case RevokeSponsorshipTypeRevokeSponsorshipSigner:
+		tv, ok := value.(RevokeSponsorshipOpSigner)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be RevokeSponsorshipOpSigner")
+			return
+		}
+		result.Signer = &tv
+	}
+	return
+}
+
+// MustLedgerKey retrieves the LedgerKey value from the union,
+// panicing if the value is not set.
+func (u RevokeSponsorshipOp) MustLedgerKey() LedgerKey {
+	val, ok := u.GetLedgerKey()
+
+	if !ok {
+		panic("arm LedgerKey is not set")
+	}
+
+	return val
+}
+
+// GetLedgerKey retrieves the LedgerKey value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u RevokeSponsorshipOp) GetLedgerKey() (result LedgerKey, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "LedgerKey" {
+		result = *u.LedgerKey
+		ok = true
+	}
+
+	return
+}
+
+// MustSigner retrieves the Signer value from the union,
+// panicing if the value is not set.
+func (u RevokeSponsorshipOp) MustSigner() RevokeSponsorshipOpSigner {
+	val, ok := u.GetSigner()
+
+	if !ok {
+		panic("arm Signer is not set")
+	}
+
+	return val
+}
+
+// GetSigner retrieves the Signer value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u RevokeSponsorshipOp) GetSigner() (result RevokeSponsorshipOpSigner, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Signer" {
+		result = *u.Signer
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s RevokeSponsorshipOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *RevokeSponsorshipOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*RevokeSponsorshipOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*RevokeSponsorshipOp)(nil)
+)
+
+// OperationBody is an XDR NestedUnion defines as:
+//
+//   union switch (OperationType type)
+//        {
+//        case CREATE_ACCOUNT:
+//            CreateAccountOp createAccountOp;
+//        case PAYMENT:
+//            PaymentOp paymentOp;
+//        case PATH_PAYMENT_STRICT_RECEIVE:
+//            PathPaymentStrictReceiveOp pathPaymentStrictReceiveOp;
+//        case MANAGE_SELL_OFFER:
+//            ManageSellOfferOp manageSellOfferOp;
+//        case CREATE_PASSIVE_SELL_OFFER:
+//            CreatePassiveSellOfferOp createPassiveSellOfferOp;
+//        case SET_OPTIONS:
+//            SetOptionsOp setOptionsOp;
+//        case CHANGE_TRUST:
+//            ChangeTrustOp changeTrustOp;
+//        case ALLOW_TRUST:
+//            AllowTrustOp allowTrustOp;
+//        case ACCOUNT_MERGE:
+//            MuxedAccount destination;
+//        case INFLATION:
+//            void;
+//        case MANAGE_DATA:
+//            ManageDataOp manageDataOp;
+//        case BUMP_SEQUENCE:
+//            BumpSequenceOp bumpSequenceOp;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferOp manageBuyOfferOp;
+//        case PATH_PAYMENT_STRICT_SEND:
+//            PathPaymentStrictSendOp pathPaymentStrictSendOp;
+//        case CREATE_CLAIMABLE_BALANCE:
+//            CreateClaimableBalanceOp createClaimableBalanceOp;
+//        case CLAIM_CLAIMABLE_BALANCE:
+//            ClaimClaimableBalanceOp claimClaimableBalanceOp;
+//        case BEGIN_SPONSORING_FUTURE_RESERVES:
+//            BeginSponsoringFutureReservesOp beginSponsoringFutureReservesOp;
+//        case END_SPONSORING_FUTURE_RESERVES:
+//            void;
+//        case REVOKE_SPONSORSHIP:
+//            RevokeSponsorshipOp revokeSponsorshipOp;
+//        }
+//
+type OperationBody struct {
+	Type                            OperationType
+	CreateAccountOp                 *CreateAccountOp
+	PaymentOp                       *PaymentOp
+	PathPaymentStrictReceiveOp      *PathPaymentStrictReceiveOp
+	ManageSellOfferOp               *ManageSellOfferOp
+	CreatePassiveSellOfferOp        *CreatePassiveSellOfferOp
+	SetOptionsOp                    *SetOptionsOp
+	ChangeTrustOp                   *ChangeTrustOp
+	AllowTrustOp                    *AllowTrustOp
+	Destination                     *MuxedAccount
+	ManageDataOp                    *ManageDataOp
+	BumpSequenceOp                  *BumpSequenceOp
+	ManageBuyOfferOp                *ManageBuyOfferOp
+	PathPaymentStrictSendOp         *PathPaymentStrictSendOp
+	CreateClaimableBalanceOp        *CreateClaimableBalanceOp
+	ClaimClaimableBalanceOp         *ClaimClaimableBalanceOp
+	BeginSponsoringFutureReservesOp *BeginSponsoringFutureReservesOp
+	RevokeSponsorshipOp             *RevokeSponsorshipOp
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u OperationBody) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of OperationBody
+func (u OperationBody) ArmForSwitch(sw int32) (string, bool) {
+	switch OperationType(sw) {
+	case OperationTypeCreateAccount:
+		return "CreateAccountOp", true
+	case OperationTypePayment:
+		return "PaymentOp", true
+	case OperationTypePathPaymentStrictReceive:
+		return "PathPaymentStrictReceiveOp", true
+	case OperationTypeManageSellOffer:
+		return "ManageSellOfferOp", true
+	case OperationTypeCreatePassiveSellOffer:
+		return "CreatePassiveSellOfferOp", true
+	case OperationTypeSetOptions:
+		return "SetOptionsOp", true
+	case OperationTypeChangeTrust:
+		return "ChangeTrustOp", true
+	case OperationTypeAllowTrust:
+		return "AllowTrustOp", true
+	case OperationTypeAccountMerge:
+		return "Destination", true
+	case OperationTypeInflation:
+		return "", true
+	case OperationTypeManageData:
+		return "ManageDataOp", true
+	case OperationTypeBumpSequence:
+		return "BumpSequenceOp", true
+	case OperationTypeManageBuyOffer:
+		return "ManageBuyOfferOp", true
+	case OperationTypePathPaymentStrictSend:
+		return "PathPaymentStrictSendOp", true
+	case OperationTypeCreateClaimableBalance:
+		return "CreateClaimableBalanceOp", true
+	case OperationTypeClaimClaimableBalance:
+		return "ClaimClaimableBalanceOp", true
+	case OperationTypeBeginSponsoringFutureReserves:
+		return "BeginSponsoringFutureReservesOp", true
+	case OperationTypeEndSponsoringFutureReserves:
+		return "", true
+	case OperationTypeRevokeSponsorship:
+		return "RevokeSponsorshipOp", true
+	}
+	return "-", false
+}
+
+// NewOperationBody creates a new  OperationBody.
+func NewOperationBody(aType OperationType, value interface{}) (result OperationBody, err error) {
+	result.Type = aType
+	switch OperationType(aType) {
+	case OperationTypeCreateAccount:
+		tv, ok := value.(CreateAccountOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be CreateAccountOp")
+			return
+		}
+		result.CreateAccountOp = &tv
+	case OperationTypePayment:
+		tv, ok := value.(PaymentOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be PaymentOp")
+			return
+		}
+		result.PaymentOp = &tv
+	case OperationTypePathPaymentStrictReceive:
+		tv, ok := value.(PathPaymentStrictReceiveOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be PathPaymentStrictReceiveOp")
+			return
+		}
+		result.PathPaymentStrictReceiveOp = &tv
+	case OperationTypeManageSellOffer:
+		tv, ok := value.(ManageSellOfferOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageSellOfferOp")
+			return
+		}
+		result.ManageSellOfferOp = &tv
+	case OperationTypeCreatePassiveSellOffer:
+		tv, ok := value.(CreatePassiveSellOfferOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be CreatePassiveSellOfferOp")
+			return
+		}
+		result.CreatePassiveSellOfferOp = &tv
+	case OperationTypeSetOptions:
+		tv, ok := value.(SetOptionsOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be SetOptionsOp")
+			return
+		}
+		result.SetOptionsOp = &tv
+	case OperationTypeChangeTrust:
+		tv, ok := value.(ChangeTrustOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ChangeTrustOp")
+			return
+		}
+		result.ChangeTrustOp = &tv
+	case OperationTypeAllowTrust:
+		tv, ok := value.(AllowTrustOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be AllowTrustOp")
+			return
+		}
+		result.AllowTrustOp = &tv
+	case OperationTypeAccountMerge:
+		tv, ok := value.(MuxedAccount)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be MuxedAccount")
+			return
+		}
+		result.Destination = &tv
+	case OperationTypeInflation:
+		// void
+	case OperationTypeManageData:
+		tv, ok := value.(ManageDataOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageDataOp")
+			return
+		}
+		result.ManageDataOp = &tv
+	case OperationTypeBumpSequence:
+		tv, ok := value.(BumpSequenceOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be BumpSequenceOp")
+			return
+		}
+		result.BumpSequenceOp = &tv
+	case OperationTypeManageBuyOffer:
+		tv, ok := value.(ManageBuyOfferOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageBuyOfferOp")
+			return
+		}
+		result.ManageBuyOfferOp = &tv
+	case OperationTypePathPaymentStrictSend:
+		tv, ok := value.(PathPaymentStrictSendOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be PathPaymentStrictSendOp")
+			return
+		}
+		result.PathPaymentStrictSendOp = &tv
+	case OperationTypeCreateClaimableBalance:
+		tv, ok := value.(CreateClaimableBalanceOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be CreateClaimableBalanceOp")
+			return
+		}
+		result.CreateClaimableBalanceOp = &tv
+	case OperationTypeClaimClaimableBalance:
+		tv, ok := value.(ClaimClaimableBalanceOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ClaimClaimableBalanceOp")
+			return
+		}
+		result.ClaimClaimableBalanceOp = &tv
+	case OperationTypeBeginSponsoringFutureReserves:
+		tv, ok := value.(BeginSponsoringFutureReservesOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be BeginSponsoringFutureReservesOp")
+			return
+		}
+		result.BeginSponsoringFutureReservesOp = &tv
+	case OperationTypeEndSponsoringFutureReserves:
+		// void
+	case OperationTypeRevokeSponsorship:
+		tv, ok := value.(RevokeSponsorshipOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be RevokeSponsorshipOp")
+			return
+		}
+		result.RevokeSponsorshipOp = &tv
+	}
+	return
+}
+
+// MustCreateAccountOp retrieves the CreateAccountOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustCreateAccountOp() CreateAccountOp {
+	val, ok := u.GetCreateAccountOp()
+
+	if !ok {
+		panic("arm CreateAccountOp is not set")
+	}
+
+	return val
+}
+
+// GetCreateAccountOp retrieves the CreateAccountOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetCreateAccountOp() (result CreateAccountOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "CreateAccountOp" {
+		result = *u.CreateAccountOp
+		ok = true
+	}
+
+	return
+}
+
+// MustPaymentOp retrieves the PaymentOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustPaymentOp() PaymentOp {
+	val, ok := u.GetPaymentOp()
+
+	if !ok {
+		panic("arm PaymentOp is not set")
+	}
+
+	return val
+}
+
+// GetPaymentOp retrieves the PaymentOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetPaymentOp() (result PaymentOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "PaymentOp" {
+		result = *u.PaymentOp
+		ok = true
+	}
+
+	return
+}
+
+// MustPathPaymentStrictReceiveOp retrieves the PathPaymentStrictReceiveOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustPathPaymentStrictReceiveOp() PathPaymentStrictReceiveOp {
+	val, ok := u.GetPathPaymentStrictReceiveOp()
+
+	if !ok {
+		panic("arm PathPaymentStrictReceiveOp is not set")
+	}
+
+	return val
+}
+
+// GetPathPaymentStrictReceiveOp retrieves the PathPaymentStrictReceiveOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetPathPaymentStrictReceiveOp() (result PathPaymentStrictReceiveOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "PathPaymentStrictReceiveOp" {
+		result = *u.PathPaymentStrictReceiveOp
+		ok = true
+	}
+
+	return
+}
+
+// MustManageSellOfferOp retrieves the ManageSellOfferOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustManageSellOfferOp() ManageSellOfferOp {
+	val, ok := u.GetManageSellOfferOp()
+
+	if !ok {
+		panic("arm ManageSellOfferOp is not set")
+	}
+
+	return val
+}
+
+// GetManageSellOfferOp retrieves the ManageSellOfferOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetManageSellOfferOp() (result ManageSellOfferOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ManageSellOfferOp" {
+		result = *u.ManageSellOfferOp
+		ok = true
+	}
+
+	return
+}
+
+// MustCreatePassiveSellOfferOp retrieves the CreatePassiveSellOfferOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustCreatePassiveSellOfferOp() CreatePassiveSellOfferOp {
+	val, ok := u.GetCreatePassiveSellOfferOp()
+
+	if !ok {
+		panic("arm CreatePassiveSellOfferOp is not set")
+	}
+
+	return val
+}
+
+// GetCreatePassiveSellOfferOp retrieves the CreatePassiveSellOfferOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetCreatePassiveSellOfferOp() (result CreatePassiveSellOfferOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "CreatePassiveSellOfferOp" {
+		result = *u.CreatePassiveSellOfferOp
+		ok = true
+	}
+
+	return
+}
+
+// MustSetOptionsOp retrieves the SetOptionsOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustSetOptionsOp() SetOptionsOp {
+	val, ok := u.GetSetOptionsOp()
+
+	if !ok {
+		panic("arm SetOptionsOp is not set")
+	}
+
+	return val
+}
+
+// GetSetOptionsOp retrieves the SetOptionsOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetSetOptionsOp() (result SetOptionsOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "SetOptionsOp" {
+		result = *u.SetOptionsOp
+		ok = true
+	}
+
+	return
+}
+
+// MustChangeTrustOp retrieves the ChangeTrustOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustChangeTrustOp() ChangeTrustOp {
+	val, ok := u.GetChangeTrustOp()
+
+	if !ok {
+		panic("arm ChangeTrustOp is not set")
+	}
+
+	return val
+}
+
+// GetChangeTrustOp retrieves the ChangeTrustOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetChangeTrustOp() (result ChangeTrustOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ChangeTrustOp" {
+		result = *u.ChangeTrustOp
+		ok = true
+	}
+
+	return
+}
+
+// MustAllowTrustOp retrieves the AllowTrustOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustAllowTrustOp() AllowTrustOp {
+	val, ok := u.GetAllowTrustOp()
+
+	if !ok {
+		panic("arm AllowTrustOp is not set")
+	}
+
+	return val
+}
+
+// GetAllowTrustOp retrieves the AllowTrustOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetAllowTrustOp() (result AllowTrustOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "AllowTrustOp" {
+		result = *u.AllowTrustOp
+		ok = true
+	}
+
+	return
+}
+
+// MustDestination retrieves the Destination value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustDestination() MuxedAccount {
+	val, ok := u.GetDestination()
+
+	if !ok {
+		panic("arm Destination is not set")
+	}
+
+	return val
+}
+
+// GetDestination retrieves the Destination value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetDestination() (result MuxedAccount, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Destination" {
+		result = *u.Destination
+		ok = true
+	}
+
+	return
+}
+
+// MustManageDataOp retrieves the ManageDataOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustManageDataOp() ManageDataOp {
+	val, ok := u.GetManageDataOp()
+
+	if !ok {
+		panic("arm ManageDataOp is not set")
+	}
+
+	return val
+}
+
+// GetManageDataOp retrieves the ManageDataOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetManageDataOp() (result ManageDataOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ManageDataOp" {
+		result = *u.ManageDataOp
+		ok = true
+	}
+
+	return
+}
+
+// MustBumpSequenceOp retrieves the BumpSequenceOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustBumpSequenceOp() BumpSequenceOp {
+	val, ok := u.GetBumpSequenceOp()
+
+	if !ok {
+		panic("arm BumpSequenceOp is not set")
+	}
+
+	return val
+}
+
+// GetBumpSequenceOp retrieves the BumpSequenceOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetBumpSequenceOp() (result BumpSequenceOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "BumpSequenceOp" {
+		result = *u.BumpSequenceOp
+		ok = true
+	}
+
+	return
+}
+
+// MustManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustManageBuyOfferOp() ManageBuyOfferOp {
+	val, ok := u.GetManageBuyOfferOp()
+
+	if !ok {
+		panic("arm ManageBuyOfferOp is not set")
+	}
+
+	return val
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *CreatePassiveSellOfferOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// GetManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetManageBuyOfferOp() (result ManageBuyOfferOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-var (
-	_ encoding.BinaryMarshaler   = (*CreatePassiveSellOfferOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*CreatePassiveSellOfferOp)(nil)
-)
+	if armName == "ManageBuyOfferOp" {
+		result = *u.ManageBuyOfferOp
+		ok = true
+	}
 
-// SetOptionsOp is an XDR Struct defines as:
-//
-//   struct SetOptionsOp
-//    {
-//        AccountID* inflationDest; // sets the inflation destination
-//
-//        uint32* clearFlags; // which flags to clear
-//        uint32* setFlags;   // which flags to set
-//
-//        // account threshold manipulation
-//        uint32* masterWeight; // weight of the master account
-//        uint32* lowThreshold;
-//        uint32* medThreshold;
-//        uint32* highThreshold;
-//
-//        string32* homeDomain; // sets the home domain
-//
-//        // Add, update or remove a signer for the account
-//        // signer is deleted if the weight is 0
-//        Signer* signer;
-//    };
-//
-type SetOptionsOp struct {
-	InflationDest *AccountId
-	ClearFlags    *Uint32
-	SetFlags      *Uint32
-	MasterWeight  *Uint32
-	LowThreshold  *Uint32
-	MedThreshold  *Uint32
-	HighThreshold *Uint32
-	HomeDomain    *String32
-	Signer        *Signer
+	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s SetOptionsOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+// MustPathPaymentStrictSendOp retrieves the PathPaymentStrictSendOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustPathPaymentStrictSendOp() PathPaymentStrictSendOp {
+	val, ok := u.GetPathPaymentStrictSendOp()
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SetOptionsOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+	if !ok {
+		panic("arm PathPaymentStrictSendOp is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*SetOptionsOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*SetOptionsOp)(nil)
-)
+// GetPathPaymentStrictSendOp retrieves the PathPaymentStrictSendOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetPathPaymentStrictSendOp() (result PathPaymentStrictSendOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-// ChangeTrustOp is an XDR Struct defines as:
-//
-//   struct ChangeTrustOp
-//    {
-//        Asset line;
-//
-//        // if limit is set to 0, deletes the trust line
-//        int64 limit;
-//    };
-//
-type ChangeTrustOp struct {
-	Line  Asset
-	Limit Int64
-}
+	if armName == "PathPaymentStrictSendOp" {
+		result = *u.PathPaymentStrictSendOp
+		ok = true
+	}
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s ChangeTrustOp) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
+	return
 }
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ChangeTrustOp) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
+// MustCreateClaimableBalanceOp retrieves the CreateClaimableBalanceOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustCreateClaimableBalanceOp() CreateClaimableBalanceOp {
+	val, ok := u.GetCreateClaimableBalanceOp()
 
-var (
-	_ encoding.BinaryMarshaler   = (*ChangeTrustOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*ChangeTrustOp)(nil)
-)
+	if !ok {
+		panic("arm CreateClaimableBalanceOp is not set")
+	}
 
-// AllowTrustOpAsset is an XDR NestedUnion defines as:
-//
-//   union switch (AssetType type)
-//        {
-//        // ASSET_TYPE_NATIVE is not allowed
-//        case ASSET_TYPE_CREDIT_ALPHANUM4:
-//            AssetCode4 assetCode4;
-//
-//        case ASSET_TYPE_CREDIT_ALPHANUM12:
-//            AssetCode12 assetCode12;
-//
-//            // add other asset types here in the future
-//        }
-//
-type AllowTrustOpAsset struct {
-	Type        AssetType
-	AssetCode4  *AssetCode4
-	AssetCode12 *AssetCode12
+	return val
 }
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u AllowTrustOpAsset) SwitchFieldName() string {
-	return "Type"
+// GetCreateClaimableBalanceOp retrieves the CreateClaimableBalanceOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetCreateClaimableBalanceOp() (result CreateClaimableBalanceOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "CreateClaimableBalanceOp" {
+		result = *u.CreateClaimableBalanceOp
+		ok = true
+	}
+
+	return
 }
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of AllowTrustOpAsset
-func (u AllowTrustOpAsset) ArmForSwitch(sw int32) (string, bool) {
-	switch AssetType(sw) {
-	case AssetTypeAssetTypeCreditAlphanum4:
-		return "AssetCode4", true
-	case AssetTypeAssetTypeCreditAlphanum12:
-		return "AssetCode12", true
+// MustClaimClaimableBalanceOp retrieves the ClaimClaimableBalanceOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustClaimClaimableBalanceOp() ClaimClaimableBalanceOp {
+	val, ok := u.GetClaimClaimableBalanceOp()
+
+	if !ok {
+		panic("arm ClaimClaimableBalanceOp is not set")
 	}
-	return "-", false
+
+	return val
 }
 
-// NewAllowTrustOpAsset creates a new  AllowTrustOpAsset.
-func NewAllowTrustOpAsset(aType AssetType, value interface{}) (result AllowTrustOpAsset, err error) {
-	result.Type = aType
-	switch AssetType(aType) {
-	case AssetTypeAssetTypeCreditAlphanum4:
-		tv, ok := value.(AssetCode4)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be AssetCode4")
-			return
-		}
-		result.AssetCode4 = &tv
-	case AssetTypeAssetTypeCreditAlphanum12:
-		tv, ok := value.(AssetCode12)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be AssetCode12")
-			return
-		}
-		result.AssetCode12 = &tv
+// GetClaimClaimableBalanceOp retrieves the ClaimClaimableBalanceOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetClaimClaimableBalanceOp() (result ClaimClaimableBalanceOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ClaimClaimableBalanceOp" {
+		result = *u.ClaimClaimableBalanceOp
+		ok = true
 	}
+
 	return
 }
 
-// MustAssetCode4 retrieves the AssetCode4 value from the union,
+// MustBeginSponsoringFutureReservesOp retrieves the BeginSponsoringFutureReservesOp value from the union,
 // panicing if the value is not set.
-func (u AllowTrustOpAsset) MustAssetCode4() AssetCode4 {
-	val, ok := u.GetAssetCode4()
+func (u OperationBody) MustBeginSponsoringFutureReservesOp() BeginSponsoringFutureReservesOp {
+	val, ok := u.GetBeginSponsoringFutureReservesOp()
 
 	if !ok {
-		panic("arm AssetCode4 is not set")
+		panic("arm BeginSponsoringFutureReservesOp is not set")
 	}
 
 	return val
 }
 
-// GetAssetCode4 retrieves the AssetCode4 value from the union,
+// GetBeginSponsoringFutureReservesOp retrieves the BeginSponsoringFutureReservesOp value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u AllowTrustOpAsset) GetAssetCode4() (result AssetCode4, ok bool) {
+func (u OperationBody) GetBeginSponsoringFutureReservesOp() (result BeginSponsoringFutureReservesOp, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "AssetCode4" {
-		result = *u.AssetCode4
+	if armName == "BeginSponsoringFutureReservesOp" {
+		result = *u.BeginSponsoringFutureReservesOp
 		ok = true
 	}
 
 	return
 }
 
-// MustAssetCode12 retrieves the AssetCode12 value from the union,
+// MustRevokeSponsorshipOp retrieves the RevokeSponsorshipOp value from the union,
 // panicing if the value is not set.
-func (u AllowTrustOpAsset) MustAssetCode12() AssetCode12 {
-	val, ok := u.GetAssetCode12()
+func (u OperationBody) MustRevokeSponsorshipOp() RevokeSponsorshipOp {
+	val, ok := u.GetRevokeSponsorshipOp()
 
 	if !ok {
-		panic("arm AssetCode12 is not set")
+		panic("arm RevokeSponsorshipOp is not set")
 	}
 
 	return val
 }
 
-// GetAssetCode12 retrieves the AssetCode12 value from the union,
+// GetRevokeSponsorshipOp retrieves the RevokeSponsorshipOp value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u AllowTrustOpAsset) GetAssetCode12() (result AssetCode12, ok bool) {
+func (u OperationBody) GetRevokeSponsorshipOp() (result RevokeSponsorshipOp, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "AssetCode12" {
-		result = *u.AssetCode12
+	if armName == "RevokeSponsorshipOp" {
+		result = *u.RevokeSponsorshipOp
 		ok = true
 	}
 
@@ -7642,642 +9847,869 @@ func (u AllowTrustOpAsset) GetAssetCode12() (result AssetCode12, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AllowTrustOpAsset) MarshalBinary() ([]byte, error) {
+func (s OperationBody) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *OperationBody) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*OperationBody)(nil)
+	_ encoding.BinaryUnmarshaler = (*OperationBody)(nil)
+)
+
+// Operation is an XDR Struct defines as:
+//
+//   struct Operation
+//    {
+//        // sourceAccount is the account used to run the operation
+//        // if not set, the runtime defaults to "sourceAccount" specified at
+//        // the transaction level
+//        MuxedAccount* sourceAccount;
+//
+//        union switch (OperationType type)
+//        {
+//        case CREATE_ACCOUNT:
+//            CreateAccountOp createAccountOp;
+//        case PAYMENT:
+//            PaymentOp paymentOp;
+//        case PATH_PAYMENT_STRICT_RECEIVE:
+//            PathPaymentStrictReceiveOp pathPaymentStrictReceiveOp;
+//        case MANAGE_SELL_OFFER:
+//            ManageSellOfferOp manageSellOfferOp;
+//        case CREATE_PASSIVE_SELL_OFFER:
+//            CreatePassiveSellOfferOp createPassiveSellOfferOp;
+//        case SET_OPTIONS:
+//            SetOptionsOp setOptionsOp;
+//        case CHANGE_TRUST:
+//            ChangeTrustOp changeTrustOp;
+//        case ALLOW_TRUST:
+//            AllowTrustOp allowTrustOp;
+//        case ACCOUNT_MERGE:
+//            MuxedAccount destination;
+//        case INFLATION:
+//            void;
+//        case MANAGE_DATA:
+//            ManageDataOp manageDataOp;
+//        case BUMP_SEQUENCE:
+//            BumpSequenceOp bumpSequenceOp;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferOp manageBuyOfferOp;
+//        case PATH_PAYMENT_STRICT_SEND:
+//            PathPaymentStrictSendOp pathPaymentStrictSendOp;
+//        case CREATE_CLAIMABLE_BALANCE:
+//            CreateClaimableBalanceOp createClaimableBalanceOp;
+//        case CLAIM_CLAIMABLE_BALANCE:
+//            ClaimClaimableBalanceOp claimClaimableBalanceOp;
+//        case BEGIN_SPONSORING_FUTURE_RESERVES:
+//            BeginSponsoringFutureReservesOp beginSponsoringFutureReservesOp;
+//        case END_SPONSORING_FUTURE_RESERVES:
+//            void;
+//        case REVOKE_SPONSORSHIP:
+//            RevokeSponsorshipOp revokeSponsorshipOp;
+//        }
+//        body;
+//    };
+//
+type Operation struct {
+	SourceAccount *MuxedAccount
+	Body          OperationBody
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Operation) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AllowTrustOpAsset) UnmarshalBinary(inp []byte) error {
+func (s *Operation) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AllowTrustOpAsset)(nil)
-	_ encoding.BinaryUnmarshaler = (*AllowTrustOpAsset)(nil)
+	_ encoding.BinaryMarshaler   = (*Operation)(nil)
+	_ encoding.BinaryUnmarshaler = (*Operation)(nil)
 )
 
-// AllowTrustOp is an XDR Struct defines as:
+// OperationIdId is an XDR NestedStruct defines as:
 //
-//   struct AllowTrustOp
-//    {
-//        AccountID trustor;
-//        union switch (AssetType type)
+//   struct
 //        {
-//        // ASSET_TYPE_NATIVE is not allowed
-//        case ASSET_TYPE_CREDIT_ALPHANUM4:
-//            AssetCode4 assetCode4;
-//
-//        case ASSET_TYPE_CREDIT_ALPHANUM12:
-//            AssetCode12 assetCode12;
-//
-//            // add other asset types here in the future
+//            MuxedAccount sourceAccount;
+//            SequenceNumber seqNum;
+//            uint32 opNum;
 //        }
-//        asset;
-//
-//        // 0, or any bitwise combination of TrustLineFlags
-//        uint32 authorize;
-//    };
 //
-type AllowTrustOp struct {
-	Trustor   AccountId
-	Asset     AllowTrustOpAsset
-	Authorize Uint32
+type OperationIdId struct {
+	SourceAccount MuxedAccount
+	SeqNum        SequenceNumber
+	OpNum         Uint32
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AllowTrustOp) MarshalBinary() ([]byte, error) {
+func (s OperationIdId) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AllowTrustOp) UnmarshalBinary(inp []byte) error {
+func (s *OperationIdId) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AllowTrustOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*AllowTrustOp)(nil)
+	_ encoding.BinaryMarshaler   = (*OperationIdId)(nil)
+	_ encoding.BinaryUnmarshaler = (*OperationIdId)(nil)
 )
 
-// ManageDataOp is an XDR Struct defines as:
+// OperationId is an XDR Union defines as:
 //
-//   struct ManageDataOp
+//   union OperationID switch (EnvelopeType type)
 //    {
-//        string64 dataName;
-//        DataValue* dataValue; // set to null to clear
+//    case ENVELOPE_TYPE_OP_ID:
+//        struct
+//        {
+//            MuxedAccount sourceAccount;
+//            SequenceNumber seqNum;
+//            uint32 opNum;
+//        } id;
 //    };
 //
-type ManageDataOp struct {
-	DataName  String64
-	DataValue *DataValue
+type OperationId struct {
+	Type EnvelopeType
+	Id   *OperationIdId
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u OperationId) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of OperationId
+func (u OperationId) ArmForSwitch(sw int32) (string, bool) {
+	switch EnvelopeType(sw) {
+	case EnvelopeTypeEnvelopeTypeOpId:
+		return "Id", true
+	}
+	return "-", false
+}
+
+// NewOperationId creates a new  OperationId.
+func NewOperationId(aType EnvelopeType, value interface{}) (result OperationId, err error) {
+	result.Type = aType
+	switch EnvelopeType(aType) {
+	case EnvelopeTypeEnvelopeTypeOpId:
+		tv, ok := value.(OperationIdId)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be OperationIdId")
+			return
+		}
+		result.Id = &tv
+	}
+	return
+}
+
+// MustId retrieves the Id value from the union,
+// panicing if the value is not set.
+func (u OperationId) MustId() OperationIdId {
+	val, ok := u.GetId()
+
+	if !ok {
+		panic("arm Id is not set")
+	}
+
+	return val
+}
+
+// GetId retrieves the Id value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationId) GetId() (result OperationIdId, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "Id" {
+		result = *u.Id
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageDataOp) MarshalBinary() ([]byte, error) {
+func (s OperationId) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageDataOp) UnmarshalBinary(inp []byte) error {
+func (s *OperationId) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageDataOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageDataOp)(nil)
+	_ encoding.BinaryMarshaler   = (*OperationId)(nil)
+	_ encoding.BinaryUnmarshaler = (*OperationId)(nil)
 )
 
-// BumpSequenceOp is an XDR Struct defines as:
+// MemoType is an XDR Enum defines as:
 //
-//   struct BumpSequenceOp
+//   enum MemoType
 //    {
-//        SequenceNumber bumpTo;
+//        MEMO_NONE = 0,
+//        MEMO_TEXT = 1,
+//        MEMO_ID = 2,
+//        MEMO_HASH = 3,
+//        MEMO_RETURN = 4
 //    };
 //
-type BumpSequenceOp struct {
-	BumpTo SequenceNumber
+type MemoType int32
+
+const (
+	MemoTypeMemoNone   MemoType = 0
+	MemoTypeMemoText   MemoType = 1
+	MemoTypeMemoId     MemoType = 2
+	MemoTypeMemoHash   MemoType = 3
+	MemoTypeMemoReturn MemoType = 4
+)
+
+var memoTypeMap = map[int32]string{
+	0: "MemoTypeMemoNone",
+	1: "MemoTypeMemoText",
+	2: "MemoTypeMemoId",
+	3: "MemoTypeMemoHash",
+	4: "MemoTypeMemoReturn",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for MemoType
+func (e MemoType) ValidEnum(v int32) bool {
+	_, ok := memoTypeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e MemoType) String() string {
+	name, _ := memoTypeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BumpSequenceOp) MarshalBinary() ([]byte, error) {
+func (s MemoType) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BumpSequenceOp) UnmarshalBinary(inp []byte) error {
+func (s *MemoType) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BumpSequenceOp)(nil)
-	_ encoding.BinaryUnmarshaler = (*BumpSequenceOp)(nil)
+	_ encoding.BinaryMarshaler   = (*MemoType)(nil)
+	_ encoding.BinaryUnmarshaler = (*MemoType)(nil)
 )
 
-// OperationBody is an XDR NestedUnion defines as:
+// Memo is an XDR Union defines as:
 //
-//   union switch (OperationType type)
-//        {
-//        case CREATE_ACCOUNT:
-//            CreateAccountOp createAccountOp;
-//        case PAYMENT:
-//            PaymentOp paymentOp;
-//        case PATH_PAYMENT_STRICT_RECEIVE:
-//            PathPaymentStrictReceiveOp pathPaymentStrictReceiveOp;
-//        case MANAGE_SELL_OFFER:
-//            ManageSellOfferOp manageSellOfferOp;
-//        case CREATE_PASSIVE_SELL_OFFER:
-//            CreatePassiveSellOfferOp createPassiveSellOfferOp;
-//        case SET_OPTIONS:
-//            SetOptionsOp setOptionsOp;
-//        case CHANGE_TRUST:
-//            ChangeTrustOp changeTrustOp;
-//        case ALLOW_TRUST:
-//            AllowTrustOp allowTrustOp;
-//        case ACCOUNT_MERGE:
-//            MuxedAccount destination;
-//        case INFLATION:
-//            void;
-//        case MANAGE_DATA:
-//            ManageDataOp manageDataOp;
-//        case BUMP_SEQUENCE:
-//            BumpSequenceOp bumpSequenceOp;
-//        case MANAGE_BUY_OFFER:
-//            ManageBuyOfferOp manageBuyOfferOp;
-//        case PATH_PAYMENT_STRICT_SEND:
-//            PathPaymentStrictSendOp pathPaymentStrictSendOp;
-//        }
+//   union Memo switch (MemoType type)
+//    {
+//    case MEMO_NONE:
+//        void;
+//    case MEMO_TEXT:
+//        string text<28>;
+//    case MEMO_ID:
+//        uint64 id;
+//    case MEMO_HASH:
+//        Hash hash; // the hash of what to pull from the content server
+//    case MEMO_RETURN:
+//        Hash retHash; // the hash of the tx you are rejecting
+//    };
 //
-type OperationBody struct {
-	Type                       OperationType
-	CreateAccountOp            *CreateAccountOp
-	PaymentOp                  *PaymentOp
-	PathPaymentStrictReceiveOp *PathPaymentStrictReceiveOp
-	ManageSellOfferOp          *ManageSellOfferOp
-	CreatePassiveSellOfferOp   *CreatePassiveSellOfferOp
-	SetOptionsOp               *SetOptionsOp
-	ChangeTrustOp              *ChangeTrustOp
-	AllowTrustOp               *AllowTrustOp
-	Destination                *MuxedAccount
-	ManageDataOp               *ManageDataOp
-	BumpSequenceOp             *BumpSequenceOp
-	ManageBuyOfferOp           *ManageBuyOfferOp
-	PathPaymentStrictSendOp    *PathPaymentStrictSendOp
+type Memo struct {
+	Type    MemoType
+	Text    *string `xdrmaxsize:"28"`
+	Id      *Uint64
+	Hash    *Hash
+	RetHash *Hash
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u OperationBody) SwitchFieldName() string {
+func (u Memo) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of OperationBody
-func (u OperationBody) ArmForSwitch(sw int32) (string, bool) {
-	switch OperationType(sw) {
-	case OperationTypeCreateAccount:
-		return "CreateAccountOp", true
-	case OperationTypePayment:
-		return "PaymentOp", true
-	case OperationTypePathPaymentStrictReceive:
-		return "PathPaymentStrictReceiveOp", true
-	case OperationTypeManageSellOffer:
-		return "ManageSellOfferOp", true
-	case OperationTypeCreatePassiveSellOffer:
-		return "CreatePassiveSellOfferOp", true
-	case OperationTypeSetOptions:
-		return "SetOptionsOp", true
-	case OperationTypeChangeTrust:
-		return "ChangeTrustOp", true
-	case OperationTypeAllowTrust:
-		return "AllowTrustOp", true
-	case OperationTypeAccountMerge:
-		return "Destination", true
-	case OperationTypeInflation:
+// the value for an instance of Memo
+func (u Memo) ArmForSwitch(sw int32) (string, bool) {
+	switch MemoType(sw) {
+	case MemoTypeMemoNone:
 		return "", true
-	case OperationTypeManageData:
-		return "ManageDataOp", true
-	case OperationTypeBumpSequence:
-		return "BumpSequenceOp", true
-	case OperationTypeManageBuyOffer:
-		return "ManageBuyOfferOp", true
-	case OperationTypePathPaymentStrictSend:
-		return "PathPaymentStrictSendOp", true
+	case MemoTypeMemoText:
+		return "Text", true
+	case MemoTypeMemoId:
+		return "Id", true
+	case MemoTypeMemoHash:
+		return "Hash", true
+	case MemoTypeMemoReturn:
+		return "RetHash", true
 	}
 	return "-", false
 }
 
-// NewOperationBody creates a new  OperationBody.
-func NewOperationBody(aType OperationType, value interface{}) (result OperationBody, err error) {
+// NewMemo creates a new  Memo.
+func NewMemo(aType MemoType, value interface{}) (result Memo, err error) {
 	result.Type = aType
-	switch OperationType(aType) {
-	case OperationTypeCreateAccount:
-		tv, ok := value.(CreateAccountOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be CreateAccountOp")
-			return
-		}
-		result.CreateAccountOp = &tv
-	case OperationTypePayment:
-		tv, ok := value.(PaymentOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be PaymentOp")
-			return
-		}
-		result.PaymentOp = &tv
-	case OperationTypePathPaymentStrictReceive:
-		tv, ok := value.(PathPaymentStrictReceiveOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be PathPaymentStrictReceiveOp")
-			return
-		}
-		result.PathPaymentStrictReceiveOp = &tv
-	case OperationTypeManageSellOffer:
-		tv, ok := value.(ManageSellOfferOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be ManageSellOfferOp")
-			return
-		}
-		result.ManageSellOfferOp = &tv
-	case OperationTypeCreatePassiveSellOffer:
-		tv, ok := value.(CreatePassiveSellOfferOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be CreatePassiveSellOfferOp")
-			return
-		}
-		result.CreatePassiveSellOfferOp = &tv
-	case OperationTypeSetOptions:
-		tv, ok := value.(SetOptionsOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be SetOptionsOp")
-			return
-		}
-		result.SetOptionsOp = &tv
-	case OperationTypeChangeTrust:
-		tv, ok := value.(ChangeTrustOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be ChangeTrustOp")
-			return
-		}
-		result.ChangeTrustOp = &tv
-	case OperationTypeAllowTrust:
-		tv, ok := value.(AllowTrustOp)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be AllowTrustOp")
-			return
-		}
-		result.AllowTrustOp = &tv
-	case OperationTypeAccountMerge:
-		tv, ok := value.(MuxedAccount)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be MuxedAccount")
-			return
-		}
-		result.Destination = &tv
-	case OperationTypeInflation:
+	switch MemoType(aType) {
+	case MemoTypeMemoNone:
 		// void
-	case OperationTypeManageData:
-		tv, ok := value.(ManageDataOp)
+	case MemoTypeMemoText:
+		tv, ok := value.(string)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be ManageDataOp")
+			err = fmt.Errorf("invalid value, must be string")
 			return
 		}
-		result.ManageDataOp = &tv
-	case OperationTypeBumpSequence:
-		tv, ok := value.(BumpSequenceOp)
+		result.Text = &tv
+	case MemoTypeMemoId:
+		tv, ok := value.(Uint64)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be BumpSequenceOp")
+			err = fmt.Errorf("invalid value, must be Uint64")
 			return
 		}
-		result.BumpSequenceOp = &tv
-	case OperationTypeManageBuyOffer:
-		tv, ok := value.(ManageBuyOfferOp)
+		result.Id = &tv
+	case MemoTypeMemoHash:
+		tv, ok := value.(Hash)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be ManageBuyOfferOp")
+			err = fmt.Errorf("invalid value, must be Hash")
 			return
 		}
-		result.ManageBuyOfferOp = &tv
-	case OperationTypePathPaymentStrictSend:
-		tv, ok := value.(PathPaymentStrictSendOp)
+		result.Hash = &tv
+	case MemoTypeMemoReturn:
+		tv, ok := value.(Hash)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be PathPaymentStrictSendOp")
+			err = fmt.Errorf("invalid value, must be Hash")
 			return
 		}
-		result.PathPaymentStrictSendOp = &tv
+		result.RetHash = &tv
 	}
 	return
 }
 
-// MustCreateAccountOp retrieves the CreateAccountOp value from the union,
+// MustText retrieves the Text value from the union,
 // panicing if the value is not set.
-func (u OperationBody) MustCreateAccountOp() CreateAccountOp {
-	val, ok := u.GetCreateAccountOp()
+func (u Memo) MustText() string {
+	val, ok := u.GetText()
 
 	if !ok {
-		panic("arm CreateAccountOp is not set")
+		panic("arm Text is not set")
 	}
 
 	return val
 }
 
-// GetCreateAccountOp retrieves the CreateAccountOp value from the union,
+// GetText retrieves the Text value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetCreateAccountOp() (result CreateAccountOp, ok bool) {
+func (u Memo) GetText() (result string, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "CreateAccountOp" {
-		result = *u.CreateAccountOp
+	if armName == "Text" {
+		result = *u.Text
 		ok = true
 	}
 
 	return
 }
 
-// MustPaymentOp retrieves the PaymentOp value from the union,
+// MustId retrieves the Id value from the union,
 // panicing if the value is not set.
-func (u OperationBody) MustPaymentOp() PaymentOp {
-	val, ok := u.GetPaymentOp()
+func (u Memo) MustId() Uint64 {
+	val, ok := u.GetId()
 
 	if !ok {
-		panic("arm PaymentOp is not set")
+		panic("arm Id is not set")
 	}
 
 	return val
 }
 
-// GetPaymentOp retrieves the PaymentOp value from the union,
+// GetId retrieves the Id value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetPaymentOp() (result PaymentOp, ok bool) {
+func (u Memo) GetId() (result Uint64, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "PaymentOp" {
-		result = *u.PaymentOp
+	if armName == "Id" {
+		result = *u.Id
 		ok = true
 	}
 
 	return
 }
 
-// MustPathPaymentStrictReceiveOp retrieves the PathPaymentStrictReceiveOp value from the union,
+// MustHash retrieves the Hash value from the union,
 // panicing if the value is not set.
-func (u OperationBody) MustPathPaymentStrictReceiveOp() PathPaymentStrictReceiveOp {
-	val, ok := u.GetPathPaymentStrictReceiveOp()
+func (u Memo) MustHash() Hash {
+	val, ok := u.GetHash()
 
 	if !ok {
-		panic("arm PathPaymentStrictReceiveOp is not set")
+		panic("arm Hash is not set")
 	}
 
 	return val
 }
 
-// GetPathPaymentStrictReceiveOp retrieves the PathPaymentStrictReceiveOp value from the union,
+// GetHash retrieves the Hash value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetPathPaymentStrictReceiveOp() (result PathPaymentStrictReceiveOp, ok bool) {
+func (u Memo) GetHash() (result Hash, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "PathPaymentStrictReceiveOp" {
-		result = *u.PathPaymentStrictReceiveOp
+	if armName == "Hash" {
+		result = *u.Hash
 		ok = true
 	}
 
 	return
 }
 
-// MustManageSellOfferOp retrieves the ManageSellOfferOp value from the union,
+// MustRetHash retrieves the RetHash value from the union,
 // panicing if the value is not set.
-func (u OperationBody) MustManageSellOfferOp() ManageSellOfferOp {
-	val, ok := u.GetManageSellOfferOp()
+func (u Memo) MustRetHash() Hash {
+	val, ok := u.GetRetHash()
 
 	if !ok {
-		panic("arm ManageSellOfferOp is not set")
+		panic("arm RetHash is not set")
 	}
 
 	return val
 }
 
-// GetManageSellOfferOp retrieves the ManageSellOfferOp value from the union,
+// GetRetHash retrieves the RetHash value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetManageSellOfferOp() (result ManageSellOfferOp, ok bool) {
+func (u Memo) GetRetHash() (result Hash, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "ManageSellOfferOp" {
-		result = *u.ManageSellOfferOp
+	if armName == "RetHash" {
+		result = *u.RetHash
 		ok = true
 	}
 
 	return
 }
 
-// MustCreatePassiveSellOfferOp retrieves the CreatePassiveSellOfferOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustCreatePassiveSellOfferOp() CreatePassiveSellOfferOp {
-	val, ok := u.GetCreatePassiveSellOfferOp()
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Memo) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	if !ok {
-		panic("arm CreatePassiveSellOfferOp is not set")
-	}
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Memo) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	return val
+var (
+	_ encoding.BinaryMarshaler   = (*Memo)(nil)
+	_ encoding.BinaryUnmarshaler = (*Memo)(nil)
+)
+
+// TimeBounds is an XDR Struct defines as:
+//
+//   struct TimeBounds
+//    {
+//        TimePoint minTime;
+//        TimePoint maxTime; // 0 here means no maxTime
+//    };
+//
+type TimeBounds struct {
+	MinTime TimePoint
+	MaxTime TimePoint
 }
 
-// GetCreatePassiveSellOfferOp retrieves the CreatePassiveSellOfferOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetCreatePassiveSellOfferOp() (result CreatePassiveSellOfferOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TimeBounds) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	if armName == "CreatePassiveSellOfferOp" {
-		result = *u.CreatePassiveSellOfferOp
-		ok = true
-	}
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TimeBounds) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	return
+var (
+	_ encoding.BinaryMarshaler   = (*TimeBounds)(nil)
+	_ encoding.BinaryUnmarshaler = (*TimeBounds)(nil)
+)
+
+// MaxOpsPerTx is an XDR Const defines as:
+//
+//   const MAX_OPS_PER_TX = 100;
+//
+const MaxOpsPerTx = 100
+
+// TransactionV0Ext is an XDR NestedUnion defines as:
+//
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//
+type TransactionV0Ext struct {
+	V int32
 }
 
-// MustSetOptionsOp retrieves the SetOptionsOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustSetOptionsOp() SetOptionsOp {
-	val, ok := u.GetSetOptionsOp()
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u TransactionV0Ext) SwitchFieldName() string {
+	return "V"
+}
 
-	if !ok {
-		panic("arm SetOptionsOp is not set")
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of TransactionV0Ext
+func (u TransactionV0Ext) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
 	}
-
-	return val
+	return "-", false
 }
 
-// GetSetOptionsOp retrieves the SetOptionsOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetSetOptionsOp() (result SetOptionsOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "SetOptionsOp" {
-		result = *u.SetOptionsOp
-		ok = true
+// NewTransactionV0Ext creates a new  TransactionV0Ext.
+func NewTransactionV0Ext(v int32, value interface{}) (result TransactionV0Ext, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
 	}
-
 	return
 }
 
-// MustChangeTrustOp retrieves the ChangeTrustOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustChangeTrustOp() ChangeTrustOp {
-	val, ok := u.GetChangeTrustOp()
-
-	if !ok {
-		panic("arm ChangeTrustOp is not set")
-	}
-
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionV0Ext) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetChangeTrustOp retrieves the ChangeTrustOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetChangeTrustOp() (result ChangeTrustOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionV0Ext) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if armName == "ChangeTrustOp" {
-		result = *u.ChangeTrustOp
-		ok = true
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionV0Ext)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionV0Ext)(nil)
+)
 
-	return
+// TransactionV0 is an XDR Struct defines as:
+//
+//   struct TransactionV0
+//    {
+//        uint256 sourceAccountEd25519;
+//        uint32 fee;
+//        SequenceNumber seqNum;
+//        TimeBounds* timeBounds;
+//        Memo memo;
+//        Operation operations<MAX_OPS_PER_TX>;
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
+//    };
+//
+type TransactionV0 struct {
+	SourceAccountEd25519 Uint256
+	Fee                  Uint32
+	SeqNum               SequenceNumber
+	TimeBounds           *TimeBounds
+	Memo                 Memo
+	Operations           []Operation `xdrmaxsize:"100"`
+	Ext                  TransactionV0Ext
 }
 
-// MustAllowTrustOp retrieves the AllowTrustOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustAllowTrustOp() AllowTrustOp {
-	val, ok := u.GetAllowTrustOp()
-
-	if !ok {
-		panic("arm AllowTrustOp is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionV0) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionV0) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetAllowTrustOp retrieves the AllowTrustOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetAllowTrustOp() (result AllowTrustOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionV0)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionV0)(nil)
+)
 
-	if armName == "AllowTrustOp" {
-		result = *u.AllowTrustOp
-		ok = true
-	}
+// TransactionV0Envelope is an XDR Struct defines as:
+//
+//   struct TransactionV0Envelope
+//    {
+//        TransactionV0 tx;
+//        /* Each decorated signature is a signature over the SHA256 hash of
+//         * a TransactionSignaturePayload */
+//        DecoratedSignature signatures<20>;
+//    };
+//
+type TransactionV0Envelope struct {
+	Tx         TransactionV0
+	Signatures []DecoratedSignature `xdrmaxsize:"20"`
+}
 
-	return
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionV0Envelope) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// MustDestination retrieves the Destination value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustDestination() MuxedAccount {
-	val, ok := u.GetDestination()
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionV0Envelope) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if !ok {
-		panic("arm Destination is not set")
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionV0Envelope)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionV0Envelope)(nil)
+)
 
-	return val
+// TransactionExt is an XDR NestedUnion defines as:
+//
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//
+type TransactionExt struct {
+	V int32
 }
 
-// GetDestination retrieves the Destination value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetDestination() (result MuxedAccount, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u TransactionExt) SwitchFieldName() string {
+	return "V"
+}
 
-	if armName == "Destination" {
-		result = *u.Destination
-		ok = true
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of TransactionExt
+func (u TransactionExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
 	}
-
-	return
+	return "-", false
 }
 
-// MustManageDataOp retrieves the ManageDataOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustManageDataOp() ManageDataOp {
-	val, ok := u.GetManageDataOp()
-
-	if !ok {
-		panic("arm ManageDataOp is not set")
+// NewTransactionExt creates a new  TransactionExt.
+func NewTransactionExt(v int32, value interface{}) (result TransactionExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
 	}
+	return
+}
 
-	return val
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionExt) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// GetManageDataOp retrieves the ManageDataOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetManageDataOp() (result ManageDataOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionExt) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if armName == "ManageDataOp" {
-		result = *u.ManageDataOp
-		ok = true
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionExt)(nil)
+)
 
-	return
+// Transaction is an XDR Struct defines as:
+//
+//   struct Transaction
+//    {
+//        // account used to run the transaction
+//        MuxedAccount sourceAccount;
+//
+//        // the fee the sourceAccount will pay
+//        uint32 fee;
+//
+//        // sequence number to consume in the account
+//        SequenceNumber seqNum;
+//
+//        // validity range (inclusive) for the last ledger close time
+//        TimeBounds* timeBounds;
+//
+//        Memo memo;
+//
+//        Operation operations<MAX_OPS_PER_TX>;
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
+//    };
+//
+type Transaction struct {
+	SourceAccount MuxedAccount
+	Fee           Uint32
+	SeqNum        SequenceNumber
+	TimeBounds    *TimeBounds
+	Memo          Memo
+	Operations    []Operation `xdrmaxsize:"100"`
+	Ext           TransactionExt
 }
 
-// MustBumpSequenceOp retrieves the BumpSequenceOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustBumpSequenceOp() BumpSequenceOp {
-	val, ok := u.GetBumpSequenceOp()
-
-	if !ok {
-		panic("arm BumpSequenceOp is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Transaction) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Transaction) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetBumpSequenceOp retrieves the BumpSequenceOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetBumpSequenceOp() (result BumpSequenceOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*Transaction)(nil)
+	_ encoding.BinaryUnmarshaler = (*Transaction)(nil)
+)
 
-	if armName == "BumpSequenceOp" {
-		result = *u.BumpSequenceOp
-		ok = true
-	}
+// TransactionV1Envelope is an XDR Struct defines as:
+//
+//   struct TransactionV1Envelope
+//    {
+//        Transaction tx;
+//        /* Each decorated signature is a signature over the SHA256 hash of
+//         * a TransactionSignaturePayload */
+//        DecoratedSignature signatures<20>;
+//    };
+//
+type TransactionV1Envelope struct {
+	Tx         Transaction
+	Signatures []DecoratedSignature `xdrmaxsize:"20"`
+}
 
-	return
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionV1Envelope) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// MustManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
-// panicing if the value is not set.
-func (u OperationBody) MustManageBuyOfferOp() ManageBuyOfferOp {
-	val, ok := u.GetManageBuyOfferOp()
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionV1Envelope) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
 
-	if !ok {
-		panic("arm ManageBuyOfferOp is not set")
-	}
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionV1Envelope)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionV1Envelope)(nil)
+)
 
-	return val
+// FeeBumpTransactionInnerTx is an XDR NestedUnion defines as:
+//
+//   union switch (EnvelopeType type)
+//        {
+//        case ENVELOPE_TYPE_TX:
+//            TransactionV1Envelope v1;
+//        }
+//
+type FeeBumpTransactionInnerTx struct {
+	Type EnvelopeType
+	V1   *TransactionV1Envelope
 }
 
-// GetManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetManageBuyOfferOp() (result ManageBuyOfferOp, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u FeeBumpTransactionInnerTx) SwitchFieldName() string {
+	return "Type"
+}
 
-	if armName == "ManageBuyOfferOp" {
-		result = *u.ManageBuyOfferOp
-		ok = true
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of FeeBumpTransactionInnerTx
+func (u FeeBumpTransactionInnerTx) ArmForSwitch(sw int32) (string, bool) {
+	switch EnvelopeType(sw) {
+	case EnvelopeTypeEnvelopeTypeTx:
+		return "V1", true
 	}
+	return "-", false
+}
 
+// NewFeeBumpTransactionInnerTx creates a new  FeeBumpTransactionInnerTx.
+func NewFeeBumpTransactionInnerTx(aType EnvelopeType, value interface{}) (result FeeBumpTransactionInnerTx, err error) {
+	result.Type = aType
+	switch EnvelopeType(aType) {
+	case EnvelopeTypeEnvelopeTypeTx:
+		tv, ok := value.(TransactionV1Envelope)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be TransactionV1Envelope")
+			return
+		}
+		result.V1 = &tv
+	}
 	return
 }
 
-// MustPathPaymentStrictSendOp retrieves the PathPaymentStrictSendOp value from the union,
+// MustV1 retrieves the V1 value from the union,
 // panicing if the value is not set.
-func (u OperationBody) MustPathPaymentStrictSendOp() PathPaymentStrictSendOp {
-	val, ok := u.GetPathPaymentStrictSendOp()
+func (u FeeBumpTransactionInnerTx) MustV1() TransactionV1Envelope {
+	val, ok := u.GetV1()
 
 	if !ok {
-		panic("arm PathPaymentStrictSendOp is not set")
+		panic("arm V1 is not set")
 	}
 
 	return val
 }
 
-// GetPathPaymentStrictSendOp retrieves the PathPaymentStrictSendOp value from the union,
+// GetV1 retrieves the V1 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u OperationBody) GetPathPaymentStrictSendOp() (result PathPaymentStrictSendOp, ok bool) {
+func (u FeeBumpTransactionInnerTx) GetV1() (result TransactionV1Envelope, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "PathPaymentStrictSendOp" {
-		result = *u.PathPaymentStrictSendOp
+	if armName == "V1" {
+		result = *u.V1
 		ok = true
 	}
 
@@ -8285,329 +10717,419 @@ func (u OperationBody) GetPathPaymentStrictSendOp() (result PathPaymentStrictSen
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s OperationBody) MarshalBinary() ([]byte, error) {
+func (s FeeBumpTransactionInnerTx) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *OperationBody) UnmarshalBinary(inp []byte) error {
+func (s *FeeBumpTransactionInnerTx) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*OperationBody)(nil)
-	_ encoding.BinaryUnmarshaler = (*OperationBody)(nil)
+	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionInnerTx)(nil)
+	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionInnerTx)(nil)
 )
 
-// Operation is an XDR Struct defines as:
-//
-//   struct Operation
-//    {
-//        // sourceAccount is the account used to run the operation
-//        // if not set, the runtime defaults to "sourceAccount" specified at
-//        // the transaction level
-//        MuxedAccount* sourceAccount;
+// FeeBumpTransactionExt is an XDR NestedUnion defines as:
 //
-//        union switch (OperationType type)
+//   union switch (int v)
 //        {
-//        case CREATE_ACCOUNT:
-//            CreateAccountOp createAccountOp;
-//        case PAYMENT:
-//            PaymentOp paymentOp;
-//        case PATH_PAYMENT_STRICT_RECEIVE:
-//            PathPaymentStrictReceiveOp pathPaymentStrictReceiveOp;
-//        case MANAGE_SELL_OFFER:
-//            ManageSellOfferOp manageSellOfferOp;
-//        case CREATE_PASSIVE_SELL_OFFER:
-//            CreatePassiveSellOfferOp createPassiveSellOfferOp;
-//        case SET_OPTIONS:
-//            SetOptionsOp setOptionsOp;
-//        case CHANGE_TRUST:
-//            ChangeTrustOp changeTrustOp;
-//        case ALLOW_TRUST:
-//            AllowTrustOp allowTrustOp;
-//        case ACCOUNT_MERGE:
-//            MuxedAccount destination;
-//        case INFLATION:
+//        case 0:
 //            void;
-//        case MANAGE_DATA:
-//            ManageDataOp manageDataOp;
-//        case BUMP_SEQUENCE:
-//            BumpSequenceOp bumpSequenceOp;
-//        case MANAGE_BUY_OFFER:
-//            ManageBuyOfferOp manageBuyOfferOp;
-//        case PATH_PAYMENT_STRICT_SEND:
-//            PathPaymentStrictSendOp pathPaymentStrictSendOp;
 //        }
-//        body;
-//    };
 //
-type Operation struct {
-	SourceAccount *MuxedAccount
-	Body          OperationBody
+type FeeBumpTransactionExt struct {
+	V int32
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u FeeBumpTransactionExt) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of FeeBumpTransactionExt
+func (u FeeBumpTransactionExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
+	}
+	return "-", false
+}
+
+// NewFeeBumpTransactionExt creates a new  FeeBumpTransactionExt.
+func NewFeeBumpTransactionExt(v int32, value interface{}) (result FeeBumpTransactionExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	}
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s Operation) MarshalBinary() ([]byte, error) {
+func (s FeeBumpTransactionExt) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Operation) UnmarshalBinary(inp []byte) error {
+func (s *FeeBumpTransactionExt) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*Operation)(nil)
-	_ encoding.BinaryUnmarshaler = (*Operation)(nil)
+	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionExt)(nil)
 )
 
-// MemoType is an XDR Enum defines as:
+// FeeBumpTransaction is an XDR Struct defines as:
 //
-//   enum MemoType
+//   struct FeeBumpTransaction
 //    {
-//        MEMO_NONE = 0,
-//        MEMO_TEXT = 1,
-//        MEMO_ID = 2,
-//        MEMO_HASH = 3,
-//        MEMO_RETURN = 4
+//        MuxedAccount feeSource;
+//        int64 fee;
+//        union switch (EnvelopeType type)
+//        {
+//        case ENVELOPE_TYPE_TX:
+//            TransactionV1Envelope v1;
+//        }
+//        innerTx;
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
 //    };
 //
-type MemoType int32
-
-const (
-	MemoTypeMemoNone   MemoType = 0
-	MemoTypeMemoText   MemoType = 1
-	MemoTypeMemoId     MemoType = 2
-	MemoTypeMemoHash   MemoType = 3
-	MemoTypeMemoReturn MemoType = 4
-)
-
-var memoTypeMap = map[int32]string{
-	0: "MemoTypeMemoNone",
-	1: "MemoTypeMemoText",
-	2: "MemoTypeMemoId",
-	3: "MemoTypeMemoHash",
-	4: "MemoTypeMemoReturn",
+type FeeBumpTransaction struct {
+	FeeSource MuxedAccount
+	Fee       Int64
+	InnerTx   FeeBumpTransactionInnerTx
+	Ext       FeeBumpTransactionExt
 }
 
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for MemoType
-func (e MemoType) ValidEnum(v int32) bool {
-	_, ok := memoTypeMap[v]
-	return ok
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s FeeBumpTransaction) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
 }
 
-// String returns the name of `e`
-func (e MemoType) String() string {
-	name, _ := memoTypeMap[int32(e)]
-	return name
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *FeeBumpTransaction) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*FeeBumpTransaction)(nil)
+	_ encoding.BinaryUnmarshaler = (*FeeBumpTransaction)(nil)
+)
+
+// FeeBumpTransactionEnvelope is an XDR Struct defines as:
+//
+//   struct FeeBumpTransactionEnvelope
+//    {
+//        FeeBumpTransaction tx;
+//        /* Each decorated signature is a signature over the SHA256 hash of
+//         * a TransactionSignaturePayload */
+//        DecoratedSignature signatures<20>;
+//    };
+//
+type FeeBumpTransactionEnvelope struct {
+	Tx         FeeBumpTransaction
+	Signatures []DecoratedSignature `xdrmaxsize:"20"`
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s MemoType) MarshalBinary() ([]byte, error) {
+func (s FeeBumpTransactionEnvelope) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *MemoType) UnmarshalBinary(inp []byte) error {
+func (s *FeeBumpTransactionEnvelope) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*MemoType)(nil)
-	_ encoding.BinaryUnmarshaler = (*MemoType)(nil)
+	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionEnvelope)(nil)
+	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionEnvelope)(nil)
 )
 
-// Memo is an XDR Union defines as:
+// TransactionEnvelope is an XDR Union defines as:
 //
-//   union Memo switch (MemoType type)
+//   union TransactionEnvelope switch (EnvelopeType type)
 //    {
-//    case MEMO_NONE:
-//        void;
-//    case MEMO_TEXT:
-//        string text<28>;
-//    case MEMO_ID:
-//        uint64 id;
-//    case MEMO_HASH:
-//        Hash hash; // the hash of what to pull from the content server
-//    case MEMO_RETURN:
-//        Hash retHash; // the hash of the tx you are rejecting
+//    case ENVELOPE_TYPE_TX_V0:
+//        TransactionV0Envelope v0;
+//    case ENVELOPE_TYPE_TX:
+//        TransactionV1Envelope v1;
+//    case ENVELOPE_TYPE_TX_FEE_BUMP:
+//        FeeBumpTransactionEnvelope feeBump;
 //    };
 //
-type Memo struct {
-	Type    MemoType
-	Text    *string `xdrmaxsize:"28"`
-	Id      *Uint64
-	Hash    *Hash
-	RetHash *Hash
+type TransactionEnvelope struct {
+	Type    EnvelopeType
+	V0      *TransactionV0Envelope
+	V1      *TransactionV1Envelope
+	FeeBump *FeeBumpTransactionEnvelope
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u Memo) SwitchFieldName() string {
+func (u TransactionEnvelope) SwitchFieldName() string {
 	return "Type"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of Memo
-func (u Memo) ArmForSwitch(sw int32) (string, bool) {
-	switch MemoType(sw) {
-	case MemoTypeMemoNone:
-		return "", true
-	case MemoTypeMemoText:
-		return "Text", true
-	case MemoTypeMemoId:
-		return "Id", true
-	case MemoTypeMemoHash:
-		return "Hash", true
-	case MemoTypeMemoReturn:
-		return "RetHash", true
+// the value for an instance of TransactionEnvelope
+func (u TransactionEnvelope) ArmForSwitch(sw int32) (string, bool) {
+	switch EnvelopeType(sw) {
+	case EnvelopeTypeEnvelopeTypeTxV0:
+		return "V0", true
+	case EnvelopeTypeEnvelopeTypeTx:
+		return "V1", true
+	case EnvelopeTypeEnvelopeTypeTxFeeBump:
+		return "FeeBump", true
 	}
 	return "-", false
 }
 
-// NewMemo creates a new  Memo.
-func NewMemo(aType MemoType, value interface{}) (result Memo, err error) {
+// NewTransactionEnvelope creates a new  TransactionEnvelope.
+func NewTransactionEnvelope(aType EnvelopeType, value interface{}) (result TransactionEnvelope, err error) {
 	result.Type = aType
-	switch MemoType(aType) {
-	case MemoTypeMemoNone:
-		// void
-	case MemoTypeMemoText:
-		tv, ok := value.(string)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be string")
-			return
-		}
-		result.Text = &tv
-	case MemoTypeMemoId:
-		tv, ok := value.(Uint64)
+	switch EnvelopeType(aType) {
+	case EnvelopeTypeEnvelopeTypeTxV0:
+		tv, ok := value.(TransactionV0Envelope)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Uint64")
+			err = fmt.Errorf("invalid value, must be TransactionV0Envelope")
 			return
 		}
-		result.Id = &tv
-	case MemoTypeMemoHash:
-		tv, ok := value.(Hash)
+		result.V0 = &tv
+	case EnvelopeTypeEnvelopeTypeTx:
+		tv, ok := value.(TransactionV1Envelope)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Hash")
+			err = fmt.Errorf("invalid value, must be TransactionV1Envelope")
 			return
 		}
-		result.Hash = &tv
-	case MemoTypeMemoReturn:
-		tv, ok := value.(Hash)
+		result.V1 = &tv
+	case EnvelopeTypeEnvelopeTypeTxFeeBump:
+		tv, ok := value.(FeeBumpTransactionEnvelope)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Hash")
+			err = fmt.Errorf("invalid value, must be FeeBumpTransactionEnvelope")
 			return
 		}
-		result.RetHash = &tv
+		result.FeeBump = &tv
 	}
 	return
 }
 
-// MustText retrieves the Text value from the union,
+// MustV0 retrieves the V0 value from the union,
 // panicing if the value is not set.
-func (u Memo) MustText() string {
-	val, ok := u.GetText()
+func (u TransactionEnvelope) MustV0() TransactionV0Envelope {
+	val, ok := u.GetV0()
 
 	if !ok {
-		panic("arm Text is not set")
+		panic("arm V0 is not set")
 	}
 
 	return val
 }
 
-// GetText retrieves the Text value from the union,
+// GetV0 retrieves the V0 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u Memo) GetText() (result string, ok bool) {
+func (u TransactionEnvelope) GetV0() (result TransactionV0Envelope, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Text" {
-		result = *u.Text
+	if armName == "V0" {
+		result = *u.V0
 		ok = true
 	}
 
 	return
 }
 
-// MustId retrieves the Id value from the union,
+// MustV1 retrieves the V1 value from the union,
 // panicing if the value is not set.
-func (u Memo) MustId() Uint64 {
-	val, ok := u.GetId()
+func (u TransactionEnvelope) MustV1() TransactionV1Envelope {
+	val, ok := u.GetV1()
 
 	if !ok {
-		panic("arm Id is not set")
+		panic("arm V1 is not set")
 	}
 
 	return val
 }
 
-// GetId retrieves the Id value from the union,
+// GetV1 retrieves the V1 value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u Memo) GetId() (result Uint64, ok bool) {
+func (u TransactionEnvelope) GetV1() (result TransactionV1Envelope, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Id" {
-		result = *u.Id
+	if armName == "V1" {
+		result = *u.V1
+		ok = true
+	}
+
+	return
+}
+
+// MustFeeBump retrieves the FeeBump value from the union,
+// panicing if the value is not set.
+func (u TransactionEnvelope) MustFeeBump() FeeBumpTransactionEnvelope {
+	val, ok := u.GetFeeBump()
+
+	if !ok {
+		panic("arm FeeBump is not set")
+	}
+
+	return val
+}
+
+// GetFeeBump retrieves the FeeBump value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u TransactionEnvelope) GetFeeBump() (result FeeBumpTransactionEnvelope, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "FeeBump" {
+		result = *u.FeeBump
 		ok = true
 	}
 
 	return
 }
 
-// MustHash retrieves the Hash value from the union,
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s TransactionEnvelope) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *TransactionEnvelope) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*TransactionEnvelope)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionEnvelope)(nil)
+)
+
+// TransactionSignaturePayloadTaggedTransaction is an XDR NestedUnion defines as:
+//
+//   union switch (EnvelopeType type)
+//        {
+//        // Backwards Compatibility: Use ENVELOPE_TYPE_TX to sign ENVELOPE_TYPE_TX_V0
+//        case ENVELOPE_TYPE_TX:
+//            Transaction tx;
+//        case ENVELOPE_TYPE_TX_FEE_BUMP:
+//            FeeBumpTransaction feeBump;
+//        }
+//
+type TransactionSignaturePayloadTaggedTransaction struct {
+	Type    EnvelopeType
+	Tx      *Transaction
+	FeeBump *FeeBumpTransaction
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u TransactionSignaturePayloadTaggedTransaction) SwitchFieldName() string {
+	return "Type"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of TransactionSignaturePayloadTaggedTransaction
+func (u TransactionSignaturePayloadTaggedTransaction) ArmForSwitch(sw int32) (string, bool) {
+	switch EnvelopeType(sw) {
+	case EnvelopeTypeEnvelopeTypeTx:
+		return "Tx", true
+	case EnvelopeTypeEnvelopeTypeTxFeeBump:
+		return "FeeBump", true
+	}
+	return "-", false
+}
+
+// NewTransactionSignaturePayloadTaggedTransaction creates a new  TransactionSignaturePayloadTaggedTransaction.
+func NewTransactionSignaturePayloadTaggedTransaction(aType EnvelopeType, value interface{}) (result TransactionSignaturePayloadTaggedTransaction, err error) {
+	result.Type = aType
+	switch EnvelopeType(aType) {
+	case EnvelopeTypeEnvelopeTypeTx:
+		tv, ok := value.(Transaction)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be Transaction")
+			return
+		}
+		result.Tx = &tv
+	case EnvelopeTypeEnvelopeTypeTxFeeBump:
+		tv, ok := value.(FeeBumpTransaction)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be FeeBumpTransaction")
+			return
+		}
+		result.FeeBump = &tv
+	}
+	return
+}
+
+// MustTx retrieves the Tx value from the union,
 // panicing if the value is not set.
-func (u Memo) MustHash() Hash {
-	val, ok := u.GetHash()
+func (u TransactionSignaturePayloadTaggedTransaction) MustTx() Transaction {
+	val, ok := u.GetTx()
 
 	if !ok {
-		panic("arm Hash is not set")
+		panic("arm Tx is not set")
 	}
 
 	return val
 }
 
-// GetHash retrieves the Hash value from the union,
+// GetTx retrieves the Tx value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u Memo) GetHash() (result Hash, ok bool) {
+func (u TransactionSignaturePayloadTaggedTransaction) GetTx() (result Transaction, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "Hash" {
-		result = *u.Hash
+	if armName == "Tx" {
+		result = *u.Tx
 		ok = true
 	}
 
 	return
 }
 
-// MustRetHash retrieves the RetHash value from the union,
+// MustFeeBump retrieves the FeeBump value from the union,
 // panicing if the value is not set.
-func (u Memo) MustRetHash() Hash {
-	val, ok := u.GetRetHash()
+func (u TransactionSignaturePayloadTaggedTransaction) MustFeeBump() FeeBumpTransaction {
+	val, ok := u.GetFeeBump()
 
 	if !ok {
-		panic("arm RetHash is not set")
+		panic("arm FeeBump is not set")
 	}
 
 	return val
 }
 
-// GetRetHash retrieves the RetHash value from the union,
+// GetFeeBump retrieves the FeeBump value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u Memo) GetRetHash() (result Hash, ok bool) {
+func (u TransactionSignaturePayloadTaggedTransaction) GetFeeBump() (result FeeBumpTransaction, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Type))
 
-	if armName == "RetHash" {
-		result = *u.RetHash
+	if armName == "FeeBump" {
+		result = *u.FeeBump
 		ok = true
 	}
 
@@ -8615,824 +11137,898 @@ func (u Memo) GetRetHash() (result Hash, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s Memo) MarshalBinary() ([]byte, error) {
+func (s TransactionSignaturePayloadTaggedTransaction) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Memo) UnmarshalBinary(inp []byte) error {
+func (s *TransactionSignaturePayloadTaggedTransaction) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*Memo)(nil)
-	_ encoding.BinaryUnmarshaler = (*Memo)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionSignaturePayloadTaggedTransaction)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionSignaturePayloadTaggedTransaction)(nil)
 )
 
-// TimeBounds is an XDR Struct defines as:
+// TransactionSignaturePayload is an XDR Struct defines as:
 //
-//   struct TimeBounds
+//   struct TransactionSignaturePayload
 //    {
-//        TimePoint minTime;
-//        TimePoint maxTime; // 0 here means no maxTime
+//        Hash networkId;
+//        union switch (EnvelopeType type)
+//        {
+//        // Backwards Compatibility: Use ENVELOPE_TYPE_TX to sign ENVELOPE_TYPE_TX_V0
+//        case ENVELOPE_TYPE_TX:
+//            Transaction tx;
+//        case ENVELOPE_TYPE_TX_FEE_BUMP:
+//            FeeBumpTransaction feeBump;
+//        }
+//        taggedTransaction;
 //    };
 //
-type TimeBounds struct {
-	MinTime TimePoint
-	MaxTime TimePoint
+type TransactionSignaturePayload struct {
+	NetworkId         Hash
+	TaggedTransaction TransactionSignaturePayloadTaggedTransaction
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TimeBounds) MarshalBinary() ([]byte, error) {
+func (s TransactionSignaturePayload) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TimeBounds) UnmarshalBinary(inp []byte) error {
+func (s *TransactionSignaturePayload) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TimeBounds)(nil)
-	_ encoding.BinaryUnmarshaler = (*TimeBounds)(nil)
+	_ encoding.BinaryMarshaler   = (*TransactionSignaturePayload)(nil)
+	_ encoding.BinaryUnmarshaler = (*TransactionSignaturePayload)(nil)
 )
 
-// MaxOpsPerTx is an XDR Const defines as:
+// ClaimOfferAtom is an XDR Struct defines as:
 //
-//   const MAX_OPS_PER_TX = 100;
+//   struct ClaimOfferAtom
+//    {
+//        // emitted to identify the offer
+//        AccountID sellerID; // Account that owns the offer
+//        int64 offerID;
 //
-const MaxOpsPerTx = 100
-
-// TransactionV0Ext is an XDR NestedUnion defines as:
+//        // amount and asset taken from the owner
+//        Asset assetSold;
+//        int64 amountSold;
 //
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
+//        // amount and asset sent to the owner
+//        Asset assetBought;
+//        int64 amountBought;
+//    };
 //
-type TransactionV0Ext struct {
-	V int32
-}
-
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u TransactionV0Ext) SwitchFieldName() string {
-	return "V"
-}
-
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionV0Ext
-func (u TransactionV0Ext) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
-	}
-	return "-", false
-}
-
-// NewTransactionV0Ext creates a new  TransactionV0Ext.
-func NewTransactionV0Ext(v int32, value interface{}) (result TransactionV0Ext, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
-	}
-	return
+type ClaimOfferAtom struct {
+	SellerId     AccountId
+	OfferId      Int64
+	AssetSold    Asset
+	AmountSold   Int64
+	AssetBought  Asset
+	AmountBought Int64
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionV0Ext) MarshalBinary() ([]byte, error) {
+func (s ClaimOfferAtom) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionV0Ext) UnmarshalBinary(inp []byte) error {
+func (s *ClaimOfferAtom) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionV0Ext)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionV0Ext)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimOfferAtom)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimOfferAtom)(nil)
 )
 
-// TransactionV0 is an XDR Struct defines as:
+// CreateAccountResultCode is an XDR Enum defines as:
 //
-//   struct TransactionV0
+//   enum CreateAccountResultCode
 //    {
-//        uint256 sourceAccountEd25519;
-//        uint32 fee;
-//        SequenceNumber seqNum;
-//        TimeBounds* timeBounds;
-//        Memo memo;
-//        Operation operations<MAX_OPS_PER_TX>;
-//        union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//        ext;
+//        // codes considered as "success" for the operation
+//        CREATE_ACCOUNT_SUCCESS = 0, // account was created
+//
+//        // codes considered as "failure" for the operation
+//        CREATE_ACCOUNT_MALFORMED = -1,   // invalid destination
+//        CREATE_ACCOUNT_UNDERFUNDED = -2, // not enough funds in source account
+//        CREATE_ACCOUNT_LOW_RESERVE =
+//            -3, // would create an account below the min reserve
+//        CREATE_ACCOUNT_ALREADY_EXIST = -4 // account already exists
 //    };
 //
-type TransactionV0 struct {
-	SourceAccountEd25519 Uint256
-	Fee                  Uint32
-	SeqNum               SequenceNumber
-	TimeBounds           *TimeBounds
-	Memo                 Memo
-	Operations           []Operation `xdrmaxsize:"100"`
-	Ext                  TransactionV0Ext
-}
+type CreateAccountResultCode int32
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionV0) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+const (
+	CreateAccountResultCodeCreateAccountSuccess      CreateAccountResultCode = 0
+	CreateAccountResultCodeCreateAccountMalformed    CreateAccountResultCode = -1
+	CreateAccountResultCodeCreateAccountUnderfunded  CreateAccountResultCode = -2
+	CreateAccountResultCodeCreateAccountLowReserve   CreateAccountResultCode = -3
+	CreateAccountResultCodeCreateAccountAlreadyExist CreateAccountResultCode = -4
+)
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionV0) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+var createAccountResultCodeMap = map[int32]string{
+	0:  "CreateAccountResultCodeCreateAccountSuccess",
+	-1: "CreateAccountResultCodeCreateAccountMalformed",
+	-2: "CreateAccountResultCodeCreateAccountUnderfunded",
+	-3: "CreateAccountResultCodeCreateAccountLowReserve",
+	-4: "CreateAccountResultCodeCreateAccountAlreadyExist",
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionV0)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionV0)(nil)
-)
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for CreateAccountResultCode
+func (e CreateAccountResultCode) ValidEnum(v int32) bool {
+	_, ok := createAccountResultCodeMap[v]
+	return ok
+}
 
-// TransactionV0Envelope is an XDR Struct defines as:
-//
-//   struct TransactionV0Envelope
-//    {
-//        TransactionV0 tx;
-//        /* Each decorated signature is a signature over the SHA256 hash of
-//         * a TransactionSignaturePayload */
-//        DecoratedSignature signatures<20>;
-//    };
-//
-type TransactionV0Envelope struct {
-	Tx         TransactionV0
-	Signatures []DecoratedSignature `xdrmaxsize:"20"`
+// String returns the name of `e`
+func (e CreateAccountResultCode) String() string {
+	name, _ := createAccountResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionV0Envelope) MarshalBinary() ([]byte, error) {
+func (s CreateAccountResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionV0Envelope) UnmarshalBinary(inp []byte) error {
+func (s *CreateAccountResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionV0Envelope)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionV0Envelope)(nil)
+	_ encoding.BinaryMarshaler   = (*CreateAccountResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateAccountResultCode)(nil)
 )
 
-// TransactionExt is an XDR NestedUnion defines as:
-//
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
+// CreateAccountResult is an XDR Union defines as:
 //
-type TransactionExt struct {
-	V int32
+//   union CreateAccountResult switch (CreateAccountResultCode code)
+//    {
+//    case CREATE_ACCOUNT_SUCCESS:
+//        void;
+//    default:
+//        void;
+//    };
+//
+type CreateAccountResult struct {
+	Code CreateAccountResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u TransactionExt) SwitchFieldName() string {
-	return "V"
+func (u CreateAccountResult) SwitchFieldName() string {
+	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionExt
-func (u TransactionExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
+// the value for an instance of CreateAccountResult
+func (u CreateAccountResult) ArmForSwitch(sw int32) (string, bool) {
+	switch CreateAccountResultCode(sw) {
+	case CreateAccountResultCodeCreateAccountSuccess:
+		return "", true
+	default:
 		return "", true
 	}
-	return "-", false
 }
 
-// NewTransactionExt creates a new  TransactionExt.
-func NewTransactionExt(v int32, value interface{}) (result TransactionExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
+// NewCreateAccountResult creates a new  CreateAccountResult.
+func NewCreateAccountResult(code CreateAccountResultCode, value interface{}) (result CreateAccountResult, err error) {
+	result.Code = code
+	switch CreateAccountResultCode(code) {
+	case CreateAccountResultCodeCreateAccountSuccess:
+		// void
+	default:
 		// void
 	}
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionExt) MarshalBinary() ([]byte, error) {
+func (s CreateAccountResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionExt) UnmarshalBinary(inp []byte) error {
+func (s *CreateAccountResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionExt)(nil)
+	_ encoding.BinaryMarshaler   = (*CreateAccountResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateAccountResult)(nil)
 )
 
-// Transaction is an XDR Struct defines as:
+// PaymentResultCode is an XDR Enum defines as:
 //
-//   struct Transaction
+//   enum PaymentResultCode
 //    {
-//        // account used to run the transaction
-//        MuxedAccount sourceAccount;
-//
-//        // the fee the sourceAccount will pay
-//        uint32 fee;
-//
-//        // sequence number to consume in the account
-//        SequenceNumber seqNum;
-//
-//        // validity range (inclusive) for the last ledger close time
-//        TimeBounds* timeBounds;
-//
-//        Memo memo;
-//
-//        Operation operations<MAX_OPS_PER_TX>;
+//        // codes considered as "success" for the operation
+//        PAYMENT_SUCCESS = 0, // payment successfuly completed
 //
-//        // reserved for future use
-//        union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//        ext;
+//        // codes considered as "failure" for the operation
+//        PAYMENT_MALFORMED = -1,          // bad input
+//        PAYMENT_UNDERFUNDED = -2,        // not enough funds in source account
+//        PAYMENT_SRC_NO_TRUST = -3,       // no trust line on source account
+//        PAYMENT_SRC_NOT_AUTHORIZED = -4, // source not authorized to transfer
+//        PAYMENT_NO_DESTINATION = -5,     // destination account does not exist
+//        PAYMENT_NO_TRUST = -6,       // destination missing a trust line for asset
+//        PAYMENT_NOT_AUTHORIZED = -7, // destination not authorized to hold asset
+//        PAYMENT_LINE_FULL = -8,      // destination would go above their limit
+//        PAYMENT_NO_ISSUER = -9       // missing issuer on asset
 //    };
 //
-type Transaction struct {
-	SourceAccount MuxedAccount
-	Fee           Uint32
-	SeqNum        SequenceNumber
-	TimeBounds    *TimeBounds
-	Memo          Memo
-	Operations    []Operation `xdrmaxsize:"100"`
-	Ext           TransactionExt
-}
+type PaymentResultCode int32
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s Transaction) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
+const (
+	PaymentResultCodePaymentSuccess          PaymentResultCode = 0
+	PaymentResultCodePaymentMalformed        PaymentResultCode = -1
+	PaymentResultCodePaymentUnderfunded      PaymentResultCode = -2
+	PaymentResultCodePaymentSrcNoTrust       PaymentResultCode = -3
+	PaymentResultCodePaymentSrcNotAuthorized PaymentResultCode = -4
+	PaymentResultCodePaymentNoDestination    PaymentResultCode = -5
+	PaymentResultCodePaymentNoTrust          PaymentResultCode = -6
+	PaymentResultCodePaymentNotAuthorized    PaymentResultCode = -7
+	PaymentResultCodePaymentLineFull         PaymentResultCode = -8
+	PaymentResultCodePaymentNoIssuer         PaymentResultCode = -9
+)
 
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *Transaction) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+var paymentResultCodeMap = map[int32]string{
+	0:  "PaymentResultCodePaymentSuccess",
+	-1: "PaymentResultCodePaymentMalformed",
+	-2: "PaymentResultCodePaymentUnderfunded",
+	-3: "PaymentResultCodePaymentSrcNoTrust",
+	-4: "PaymentResultCodePaymentSrcNotAuthorized",
+	-5: "PaymentResultCodePaymentNoDestination",
+	-6: "PaymentResultCodePaymentNoTrust",
+	-7: "PaymentResultCodePaymentNotAuthorized",
+	-8: "PaymentResultCodePaymentLineFull",
+	-9: "PaymentResultCodePaymentNoIssuer",
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*Transaction)(nil)
-	_ encoding.BinaryUnmarshaler = (*Transaction)(nil)
-)
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for PaymentResultCode
+func (e PaymentResultCode) ValidEnum(v int32) bool {
+	_, ok := paymentResultCodeMap[v]
+	return ok
+}
 
-// TransactionV1Envelope is an XDR Struct defines as:
-//
-//   struct TransactionV1Envelope
-//    {
-//        Transaction tx;
-//        /* Each decorated signature is a signature over the SHA256 hash of
-//         * a TransactionSignaturePayload */
-//        DecoratedSignature signatures<20>;
-//    };
-//
-type TransactionV1Envelope struct {
-	Tx         Transaction
-	Signatures []DecoratedSignature `xdrmaxsize:"20"`
+// String returns the name of `e`
+func (e PaymentResultCode) String() string {
+	name, _ := paymentResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionV1Envelope) MarshalBinary() ([]byte, error) {
+func (s PaymentResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionV1Envelope) UnmarshalBinary(inp []byte) error {
+func (s *PaymentResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionV1Envelope)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionV1Envelope)(nil)
+	_ encoding.BinaryMarshaler   = (*PaymentResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*PaymentResultCode)(nil)
 )
 
-// FeeBumpTransactionInnerTx is an XDR NestedUnion defines as:
+// PaymentResult is an XDR Union defines as:
 //
-//   union switch (EnvelopeType type)
-//        {
-//        case ENVELOPE_TYPE_TX:
-//            TransactionV1Envelope v1;
-//        }
+//   union PaymentResult switch (PaymentResultCode code)
+//    {
+//    case PAYMENT_SUCCESS:
+//        void;
+//    default:
+//        void;
+//    };
 //
-type FeeBumpTransactionInnerTx struct {
-	Type EnvelopeType
-	V1   *TransactionV1Envelope
+type PaymentResult struct {
+	Code PaymentResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u FeeBumpTransactionInnerTx) SwitchFieldName() string {
-	return "Type"
+func (u PaymentResult) SwitchFieldName() string {
+	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of FeeBumpTransactionInnerTx
-func (u FeeBumpTransactionInnerTx) ArmForSwitch(sw int32) (string, bool) {
-	switch EnvelopeType(sw) {
-	case EnvelopeTypeEnvelopeTypeTx:
-		return "V1", true
-	}
-	return "-", false
-}
-
-// NewFeeBumpTransactionInnerTx creates a new  FeeBumpTransactionInnerTx.
-func NewFeeBumpTransactionInnerTx(aType EnvelopeType, value interface{}) (result FeeBumpTransactionInnerTx, err error) {
-	result.Type = aType
-	switch EnvelopeType(aType) {
-	case EnvelopeTypeEnvelopeTypeTx:
-		tv, ok := value.(TransactionV1Envelope)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionV1Envelope")
-			return
-		}
-		result.V1 = &tv
-	}
-	return
-}
-
-// MustV1 retrieves the V1 value from the union,
-// panicing if the value is not set.
-func (u FeeBumpTransactionInnerTx) MustV1() TransactionV1Envelope {
-	val, ok := u.GetV1()
-
-	if !ok {
-		panic("arm V1 is not set")
+// the value for an instance of PaymentResult
+func (u PaymentResult) ArmForSwitch(sw int32) (string, bool) {
+	switch PaymentResultCode(sw) {
+	case PaymentResultCodePaymentSuccess:
+		return "", true
+	default:
+		return "", true
 	}
-
-	return val
 }
 
-// GetV1 retrieves the V1 value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u FeeBumpTransactionInnerTx) GetV1() (result TransactionV1Envelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
-
-	if armName == "V1" {
-		result = *u.V1
-		ok = true
+// NewPaymentResult creates a new  PaymentResult.
+func NewPaymentResult(code PaymentResultCode, value interface{}) (result PaymentResult, err error) {
+	result.Code = code
+	switch PaymentResultCode(code) {
+	case PaymentResultCodePaymentSuccess:
+		// void
+	default:
+		// void
 	}
-
 	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s FeeBumpTransactionInnerTx) MarshalBinary() ([]byte, error) {
+func (s PaymentResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *FeeBumpTransactionInnerTx) UnmarshalBinary(inp []byte) error {
+func (s *PaymentResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionInnerTx)(nil)
-	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionInnerTx)(nil)
+	_ encoding.BinaryMarshaler   = (*PaymentResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*PaymentResult)(nil)
 )
 
-// FeeBumpTransactionExt is an XDR NestedUnion defines as:
+// PathPaymentStrictReceiveResultCode is an XDR Enum defines as:
+//
+//   enum PathPaymentStrictReceiveResultCode
+//    {
+//        // codes considered as "success" for the operation
+//        PATH_PAYMENT_STRICT_RECEIVE_SUCCESS = 0, // success
 //
-//   union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
+//        // codes considered as "failure" for the operation
+//        PATH_PAYMENT_STRICT_RECEIVE_MALFORMED = -1, // bad input
+//        PATH_PAYMENT_STRICT_RECEIVE_UNDERFUNDED =
+//            -2, // not enough funds in source account
+//        PATH_PAYMENT_STRICT_RECEIVE_SRC_NO_TRUST =
+//            -3, // no trust line on source account
+//        PATH_PAYMENT_STRICT_RECEIVE_SRC_NOT_AUTHORIZED =
+//            -4, // source not authorized to transfer
+//        PATH_PAYMENT_STRICT_RECEIVE_NO_DESTINATION =
+//            -5, // destination account does not exist
+//        PATH_PAYMENT_STRICT_RECEIVE_NO_TRUST =
+//            -6, // dest missing a trust line for asset
+//        PATH_PAYMENT_STRICT_RECEIVE_NOT_AUTHORIZED =
+//            -7, // dest not authorized to hold asset
+//        PATH_PAYMENT_STRICT_RECEIVE_LINE_FULL =
+//            -8, // dest would go above their limit
+//        PATH_PAYMENT_STRICT_RECEIVE_NO_ISSUER = -9, // missing issuer on one asset
+//        PATH_PAYMENT_STRICT_RECEIVE_TOO_FEW_OFFERS =
+//            -10, // not enough offers to satisfy path
+//        PATH_PAYMENT_STRICT_RECEIVE_OFFER_CROSS_SELF =
+//            -11, // would cross one of its own offers
+//        PATH_PAYMENT_STRICT_RECEIVE_OVER_SENDMAX = -12 // could not satisfy sendmax
+//    };
 //
-type FeeBumpTransactionExt struct {
-	V int32
-}
+type PathPaymentStrictReceiveResultCode int32
 
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u FeeBumpTransactionExt) SwitchFieldName() string {
-	return "V"
+const (
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess          PathPaymentStrictReceiveResultCode = 0
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveMalformed        PathPaymentStrictReceiveResultCode = -1
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveUnderfunded      PathPaymentStrictReceiveResultCode = -2
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNoTrust       PathPaymentStrictReceiveResultCode = -3
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNotAuthorized PathPaymentStrictReceiveResultCode = -4
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoDestination    PathPaymentStrictReceiveResultCode = -5
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoTrust          PathPaymentStrictReceiveResultCode = -6
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNotAuthorized    PathPaymentStrictReceiveResultCode = -7
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveLineFull         PathPaymentStrictReceiveResultCode = -8
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer         PathPaymentStrictReceiveResultCode = -9
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveTooFewOffers     PathPaymentStrictReceiveResultCode = -10
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOfferCrossSelf   PathPaymentStrictReceiveResultCode = -11
+	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOverSendmax      PathPaymentStrictReceiveResultCode = -12
+)
+
+var pathPaymentStrictReceiveResultCodeMap = map[int32]string{
+	0:   "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess",
+	-1:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveMalformed",
+	-2:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveUnderfunded",
+	-3:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNoTrust",
+	-4:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNotAuthorized",
+	-5:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoDestination",
+	-6:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoTrust",
+	-7:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNotAuthorized",
+	-8:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveLineFull",
+	-9:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer",
+	-10: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveTooFewOffers",
+	-11: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOfferCrossSelf",
+	-12: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOverSendmax",
 }
 
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of FeeBumpTransactionExt
-func (u FeeBumpTransactionExt) ArmForSwitch(sw int32) (string, bool) {
-	switch int32(sw) {
-	case 0:
-		return "", true
-	}
-	return "-", false
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for PathPaymentStrictReceiveResultCode
+func (e PathPaymentStrictReceiveResultCode) ValidEnum(v int32) bool {
+	_, ok := pathPaymentStrictReceiveResultCodeMap[v]
+	return ok
 }
 
-// NewFeeBumpTransactionExt creates a new  FeeBumpTransactionExt.
-func NewFeeBumpTransactionExt(v int32, value interface{}) (result FeeBumpTransactionExt, err error) {
-	result.V = v
-	switch int32(v) {
-	case 0:
-		// void
-	}
-	return
+// String returns the name of `e`
+func (e PathPaymentStrictReceiveResultCode) String() string {
+	name, _ := pathPaymentStrictReceiveResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s FeeBumpTransactionExt) MarshalBinary() ([]byte, error) {
+func (s PathPaymentStrictReceiveResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *FeeBumpTransactionExt) UnmarshalBinary(inp []byte) error {
+func (s *PathPaymentStrictReceiveResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionExt)(nil)
-	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionExt)(nil)
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResultCode)(nil)
 )
 
-// FeeBumpTransaction is an XDR Struct defines as:
+// SimplePaymentResult is an XDR Struct defines as:
 //
-//   struct FeeBumpTransaction
+//   struct SimplePaymentResult
 //    {
-//        MuxedAccount feeSource;
-//        int64 fee;
-//        union switch (EnvelopeType type)
-//        {
-//        case ENVELOPE_TYPE_TX:
-//            TransactionV1Envelope v1;
-//        }
-//        innerTx;
-//        union switch (int v)
-//        {
-//        case 0:
-//            void;
-//        }
-//        ext;
+//        AccountID destination;
+//        Asset asset;
+//        int64 amount;
 //    };
 //
-type FeeBumpTransaction struct {
-	FeeSource MuxedAccount
-	Fee       Int64
-	InnerTx   FeeBumpTransactionInnerTx
-	Ext       FeeBumpTransactionExt
+type SimplePaymentResult struct {
+	Destination AccountId
+	Asset       Asset
+	Amount      Int64
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s FeeBumpTransaction) MarshalBinary() ([]byte, error) {
+func (s SimplePaymentResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *FeeBumpTransaction) UnmarshalBinary(inp []byte) error {
+func (s *SimplePaymentResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*FeeBumpTransaction)(nil)
-	_ encoding.BinaryUnmarshaler = (*FeeBumpTransaction)(nil)
+	_ encoding.BinaryMarshaler   = (*SimplePaymentResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*SimplePaymentResult)(nil)
 )
 
-// FeeBumpTransactionEnvelope is an XDR Struct defines as:
+// PathPaymentStrictReceiveResultSuccess is an XDR NestedStruct defines as:
 //
-//   struct FeeBumpTransactionEnvelope
-//    {
-//        FeeBumpTransaction tx;
-//        /* Each decorated signature is a signature over the SHA256 hash of
-//         * a TransactionSignaturePayload */
-//        DecoratedSignature signatures<20>;
-//    };
+//   struct
+//        {
+//            ClaimOfferAtom offers<>;
+//            SimplePaymentResult last;
+//        }
 //
-type FeeBumpTransactionEnvelope struct {
-	Tx         FeeBumpTransaction
-	Signatures []DecoratedSignature `xdrmaxsize:"20"`
+type PathPaymentStrictReceiveResultSuccess struct {
+	Offers []ClaimOfferAtom
+	Last   SimplePaymentResult
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s FeeBumpTransactionEnvelope) MarshalBinary() ([]byte, error) {
+func (s PathPaymentStrictReceiveResultSuccess) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *FeeBumpTransactionEnvelope) UnmarshalBinary(inp []byte) error {
+func (s *PathPaymentStrictReceiveResultSuccess) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*FeeBumpTransactionEnvelope)(nil)
-	_ encoding.BinaryUnmarshaler = (*FeeBumpTransactionEnvelope)(nil)
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResultSuccess)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResultSuccess)(nil)
 )
 
-// TransactionEnvelope is an XDR Union defines as:
+// PathPaymentStrictReceiveResult is an XDR Union defines as:
 //
-//   union TransactionEnvelope switch (EnvelopeType type)
+//   union PathPaymentStrictReceiveResult switch (PathPaymentStrictReceiveResultCode code)
 //    {
-//    case ENVELOPE_TYPE_TX_V0:
-//        TransactionV0Envelope v0;
-//    case ENVELOPE_TYPE_TX:
-//        TransactionV1Envelope v1;
-//    case ENVELOPE_TYPE_TX_FEE_BUMP:
-//        FeeBumpTransactionEnvelope feeBump;
+//    case PATH_PAYMENT_STRICT_RECEIVE_SUCCESS:
+//        struct
+//        {
+//            ClaimOfferAtom offers<>;
+//            SimplePaymentResult last;
+//        } success;
+//    case PATH_PAYMENT_STRICT_RECEIVE_NO_ISSUER:
+//        Asset noIssuer; // the asset that caused the error
+//    default:
+//        void;
 //    };
 //
-type TransactionEnvelope struct {
-	Type    EnvelopeType
-	V0      *TransactionV0Envelope
-	V1      *TransactionV1Envelope
-	FeeBump *FeeBumpTransactionEnvelope
+type PathPaymentStrictReceiveResult struct {
+	Code     PathPaymentStrictReceiveResultCode
+	Success  *PathPaymentStrictReceiveResultSuccess
+	NoIssuer *Asset
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u TransactionEnvelope) SwitchFieldName() string {
-	return "Type"
+func (u PathPaymentStrictReceiveResult) SwitchFieldName() string {
+	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionEnvelope
-func (u TransactionEnvelope) ArmForSwitch(sw int32) (string, bool) {
-	switch EnvelopeType(sw) {
-	case EnvelopeTypeEnvelopeTypeTxV0:
-		return "V0", true
-	case EnvelopeTypeEnvelopeTypeTx:
-		return "V1", true
-	case EnvelopeTypeEnvelopeTypeTxFeeBump:
-		return "FeeBump", true
+// the value for an instance of PathPaymentStrictReceiveResult
+func (u PathPaymentStrictReceiveResult) ArmForSwitch(sw int32) (string, bool) {
+	switch PathPaymentStrictReceiveResultCode(sw) {
+	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess:
+		return "Success", true
+	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer:
+		return "NoIssuer", true
+	default:
+		return "", true
 	}
-	return "-", false
 }
 
-// NewTransactionEnvelope creates a new  TransactionEnvelope.
-func NewTransactionEnvelope(aType EnvelopeType, value interface{}) (result TransactionEnvelope, err error) {
-	result.Type = aType
-	switch EnvelopeType(aType) {
-	case EnvelopeTypeEnvelopeTypeTxV0:
-		tv, ok := value.(TransactionV0Envelope)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionV0Envelope")
-			return
-		}
-		result.V0 = &tv
-	case EnvelopeTypeEnvelopeTypeTx:
-		tv, ok := value.(TransactionV1Envelope)
+// NewPathPaymentStrictReceiveResult creates a new  PathPaymentStrictReceiveResult.
+func NewPathPaymentStrictReceiveResult(code PathPaymentStrictReceiveResultCode, value interface{}) (result PathPaymentStrictReceiveResult, err error) {
+	result.Code = code
+	switch PathPaymentStrictReceiveResultCode(code) {
+	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess:
+		tv, ok := value.(PathPaymentStrictReceiveResultSuccess)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be TransactionV1Envelope")
+			err = fmt.Errorf("invalid value, must be PathPaymentStrictReceiveResultSuccess")
 			return
 		}
-		result.V1 = &tv
-	case EnvelopeTypeEnvelopeTypeTxFeeBump:
-		tv, ok := value.(FeeBumpTransactionEnvelope)
+		result.Success = &tv
+	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer:
+		tv, ok := value.(Asset)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be FeeBumpTransactionEnvelope")
+			err = fmt.Errorf("invalid value, must be Asset")
 			return
 		}
-		result.FeeBump = &tv
+		result.NoIssuer = &tv
+	default:
+		// void
 	}
 	return
 }
 
-// MustV0 retrieves the V0 value from the union,
+// MustSuccess retrieves the Success value from the union,
 // panicing if the value is not set.
-func (u TransactionEnvelope) MustV0() TransactionV0Envelope {
-	val, ok := u.GetV0()
+func (u PathPaymentStrictReceiveResult) MustSuccess() PathPaymentStrictReceiveResultSuccess {
+	val, ok := u.GetSuccess()
 
 	if !ok {
-		panic("arm V0 is not set")
+		panic("arm Success is not set")
 	}
 
 	return val
 }
 
-// GetV0 retrieves the V0 value from the union,
+// GetSuccess retrieves the Success value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u TransactionEnvelope) GetV0() (result TransactionV0Envelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+func (u PathPaymentStrictReceiveResult) GetSuccess() (result PathPaymentStrictReceiveResultSuccess, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "V0" {
-		result = *u.V0
+	if armName == "Success" {
+		result = *u.Success
 		ok = true
 	}
 
 	return
 }
 
-// MustV1 retrieves the V1 value from the union,
+// MustNoIssuer retrieves the NoIssuer value from the union,
 // panicing if the value is not set.
-func (u TransactionEnvelope) MustV1() TransactionV1Envelope {
-	val, ok := u.GetV1()
+func (u PathPaymentStrictReceiveResult) MustNoIssuer() Asset {
+	val, ok := u.GetNoIssuer()
 
 	if !ok {
-		panic("arm V1 is not set")
+		panic("arm NoIssuer is not set")
 	}
 
 	return val
 }
 
-// GetV1 retrieves the V1 value from the union,
+// GetNoIssuer retrieves the NoIssuer value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u TransactionEnvelope) GetV1() (result TransactionV1Envelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+func (u PathPaymentStrictReceiveResult) GetNoIssuer() (result Asset, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "V1" {
-		result = *u.V1
+	if armName == "NoIssuer" {
+		result = *u.NoIssuer
 		ok = true
 	}
 
 	return
 }
 
-// MustFeeBump retrieves the FeeBump value from the union,
-// panicing if the value is not set.
-func (u TransactionEnvelope) MustFeeBump() FeeBumpTransactionEnvelope {
-	val, ok := u.GetFeeBump()
-
-	if !ok {
-		panic("arm FeeBump is not set")
-	}
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PathPaymentStrictReceiveResult) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
 
-	return val
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PathPaymentStrictReceiveResult) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
 }
 
-// GetFeeBump retrieves the FeeBump value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u TransactionEnvelope) GetFeeBump() (result FeeBumpTransactionEnvelope, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+var (
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResult)(nil)
+)
 
-	if armName == "FeeBump" {
-		result = *u.FeeBump
-		ok = true
-	}
+// PathPaymentStrictSendResultCode is an XDR Enum defines as:
+//
+//   enum PathPaymentStrictSendResultCode
+//    {
+//        // codes considered as "success" for the operation
+//        PATH_PAYMENT_STRICT_SEND_SUCCESS = 0, // success
+//
+//        // codes considered as "failure" for the operation
+//        PATH_PAYMENT_STRICT_SEND_MALFORMED = -1, // bad input
+//        PATH_PAYMENT_STRICT_SEND_UNDERFUNDED =
+//            -2, // not enough funds in source account
+//        PATH_PAYMENT_STRICT_SEND_SRC_NO_TRUST =
+//            -3, // no trust line on source account
+//        PATH_PAYMENT_STRICT_SEND_SRC_NOT_AUTHORIZED =
+//            -4, // source not authorized to transfer
+//        PATH_PAYMENT_STRICT_SEND_NO_DESTINATION =
+//            -5, // destination account does not exist
+//        PATH_PAYMENT_STRICT_SEND_NO_TRUST =
+//            -6, // dest missing a trust line for asset
+//        PATH_PAYMENT_STRICT_SEND_NOT_AUTHORIZED =
+//            -7, // dest not authorized to hold asset
+//        PATH_PAYMENT_STRICT_SEND_LINE_FULL = -8, // dest would go above their limit
+//        PATH_PAYMENT_STRICT_SEND_NO_ISSUER = -9, // missing issuer on one asset
+//        PATH_PAYMENT_STRICT_SEND_TOO_FEW_OFFERS =
+//            -10, // not enough offers to satisfy path
+//        PATH_PAYMENT_STRICT_SEND_OFFER_CROSS_SELF =
+//            -11, // would cross one of its own offers
+//        PATH_PAYMENT_STRICT_SEND_UNDER_DESTMIN = -12 // could not satisfy destMin
+//    };
+//
+type PathPaymentStrictSendResultCode int32
 
-	return
+const (
+	PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess          PathPaymentStrictSendResultCode = 0
+	PathPaymentStrictSendResultCodePathPaymentStrictSendMalformed        PathPaymentStrictSendResultCode = -1
+	PathPaymentStrictSendResultCodePathPaymentStrictSendUnderfunded      PathPaymentStrictSendResultCode = -2
+	PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNoTrust       PathPaymentStrictSendResultCode = -3
+	PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNotAuthorized PathPaymentStrictSendResultCode = -4
+	PathPaymentStrictSendResultCodePathPaymentStrictSendNoDestination    PathPaymentStrictSendResultCode = -5
+	PathPaymentStrictSendResultCodePathPaymentStrictSendNoTrust          PathPaymentStrictSendResultCode = -6
+	PathPaymentStrictSendResultCodePathPaymentStrictSendNotAuthorized    PathPaymentStrictSendResultCode = -7
+	PathPaymentStrictSendResultCodePathPaymentStrictSendLineFull         PathPaymentStrictSendResultCode = -8
+	PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer         PathPaymentStrictSendResultCode = -9
+	PathPaymentStrictSendResultCodePathPaymentStrictSendTooFewOffers     PathPaymentStrictSendResultCode = -10
+	PathPaymentStrictSendResultCodePathPaymentStrictSendOfferCrossSelf   PathPaymentStrictSendResultCode = -11
+	PathPaymentStrictSendResultCodePathPaymentStrictSendUnderDestmin     PathPaymentStrictSendResultCode = -12
+)
+
+var pathPaymentStrictSendResultCodeMap = map[int32]string{
+	0:   "PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess",
+	-1:  "PathPaymentStrictSendResultCodePathPaymentStrictSendMalformed",
+	-2:  "PathPaymentStrictSendResultCodePathPaymentStrictSendUnderfunded",
+	-3:  "PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNoTrust",
+	-4:  "PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNotAuthorized",
+	-5:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoDestination",
+	-6:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoTrust",
+	-7:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNotAuthorized",
+	-8:  "PathPaymentStrictSendResultCodePathPaymentStrictSendLineFull",
+	-9:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer",
+	-10: "PathPaymentStrictSendResultCodePathPaymentStrictSendTooFewOffers",
+	-11: "PathPaymentStrictSendResultCodePathPaymentStrictSendOfferCrossSelf",
+	-12: "PathPaymentStrictSendResultCodePathPaymentStrictSendUnderDestmin",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for PathPaymentStrictSendResultCode
+func (e PathPaymentStrictSendResultCode) ValidEnum(v int32) bool {
+	_, ok := pathPaymentStrictSendResultCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e PathPaymentStrictSendResultCode) String() string {
+	name, _ := pathPaymentStrictSendResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionEnvelope) MarshalBinary() ([]byte, error) {
+func (s PathPaymentStrictSendResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionEnvelope) UnmarshalBinary(inp []byte) error {
+func (s *PathPaymentStrictSendResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*TransactionEnvelope)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionEnvelope)(nil)
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResultCode)(nil)
 )
 
-// TransactionSignaturePayloadTaggedTransaction is an XDR NestedUnion defines as:
+// PathPaymentStrictSendResultSuccess is an XDR NestedStruct defines as:
 //
-//   union switch (EnvelopeType type)
+//   struct
 //        {
-//        // Backwards Compatibility: Use ENVELOPE_TYPE_TX to sign ENVELOPE_TYPE_TX_V0
-//        case ENVELOPE_TYPE_TX:
-//            Transaction tx;
-//        case ENVELOPE_TYPE_TX_FEE_BUMP:
-//            FeeBumpTransaction feeBump;
+//            ClaimOfferAtom offers<>;
+//            SimplePaymentResult last;
 //        }
 //
-type TransactionSignaturePayloadTaggedTransaction struct {
-	Type    EnvelopeType
-	Tx      *Transaction
-	FeeBump *FeeBumpTransaction
+type PathPaymentStrictSendResultSuccess struct {
+	Offers []ClaimOfferAtom
+	Last   SimplePaymentResult
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s PathPaymentStrictSendResultSuccess) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *PathPaymentStrictSendResultSuccess) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResultSuccess)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResultSuccess)(nil)
+)
+
+// PathPaymentStrictSendResult is an XDR Union defines as:
+//
+//   union PathPaymentStrictSendResult switch (PathPaymentStrictSendResultCode code)
+//    {
+//    case PATH_PAYMENT_STRICT_SEND_SUCCESS:
+//        struct
+//        {
+//            ClaimOfferAtom offers<>;
+//            SimplePaymentResult last;
+//        } success;
+//    case PATH_PAYMENT_STRICT_SEND_NO_ISSUER:
+//        Asset noIssuer; // the asset that caused the error
+//    default:
+//        void;
+//    };
+//
+type PathPaymentStrictSendResult struct {
+	Code     PathPaymentStrictSendResultCode
+	Success  *PathPaymentStrictSendResultSuccess
+	NoIssuer *Asset
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u TransactionSignaturePayloadTaggedTransaction) SwitchFieldName() string {
-	return "Type"
+func (u PathPaymentStrictSendResult) SwitchFieldName() string {
+	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of TransactionSignaturePayloadTaggedTransaction
-func (u TransactionSignaturePayloadTaggedTransaction) ArmForSwitch(sw int32) (string, bool) {
-	switch EnvelopeType(sw) {
-	case EnvelopeTypeEnvelopeTypeTx:
-		return "Tx", true
-	case EnvelopeTypeEnvelopeTypeTxFeeBump:
-		return "FeeBump", true
+// the value for an instance of PathPaymentStrictSendResult
+func (u PathPaymentStrictSendResult) ArmForSwitch(sw int32) (string, bool) {
+	switch PathPaymentStrictSendResultCode(sw) {
+	case PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess:
+		return "Success", true
+	case PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer:
+		return "NoIssuer", true
+	default:
+		return "", true
 	}
-	return "-", false
 }
 
-// NewTransactionSignaturePayloadTaggedTransaction creates a new  TransactionSignaturePayloadTaggedTransaction.
-func NewTransactionSignaturePayloadTaggedTransaction(aType EnvelopeType, value interface{}) (result TransactionSignaturePayloadTaggedTransaction, err error) {
-	result.Type = aType
-	switch EnvelopeType(aType) {
-	case EnvelopeTypeEnvelopeTypeTx:
-		tv, ok := value.(Transaction)
+// NewPathPaymentStrictSendResult creates a new  PathPaymentStrictSendResult.
+func NewPathPaymentStrictSendResult(code PathPaymentStrictSendResultCode, value interface{}) (result PathPaymentStrictSendResult, err error) {
+	result.Code = code
+	switch PathPaymentStrictSendResultCode(code) {
+	case PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess:
+		tv, ok := value.(PathPaymentStrictSendResultSuccess)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Transaction")
+			err = fmt.Errorf("invalid value, must be PathPaymentStrictSendResultSuccess")
 			return
 		}
-		result.Tx = &tv
-	case EnvelopeTypeEnvelopeTypeTxFeeBump:
-		tv, ok := value.(FeeBumpTransaction)
+		result.Success = &tv
+	case PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer:
+		tv, ok := value.(Asset)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be FeeBumpTransaction")
+			err = fmt.Errorf("invalid value, must be Asset")
 			return
 		}
-		result.FeeBump = &tv
+		result.NoIssuer = &tv
+	default:
+		// void
 	}
 	return
 }
 
-// MustTx retrieves the Tx value from the union,
-// panicing if the value is not set.
-func (u TransactionSignaturePayloadTaggedTransaction) MustTx() Transaction {
-	val, ok := u.GetTx()
+// MustSuccess retrieves the Success value from the union,
+// panicing if the value is not set.
+func (u PathPaymentStrictSendResult) MustSuccess() PathPaymentStrictSendResultSuccess {
+	val, ok := u.GetSuccess()
 
 	if !ok {
-		panic("arm Tx is not set")
+		panic("arm Success is not set")
 	}
 
 	return val
 }
 
-// GetTx retrieves the Tx value from the union,
+// GetSuccess retrieves the Success value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u TransactionSignaturePayloadTaggedTransaction) GetTx() (result Transaction, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+func (u PathPaymentStrictSendResult) GetSuccess() (result PathPaymentStrictSendResultSuccess, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "Tx" {
-		result = *u.Tx
+	if armName == "Success" {
+		result = *u.Success
 		ok = true
 	}
 
 	return
 }
 
-// MustFeeBump retrieves the FeeBump value from the union,
+// MustNoIssuer retrieves the NoIssuer value from the union,
 // panicing if the value is not set.
-func (u TransactionSignaturePayloadTaggedTransaction) MustFeeBump() FeeBumpTransaction {
-	val, ok := u.GetFeeBump()
+func (u PathPaymentStrictSendResult) MustNoIssuer() Asset {
+	val, ok := u.GetNoIssuer()
 
 	if !ok {
-		panic("arm FeeBump is not set")
+		panic("arm NoIssuer is not set")
 	}
 
 	return val
 }
 
-// GetFeeBump retrieves the FeeBump value from the union,
+// GetNoIssuer retrieves the NoIssuer value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u TransactionSignaturePayloadTaggedTransaction) GetFeeBump() (result FeeBumpTransaction, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Type))
+func (u PathPaymentStrictSendResult) GetNoIssuer() (result Asset, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "FeeBump" {
-		result = *u.FeeBump
+	if armName == "NoIssuer" {
+		result = *u.NoIssuer
 		ok = true
 	}
 
@@ -9440,589 +12036,357 @@ func (u TransactionSignaturePayloadTaggedTransaction) GetFeeBump() (result FeeBu
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionSignaturePayloadTaggedTransaction) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionSignaturePayloadTaggedTransaction) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionSignaturePayloadTaggedTransaction)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionSignaturePayloadTaggedTransaction)(nil)
-)
-
-// TransactionSignaturePayload is an XDR Struct defines as:
-//
-//   struct TransactionSignaturePayload
-//    {
-//        Hash networkId;
-//        union switch (EnvelopeType type)
-//        {
-//        // Backwards Compatibility: Use ENVELOPE_TYPE_TX to sign ENVELOPE_TYPE_TX_V0
-//        case ENVELOPE_TYPE_TX:
-//            Transaction tx;
-//        case ENVELOPE_TYPE_TX_FEE_BUMP:
-//            FeeBumpTransaction feeBump;
-//        }
-//        taggedTransaction;
-//    };
-//
-type TransactionSignaturePayload struct {
-	NetworkId         Hash
-	TaggedTransaction TransactionSignaturePayloadTaggedTransaction
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s TransactionSignaturePayload) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *TransactionSignaturePayload) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*TransactionSignaturePayload)(nil)
-	_ encoding.BinaryUnmarshaler = (*TransactionSignaturePayload)(nil)
-)
-
-// ClaimOfferAtom is an XDR Struct defines as:
-//
-//   struct ClaimOfferAtom
-//    {
-//        // emitted to identify the offer
-//        AccountID sellerID; // Account that owns the offer
-//        int64 offerID;
-//
-//        // amount and asset taken from the owner
-//        Asset assetSold;
-//        int64 amountSold;
-//
-//        // amount and asset sent to the owner
-//        Asset assetBought;
-//        int64 amountBought;
-//    };
-//
-type ClaimOfferAtom struct {
-	SellerId     AccountId
-	OfferId      Int64
-	AssetSold    Asset
-	AmountSold   Int64
-	AssetBought  Asset
-	AmountBought Int64
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s ClaimOfferAtom) MarshalBinary() ([]byte, error) {
+func (s PathPaymentStrictSendResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ClaimOfferAtom) UnmarshalBinary(inp []byte) error {
+func (s *PathPaymentStrictSendResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ClaimOfferAtom)(nil)
-	_ encoding.BinaryUnmarshaler = (*ClaimOfferAtom)(nil)
+	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResult)(nil)
 )
 
-// CreateAccountResultCode is an XDR Enum defines as:
+// ManageSellOfferResultCode is an XDR Enum defines as:
 //
-//   enum CreateAccountResultCode
+//   enum ManageSellOfferResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        CREATE_ACCOUNT_SUCCESS = 0, // account was created
+//        MANAGE_SELL_OFFER_SUCCESS = 0,
 //
 //        // codes considered as "failure" for the operation
-//        CREATE_ACCOUNT_MALFORMED = -1,   // invalid destination
-//        CREATE_ACCOUNT_UNDERFUNDED = -2, // not enough funds in source account
-//        CREATE_ACCOUNT_LOW_RESERVE =
-//            -3, // would create an account below the min reserve
-//        CREATE_ACCOUNT_ALREADY_EXIST = -4 // account already exists
+//        MANAGE_SELL_OFFER_MALFORMED = -1, // generated offer would be invalid
+//        MANAGE_SELL_OFFER_SELL_NO_TRUST =
+//            -2,                              // no trust line for what we're selling
+//        MANAGE_SELL_OFFER_BUY_NO_TRUST = -3, // no trust line for what we're buying
+//        MANAGE_SELL_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
+//        MANAGE_SELL_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
+//        MANAGE_SELL_OFFER_LINE_FULL = -6, // can't receive more of what it's buying
+//        MANAGE_SELL_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
+//        MANAGE_SELL_OFFER_CROSS_SELF =
+//            -8, // would cross an offer from the same user
+//        MANAGE_SELL_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
+//        MANAGE_SELL_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
+//
+//        // update errors
+//        MANAGE_SELL_OFFER_NOT_FOUND =
+//            -11, // offerID does not match an existing offer
+//
+//        MANAGE_SELL_OFFER_LOW_RESERVE =
+//            -12 // not enough funds to create a new Offer
 //    };
 //
-type CreateAccountResultCode int32
+type ManageSellOfferResultCode int32
 
 const (
-	CreateAccountResultCodeCreateAccountSuccess      CreateAccountResultCode = 0
-	CreateAccountResultCodeCreateAccountMalformed    CreateAccountResultCode = -1
-	CreateAccountResultCodeCreateAccountUnderfunded  CreateAccountResultCode = -2
-	CreateAccountResultCodeCreateAccountLowReserve   CreateAccountResultCode = -3
-	CreateAccountResultCodeCreateAccountAlreadyExist CreateAccountResultCode = -4
+	ManageSellOfferResultCodeManageSellOfferSuccess           ManageSellOfferResultCode = 0
+	ManageSellOfferResultCodeManageSellOfferMalformed         ManageSellOfferResultCode = -1
+	ManageSellOfferResultCodeManageSellOfferSellNoTrust       ManageSellOfferResultCode = -2
+	ManageSellOfferResultCodeManageSellOfferBuyNoTrust        ManageSellOfferResultCode = -3
+	ManageSellOfferResultCodeManageSellOfferSellNotAuthorized ManageSellOfferResultCode = -4
+	ManageSellOfferResultCodeManageSellOfferBuyNotAuthorized  ManageSellOfferResultCode = -5
+	ManageSellOfferResultCodeManageSellOfferLineFull          ManageSellOfferResultCode = -6
+	ManageSellOfferResultCodeManageSellOfferUnderfunded       ManageSellOfferResultCode = -7
+	ManageSellOfferResultCodeManageSellOfferCrossSelf         ManageSellOfferResultCode = -8
+	ManageSellOfferResultCodeManageSellOfferSellNoIssuer      ManageSellOfferResultCode = -9
+	ManageSellOfferResultCodeManageSellOfferBuyNoIssuer       ManageSellOfferResultCode = -10
+	ManageSellOfferResultCodeManageSellOfferNotFound          ManageSellOfferResultCode = -11
+	ManageSellOfferResultCodeManageSellOfferLowReserve        ManageSellOfferResultCode = -12
 )
 
-var createAccountResultCodeMap = map[int32]string{
-	0:  "CreateAccountResultCodeCreateAccountSuccess",
-	-1: "CreateAccountResultCodeCreateAccountMalformed",
-	-2: "CreateAccountResultCodeCreateAccountUnderfunded",
-	-3: "CreateAccountResultCodeCreateAccountLowReserve",
-	-4: "CreateAccountResultCodeCreateAccountAlreadyExist",
+var manageSellOfferResultCodeMap = map[int32]string{
+	0:   "ManageSellOfferResultCodeManageSellOfferSuccess",
+	-1:  "ManageSellOfferResultCodeManageSellOfferMalformed",
+	-2:  "ManageSellOfferResultCodeManageSellOfferSellNoTrust",
+	-3:  "ManageSellOfferResultCodeManageSellOfferBuyNoTrust",
+	-4:  "ManageSellOfferResultCodeManageSellOfferSellNotAuthorized",
+	-5:  "ManageSellOfferResultCodeManageSellOfferBuyNotAuthorized",
+	-6:  "ManageSellOfferResultCodeManageSellOfferLineFull",
+	-7:  "ManageSellOfferResultCodeManageSellOfferUnderfunded",
+	-8:  "ManageSellOfferResultCodeManageSellOfferCrossSelf",
+	-9:  "ManageSellOfferResultCodeManageSellOfferSellNoIssuer",
+	-10: "ManageSellOfferResultCodeManageSellOfferBuyNoIssuer",
+	-11: "ManageSellOfferResultCodeManageSellOfferNotFound",
+	-12: "ManageSellOfferResultCodeManageSellOfferLowReserve",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for CreateAccountResultCode
-func (e CreateAccountResultCode) ValidEnum(v int32) bool {
-	_, ok := createAccountResultCodeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e CreateAccountResultCode) String() string {
-	name, _ := createAccountResultCodeMap[int32(e)]
-	return name
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s CreateAccountResultCode) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *CreateAccountResultCode) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*CreateAccountResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*CreateAccountResultCode)(nil)
-)
-
-// CreateAccountResult is an XDR Union defines as:
-//
-//   union CreateAccountResult switch (CreateAccountResultCode code)
-//    {
-//    case CREATE_ACCOUNT_SUCCESS:
-//        void;
-//    default:
-//        void;
-//    };
-//
-type CreateAccountResult struct {
-	Code CreateAccountResultCode
-}
-
-// SwitchFieldName returns the field name in which this union's
-// discriminant is stored
-func (u CreateAccountResult) SwitchFieldName() string {
-	return "Code"
-}
-
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of CreateAccountResult
-func (u CreateAccountResult) ArmForSwitch(sw int32) (string, bool) {
-	switch CreateAccountResultCode(sw) {
-	case CreateAccountResultCodeCreateAccountSuccess:
-		return "", true
-	default:
-		return "", true
-	}
+// the Enum interface for ManageSellOfferResultCode
+func (e ManageSellOfferResultCode) ValidEnum(v int32) bool {
+	_, ok := manageSellOfferResultCodeMap[v]
+	return ok
 }
 
-// NewCreateAccountResult creates a new  CreateAccountResult.
-func NewCreateAccountResult(code CreateAccountResultCode, value interface{}) (result CreateAccountResult, err error) {
-	result.Code = code
-	switch CreateAccountResultCode(code) {
-	case CreateAccountResultCodeCreateAccountSuccess:
-		// void
-	default:
-		// void
-	}
-	return
+// String returns the name of `e`
+func (e ManageSellOfferResultCode) String() string {
+	name, _ := manageSellOfferResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s CreateAccountResult) MarshalBinary() ([]byte, error) {
+func (s ManageSellOfferResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *CreateAccountResult) UnmarshalBinary(inp []byte) error {
+func (s *ManageSellOfferResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*CreateAccountResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*CreateAccountResult)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageSellOfferResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageSellOfferResultCode)(nil)
 )
 
-// PaymentResultCode is an XDR Enum defines as:
+// ManageOfferEffect is an XDR Enum defines as:
 //
-//   enum PaymentResultCode
+//   enum ManageOfferEffect
 //    {
-//        // codes considered as "success" for the operation
-//        PAYMENT_SUCCESS = 0, // payment successfuly completed
-//
-//        // codes considered as "failure" for the operation
-//        PAYMENT_MALFORMED = -1,          // bad input
-//        PAYMENT_UNDERFUNDED = -2,        // not enough funds in source account
-//        PAYMENT_SRC_NO_TRUST = -3,       // no trust line on source account
-//        PAYMENT_SRC_NOT_AUTHORIZED = -4, // source not authorized to transfer
-//        PAYMENT_NO_DESTINATION = -5,     // destination account does not exist
-//        PAYMENT_NO_TRUST = -6,       // destination missing a trust line for asset
-//        PAYMENT_NOT_AUTHORIZED = -7, // destination not authorized to hold asset
-//        PAYMENT_LINE_FULL = -8,      // destination would go above their limit
-//        PAYMENT_NO_ISSUER = -9       // missing issuer on asset
+//        MANAGE_OFFER_CREATED = 0,
+//        MANAGE_OFFER_UPDATED = 1,
+//        MANAGE_OFFER_DELETED = 2
 //    };
 //
-type PaymentResultCode int32
+type ManageOfferEffect int32
 
 const (
-	PaymentResultCodePaymentSuccess          PaymentResultCode = 0
-	PaymentResultCodePaymentMalformed        PaymentResultCode = -1
-	PaymentResultCodePaymentUnderfunded      PaymentResultCode = -2
-	PaymentResultCodePaymentSrcNoTrust       PaymentResultCode = -3
-	PaymentResultCodePaymentSrcNotAuthorized PaymentResultCode = -4
-	PaymentResultCodePaymentNoDestination    PaymentResultCode = -5
-	PaymentResultCodePaymentNoTrust          PaymentResultCode = -6
-	PaymentResultCodePaymentNotAuthorized    PaymentResultCode = -7
-	PaymentResultCodePaymentLineFull         PaymentResultCode = -8
-	PaymentResultCodePaymentNoIssuer         PaymentResultCode = -9
+	ManageOfferEffectManageOfferCreated ManageOfferEffect = 0
+	ManageOfferEffectManageOfferUpdated ManageOfferEffect = 1
+	ManageOfferEffectManageOfferDeleted ManageOfferEffect = 2
 )
 
-var paymentResultCodeMap = map[int32]string{
-	0:  "PaymentResultCodePaymentSuccess",
-	-1: "PaymentResultCodePaymentMalformed",
-	-2: "PaymentResultCodePaymentUnderfunded",
-	-3: "PaymentResultCodePaymentSrcNoTrust",
-	-4: "PaymentResultCodePaymentSrcNotAuthorized",
-	-5: "PaymentResultCodePaymentNoDestination",
-	-6: "PaymentResultCodePaymentNoTrust",
-	-7: "PaymentResultCodePaymentNotAuthorized",
-	-8: "PaymentResultCodePaymentLineFull",
-	-9: "PaymentResultCodePaymentNoIssuer",
+var manageOfferEffectMap = map[int32]string{
+	0: "ManageOfferEffectManageOfferCreated",
+	1: "ManageOfferEffectManageOfferUpdated",
+	2: "ManageOfferEffectManageOfferDeleted",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for PaymentResultCode
-func (e PaymentResultCode) ValidEnum(v int32) bool {
-	_, ok := paymentResultCodeMap[v]
+// the Enum interface for ManageOfferEffect
+func (e ManageOfferEffect) ValidEnum(v int32) bool {
+	_, ok := manageOfferEffectMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e PaymentResultCode) String() string {
-	name, _ := paymentResultCodeMap[int32(e)]
+func (e ManageOfferEffect) String() string {
+	name, _ := manageOfferEffectMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PaymentResultCode) MarshalBinary() ([]byte, error) {
+func (s ManageOfferEffect) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PaymentResultCode) UnmarshalBinary(inp []byte) error {
+func (s *ManageOfferEffect) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PaymentResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*PaymentResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageOfferEffect)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageOfferEffect)(nil)
 )
 
-// PaymentResult is an XDR Union defines as:
+// ManageOfferSuccessResultOffer is an XDR NestedUnion defines as:
 //
-//   union PaymentResult switch (PaymentResultCode code)
-//    {
-//    case PAYMENT_SUCCESS:
-//        void;
-//    default:
-//        void;
-//    };
+//   union switch (ManageOfferEffect effect)
+//        {
+//        case MANAGE_OFFER_CREATED:
+//        case MANAGE_OFFER_UPDATED:
+//            OfferEntry offer;
+//        default:
+//            void;
+//        }
 //
-type PaymentResult struct {
-	Code PaymentResultCode
+type ManageOfferSuccessResultOffer struct {
+	Effect ManageOfferEffect
+	Offer  *OfferEntry
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u PaymentResult) SwitchFieldName() string {
-	return "Code"
+func (u ManageOfferSuccessResultOffer) SwitchFieldName() string {
+	return "Effect"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of PaymentResult
-func (u PaymentResult) ArmForSwitch(sw int32) (string, bool) {
-	switch PaymentResultCode(sw) {
-	case PaymentResultCodePaymentSuccess:
-		return "", true
+// the value for an instance of ManageOfferSuccessResultOffer
+func (u ManageOfferSuccessResultOffer) ArmForSwitch(sw int32) (string, bool) {
+	switch ManageOfferEffect(sw) {
+	case ManageOfferEffectManageOfferCreated:
+		return "Offer", true
+	case ManageOfferEffectManageOfferUpdated:
+		return "Offer", true
 	default:
 		return "", true
 	}
 }
 
-// NewPaymentResult creates a new  PaymentResult.
-func NewPaymentResult(code PaymentResultCode, value interface{}) (result PaymentResult, err error) {
-	result.Code = code
-	switch PaymentResultCode(code) {
-	case PaymentResultCodePaymentSuccess:
-		// void
+// NewManageOfferSuccessResultOffer creates a new  ManageOfferSuccessResultOffer.
+func NewManageOfferSuccessResultOffer(effect ManageOfferEffect, value interface{}) (result ManageOfferSuccessResultOffer, err error) {
+	result.Effect = effect
+	switch ManageOfferEffect(effect) {
+	case ManageOfferEffectManageOfferCreated:
+		tv, ok := value.(OfferEntry)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be OfferEntry")
+			return
+		}
+		result.Offer = &tv
+	case ManageOfferEffectManageOfferUpdated:
+		tv, ok := value.(OfferEntry)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be OfferEntry")
+			return
+		}
+		result.Offer = &tv
 	default:
 		// void
 	}
 	return
 }
 
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PaymentResult) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PaymentResult) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*PaymentResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*PaymentResult)(nil)
-)
-
-// PathPaymentStrictReceiveResultCode is an XDR Enum defines as:
-//
-//   enum PathPaymentStrictReceiveResultCode
-//    {
-//        // codes considered as "success" for the operation
-//        PATH_PAYMENT_STRICT_RECEIVE_SUCCESS = 0, // success
-//
-//        // codes considered as "failure" for the operation
-//        PATH_PAYMENT_STRICT_RECEIVE_MALFORMED = -1, // bad input
-//        PATH_PAYMENT_STRICT_RECEIVE_UNDERFUNDED =
-//            -2, // not enough funds in source account
-//        PATH_PAYMENT_STRICT_RECEIVE_SRC_NO_TRUST =
-//            -3, // no trust line on source account
-//        PATH_PAYMENT_STRICT_RECEIVE_SRC_NOT_AUTHORIZED =
-//            -4, // source not authorized to transfer
-//        PATH_PAYMENT_STRICT_RECEIVE_NO_DESTINATION =
-//            -5, // destination account does not exist
-//        PATH_PAYMENT_STRICT_RECEIVE_NO_TRUST =
-//            -6, // dest missing a trust line for asset
-//        PATH_PAYMENT_STRICT_RECEIVE_NOT_AUTHORIZED =
-//            -7, // dest not authorized to hold asset
-//        PATH_PAYMENT_STRICT_RECEIVE_LINE_FULL =
-//            -8, // dest would go above their limit
-//        PATH_PAYMENT_STRICT_RECEIVE_NO_ISSUER = -9, // missing issuer on one asset
-//        PATH_PAYMENT_STRICT_RECEIVE_TOO_FEW_OFFERS =
-//            -10, // not enough offers to satisfy path
-//        PATH_PAYMENT_STRICT_RECEIVE_OFFER_CROSS_SELF =
-//            -11, // would cross one of its own offers
-//        PATH_PAYMENT_STRICT_RECEIVE_OVER_SENDMAX = -12 // could not satisfy sendmax
-//    };
-//
-type PathPaymentStrictReceiveResultCode int32
-
-const (
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess          PathPaymentStrictReceiveResultCode = 0
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveMalformed        PathPaymentStrictReceiveResultCode = -1
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveUnderfunded      PathPaymentStrictReceiveResultCode = -2
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNoTrust       PathPaymentStrictReceiveResultCode = -3
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNotAuthorized PathPaymentStrictReceiveResultCode = -4
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoDestination    PathPaymentStrictReceiveResultCode = -5
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoTrust          PathPaymentStrictReceiveResultCode = -6
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNotAuthorized    PathPaymentStrictReceiveResultCode = -7
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveLineFull         PathPaymentStrictReceiveResultCode = -8
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer         PathPaymentStrictReceiveResultCode = -9
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveTooFewOffers     PathPaymentStrictReceiveResultCode = -10
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOfferCrossSelf   PathPaymentStrictReceiveResultCode = -11
-	PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOverSendmax      PathPaymentStrictReceiveResultCode = -12
-)
-
-var pathPaymentStrictReceiveResultCodeMap = map[int32]string{
-	0:   "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess",
-	-1:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveMalformed",
-	-2:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveUnderfunded",
-	-3:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNoTrust",
-	-4:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSrcNotAuthorized",
-	-5:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoDestination",
-	-6:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoTrust",
-	-7:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNotAuthorized",
-	-8:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveLineFull",
-	-9:  "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer",
-	-10: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveTooFewOffers",
-	-11: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOfferCrossSelf",
-	-12: "PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveOverSendmax",
-}
-
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for PathPaymentStrictReceiveResultCode
-func (e PathPaymentStrictReceiveResultCode) ValidEnum(v int32) bool {
-	_, ok := pathPaymentStrictReceiveResultCodeMap[v]
-	return ok
-}
-
-// String returns the name of `e`
-func (e PathPaymentStrictReceiveResultCode) String() string {
-	name, _ := pathPaymentStrictReceiveResultCodeMap[int32(e)]
-	return name
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictReceiveResultCode) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictReceiveResultCode) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
+// MustOffer retrieves the Offer value from the union,
+// panicing if the value is not set.
+func (u ManageOfferSuccessResultOffer) MustOffer() OfferEntry {
+	val, ok := u.GetOffer()
+
+	if !ok {
+		panic("arm Offer is not set")
+	}
+
+	return val
 }
 
-var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResultCode)(nil)
-)
+// GetOffer retrieves the Offer value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u ManageOfferSuccessResultOffer) GetOffer() (result OfferEntry, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Effect))
 
-// SimplePaymentResult is an XDR Struct defines as:
-//
-//   struct SimplePaymentResult
-//    {
-//        AccountID destination;
-//        Asset asset;
-//        int64 amount;
-//    };
-//
-type SimplePaymentResult struct {
-	Destination AccountId
-	Asset       Asset
-	Amount      Int64
+	if armName == "Offer" {
+		result = *u.Offer
+		ok = true
+	}
+
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SimplePaymentResult) MarshalBinary() ([]byte, error) {
+func (s ManageOfferSuccessResultOffer) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SimplePaymentResult) UnmarshalBinary(inp []byte) error {
+func (s *ManageOfferSuccessResultOffer) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SimplePaymentResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*SimplePaymentResult)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageOfferSuccessResultOffer)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageOfferSuccessResultOffer)(nil)
 )
 
-// PathPaymentStrictReceiveResultSuccess is an XDR NestedStruct defines as:
+// ManageOfferSuccessResult is an XDR Struct defines as:
 //
-//   struct
+//   struct ManageOfferSuccessResult
+//    {
+//        // offers that got claimed while creating this offer
+//        ClaimOfferAtom offersClaimed<>;
+//
+//        union switch (ManageOfferEffect effect)
 //        {
-//            ClaimOfferAtom offers<>;
-//            SimplePaymentResult last;
+//        case MANAGE_OFFER_CREATED:
+//        case MANAGE_OFFER_UPDATED:
+//            OfferEntry offer;
+//        default:
+//            void;
 //        }
+//        offer;
+//    };
 //
-type PathPaymentStrictReceiveResultSuccess struct {
-	Offers []ClaimOfferAtom
-	Last   SimplePaymentResult
+type ManageOfferSuccessResult struct {
+	OffersClaimed []ClaimOfferAtom
+	Offer         ManageOfferSuccessResultOffer
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictReceiveResultSuccess) MarshalBinary() ([]byte, error) {
+func (s ManageOfferSuccessResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictReceiveResultSuccess) UnmarshalBinary(inp []byte) error {
+func (s *ManageOfferSuccessResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResultSuccess)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResultSuccess)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageOfferSuccessResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageOfferSuccessResult)(nil)
 )
 
-// PathPaymentStrictReceiveResult is an XDR Union defines as:
+// ManageSellOfferResult is an XDR Union defines as:
 //
-//   union PathPaymentStrictReceiveResult switch (PathPaymentStrictReceiveResultCode code)
+//   union ManageSellOfferResult switch (ManageSellOfferResultCode code)
 //    {
-//    case PATH_PAYMENT_STRICT_RECEIVE_SUCCESS:
-//        struct
-//        {
-//            ClaimOfferAtom offers<>;
-//            SimplePaymentResult last;
-//        } success;
-//    case PATH_PAYMENT_STRICT_RECEIVE_NO_ISSUER:
-//        Asset noIssuer; // the asset that caused the error
+//    case MANAGE_SELL_OFFER_SUCCESS:
+//        ManageOfferSuccessResult success;
 //    default:
 //        void;
 //    };
 //
-type PathPaymentStrictReceiveResult struct {
-	Code     PathPaymentStrictReceiveResultCode
-	Success  *PathPaymentStrictReceiveResultSuccess
-	NoIssuer *Asset
+type ManageSellOfferResult struct {
+	Code    ManageSellOfferResultCode
+	Success *ManageOfferSuccessResult
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u PathPaymentStrictReceiveResult) SwitchFieldName() string {
+func (u ManageSellOfferResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of PathPaymentStrictReceiveResult
-func (u PathPaymentStrictReceiveResult) ArmForSwitch(sw int32) (string, bool) {
-	switch PathPaymentStrictReceiveResultCode(sw) {
-	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess:
+// the value for an instance of ManageSellOfferResult
+func (u ManageSellOfferResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ManageSellOfferResultCode(sw) {
+	case ManageSellOfferResultCodeManageSellOfferSuccess:
 		return "Success", true
-	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer:
-		return "NoIssuer", true
 	default:
 		return "", true
 	}
 }
 
-// NewPathPaymentStrictReceiveResult creates a new  PathPaymentStrictReceiveResult.
-func NewPathPaymentStrictReceiveResult(code PathPaymentStrictReceiveResultCode, value interface{}) (result PathPaymentStrictReceiveResult, err error) {
+// NewManageSellOfferResult creates a new  ManageSellOfferResult.
+func NewManageSellOfferResult(code ManageSellOfferResultCode, value interface{}) (result ManageSellOfferResult, err error) {
 	result.Code = code
-	switch PathPaymentStrictReceiveResultCode(code) {
-	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveSuccess:
-		tv, ok := value.(PathPaymentStrictReceiveResultSuccess)
+	switch ManageSellOfferResultCode(code) {
+	case ManageSellOfferResultCodeManageSellOfferSuccess:
+		tv, ok := value.(ManageOfferSuccessResult)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be PathPaymentStrictReceiveResultSuccess")
+			err = fmt.Errorf("invalid value, must be ManageOfferSuccessResult")
 			return
 		}
 		result.Success = &tv
-	case PathPaymentStrictReceiveResultCodePathPaymentStrictReceiveNoIssuer:
-		tv, ok := value.(Asset)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Asset")
-			return
-		}
-		result.NoIssuer = &tv
 	default:
 		// void
 	}
@@ -10031,7 +12395,7 @@ func NewPathPaymentStrictReceiveResult(code PathPaymentStrictReceiveResultCode,
 
 // MustSuccess retrieves the Success value from the union,
 // panicing if the value is not set.
-func (u PathPaymentStrictReceiveResult) MustSuccess() PathPaymentStrictReceiveResultSuccess {
+func (u ManageSellOfferResult) MustSuccess() ManageOfferSuccessResult {
 	val, ok := u.GetSuccess()
 
 	if !ok {
@@ -10043,7 +12407,7 @@ func (u PathPaymentStrictReceiveResult) MustSuccess() PathPaymentStrictReceiveRe
 
 // GetSuccess retrieves the Success value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u PathPaymentStrictReceiveResult) GetSuccess() (result PathPaymentStrictReceiveResultSuccess, ok bool) {
+func (u ManageSellOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Code))
 
 	if armName == "Success" {
@@ -10054,234 +12418,158 @@ func (u PathPaymentStrictReceiveResult) GetSuccess() (result PathPaymentStrictRe
 	return
 }
 
-// MustNoIssuer retrieves the NoIssuer value from the union,
-// panicing if the value is not set.
-func (u PathPaymentStrictReceiveResult) MustNoIssuer() Asset {
-	val, ok := u.GetNoIssuer()
-
-	if !ok {
-		panic("arm NoIssuer is not set")
-	}
-
-	return val
-}
-
-// GetNoIssuer retrieves the NoIssuer value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u PathPaymentStrictReceiveResult) GetNoIssuer() (result Asset, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Code))
-
-	if armName == "NoIssuer" {
-		result = *u.NoIssuer
-		ok = true
-	}
-
-	return
-}
-
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictReceiveResult) MarshalBinary() ([]byte, error) {
+func (s ManageSellOfferResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictReceiveResult) UnmarshalBinary(inp []byte) error {
+func (s *ManageSellOfferResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictReceiveResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictReceiveResult)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageSellOfferResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageSellOfferResult)(nil)
 )
 
-// PathPaymentStrictSendResultCode is an XDR Enum defines as:
+// ManageBuyOfferResultCode is an XDR Enum defines as:
 //
-//   enum PathPaymentStrictSendResultCode
+//   enum ManageBuyOfferResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        PATH_PAYMENT_STRICT_SEND_SUCCESS = 0, // success
+//        MANAGE_BUY_OFFER_SUCCESS = 0,
 //
 //        // codes considered as "failure" for the operation
-//        PATH_PAYMENT_STRICT_SEND_MALFORMED = -1, // bad input
-//        PATH_PAYMENT_STRICT_SEND_UNDERFUNDED =
-//            -2, // not enough funds in source account
-//        PATH_PAYMENT_STRICT_SEND_SRC_NO_TRUST =
-//            -3, // no trust line on source account
-//        PATH_PAYMENT_STRICT_SEND_SRC_NOT_AUTHORIZED =
-//            -4, // source not authorized to transfer
-//        PATH_PAYMENT_STRICT_SEND_NO_DESTINATION =
-//            -5, // destination account does not exist
-//        PATH_PAYMENT_STRICT_SEND_NO_TRUST =
-//            -6, // dest missing a trust line for asset
-//        PATH_PAYMENT_STRICT_SEND_NOT_AUTHORIZED =
-//            -7, // dest not authorized to hold asset
-//        PATH_PAYMENT_STRICT_SEND_LINE_FULL = -8, // dest would go above their limit
-//        PATH_PAYMENT_STRICT_SEND_NO_ISSUER = -9, // missing issuer on one asset
-//        PATH_PAYMENT_STRICT_SEND_TOO_FEW_OFFERS =
-//            -10, // not enough offers to satisfy path
-//        PATH_PAYMENT_STRICT_SEND_OFFER_CROSS_SELF =
-//            -11, // would cross one of its own offers
-//        PATH_PAYMENT_STRICT_SEND_UNDER_DESTMIN = -12 // could not satisfy destMin
+//        MANAGE_BUY_OFFER_MALFORMED = -1,     // generated offer would be invalid
+//        MANAGE_BUY_OFFER_SELL_NO_TRUST = -2, // no trust line for what we're selling
+//        MANAGE_BUY_OFFER_BUY_NO_TRUST = -3,  // no trust line for what we're buying
+//        MANAGE_BUY_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
+//        MANAGE_BUY_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
+//        MANAGE_BUY_OFFER_LINE_FULL = -6,   // can't receive more of what it's buying
+//        MANAGE_BUY_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
+//        MANAGE_BUY_OFFER_CROSS_SELF = -8, // would cross an offer from the same user
+//        MANAGE_BUY_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
+//        MANAGE_BUY_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
+//
+//        // update errors
+//        MANAGE_BUY_OFFER_NOT_FOUND =
+//            -11, // offerID does not match an existing offer
+//
+//        MANAGE_BUY_OFFER_LOW_RESERVE = -12 // not enough funds to create a new Offer
 //    };
 //
-type PathPaymentStrictSendResultCode int32
+type ManageBuyOfferResultCode int32
 
 const (
-	PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess          PathPaymentStrictSendResultCode = 0
-	PathPaymentStrictSendResultCodePathPaymentStrictSendMalformed        PathPaymentStrictSendResultCode = -1
-	PathPaymentStrictSendResultCodePathPaymentStrictSendUnderfunded      PathPaymentStrictSendResultCode = -2
-	PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNoTrust       PathPaymentStrictSendResultCode = -3
-	PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNotAuthorized PathPaymentStrictSendResultCode = -4
-	PathPaymentStrictSendResultCodePathPaymentStrictSendNoDestination    PathPaymentStrictSendResultCode = -5
-	PathPaymentStrictSendResultCodePathPaymentStrictSendNoTrust          PathPaymentStrictSendResultCode = -6
-	PathPaymentStrictSendResultCodePathPaymentStrictSendNotAuthorized    PathPaymentStrictSendResultCode = -7
-	PathPaymentStrictSendResultCodePathPaymentStrictSendLineFull         PathPaymentStrictSendResultCode = -8
-	PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer         PathPaymentStrictSendResultCode = -9
-	PathPaymentStrictSendResultCodePathPaymentStrictSendTooFewOffers     PathPaymentStrictSendResultCode = -10
-	PathPaymentStrictSendResultCodePathPaymentStrictSendOfferCrossSelf   PathPaymentStrictSendResultCode = -11
-	PathPaymentStrictSendResultCodePathPaymentStrictSendUnderDestmin     PathPaymentStrictSendResultCode = -12
+	ManageBuyOfferResultCodeManageBuyOfferSuccess           ManageBuyOfferResultCode = 0
+	ManageBuyOfferResultCodeManageBuyOfferMalformed         ManageBuyOfferResultCode = -1
+	ManageBuyOfferResultCodeManageBuyOfferSellNoTrust       ManageBuyOfferResultCode = -2
+	ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust        ManageBuyOfferResultCode = -3
+	ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized ManageBuyOfferResultCode = -4
+	ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized  ManageBuyOfferResultCode = -5
+	ManageBuyOfferResultCodeManageBuyOfferLineFull          ManageBuyOfferResultCode = -6
+	ManageBuyOfferResultCodeManageBuyOfferUnderfunded       ManageBuyOfferResultCode = -7
+	ManageBuyOfferResultCodeManageBuyOfferCrossSelf         ManageBuyOfferResultCode = -8
+	ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer      ManageBuyOfferResultCode = -9
+	ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer       ManageBuyOfferResultCode = -10
+	ManageBuyOfferResultCodeManageBuyOfferNotFound          ManageBuyOfferResultCode = -11
+	ManageBuyOfferResultCodeManageBuyOfferLowReserve        ManageBuyOfferResultCode = -12
 )
 
-var pathPaymentStrictSendResultCodeMap = map[int32]string{
-	0:   "PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess",
-	-1:  "PathPaymentStrictSendResultCodePathPaymentStrictSendMalformed",
-	-2:  "PathPaymentStrictSendResultCodePathPaymentStrictSendUnderfunded",
-	-3:  "PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNoTrust",
-	-4:  "PathPaymentStrictSendResultCodePathPaymentStrictSendSrcNotAuthorized",
-	-5:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoDestination",
-	-6:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoTrust",
-	-7:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNotAuthorized",
-	-8:  "PathPaymentStrictSendResultCodePathPaymentStrictSendLineFull",
-	-9:  "PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer",
-	-10: "PathPaymentStrictSendResultCodePathPaymentStrictSendTooFewOffers",
-	-11: "PathPaymentStrictSendResultCodePathPaymentStrictSendOfferCrossSelf",
-	-12: "PathPaymentStrictSendResultCodePathPaymentStrictSendUnderDestmin",
+var manageBuyOfferResultCodeMap = map[int32]string{
+	0:   "ManageBuyOfferResultCodeManageBuyOfferSuccess",
+	-1:  "ManageBuyOfferResultCodeManageBuyOfferMalformed",
+	-2:  "ManageBuyOfferResultCodeManageBuyOfferSellNoTrust",
+	-3:  "ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust",
+	-4:  "ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized",
+	-5:  "ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized",
+	-6:  "ManageBuyOfferResultCodeManageBuyOfferLineFull",
+	-7:  "ManageBuyOfferResultCodeManageBuyOfferUnderfunded",
+	-8:  "ManageBuyOfferResultCodeManageBuyOfferCrossSelf",
+	-9:  "ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer",
+	-10: "ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer",
+	-11: "ManageBuyOfferResultCodeManageBuyOfferNotFound",
+	-12: "ManageBuyOfferResultCodeManageBuyOfferLowReserve",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for PathPaymentStrictSendResultCode
-func (e PathPaymentStrictSendResultCode) ValidEnum(v int32) bool {
-	_, ok := pathPaymentStrictSendResultCodeMap[v]
+// the Enum interface for ManageBuyOfferResultCode
+func (e ManageBuyOfferResultCode) ValidEnum(v int32) bool {
+	_, ok := manageBuyOfferResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e PathPaymentStrictSendResultCode) String() string {
-	name, _ := pathPaymentStrictSendResultCodeMap[int32(e)]
+func (e ManageBuyOfferResultCode) String() string {
+	name, _ := manageBuyOfferResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictSendResultCode) MarshalBinary() ([]byte, error) {
-	b := new(bytes.Buffer)
-	_, err := Marshal(b, s)
-	return b.Bytes(), err
-}
-
-// UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictSendResultCode) UnmarshalBinary(inp []byte) error {
-	_, err := Unmarshal(bytes.NewReader(inp), s)
-	return err
-}
-
-var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResultCode)(nil)
-)
-
-// PathPaymentStrictSendResultSuccess is an XDR NestedStruct defines as:
-//
-//   struct
-//        {
-//            ClaimOfferAtom offers<>;
-//            SimplePaymentResult last;
-//        }
-//
-type PathPaymentStrictSendResultSuccess struct {
-	Offers []ClaimOfferAtom
-	Last   SimplePaymentResult
-}
-
-// MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictSendResultSuccess) MarshalBinary() ([]byte, error) {
+func (s ManageBuyOfferResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictSendResultSuccess) UnmarshalBinary(inp []byte) error {
+func (s *ManageBuyOfferResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResultSuccess)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResultSuccess)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResultCode)(nil)
 )
 
-// PathPaymentStrictSendResult is an XDR Union defines as:
+// ManageBuyOfferResult is an XDR Union defines as:
 //
-//   union PathPaymentStrictSendResult switch (PathPaymentStrictSendResultCode code)
+//   union ManageBuyOfferResult switch (ManageBuyOfferResultCode code)
 //    {
-//    case PATH_PAYMENT_STRICT_SEND_SUCCESS:
-//        struct
-//        {
-//            ClaimOfferAtom offers<>;
-//            SimplePaymentResult last;
-//        } success;
-//    case PATH_PAYMENT_STRICT_SEND_NO_ISSUER:
-//        Asset noIssuer; // the asset that caused the error
+//    case MANAGE_BUY_OFFER_SUCCESS:
+//        ManageOfferSuccessResult success;
 //    default:
 //        void;
 //    };
 //
-type PathPaymentStrictSendResult struct {
-	Code     PathPaymentStrictSendResultCode
-	Success  *PathPaymentStrictSendResultSuccess
-	NoIssuer *Asset
+type ManageBuyOfferResult struct {
+	Code    ManageBuyOfferResultCode
+	Success *ManageOfferSuccessResult
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u PathPaymentStrictSendResult) SwitchFieldName() string {
+func (u ManageBuyOfferResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of PathPaymentStrictSendResult
-func (u PathPaymentStrictSendResult) ArmForSwitch(sw int32) (string, bool) {
-	switch PathPaymentStrictSendResultCode(sw) {
-	case PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess:
+// the value for an instance of ManageBuyOfferResult
+func (u ManageBuyOfferResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ManageBuyOfferResultCode(sw) {
+	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
 		return "Success", true
-	case PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer:
-		return "NoIssuer", true
 	default:
 		return "", true
 	}
 }
 
-// NewPathPaymentStrictSendResult creates a new  PathPaymentStrictSendResult.
-func NewPathPaymentStrictSendResult(code PathPaymentStrictSendResultCode, value interface{}) (result PathPaymentStrictSendResult, err error) {
+// NewManageBuyOfferResult creates a new  ManageBuyOfferResult.
+func NewManageBuyOfferResult(code ManageBuyOfferResultCode, value interface{}) (result ManageBuyOfferResult, err error) {
 	result.Code = code
-	switch PathPaymentStrictSendResultCode(code) {
-	case PathPaymentStrictSendResultCodePathPaymentStrictSendSuccess:
-		tv, ok := value.(PathPaymentStrictSendResultSuccess)
+	switch ManageBuyOfferResultCode(code) {
+	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
+		tv, ok := value.(ManageOfferSuccessResult)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be PathPaymentStrictSendResultSuccess")
+			err = fmt.Errorf("invalid value, must be ManageOfferSuccessResult")
 			return
 		}
 		result.Success = &tv
-	case PathPaymentStrictSendResultCodePathPaymentStrictSendNoIssuer:
-		tv, ok := value.(Asset)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be Asset")
-			return
-		}
-		result.NoIssuer = &tv
 	default:
 		// void
 	}
@@ -10290,7 +12578,7 @@ func NewPathPaymentStrictSendResult(code PathPaymentStrictSendResultCode, value
 
 // MustSuccess retrieves the Success value from the union,
 // panicing if the value is not set.
-func (u PathPaymentStrictSendResult) MustSuccess() PathPaymentStrictSendResultSuccess {
+func (u ManageBuyOfferResult) MustSuccess() ManageOfferSuccessResult {
 	val, ok := u.GetSuccess()
 
 	if !ok {
@@ -10302,7 +12590,7 @@ func (u PathPaymentStrictSendResult) MustSuccess() PathPaymentStrictSendResultSu
 
 // GetSuccess retrieves the Success value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u PathPaymentStrictSendResult) GetSuccess() (result PathPaymentStrictSendResultSuccess, ok bool) {
+func (u ManageBuyOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Code))
 
 	if armName == "Success" {
@@ -10313,591 +12601,557 @@ func (u PathPaymentStrictSendResult) GetSuccess() (result PathPaymentStrictSendR
 	return
 }
 
-// MustNoIssuer retrieves the NoIssuer value from the union,
-// panicing if the value is not set.
-func (u PathPaymentStrictSendResult) MustNoIssuer() Asset {
-	val, ok := u.GetNoIssuer()
-
-	if !ok {
-		panic("arm NoIssuer is not set")
-	}
-
-	return val
-}
-
-// GetNoIssuer retrieves the NoIssuer value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u PathPaymentStrictSendResult) GetNoIssuer() (result Asset, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Code))
-
-	if armName == "NoIssuer" {
-		result = *u.NoIssuer
-		ok = true
-	}
-
-	return
-}
-
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s PathPaymentStrictSendResult) MarshalBinary() ([]byte, error) {
+func (s ManageBuyOfferResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *PathPaymentStrictSendResult) UnmarshalBinary(inp []byte) error {
+func (s *ManageBuyOfferResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*PathPaymentStrictSendResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*PathPaymentStrictSendResult)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResult)(nil)
 )
 
-// ManageSellOfferResultCode is an XDR Enum defines as:
+// SetOptionsResultCode is an XDR Enum defines as:
 //
-//   enum ManageSellOfferResultCode
+//   enum SetOptionsResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        MANAGE_SELL_OFFER_SUCCESS = 0,
-//
+//        SET_OPTIONS_SUCCESS = 0,
 //        // codes considered as "failure" for the operation
-//        MANAGE_SELL_OFFER_MALFORMED = -1, // generated offer would be invalid
-//        MANAGE_SELL_OFFER_SELL_NO_TRUST =
-//            -2,                              // no trust line for what we're selling
-//        MANAGE_SELL_OFFER_BUY_NO_TRUST = -3, // no trust line for what we're buying
-//        MANAGE_SELL_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
-//        MANAGE_SELL_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
-//        MANAGE_SELL_OFFER_LINE_FULL = -6, // can't receive more of what it's buying
-//        MANAGE_SELL_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
-//        MANAGE_SELL_OFFER_CROSS_SELF =
-//            -8, // would cross an offer from the same user
-//        MANAGE_SELL_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
-//        MANAGE_SELL_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
-//
-//        // update errors
-//        MANAGE_SELL_OFFER_NOT_FOUND =
-//            -11, // offerID does not match an existing offer
-//
-//        MANAGE_SELL_OFFER_LOW_RESERVE =
-//            -12 // not enough funds to create a new Offer
+//        SET_OPTIONS_LOW_RESERVE = -1,      // not enough funds to add a signer
+//        SET_OPTIONS_TOO_MANY_SIGNERS = -2, // max number of signers already reached
+//        SET_OPTIONS_BAD_FLAGS = -3,        // invalid combination of clear/set flags
+//        SET_OPTIONS_INVALID_INFLATION = -4,      // inflation account does not exist
+//        SET_OPTIONS_CANT_CHANGE = -5,            // can no longer change this option
+//        SET_OPTIONS_UNKNOWN_FLAG = -6,           // can't set an unknown flag
+//        SET_OPTIONS_THRESHOLD_OUT_OF_RANGE = -7, // bad value for weight/threshold
+//        SET_OPTIONS_BAD_SIGNER = -8,             // signer cannot be masterkey
+//        SET_OPTIONS_INVALID_HOME_DOMAIN = -9     // malformed home domain
 //    };
 //
-type ManageSellOfferResultCode int32
+type SetOptionsResultCode int32
 
 const (
-	ManageSellOfferResultCodeManageSellOfferSuccess           ManageSellOfferResultCode = 0
-	ManageSellOfferResultCodeManageSellOfferMalformed         ManageSellOfferResultCode = -1
-	ManageSellOfferResultCodeManageSellOfferSellNoTrust       ManageSellOfferResultCode = -2
-	ManageSellOfferResultCodeManageSellOfferBuyNoTrust        ManageSellOfferResultCode = -3
-	ManageSellOfferResultCodeManageSellOfferSellNotAuthorized ManageSellOfferResultCode = -4
-	ManageSellOfferResultCodeManageSellOfferBuyNotAuthorized  ManageSellOfferResultCode = -5
-	ManageSellOfferResultCodeManageSellOfferLineFull          ManageSellOfferResultCode = -6
-	ManageSellOfferResultCodeManageSellOfferUnderfunded       ManageSellOfferResultCode = -7
-	ManageSellOfferResultCodeManageSellOfferCrossSelf         ManageSellOfferResultCode = -8
-	ManageSellOfferResultCodeManageSellOfferSellNoIssuer      ManageSellOfferResultCode = -9
-	ManageSellOfferResultCodeManageSellOfferBuyNoIssuer       ManageSellOfferResultCode = -10
-	ManageSellOfferResultCodeManageSellOfferNotFound          ManageSellOfferResultCode = -11
-	ManageSellOfferResultCodeManageSellOfferLowReserve        ManageSellOfferResultCode = -12
+	SetOptionsResultCodeSetOptionsSuccess             SetOptionsResultCode = 0
+	SetOptionsResultCodeSetOptionsLowReserve          SetOptionsResultCode = -1
+	SetOptionsResultCodeSetOptionsTooManySigners      SetOptionsResultCode = -2
+	SetOptionsResultCodeSetOptionsBadFlags            SetOptionsResultCode = -3
+	SetOptionsResultCodeSetOptionsInvalidInflation    SetOptionsResultCode = -4
+	SetOptionsResultCodeSetOptionsCantChange          SetOptionsResultCode = -5
+	SetOptionsResultCodeSetOptionsUnknownFlag         SetOptionsResultCode = -6
+	SetOptionsResultCodeSetOptionsThresholdOutOfRange SetOptionsResultCode = -7
+	SetOptionsResultCodeSetOptionsBadSigner           SetOptionsResultCode = -8
+	SetOptionsResultCodeSetOptionsInvalidHomeDomain   SetOptionsResultCode = -9
+)
+
+var setOptionsResultCodeMap = map[int32]string{
+	0:  "SetOptionsResultCodeSetOptionsSuccess",
+	-1: "SetOptionsResultCodeSetOptionsLowReserve",
+	-2: "SetOptionsResultCodeSetOptionsTooManySigners",
+	-3: "SetOptionsResultCodeSetOptionsBadFlags",
+	-4: "SetOptionsResultCodeSetOptionsInvalidInflation",
+	-5: "SetOptionsResultCodeSetOptionsCantChange",
+	-6: "SetOptionsResultCodeSetOptionsUnknownFlag",
+	-7: "SetOptionsResultCodeSetOptionsThresholdOutOfRange",
+	-8: "SetOptionsResultCodeSetOptionsBadSigner",
+	-9: "SetOptionsResultCodeSetOptionsInvalidHomeDomain",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for SetOptionsResultCode
+func (e SetOptionsResultCode) ValidEnum(v int32) bool {
+	_, ok := setOptionsResultCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e SetOptionsResultCode) String() string {
+	name, _ := setOptionsResultCodeMap[int32(e)]
+	return name
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SetOptionsResultCode) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SetOptionsResultCode) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*SetOptionsResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*SetOptionsResultCode)(nil)
 )
 
-var manageSellOfferResultCodeMap = map[int32]string{
-	0:   "ManageSellOfferResultCodeManageSellOfferSuccess",
-	-1:  "ManageSellOfferResultCodeManageSellOfferMalformed",
-	-2:  "ManageSellOfferResultCodeManageSellOfferSellNoTrust",
-	-3:  "ManageSellOfferResultCodeManageSellOfferBuyNoTrust",
-	-4:  "ManageSellOfferResultCodeManageSellOfferSellNotAuthorized",
-	-5:  "ManageSellOfferResultCodeManageSellOfferBuyNotAuthorized",
-	-6:  "ManageSellOfferResultCodeManageSellOfferLineFull",
-	-7:  "ManageSellOfferResultCodeManageSellOfferUnderfunded",
-	-8:  "ManageSellOfferResultCodeManageSellOfferCrossSelf",
-	-9:  "ManageSellOfferResultCodeManageSellOfferSellNoIssuer",
-	-10: "ManageSellOfferResultCodeManageSellOfferBuyNoIssuer",
-	-11: "ManageSellOfferResultCodeManageSellOfferNotFound",
-	-12: "ManageSellOfferResultCodeManageSellOfferLowReserve",
+// SetOptionsResult is an XDR Union defines as:
+//
+//   union SetOptionsResult switch (SetOptionsResultCode code)
+//    {
+//    case SET_OPTIONS_SUCCESS:
+//        void;
+//    default:
+//        void;
+//    };
+//
+type SetOptionsResult struct {
+	Code SetOptionsResultCode
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u SetOptionsResult) SwitchFieldName() string {
+	return "Code"
 }
 
-// ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ManageSellOfferResultCode
-func (e ManageSellOfferResultCode) ValidEnum(v int32) bool {
-	_, ok := manageSellOfferResultCodeMap[v]
-	return ok
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of SetOptionsResult
+func (u SetOptionsResult) ArmForSwitch(sw int32) (string, bool) {
+	switch SetOptionsResultCode(sw) {
+	case SetOptionsResultCodeSetOptionsSuccess:
+		return "", true
+	default:
+		return "", true
+	}
 }
 
-// String returns the name of `e`
-func (e ManageSellOfferResultCode) String() string {
-	name, _ := manageSellOfferResultCodeMap[int32(e)]
-	return name
+// NewSetOptionsResult creates a new  SetOptionsResult.
+func NewSetOptionsResult(code SetOptionsResultCode, value interface{}) (result SetOptionsResult, err error) {
+	result.Code = code
+	switch SetOptionsResultCode(code) {
+	case SetOptionsResultCodeSetOptionsSuccess:
+		// void
+	default:
+		// void
+	}
+	return
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageSellOfferResultCode) MarshalBinary() ([]byte, error) {
+func (s SetOptionsResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageSellOfferResultCode) UnmarshalBinary(inp []byte) error {
+func (s *SetOptionsResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageSellOfferResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageSellOfferResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*SetOptionsResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*SetOptionsResult)(nil)
 )
 
-// ManageOfferEffect is an XDR Enum defines as:
+// ChangeTrustResultCode is an XDR Enum defines as:
 //
-//   enum ManageOfferEffect
+//   enum ChangeTrustResultCode
 //    {
-//        MANAGE_OFFER_CREATED = 0,
-//        MANAGE_OFFER_UPDATED = 1,
-//        MANAGE_OFFER_DELETED = 2
+//        // codes considered as "success" for the operation
+//        CHANGE_TRUST_SUCCESS = 0,
+//        // codes considered as "failure" for the operation
+//        CHANGE_TRUST_MALFORMED = -1,     // bad input
+//        CHANGE_TRUST_NO_ISSUER = -2,     // could not find issuer
+//        CHANGE_TRUST_INVALID_LIMIT = -3, // cannot drop limit below balance
+//                                         // cannot create with a limit of 0
+//        CHANGE_TRUST_LOW_RESERVE =
+//            -4, // not enough funds to create a new trust line,
+//        CHANGE_TRUST_SELF_NOT_ALLOWED = -5 // trusting self is not allowed
 //    };
 //
-type ManageOfferEffect int32
+type ChangeTrustResultCode int32
 
 const (
-	ManageOfferEffectManageOfferCreated ManageOfferEffect = 0
-	ManageOfferEffectManageOfferUpdated ManageOfferEffect = 1
-	ManageOfferEffectManageOfferDeleted ManageOfferEffect = 2
+	ChangeTrustResultCodeChangeTrustSuccess        ChangeTrustResultCode = 0
+	ChangeTrustResultCodeChangeTrustMalformed      ChangeTrustResultCode = -1
+	ChangeTrustResultCodeChangeTrustNoIssuer       ChangeTrustResultCode = -2
+	ChangeTrustResultCodeChangeTrustInvalidLimit   ChangeTrustResultCode = -3
+	ChangeTrustResultCodeChangeTrustLowReserve     ChangeTrustResultCode = -4
+	ChangeTrustResultCodeChangeTrustSelfNotAllowed ChangeTrustResultCode = -5
 )
 
-var manageOfferEffectMap = map[int32]string{
-	0: "ManageOfferEffectManageOfferCreated",
-	1: "ManageOfferEffectManageOfferUpdated",
-	2: "ManageOfferEffectManageOfferDeleted",
+var changeTrustResultCodeMap = map[int32]string{
+	0:  "ChangeTrustResultCodeChangeTrustSuccess",
+	-1: "ChangeTrustResultCodeChangeTrustMalformed",
+	-2: "ChangeTrustResultCodeChangeTrustNoIssuer",
+	-3: "ChangeTrustResultCodeChangeTrustInvalidLimit",
+	-4: "ChangeTrustResultCodeChangeTrustLowReserve",
+	-5: "ChangeTrustResultCodeChangeTrustSelfNotAllowed",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ManageOfferEffect
-func (e ManageOfferEffect) ValidEnum(v int32) bool {
-	_, ok := manageOfferEffectMap[v]
+// the Enum interface for ChangeTrustResultCode
+func (e ChangeTrustResultCode) ValidEnum(v int32) bool {
+	_, ok := changeTrustResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e ManageOfferEffect) String() string {
-	name, _ := manageOfferEffectMap[int32(e)]
+func (e ChangeTrustResultCode) String() string {
+	name, _ := changeTrustResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageOfferEffect) MarshalBinary() ([]byte, error) {
+func (s ChangeTrustResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageOfferEffect) UnmarshalBinary(inp []byte) error {
+func (s *ChangeTrustResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageOfferEffect)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageOfferEffect)(nil)
+	_ encoding.BinaryMarshaler   = (*ChangeTrustResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ChangeTrustResultCode)(nil)
 )
 
-// ManageOfferSuccessResultOffer is an XDR NestedUnion defines as:
+// ChangeTrustResult is an XDR Union defines as:
 //
-//   union switch (ManageOfferEffect effect)
-//        {
-//        case MANAGE_OFFER_CREATED:
-//        case MANAGE_OFFER_UPDATED:
-//            OfferEntry offer;
-//        default:
-//            void;
-//        }
+//   union ChangeTrustResult switch (ChangeTrustResultCode code)
+//    {
+//    case CHANGE_TRUST_SUCCESS:
+//        void;
+//    default:
+//        void;
+//    };
 //
-type ManageOfferSuccessResultOffer struct {
-	Effect ManageOfferEffect
-	Offer  *OfferEntry
+type ChangeTrustResult struct {
+	Code ChangeTrustResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ManageOfferSuccessResultOffer) SwitchFieldName() string {
-	return "Effect"
+func (u ChangeTrustResult) SwitchFieldName() string {
+	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ManageOfferSuccessResultOffer
-func (u ManageOfferSuccessResultOffer) ArmForSwitch(sw int32) (string, bool) {
-	switch ManageOfferEffect(sw) {
-	case ManageOfferEffectManageOfferCreated:
-		return "Offer", true
-	case ManageOfferEffectManageOfferUpdated:
-		return "Offer", true
+// the value for an instance of ChangeTrustResult
+func (u ChangeTrustResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ChangeTrustResultCode(sw) {
+	case ChangeTrustResultCodeChangeTrustSuccess:
+		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewManageOfferSuccessResultOffer creates a new  ManageOfferSuccessResultOffer.
-func NewManageOfferSuccessResultOffer(effect ManageOfferEffect, value interface{}) (result ManageOfferSuccessResultOffer, err error) {
-	result.Effect = effect
-	switch ManageOfferEffect(effect) {
-	case ManageOfferEffectManageOfferCreated:
-		tv, ok := value.(OfferEntry)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be OfferEntry")
-			return
-		}
-		result.Offer = &tv
-	case ManageOfferEffectManageOfferUpdated:
-		tv, ok := value.(OfferEntry)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be OfferEntry")
-			return
-		}
-		result.Offer = &tv
+// NewChangeTrustResult creates a new  ChangeTrustResult.
+func NewChangeTrustResult(code ChangeTrustResultCode, value interface{}) (result ChangeTrustResult, err error) {
+	result.Code = code
+	switch ChangeTrustResultCode(code) {
+	case ChangeTrustResultCodeChangeTrustSuccess:
+		// void
 	default:
 		// void
 	}
 	return
 }
 
-// MustOffer retrieves the Offer value from the union,
-// panicing if the value is not set.
-func (u ManageOfferSuccessResultOffer) MustOffer() OfferEntry {
-	val, ok := u.GetOffer()
-
-	if !ok {
-		panic("arm Offer is not set")
-	}
-
-	return val
-}
-
-// GetOffer retrieves the Offer value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u ManageOfferSuccessResultOffer) GetOffer() (result OfferEntry, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Effect))
-
-	if armName == "Offer" {
-		result = *u.Offer
-		ok = true
-	}
-
-	return
-}
-
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageOfferSuccessResultOffer) MarshalBinary() ([]byte, error) {
+func (s ChangeTrustResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageOfferSuccessResultOffer) UnmarshalBinary(inp []byte) error {
+func (s *ChangeTrustResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageOfferSuccessResultOffer)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageOfferSuccessResultOffer)(nil)
+	_ encoding.BinaryMarshaler   = (*ChangeTrustResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ChangeTrustResult)(nil)
+)
+
+// AllowTrustResultCode is an XDR Enum defines as:
+//
+//   enum AllowTrustResultCode
+//    {
+//        // codes considered as "success" for the operation
+//        ALLOW_TRUST_SUCCESS = 0,
+//        // codes considered as "failure" for the operation
+//        ALLOW_TRUST_MALFORMED = -1,     // asset is not ASSET_TYPE_ALPHANUM
+//        ALLOW_TRUST_NO_TRUST_LINE = -2, // trustor does not have a trustline
+//                                        // source account does not require trust
+//        ALLOW_TRUST_TRUST_NOT_REQUIRED = -3,
+//        ALLOW_TRUST_CANT_REVOKE = -4,     // source account can't revoke trust,
+//        ALLOW_TRUST_SELF_NOT_ALLOWED = -5 // trusting self is not allowed
+//    };
+//
+type AllowTrustResultCode int32
+
+const (
+	AllowTrustResultCodeAllowTrustSuccess          AllowTrustResultCode = 0
+	AllowTrustResultCodeAllowTrustMalformed        AllowTrustResultCode = -1
+	AllowTrustResultCodeAllowTrustNoTrustLine      AllowTrustResultCode = -2
+	AllowTrustResultCodeAllowTrustTrustNotRequired AllowTrustResultCode = -3
+	AllowTrustResultCodeAllowTrustCantRevoke       AllowTrustResultCode = -4
+	AllowTrustResultCodeAllowTrustSelfNotAllowed   AllowTrustResultCode = -5
 )
 
-// ManageOfferSuccessResult is an XDR Struct defines as:
-//
-//   struct ManageOfferSuccessResult
-//    {
-//        // offers that got claimed while creating this offer
-//        ClaimOfferAtom offersClaimed<>;
-//
-//        union switch (ManageOfferEffect effect)
-//        {
-//        case MANAGE_OFFER_CREATED:
-//        case MANAGE_OFFER_UPDATED:
-//            OfferEntry offer;
-//        default:
-//            void;
-//        }
-//        offer;
-//    };
-//
-type ManageOfferSuccessResult struct {
-	OffersClaimed []ClaimOfferAtom
-	Offer         ManageOfferSuccessResultOffer
+var allowTrustResultCodeMap = map[int32]string{
+	0:  "AllowTrustResultCodeAllowTrustSuccess",
+	-1: "AllowTrustResultCodeAllowTrustMalformed",
+	-2: "AllowTrustResultCodeAllowTrustNoTrustLine",
+	-3: "AllowTrustResultCodeAllowTrustTrustNotRequired",
+	-4: "AllowTrustResultCodeAllowTrustCantRevoke",
+	-5: "AllowTrustResultCodeAllowTrustSelfNotAllowed",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for AllowTrustResultCode
+func (e AllowTrustResultCode) ValidEnum(v int32) bool {
+	_, ok := allowTrustResultCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e AllowTrustResultCode) String() string {
+	name, _ := allowTrustResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageOfferSuccessResult) MarshalBinary() ([]byte, error) {
+func (s AllowTrustResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageOfferSuccessResult) UnmarshalBinary(inp []byte) error {
+func (s *AllowTrustResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageOfferSuccessResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageOfferSuccessResult)(nil)
+	_ encoding.BinaryMarshaler   = (*AllowTrustResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*AllowTrustResultCode)(nil)
 )
 
-// ManageSellOfferResult is an XDR Union defines as:
+// AllowTrustResult is an XDR Union defines as:
 //
-//   union ManageSellOfferResult switch (ManageSellOfferResultCode code)
+//   union AllowTrustResult switch (AllowTrustResultCode code)
 //    {
-//    case MANAGE_SELL_OFFER_SUCCESS:
-//        ManageOfferSuccessResult success;
+//    case ALLOW_TRUST_SUCCESS:
+//        void;
 //    default:
 //        void;
 //    };
 //
-type ManageSellOfferResult struct {
-	Code    ManageSellOfferResultCode
-	Success *ManageOfferSuccessResult
+type AllowTrustResult struct {
+	Code AllowTrustResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ManageSellOfferResult) SwitchFieldName() string {
+func (u AllowTrustResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ManageSellOfferResult
-func (u ManageSellOfferResult) ArmForSwitch(sw int32) (string, bool) {
-	switch ManageSellOfferResultCode(sw) {
-	case ManageSellOfferResultCodeManageSellOfferSuccess:
-		return "Success", true
+// the value for an instance of AllowTrustResult
+func (u AllowTrustResult) ArmForSwitch(sw int32) (string, bool) {
+	switch AllowTrustResultCode(sw) {
+	case AllowTrustResultCodeAllowTrustSuccess:
+		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewManageSellOfferResult creates a new  ManageSellOfferResult.
-func NewManageSellOfferResult(code ManageSellOfferResultCode, value interface{}) (result ManageSellOfferResult, err error) {
+// NewAllowTrustResult creates a new  AllowTrustResult.
+func NewAllowTrustResult(code AllowTrustResultCode, value interface{}) (result AllowTrustResult, err error) {
 	result.Code = code
-	switch ManageSellOfferResultCode(code) {
-	case ManageSellOfferResultCodeManageSellOfferSuccess:
-		tv, ok := value.(ManageOfferSuccessResult)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be ManageOfferSuccessResult")
-			return
-		}
-		result.Success = &tv
+	switch AllowTrustResultCode(code) {
+	case AllowTrustResultCodeAllowTrustSuccess:
+		// void
 	default:
 		// void
 	}
 	return
 }
 
-// MustSuccess retrieves the Success value from the union,
-// panicing if the value is not set.
-func (u ManageSellOfferResult) MustSuccess() ManageOfferSuccessResult {
-	val, ok := u.GetSuccess()
-
-	if !ok {
-		panic("arm Success is not set")
-	}
-
-	return val
-}
-
-// GetSuccess retrieves the Success value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u ManageSellOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Code))
-
-	if armName == "Success" {
-		result = *u.Success
-		ok = true
-	}
-
-	return
-}
-
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageSellOfferResult) MarshalBinary() ([]byte, error) {
+func (s AllowTrustResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageSellOfferResult) UnmarshalBinary(inp []byte) error {
+func (s *AllowTrustResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageSellOfferResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageSellOfferResult)(nil)
+	_ encoding.BinaryMarshaler   = (*AllowTrustResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*AllowTrustResult)(nil)
 )
 
-// ManageBuyOfferResultCode is an XDR Enum defines as:
+// AccountMergeResultCode is an XDR Enum defines as:
 //
-//   enum ManageBuyOfferResultCode
+//   enum AccountMergeResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        MANAGE_BUY_OFFER_SUCCESS = 0,
-//
+//        ACCOUNT_MERGE_SUCCESS = 0,
 //        // codes considered as "failure" for the operation
-//        MANAGE_BUY_OFFER_MALFORMED = -1,     // generated offer would be invalid
-//        MANAGE_BUY_OFFER_SELL_NO_TRUST = -2, // no trust line for what we're selling
-//        MANAGE_BUY_OFFER_BUY_NO_TRUST = -3,  // no trust line for what we're buying
-//        MANAGE_BUY_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
-//        MANAGE_BUY_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
-//        MANAGE_BUY_OFFER_LINE_FULL = -6,   // can't receive more of what it's buying
-//        MANAGE_BUY_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
-//        MANAGE_BUY_OFFER_CROSS_SELF = -8, // would cross an offer from the same user
-//        MANAGE_BUY_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
-//        MANAGE_BUY_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
-//
-//        // update errors
-//        MANAGE_BUY_OFFER_NOT_FOUND =
-//            -11, // offerID does not match an existing offer
-//
-//        MANAGE_BUY_OFFER_LOW_RESERVE = -12 // not enough funds to create a new Offer
+//        ACCOUNT_MERGE_MALFORMED = -1,       // can't merge onto itself
+//        ACCOUNT_MERGE_NO_ACCOUNT = -2,      // destination does not exist
+//        ACCOUNT_MERGE_IMMUTABLE_SET = -3,   // source account has AUTH_IMMUTABLE set
+//        ACCOUNT_MERGE_HAS_SUB_ENTRIES = -4, // account has trust lines/offers
+//        ACCOUNT_MERGE_SEQNUM_TOO_FAR = -5,  // sequence number is over max allowed
+//        ACCOUNT_MERGE_DEST_FULL = -6,       // can't add source balance to
+//                                            // destination balance
+//        ACCOUNT_MERGE_IS_SPONSOR = -7       // can't merge account that is a sponsor
 //    };
 //
-type ManageBuyOfferResultCode int32
+type AccountMergeResultCode int32
 
 const (
-	ManageBuyOfferResultCodeManageBuyOfferSuccess           ManageBuyOfferResultCode = 0
-	ManageBuyOfferResultCodeManageBuyOfferMalformed         ManageBuyOfferResultCode = -1
-	ManageBuyOfferResultCodeManageBuyOfferSellNoTrust       ManageBuyOfferResultCode = -2
-	ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust        ManageBuyOfferResultCode = -3
-	ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized ManageBuyOfferResultCode = -4
-	ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized  ManageBuyOfferResultCode = -5
-	ManageBuyOfferResultCodeManageBuyOfferLineFull          ManageBuyOfferResultCode = -6
-	ManageBuyOfferResultCodeManageBuyOfferUnderfunded       ManageBuyOfferResultCode = -7
-	ManageBuyOfferResultCodeManageBuyOfferCrossSelf         ManageBuyOfferResultCode = -8
-	ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer      ManageBuyOfferResultCode = -9
-	ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer       ManageBuyOfferResultCode = -10
-	ManageBuyOfferResultCodeManageBuyOfferNotFound          ManageBuyOfferResultCode = -11
-	ManageBuyOfferResultCodeManageBuyOfferLowReserve        ManageBuyOfferResultCode = -12
+	AccountMergeResultCodeAccountMergeSuccess       AccountMergeResultCode = 0
+	AccountMergeResultCodeAccountMergeMalformed     AccountMergeResultCode = -1
+	AccountMergeResultCodeAccountMergeNoAccount     AccountMergeResultCode = -2
+	AccountMergeResultCodeAccountMergeImmutableSet  AccountMergeResultCode = -3
+	AccountMergeResultCodeAccountMergeHasSubEntries AccountMergeResultCode = -4
+	AccountMergeResultCodeAccountMergeSeqnumTooFar  AccountMergeResultCode = -5
+	AccountMergeResultCodeAccountMergeDestFull      AccountMergeResultCode = -6
+	AccountMergeResultCodeAccountMergeIsSponsor     AccountMergeResultCode = -7
 )
 
-var manageBuyOfferResultCodeMap = map[int32]string{
-	0:   "ManageBuyOfferResultCodeManageBuyOfferSuccess",
-	-1:  "ManageBuyOfferResultCodeManageBuyOfferMalformed",
-	-2:  "ManageBuyOfferResultCodeManageBuyOfferSellNoTrust",
-	-3:  "ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust",
-	-4:  "ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized",
-	-5:  "ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized",
-	-6:  "ManageBuyOfferResultCodeManageBuyOfferLineFull",
-	-7:  "ManageBuyOfferResultCodeManageBuyOfferUnderfunded",
-	-8:  "ManageBuyOfferResultCodeManageBuyOfferCrossSelf",
-	-9:  "ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer",
-	-10: "ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer",
-	-11: "ManageBuyOfferResultCodeManageBuyOfferNotFound",
-	-12: "ManageBuyOfferResultCodeManageBuyOfferLowReserve",
+var accountMergeResultCodeMap = map[int32]string{
+	0:  "AccountMergeResultCodeAccountMergeSuccess",
+	-1: "AccountMergeResultCodeAccountMergeMalformed",
+	-2: "AccountMergeResultCodeAccountMergeNoAccount",
+	-3: "AccountMergeResultCodeAccountMergeImmutableSet",
+	-4: "AccountMergeResultCodeAccountMergeHasSubEntries",
+	-5: "AccountMergeResultCodeAccountMergeSeqnumTooFar",
+	-6: "AccountMergeResultCodeAccountMergeDestFull",
+	-7: "AccountMergeResultCodeAccountMergeIsSponsor",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ManageBuyOfferResultCode
-func (e ManageBuyOfferResultCode) ValidEnum(v int32) bool {
-	_, ok := manageBuyOfferResultCodeMap[v]
+// the Enum interface for AccountMergeResultCode
+func (e AccountMergeResultCode) ValidEnum(v int32) bool {
+	_, ok := accountMergeResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e ManageBuyOfferResultCode) String() string {
-	name, _ := manageBuyOfferResultCodeMap[int32(e)]
+func (e AccountMergeResultCode) String() string {
+	name, _ := accountMergeResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageBuyOfferResultCode) MarshalBinary() ([]byte, error) {
+func (s AccountMergeResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageBuyOfferResultCode) UnmarshalBinary(inp []byte) error {
+func (s *AccountMergeResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*AccountMergeResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountMergeResultCode)(nil)
 )
 
-// ManageBuyOfferResult is an XDR Union defines as:
+// AccountMergeResult is an XDR Union defines as:
 //
-//   union ManageBuyOfferResult switch (ManageBuyOfferResultCode code)
+//   union AccountMergeResult switch (AccountMergeResultCode code)
 //    {
-//    case MANAGE_BUY_OFFER_SUCCESS:
-//        ManageOfferSuccessResult success;
+//    case ACCOUNT_MERGE_SUCCESS:
+//        int64 sourceAccountBalance; // how much got transfered from source account
 //    default:
 //        void;
 //    };
 //
-type ManageBuyOfferResult struct {
-	Code    ManageBuyOfferResultCode
-	Success *ManageOfferSuccessResult
+type AccountMergeResult struct {
+	Code                 AccountMergeResultCode
+	SourceAccountBalance *Int64
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ManageBuyOfferResult) SwitchFieldName() string {
+func (u AccountMergeResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ManageBuyOfferResult
-func (u ManageBuyOfferResult) ArmForSwitch(sw int32) (string, bool) {
-	switch ManageBuyOfferResultCode(sw) {
-	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
-		return "Success", true
+// the value for an instance of AccountMergeResult
+func (u AccountMergeResult) ArmForSwitch(sw int32) (string, bool) {
+	switch AccountMergeResultCode(sw) {
+	case AccountMergeResultCodeAccountMergeSuccess:
+		return "SourceAccountBalance", true
 	default:
 		return "", true
 	}
 }
 
-// NewManageBuyOfferResult creates a new  ManageBuyOfferResult.
-func NewManageBuyOfferResult(code ManageBuyOfferResultCode, value interface{}) (result ManageBuyOfferResult, err error) {
+// NewAccountMergeResult creates a new  AccountMergeResult.
+func NewAccountMergeResult(code AccountMergeResultCode, value interface{}) (result AccountMergeResult, err error) {
 	result.Code = code
-	switch ManageBuyOfferResultCode(code) {
-	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
-		tv, ok := value.(ManageOfferSuccessResult)
+	switch AccountMergeResultCode(code) {
+	case AccountMergeResultCodeAccountMergeSuccess:
+		tv, ok := value.(Int64)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be ManageOfferSuccessResult")
+			err = fmt.Errorf("invalid value, must be Int64")
 			return
 		}
-		result.Success = &tv
+		result.SourceAccountBalance = &tv
 	default:
 		// void
 	}
 	return
 }
 
-// MustSuccess retrieves the Success value from the union,
+// MustSourceAccountBalance retrieves the SourceAccountBalance value from the union,
 // panicing if the value is not set.
-func (u ManageBuyOfferResult) MustSuccess() ManageOfferSuccessResult {
-	val, ok := u.GetSuccess()
+func (u AccountMergeResult) MustSourceAccountBalance() Int64 {
+	val, ok := u.GetSourceAccountBalance()
 
 	if !ok {
-		panic("arm Success is not set")
+		panic("arm SourceAccountBalance is not set")
 	}
 
 	return val
 }
 
-// GetSuccess retrieves the Success value from the union,
+// GetSourceAccountBalance retrieves the SourceAccountBalance value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u ManageBuyOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok bool) {
+func (u AccountMergeResult) GetSourceAccountBalance() (result Int64, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "Success" {
-		result = *u.Success
+	if armName == "SourceAccountBalance" {
+		result = *u.SourceAccountBalance
 		ok = true
 	}
 
@@ -10905,264 +13159,299 @@ func (u ManageBuyOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageBuyOfferResult) MarshalBinary() ([]byte, error) {
+func (s AccountMergeResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageBuyOfferResult) UnmarshalBinary(inp []byte) error {
+func (s *AccountMergeResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResult)(nil)
+	_ encoding.BinaryMarshaler   = (*AccountMergeResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*AccountMergeResult)(nil)
 )
 
-// SetOptionsResultCode is an XDR Enum defines as:
+// InflationResultCode is an XDR Enum defines as:
 //
-//   enum SetOptionsResultCode
+//   enum InflationResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        SET_OPTIONS_SUCCESS = 0,
+//        INFLATION_SUCCESS = 0,
 //        // codes considered as "failure" for the operation
-//        SET_OPTIONS_LOW_RESERVE = -1,      // not enough funds to add a signer
-//        SET_OPTIONS_TOO_MANY_SIGNERS = -2, // max number of signers already reached
-//        SET_OPTIONS_BAD_FLAGS = -3,        // invalid combination of clear/set flags
-//        SET_OPTIONS_INVALID_INFLATION = -4,      // inflation account does not exist
-//        SET_OPTIONS_CANT_CHANGE = -5,            // can no longer change this option
-//        SET_OPTIONS_UNKNOWN_FLAG = -6,           // can't set an unknown flag
-//        SET_OPTIONS_THRESHOLD_OUT_OF_RANGE = -7, // bad value for weight/threshold
-//        SET_OPTIONS_BAD_SIGNER = -8,             // signer cannot be masterkey
-//        SET_OPTIONS_INVALID_HOME_DOMAIN = -9     // malformed home domain
+//        INFLATION_NOT_TIME = -1
 //    };
 //
-type SetOptionsResultCode int32
+type InflationResultCode int32
 
 const (
-	SetOptionsResultCodeSetOptionsSuccess             SetOptionsResultCode = 0
-	SetOptionsResultCodeSetOptionsLowReserve          SetOptionsResultCode = -1
-	SetOptionsResultCodeSetOptionsTooManySigners      SetOptionsResultCode = -2
-	SetOptionsResultCodeSetOptionsBadFlags            SetOptionsResultCode = -3
-	SetOptionsResultCodeSetOptionsInvalidInflation    SetOptionsResultCode = -4
-	SetOptionsResultCodeSetOptionsCantChange          SetOptionsResultCode = -5
-	SetOptionsResultCodeSetOptionsUnknownFlag         SetOptionsResultCode = -6
-	SetOptionsResultCodeSetOptionsThresholdOutOfRange SetOptionsResultCode = -7
-	SetOptionsResultCodeSetOptionsBadSigner           SetOptionsResultCode = -8
-	SetOptionsResultCodeSetOptionsInvalidHomeDomain   SetOptionsResultCode = -9
+	InflationResultCodeInflationSuccess InflationResultCode = 0
+	InflationResultCodeInflationNotTime InflationResultCode = -1
 )
 
-var setOptionsResultCodeMap = map[int32]string{
-	0:  "SetOptionsResultCodeSetOptionsSuccess",
-	-1: "SetOptionsResultCodeSetOptionsLowReserve",
-	-2: "SetOptionsResultCodeSetOptionsTooManySigners",
-	-3: "SetOptionsResultCodeSetOptionsBadFlags",
-	-4: "SetOptionsResultCodeSetOptionsInvalidInflation",
-	-5: "SetOptionsResultCodeSetOptionsCantChange",
-	-6: "SetOptionsResultCodeSetOptionsUnknownFlag",
-	-7: "SetOptionsResultCodeSetOptionsThresholdOutOfRange",
-	-8: "SetOptionsResultCodeSetOptionsBadSigner",
-	-9: "SetOptionsResultCodeSetOptionsInvalidHomeDomain",
+var inflationResultCodeMap = map[int32]string{
+	0:  "InflationResultCodeInflationSuccess",
+	-1: "InflationResultCodeInflationNotTime",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for SetOptionsResultCode
-func (e SetOptionsResultCode) ValidEnum(v int32) bool {
-	_, ok := setOptionsResultCodeMap[v]
+// the Enum interface for InflationResultCode
+func (e InflationResultCode) ValidEnum(v int32) bool {
+	_, ok := inflationResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e SetOptionsResultCode) String() string {
-	name, _ := setOptionsResultCodeMap[int32(e)]
+func (e InflationResultCode) String() string {
+	name, _ := inflationResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SetOptionsResultCode) MarshalBinary() ([]byte, error) {
+func (s InflationResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SetOptionsResultCode) UnmarshalBinary(inp []byte) error {
+func (s *InflationResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SetOptionsResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*SetOptionsResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*InflationResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*InflationResultCode)(nil)
 )
 
-// SetOptionsResult is an XDR Union defines as:
+// InflationPayout is an XDR Struct defines as:
 //
-//   union SetOptionsResult switch (SetOptionsResultCode code)
+//   struct InflationPayout // or use PaymentResultAtom to limit types?
 //    {
-//    case SET_OPTIONS_SUCCESS:
-//        void;
+//        AccountID destination;
+//        int64 amount;
+//    };
+//
+type InflationPayout struct {
+	Destination AccountId
+	Amount      Int64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s InflationPayout) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *InflationPayout) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*InflationPayout)(nil)
+	_ encoding.BinaryUnmarshaler = (*InflationPayout)(nil)
+)
+
+// InflationResult is an XDR Union defines as:
+//
+//   union InflationResult switch (InflationResultCode code)
+//    {
+//    case INFLATION_SUCCESS:
+//        InflationPayout payouts<>;
 //    default:
 //        void;
 //    };
 //
-type SetOptionsResult struct {
-	Code SetOptionsResultCode
+type InflationResult struct {
+	Code    InflationResultCode
+	Payouts *[]InflationPayout
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u SetOptionsResult) SwitchFieldName() string {
+func (u InflationResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of SetOptionsResult
-func (u SetOptionsResult) ArmForSwitch(sw int32) (string, bool) {
-	switch SetOptionsResultCode(sw) {
-	case SetOptionsResultCodeSetOptionsSuccess:
-		return "", true
+// the value for an instance of InflationResult
+func (u InflationResult) ArmForSwitch(sw int32) (string, bool) {
+	switch InflationResultCode(sw) {
+	case InflationResultCodeInflationSuccess:
+		return "Payouts", true
 	default:
 		return "", true
 	}
 }
 
-// NewSetOptionsResult creates a new  SetOptionsResult.
-func NewSetOptionsResult(code SetOptionsResultCode, value interface{}) (result SetOptionsResult, err error) {
+// NewInflationResult creates a new  InflationResult.
+func NewInflationResult(code InflationResultCode, value interface{}) (result InflationResult, err error) {
 	result.Code = code
-	switch SetOptionsResultCode(code) {
-	case SetOptionsResultCodeSetOptionsSuccess:
-		// void
+	switch InflationResultCode(code) {
+	case InflationResultCodeInflationSuccess:
+		tv, ok := value.([]InflationPayout)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be []InflationPayout")
+			return
+		}
+		result.Payouts = &tv
 	default:
 		// void
 	}
 	return
 }
 
+// MustPayouts retrieves the Payouts value from the union,
+// panicing if the value is not set.
+func (u InflationResult) MustPayouts() []InflationPayout {
+	val, ok := u.GetPayouts()
+
+	if !ok {
+		panic("arm Payouts is not set")
+	}
+
+	return val
+}
+
+// GetPayouts retrieves the Payouts value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u InflationResult) GetPayouts() (result []InflationPayout, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
+
+	if armName == "Payouts" {
+		result = *u.Payouts
+		ok = true
+	}
+
+	return
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s SetOptionsResult) MarshalBinary() ([]byte, error) {
+func (s InflationResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *SetOptionsResult) UnmarshalBinary(inp []byte) error {
+func (s *InflationResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*SetOptionsResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*SetOptionsResult)(nil)
+	_ encoding.BinaryMarshaler   = (*InflationResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*InflationResult)(nil)
 )
 
-// ChangeTrustResultCode is an XDR Enum defines as:
+// ManageDataResultCode is an XDR Enum defines as:
 //
-//   enum ChangeTrustResultCode
+//   enum ManageDataResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        CHANGE_TRUST_SUCCESS = 0,
+//        MANAGE_DATA_SUCCESS = 0,
 //        // codes considered as "failure" for the operation
-//        CHANGE_TRUST_MALFORMED = -1,     // bad input
-//        CHANGE_TRUST_NO_ISSUER = -2,     // could not find issuer
-//        CHANGE_TRUST_INVALID_LIMIT = -3, // cannot drop limit below balance
-//                                         // cannot create with a limit of 0
-//        CHANGE_TRUST_LOW_RESERVE =
-//            -4, // not enough funds to create a new trust line,
-//        CHANGE_TRUST_SELF_NOT_ALLOWED = -5 // trusting self is not allowed
+//        MANAGE_DATA_NOT_SUPPORTED_YET =
+//            -1, // The network hasn't moved to this protocol change yet
+//        MANAGE_DATA_NAME_NOT_FOUND =
+//            -2, // Trying to remove a Data Entry that isn't there
+//        MANAGE_DATA_LOW_RESERVE = -3, // not enough funds to create a new Data Entry
+//        MANAGE_DATA_INVALID_NAME = -4 // Name not a valid string
 //    };
 //
-type ChangeTrustResultCode int32
+type ManageDataResultCode int32
 
 const (
-	ChangeTrustResultCodeChangeTrustSuccess        ChangeTrustResultCode = 0
-	ChangeTrustResultCodeChangeTrustMalformed      ChangeTrustResultCode = -1
-	ChangeTrustResultCodeChangeTrustNoIssuer       ChangeTrustResultCode = -2
-	ChangeTrustResultCodeChangeTrustInvalidLimit   ChangeTrustResultCode = -3
-	ChangeTrustResultCodeChangeTrustLowReserve     ChangeTrustResultCode = -4
-	ChangeTrustResultCodeChangeTrustSelfNotAllowed ChangeTrustResultCode = -5
+	ManageDataResultCodeManageDataSuccess         ManageDataResultCode = 0
+	ManageDataResultCodeManageDataNotSupportedYet ManageDataResultCode = -1
+	ManageDataResultCodeManageDataNameNotFound    ManageDataResultCode = -2
+	ManageDataResultCodeManageDataLowReserve      ManageDataResultCode = -3
+	ManageDataResultCodeManageDataInvalidName     ManageDataResultCode = -4
 )
 
-var changeTrustResultCodeMap = map[int32]string{
-	0:  "ChangeTrustResultCodeChangeTrustSuccess",
-	-1: "ChangeTrustResultCodeChangeTrustMalformed",
-	-2: "ChangeTrustResultCodeChangeTrustNoIssuer",
-	-3: "ChangeTrustResultCodeChangeTrustInvalidLimit",
-	-4: "ChangeTrustResultCodeChangeTrustLowReserve",
-	-5: "ChangeTrustResultCodeChangeTrustSelfNotAllowed",
+var manageDataResultCodeMap = map[int32]string{
+	0:  "ManageDataResultCodeManageDataSuccess",
+	-1: "ManageDataResultCodeManageDataNotSupportedYet",
+	-2: "ManageDataResultCodeManageDataNameNotFound",
+	-3: "ManageDataResultCodeManageDataLowReserve",
+	-4: "ManageDataResultCodeManageDataInvalidName",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ChangeTrustResultCode
-func (e ChangeTrustResultCode) ValidEnum(v int32) bool {
-	_, ok := changeTrustResultCodeMap[v]
+// the Enum interface for ManageDataResultCode
+func (e ManageDataResultCode) ValidEnum(v int32) bool {
+	_, ok := manageDataResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e ChangeTrustResultCode) String() string {
-	name, _ := changeTrustResultCodeMap[int32(e)]
+func (e ManageDataResultCode) String() string {
+	name, _ := manageDataResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ChangeTrustResultCode) MarshalBinary() ([]byte, error) {
+func (s ManageDataResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ChangeTrustResultCode) UnmarshalBinary(inp []byte) error {
+func (s *ManageDataResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ChangeTrustResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*ChangeTrustResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageDataResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageDataResultCode)(nil)
 )
 
-// ChangeTrustResult is an XDR Union defines as:
+// ManageDataResult is an XDR Union defines as:
 //
-//   union ChangeTrustResult switch (ChangeTrustResultCode code)
+//   union ManageDataResult switch (ManageDataResultCode code)
 //    {
-//    case CHANGE_TRUST_SUCCESS:
+//    case MANAGE_DATA_SUCCESS:
 //        void;
 //    default:
 //        void;
 //    };
 //
-type ChangeTrustResult struct {
-	Code ChangeTrustResultCode
+type ManageDataResult struct {
+	Code ManageDataResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ChangeTrustResult) SwitchFieldName() string {
+func (u ManageDataResult) SwitchFieldName() string {
 	return "Code"
 }
-
-// ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ChangeTrustResult
-func (u ChangeTrustResult) ArmForSwitch(sw int32) (string, bool) {
-	switch ChangeTrustResultCode(sw) {
-	case ChangeTrustResultCodeChangeTrustSuccess:
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of ManageDataResult
+func (u ManageDataResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ManageDataResultCode(sw) {
+	case ManageDataResultCodeManageDataSuccess:
 		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewChangeTrustResult creates a new  ChangeTrustResult.
-func NewChangeTrustResult(code ChangeTrustResultCode, value interface{}) (result ChangeTrustResult, err error) {
+// NewManageDataResult creates a new  ManageDataResult.
+func NewManageDataResult(code ManageDataResultCode, value interface{}) (result ManageDataResult, err error) {
 	result.Code = code
-	switch ChangeTrustResultCode(code) {
-	case ChangeTrustResultCodeChangeTrustSuccess:
+	switch ManageDataResultCode(code) {
+	case ManageDataResultCodeManageDataSuccess:
 		// void
 	default:
 		// void
@@ -11171,125 +13460,112 @@ func NewChangeTrustResult(code ChangeTrustResultCode, value interface{}) (result
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ChangeTrustResult) MarshalBinary() ([]byte, error) {
+func (s ManageDataResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ChangeTrustResult) UnmarshalBinary(inp []byte) error {
+func (s *ManageDataResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ChangeTrustResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*ChangeTrustResult)(nil)
+	_ encoding.BinaryMarshaler   = (*ManageDataResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageDataResult)(nil)
 )
 
-// AllowTrustResultCode is an XDR Enum defines as:
+// BumpSequenceResultCode is an XDR Enum defines as:
 //
-//   enum AllowTrustResultCode
+//   enum BumpSequenceResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        ALLOW_TRUST_SUCCESS = 0,
+//        BUMP_SEQUENCE_SUCCESS = 0,
 //        // codes considered as "failure" for the operation
-//        ALLOW_TRUST_MALFORMED = -1,     // asset is not ASSET_TYPE_ALPHANUM
-//        ALLOW_TRUST_NO_TRUST_LINE = -2, // trustor does not have a trustline
-//                                        // source account does not require trust
-//        ALLOW_TRUST_TRUST_NOT_REQUIRED = -3,
-//        ALLOW_TRUST_CANT_REVOKE = -4,     // source account can't revoke trust,
-//        ALLOW_TRUST_SELF_NOT_ALLOWED = -5 // trusting self is not allowed
+//        BUMP_SEQUENCE_BAD_SEQ = -1 // `bumpTo` is not within bounds
 //    };
 //
-type AllowTrustResultCode int32
+type BumpSequenceResultCode int32
 
 const (
-	AllowTrustResultCodeAllowTrustSuccess          AllowTrustResultCode = 0
-	AllowTrustResultCodeAllowTrustMalformed        AllowTrustResultCode = -1
-	AllowTrustResultCodeAllowTrustNoTrustLine      AllowTrustResultCode = -2
-	AllowTrustResultCodeAllowTrustTrustNotRequired AllowTrustResultCode = -3
-	AllowTrustResultCodeAllowTrustCantRevoke       AllowTrustResultCode = -4
-	AllowTrustResultCodeAllowTrustSelfNotAllowed   AllowTrustResultCode = -5
+	BumpSequenceResultCodeBumpSequenceSuccess BumpSequenceResultCode = 0
+	BumpSequenceResultCodeBumpSequenceBadSeq  BumpSequenceResultCode = -1
 )
 
-var allowTrustResultCodeMap = map[int32]string{
-	0:  "AllowTrustResultCodeAllowTrustSuccess",
-	-1: "AllowTrustResultCodeAllowTrustMalformed",
-	-2: "AllowTrustResultCodeAllowTrustNoTrustLine",
-	-3: "AllowTrustResultCodeAllowTrustTrustNotRequired",
-	-4: "AllowTrustResultCodeAllowTrustCantRevoke",
-	-5: "AllowTrustResultCodeAllowTrustSelfNotAllowed",
+var bumpSequenceResultCodeMap = map[int32]string{
+	0:  "BumpSequenceResultCodeBumpSequenceSuccess",
+	-1: "BumpSequenceResultCodeBumpSequenceBadSeq",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for AllowTrustResultCode
-func (e AllowTrustResultCode) ValidEnum(v int32) bool {
-	_, ok := allowTrustResultCodeMap[v]
+// the Enum interface for BumpSequenceResultCode
+func (e BumpSequenceResultCode) ValidEnum(v int32) bool {
+	_, ok := bumpSequenceResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e AllowTrustResultCode) String() string {
-	name, _ := allowTrustResultCodeMap[int32(e)]
+func (e BumpSequenceResultCode) String() string {
+	name, _ := bumpSequenceResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AllowTrustResultCode) MarshalBinary() ([]byte, error) {
+func (s BumpSequenceResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AllowTrustResultCode) UnmarshalBinary(inp []byte) error {
+func (s *BumpSequenceResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AllowTrustResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*AllowTrustResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*BumpSequenceResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*BumpSequenceResultCode)(nil)
 )
 
-// AllowTrustResult is an XDR Union defines as:
+// BumpSequenceResult is an XDR Union defines as:
 //
-//   union AllowTrustResult switch (AllowTrustResultCode code)
+//   union BumpSequenceResult switch (BumpSequenceResultCode code)
 //    {
-//    case ALLOW_TRUST_SUCCESS:
+//    case BUMP_SEQUENCE_SUCCESS:
 //        void;
 //    default:
 //        void;
 //    };
 //
-type AllowTrustResult struct {
-	Code AllowTrustResultCode
+type BumpSequenceResult struct {
+	Code BumpSequenceResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u AllowTrustResult) SwitchFieldName() string {
+func (u BumpSequenceResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of AllowTrustResult
-func (u AllowTrustResult) ArmForSwitch(sw int32) (string, bool) {
-	switch AllowTrustResultCode(sw) {
-	case AllowTrustResultCodeAllowTrustSuccess:
+// the value for an instance of BumpSequenceResult
+func (u BumpSequenceResult) ArmForSwitch(sw int32) (string, bool) {
+	switch BumpSequenceResultCode(sw) {
+	case BumpSequenceResultCodeBumpSequenceSuccess:
 		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewAllowTrustResult creates a new  AllowTrustResult.
-func NewAllowTrustResult(code AllowTrustResultCode, value interface{}) (result AllowTrustResult, err error) {
+// NewBumpSequenceResult creates a new  BumpSequenceResult.
+func NewBumpSequenceResult(code BumpSequenceResultCode, value interface{}) (result BumpSequenceResult, err error) {
 	result.Code = code
-	switch AllowTrustResultCode(code) {
-	case AllowTrustResultCodeAllowTrustSuccess:
+	switch BumpSequenceResultCode(code) {
+	case BumpSequenceResultCodeBumpSequenceSuccess:
 		// void
 	default:
 		// void
@@ -11298,160 +13574,155 @@ func NewAllowTrustResult(code AllowTrustResultCode, value interface{}) (result A
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AllowTrustResult) MarshalBinary() ([]byte, error) {
+func (s BumpSequenceResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AllowTrustResult) UnmarshalBinary(inp []byte) error {
+func (s *BumpSequenceResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AllowTrustResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*AllowTrustResult)(nil)
+	_ encoding.BinaryMarshaler   = (*BumpSequenceResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*BumpSequenceResult)(nil)
 )
 
-// AccountMergeResultCode is an XDR Enum defines as:
+// CreateClaimableBalanceResultCode is an XDR Enum defines as:
 //
-//   enum AccountMergeResultCode
+//   enum CreateClaimableBalanceResultCode
 //    {
-//        // codes considered as "success" for the operation
-//        ACCOUNT_MERGE_SUCCESS = 0,
-//        // codes considered as "failure" for the operation
-//        ACCOUNT_MERGE_MALFORMED = -1,       // can't merge onto itself
-//        ACCOUNT_MERGE_NO_ACCOUNT = -2,      // destination does not exist
-//        ACCOUNT_MERGE_IMMUTABLE_SET = -3,   // source account has AUTH_IMMUTABLE set
-//        ACCOUNT_MERGE_HAS_SUB_ENTRIES = -4, // account has trust lines/offers
-//        ACCOUNT_MERGE_SEQNUM_TOO_FAR = -5,  // sequence number is over max allowed
-//        ACCOUNT_MERGE_DEST_FULL = -6        // can't add source balance to
-//                                            // destination balance
+//        CREATE_CLAIMABLE_BALANCE_SUCCESS = 0,
+//        CREATE_CLAIMABLE_BALANCE_MALFORMED = -1,
+//        CREATE_CLAIMABLE_BALANCE_LOW_RESERVE = -2,
+//        CREATE_CLAIMABLE_BALANCE_NO_TRUST = -3,
+//        CREATE_CLAIMABLE_BALANCE_NOT_AUTHORIZED = -4,
+//        CREATE_CLAIMABLE_BALANCE_UNDERFUNDED = -5
 //    };
 //
-type AccountMergeResultCode int32
+type CreateClaimableBalanceResultCode int32
 
 const (
-	AccountMergeResultCodeAccountMergeSuccess       AccountMergeResultCode = 0
-	AccountMergeResultCodeAccountMergeMalformed     AccountMergeResultCode = -1
-	AccountMergeResultCodeAccountMergeNoAccount     AccountMergeResultCode = -2
-	AccountMergeResultCodeAccountMergeImmutableSet  AccountMergeResultCode = -3
-	AccountMergeResultCodeAccountMergeHasSubEntries AccountMergeResultCode = -4
-	AccountMergeResultCodeAccountMergeSeqnumTooFar  AccountMergeResultCode = -5
-	AccountMergeResultCodeAccountMergeDestFull      AccountMergeResultCode = -6
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess       CreateClaimableBalanceResultCode = 0
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceMalformed     CreateClaimableBalanceResultCode = -1
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceLowReserve    CreateClaimableBalanceResultCode = -2
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceNoTrust       CreateClaimableBalanceResultCode = -3
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceNotAuthorized CreateClaimableBalanceResultCode = -4
+	CreateClaimableBalanceResultCodeCreateClaimableBalanceUnderfunded   CreateClaimableBalanceResultCode = -5
 )
 
-var accountMergeResultCodeMap = map[int32]string{
-	0:  "AccountMergeResultCodeAccountMergeSuccess",
-	-1: "AccountMergeResultCodeAccountMergeMalformed",
-	-2: "AccountMergeResultCodeAccountMergeNoAccount",
-	-3: "AccountMergeResultCodeAccountMergeImmutableSet",
-	-4: "AccountMergeResultCodeAccountMergeHasSubEntries",
-	-5: "AccountMergeResultCodeAccountMergeSeqnumTooFar",
-	-6: "AccountMergeResultCodeAccountMergeDestFull",
+var createClaimableBalanceResultCodeMap = map[int32]string{
+	0:  "CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess",
+	-1: "CreateClaimableBalanceResultCodeCreateClaimableBalanceMalformed",
+	-2: "CreateClaimableBalanceResultCodeCreateClaimableBalanceLowReserve",
+	-3: "CreateClaimableBalanceResultCodeCreateClaimableBalanceNoTrust",
+	-4: "CreateClaimableBalanceResultCodeCreateClaimableBalanceNotAuthorized",
+	-5: "CreateClaimableBalanceResultCodeCreateClaimableBalanceUnderfunded",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for AccountMergeResultCode
-func (e AccountMergeResultCode) ValidEnum(v int32) bool {
-	_, ok := accountMergeResultCodeMap[v]
+// the Enum interface for CreateClaimableBalanceResultCode
+func (e CreateClaimableBalanceResultCode) ValidEnum(v int32) bool {
+	_, ok := createClaimableBalanceResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e AccountMergeResultCode) String() string {
-	name, _ := accountMergeResultCodeMap[int32(e)]
+func (e CreateClaimableBalanceResultCode) String() string {
+	name, _ := createClaimableBalanceResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AccountMergeResultCode) MarshalBinary() ([]byte, error) {
+func (s CreateClaimableBalanceResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AccountMergeResultCode) UnmarshalBinary(inp []byte) error {
+func (s *CreateClaimableBalanceResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AccountMergeResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*AccountMergeResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*CreateClaimableBalanceResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateClaimableBalanceResultCode)(nil)
 )
 
-// AccountMergeResult is an XDR Union defines as:
+// CreateClaimableBalanceResult is an XDR Union defines as:
 //
-//   union AccountMergeResult switch (AccountMergeResultCode code)
+//   union CreateClaimableBalanceResult switch (
+//        CreateClaimableBalanceResultCode code)
 //    {
-//    case ACCOUNT_MERGE_SUCCESS:
-//        int64 sourceAccountBalance; // how much got transfered from source account
+//    case CREATE_CLAIMABLE_BALANCE_SUCCESS:
+//        ClaimableBalanceID balanceID;
 //    default:
 //        void;
 //    };
 //
-type AccountMergeResult struct {
-	Code                 AccountMergeResultCode
-	SourceAccountBalance *Int64
+type CreateClaimableBalanceResult struct {
+	Code      CreateClaimableBalanceResultCode
+	BalanceId *ClaimableBalanceId
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u AccountMergeResult) SwitchFieldName() string {
+func (u CreateClaimableBalanceResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of AccountMergeResult
-func (u AccountMergeResult) ArmForSwitch(sw int32) (string, bool) {
-	switch AccountMergeResultCode(sw) {
-	case AccountMergeResultCodeAccountMergeSuccess:
-		return "SourceAccountBalance", true
+// the value for an instance of CreateClaimableBalanceResult
+func (u CreateClaimableBalanceResult) ArmForSwitch(sw int32) (string, bool) {
+	switch CreateClaimableBalanceResultCode(sw) {
+	case CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess:
+		return "BalanceId", true
 	default:
 		return "", true
 	}
 }
 
-// NewAccountMergeResult creates a new  AccountMergeResult.
-func NewAccountMergeResult(code AccountMergeResultCode, value interface{}) (result AccountMergeResult, err error) {
+// NewCreateClaimableBalanceResult creates a new  CreateClaimableBalanceResult.
+func NewCreateClaimableBalanceResult(code CreateClaimableBalanceResultCode, value interface{}) (result CreateClaimableBalanceResult, err error) {
 	result.Code = code
-	switch AccountMergeResultCode(code) {
-	case AccountMergeResultCodeAccountMergeSuccess:
-		tv, ok := value.(Int64)
+	switch CreateClaimableBalanceResultCode(code) {
+	case CreateClaimableBalanceResultCodeCreateClaimableBalanceSuccess:
+		tv, ok := value.(ClaimableBalanceId)
 		if !ok {
-			err = fmt.Errorf("invalid value, must be Int64")
+			err = fmt.Errorf("invalid value, must be ClaimableBalanceId")
 			return
 		}
-		result.SourceAccountBalance = &tv
+		result.BalanceId = &tv
 	default:
 		// void
 	}
 	return
 }
 
-// MustSourceAccountBalance retrieves the SourceAccountBalance value from the union,
+// MustBalanceId retrieves the BalanceId value from the union,
 // panicing if the value is not set.
-func (u AccountMergeResult) MustSourceAccountBalance() Int64 {
-	val, ok := u.GetSourceAccountBalance()
+func (u CreateClaimableBalanceResult) MustBalanceId() ClaimableBalanceId {
+	val, ok := u.GetBalanceId()
 
 	if !ok {
-		panic("arm SourceAccountBalance is not set")
+		panic("arm BalanceId is not set")
 	}
 
 	return val
 }
 
-// GetSourceAccountBalance retrieves the SourceAccountBalance value from the union,
+// GetBalanceId retrieves the BalanceId value from the union,
 // returning ok if the union's switch indicated the value is valid.
-func (u AccountMergeResult) GetSourceAccountBalance() (result Int64, ok bool) {
+func (u CreateClaimableBalanceResult) GetBalanceId() (result ClaimableBalanceId, ok bool) {
 	armName, _ := u.ArmForSwitch(int32(u.Code))
 
-	if armName == "SourceAccountBalance" {
-		result = *u.SourceAccountBalance
+	if armName == "BalanceId" {
+		result = *u.BalanceId
 		ok = true
 	}
 
@@ -11459,299 +13730,361 @@ func (u AccountMergeResult) GetSourceAccountBalance() (result Int64, ok bool) {
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s AccountMergeResult) MarshalBinary() ([]byte, error) {
+func (s CreateClaimableBalanceResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *AccountMergeResult) UnmarshalBinary(inp []byte) error {
+func (s *CreateClaimableBalanceResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*AccountMergeResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*AccountMergeResult)(nil)
+	_ encoding.BinaryMarshaler   = (*CreateClaimableBalanceResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*CreateClaimableBalanceResult)(nil)
 )
 
-// InflationResultCode is an XDR Enum defines as:
+// ClaimClaimableBalanceResultCode is an XDR Enum defines as:
 //
-//   enum InflationResultCode
+//   enum ClaimClaimableBalanceResultCode
 //    {
-//        // codes considered as "success" for the operation
-//        INFLATION_SUCCESS = 0,
-//        // codes considered as "failure" for the operation
-//        INFLATION_NOT_TIME = -1
+//        CLAIM_CLAIMABLE_BALANCE_SUCCESS = 0,
+//        CLAIM_CLAIMABLE_BALANCE_DOES_NOT_EXIST = -1,
+//        CLAIM_CLAIMABLE_BALANCE_CANNOT_CLAIM = -2,
+//        CLAIM_CLAIMABLE_BALANCE_LINE_FULL = -3,
+//        CLAIM_CLAIMABLE_BALANCE_NO_TRUST = -4,
+//        CLAIM_CLAIMABLE_BALANCE_NOT_AUTHORIZED = -5
+//
 //    };
 //
-type InflationResultCode int32
+type ClaimClaimableBalanceResultCode int32
 
 const (
-	InflationResultCodeInflationSuccess InflationResultCode = 0
-	InflationResultCodeInflationNotTime InflationResultCode = -1
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceSuccess       ClaimClaimableBalanceResultCode = 0
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceDoesNotExist  ClaimClaimableBalanceResultCode = -1
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceCannotClaim   ClaimClaimableBalanceResultCode = -2
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceLineFull      ClaimClaimableBalanceResultCode = -3
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceNoTrust       ClaimClaimableBalanceResultCode = -4
+	ClaimClaimableBalanceResultCodeClaimClaimableBalanceNotAuthorized ClaimClaimableBalanceResultCode = -5
 )
 
-var inflationResultCodeMap = map[int32]string{
-	0:  "InflationResultCodeInflationSuccess",
-	-1: "InflationResultCodeInflationNotTime",
+var claimClaimableBalanceResultCodeMap = map[int32]string{
+	0:  "ClaimClaimableBalanceResultCodeClaimClaimableBalanceSuccess",
+	-1: "ClaimClaimableBalanceResultCodeClaimClaimableBalanceDoesNotExist",
+	-2: "ClaimClaimableBalanceResultCodeClaimClaimableBalanceCannotClaim",
+	-3: "ClaimClaimableBalanceResultCodeClaimClaimableBalanceLineFull",
+	-4: "ClaimClaimableBalanceResultCodeClaimClaimableBalanceNoTrust",
+	-5: "ClaimClaimableBalanceResultCodeClaimClaimableBalanceNotAuthorized",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for InflationResultCode
-func (e InflationResultCode) ValidEnum(v int32) bool {
-	_, ok := inflationResultCodeMap[v]
+// the Enum interface for ClaimClaimableBalanceResultCode
+func (e ClaimClaimableBalanceResultCode) ValidEnum(v int32) bool {
+	_, ok := claimClaimableBalanceResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e InflationResultCode) String() string {
-	name, _ := inflationResultCodeMap[int32(e)]
+func (e ClaimClaimableBalanceResultCode) String() string {
+	name, _ := claimClaimableBalanceResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s InflationResultCode) MarshalBinary() ([]byte, error) {
+func (s ClaimClaimableBalanceResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *InflationResultCode) UnmarshalBinary(inp []byte) error {
+func (s *ClaimClaimableBalanceResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*InflationResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*InflationResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*ClaimClaimableBalanceResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimClaimableBalanceResultCode)(nil)
 )
 
-// InflationPayout is an XDR Struct defines as:
+// ClaimClaimableBalanceResult is an XDR Union defines as:
 //
-//   struct InflationPayout // or use PaymentResultAtom to limit types?
+//   union ClaimClaimableBalanceResult switch (ClaimClaimableBalanceResultCode code)
 //    {
-//        AccountID destination;
-//        int64 amount;
+//    case CLAIM_CLAIMABLE_BALANCE_SUCCESS:
+//        void;
+//    default:
+//        void;
 //    };
 //
-type InflationPayout struct {
-	Destination AccountId
-	Amount      Int64
+type ClaimClaimableBalanceResult struct {
+	Code ClaimClaimableBalanceResultCode
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u ClaimClaimableBalanceResult) SwitchFieldName() string {
+	return "Code"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of ClaimClaimableBalanceResult
+func (u ClaimClaimableBalanceResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ClaimClaimableBalanceResultCode(sw) {
+	case ClaimClaimableBalanceResultCodeClaimClaimableBalanceSuccess:
+		return "", true
+	default:
+		return "", true
+	}
+}
+
+// NewClaimClaimableBalanceResult creates a new  ClaimClaimableBalanceResult.
+func NewClaimClaimableBalanceResult(code ClaimClaimableBalanceResultCode, value interface{}) (result ClaimClaimableBalanceResult, err error) {
+	result.Code = code
+	switch ClaimClaimableBalanceResultCode(code) {
+	case ClaimClaimableBalanceResultCodeClaimClaimableBalanceSuccess:
+		// void
+	default:
+		// void
+	}
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ClaimClaimableBalanceResult) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ClaimClaimableBalanceResult) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ClaimClaimableBalanceResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ClaimClaimableBalanceResult)(nil)
+)
+
+// BeginSponsoringFutureReservesResultCode is an XDR Enum defines as:
+//
+//   enum BeginSponsoringFutureReservesResultCode
+//    {
+//        // codes considered as "success" for the operation
+//        BEGIN_SPONSORING_FUTURE_RESERVES_SUCCESS = 0,
+//
+//        // codes considered as "failure" for the operation
+//        BEGIN_SPONSORING_FUTURE_RESERVES_MALFORMED = -1,
+//        BEGIN_SPONSORING_FUTURE_RESERVES_ALREADY_SPONSORED = -2,
+//        BEGIN_SPONSORING_FUTURE_RESERVES_RECURSIVE = -3
+//    };
+//
+type BeginSponsoringFutureReservesResultCode int32
+
+const (
+	BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesSuccess          BeginSponsoringFutureReservesResultCode = 0
+	BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesMalformed        BeginSponsoringFutureReservesResultCode = -1
+	BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesAlreadySponsored BeginSponsoringFutureReservesResultCode = -2
+	BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesRecursive        BeginSponsoringFutureReservesResultCode = -3
+)
+
+var beginSponsoringFutureReservesResultCodeMap = map[int32]string{
+	0:  "BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesSuccess",
+	-1: "BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesMalformed",
+	-2: "BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesAlreadySponsored",
+	-3: "BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesRecursive",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for BeginSponsoringFutureReservesResultCode
+func (e BeginSponsoringFutureReservesResultCode) ValidEnum(v int32) bool {
+	_, ok := beginSponsoringFutureReservesResultCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e BeginSponsoringFutureReservesResultCode) String() string {
+	name, _ := beginSponsoringFutureReservesResultCodeMap[int32(e)]
+	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s InflationPayout) MarshalBinary() ([]byte, error) {
+func (s BeginSponsoringFutureReservesResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *InflationPayout) UnmarshalBinary(inp []byte) error {
+func (s *BeginSponsoringFutureReservesResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*InflationPayout)(nil)
-	_ encoding.BinaryUnmarshaler = (*InflationPayout)(nil)
+	_ encoding.BinaryMarshaler   = (*BeginSponsoringFutureReservesResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*BeginSponsoringFutureReservesResultCode)(nil)
 )
 
-// InflationResult is an XDR Union defines as:
+// BeginSponsoringFutureReservesResult is an XDR Union defines as:
 //
-//   union InflationResult switch (InflationResultCode code)
+//   union BeginSponsoringFutureReservesResult switch (
+//        BeginSponsoringFutureReservesResultCode code)
 //    {
-//    case INFLATION_SUCCESS:
-//        InflationPayout payouts<>;
+//    case BEGIN_SPONSORING_FUTURE_RESERVES_SUCCESS:
+//        void;
 //    default:
 //        void;
 //    };
 //
-type InflationResult struct {
-	Code    InflationResultCode
-	Payouts *[]InflationPayout
+type BeginSponsoringFutureReservesResult struct {
+	Code BeginSponsoringFutureReservesResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u InflationResult) SwitchFieldName() string {
+func (u BeginSponsoringFutureReservesResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of InflationResult
-func (u InflationResult) ArmForSwitch(sw int32) (string, bool) {
-	switch InflationResultCode(sw) {
-	case InflationResultCodeInflationSuccess:
-		return "Payouts", true
+// the value for an instance of BeginSponsoringFutureReservesResult
+func (u BeginSponsoringFutureReservesResult) ArmForSwitch(sw int32) (string, bool) {
+	switch BeginSponsoringFutureReservesResultCode(sw) {
+	case BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesSuccess:
+		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewInflationResult creates a new  InflationResult.
-func NewInflationResult(code InflationResultCode, value interface{}) (result InflationResult, err error) {
+// NewBeginSponsoringFutureReservesResult creates a new  BeginSponsoringFutureReservesResult.
+func NewBeginSponsoringFutureReservesResult(code BeginSponsoringFutureReservesResultCode, value interface{}) (result BeginSponsoringFutureReservesResult, err error) {
 	result.Code = code
-	switch InflationResultCode(code) {
-	case InflationResultCodeInflationSuccess:
-		tv, ok := value.([]InflationPayout)
-		if !ok {
-			err = fmt.Errorf("invalid value, must be []InflationPayout")
-			return
-		}
-		result.Payouts = &tv
+	switch BeginSponsoringFutureReservesResultCode(code) {
+	case BeginSponsoringFutureReservesResultCodeBeginSponsoringFutureReservesSuccess:
+		// void
 	default:
 		// void
 	}
 	return
 }
 
-// MustPayouts retrieves the Payouts value from the union,
-// panicing if the value is not set.
-func (u InflationResult) MustPayouts() []InflationPayout {
-	val, ok := u.GetPayouts()
-
-	if !ok {
-		panic("arm Payouts is not set")
-	}
-
-	return val
-}
-
-// GetPayouts retrieves the Payouts value from the union,
-// returning ok if the union's switch indicated the value is valid.
-func (u InflationResult) GetPayouts() (result []InflationPayout, ok bool) {
-	armName, _ := u.ArmForSwitch(int32(u.Code))
-
-	if armName == "Payouts" {
-		result = *u.Payouts
-		ok = true
-	}
-
-	return
-}
-
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s InflationResult) MarshalBinary() ([]byte, error) {
+func (s BeginSponsoringFutureReservesResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *InflationResult) UnmarshalBinary(inp []byte) error {
+func (s *BeginSponsoringFutureReservesResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*InflationResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*InflationResult)(nil)
+	_ encoding.BinaryMarshaler   = (*BeginSponsoringFutureReservesResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*BeginSponsoringFutureReservesResult)(nil)
 )
 
-// ManageDataResultCode is an XDR Enum defines as:
+// EndSponsoringFutureReservesResultCode is an XDR Enum defines as:
 //
-//   enum ManageDataResultCode
+//   enum EndSponsoringFutureReservesResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        MANAGE_DATA_SUCCESS = 0,
+//        END_SPONSORING_FUTURE_RESERVES_SUCCESS = 0,
+//
 //        // codes considered as "failure" for the operation
-//        MANAGE_DATA_NOT_SUPPORTED_YET =
-//            -1, // The network hasn't moved to this protocol change yet
-//        MANAGE_DATA_NAME_NOT_FOUND =
-//            -2, // Trying to remove a Data Entry that isn't there
-//        MANAGE_DATA_LOW_RESERVE = -3, // not enough funds to create a new Data Entry
-//        MANAGE_DATA_INVALID_NAME = -4 // Name not a valid string
+//        END_SPONSORING_FUTURE_RESERVES_NOT_SPONSORED = -1
 //    };
 //
-type ManageDataResultCode int32
+type EndSponsoringFutureReservesResultCode int32
 
 const (
-	ManageDataResultCodeManageDataSuccess         ManageDataResultCode = 0
-	ManageDataResultCodeManageDataNotSupportedYet ManageDataResultCode = -1
-	ManageDataResultCodeManageDataNameNotFound    ManageDataResultCode = -2
-	ManageDataResultCodeManageDataLowReserve      ManageDataResultCode = -3
-	ManageDataResultCodeManageDataInvalidName     ManageDataResultCode = -4
+	EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesSuccess      EndSponsoringFutureReservesResultCode = 0
+	EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesNotSponsored EndSponsoringFutureReservesResultCode = -1
 )
 
-var manageDataResultCodeMap = map[int32]string{
-	0:  "ManageDataResultCodeManageDataSuccess",
-	-1: "ManageDataResultCodeManageDataNotSupportedYet",
-	-2: "ManageDataResultCodeManageDataNameNotFound",
-	-3: "ManageDataResultCodeManageDataLowReserve",
-	-4: "ManageDataResultCodeManageDataInvalidName",
+var endSponsoringFutureReservesResultCodeMap = map[int32]string{
+	0:  "EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesSuccess",
+	-1: "EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesNotSponsored",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for ManageDataResultCode
-func (e ManageDataResultCode) ValidEnum(v int32) bool {
-	_, ok := manageDataResultCodeMap[v]
+// the Enum interface for EndSponsoringFutureReservesResultCode
+func (e EndSponsoringFutureReservesResultCode) ValidEnum(v int32) bool {
+	_, ok := endSponsoringFutureReservesResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e ManageDataResultCode) String() string {
-	name, _ := manageDataResultCodeMap[int32(e)]
+func (e EndSponsoringFutureReservesResultCode) String() string {
+	name, _ := endSponsoringFutureReservesResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageDataResultCode) MarshalBinary() ([]byte, error) {
+func (s EndSponsoringFutureReservesResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageDataResultCode) UnmarshalBinary(inp []byte) error {
+func (s *EndSponsoringFutureReservesResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageDataResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageDataResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*EndSponsoringFutureReservesResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*EndSponsoringFutureReservesResultCode)(nil)
 )
 
-// ManageDataResult is an XDR Union defines as:
+// EndSponsoringFutureReservesResult is an XDR Union defines as:
 //
-//   union ManageDataResult switch (ManageDataResultCode code)
+//   union EndSponsoringFutureReservesResult switch (
+//        EndSponsoringFutureReservesResultCode code)
 //    {
-//    case MANAGE_DATA_SUCCESS:
+//    case END_SPONSORING_FUTURE_RESERVES_SUCCESS:
 //        void;
 //    default:
 //        void;
 //    };
 //
-type ManageDataResult struct {
-	Code ManageDataResultCode
+type EndSponsoringFutureReservesResult struct {
+	Code EndSponsoringFutureReservesResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u ManageDataResult) SwitchFieldName() string {
+func (u EndSponsoringFutureReservesResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of ManageDataResult
-func (u ManageDataResult) ArmForSwitch(sw int32) (string, bool) {
-	switch ManageDataResultCode(sw) {
-	case ManageDataResultCodeManageDataSuccess:
+// the value for an instance of EndSponsoringFutureReservesResult
+func (u EndSponsoringFutureReservesResult) ArmForSwitch(sw int32) (string, bool) {
+	switch EndSponsoringFutureReservesResultCode(sw) {
+	case EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesSuccess:
 		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewManageDataResult creates a new  ManageDataResult.
-func NewManageDataResult(code ManageDataResultCode, value interface{}) (result ManageDataResult, err error) {
+// NewEndSponsoringFutureReservesResult creates a new  EndSponsoringFutureReservesResult.
+func NewEndSponsoringFutureReservesResult(code EndSponsoringFutureReservesResultCode, value interface{}) (result EndSponsoringFutureReservesResult, err error) {
 	result.Code = code
-	switch ManageDataResultCode(code) {
-	case ManageDataResultCodeManageDataSuccess:
+	switch EndSponsoringFutureReservesResultCode(code) {
+	case EndSponsoringFutureReservesResultCodeEndSponsoringFutureReservesSuccess:
 		// void
 	default:
 		// void
@@ -11760,112 +14093,122 @@ func NewManageDataResult(code ManageDataResultCode, value interface{}) (result M
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s ManageDataResult) MarshalBinary() ([]byte, error) {
+func (s EndSponsoringFutureReservesResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *ManageDataResult) UnmarshalBinary(inp []byte) error {
+func (s *EndSponsoringFutureReservesResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*ManageDataResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*ManageDataResult)(nil)
+	_ encoding.BinaryMarshaler   = (*EndSponsoringFutureReservesResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*EndSponsoringFutureReservesResult)(nil)
 )
 
-// BumpSequenceResultCode is an XDR Enum defines as:
+// RevokeSponsorshipResultCode is an XDR Enum defines as:
 //
-//   enum BumpSequenceResultCode
+//   enum RevokeSponsorshipResultCode
 //    {
 //        // codes considered as "success" for the operation
-//        BUMP_SEQUENCE_SUCCESS = 0,
+//        REVOKE_SPONSORSHIP_SUCCESS = 0,
+//
 //        // codes considered as "failure" for the operation
-//        BUMP_SEQUENCE_BAD_SEQ = -1 // `bumpTo` is not within bounds
+//        REVOKE_SPONSORSHIP_DOES_NOT_EXIST = -1,
+//        REVOKE_SPONSORSHIP_NOT_SPONSOR = -2,
+//        REVOKE_SPONSORSHIP_LOW_RESERVE = -3,
+//        REVOKE_SPONSORSHIP_ONLY_TRANSFERABLE = -4
 //    };
 //
-type BumpSequenceResultCode int32
+type RevokeSponsorshipResultCode int32
 
 const (
-	BumpSequenceResultCodeBumpSequenceSuccess BumpSequenceResultCode = 0
-	BumpSequenceResultCodeBumpSequenceBadSeq  BumpSequenceResultCode = -1
+	RevokeSponsorshipResultCodeRevokeSponsorshipSuccess          RevokeSponsorshipResultCode = 0
+	RevokeSponsorshipResultCodeRevokeSponsorshipDoesNotExist     RevokeSponsorshipResultCode = -1
+	RevokeSponsorshipResultCodeRevokeSponsorshipNotSponsor       RevokeSponsorshipResultCode = -2
+	RevokeSponsorshipResultCodeRevokeSponsorshipLowReserve       RevokeSponsorshipResultCode = -3
+	RevokeSponsorshipResultCodeRevokeSponsorshipOnlyTransferable RevokeSponsorshipResultCode = -4
 )
 
-var bumpSequenceResultCodeMap = map[int32]string{
-	0:  "BumpSequenceResultCodeBumpSequenceSuccess",
-	-1: "BumpSequenceResultCodeBumpSequenceBadSeq",
+var revokeSponsorshipResultCodeMap = map[int32]string{
+	0:  "RevokeSponsorshipResultCodeRevokeSponsorshipSuccess",
+	-1: "RevokeSponsorshipResultCodeRevokeSponsorshipDoesNotExist",
+	-2: "RevokeSponsorshipResultCodeRevokeSponsorshipNotSponsor",
+	-3: "RevokeSponsorshipResultCodeRevokeSponsorshipLowReserve",
+	-4: "RevokeSponsorshipResultCodeRevokeSponsorshipOnlyTransferable",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
-// the Enum interface for BumpSequenceResultCode
-func (e BumpSequenceResultCode) ValidEnum(v int32) bool {
-	_, ok := bumpSequenceResultCodeMap[v]
+// the Enum interface for RevokeSponsorshipResultCode
+func (e RevokeSponsorshipResultCode) ValidEnum(v int32) bool {
+	_, ok := revokeSponsorshipResultCodeMap[v]
 	return ok
 }
 
 // String returns the name of `e`
-func (e BumpSequenceResultCode) String() string {
-	name, _ := bumpSequenceResultCodeMap[int32(e)]
+func (e RevokeSponsorshipResultCode) String() string {
+	name, _ := revokeSponsorshipResultCodeMap[int32(e)]
 	return name
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BumpSequenceResultCode) MarshalBinary() ([]byte, error) {
+func (s RevokeSponsorshipResultCode) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BumpSequenceResultCode) UnmarshalBinary(inp []byte) error {
+func (s *RevokeSponsorshipResultCode) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BumpSequenceResultCode)(nil)
-	_ encoding.BinaryUnmarshaler = (*BumpSequenceResultCode)(nil)
+	_ encoding.BinaryMarshaler   = (*RevokeSponsorshipResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*RevokeSponsorshipResultCode)(nil)
 )
 
-// BumpSequenceResult is an XDR Union defines as:
+// RevokeSponsorshipResult is an XDR Union defines as:
 //
-//   union BumpSequenceResult switch (BumpSequenceResultCode code)
+//   union RevokeSponsorshipResult switch (RevokeSponsorshipResultCode code)
 //    {
-//    case BUMP_SEQUENCE_SUCCESS:
+//    case REVOKE_SPONSORSHIP_SUCCESS:
 //        void;
 //    default:
 //        void;
 //    };
 //
-type BumpSequenceResult struct {
-	Code BumpSequenceResultCode
+type RevokeSponsorshipResult struct {
+	Code RevokeSponsorshipResultCode
 }
 
 // SwitchFieldName returns the field name in which this union's
 // discriminant is stored
-func (u BumpSequenceResult) SwitchFieldName() string {
+func (u RevokeSponsorshipResult) SwitchFieldName() string {
 	return "Code"
 }
 
 // ArmForSwitch returns which field name should be used for storing
-// the value for an instance of BumpSequenceResult
-func (u BumpSequenceResult) ArmForSwitch(sw int32) (string, bool) {
-	switch BumpSequenceResultCode(sw) {
-	case BumpSequenceResultCodeBumpSequenceSuccess:
+// the value for an instance of RevokeSponsorshipResult
+func (u RevokeSponsorshipResult) ArmForSwitch(sw int32) (string, bool) {
+	switch RevokeSponsorshipResultCode(sw) {
+	case RevokeSponsorshipResultCodeRevokeSponsorshipSuccess:
 		return "", true
 	default:
 		return "", true
 	}
 }
 
-// NewBumpSequenceResult creates a new  BumpSequenceResult.
-func NewBumpSequenceResult(code BumpSequenceResultCode, value interface{}) (result BumpSequenceResult, err error) {
+// NewRevokeSponsorshipResult creates a new  RevokeSponsorshipResult.
+func NewRevokeSponsorshipResult(code RevokeSponsorshipResultCode, value interface{}) (result RevokeSponsorshipResult, err error) {
 	result.Code = code
-	switch BumpSequenceResultCode(code) {
-	case BumpSequenceResultCodeBumpSequenceSuccess:
+	switch RevokeSponsorshipResultCode(code) {
+	case RevokeSponsorshipResultCodeRevokeSponsorshipSuccess:
 		// void
 	default:
 		// void
@@ -11874,21 +14217,21 @@ func NewBumpSequenceResult(code BumpSequenceResultCode, value interface{}) (resu
 }
 
 // MarshalBinary implements encoding.BinaryMarshaler.
-func (s BumpSequenceResult) MarshalBinary() ([]byte, error) {
+func (s RevokeSponsorshipResult) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
 	_, err := Marshal(b, s)
 	return b.Bytes(), err
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (s *BumpSequenceResult) UnmarshalBinary(inp []byte) error {
+func (s *RevokeSponsorshipResult) UnmarshalBinary(inp []byte) error {
 	_, err := Unmarshal(bytes.NewReader(inp), s)
 	return err
 }
 
 var (
-	_ encoding.BinaryMarshaler   = (*BumpSequenceResult)(nil)
-	_ encoding.BinaryUnmarshaler = (*BumpSequenceResult)(nil)
+	_ encoding.BinaryMarshaler   = (*RevokeSponsorshipResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*RevokeSponsorshipResult)(nil)
 )
 
 // OperationResultCode is an XDR Enum defines as:
@@ -11901,7 +14244,8 @@ var (
 //        opNO_ACCOUNT = -2,          // source account was not found
 //        opNOT_SUPPORTED = -3,       // operation not supported at this time
 //        opTOO_MANY_SUBENTRIES = -4, // max number of subentries already reached
-//        opEXCEEDED_WORK_LIMIT = -5  // operation did too much work
+//        opEXCEEDED_WORK_LIMIT = -5, // operation did too much work
+//        opTOO_MANY_SPONSORING = -6  // account is sponsoring too many entries
 //    };
 //
 type OperationResultCode int32
@@ -11913,6 +14257,7 @@ const (
 	OperationResultCodeOpNotSupported      OperationResultCode = -3
 	OperationResultCodeOpTooManySubentries OperationResultCode = -4
 	OperationResultCodeOpExceededWorkLimit OperationResultCode = -5
+	OperationResultCodeOpTooManySponsoring OperationResultCode = -6
 )
 
 var operationResultCodeMap = map[int32]string{
@@ -11922,6 +14267,7 @@ var operationResultCodeMap = map[int32]string{
 	-3: "OperationResultCodeOpNotSupported",
 	-4: "OperationResultCodeOpTooManySubentries",
 	-5: "OperationResultCodeOpExceededWorkLimit",
+	-6: "OperationResultCodeOpTooManySponsoring",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
@@ -11987,24 +14333,39 @@ var (
 //            ManageBuyOfferResult manageBuyOfferResult;
 //        case PATH_PAYMENT_STRICT_SEND:
 //            PathPaymentStrictSendResult pathPaymentStrictSendResult;
+//        case CREATE_CLAIMABLE_BALANCE:
+//            CreateClaimableBalanceResult createClaimableBalanceResult;
+//        case CLAIM_CLAIMABLE_BALANCE:
+//            ClaimClaimableBalanceResult claimClaimableBalanceResult;
+//        case BEGIN_SPONSORING_FUTURE_RESERVES:
+//            BeginSponsoringFutureReservesResult beginSponsoringFutureReservesResult;
+//        case END_SPONSORING_FUTURE_RESERVES:
+//            EndSponsoringFutureReservesResult endSponsoringFutureReservesResult;
+//        case REVOKE_SPONSORSHIP:
+//            RevokeSponsorshipResult revokeSponsorshipResult;
 //        }
 //
 type OperationResultTr struct {
-	Type                           OperationType
-	CreateAccountResult            *CreateAccountResult
-	PaymentResult                  *PaymentResult
-	PathPaymentStrictReceiveResult *PathPaymentStrictReceiveResult
-	ManageSellOfferResult          *ManageSellOfferResult
-	CreatePassiveSellOfferResult   *ManageSellOfferResult
-	SetOptionsResult               *SetOptionsResult
-	ChangeTrustResult              *ChangeTrustResult
-	AllowTrustResult               *AllowTrustResult
-	AccountMergeResult             *AccountMergeResult
-	InflationResult                *InflationResult
-	ManageDataResult               *ManageDataResult
-	BumpSeqResult                  *BumpSequenceResult
-	ManageBuyOfferResult           *ManageBuyOfferResult
-	PathPaymentStrictSendResult    *PathPaymentStrictSendResult
+	Type                                OperationType
+	CreateAccountResult                 *CreateAccountResult
+	PaymentResult                       *PaymentResult
+	PathPaymentStrictReceiveResult      *PathPaymentStrictReceiveResult
+	ManageSellOfferResult               *ManageSellOfferResult
+	CreatePassiveSellOfferResult        *ManageSellOfferResult
+	SetOptionsResult                    *SetOptionsResult
+	ChangeTrustResult                   *ChangeTrustResult
+	AllowTrustResult                    *AllowTrustResult
+	AccountMergeResult                  *AccountMergeResult
+	InflationResult                     *InflationResult
+	ManageDataResult                    *ManageDataResult
+	BumpSeqResult                       *BumpSequenceResult
+	ManageBuyOfferResult                *ManageBuyOfferResult
+	PathPaymentStrictSendResult         *PathPaymentStrictSendResult
+	CreateClaimableBalanceResult        *CreateClaimableBalanceResult
+	ClaimClaimableBalanceResult         *ClaimClaimableBalanceResult
+	BeginSponsoringFutureReservesResult *BeginSponsoringFutureReservesResult
+	EndSponsoringFutureReservesResult   *EndSponsoringFutureReservesResult
+	RevokeSponsorshipResult             *RevokeSponsorshipResult
 }
 
 // SwitchFieldName returns the field name in which this union's
@@ -12045,6 +14406,16 @@ func (u OperationResultTr) ArmForSwitch(sw int32) (string, bool) {
 		return "ManageBuyOfferResult", true
 	case OperationTypePathPaymentStrictSend:
 		return "PathPaymentStrictSendResult", true
+	case OperationTypeCreateClaimableBalance:
+		return "CreateClaimableBalanceResult", true
+	case OperationTypeClaimClaimableBalance:
+		return "ClaimClaimableBalanceResult", true
+	case OperationTypeBeginSponsoringFutureReserves:
+		return "BeginSponsoringFutureReservesResult", true
+	case OperationTypeEndSponsoringFutureReserves:
+		return "EndSponsoringFutureReservesResult", true
+	case OperationTypeRevokeSponsorship:
+		return "RevokeSponsorshipResult", true
 	}
 	return "-", false
 }
@@ -12151,6 +14522,41 @@ func NewOperationResultTr(aType OperationType, value interface{}) (result Operat
 			return
 		}
 		result.PathPaymentStrictSendResult = &tv
+	case OperationTypeCreateClaimableBalance:
+		tv, ok := value.(CreateClaimableBalanceResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be CreateClaimableBalanceResult")
+			return
+		}
+		result.CreateClaimableBalanceResult = &tv
+	case OperationTypeClaimClaimableBalance:
+		tv, ok := value.(ClaimClaimableBalanceResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ClaimClaimableBalanceResult")
+			return
+		}
+		result.ClaimClaimableBalanceResult = &tv
+	case OperationTypeBeginSponsoringFutureReserves:
+		tv, ok := value.(BeginSponsoringFutureReservesResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be BeginSponsoringFutureReservesResult")
+			return
+		}
+		result.BeginSponsoringFutureReservesResult = &tv
+	case OperationTypeEndSponsoringFutureReserves:
+		tv, ok := value.(EndSponsoringFutureReservesResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be EndSponsoringFutureReservesResult")
+			return
+		}
+		result.EndSponsoringFutureReservesResult = &tv
+	case OperationTypeRevokeSponsorship:
+		tv, ok := value.(RevokeSponsorshipResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be RevokeSponsorshipResult")
+			return
+		}
+		result.RevokeSponsorshipResult = &tv
 	}
 	return
 }
@@ -12505,6 +14911,131 @@ func (u OperationResultTr) GetPathPaymentStrictSendResult() (result PathPaymentS
 	return
 }
 
+// MustCreateClaimableBalanceResult retrieves the CreateClaimableBalanceResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustCreateClaimableBalanceResult() CreateClaimableBalanceResult {
+	val, ok := u.GetCreateClaimableBalanceResult()
+
+	if !ok {
+		panic("arm CreateClaimableBalanceResult is not set")
+	}
+
+	return val
+}
+
+// GetCreateClaimableBalanceResult retrieves the CreateClaimableBalanceResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetCreateClaimableBalanceResult() (result CreateClaimableBalanceResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "CreateClaimableBalanceResult" {
+		result = *u.CreateClaimableBalanceResult
+		ok = true
+	}
+
+	return
+}
+
+// MustClaimClaimableBalanceResult retrieves the ClaimClaimableBalanceResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustClaimClaimableBalanceResult() ClaimClaimableBalanceResult {
+	val, ok := u.GetClaimClaimableBalanceResult()
+
+	if !ok {
+		panic("arm ClaimClaimableBalanceResult is not set")
+	}
+
+	return val
+}
+
+// GetClaimClaimableBalanceResult retrieves the ClaimClaimableBalanceResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetClaimClaimableBalanceResult() (result ClaimClaimableBalanceResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ClaimClaimableBalanceResult" {
+		result = *u.ClaimClaimableBalanceResult
+		ok = true
+	}
+
+	return
+}
+
+// MustBeginSponsoringFutureReservesResult retrieves the BeginSponsoringFutureReservesResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustBeginSponsoringFutureReservesResult() BeginSponsoringFutureReservesResult {
+	val, ok := u.GetBeginSponsoringFutureReservesResult()
+
+	if !ok {
+		panic("arm BeginSponsoringFutureReservesResult is not set")
+	}
+
+	return val
+}
+
+// GetBeginSponsoringFutureReservesResult retrieves the BeginSponsoringFutureReservesResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetBeginSponsoringFutureReservesResult() (result BeginSponsoringFutureReservesResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "BeginSponsoringFutureReservesResult" {
+		result = *u.BeginSponsoringFutureReservesResult
+		ok = true
+	}
+
+	return
+}
+
+// MustEndSponsoringFutureReservesResult retrieves the EndSponsoringFutureReservesResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustEndSponsoringFutureReservesResult() EndSponsoringFutureReservesResult {
+	val, ok := u.GetEndSponsoringFutureReservesResult()
+
+	if !ok {
+		panic("arm EndSponsoringFutureReservesResult is not set")
+	}
+
+	return val
+}
+
+// GetEndSponsoringFutureReservesResult retrieves the EndSponsoringFutureReservesResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetEndSponsoringFutureReservesResult() (result EndSponsoringFutureReservesResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "EndSponsoringFutureReservesResult" {
+		result = *u.EndSponsoringFutureReservesResult
+		ok = true
+	}
+
+	return
+}
+
+// MustRevokeSponsorshipResult retrieves the RevokeSponsorshipResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustRevokeSponsorshipResult() RevokeSponsorshipResult {
+	val, ok := u.GetRevokeSponsorshipResult()
+
+	if !ok {
+		panic("arm RevokeSponsorshipResult is not set")
+	}
+
+	return val
+}
+
+// GetRevokeSponsorshipResult retrieves the RevokeSponsorshipResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetRevokeSponsorshipResult() (result RevokeSponsorshipResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "RevokeSponsorshipResult" {
+		result = *u.RevokeSponsorshipResult
+		ok = true
+	}
+
+	return
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
 func (s OperationResultTr) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
@@ -12558,6 +15089,16 @@ var (
 //            ManageBuyOfferResult manageBuyOfferResult;
 //        case PATH_PAYMENT_STRICT_SEND:
 //            PathPaymentStrictSendResult pathPaymentStrictSendResult;
+//        case CREATE_CLAIMABLE_BALANCE:
+//            CreateClaimableBalanceResult createClaimableBalanceResult;
+//        case CLAIM_CLAIMABLE_BALANCE:
+//            ClaimClaimableBalanceResult claimClaimableBalanceResult;
+//        case BEGIN_SPONSORING_FUTURE_RESERVES:
+//            BeginSponsoringFutureReservesResult beginSponsoringFutureReservesResult;
+//        case END_SPONSORING_FUTURE_RESERVES:
+//            EndSponsoringFutureReservesResult endSponsoringFutureReservesResult;
+//        case REVOKE_SPONSORSHIP:
+//            RevokeSponsorshipResult revokeSponsorshipResult;
 //        }
 //        tr;
 //    default:
@@ -12667,8 +15208,9 @@ var (
 //        txBAD_AUTH_EXTRA = -10,      // unused signatures attached to transaction
 //        txINTERNAL_ERROR = -11,      // an unknown error occured
 //
-//        txNOT_SUPPORTED = -12,        // transaction type not supported
-//        txFEE_BUMP_INNER_FAILED = -13 // fee bump inner transaction failed
+//        txNOT_SUPPORTED = -12,         // transaction type not supported
+//        txFEE_BUMP_INNER_FAILED = -13, // fee bump inner transaction failed
+//        txBAD_SPONSORSHIP = -14        // sponsorship not confirmed
 //    };
 //
 type TransactionResultCode int32
@@ -12689,6 +15231,7 @@ const (
 	TransactionResultCodeTxInternalError       TransactionResultCode = -11
 	TransactionResultCodeTxNotSupported        TransactionResultCode = -12
 	TransactionResultCodeTxFeeBumpInnerFailed  TransactionResultCode = -13
+	TransactionResultCodeTxBadSponsorship      TransactionResultCode = -14
 )
 
 var transactionResultCodeMap = map[int32]string{
@@ -12707,6 +15250,7 @@ var transactionResultCodeMap = map[int32]string{
 	-11: "TransactionResultCodeTxInternalError",
 	-12: "TransactionResultCodeTxNotSupported",
 	-13: "TransactionResultCodeTxFeeBumpInnerFailed",
+	-14: "TransactionResultCodeTxBadSponsorship",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
@@ -12759,7 +15303,8 @@ var (
 //        case txBAD_AUTH_EXTRA:
 //        case txINTERNAL_ERROR:
 //        case txNOT_SUPPORTED:
-//            // txFEE_BUMP_INNER_FAILED is not included
+//        // txFEE_BUMP_INNER_FAILED is not included
+//        case txBAD_SPONSORSHIP:
 //            void;
 //        }
 //
@@ -12804,6 +15349,8 @@ func (u InnerTransactionResultResult) ArmForSwitch(sw int32) (string, bool) {
 		return "", true
 	case TransactionResultCodeTxNotSupported:
 		return "", true
+	case TransactionResultCodeTxBadSponsorship:
+		return "", true
 	}
 	return "-", false
 }
@@ -12848,6 +15395,8 @@ func NewInnerTransactionResultResult(code TransactionResultCode, value interface
 		// void
 	case TransactionResultCodeTxNotSupported:
 		// void
+	case TransactionResultCodeTxBadSponsorship:
+		// void
 	}
 	return
 }
@@ -12975,7 +15524,8 @@ var (
 //        case txBAD_AUTH_EXTRA:
 //        case txINTERNAL_ERROR:
 //        case txNOT_SUPPORTED:
-//            // txFEE_BUMP_INNER_FAILED is not included
+//        // txFEE_BUMP_INNER_FAILED is not included
+//        case txBAD_SPONSORSHIP:
 //            void;
 //        }
 //        result;